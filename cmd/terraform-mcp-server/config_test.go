@@ -5,13 +5,16 @@ package main
 
 import (
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/tools"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestGetHTTPHost(t *testing.T) {
@@ -123,6 +126,44 @@ func TestShouldUseStreamableHTTPMode(t *testing.T) {
 	assert.True(t, shouldUseStreamableHTTPMode(), "HTTP mode should be used when MCP_ENDPOINT is set")
 }
 
+func TestShouldUseSSEMode(t *testing.T) {
+	t.Setenv("TRANSPORT_MODE", "")
+	t.Setenv("TRANSPORT_PORT", "9090")
+	assert.False(t, shouldUseSSEMode(), "SSE mode should not be used unless TRANSPORT_MODE is 'sse'")
+
+	// TRANSPORT_PORT and friends select the SSE transport's port rather than streamable HTTP
+	t.Setenv("TRANSPORT_MODE", "sse")
+	assert.True(t, shouldUseSSEMode(), "SSE mode should be used when TRANSPORT_MODE is set to 'sse'")
+	assert.False(t, shouldUseStreamableHTTPMode(), "HTTP mode should not be used when TRANSPORT_MODE is set to 'sse'")
+}
+
+func TestGetSSEEndpoints(t *testing.T) {
+	t.Setenv("MCP_SSE_ENDPOINT", "")
+	t.Setenv("MCP_MESSAGE_ENDPOINT", "")
+	t.Setenv("MCP_SSE_BASE_URL", "")
+	assert.Equal(t, "/sse", getSSEEndpoint(nil))
+	assert.Equal(t, "/message", getMessageEndpoint(nil))
+	assert.Equal(t, "", getSSEBaseURL(nil))
+
+	assert.Equal(t, "/events", getSSEEndpoint(sseCmdWithFlags(t, "--sse-endpoint", "/events")))
+
+	t.Setenv("MCP_SSE_ENDPOINT", "/custom/sse")
+	t.Setenv("MCP_MESSAGE_ENDPOINT", "/custom/message")
+	t.Setenv("MCP_SSE_BASE_URL", "https://mcp.example.com")
+	assert.Equal(t, "/custom/sse", getSSEEndpoint(sseCmdWithFlags(t, "--sse-endpoint", "/events")))
+	assert.Equal(t, "/custom/message", getMessageEndpoint(nil))
+	assert.Equal(t, "https://mcp.example.com", getSSEBaseURL(nil))
+}
+
+func sseCmdWithFlags(t *testing.T, args ...string) *cobra.Command {
+	cmd := &cobra.Command{Use: "sse"}
+	cmd.Flags().String("sse-endpoint", "/sse", "")
+	cmd.Flags().String("message-endpoint", "/message", "")
+	cmd.Flags().String("base-url", "", "")
+	require.NoError(t, cmd.Flags().Parse(args))
+	return cmd
+}
+
 func TestShouldUseStatelessMode(t *testing.T) {
 	// Save original env var to restore later
 	origMode := os.Getenv("MCP_SESSION_MODE")
@@ -444,6 +485,31 @@ func TestInitLoggerWithFormat(t *testing.T) {
 	}
 }
 
+func TestInitLoggerConfiguresStandardLogger(t *testing.T) {
+	std := log.StandardLogger()
+	level, formatter, out := std.GetLevel(), std.Formatter, std.Out
+	t.Cleanup(func() {
+		std.SetLevel(level)
+		std.SetFormatter(formatter)
+		std.SetOutput(out)
+	})
+
+	logFile := filepath.Join(t.TempDir(), "server.log")
+	logger, err := initLogger(logFile, log.DebugLevel, "json")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if std.GetLevel() != log.DebugLevel {
+		t.Errorf("expected standard logger level %v, got %v", log.DebugLevel, std.GetLevel())
+	}
+	if _, ok := std.Formatter.(*log.JSONFormatter); !ok {
+		t.Errorf("expected standard logger JSONFormatter, got %T", std.Formatter)
+	}
+	if std.Out != logger.Out {
+		t.Error("expected standard logger to write to the log file")
+	}
+}
+
 func TestGetLogFormat(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -616,3 +682,131 @@ func TestGetLogFormatWithNilCommand(t *testing.T) {
 		t.Errorf("expected default text format with invalid env var and nil command, got %q", format)
 	}
 }
+
+func TestGetMaxResponseBytes(t *testing.T) {
+	newCmd := func(args ...string) *cobra.Command {
+		cmd := &cobra.Command{}
+		cmd.PersistentFlags().Int("max-response-bytes", tools.DefaultMaxResponseBytes, "")
+		require.NoError(t, cmd.PersistentFlags().Parse(args))
+		return cmd
+	}
+
+	t.Setenv(tools.MaxResponseBytesEnv, "")
+	assert.Equal(t, -1, getMaxResponseBytes(nil))
+	assert.Equal(t, -1, getMaxResponseBytes(newCmd()))
+	assert.Equal(t, 50000, getMaxResponseBytes(newCmd("--max-response-bytes=50000")))
+	assert.Equal(t, 0, getMaxResponseBytes(newCmd("--max-response-bytes=0")))
+
+	t.Setenv(tools.MaxResponseBytesEnv, "100000")
+	assert.Equal(t, -1, getMaxResponseBytes(newCmd("--max-response-bytes=50000")))
+}
+
+func TestGetUnsafeFullState(t *testing.T) {
+	newCmd := func(args ...string) *cobra.Command {
+		cmd := &cobra.Command{}
+		cmd.PersistentFlags().Bool("unsafe-full-state", false, "")
+		require.NoError(t, cmd.PersistentFlags().Parse(args))
+		return cmd
+	}
+
+	assert.False(t, getUnsafeFullState(nil))
+	assert.False(t, getUnsafeFullState(newCmd()))
+	assert.True(t, getUnsafeFullState(newCmd("--unsafe-full-state")))
+}
+
+func TestGetWorkspaceBlueprintsFile(t *testing.T) {
+	newCmd := func(args ...string) *cobra.Command {
+		cmd := &cobra.Command{}
+		cmd.PersistentFlags().String("workspace-blueprints-file", "", "")
+		require.NoError(t, cmd.PersistentFlags().Parse(args))
+		return cmd
+	}
+
+	assert.Empty(t, getWorkspaceBlueprintsFile(nil))
+	assert.Empty(t, getWorkspaceBlueprintsFile(newCmd()))
+	assert.Equal(t, "/etc/mcp/blueprints.yaml", getWorkspaceBlueprintsFile(newCmd("--workspace-blueprints-file= /etc/mcp/blueprints.yaml")))
+}
+
+func TestGetLocalExecution(t *testing.T) {
+	newCmd := func(args ...string) *cobra.Command {
+		cmd := &cobra.Command{}
+		cmd.PersistentFlags().Bool("enable-local-execution", false, "")
+		cmd.PersistentFlags().String("terraform-provider-mirror", "", "")
+		require.NoError(t, cmd.PersistentFlags().Parse(args))
+		return cmd
+	}
+
+	enabled, mirror := getLocalExecution(nil)
+	assert.False(t, enabled)
+	assert.Empty(t, mirror)
+	enabled, mirror = getLocalExecution(newCmd("--enable-local-execution", "--terraform-provider-mirror= /opt/terraform/providers"))
+	assert.True(t, enabled)
+	assert.Equal(t, "/opt/terraform/providers", mirror)
+}
+
+func TestGetHTTPServerConfig(t *testing.T) {
+	newCmd := func(args ...string) *cobra.Command {
+		cmd := &cobra.Command{}
+		cmd.Flags().Duration("read-timeout", 30*time.Second, "")
+		cmd.Flags().Duration("write-timeout", 0, "")
+		cmd.Flags().Duration("idle-timeout", time.Minute, "")
+		cmd.Flags().Duration("session-idle-ttl", 0, "")
+		cmd.Flags().Int("event-replay-buffer", client.DefaultEventReplayBuffer, "")
+		require.NoError(t, cmd.Flags().Parse(args))
+		return cmd
+	}
+	t.Setenv(client.ServerReadTimeoutEnv, "")
+	t.Setenv(client.ServerIdleTimeoutEnv, "")
+	t.Setenv(client.SessionIdleTTLEnv, "")
+	t.Setenv(client.EventReplayBufferEnv, "")
+
+	t.Run("defaults", func(t *testing.T) {
+		t.Setenv(client.ServerWriteTimeoutEnv, "")
+		assert.Equal(t, client.DefaultHTTPServerConfig(), getHTTPServerConfig(newCmd()))
+		assert.Equal(t, client.DefaultHTTPServerConfig(), getHTTPServerConfig(nil))
+	})
+
+	t.Run("flags", func(t *testing.T) {
+		t.Setenv(client.ServerWriteTimeoutEnv, "")
+		config := getHTTPServerConfig(newCmd("--read-timeout=1m", "--write-timeout=15m", "--idle-timeout=2m", "--session-idle-ttl=1h", "--event-replay-buffer=0"))
+		assert.Equal(t, client.HTTPServerConfig{ReadTimeout: time.Minute, WriteTimeout: 15 * time.Minute, IdleTimeout: 2 * time.Minute, SessionIdleTTL: time.Hour}, config)
+	})
+
+	t.Run("environment variables take precedence", func(t *testing.T) {
+		t.Setenv(client.ServerWriteTimeoutEnv, "5m")
+		config := getHTTPServerConfig(newCmd("--write-timeout=15m"))
+		assert.Equal(t, 5*time.Minute, config.WriteTimeout)
+	})
+}
+
+func TestGetRetryConfig(t *testing.T) {
+	newCmd := func(args ...string) *cobra.Command {
+		cmd := &cobra.Command{}
+		cmd.PersistentFlags().Int("http-retry-max", 3, "")
+		cmd.PersistentFlags().Duration("http-timeout", 10*time.Second, "")
+		cmd.PersistentFlags().Duration("http-backoff-max", 0, "")
+		cmd.PersistentFlags().Duration("http-download-timeout", 5*time.Minute, "")
+		require.NoError(t, cmd.PersistentFlags().Parse(args))
+		return cmd
+	}
+	t.Setenv(client.HTTPRetryMaxEnv, "")
+	t.Setenv(client.HTTPBackoffMaxEnv, "")
+	t.Setenv(client.HTTPDownloadTimeoutEnv, "")
+
+	t.Run("defaults", func(t *testing.T) {
+		t.Setenv(client.HTTPTimeoutEnv, "")
+		assert.Equal(t, client.DefaultRetryConfig(), getRetryConfig(newCmd()))
+	})
+
+	t.Run("flags", func(t *testing.T) {
+		t.Setenv(client.HTTPTimeoutEnv, "")
+		config := getRetryConfig(newCmd("--http-retry-max=5", "--http-timeout=1m", "--http-backoff-max=30s", "--http-download-timeout=20m"))
+		assert.Equal(t, client.RetryConfig{RetryMax: 5, Timeout: time.Minute, BackoffMax: 30 * time.Second, DownloadTimeout: 20 * time.Minute}, config)
+	})
+
+	t.Run("environment variables take precedence", func(t *testing.T) {
+		t.Setenv(client.HTTPTimeoutEnv, "2m")
+		config := getRetryConfig(newCmd("--http-timeout=1m"))
+		assert.Equal(t, 2*time.Minute, config.Timeout)
+	})
+}
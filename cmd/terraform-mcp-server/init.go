@@ -16,8 +16,11 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/prompts"
 	"github.com/hashicorp/terraform-mcp-server/pkg/resources"
 	"github.com/hashicorp/terraform-mcp-server/pkg/tools"
+	registryTools "github.com/hashicorp/terraform-mcp-server/pkg/tools/registry"
+	tfeTools "github.com/hashicorp/terraform-mcp-server/pkg/tools/tfe"
 	"github.com/hashicorp/terraform-mcp-server/pkg/toolsets"
 	"github.com/hashicorp/terraform-mcp-server/version"
 	instana "github.com/instana/go-sensor"
@@ -33,7 +36,9 @@ type healthResponse struct {
 	Service   string `json:"service"`
 	Transport string `json:"transport"`
 	Endpoint  string `json:"endpoint"`
-	Version   string `json:"version"`
+	// SessionMode is "stateful" or "stateless" for the streamable HTTP transport
+	SessionMode string `json:"session_mode,omitempty"`
+	Version     string `json:"version"`
 }
 
 var (
@@ -61,6 +66,11 @@ var (
 				stdlog.Fatal("Failed to initialize logger:", err)
 			}
 
+			client.SetRetryConfig(getRetryConfig(cmd.Root()))
+			tools.SetMaxResponseBytes(getMaxResponseBytes(cmd.Root()))
+			tfeTools.SetUnsafeFullState(getUnsafeFullState(cmd.Root()))
+			tfeTools.SetWorkspaceBlueprintsFile(getWorkspaceBlueprintsFile(cmd.Root()))
+			registryTools.SetLocalExecution(getLocalExecution(cmd.Root()))
 			enabledToolsets := getToolsetsFromCmd(cmd.Root(), logger)
 
 			if err := runStdioServer(logger, enabledToolsets); err != nil {
@@ -103,7 +113,15 @@ var (
 			if err != nil {
 				stdlog.Fatal("Failed to get heartbeat-interval:", err)
 			}
+			if !cmd.Flags().Changed("heartbeat-interval") {
+				heartbeatInterval = getHeartbeatInterval()
+			}
 
+			client.SetRetryConfig(getRetryConfig(cmd.Root()))
+			tools.SetMaxResponseBytes(getMaxResponseBytes(cmd.Root()))
+			tfeTools.SetUnsafeFullState(getUnsafeFullState(cmd.Root()))
+			tfeTools.SetWorkspaceBlueprintsFile(getWorkspaceBlueprintsFile(cmd.Root()))
+			registryTools.SetLocalExecution(getLocalExecution(cmd.Root()))
 			enabledToolsets := getToolsetsFromCmd(cmd.Root(), logger)
 			organizationAllowlist, err := getOrganizationAllowlist(cmd)
 			if err != nil {
@@ -113,12 +131,67 @@ var (
 			metricsConfig, shutdownMetrics := setupMetrics(logger)
 			defer shutdownMetrics()
 
-			if err := runHTTPServer(logger, host, port, endpointPath, heartbeatInterval, enabledToolsets, metricsConfig, organizationAllowlist); err != nil {
+			if err := runHTTPServer(logger, host, port, endpointPath, heartbeatInterval, getHTTPServerConfig(cmd), enabledToolsets, metricsConfig, organizationAllowlist); err != nil {
 				stdlog.Fatal("failed to run streamableHTTP server:", err)
 			}
 		},
 	}
 
+	sseCmd = &cobra.Command{
+		Use:   "sse",
+		Short: "Start SSE server",
+		Long:  `Start a server that communicates via the legacy HTTP+SSE transport on port 8080, for MCP clients that don't support streamable HTTP. Clients connect to the /sse endpoint and post messages to the /message endpoint.`,
+		Run: func(cmd *cobra.Command, _ []string) {
+			logFile, err := rootCmd.PersistentFlags().GetString("log-file")
+			if err != nil {
+				stdlog.Fatal("Failed to get log file:", err)
+			}
+			logLevel := getLogLevel(cmd.Root())
+			logFormat := getLogFormat(cmd)
+			logger, err := initLogger(logFile, logLevel, logFormat)
+			if err != nil {
+				stdlog.Fatal("Failed to initialize logger:", err)
+			}
+
+			port, err := cmd.Flags().GetString("transport-port")
+			if err != nil {
+				stdlog.Fatal("Failed to get SSE port:", err)
+			}
+			host, err := cmd.Flags().GetString("transport-host")
+			if err != nil {
+				stdlog.Fatal("Failed to get SSE host:", err)
+			}
+			keepAliveInterval, err := cmd.Flags().GetDuration("heartbeat-interval")
+			if err != nil {
+				stdlog.Fatal("Failed to get heartbeat-interval:", err)
+			}
+			if !cmd.Flags().Changed("heartbeat-interval") {
+				keepAliveInterval = getHeartbeatInterval()
+			}
+			sseEndpoint := getSSEEndpoint(cmd)
+			messageEndpoint := getMessageEndpoint(cmd)
+			baseURL := getSSEBaseURL(cmd)
+
+			client.SetRetryConfig(getRetryConfig(cmd.Root()))
+			tools.SetMaxResponseBytes(getMaxResponseBytes(cmd.Root()))
+			tfeTools.SetUnsafeFullState(getUnsafeFullState(cmd.Root()))
+			tfeTools.SetWorkspaceBlueprintsFile(getWorkspaceBlueprintsFile(cmd.Root()))
+			registryTools.SetLocalExecution(getLocalExecution(cmd.Root()))
+			enabledToolsets := getToolsetsFromCmd(cmd.Root(), logger)
+			organizationAllowlist, err := getOrganizationAllowlist(cmd)
+			if err != nil {
+				stdlog.Fatal(err)
+			}
+			logger.Printf("Starting SSE server with host: %s, port: %s, sseEndpoint: %s, messageEndpoint: %s, baseURL: %s, heartbeatInterval: %v, enabledToolsets: %v, organizationAllowlistConfigured: %t, organizationAllowlistCount: %d", host, port, sseEndpoint, messageEndpoint, baseURL, keepAliveInterval, enabledToolsets, len(organizationAllowlist) > 0, len(organizationAllowlist))
+			metricsConfig, shutdownMetrics := setupMetrics(logger)
+			defer shutdownMetrics()
+
+			if err := runSSEServer(logger, host, port, sseEndpoint, messageEndpoint, baseURL, keepAliveInterval, getHTTPServerConfig(cmd), enabledToolsets, metricsConfig, organizationAllowlist); err != nil {
+				stdlog.Fatal("failed to run SSE server:", err)
+			}
+		},
+	}
+
 	// Create an alias for backward compatibility
 	httpCmdAlias = &cobra.Command{
 		Use:        "http",
@@ -140,6 +213,15 @@ func init() {
 	rootCmd.PersistentFlags().String("log-format", "text", "Log format (text or json)")
 	rootCmd.PersistentFlags().String("toolsets", "all", toolsets.GenerateToolsetsHelp())
 	rootCmd.PersistentFlags().String("tools", "", toolsets.GenerateToolsHelp())
+	rootCmd.PersistentFlags().Int("http-retry-max", client.DefaultRetryConfig().RetryMax, "How many times a throttled request to HCP Terraform / TFE or the public registry is retried")
+	rootCmd.PersistentFlags().Duration("http-timeout", client.DefaultRetryConfig().Timeout, "Timeout of each outbound request (e.g., 30s)")
+	rootCmd.PersistentFlags().Duration("http-backoff-max", 0, "Longest wait before a throttled request is retried (e.g., 1m). 0 to follow the Retry-After header")
+	rootCmd.PersistentFlags().Duration("http-download-timeout", client.DefaultRetryConfig().DownloadTimeout, "Timeout of the outbound requests of tools that download configuration, state or plan files (e.g., 10m)")
+	rootCmd.PersistentFlags().Int("max-response-bytes", tools.DefaultMaxResponseBytes, "Largest text returned by a tool call in bytes, larger results are truncated. 0 to disable")
+	rootCmd.PersistentFlags().Bool("unsafe-full-state", false, "Return the sensitive attribute values of state resources to the model. Exposes secrets, only for trusted local use")
+	rootCmd.PersistentFlags().String("workspace-blueprints-file", "", "Path to the JSON or YAML file of the workspace blueprints of create_workspace_from_blueprint")
+	rootCmd.PersistentFlags().Bool("enable-local-execution", false, "Register validate_terraform_configuration, which runs the Terraform CLI on configuration passed in by the client. stdio transport only, requires --terraform-provider-mirror")
+	rootCmd.PersistentFlags().String("terraform-provider-mirror", "", "Directory or https:// URL of the provider mirror validate_terraform_configuration installs providers from")
 
 	// Add StreamableHTTP command flags (avoid 'h' shorthand conflict with help)
 	streamableHTTPCmd.Flags().String("transport-host", "127.0.0.1", "Host to bind to")
@@ -155,8 +237,29 @@ func init() {
 	httpCmdAlias.Flags().Duration("heartbeat-interval", 0, "Heartbeat interval for HTTP connections (e.g., 30s). 0 to disable")
 	httpCmdAlias.Flags().String("organization-allowlist", "", "Comma-separated list of HCP Terraform organization names allowed to access the HTTP server")
 
+	// Add SSE command flags
+	sseCmd.Flags().String("transport-host", "127.0.0.1", "Host to bind to")
+	sseCmd.Flags().StringP("transport-port", "p", "8080", "Port to listen on")
+	sseCmd.Flags().Duration("heartbeat-interval", 0, "Keep-alive interval for SSE connections (e.g., 30s). 0 to disable")
+	sseCmd.Flags().String("sse-endpoint", "/sse", "Path for the SSE event stream endpoint")
+	sseCmd.Flags().String("message-endpoint", "/message", "Path for the SSE message endpoint")
+	sseCmd.Flags().String("base-url", "", "Public base URL of the server, used to announce an absolute message endpoint (e.g., https://mcp.example.com)")
+	sseCmd.Flags().String("organization-allowlist", "", "Comma-separated list of HCP Terraform organization names allowed to access the HTTP server")
+
+	for _, cmd := range []*cobra.Command{streamableHTTPCmd, httpCmdAlias, sseCmd} {
+		defaults := client.DefaultHTTPServerConfig()
+		cmd.Flags().Duration("read-timeout", defaults.ReadTimeout, "How long the server waits for a request to be read (e.g., 30s). 0 to disable")
+		cmd.Flags().Duration("write-timeout", defaults.WriteTimeout, "How long the server may take to write a response, event streams included (e.g., 10m). 0 to disable")
+		cmd.Flags().Duration("idle-timeout", defaults.IdleTimeout, "How long an idle keep-alive connection is kept open (e.g., 2m)")
+	}
+	for _, cmd := range []*cobra.Command{streamableHTTPCmd, httpCmdAlias} {
+		cmd.Flags().Duration("session-idle-ttl", 0, "How long the state of a session without requests is kept (e.g., 1h). 0 to keep it until the session ends")
+		cmd.Flags().Int("event-replay-buffer", client.DefaultEventReplayBuffer, "Events kept per session for clients that resume a dropped event stream with Last-Event-ID. 0 to disable")
+	}
+
 	rootCmd.AddCommand(stdioCmd)
 	rootCmd.AddCommand(streamableHTTPCmd)
+	rootCmd.AddCommand(sseCmd)
 	rootCmd.AddCommand(httpCmdAlias) // Add the alias for backward compatibility
 }
 
@@ -193,6 +296,121 @@ func getLogLevel(cmd *cobra.Command) log.Level {
 	return log.InfoLevel
 }
 
+// getRetryConfig returns the retry and timeout settings of the API clients. Environment variables
+// take precedence over the command line flags.
+func getRetryConfig(cmd *cobra.Command) client.RetryConfig {
+	config := client.LoadRetryConfigFromEnv()
+	if cmd == nil {
+		return config
+	}
+	flags := cmd.PersistentFlags()
+	if strings.TrimSpace(os.Getenv(client.HTTPRetryMaxEnv)) == "" && flags.Changed("http-retry-max") {
+		if value, err := flags.GetInt("http-retry-max"); err == nil && value >= 0 {
+			config.RetryMax = value
+		}
+	}
+	if strings.TrimSpace(os.Getenv(client.HTTPTimeoutEnv)) == "" && flags.Changed("http-timeout") {
+		if value, err := flags.GetDuration("http-timeout"); err == nil && value > 0 {
+			config.Timeout = value
+		}
+	}
+	if strings.TrimSpace(os.Getenv(client.HTTPBackoffMaxEnv)) == "" && flags.Changed("http-backoff-max") {
+		if value, err := flags.GetDuration("http-backoff-max"); err == nil && value >= 0 {
+			config.BackoffMax = value
+		}
+	}
+	if strings.TrimSpace(os.Getenv(client.HTTPDownloadTimeoutEnv)) == "" && flags.Changed("http-download-timeout") {
+		if value, err := flags.GetDuration("http-download-timeout"); err == nil && value > 0 {
+			config.DownloadTimeout = value
+		}
+	}
+	return config
+}
+
+// getMaxResponseBytes returns the response budget given by the --max-response-bytes flag, or -1 to
+// use MCP_MAX_RESPONSE_BYTES, which takes precedence, or the default
+func getMaxResponseBytes(cmd *cobra.Command) int {
+	if cmd == nil || strings.TrimSpace(os.Getenv(tools.MaxResponseBytesEnv)) != "" || !cmd.PersistentFlags().Changed("max-response-bytes") {
+		return -1
+	}
+	value, err := cmd.PersistentFlags().GetInt("max-response-bytes")
+	if err != nil || value < 0 {
+		return -1
+	}
+	return value
+}
+
+// getHTTPServerConfig returns the connection settings of the HTTP transports from the environment
+// variables, which take precedence, or the flags of the transport command
+func getHTTPServerConfig(cmd *cobra.Command) client.HTTPServerConfig {
+	config := client.LoadHTTPServerConfigFromEnv()
+	if cmd == nil {
+		return config
+	}
+	flags := cmd.Flags()
+	durations := []struct {
+		env   string
+		flag  string
+		value *time.Duration
+	}{
+		{client.ServerReadTimeoutEnv, "read-timeout", &config.ReadTimeout},
+		{client.ServerWriteTimeoutEnv, "write-timeout", &config.WriteTimeout},
+		{client.ServerIdleTimeoutEnv, "idle-timeout", &config.IdleTimeout},
+		{client.SessionIdleTTLEnv, "session-idle-ttl", &config.SessionIdleTTL},
+	}
+	for _, setting := range durations {
+		if strings.TrimSpace(os.Getenv(setting.env)) == "" && flags.Changed(setting.flag) {
+			if value, err := flags.GetDuration(setting.flag); err == nil && value >= 0 {
+				*setting.value = value
+			}
+		}
+	}
+	if strings.TrimSpace(os.Getenv(client.EventReplayBufferEnv)) == "" && flags.Changed("event-replay-buffer") {
+		if value, err := flags.GetInt("event-replay-buffer"); err == nil && value >= 0 {
+			config.EventReplayBuffer = value
+		}
+	}
+	return config
+}
+
+// getUnsafeFullState returns whether the --unsafe-full-state flag is set. MCP_UNSAFE_FULL_STATE is read by the tools
+func getUnsafeFullState(cmd *cobra.Command) bool {
+	if cmd == nil {
+		return false
+	}
+	unsafe, err := cmd.PersistentFlags().GetBool("unsafe-full-state")
+	return err == nil && unsafe
+}
+
+// getWorkspaceBlueprintsFile returns the --workspace-blueprints-file flag. MCP_WORKSPACE_BLUEPRINTS_FILE is read by the tool
+func getWorkspaceBlueprintsFile(cmd *cobra.Command) string {
+	if cmd == nil {
+		return ""
+	}
+	path, err := cmd.PersistentFlags().GetString("workspace-blueprints-file")
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(path)
+}
+
+// getLocalExecution returns the --enable-local-execution and --terraform-provider-mirror flags.
+// ENABLE_TF_LOCAL_EXECUTION and MCP_TERRAFORM_PROVIDER_MIRROR are read by the tool
+func getLocalExecution(cmd *cobra.Command) (bool, string) {
+	if cmd == nil {
+		return false, ""
+	}
+	enabled, err := cmd.PersistentFlags().GetBool("enable-local-execution")
+	if err != nil {
+		enabled = false
+	}
+	mirror, err := cmd.PersistentFlags().GetString("terraform-provider-mirror")
+	if err != nil {
+		mirror = ""
+	}
+	return enabled, strings.TrimSpace(mirror)
+}
+
 // getLogFormat determines the log format from environment variable or CLI flag
 func getLogFormat(cmd *cobra.Command) string {
 	// Check environment variable first
@@ -232,25 +450,28 @@ func initLogger(outPath string, level log.Level, format string) (*log.Logger, er
 		})
 	}
 
-	if outPath == "" {
-		return logger, nil
-	}
-
-	file, err := os.OpenFile(outPath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o666)
-	if err != nil {
-		return nil, fmt.Errorf("failed to open log file: %w", err)
+	if outPath != "" {
+		file, err := os.OpenFile(outPath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o666)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open log file: %w", err)
+		}
+		logger.SetOutput(file)
 	}
 
-	logger.SetOutput(file)
+	// Packages that log through the logrus standard logger use the same level, format and output
+	log.SetLevel(logger.GetLevel())
+	log.SetFormatter(logger.Formatter)
+	log.SetOutput(logger.Out)
 
 	return logger, nil
 }
 
-// registerToolsAndResources registers tools and resources with the MCP server
-func registerToolsAndResources(hcServer *server.MCPServer, logger *log.Logger, enabledToolsets []string) {
-	tools.RegisterTools(hcServer, logger, enabledToolsets)
+// registerToolsAndResources registers tools, resources and prompts with the MCP server
+func registerToolsAndResources(hcServer *server.MCPServer, logger *log.Logger, enabledToolsets []string, transport tools.Transport) {
+	tools.RegisterTools(hcServer, logger, enabledToolsets, transport)
 	resources.RegisterResources(hcServer, logger)
 	resources.RegisterResourceTemplates(hcServer, logger)
+	prompts.RegisterPrompts(hcServer, logger)
 }
 
 func serverInit(ctx context.Context, hcServer *server.MCPServer, logger *log.Logger) error {
@@ -294,7 +515,7 @@ func setupInstana(logger *log.Logger) instana.TracerLogger {
 	})
 }
 
-func streamableHTTPServerInit(ctx context.Context, hcServer *server.MCPServer, logger *log.Logger, host string, port string, endpointPath string, heartbeatInterval time.Duration, organizationAllowlist []string) error {
+func streamableHTTPServerInit(ctx context.Context, hcServer *server.MCPServer, logger *log.Logger, host string, port string, endpointPath string, heartbeatInterval time.Duration, serverConfig client.HTTPServerConfig, organizationAllowlist []string) error {
 	// Ensure endpoint path starts with /
 	endpointPath = path.Join("/", endpointPath)
 	var handler http.Handler
@@ -321,19 +542,150 @@ func streamableHTTPServerInit(ctx context.Context, hcServer *server.MCPServer, l
 	// Log the endpoint path being used
 	logger.Infof("Using endpoint path: %s", endpointPath)
 
-	// Check if stateless mode is enabled
+	// Check if stateless mode is enabled. Stateless servers issue no Mcp-Session-Id, keep no state
+	// between requests and can run behind a load balancer without sticky sessions: the TFE tools are
+	// registered up front and every request is authenticated with its own token. There is no session
+	// to push server-initiated messages to, so the GET event stream is disabled.
 	isStateless := shouldUseStatelessMode()
 	opts = append(opts, server.WithStateLess(isStateless))
 	logger.Infof("Running with stateless mode: %v", isStateless)
+	sessionMode := "stateful"
+	if isStateless {
+		sessionMode = "stateless"
+		opts = append(opts, server.WithDisableStreaming(true))
+		if registry := tools.GetDynamicToolRegistry(); registry != nil {
+			registry.EnableStatelessMode()
+		}
+	}
+
+	// Share caches and sessions between server instances when a shared store is configured
+	store, err := client.NewStoreFromEnv(logger)
+	if err != nil {
+		return fmt.Errorf("store configuration error: %w", err)
+	}
+	if store != nil {
+		client.SetSharedStore(store)
+		if !isStateless {
+			opts = append(opts, server.WithSessionIdManager(client.NewStoreSessionIdManager(store, logger)))
+		}
+	}
 
 	// Configure heartbeat interval if enabled
-	if heartbeatInterval > 0 {
+	// Drop the state of sessions whose clients went away without ending them
+	if serverConfig.SessionIdleTTL > 0 && !isStateless {
+		opts = append(opts, server.WithSessionIdleTTL(serverConfig.SessionIdleTTL))
+		logger.Infof("Idle sessions expire after %v", serverConfig.SessionIdleTTL)
+	}
+
+	if heartbeatInterval > 0 && isStateless {
+		logger.Warnf("Ignoring the HTTP heartbeat interval in stateless mode, which has no event streams to keep alive")
+	} else if heartbeatInterval > 0 {
 		opts = append(opts, server.WithHeartbeatInterval(heartbeatInterval))
 		logger.Infof("HTTP heartbeat enabled with interval: %v", heartbeatInterval)
 	}
 
 	baseStreamableServer := server.NewStreamableHTTPServer(hcServer, opts...)
 
+	mux := http.NewServeMux()
+
+	// Apply middleware
+	corsConfig := loadCORSConfig(logger)
+	var streamableHandler http.Handler = baseStreamableServer
+	if serverConfig.EventReplayBuffer > 0 && !isStateless {
+		// Clients resume a dropped event stream with the Last-Event-ID header
+		streamableHandler = client.NewEventReplay(serverConfig.EventReplayBuffer, serverConfig.SessionIdleTTL, logger).Middleware(streamableHandler)
+		logger.Infof("Event stream resumption enabled, keeping %d events per session", serverConfig.EventReplayBuffer)
+	}
+	streamableServer := withHTTPMiddleware(streamableHandler, corsConfig, organizationAllowlist, logger)
+
+	// Handle the /mcp endpoint with the streamable server (with security wrapper)
+	mux.Handle(endpointPath, streamableServer)
+	mux.Handle(endpointPath+"/", streamableServer)
+
+	handleRootAndHealth(mux, "streamable-http", endpointPath, sessionMode, logger)
+	handlePrometheusMetrics(mux, logger)
+
+	addr := fmt.Sprintf("%s:%s", host, port)
+	handler = instrumentHandler(mux, endpointPath, instanaCollector)
+
+	httpServer := newTransportServer(addr, handler, serverConfig)
+
+	return serveHTTP(ctx, httpServer, "StreamableHTTP", host, endpointPath, tlsConfig, logger)
+}
+
+// sseServerInit starts the legacy HTTP+SSE transport, for MCP clients that don't support
+// streamable HTTP yet. Clients open an event stream at sseEndpoint and post their messages
+// to the message endpoint announced on that stream.
+func sseServerInit(ctx context.Context, hcServer *server.MCPServer, logger *log.Logger, host string, port string, sseEndpoint string, messageEndpoint string, baseURL string, keepAliveInterval time.Duration, serverConfig client.HTTPServerConfig, organizationAllowlist []string) error {
+	sseEndpoint = path.Join("/", sseEndpoint)
+	messageEndpoint = path.Join("/", messageEndpoint)
+	if sseEndpoint == messageEndpoint {
+		return fmt.Errorf("SSE endpoint and message endpoint must be different paths, both are %s", sseEndpoint)
+	}
+
+	instanaCollector := setupInstana(logger)
+
+	tlsConfig, err := client.GetTLSConfigFromEnv()
+	if err != nil {
+		return fmt.Errorf("TLS configuration error: %w", err)
+	}
+
+	// The message endpoint is announced to clients as an absolute URL when a base URL is set,
+	// e.g. when the server runs behind a reverse proxy, and as a path relative to the host otherwise
+	opts := []server.SSEOption{
+		server.WithSSEEndpoint(sseEndpoint),
+		server.WithMessageEndpoint(messageEndpoint),
+		server.WithUseFullURLForMessageEndpoint(baseURL != ""),
+	}
+	if baseURL != "" {
+		opts = append(opts, server.WithBaseURL(strings.TrimSuffix(baseURL, "/")))
+	}
+	if keepAliveInterval > 0 {
+		opts = append(opts, server.WithKeepAliveInterval(keepAliveInterval))
+		logger.Infof("SSE keep-alive enabled with interval: %v", keepAliveInterval)
+	}
+	logger.Infof("Using SSE endpoint: %s, message endpoint: %s", sseEndpoint, messageEndpoint)
+
+	// Sessions of the SSE transport live in the event stream of one instance, so only the
+	// caches are shared when a shared store is configured
+	store, err := client.NewStoreFromEnv(logger)
+	if err != nil {
+		return fmt.Errorf("store configuration error: %w", err)
+	}
+	if store != nil {
+		client.SetSharedStore(store)
+	}
+
+	sseServer := server.NewSSEServer(hcServer, opts...)
+
+	corsConfig := loadCORSConfig(logger)
+	mux := http.NewServeMux()
+	mux.Handle(sseEndpoint, withHTTPMiddleware(sseServer.SSEHandler(), corsConfig, organizationAllowlist, logger))
+	mux.Handle(messageEndpoint, withHTTPMiddleware(sseServer.MessageHandler(), corsConfig, organizationAllowlist, logger))
+
+	handleRootAndHealth(mux, "sse", sseEndpoint, "", logger)
+	handlePrometheusMetrics(mux, logger)
+
+	httpServer := newTransportServer(fmt.Sprintf("%s:%s", host, port), instrumentHandler(mux, sseEndpoint, instanaCollector), serverConfig)
+
+	return serveHTTP(ctx, httpServer, "SSE", host, sseEndpoint, tlsConfig, logger)
+}
+
+// newTransportServer creates the HTTP server of a transport. The write timeout is disabled by default,
+// as event streams stay open for the lifetime of the sessions and the waits of long plans and applies.
+func newTransportServer(addr string, handler http.Handler, serverConfig client.HTTPServerConfig) *http.Server {
+	return &http.Server{
+		Addr:              addr,
+		Handler:           handler,
+		ReadTimeout:       serverConfig.ReadTimeout,
+		ReadHeaderTimeout: 30 * time.Second,
+		WriteTimeout:      serverConfig.WriteTimeout,
+		IdleTimeout:       serverConfig.IdleTimeout,
+	}
+}
+
+// loadCORSConfig loads and logs the CORS configuration
+func loadCORSConfig(logger *log.Logger) client.CORSConfig {
 	// Load CORS configuration
 	corsConfig := client.LoadCORSConfigFromEnv()
 
@@ -348,18 +700,23 @@ func streamableHTTPServerInit(ctx context.Context, hcServer *server.MCPServer, l
 	} else if corsConfig.Mode == "disabled" {
 		logger.Warnf("CORS validation is disabled. This is not recommended for production.")
 	}
+	return corsConfig
+}
 
-	mux := http.NewServeMux()
-
-	// Apply middleware
-	streamableServer := client.OrganizationAllowlistMiddleware(organizationAllowlist, logger)(baseStreamableServer)
-	streamableServer = client.TerraformContextMiddleware(logger)(streamableServer)
-	streamableServer = client.NewSecurityHandler(streamableServer, corsConfig.AllowedOrigins, corsConfig.Mode, logger)
-
-	// Handle the /mcp endpoint with the streamable server (with security wrapper)
-	mux.Handle(endpointPath, streamableServer)
-	mux.Handle(endpointPath+"/", streamableServer)
+// withHTTPMiddleware wraps an MCP transport handler with the organization allowlist, the
+// Terraform context and the CORS security checks
+func withHTTPMiddleware(handler http.Handler, corsConfig client.CORSConfig, organizationAllowlist []string, logger *log.Logger) http.Handler {
+	if len(organizationAllowlist) > 0 {
+		// Tokens stored for a session would bypass the allowlist checks of the requests
+		client.DisableSessionCredentials()
+	}
+	handler = client.OrganizationAllowlistMiddleware(organizationAllowlist, logger)(handler)
+	handler = client.TerraformContextMiddleware(logger)(handler)
+	return client.NewSecurityHandler(handler, corsConfig.AllowedOrigins, corsConfig.Mode, logger)
+}
 
+// handleRootAndHealth adds the optional root redirect and the health check endpoint
+func handleRootAndHealth(mux *http.ServeMux, transport string, endpointPath string, sessionMode string, logger *log.Logger) {
 	if redirectURL := os.Getenv("MCP_REDIRECT_ROOT_URL"); redirectURL != "" {
 		logger.Infof("Requests to `/` will be redirected to %s", redirectURL)
 		// handle root direct if it's configured
@@ -371,11 +728,12 @@ func streamableHTTPServerInit(ctx context.Context, hcServer *server.MCPServer, l
 	// Add health check endpoint
 	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
 		response, err := json.Marshal(healthResponse{
-			Status:    "ok",
-			Service:   "terraform-mcp-server",
-			Transport: "streamable-http",
-			Endpoint:  endpointPath,
-			Version:   version.GetHumanVersion(),
+			Status:      "ok",
+			Service:     "terraform-mcp-server",
+			Transport:   transport,
+			Endpoint:    endpointPath,
+			SessionMode: sessionMode,
+			Version:     version.GetHumanVersion(),
 		})
 		if err != nil {
 			logger.Errorf("Failed to marshal health response: %v", err)
@@ -385,9 +743,19 @@ func streamableHTTPServerInit(ctx context.Context, hcServer *server.MCPServer, l
 		w.Header().Set("Content-Type", "application/json")
 		w.Write(response)
 	})
+}
 
-	addr := fmt.Sprintf("%s:%s", host, port)
-	handler = mux
+// handlePrometheusMetrics adds the Prometheus metrics endpoint when it is enabled
+func handlePrometheusMetrics(mux *http.ServeMux, logger *log.Logger) {
+	if os.Getenv(client.PrometheusMetricsEnv) != "true" {
+		return
+	}
+	logger.Infof("Prometheus metrics enabled at %s", client.PrometheusMetricsPath)
+	mux.Handle(client.PrometheusMetricsPath, client.PrometheusMetricsHandler())
+}
+
+// instrumentHandler adds the OpenTelemetry and Instana instrumentation when they are enabled
+func instrumentHandler(handler http.Handler, endpointPath string, instanaCollector instana.TracerLogger) http.Handler {
 	if enableOtelMetrics := os.Getenv("OTEL_METRICS_ENABLED"); enableOtelMetrics == "true" {
 		// Add http server instrumentation for standard server metrics
 		handler = otelhttp.NewHandler(handler, "terraform-mcp-server")
@@ -396,16 +764,11 @@ func streamableHTTPServerInit(ctx context.Context, hcServer *server.MCPServer, l
 		// Wrapping the handler so incoming HTTP requests will be able to be traced by Instana
 		handler = instana.TracingHandlerFunc(instanaCollector, endpointPath, handler.ServeHTTP)
 	}
+	return handler
+}
 
-	httpServer := &http.Server{
-		Addr:              addr,
-		Handler:           handler,
-		ReadTimeout:       30 * time.Second,
-		ReadHeaderTimeout: 30 * time.Second,
-		WriteTimeout:      30 * time.Second,
-		IdleTimeout:       60 * time.Second,
-	}
-
+// serveHTTP runs the HTTP server until the context is done, requiring TLS for non-localhost hosts
+func serveHTTP(ctx context.Context, httpServer *http.Server, name string, host string, endpointPath string, tlsConfig *client.TLSConfig, logger *log.Logger) error {
 	if tlsConfig != nil {
 		httpServer.TLSConfig = tlsConfig.Config
 		logger.Infof("TLS enabled with certificate: %s", tlsConfig.CertFile)
@@ -413,13 +776,13 @@ func streamableHTTPServerInit(ctx context.Context, hcServer *server.MCPServer, l
 		if !client.IsLocalHost(host) {
 			return fmt.Errorf("TLS is required for non-localhost binding (%s). Set MCP_TLS_CERT_FILE and MCP_TLS_KEY_FILE environment variables", host)
 		}
-		logger.Warnf("TLS is disabled on StreamableHTTP server; this is not recommended for production")
+		logger.Warnf("TLS is disabled on %s server; this is not recommended for production", name)
 	}
 
 	// Start server in goroutine
 	errC := make(chan error, 1)
 	go func() {
-		logger.Infof("Starting StreamableHTTP server on %s%s", addr, endpointPath)
+		logger.Infof("Starting %s server on %s%s", name, httpServer.Addr, endpointPath)
 		if tlsConfig != nil {
 			errC <- httpServer.ListenAndServeTLS(tlsConfig.CertFile, tlsConfig.KeyFile)
 		} else {
@@ -430,13 +793,13 @@ func streamableHTTPServerInit(ctx context.Context, hcServer *server.MCPServer, l
 	// Wait for shutdown signal
 	select {
 	case <-ctx.Done():
-		logger.Infof("Shutting down StreamableHTTP server...")
+		logger.Infof("Shutting down %s server...", name)
 		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 		defer cancel()
 		return httpServer.Shutdown(shutdownCtx)
 	case err := <-errC:
 		if err != nil && err != http.ErrServerClosed {
-			return fmt.Errorf("StreamableHTTP server error: %w", err)
+			return fmt.Errorf("%s server error: %w", name, err)
 		}
 	}
 
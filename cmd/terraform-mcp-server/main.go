@@ -16,6 +16,9 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/tools"
+	registryTools "github.com/hashicorp/terraform-mcp-server/pkg/tools/registry"
+	tfeTools "github.com/hashicorp/terraform-mcp-server/pkg/tools/tfe"
 	"github.com/hashicorp/terraform-mcp-server/pkg/toolsets"
 	"github.com/hashicorp/terraform-mcp-server/version"
 	"go.opentelemetry.io/otel"
@@ -36,17 +39,33 @@ import (
 var instructions string
 var sessionClientInfo sync.Map // map[string]client.ClientInfo
 
-func runHTTPServer(logger *log.Logger, host string, port string, endpointPath string, heartbeatInterval time.Duration, enabledToolsets []string, metricsConfig client.MetricsConfig, organizationAllowlist []string) error {
+func runHTTPServer(logger *log.Logger, host string, port string, endpointPath string, heartbeatInterval time.Duration, serverConfig client.HTTPServerConfig, enabledToolsets []string, metricsConfig client.MetricsConfig, organizationAllowlist []string) error {
 	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer stop()
 
+	hcServer := newHTTPServer(logger, enabledToolsets, metricsConfig, tools.TransportStreamableHTTP)
+	tfeTools.StartRunTriage(ctx, logger)
+	return streamableHTTPServerInit(ctx, hcServer, logger, host, port, endpointPath, heartbeatInterval, serverConfig, organizationAllowlist)
+}
+
+func runSSEServer(logger *log.Logger, host string, port string, sseEndpoint string, messageEndpoint string, baseURL string, keepAliveInterval time.Duration, serverConfig client.HTTPServerConfig, enabledToolsets []string, metricsConfig client.MetricsConfig, organizationAllowlist []string) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	hcServer := newHTTPServer(logger, enabledToolsets, metricsConfig, tools.TransportSSE)
+	tfeTools.StartRunTriage(ctx, logger)
+	return sseServerInit(ctx, hcServer, logger, host, port, sseEndpoint, messageEndpoint, baseURL, keepAliveInterval, serverConfig, organizationAllowlist)
+}
+
+// newHTTPServer creates the MCP server for the HTTP transports, with the session and metrics hooks
+func newHTTPServer(logger *log.Logger, enabledToolsets []string, metricsConfig client.MetricsConfig, transport tools.Transport) *server.MCPServer {
 	// Create hooks for session management
 	hooks := &server.Hooks{}
 	hooks.AddOnRegisterSession(func(ctx context.Context, session server.ClientSession) {
 		client.NewSessionHandler(ctx, session, logger)
 	})
 	hcServer, rateLimiter := NewServer(version.Version, logger, enabledToolsets, server.WithHooks(hooks))
-	registerToolsAndResources(hcServer, logger, enabledToolsets)
+	registerToolsAndResources(hcServer, logger, enabledToolsets, transport)
 
 	hooks.AddOnUnregisterSession(func(ctx context.Context, session server.ClientSession) {
 		// Clean up client info populated in the metrics hooks, for the session
@@ -71,8 +90,36 @@ func runHTTPServer(logger *log.Logger, host string, port string, endpointPath st
 		}
 	})
 	attachMetricsHooks(hooks, metricsConfig, logger)
+	attachPrometheusHooks(hooks)
+
+	return hcServer
+}
+
+// attachPrometheusHooks records the status and duration of tool calls for the Prometheus metrics endpoint
+func attachPrometheusHooks(hooks *server.Hooks) {
+	var toolStartTimes sync.Map
+	hooks.AddBeforeCallTool(func(ctx context.Context, id any, message *mcp.CallToolRequest) {
+		toolStartTimes.Store(toolCallKey(ctx, id), time.Now())
+	})
+	hooks.AddAfterCallTool(func(ctx context.Context, id any, message *mcp.CallToolRequest, result any) {
+		// A call whose start time is unknown is still counted, without a duration
+		var startTime time.Time
+		if storedStart, ok := toolStartTimes.LoadAndDelete(toolCallKey(ctx, id)); ok {
+			startTime, _ = storedStart.(time.Time)
+		}
+		res, _ := result.(*mcp.CallToolResult)
+		client.RecordPrometheusToolCall(message.Params.Name, startTime, res != nil && res.IsError)
+	})
+}
 
-	return streamableHTTPServerInit(ctx, hcServer, logger, host, port, endpointPath, heartbeatInterval, organizationAllowlist)
+// toolCallKey identifies a tool call by its session and request ID, as JSON-RPC request IDs are only
+// unique within a session
+func toolCallKey(ctx context.Context, id any) string {
+	sessionID := ""
+	if session := server.ClientSessionFromContext(ctx); session != nil {
+		sessionID = session.SessionID()
+	}
+	return fmt.Sprintf("%s/%v", sessionID, id)
 }
 
 func attachMetricsHooks(hooks *server.Hooks, metricsConfig client.MetricsConfig, logger *log.Logger) {
@@ -92,8 +139,11 @@ func attachMetricsHooks(hooks *server.Hooks, metricsConfig client.MetricsConfig,
 				Title:       message.Params.ClientInfo.Title,
 				Description: message.Params.ClientInfo.Description,
 			}
-			// Record the client info in the session first so we can reuse it in the BeforeToolCall hook
-			sessionClientInfo.Store(session.SessionID(), ci)
+			// Record the client info in the session first so we can reuse it in the BeforeToolCall hook.
+			// Stateless requests share the empty session ID, their client info can't be told apart.
+			if session.SessionID() != "" {
+				sessionClientInfo.Store(session.SessionID(), ci)
+			}
 			// Record the metric
 			client.RecordClientType(ctx, ci, metricsConfig, logger)
 		}
@@ -101,7 +151,7 @@ func attachMetricsHooks(hooks *server.Hooks, metricsConfig client.MetricsConfig,
 
 	var toolStartTimes sync.Map
 	hooks.AddBeforeCallTool(func(ctx context.Context, id any, message *mcp.CallToolRequest) {
-		toolStartTimes.Store(fmt.Sprintf("%v", id), time.Now())
+		toolStartTimes.Store(toolCallKey(ctx, id), time.Now())
 		session := server.ClientSessionFromContext(ctx)
 		if session == nil {
 			logger.Debug("AddBeforeCallTool hook: No session found in context")
@@ -127,7 +177,7 @@ func attachMetricsHooks(hooks *server.Hooks, metricsConfig client.MetricsConfig,
 	})
 	hooks.AddAfterCallTool(func(ctx context.Context, id any, message *mcp.CallToolRequest, result any) {
 		startTime := time.Now()
-		if storedStart, ok := toolStartTimes.LoadAndDelete(fmt.Sprintf("%v", id)); ok {
+		if storedStart, ok := toolStartTimes.LoadAndDelete(toolCallKey(ctx, id)); ok {
 			if ts, ok := storedStart.(time.Time); ok {
 				startTime = ts
 			}
@@ -151,7 +201,7 @@ func runStdioServer(logger *log.Logger, enabledToolsets []string) error {
 		client.NewSessionHandler(ctx, session, logger)
 	})
 	hcServer, rateLimiter := NewServer(version.Version, logger, enabledToolsets, server.WithHooks(hooks))
-	registerToolsAndResources(hcServer, logger, enabledToolsets)
+	registerToolsAndResources(hcServer, logger, enabledToolsets, tools.TransportStdio)
 
 	hooks.AddOnUnregisterSession(func(ctx context.Context, session server.ClientSession) {
 		client.EndSessionHandler(ctx, session, rateLimiter, logger)
@@ -164,13 +214,17 @@ func NewServer(version string, logger *log.Logger, enabledToolsets []string, opt
 	// Create rate limiting middleware with environment-based configuration
 	rateLimitConfig := client.LoadRateLimitConfigFromEnv()
 	rateLimitMiddleware := client.NewRateLimitMiddleware(rateLimitConfig, logger)
+	client.LogProxySettings(logger)
 
 	// Add default options
 	defaultOpts := []server.ServerOption{
 		server.WithToolCapabilities(true),
 		server.WithResourceCapabilities(true, true),
+		server.WithPromptCapabilities(true),
 		server.WithInstructions(instructions),
 		server.WithToolHandlerMiddleware(rateLimitMiddleware.Middleware()),
+		server.WithToolHandlerMiddleware(client.ThrottleKeepaliveMiddleware(logger)),
+		server.WithToolHandlerMiddleware(client.ResultMetadataMiddleware(logger)),
 		server.WithElicitation(),
 	}
 	opts = append(defaultOpts, opts...)
@@ -270,6 +324,7 @@ func runDefaultCommand(cmd *cobra.Command, _ []string) {
 
 	// Get toolsets from the command that was passed in
 	enabledToolsets := getToolsetsFromCmd(cmd, logger)
+	registryTools.SetLocalExecution(getLocalExecution(cmd))
 
 	if err := runStdioServer(logger, enabledToolsets); err != nil {
 		stdlog.Fatal("failed to run stdio server:", err)
@@ -284,6 +339,23 @@ func main() {
 	if err != nil {
 		stdlog.Fatal("Failed to initialize logger:", err)
 	}
+	if shouldUseSSEMode() {
+		logger.Info("Starting in SSE mode based on environment configuration")
+
+		metricsConfig, shutdownMetrics := setupMetrics(logger)
+		defer shutdownMetrics()
+
+		enabledToolsets := getToolsetsFromCmd(rootCmd, logger)
+		organizationAllowlist, err := getOrganizationAllowlist(rootCmd)
+		if err != nil {
+			stdlog.Fatal(err)
+		}
+		if err := runSSEServer(logger, getHTTPHost(), getHTTPPort(), getSSEEndpoint(nil), getMessageEndpoint(nil), getSSEBaseURL(nil), getHeartbeatInterval(), getHTTPServerConfig(nil), enabledToolsets, metricsConfig, organizationAllowlist); err != nil {
+			stdlog.Fatal("failed to run SSE server:", err)
+		}
+		return
+	}
+
 	if shouldUseStreamableHTTPMode() {
 		logger.Info("Starting in Streamable HTTP mode based on environment configuration")
 
@@ -299,7 +371,7 @@ func main() {
 		if err != nil {
 			stdlog.Fatal(err)
 		}
-		if err := runHTTPServer(logger, host, port, endpointPath, heartbeatInterval, enabledToolsets, metricsConfig, organizationAllowlist); err != nil {
+		if err := runHTTPServer(logger, host, port, endpointPath, heartbeatInterval, getHTTPServerConfig(nil), enabledToolsets, metricsConfig, organizationAllowlist); err != nil {
 			stdlog.Fatal("failed to run StreamableHTTP server:", err)
 		}
 		return
@@ -312,9 +384,17 @@ func main() {
 	}
 }
 
+// shouldUseSSEMode checks if environment variables select the SSE transport
+func shouldUseSSEMode() bool {
+	return os.Getenv("TRANSPORT_MODE") == "sse"
+}
+
 // shouldUseStreamableHTTPMode checks if environment variables indicate HTTP mode
 func shouldUseStreamableHTTPMode() bool {
 	transportMode := os.Getenv("TRANSPORT_MODE")
+	if transportMode == "sse" {
+		return false
+	}
 	return transportMode == "http" || transportMode == "streamable-http" ||
 		os.Getenv("TRANSPORT_PORT") != "" ||
 		os.Getenv("TRANSPORT_HOST") != "" ||
@@ -367,6 +447,33 @@ func getEndpointPath(cmd *cobra.Command) string {
 	return "/mcp"
 }
 
+// getSSEEndpoint returns the path of the SSE event stream from the env var, flag or default
+func getSSEEndpoint(cmd *cobra.Command) string {
+	return getStringSetting(cmd, "MCP_SSE_ENDPOINT", "sse-endpoint", "/sse")
+}
+
+// getMessageEndpoint returns the path SSE clients post their messages to from the env var, flag or default
+func getMessageEndpoint(cmd *cobra.Command) string {
+	return getStringSetting(cmd, "MCP_MESSAGE_ENDPOINT", "message-endpoint", "/message")
+}
+
+// getSSEBaseURL returns the public base URL of the SSE server from the env var or flag, if any
+func getSSEBaseURL(cmd *cobra.Command) string {
+	return getStringSetting(cmd, "MCP_SSE_BASE_URL", "base-url", "")
+}
+
+func getStringSetting(cmd *cobra.Command, envVar string, flag string, defaultValue string) string {
+	if value := os.Getenv(envVar); value != "" {
+		return value
+	}
+	if cmd != nil {
+		if value, err := cmd.Flags().GetString(flag); err == nil && value != "" {
+			return value
+		}
+	}
+	return defaultValue
+}
+
 // getHeartbeatInterval returns the heartbeat interval duration from the env var or default
 func getHeartbeatInterval() time.Duration {
 	if val := os.Getenv("MCP_HEARTBEAT_INTERVAL"); val != "" {
@@ -6,6 +6,8 @@ package main
 import (
 	"context"
 	"io"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"testing"
 	"time"
@@ -274,3 +276,45 @@ func TestNewServerWithHooksOptionPassesThrough(t *testing.T) {
 
 	require.GreaterOrEqual(t, len(hooks.OnBeforeCallTool), 1)
 }
+
+// metricsSession is a client session of the tool call hook tests
+type metricsSession struct{ id string }
+
+func (s metricsSession) Initialize()                                         {}
+func (s metricsSession) Initialized() bool                                   { return true }
+func (s metricsSession) NotificationChannel() chan<- mcp.JSONRPCNotification { return nil }
+func (s metricsSession) SessionID() string                                   { return s.id }
+
+func TestAttachPrometheusHooksSeparatesSessions(t *testing.T) {
+	hooks := &mcpserver.Hooks{}
+	attachPrometheusHooks(hooks)
+	hcServer := mcpserver.NewMCPServer("test", "test")
+
+	request := &mcp.CallToolRequest{}
+	request.Params.Name = "prometheus_sessions_test"
+	first := hcServer.WithContext(context.Background(), metricsSession{id: "session-1"})
+	second := hcServer.WithContext(context.Background(), metricsSession{id: "session-2"})
+
+	// Both sessions use the request ID 1, and their calls overlap
+	for _, ctx := range []context.Context{first, second} {
+		for _, hook := range hooks.OnBeforeCallTool {
+			hook(ctx, 1, request)
+		}
+	}
+	for _, ctx := range []context.Context{first, second} {
+		for _, hook := range hooks.OnAfterCallTool {
+			hook(ctx, 1, request, mcp.NewToolResultText("ok"))
+		}
+	}
+	// A call whose start time is unknown is still counted
+	for _, hook := range hooks.OnAfterCallTool {
+		hook(hcServer.WithContext(context.Background(), metricsSession{id: "session-3"}), 1, request, mcp.NewToolResultText("ok"))
+	}
+
+	recorder := httptest.NewRecorder()
+	client.PrometheusMetricsHandler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, client.PrometheusMetricsPath, nil))
+	require.Equal(t, http.StatusOK, recorder.Code)
+	metrics := recorder.Body.String()
+	assert.Contains(t, metrics, `terraform_mcp_tool_calls_total{status="success",tool="prometheus_sessions_test"} 3`)
+	assert.Contains(t, metrics, `terraform_mcp_tool_call_duration_seconds_count{tool="prometheus_sessions_test"} 2`)
+}
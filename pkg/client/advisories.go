@@ -0,0 +1,338 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// AdvisoryDBEnv is the path of a local advisory database, which can be updated offline
+	AdvisoryDBEnv = "MCP_ADVISORY_DB"
+	// AdvisoryOSVURLEnv is the OSV API queried for provider advisories, or "off" to disable it
+	AdvisoryOSVURLEnv = "MCP_ADVISORY_OSV_URL"
+
+	defaultOSVURL = "https://api.osv.dev"
+
+	// advisoryCacheKeyPrefix namespaces OSV responses in the registry cache
+	advisoryCacheKeyPrefix = "advisories:"
+)
+
+// Kinds of packages advisories apply to
+const (
+	AdvisoryKindProvider = "provider"
+	AdvisoryKindModule   = "module"
+)
+
+// ErrNoAdvisorySource is returned when no advisory source covers a package, e.g. modules without a local database
+var ErrNoAdvisorySource = errors.New("no advisory source configured")
+
+// Advisory is a known vulnerability or security advisory affecting a provider or module version
+type Advisory struct {
+	ID       string   `json:"id"`
+	Aliases  []string `json:"aliases,omitempty"`
+	Summary  string   `json:"summary"`
+	Severity string   `json:"severity,omitempty"`
+	URL      string   `json:"url,omitempty"`
+	Source   string   `json:"source"`
+}
+
+// AdvisoryAffected is a provider or module and the versions an advisory of the local database affects
+type AdvisoryAffected struct {
+	// Kind is "provider" or "module"
+	Kind string `json:"kind"`
+	// Name is the provider address (namespace/name) or module ID (namespace/name/provider)
+	Name string `json:"name"`
+	// Versions is a version constraint, e.g. ">= 1.0.0, < 1.4.2"
+	Versions string `json:"versions"`
+}
+
+// AdvisoryDBEntry is an advisory of the local database
+type AdvisoryDBEntry struct {
+	Advisory
+	Affected []AdvisoryAffected `json:"affected"`
+}
+
+// AdvisoryDB is the format of the local advisory database file
+type AdvisoryDB struct {
+	UpdatedAt  time.Time         `json:"updated_at"`
+	Advisories []AdvisoryDBEntry `json:"advisories"`
+}
+
+// localAdvisoryDB is the local advisory database, reloaded when its file changes
+var localAdvisoryDB struct {
+	mu      sync.Mutex
+	path    string
+	modTime time.Time
+	db      *AdvisoryDB
+}
+
+// LookupAdvisories returns the advisories affecting a provider (namespace/name) or module
+// (namespace/name/provider) version, from the local advisory database and, for providers, OSV
+func LookupAdvisories(ctx context.Context, httpClient *http.Client, kind string, name string, ver string, logger *log.Logger) ([]Advisory, error) {
+	name = strings.ToLower(name)
+	v, err := version.NewVersion(ver)
+	if err != nil {
+		return nil, fmt.Errorf("invalid version %q: %w", ver, err)
+	}
+
+	dbPath := utils.GetEnv(AdvisoryDBEnv, "")
+	osvURL := utils.GetEnv(AdvisoryOSVURLEnv, defaultOSVURL)
+	useOSV := kind == AdvisoryKindProvider && osvURL != "" && osvURL != "off"
+	if dbPath == "" && !useOSV {
+		return nil, ErrNoAdvisorySource
+	}
+
+	var advisories []Advisory
+	var errs []string
+
+	db, err := loadAdvisoryDB(dbPath)
+	if err != nil {
+		errs = append(errs, err.Error())
+	} else if db != nil {
+		advisories = append(advisories, matchAdvisories(db, kind, name, v, logger)...)
+	}
+
+	if useOSV {
+		osvAdvisories, err := queryOSV(ctx, httpClient, osvURL, providerGoModule(name), v.String(), logger)
+		if err != nil {
+			errs = append(errs, err.Error())
+		}
+		advisories = append(advisories, osvAdvisories...)
+	}
+
+	advisories = dedupeAdvisories(advisories)
+	if len(errs) > 0 {
+		return advisories, fmt.Errorf("advisory lookup incomplete: %s", strings.Join(errs, "; "))
+	}
+	return advisories, nil
+}
+
+// loadAdvisoryDB reads the local advisory database, reusing the loaded one while the file is unchanged
+func loadAdvisoryDB(path string) (*AdvisoryDB, error) {
+	if path == "" {
+		return nil, nil
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading advisory database: %w", err)
+	}
+
+	localAdvisoryDB.mu.Lock()
+	defer localAdvisoryDB.mu.Unlock()
+	if localAdvisoryDB.db != nil && localAdvisoryDB.path == path && localAdvisoryDB.modTime.Equal(info.ModTime()) {
+		return localAdvisoryDB.db, nil
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading advisory database: %w", err)
+	}
+	var db AdvisoryDB
+	if err := json.Unmarshal(content, &db); err != nil {
+		return nil, fmt.Errorf("parsing advisory database %s: %w", path, err)
+	}
+	localAdvisoryDB.path = path
+	localAdvisoryDB.modTime = info.ModTime()
+	localAdvisoryDB.db = &db
+	return &db, nil
+}
+
+// matchAdvisories returns the advisories of the local database affecting a version
+func matchAdvisories(db *AdvisoryDB, kind string, name string, v *version.Version, logger *log.Logger) []Advisory {
+	var advisories []Advisory
+	for _, entry := range db.Advisories {
+		for _, affected := range entry.Affected {
+			if affected.Kind != kind || strings.ToLower(affected.Name) != name {
+				continue
+			}
+			constraints, err := version.NewConstraint(affected.Versions)
+			if err != nil {
+				logger.Warnf("Ignoring advisory %s with invalid versions %q: %v", entry.ID, affected.Versions, err)
+				continue
+			}
+			if constraints.Check(v) {
+				advisory := entry.Advisory
+				if advisory.Source == "" {
+					advisory.Source = "local"
+				}
+				advisories = append(advisories, advisory)
+				break
+			}
+		}
+	}
+	return advisories
+}
+
+// providerGoModule returns the Go module of a provider, by the terraform-provider-{name} naming convention
+func providerGoModule(address string) string {
+	namespace, name, _ := strings.Cut(address, "/")
+	return fmt.Sprintf("github.com/%s/terraform-provider-%s", namespace, name)
+}
+
+// osvVulnerability is a vulnerability returned by the OSV query API
+type osvVulnerability struct {
+	ID               string   `json:"id"`
+	Summary          string   `json:"summary"`
+	Details          string   `json:"details"`
+	Aliases          []string `json:"aliases"`
+	DatabaseSpecific struct {
+		Severity string `json:"severity"`
+		URL      string `json:"url"`
+	} `json:"database_specific"`
+	References []struct {
+		Type string `json:"type"`
+		URL  string `json:"url"`
+	} `json:"references"`
+}
+
+// queryOSV queries OSV for the vulnerabilities of a Go module version. Responses are kept in the registry cache.
+func queryOSV(ctx context.Context, httpClient *http.Client, osvURL string, module string, ver string, logger *log.Logger) ([]Advisory, error) {
+	cache, ttl := getRegistryCache()
+	cacheKey := advisoryCacheKeyPrefix + "osv:" + module + "@" + ver
+
+	var body []byte
+	if ttl > 0 {
+		if cached, ok, err := cache.Get(ctx, cacheKey); err == nil {
+			RecordCacheLookup(CacheAdvisories, ok)
+			if ok {
+				body = cached
+			}
+		}
+	}
+	if body == nil {
+		query, err := json.Marshal(map[string]any{
+			"package": map[string]string{"name": module, "ecosystem": "Go"},
+			"version": ver,
+		})
+		if err != nil {
+			return nil, err
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.TrimSuffix(osvURL, "/")+"/v1/query", bytes.NewReader(query))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("querying OSV: %w", err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("querying OSV: %s", resp.Status)
+		}
+		body, err = io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, fmt.Errorf("reading OSV response: %w", err)
+		}
+		if ttl > 0 {
+			if err := cache.Set(ctx, cacheKey, body, ttl); err != nil {
+				logger.Warnf("Advisory cache update failed: %v", err)
+			}
+		}
+	}
+
+	var response struct {
+		Vulns []osvVulnerability `json:"vulns"`
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("parsing OSV response: %w", err)
+	}
+
+	advisories := make([]Advisory, 0, len(response.Vulns))
+	for _, vuln := range response.Vulns {
+		advisory := Advisory{
+			ID:       vuln.ID,
+			Aliases:  vuln.Aliases,
+			Summary:  vuln.Summary,
+			Severity: strings.ToUpper(vuln.DatabaseSpecific.Severity),
+			URL:      vuln.DatabaseSpecific.URL,
+			Source:   "osv",
+		}
+		if advisory.Summary == "" {
+			advisory.Summary, _, _ = strings.Cut(vuln.Details, "\n")
+		}
+		if advisory.URL == "" {
+			advisory.URL = "https://osv.dev/vulnerability/" + vuln.ID
+			for _, reference := range vuln.References {
+				if reference.Type == "ADVISORY" {
+					advisory.URL = reference.URL
+					break
+				}
+			}
+		}
+		advisories = append(advisories, advisory)
+	}
+	return advisories, nil
+}
+
+// dedupeAdvisories removes advisories reported by several sources under the same ID or alias,
+// keeping the first one, and sorts them by ID
+func dedupeAdvisories(advisories []Advisory) []Advisory {
+	seen := make(map[string]bool)
+	result := make([]Advisory, 0, len(advisories))
+	for _, advisory := range advisories {
+		ids := append([]string{advisory.ID}, advisory.Aliases...)
+		duplicate := false
+		for _, id := range ids {
+			if seen[id] {
+				duplicate = true
+			}
+		}
+		for _, id := range ids {
+			seen[id] = true
+		}
+		if !duplicate {
+			result = append(result, advisory)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool { return result[i].ID < result[j].ID })
+	return result
+}
+
+// FormatAdvisories renders advisories as a Markdown section for tool responses. It is empty when
+// no advisory source covers the package.
+func FormatAdvisories(advisories []Advisory, lookupErr error) string {
+	if errors.Is(lookupErr, ErrNoAdvisorySource) {
+		return ""
+	}
+	var b strings.Builder
+	b.WriteString("### Security Advisories\n\n")
+	if len(advisories) == 0 {
+		if lookupErr != nil {
+			fmt.Fprintf(&b, "Could not check for security advisories: %v\n", lookupErr)
+		} else {
+			b.WriteString("No known security advisories affect this version.\n")
+		}
+		return b.String()
+	}
+	b.WriteString("| ID | Aliases | Severity | Summary | Source |\n")
+	b.WriteString("|---|---|---|---|---|\n")
+	for _, advisory := range advisories {
+		id := advisory.ID
+		if advisory.URL != "" {
+			id = fmt.Sprintf("[%s](%s)", advisory.ID, advisory.URL)
+		}
+		fmt.Fprintf(&b, "| %s | %s | %s | %s | %s |\n", id, strings.Join(advisory.Aliases, ", "), advisory.Severity, strings.ReplaceAll(advisory.Summary, "|", "\\|"), advisory.Source)
+	}
+	if lookupErr != nil {
+		fmt.Fprintf(&b, "\nThe list may be incomplete: %v\n", lookupErr)
+	}
+	return b.String()
+}
@@ -0,0 +1,131 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeAdvisoryDB(t *testing.T, path string, db AdvisoryDB, modTime time.Time) {
+	t.Helper()
+	content, err := json.Marshal(db)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, content, 0o600))
+	require.NoError(t, os.Chtimes(path, modTime, modTime))
+}
+
+func TestLookupAdvisories(t *testing.T) {
+	logger := log.New()
+	ctx := context.Background()
+	dbPath := filepath.Join(t.TempDir(), "advisories.json")
+	writeAdvisoryDB(t, dbPath, AdvisoryDB{
+		Advisories: []AdvisoryDBEntry{
+			{
+				Advisory: Advisory{ID: "TFSA-2025-01", Aliases: []string{"CVE-2025-0001"}, Summary: "Credentials logged in plain text", Severity: "HIGH"},
+				Affected: []AdvisoryAffected{{Kind: AdvisoryKindModule, Name: "example/vpc/aws", Versions: ">= 1.0.0, < 1.4.2"}},
+			},
+			{
+				Advisory: Advisory{ID: "TFSA-2025-02", Summary: "Provider leaks tokens"},
+				Affected: []AdvisoryAffected{{Kind: AdvisoryKindProvider, Name: "example/widget", Versions: "< 2.0.0"}},
+			},
+		},
+	}, time.Now().Add(-time.Hour))
+
+	t.Run("no source configured", func(t *testing.T) {
+		t.Setenv(AdvisoryDBEnv, "")
+		t.Setenv(AdvisoryOSVURLEnv, "off")
+		_, err := LookupAdvisories(ctx, http.DefaultClient, AdvisoryKindModule, "example/vpc/aws", "1.0.0", logger)
+		assert.ErrorIs(t, err, ErrNoAdvisorySource)
+		assert.Empty(t, FormatAdvisories(nil, err))
+	})
+
+	t.Run("matches local database by version constraint", func(t *testing.T) {
+		t.Setenv(AdvisoryDBEnv, dbPath)
+		t.Setenv(AdvisoryOSVURLEnv, "off")
+
+		advisories, err := LookupAdvisories(ctx, http.DefaultClient, AdvisoryKindModule, "Example/VPC/aws", "1.3.0", logger)
+		require.NoError(t, err)
+		require.Len(t, advisories, 1)
+		assert.Equal(t, "TFSA-2025-01", advisories[0].ID)
+		assert.Equal(t, "local", advisories[0].Source)
+
+		advisories, err = LookupAdvisories(ctx, http.DefaultClient, AdvisoryKindModule, "example/vpc/aws", "1.4.2", logger)
+		require.NoError(t, err)
+		assert.Empty(t, advisories)
+		assert.Contains(t, FormatAdvisories(advisories, err), "No known security advisories")
+	})
+
+	t.Run("reloads the local database when it changes", func(t *testing.T) {
+		t.Setenv(AdvisoryOSVURLEnv, "off")
+		path := filepath.Join(t.TempDir(), "advisories.json")
+		t.Setenv(AdvisoryDBEnv, path)
+
+		writeAdvisoryDB(t, path, AdvisoryDB{}, time.Now().Add(-time.Hour))
+		advisories, err := LookupAdvisories(ctx, http.DefaultClient, AdvisoryKindModule, "example/vpc/aws", "1.0.0", logger)
+		require.NoError(t, err)
+		assert.Empty(t, advisories)
+
+		writeAdvisoryDB(t, path, AdvisoryDB{Advisories: []AdvisoryDBEntry{{
+			Advisory: Advisory{ID: "TFSA-2025-03"},
+			Affected: []AdvisoryAffected{{Kind: AdvisoryKindModule, Name: "example/vpc/aws", Versions: "1.0.0"}},
+		}}}, time.Now())
+		advisories, err = LookupAdvisories(ctx, http.DefaultClient, AdvisoryKindModule, "example/vpc/aws", "1.0.0", logger)
+		require.NoError(t, err)
+		require.Len(t, advisories, 1)
+		assert.Equal(t, "TFSA-2025-03", advisories[0].ID)
+	})
+
+	t.Run("queries OSV for providers and removes duplicates", func(t *testing.T) {
+		var query map[string]any
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/v1/query", r.URL.Path)
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&query))
+			_, _ = w.Write([]byte(`{"vulns":[
+				{"id":"GHSA-aaaa-bbbb-cccc","aliases":["TFSA-2025-02"],"summary":"Provider leaks tokens"},
+				{"id":"GO-2025-0002","details":"Crash on empty input\nMore details","database_specific":{"severity":"moderate"}}
+			]}`))
+		}))
+		defer server.Close()
+		t.Setenv(AdvisoryDBEnv, dbPath)
+		t.Setenv(AdvisoryOSVURLEnv, server.URL)
+
+		advisories, err := LookupAdvisories(ctx, server.Client(), AdvisoryKindProvider, "example/widget", "1.9.9", logger)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{"name": "github.com/example/terraform-provider-widget", "ecosystem": "Go"}, query["package"])
+		assert.Equal(t, "1.9.9", query["version"])
+
+		require.Len(t, advisories, 2)
+		assert.Equal(t, "GO-2025-0002", advisories[0].ID)
+		assert.Equal(t, "Crash on empty input", advisories[0].Summary)
+		assert.Equal(t, "MODERATE", advisories[0].Severity)
+		assert.Equal(t, "https://osv.dev/vulnerability/GO-2025-0002", advisories[0].URL)
+		assert.Equal(t, "TFSA-2025-02", advisories[1].ID)
+		assert.Equal(t, "local", advisories[1].Source)
+	})
+
+	t.Run("reports an incomplete lookup", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+		t.Setenv(AdvisoryDBEnv, dbPath)
+		t.Setenv(AdvisoryOSVURLEnv, server.URL)
+
+		advisories, err := LookupAdvisories(ctx, server.Client(), AdvisoryKindProvider, "example/widget", "1.9.8", logger)
+		assert.Error(t, err)
+		require.Len(t, advisories, 1)
+		assert.Contains(t, FormatAdvisories(advisories, err), "The list may be incomplete")
+	})
+}
@@ -0,0 +1,168 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/go-tfe"
+)
+
+const agentPoolPageSize = 100
+
+// AgentPoolSummary is an agent pool of an organization
+type AgentPoolSummary struct {
+	ID                 string `json:"id"`
+	Name               string `json:"name"`
+	OrganizationScoped bool   `json:"organization_scoped"`
+	AgentCount         int    `json:"agent_count"`
+	WorkspaceCount     int    `json:"workspace_count"`
+}
+
+// AgentPoolDetails describes an agent pool with the workspaces using it and its agents
+type AgentPoolDetails struct {
+	AgentPoolSummary
+	WorkspaceIDs        []string       `json:"workspace_ids"`
+	AllowedWorkspaceIDs []string       `json:"allowed_workspace_ids,omitempty"`
+	AgentStatusCounts   map[string]int `json:"agent_status_counts"`
+	Agents              []*AgentInfo   `json:"agents"`
+}
+
+// AgentInfo is an agent registered in an agent pool
+type AgentInfo struct {
+	ID         string `json:"id"`
+	Name       string `json:"name,omitempty"`
+	IP         string `json:"ip,omitempty"`
+	Status     string `json:"status"`
+	LastPingAt string `json:"last_ping_at,omitempty"`
+}
+
+// agentPoolSources are the TFE services used to look up agent pools and their agents
+type agentPoolSources struct {
+	agentPools interface {
+		List(ctx context.Context, organization string, options *tfe.AgentPoolListOptions) (*tfe.AgentPoolList, error)
+		ReadWithOptions(ctx context.Context, agentPoolID string, options *tfe.AgentPoolReadOptions) (*tfe.AgentPool, error)
+	}
+	agents interface {
+		List(ctx context.Context, agentPoolID string, options *tfe.AgentListOptions) (*tfe.AgentList, error)
+	}
+}
+
+func newAgentPoolSources(tfeClient *tfe.Client) agentPoolSources {
+	return agentPoolSources{agentPools: tfeClient.AgentPools, agents: tfeClient.Agents}
+}
+
+// ListAgentPools lists the agent pools of an organization, optionally filtered by a name search
+func ListAgentPools(ctx context.Context, tfeClient *tfe.Client, orgName string, query string) ([]*AgentPoolSummary, error) {
+	return listAgentPools(ctx, newAgentPoolSources(tfeClient), orgName, query)
+}
+
+// GetAgentPool describes an agent pool with its workspaces and the status of its agents
+func GetAgentPool(ctx context.Context, tfeClient *tfe.Client, agentPoolID string) (*AgentPoolDetails, error) {
+	return getAgentPool(ctx, newAgentPoolSources(tfeClient), agentPoolID)
+}
+
+// ListAgents lists the agents of an agent pool
+func ListAgents(ctx context.Context, tfeClient *tfe.Client, agentPoolID string) ([]*AgentInfo, error) {
+	return listAgents(ctx, newAgentPoolSources(tfeClient), agentPoolID)
+}
+
+func listAgentPools(ctx context.Context, sources agentPoolSources, orgName string, query string) ([]*AgentPoolSummary, error) {
+	pools := []*AgentPoolSummary{}
+	options := &tfe.AgentPoolListOptions{
+		ListOptions: tfe.ListOptions{PageNumber: 1, PageSize: agentPoolPageSize},
+		Query:       query,
+	}
+	for {
+		page, err := sources.agentPools.List(ctx, orgName, options)
+		if err != nil {
+			return nil, err
+		}
+		for _, pool := range page.Items {
+			pools = append(pools, agentPoolSummary(pool))
+		}
+		if page.Pagination == nil || page.NextPage == 0 {
+			break
+		}
+		options.PageNumber = page.NextPage
+	}
+
+	sort.Slice(pools, func(i, j int) bool { return pools[i].Name < pools[j].Name })
+	return pools, nil
+}
+
+func getAgentPool(ctx context.Context, sources agentPoolSources, agentPoolID string) (*AgentPoolDetails, error) {
+	pool, err := sources.agentPools.ReadWithOptions(ctx, agentPoolID, &tfe.AgentPoolReadOptions{
+		Include: []tfe.AgentPoolIncludeOpt{tfe.AgentPoolWorkspaces},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	agents, err := listAgents(ctx, sources, pool.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	details := &AgentPoolDetails{
+		AgentPoolSummary:  *agentPoolSummary(pool),
+		WorkspaceIDs:      workspaceIDs(pool.Workspaces),
+		AgentStatusCounts: make(map[string]int),
+		Agents:            agents,
+	}
+	if !pool.OrganizationScoped {
+		details.AllowedWorkspaceIDs = workspaceIDs(pool.AllowedWorkspaces)
+	}
+	for _, agent := range agents {
+		details.AgentStatusCounts[agent.Status]++
+	}
+	return details, nil
+}
+
+func listAgents(ctx context.Context, sources agentPoolSources, agentPoolID string) ([]*AgentInfo, error) {
+	agents := []*AgentInfo{}
+	options := &tfe.AgentListOptions{
+		ListOptions: tfe.ListOptions{PageNumber: 1, PageSize: agentPoolPageSize},
+	}
+	for {
+		page, err := sources.agents.List(ctx, agentPoolID, options)
+		if err != nil {
+			return nil, err
+		}
+		for _, agent := range page.Items {
+			agents = append(agents, &AgentInfo{
+				ID:         agent.ID,
+				Name:       agent.Name,
+				IP:         agent.IP,
+				Status:     agent.Status,
+				LastPingAt: agent.LastPingAt,
+			})
+		}
+		if page.Pagination == nil || page.NextPage == 0 {
+			break
+		}
+		options.PageNumber = page.NextPage
+	}
+	return agents, nil
+}
+
+func agentPoolSummary(pool *tfe.AgentPool) *AgentPoolSummary {
+	return &AgentPoolSummary{
+		ID:                 pool.ID,
+		Name:               pool.Name,
+		OrganizationScoped: pool.OrganizationScoped,
+		AgentCount:         pool.AgentCount,
+		WorkspaceCount:     len(pool.Workspaces),
+	}
+}
+
+func workspaceIDs(workspaces []*tfe.Workspace) []string {
+	ids := make([]string, 0, len(workspaces))
+	for _, workspace := range workspaces {
+		ids = append(ids, workspace.ID)
+	}
+	sort.Strings(ids)
+	return ids
+}
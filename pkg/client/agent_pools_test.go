@@ -0,0 +1,89 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeAgentPools struct {
+	pages [][]*tfe.AgentPool
+	pool  *tfe.AgentPool
+}
+
+func (f *fakeAgentPools) List(_ context.Context, _ string, options *tfe.AgentPoolListOptions) (*tfe.AgentPoolList, error) {
+	index := options.PageNumber - 1
+	list := &tfe.AgentPoolList{Items: f.pages[index], Pagination: &tfe.Pagination{}}
+	if index+1 < len(f.pages) {
+		list.NextPage = options.PageNumber + 1
+	}
+	return list, nil
+}
+
+func (f *fakeAgentPools) ReadWithOptions(_ context.Context, agentPoolID string, _ *tfe.AgentPoolReadOptions) (*tfe.AgentPool, error) {
+	if f.pool == nil || f.pool.ID != agentPoolID {
+		return nil, errors.New("not found")
+	}
+	return f.pool, nil
+}
+
+type fakeAgents map[string][]*tfe.Agent
+
+func (f fakeAgents) List(_ context.Context, agentPoolID string, _ *tfe.AgentListOptions) (*tfe.AgentList, error) {
+	return &tfe.AgentList{Items: f[agentPoolID]}, nil
+}
+
+func TestListAgentPools(t *testing.T) {
+	sources := agentPoolSources{
+		agentPools: &fakeAgentPools{pages: [][]*tfe.AgentPool{
+			{{ID: "apool-2", Name: "private", AgentCount: 2, Workspaces: []*tfe.Workspace{{ID: "ws-1"}}}},
+			{{ID: "apool-1", Name: "default", AgentCount: 0, OrganizationScoped: true}},
+		}},
+	}
+
+	pools, err := listAgentPools(context.Background(), sources, "my-org", "")
+	require.NoError(t, err)
+	require.Len(t, pools, 2)
+	assert.Equal(t, "default", pools[0].Name)
+	assert.True(t, pools[0].OrganizationScoped)
+	assert.Equal(t, "private", pools[1].Name)
+	assert.Equal(t, 2, pools[1].AgentCount)
+	assert.Equal(t, 1, pools[1].WorkspaceCount)
+}
+
+func TestGetAgentPool(t *testing.T) {
+	sources := agentPoolSources{
+		agentPools: &fakeAgentPools{pool: &tfe.AgentPool{
+			ID:                "apool-1",
+			Name:              "private",
+			AgentCount:        3,
+			Workspaces:        []*tfe.Workspace{{ID: "ws-2"}, {ID: "ws-1"}},
+			AllowedWorkspaces: []*tfe.Workspace{{ID: "ws-1"}, {ID: "ws-2"}, {ID: "ws-3"}},
+		}},
+		agents: fakeAgents{
+			"apool-1": {
+				{ID: "agent-1", Name: "a", Status: "idle"},
+				{ID: "agent-2", Name: "b", Status: "busy"},
+				{ID: "agent-3", Name: "c", Status: "idle"},
+			},
+		},
+	}
+
+	details, err := getAgentPool(context.Background(), sources, "apool-1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ws-1", "ws-2"}, details.WorkspaceIDs)
+	assert.Equal(t, []string{"ws-1", "ws-2", "ws-3"}, details.AllowedWorkspaceIDs)
+	assert.Equal(t, 2, details.WorkspaceCount)
+	assert.Equal(t, map[string]int{"idle": 2, "busy": 1}, details.AgentStatusCounts)
+	assert.Len(t, details.Agents, 3)
+
+	_, err = getAgentPool(context.Background(), sources, "apool-missing")
+	assert.Error(t, err)
+}
@@ -30,17 +30,70 @@ func GetLatestProviderVersion(ctx context.Context, httpClient *http.Client, prov
 	return providerVersionLatest.Version, nil
 }
 
-// Every provider version has a unique ID, which is used to identify the provider version in the registry and its specific documentation
+// GetLatestProviderVersionForChannel returns the latest provider version in the given release channel.
+// The stable channel uses the registry's own notion of the latest version.
+func GetLatestProviderVersionForChannel(ctx context.Context, httpClient *http.Client, providerNamespace string, providerName string, channel string, logger *log.Logger) (string, error) {
+	if channel == "" || channel == utils.ReleaseChannelStable {
+		return GetLatestProviderVersion(ctx, httpClient, providerNamespace, providerName, logger)
+	}
+
+	uri := fmt.Sprintf("providers/%s/%s", providerNamespace, providerName)
+	jsonData, err := SendRegistryCall(ctx, httpClient, "GET", uri, logger, "v1")
+	if err != nil {
+		return "", utils.LogAndReturnError(logger, "making the provider versions API request", err)
+	}
+
+	var providerVersionLatest ProviderVersionLatest
+	if err := json.Unmarshal(jsonData, &providerVersionLatest); err != nil {
+		return "", utils.LogAndReturnError(logger, "unmarshalling provider versions request", err)
+	}
+
+	latest, err := utils.LatestVersionForChannel(providerVersionLatest.Versions, channel)
+	if err != nil {
+		return "", err
+	}
+	logger.Debugf("Fetched latest provider version in %s channel: %s", channel, latest)
+	return latest, nil
+}
+
+// ListProviderVersions returns every available version of a provider, in the order of the registry
+func ListProviderVersions(ctx context.Context, httpClient *http.Client, providerNamespace string, providerName string, logger *log.Logger) (*ProviderVersions, error) {
+	uri := fmt.Sprintf("providers/%s/%s/versions", providerNamespace, providerName)
+	jsonData, err := SendRegistryCall(ctx, httpClient, "GET", uri, logger, "v1")
+	if err != nil {
+		return nil, utils.LogAndReturnError(logger, "making the provider versions API request", err)
+	}
+
+	var providerVersions ProviderVersions
+	if err := json.Unmarshal(jsonData, &providerVersions); err != nil {
+		return nil, utils.LogAndReturnError(logger, "unmarshalling provider versions request", err)
+	}
+
+	logger.Debugf("Fetched %d provider versions", len(providerVersions.Versions))
+	return &providerVersions, nil
+}
+
+// GetProviderWithVersions returns the registry metadata of a provider, such as its tier and downloads,
+// with its versions and their publication dates
 // https://registry.terraform.io/v2/providers/hashicorp/aws?include=provider-versions
-func GetProviderVersionID(ctx context.Context, httpClient *http.Client, namespace string, name string, version string, logger *log.Logger) (string, error) {
+func GetProviderWithVersions(ctx context.Context, httpClient *http.Client, namespace string, name string, logger *log.Logger) (*ProviderVersionList, error) {
 	uri := fmt.Sprintf("providers/%s/%s?include=provider-versions", namespace, name)
 	response, err := SendRegistryCall(ctx, httpClient, "GET", uri, logger, "v2")
 	if err != nil {
-		return "", utils.LogAndReturnError(logger, "making provider version ID request", err)
+		return nil, utils.LogAndReturnError(logger, "making provider versions request", err)
 	}
 	var providerVersionList ProviderVersionList
 	if err := json.Unmarshal(response, &providerVersionList); err != nil {
-		return "", utils.LogAndReturnError(logger, "unmarshalling provider version ID request", err)
+		return nil, utils.LogAndReturnError(logger, "unmarshalling provider versions request", err)
+	}
+	return &providerVersionList, nil
+}
+
+// Every provider version has a unique ID, which is used to identify the provider version in the registry and its specific documentation
+func GetProviderVersionID(ctx context.Context, httpClient *http.Client, namespace string, name string, version string, logger *log.Logger) (string, error) {
+	providerVersionList, err := GetProviderWithVersions(ctx, httpClient, namespace, name, logger)
+	if err != nil {
+		return "", err
 	}
 	for _, providerVersion := range providerVersionList.Included {
 		if providerVersion.Attributes.Version == version {
@@ -0,0 +1,276 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// DefaultEventReplayBuffer is the number of events kept per session for Last-Event-ID resumption
+	DefaultEventReplayBuffer = 100
+
+	// DefaultEventReplayRetention is how long the events of a session are kept after its last event
+	// when no session idle TTL is configured
+	DefaultEventReplayRetention = time.Hour
+
+	headerLastEventID = "Last-Event-ID"
+)
+
+var sseFrameDelimiter = []byte("\n\n")
+
+// EventReplay keeps the latest events of the event streams of each session of the streamable HTTP
+// transport. Every event gets an ID, so that a client whose stream dropped, e.g. behind a proxy that
+// cuts long responses, can reconnect with a GET request and the Last-Event-ID header and receive the
+// events of that stream it missed, such as the result of a long plan wait. The events are kept in
+// memory, so a client resumes on the instance that served the dropped stream.
+type EventReplay struct {
+	size      int
+	retention time.Duration
+	logger    *log.Logger
+
+	mu       sync.Mutex
+	sessions map[string]*sessionEvents
+}
+
+// sessionEvents are the latest events of the streams of a session
+type sessionEvents struct {
+	streams  int
+	seq      int
+	events   []replayEvent
+	lastUsed time.Time
+}
+
+// replayEvent is an SSE frame written to a stream, without its ID
+type replayEvent struct {
+	stream int
+	seq    int
+	frame  []byte
+}
+
+// NewEventReplay keeps up to size events per session, for retention after the last event of a session
+func NewEventReplay(size int, retention time.Duration, logger *log.Logger) *EventReplay {
+	if retention <= 0 {
+		retention = DefaultEventReplayRetention
+	}
+	return &EventReplay{
+		size:      size,
+		retention: retention,
+		logger:    logger,
+		sessions:  make(map[string]*sessionEvents),
+	}
+}
+
+// Middleware adds IDs to the events of the streams of a session and replays the missed events of a
+// stream to a GET request that resumes it with the Last-Event-ID header
+func (e *EventReplay) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sessionID := r.Header.Get(server.HeaderKeySessionID)
+		if sessionID == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if r.Method == http.MethodDelete {
+			next.ServeHTTP(w, r)
+			e.forget(sessionID)
+			return
+		}
+
+		rw := &replayResponseWriter{ResponseWriter: w, replay: e, sessionID: sessionID}
+		if lastEventID := r.Header.Get(headerLastEventID); lastEventID != "" && r.Method == http.MethodGet {
+			missed, err := e.eventsAfter(sessionID, lastEventID)
+			if err != nil {
+				e.logger.Warnf("Not replaying the events of session %s: %v", sessionID, err)
+			} else {
+				e.logger.Infof("Resuming an event stream of session %s after event %s with %d missed events", sessionID, lastEventID, len(missed))
+			}
+			rw.missed = missed
+		}
+		next.ServeHTTP(rw, r)
+	})
+}
+
+// openStream registers a new event stream of a session and returns its number
+func (e *EventReplay) openStream(sessionID string) int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	now := time.Now()
+	for id, session := range e.sessions {
+		if now.Sub(session.lastUsed) > e.retention {
+			delete(e.sessions, id)
+		}
+	}
+	session, ok := e.sessions[sessionID]
+	if !ok {
+		session = &sessionEvents{}
+		e.sessions[sessionID] = session
+	}
+	session.streams++
+	session.lastUsed = now
+	return session.streams
+}
+
+// record keeps an event of a stream and returns its ID
+func (e *EventReplay) record(sessionID string, stream int, frame []byte) string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	session, ok := e.sessions[sessionID]
+	if !ok {
+		session = &sessionEvents{streams: stream}
+		e.sessions[sessionID] = session
+	}
+	session.seq++
+	session.lastUsed = time.Now()
+	session.events = append(session.events, replayEvent{stream: stream, seq: session.seq, frame: bytes.Clone(frame)})
+	if len(session.events) > e.size {
+		session.events = session.events[len(session.events)-e.size:]
+	}
+	return eventID(stream, session.seq)
+}
+
+// eventsAfter returns the kept events of the stream of an event that came after it
+func (e *EventReplay) eventsAfter(sessionID string, lastEventID string) ([]replayEvent, error) {
+	stream, seq, err := parseEventID(lastEventID)
+	if err != nil {
+		return nil, err
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	session, ok := e.sessions[sessionID]
+	if !ok {
+		return nil, fmt.Errorf("no events are kept for the session")
+	}
+	var missed []replayEvent
+	for _, event := range session.events {
+		if event.stream == stream && event.seq > seq {
+			missed = append(missed, event)
+		}
+	}
+	return missed, nil
+}
+
+// forget drops the events of an ended session
+func (e *EventReplay) forget(sessionID string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	delete(e.sessions, sessionID)
+}
+
+// eventID returns the ID of an event, e.g. "2-15" for the 15th event of the session sent on its second stream
+func eventID(stream int, seq int) string {
+	return strconv.Itoa(stream) + "-" + strconv.Itoa(seq)
+}
+
+func parseEventID(id string) (int, int, error) {
+	streamPart, seqPart, ok := strings.Cut(strings.TrimSpace(id), "-")
+	if !ok {
+		return 0, 0, fmt.Errorf("malformed event ID %q", id)
+	}
+	stream, err := strconv.Atoi(streamPart)
+	if err != nil {
+		return 0, 0, fmt.Errorf("malformed event ID %q", id)
+	}
+	seq, err := strconv.Atoi(seqPart)
+	if err != nil {
+		return 0, 0, fmt.Errorf("malformed event ID %q", id)
+	}
+	return stream, seq, nil
+}
+
+// isReplayableEvent reports whether an SSE frame carries a message worth replaying. Keep-alive pings
+// are sent without an ID, which leaves the last event ID of the client unchanged.
+func isReplayableEvent(frame []byte) bool {
+	return bytes.Contains(frame, []byte("data:")) && !bytes.Contains(frame, []byte(`"method":"ping"`))
+}
+
+// replayResponseWriter adds IDs to the events of an event stream response and sends the missed events
+// of a resumed stream first
+type replayResponseWriter struct {
+	http.ResponseWriter
+	replay    *EventReplay
+	sessionID string
+	missed    []replayEvent
+
+	mu          sync.Mutex
+	wroteHeader bool
+	stream      int
+	pending     []byte
+}
+
+func (w *replayResponseWriter) WriteHeader(statusCode int) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.writeHeaderLocked(statusCode)
+}
+
+func (w *replayResponseWriter) writeHeaderLocked(statusCode int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+	if statusCode == http.StatusOK && strings.HasPrefix(w.Header().Get("Content-Type"), "text/event-stream") {
+		w.stream = w.replay.openStream(w.sessionID)
+	}
+	w.ResponseWriter.WriteHeader(statusCode)
+	if w.stream == 0 {
+		return
+	}
+	for _, event := range w.missed {
+		if _, err := w.ResponseWriter.Write(withEventID(eventID(event.stream, event.seq), event.frame)); err != nil {
+			return
+		}
+	}
+}
+
+func (w *replayResponseWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.writeHeaderLocked(http.StatusOK)
+	if w.stream == 0 {
+		return w.ResponseWriter.Write(p)
+	}
+
+	// Events are buffered until they are complete, as one event may be written in several parts
+	w.pending = append(w.pending, p...)
+	for {
+		end := bytes.Index(w.pending, sseFrameDelimiter)
+		if end < 0 {
+			break
+		}
+		frame := w.pending[:end+len(sseFrameDelimiter)]
+		w.pending = w.pending[end+len(sseFrameDelimiter):]
+		if isReplayableEvent(frame) {
+			frame = withEventID(w.replay.record(w.sessionID, w.stream, frame), frame)
+		}
+		if _, err := w.ResponseWriter.Write(frame); err != nil {
+			return 0, err
+		}
+	}
+	return len(p), nil
+}
+
+func (w *replayResponseWriter) Flush() {
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Unwrap returns the original response writer for http.ResponseController
+func (w *replayResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// withEventID prefixes an SSE frame with its ID
+func withEventID(id string, frame []byte) []byte {
+	return append([]byte("id: "+id+"\n"), frame...)
+}
@@ -0,0 +1,116 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// eventStreamHandler answers with an event stream of the given writes, like the streamable HTTP transport
+func eventStreamHandler(writes ...string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.WriteHeader(http.StatusOK)
+		for _, write := range writes {
+			_, _ = io.WriteString(w, write)
+		}
+		w.(http.Flusher).Flush()
+	})
+}
+
+func serveReplay(t *testing.T, replay *EventReplay, handler http.Handler, method string, sessionID string, lastEventID string) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(method, "/mcp", nil)
+	if sessionID != "" {
+		req.Header.Set(server.HeaderKeySessionID, sessionID)
+	}
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+	rec := httptest.NewRecorder()
+	replay.Middleware(handler).ServeHTTP(rec, req)
+	return rec
+}
+
+func TestEventReplay(t *testing.T) {
+	logger := log.New()
+	logger.SetOutput(io.Discard)
+
+	t.Run("events get IDs and pings don't", func(t *testing.T) {
+		replay := NewEventReplay(10, 0, logger)
+		rec := serveReplay(t, replay, eventStreamHandler(
+			"event: message\ndata: {\"id\":1}\n\n",
+			"event: message\ndata: {\"method\":\"ping\"}\n\n",
+			"event: message\n", "data: {\"id\":2}\n\n",
+		), http.MethodPost, "session-1", "")
+
+		assert.Equal(t, "id: 1-1\nevent: message\ndata: {\"id\":1}\n\n"+
+			"event: message\ndata: {\"method\":\"ping\"}\n\n"+
+			"id: 1-2\nevent: message\ndata: {\"id\":2}\n\n", rec.Body.String())
+	})
+
+	t.Run("a resumed stream receives the events it missed", func(t *testing.T) {
+		replay := NewEventReplay(10, 0, logger)
+		serveReplay(t, replay, eventStreamHandler("event: message\ndata: {\"stream\":1}\n\n"), http.MethodGet, "session-1", "")
+		serveReplay(t, replay, eventStreamHandler(
+			"event: message\ndata: {\"progress\":1}\n\n",
+			"event: message\ndata: {\"result\":\"applied\"}\n\n",
+		), http.MethodPost, "session-1", "")
+
+		rec := serveReplay(t, replay, eventStreamHandler(), http.MethodGet, "session-1", "2-2")
+		assert.Equal(t, "id: 2-3\nevent: message\ndata: {\"result\":\"applied\"}\n\n", rec.Body.String())
+
+		rec = serveReplay(t, replay, eventStreamHandler(), http.MethodGet, "session-2", "2-2")
+		assert.Empty(t, rec.Body.String(), "events are only replayed to their session")
+
+		rec = serveReplay(t, replay, eventStreamHandler(), http.MethodGet, "session-1", "latest")
+		assert.Empty(t, rec.Body.String())
+	})
+
+	t.Run("only the latest events are kept", func(t *testing.T) {
+		replay := NewEventReplay(2, 0, logger)
+		serveReplay(t, replay, eventStreamHandler(
+			"data: 1\n\n", "data: 2\n\n", "data: 3\n\n",
+		), http.MethodPost, "session-1", "")
+
+		rec := serveReplay(t, replay, eventStreamHandler(), http.MethodGet, "session-1", "1-0")
+		assert.Equal(t, "id: 1-2\ndata: 2\n\nid: 1-3\ndata: 3\n\n", rec.Body.String())
+	})
+
+	t.Run("ended and expired sessions are forgotten", func(t *testing.T) {
+		replay := NewEventReplay(10, time.Minute, logger)
+		serveReplay(t, replay, eventStreamHandler("data: 1\n\n"), http.MethodPost, "session-1", "")
+		serveReplay(t, replay, eventStreamHandler("data: 1\n\n"), http.MethodPost, "session-2", "")
+
+		serveReplay(t, replay, http.NotFoundHandler(), http.MethodDelete, "session-1", "")
+		rec := serveReplay(t, replay, eventStreamHandler(), http.MethodGet, "session-1", "1-0")
+		assert.Empty(t, rec.Body.String())
+
+		replay.sessions["session-2"].lastUsed = time.Now().Add(-2 * time.Minute)
+		serveReplay(t, replay, eventStreamHandler(), http.MethodGet, "session-3", "")
+		require.NotContains(t, replay.sessions, "session-2")
+	})
+
+	t.Run("other responses are unchanged", func(t *testing.T) {
+		replay := NewEventReplay(10, 0, logger)
+		jsonHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = io.WriteString(w, "{\"result\":{}}\n\n")
+		})
+		rec := serveReplay(t, replay, jsonHandler, http.MethodPost, "session-1", "")
+		assert.Equal(t, "{\"result\":{}}\n\n", rec.Body.String())
+
+		rec = serveReplay(t, replay, eventStreamHandler("data: 1\n\n"), http.MethodPost, "", "")
+		assert.Equal(t, "data: 1\n\n", rec.Body.String())
+	})
+}
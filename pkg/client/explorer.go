@@ -0,0 +1,128 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-tfe"
+)
+
+// Views of the Explorer API
+const (
+	ExplorerWorkspaces = "workspaces"
+	ExplorerTFVersions = "tf_versions"
+	ExplorerProviders  = "providers"
+	ExplorerModules    = "modules"
+)
+
+// ExplorerTypes returns the views of the Explorer API
+func ExplorerTypes() []string {
+	return []string{ExplorerWorkspaces, ExplorerTFVersions, ExplorerProviders, ExplorerModules}
+}
+
+// ExplorerOperators returns the filter operators of the Explorer API
+func ExplorerOperators() []string {
+	return []string{"is", "is_not", "contains", "does_not_contain", "is_empty", "is_not_empty", "gt", "lt", "gteq", "lteq", "is_before", "is_after"}
+}
+
+// ExplorerFilter is a condition on a field of the rows of an Explorer query
+type ExplorerFilter struct {
+	Field    string `json:"field"`
+	Operator string `json:"operator"`
+	Value    string `json:"value,omitempty"`
+}
+
+// ExplorerQuery is a query of the Explorer API of an organization
+type ExplorerQuery struct {
+	Type    string
+	Sort    string
+	Filters []ExplorerFilter
+	Page    int
+	PerPage int
+}
+
+// ExplorerResult is a page of the rows returned by the Explorer API. The fields of the rows use the
+// snake_case names the filters and sorts take.
+type ExplorerResult struct {
+	Type        string           `json:"type"`
+	Rows        []map[string]any `json:"rows"`
+	CurrentPage int              `json:"current_page"`
+	TotalPages  int              `json:"total_pages"`
+	TotalCount  int              `json:"total_count"`
+}
+
+type explorerResponse struct {
+	Data []struct {
+		ID         string         `json:"id"`
+		Attributes map[string]any `json:"attributes"`
+	} `json:"data"`
+	Meta struct {
+		Pagination struct {
+			CurrentPage int `json:"current-page"`
+			TotalPages  int `json:"total-pages"`
+			TotalCount  int `json:"total-count"`
+		} `json:"pagination"`
+	} `json:"meta"`
+}
+
+// QueryExplorer runs a query of the Explorer API, which reports on the workspaces, Terraform versions,
+// providers and modules used across an organization
+// https://developer.hashicorp.com/terraform/cloud-docs/api-docs/explorer
+func QueryExplorer(ctx context.Context, tfeClient *tfe.Client, orgName string, query ExplorerQuery) (*ExplorerResult, error) {
+	req, err := tfeClient.NewRequestWithAdditionalQueryParams("GET", fmt.Sprintf("organizations/%s/explorer", url.PathEscape(orgName)), nil, explorerParams(query))
+	if err != nil {
+		return nil, err
+	}
+	// Reading the body through a writer keeps the API errors, such as tfe.ErrResourceNotFound
+	var body bytes.Buffer
+	if err := req.Do(ctx, &body); err != nil {
+		return nil, err
+	}
+
+	var response explorerResponse
+	if err := json.Unmarshal(body.Bytes(), &response); err != nil {
+		return nil, fmt.Errorf("failed to parse the Explorer response: %w", err)
+	}
+	result := &ExplorerResult{
+		Type:        query.Type,
+		Rows:        make([]map[string]any, 0, len(response.Data)),
+		CurrentPage: response.Meta.Pagination.CurrentPage,
+		TotalPages:  response.Meta.Pagination.TotalPages,
+		TotalCount:  response.Meta.Pagination.TotalCount,
+	}
+	for _, item := range response.Data {
+		row := make(map[string]any, len(item.Attributes))
+		for key, value := range item.Attributes {
+			row[strings.ReplaceAll(key, "-", "_")] = value
+		}
+		result.Rows = append(result.Rows, row)
+	}
+	return result, nil
+}
+
+// explorerParams encodes a query in the parameters of the Explorer API, e.g.
+// filter[0][workspace_name][contains][0]=prod
+func explorerParams(query ExplorerQuery) map[string][]string {
+	params := map[string][]string{"type": {query.Type}}
+	if query.Sort != "" {
+		params["sort"] = []string{query.Sort}
+	}
+	for i, filter := range query.Filters {
+		params[fmt.Sprintf("filter[%d][%s][%s][0]", i, filter.Field, filter.Operator)] = []string{filter.Value}
+	}
+	if query.Page > 0 {
+		params["page[number]"] = []string{strconv.Itoa(query.Page)}
+	}
+	if query.PerPage > 0 {
+		params["page[size]"] = []string{strconv.Itoa(query.PerPage)}
+	}
+	return params
+}
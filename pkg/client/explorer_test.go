@@ -0,0 +1,68 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryExplorer(t *testing.T) {
+	var query url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/organizations/my-org/explorer":
+			query = r.URL.Query()
+			w.Header().Set("Content-Type", "application/vnd.api+json")
+			_, _ = w.Write([]byte(`{
+				"data": [
+					{"id": "1", "type": "visibility-workspace", "attributes": {"workspace-name": "app-prod", "drifted": true, "resources-count": 42}}
+				],
+				"meta": {"pagination": {"current-page": 2, "total-pages": 3, "total-count": 41}}
+			}`))
+		case "/api/v2/organizations/missing/explorer":
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	t.Cleanup(server.Close)
+	tfeClient, err := tfe.NewClient(&tfe.Config{Address: server.URL, Token: "test-token"})
+	require.NoError(t, err)
+
+	t.Run("filters, sort and pages are encoded", func(t *testing.T) {
+		result, err := QueryExplorer(context.Background(), tfeClient, "my-org", ExplorerQuery{
+			Type:    ExplorerWorkspaces,
+			Sort:    "-resources_count",
+			Filters: []ExplorerFilter{{Field: "workspace_name", Operator: "contains", Value: "prod"}},
+			Page:    2,
+			PerPage: 20,
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, "workspaces", query.Get("type"))
+		assert.Equal(t, "-resources_count", query.Get("sort"))
+		assert.Equal(t, "prod", query.Get("filter[0][workspace_name][contains][0]"))
+		assert.Equal(t, "2", query.Get("page[number]"))
+		assert.Equal(t, "20", query.Get("page[size]"))
+
+		require.Len(t, result.Rows, 1)
+		assert.Equal(t, "app-prod", result.Rows[0]["workspace_name"])
+		assert.Equal(t, true, result.Rows[0]["drifted"])
+		assert.Equal(t, 2, result.CurrentPage)
+		assert.Equal(t, 41, result.TotalCount)
+	})
+
+	t.Run("organizations without the Explorer", func(t *testing.T) {
+		_, err := QueryExplorer(context.Background(), tfeClient, "missing", ExplorerQuery{Type: ExplorerProviders})
+		assert.ErrorIs(t, err, tfe.ErrResourceNotFound)
+	})
+}
@@ -0,0 +1,69 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// ServerReadTimeoutEnv sets how long the HTTP transports wait for a request to be read, e.g. "30s"
+	ServerReadTimeoutEnv = "MCP_SERVER_READ_TIMEOUT"
+	// ServerWriteTimeoutEnv sets how long the HTTP transports may take to write a response, e.g. "10m".
+	// "0" disables it, which event streams of long plan and apply waits need.
+	ServerWriteTimeoutEnv = "MCP_SERVER_WRITE_TIMEOUT"
+	// ServerIdleTimeoutEnv sets how long an idle keep-alive connection is kept open, e.g. "2m"
+	ServerIdleTimeoutEnv = "MCP_SERVER_IDLE_TIMEOUT"
+	// SessionIdleTTLEnv sets how long the streamable HTTP transport keeps the state of a session that
+	// sent no request, e.g. "1h". "0" keeps it until the client ends the session.
+	SessionIdleTTLEnv = "MCP_SESSION_IDLE_TTL"
+	// EventReplayBufferEnv sets how many events of each session are kept for clients that resume a
+	// dropped event stream with the Last-Event-ID header. "0" disables the resumption.
+	EventReplayBufferEnv = "MCP_EVENT_REPLAY_BUFFER"
+)
+
+// HTTPServerConfig holds the connection settings of the HTTP transports
+type HTTPServerConfig struct {
+	ReadTimeout time.Duration
+	// WriteTimeout bounds whole responses, event streams included, 0 for no limit
+	WriteTimeout time.Duration
+	IdleTimeout  time.Duration
+	// SessionIdleTTL is how long the state of an idle session is kept, 0 to keep it until the session ends
+	SessionIdleTTL time.Duration
+	// EventReplayBuffer is the number of events kept per session for Last-Event-ID resumption, 0 to disable it
+	EventReplayBuffer int
+}
+
+// DefaultHTTPServerConfig returns the default connection settings of the HTTP transports
+func DefaultHTTPServerConfig() HTTPServerConfig {
+	return HTTPServerConfig{
+		ReadTimeout:       30 * time.Second,
+		IdleTimeout:       60 * time.Second,
+		EventReplayBuffer: DefaultEventReplayBuffer,
+	}
+}
+
+// LoadHTTPServerConfigFromEnv loads the connection settings of the HTTP transports from environment variables
+func LoadHTTPServerConfigFromEnv() HTTPServerConfig {
+	config := DefaultHTTPServerConfig()
+
+	config.ReadTimeout = parseRetryDuration(ServerReadTimeoutEnv, config.ReadTimeout, true)
+	config.WriteTimeout = parseRetryDuration(ServerWriteTimeoutEnv, config.WriteTimeout, true)
+	config.IdleTimeout = parseRetryDuration(ServerIdleTimeoutEnv, config.IdleTimeout, true)
+	config.SessionIdleTTL = parseRetryDuration(SessionIdleTTLEnv, config.SessionIdleTTL, true)
+
+	if buffer := strings.TrimSpace(os.Getenv(EventReplayBufferEnv)); buffer != "" {
+		if value, err := strconv.Atoi(buffer); err == nil && value >= 0 {
+			config.EventReplayBuffer = value
+		} else {
+			log.Warnf("Invalid %s value %q, using default %d", EventReplayBufferEnv, buffer, config.EventReplayBuffer)
+		}
+	}
+	return config
+}
@@ -0,0 +1,48 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadHTTPServerConfigFromEnv(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		t.Setenv(ServerReadTimeoutEnv, "")
+		t.Setenv(ServerWriteTimeoutEnv, "")
+		t.Setenv(ServerIdleTimeoutEnv, "")
+		t.Setenv(SessionIdleTTLEnv, "")
+		t.Setenv(EventReplayBufferEnv, "")
+		config := LoadHTTPServerConfigFromEnv()
+		assert.Equal(t, DefaultHTTPServerConfig(), config)
+		assert.Zero(t, config.WriteTimeout, "event streams must not be cut by default")
+	})
+
+	t.Run("custom values", func(t *testing.T) {
+		t.Setenv(ServerReadTimeoutEnv, "1m")
+		t.Setenv(ServerWriteTimeoutEnv, "30m")
+		t.Setenv(ServerIdleTimeoutEnv, "2m")
+		t.Setenv(SessionIdleTTLEnv, "1h")
+		t.Setenv(EventReplayBufferEnv, "500")
+		assert.Equal(t, HTTPServerConfig{
+			ReadTimeout:       time.Minute,
+			WriteTimeout:      30 * time.Minute,
+			IdleTimeout:       2 * time.Minute,
+			SessionIdleTTL:    time.Hour,
+			EventReplayBuffer: 500,
+		}, LoadHTTPServerConfigFromEnv())
+	})
+
+	t.Run("invalid values keep the defaults", func(t *testing.T) {
+		t.Setenv(ServerReadTimeoutEnv, "soon")
+		t.Setenv(ServerWriteTimeoutEnv, "-1m")
+		t.Setenv(ServerIdleTimeoutEnv, "")
+		t.Setenv(SessionIdleTTLEnv, "")
+		t.Setenv(EventReplayBufferEnv, "-5")
+		assert.Equal(t, DefaultHTTPServerConfig(), LoadHTTPServerConfigFromEnv())
+	})
+}
@@ -0,0 +1,300 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-tfe"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// InventoryFullRefreshInterval is the maximum age of a cached inventory before it is rebuilt from scratch.
+	// Incremental refreshes only pick up workspaces with new runs, so a periodic full walk is needed to
+	// notice other changes such as settings updates.
+	InventoryFullRefreshInterval = 15 * time.Minute
+
+	// InventoryMinRefreshInterval is the window in which a cached inventory is returned as-is without
+	// contacting the API at all.
+	InventoryMinRefreshInterval = 30 * time.Second
+
+	inventoryPageSize = 100
+)
+
+// Inventory refresh modes reported back to callers
+const (
+	InventoryRefreshFull        = "full"
+	InventoryRefreshIncremental = "incremental"
+	InventoryRefreshCached      = "cached"
+)
+
+// InventoryWorkspace is the cached view of a single workspace in an organization inventory.
+type InventoryWorkspace struct {
+	ID                  string     `json:"id"`
+	Name                string     `json:"workspace_name"`
+	ProjectID           string     `json:"project_id,omitempty"`
+	ExecutionMode       string     `json:"execution_mode"`
+	TerraformVersion    string     `json:"terraform_version"`
+	Locked              bool       `json:"locked"`
+	ResourceCount       int        `json:"resource_count"`
+	TagNames            []string   `json:"tag_names,omitempty"`
+	UpdatedAt           time.Time  `json:"updated_at"`
+	CurrentRunID        string     `json:"current_run_id,omitempty"`
+	CurrentRunStatus    string     `json:"current_run_status,omitempty"`
+	CurrentRunCreatedAt *time.Time `json:"current_run_created_at,omitempty"`
+}
+
+// WorkspaceInventory is a snapshot of every workspace in an organization.
+type WorkspaceInventory struct {
+	Organization string                `json:"organization"`
+	RefreshedAt  time.Time             `json:"refreshed_at"`
+	RefreshMode  string                `json:"refresh_mode"`
+	Workspaces   []*InventoryWorkspace `json:"workspaces"`
+}
+
+type workspaceLister interface {
+	List(ctx context.Context, organization string, options *tfe.WorkspaceListOptions) (*tfe.WorkspaceList, error)
+}
+
+type orgInventory struct {
+	mu              sync.Mutex
+	workspaces      map[string]*InventoryWorkspace
+	refreshedAt     time.Time
+	fullRefreshedAt time.Time
+}
+
+// activeInventories maps session ID -> organization name -> *orgInventory
+var activeInventories sync.Map
+
+// GetWorkspaceInventory returns the workspace inventory for an organization, reusing and incrementally
+// refreshing the inventory cached for the session where possible. Stateless requests (empty session ID)
+// are never cached and always walk the full workspace list.
+func GetWorkspaceInventory(ctx context.Context, tfeClient *tfe.Client, sessionID string, organization string, forceRefresh bool, logger *log.Logger) (*WorkspaceInventory, error) {
+	return getWorkspaceInventory(ctx, tfeClient.Workspaces, sessionID, organization, forceRefresh, time.Now(), logger)
+}
+
+func getWorkspaceInventory(ctx context.Context, lister workspaceLister, sessionID string, organization string, forceRefresh bool, now time.Time, logger *log.Logger) (*WorkspaceInventory, error) {
+	organization = strings.ToLower(strings.TrimSpace(organization))
+
+	if sessionID == "" {
+		inv := &orgInventory{}
+		if err := inv.fullRefresh(ctx, lister, organization, now); err != nil {
+			return nil, err
+		}
+		return inv.snapshot(organization, InventoryRefreshFull), nil
+	}
+
+	sessionValue, _ := activeInventories.LoadOrStore(sessionID, &sync.Map{})
+	orgValue, _ := sessionValue.(*sync.Map).LoadOrStore(organization, &orgInventory{})
+	inv := orgValue.(*orgInventory)
+
+	inv.mu.Lock()
+	defer inv.mu.Unlock()
+
+	// With a shared store, another server instance may have refreshed the inventory more recently
+	store := SharedStore()
+	if store != nil {
+		inv.load(ctx, store, sessionID, organization, logger)
+	}
+
+	snapshot, err := inv.refresh(ctx, lister, organization, forceRefresh, now, logger)
+	if err != nil {
+		return nil, err
+	}
+	if store != nil && snapshot.RefreshMode != InventoryRefreshCached {
+		inv.save(ctx, store, sessionID, organization, logger)
+	}
+	return snapshot, nil
+}
+
+// refresh returns the inventory, refreshing it fully, incrementally or not at all depending on its age
+func (inv *orgInventory) refresh(ctx context.Context, lister workspaceLister, organization string, forceRefresh bool, now time.Time, logger *log.Logger) (*WorkspaceInventory, error) {
+	switch {
+	case forceRefresh || inv.workspaces == nil || now.Sub(inv.fullRefreshedAt) >= InventoryFullRefreshInterval:
+		logger.Debugf("Performing full inventory refresh for organization %s", organization)
+		if err := inv.fullRefresh(ctx, lister, organization, now); err != nil {
+			return nil, err
+		}
+		return inv.snapshot(organization, InventoryRefreshFull), nil
+	case now.Sub(inv.refreshedAt) < InventoryMinRefreshInterval:
+		return inv.snapshot(organization, InventoryRefreshCached), nil
+	default:
+		logger.Debugf("Performing incremental inventory refresh for organization %s", organization)
+		mode, err := inv.incrementalRefresh(ctx, lister, organization, now)
+		if err != nil {
+			return nil, err
+		}
+		return inv.snapshot(organization, mode), nil
+	}
+}
+
+// DeleteWorkspaceInventories removes every cached inventory for the given session
+func DeleteWorkspaceInventories(sessionID string) {
+	activeInventories.Delete(sessionID)
+}
+
+// storedInventory is the state of an organization inventory kept in a shared store
+type storedInventory struct {
+	Workspaces      map[string]*InventoryWorkspace `json:"workspaces"`
+	RefreshedAt     time.Time                      `json:"refreshed_at"`
+	FullRefreshedAt time.Time                      `json:"full_refreshed_at"`
+}
+
+func inventoryStoreKey(sessionID string, organization string) string {
+	return "inventory:" + sessionID + ":" + organization
+}
+
+// load replaces the inventory with the one in the store if the stored one is more recent
+func (inv *orgInventory) load(ctx context.Context, store Store, sessionID string, organization string, logger *log.Logger) {
+	buf, ok, err := store.Get(ctx, inventoryStoreKey(sessionID, organization))
+	if err != nil {
+		logger.Warnf("Failed to load the inventory of organization %s from the store: %v", organization, err)
+		return
+	}
+	if !ok {
+		return
+	}
+	var stored storedInventory
+	if err := json.Unmarshal(buf, &stored); err != nil {
+		logger.Warnf("Ignoring the invalid stored inventory of organization %s: %v", organization, err)
+		return
+	}
+	if stored.Workspaces != nil && stored.RefreshedAt.After(inv.refreshedAt) {
+		inv.workspaces = stored.Workspaces
+		inv.refreshedAt = stored.RefreshedAt
+		inv.fullRefreshedAt = stored.FullRefreshedAt
+	}
+}
+
+// save writes the inventory to the store, where it expires when a full refresh would be due
+func (inv *orgInventory) save(ctx context.Context, store Store, sessionID string, organization string, logger *log.Logger) {
+	buf, err := json.Marshal(storedInventory{
+		Workspaces:      inv.workspaces,
+		RefreshedAt:     inv.refreshedAt,
+		FullRefreshedAt: inv.fullRefreshedAt,
+	})
+	if err != nil {
+		logger.Warnf("Failed to marshal the inventory of organization %s: %v", organization, err)
+		return
+	}
+	if err := store.Set(ctx, inventoryStoreKey(sessionID, organization), buf, InventoryFullRefreshInterval); err != nil {
+		logger.Warnf("Failed to save the inventory of organization %s to the store: %v", organization, err)
+	}
+}
+
+func (inv *orgInventory) fullRefresh(ctx context.Context, lister workspaceLister, organization string, now time.Time) error {
+	workspaces := make(map[string]*InventoryWorkspace)
+	pageNumber := 1
+	for {
+		list, err := lister.List(ctx, organization, &tfe.WorkspaceListOptions{
+			ListOptions: tfe.ListOptions{PageNumber: pageNumber, PageSize: inventoryPageSize},
+			Include:     []tfe.WSIncludeOpt{tfe.WSCurrentRun},
+		})
+		if err != nil {
+			return err
+		}
+		for _, ws := range list.Items {
+			if ws != nil {
+				workspaces[ws.ID] = newInventoryWorkspace(ws)
+			}
+		}
+		if list.Pagination == nil || list.NextPage == 0 {
+			break
+		}
+		pageNumber = list.NextPage
+	}
+
+	inv.workspaces = workspaces
+	inv.refreshedAt = now
+	inv.fullRefreshedAt = now
+	return nil
+}
+
+// incrementalRefresh walks the workspaces ordered by most recent run and stops at the first page
+// that contains no run newer than the previous refresh. If the total workspace count no longer
+// matches the cache, workspaces were created or deleted and a full refresh is performed instead.
+func (inv *orgInventory) incrementalRefresh(ctx context.Context, lister workspaceLister, organization string, now time.Time) (string, error) {
+	since := inv.refreshedAt
+	pageNumber := 1
+	for {
+		list, err := lister.List(ctx, organization, &tfe.WorkspaceListOptions{
+			ListOptions: tfe.ListOptions{PageNumber: pageNumber, PageSize: inventoryPageSize},
+			Include:     []tfe.WSIncludeOpt{tfe.WSCurrentRun},
+			Sort:        "-current-run.created-at",
+		})
+		if err != nil {
+			return "", err
+		}
+		if pageNumber == 1 && list.Pagination != nil && list.TotalCount != len(inv.workspaces) {
+			if err := inv.fullRefresh(ctx, lister, organization, now); err != nil {
+				return "", err
+			}
+			return InventoryRefreshFull, nil
+		}
+
+		changed := false
+		for _, ws := range list.Items {
+			if ws == nil {
+				continue
+			}
+			if ws.CurrentRun != nil && !ws.CurrentRun.CreatedAt.Before(since) {
+				changed = true
+			}
+			inv.workspaces[ws.ID] = newInventoryWorkspace(ws)
+		}
+		if !changed || list.Pagination == nil || list.NextPage == 0 {
+			break
+		}
+		pageNumber = list.NextPage
+	}
+
+	inv.refreshedAt = now
+	return InventoryRefreshIncremental, nil
+}
+
+func (inv *orgInventory) snapshot(organization string, mode string) *WorkspaceInventory {
+	workspaces := make([]*InventoryWorkspace, 0, len(inv.workspaces))
+	for _, ws := range inv.workspaces {
+		copied := *ws
+		workspaces = append(workspaces, &copied)
+	}
+	sort.Slice(workspaces, func(i, j int) bool { return workspaces[i].Name < workspaces[j].Name })
+	return &WorkspaceInventory{
+		Organization: organization,
+		RefreshedAt:  inv.refreshedAt,
+		RefreshMode:  mode,
+		Workspaces:   workspaces,
+	}
+}
+
+func newInventoryWorkspace(ws *tfe.Workspace) *InventoryWorkspace {
+	item := &InventoryWorkspace{
+		ID:               ws.ID,
+		Name:             ws.Name,
+		ExecutionMode:    ws.ExecutionMode,
+		TerraformVersion: ws.TerraformVersion,
+		Locked:           ws.Locked,
+		ResourceCount:    ws.ResourceCount,
+		TagNames:         ws.TagNames,
+		UpdatedAt:        ws.UpdatedAt,
+	}
+	if ws.Project != nil {
+		item.ProjectID = ws.Project.ID
+	}
+	if ws.CurrentRun != nil {
+		item.CurrentRunID = ws.CurrentRun.ID
+		item.CurrentRunStatus = string(ws.CurrentRun.Status)
+		if !ws.CurrentRun.CreatedAt.IsZero() {
+			createdAt := ws.CurrentRun.CreatedAt
+			item.CurrentRunCreatedAt = &createdAt
+		}
+	}
+	return item
+}
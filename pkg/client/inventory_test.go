@@ -0,0 +1,158 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-tfe"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeWorkspaceLister struct {
+	workspaces []*tfe.Workspace
+	calls      []*tfe.WorkspaceListOptions
+}
+
+func (l *fakeWorkspaceLister) List(_ context.Context, _ string, options *tfe.WorkspaceListOptions) (*tfe.WorkspaceList, error) {
+	l.calls = append(l.calls, options)
+
+	items := append([]*tfe.Workspace(nil), l.workspaces...)
+	if options.Sort == "-current-run.created-at" {
+		for i := 1; i < len(items); i++ {
+			for j := i; j > 0 && runCreatedAt(items[j]).After(runCreatedAt(items[j-1])); j-- {
+				items[j], items[j-1] = items[j-1], items[j]
+			}
+		}
+	}
+
+	start := (options.PageNumber - 1) * options.PageSize
+	end := min(start+options.PageSize, len(items))
+	nextPage := 0
+	if end < len(items) {
+		nextPage = options.PageNumber + 1
+	}
+	return &tfe.WorkspaceList{
+		Items: items[start:end],
+		Pagination: &tfe.Pagination{
+			CurrentPage: options.PageNumber,
+			NextPage:    nextPage,
+			TotalCount:  len(items),
+		},
+	}, nil
+}
+
+func runCreatedAt(ws *tfe.Workspace) time.Time {
+	if ws.CurrentRun == nil {
+		return time.Time{}
+	}
+	return ws.CurrentRun.CreatedAt
+}
+
+func newFakeWorkspaces(count int, runCreatedAt time.Time) []*tfe.Workspace {
+	workspaces := make([]*tfe.Workspace, count)
+	for i := range workspaces {
+		workspaces[i] = &tfe.Workspace{
+			ID:   "ws-" + string(rune('a'+i%26)) + string(rune('a'+i/26)),
+			Name: "workspace-" + string(rune('a'+i%26)) + string(rune('a'+i/26)),
+			CurrentRun: &tfe.Run{
+				ID:        "run-old",
+				Status:    tfe.RunApplied,
+				CreatedAt: runCreatedAt,
+			},
+		}
+	}
+	return workspaces
+}
+
+func TestGetWorkspaceInventory(t *testing.T) {
+	logger := log.New()
+	logger.SetLevel(log.ErrorLevel)
+	ctx := context.Background()
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("stateless requests are not cached", func(t *testing.T) {
+		lister := &fakeWorkspaceLister{workspaces: newFakeWorkspaces(3, start)}
+
+		inv, err := getWorkspaceInventory(ctx, lister, "", "my-org", false, start, logger)
+		require.NoError(t, err)
+		assert.Equal(t, InventoryRefreshFull, inv.RefreshMode)
+		assert.Len(t, inv.Workspaces, 3)
+
+		_, ok := activeInventories.Load("")
+		assert.False(t, ok)
+	})
+
+	t.Run("cached, incremental and full refreshes", func(t *testing.T) {
+		sessionID := "inventory-test-session"
+		defer DeleteWorkspaceInventories(sessionID)
+
+		lister := &fakeWorkspaceLister{workspaces: newFakeWorkspaces(250, start.Add(-time.Hour))}
+
+		inv, err := getWorkspaceInventory(ctx, lister, sessionID, "my-org", false, start, logger)
+		require.NoError(t, err)
+		assert.Equal(t, InventoryRefreshFull, inv.RefreshMode)
+		assert.Len(t, inv.Workspaces, 250)
+		assert.Len(t, lister.calls, 3)
+
+		// Within the minimum refresh interval the cache is returned without API calls
+		lister.calls = nil
+		inv, err = getWorkspaceInventory(ctx, lister, sessionID, "my-org", false, start.Add(10*time.Second), logger)
+		require.NoError(t, err)
+		assert.Equal(t, InventoryRefreshCached, inv.RefreshMode)
+		assert.Empty(t, lister.calls)
+
+		// A new run on one workspace is picked up from the first page only
+		lister.workspaces[42].CurrentRun = &tfe.Run{ID: "run-new", Status: tfe.RunPlanning, CreatedAt: start.Add(time.Minute)}
+		inv, err = getWorkspaceInventory(ctx, lister, sessionID, "my-org", false, start.Add(2*time.Minute), logger)
+		require.NoError(t, err)
+		assert.Equal(t, InventoryRefreshIncremental, inv.RefreshMode)
+		assert.Len(t, lister.calls, 2)
+		for _, ws := range inv.Workspaces {
+			if ws.ID == lister.workspaces[42].ID {
+				assert.Equal(t, "run-new", ws.CurrentRunID)
+				assert.Equal(t, string(tfe.RunPlanning), ws.CurrentRunStatus)
+			}
+		}
+
+		// A change in the workspace count falls back to a full refresh
+		lister.calls = nil
+		lister.workspaces = lister.workspaces[:200]
+		inv, err = getWorkspaceInventory(ctx, lister, sessionID, "my-org", false, start.Add(4*time.Minute), logger)
+		require.NoError(t, err)
+		assert.Equal(t, InventoryRefreshFull, inv.RefreshMode)
+		assert.Len(t, inv.Workspaces, 200)
+
+		// force_refresh always walks everything again
+		lister.calls = nil
+		inv, err = getWorkspaceInventory(ctx, lister, sessionID, "my-org", true, start.Add(4*time.Minute+time.Second), logger)
+		require.NoError(t, err)
+		assert.Equal(t, InventoryRefreshFull, inv.RefreshMode)
+		assert.Len(t, lister.calls, 2)
+	})
+	t.Run("shared store", func(t *testing.T) {
+		sessionID := "inventory-shared-session"
+		defer DeleteWorkspaceInventories(sessionID)
+		SetSharedStore(newRegistryCache(RegistryCacheConfig{TTL: time.Hour, MaxEntries: 10}))
+		defer SetSharedStore(nil)
+
+		lister := &fakeWorkspaceLister{workspaces: newFakeWorkspaces(3, start.Add(-time.Hour))}
+		inv, err := getWorkspaceInventory(ctx, lister, sessionID, "my-org", false, start, logger)
+		require.NoError(t, err)
+		assert.Equal(t, InventoryRefreshFull, inv.RefreshMode)
+
+		// Another instance without the inventory in memory reuses the stored one
+		DeleteWorkspaceInventories(sessionID)
+		lister.calls = nil
+		inv, err = getWorkspaceInventory(ctx, lister, sessionID, "my-org", false, start.Add(10*time.Second), logger)
+		require.NoError(t, err)
+		assert.Equal(t, InventoryRefreshCached, inv.RefreshMode)
+		assert.Len(t, inv.Workspaces, 3)
+		assert.Empty(t, lister.calls)
+	})
+}
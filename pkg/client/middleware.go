@@ -190,10 +190,10 @@ func OrganizationAllowlistMiddleware(allowlist []string, logger *log.Logger) fun
 				return
 			}
 
-			token := strings.TrimSpace(getTokenFromAuthHeader(r))
+			token := getRequestToken(r)
 			if token == "" {
-				logger.Warn("Rejecting request: organization allowlist requires Authorization bearer token")
-				http.Error(w, "Authorization bearer token is required", http.StatusUnauthorized)
+				logger.Warn("Rejecting request: organization allowlist requires an X-TFC-Token header or Authorization bearer token")
+				http.Error(w, "X-TFC-Token header or Authorization bearer token is required", http.StatusUnauthorized)
 				return
 			}
 
@@ -277,6 +277,15 @@ func getTokenFromAuthHeader(r *http.Request) string {
 	return ""
 }
 
+// getRequestToken returns the Terraform token of a request: the X-TFC-Token header, which gateways
+// that use the Authorization header for their own authentication set, or else the Authorization bearer token
+func getRequestToken(r *http.Request) string {
+	if token := strings.TrimSpace(r.Header.Get(TerraformTokenHeader)); token != "" {
+		return token
+	}
+	return strings.TrimSpace(getTokenFromAuthHeader(r))
+}
+
 // TerraformContextMiddleware adds Terraform-related header values to the request context
 // This middleware extracts Terraform configuration from HTTP headers, query parameters,
 // or environment variables and adds them to the request context for use by MCP tools
@@ -306,10 +315,10 @@ func TerraformContextMiddleware(logger *log.Logger) func(http.Handler) http.Hand
 			for _, header := range clientHeaders {
 				var headerValue string
 
-				// The allowlist validates the Authorization bearer token, so it must
-				// also be the token used for downstream Terraform API requests.
+				// The allowlist validates the X-TFC-Token or Authorization bearer token, so
+				// it must also be the token used for downstream Terraform API requests.
 				if header == TerraformToken {
-					headerValue = getTokenFromAuthHeader(r)
+					headerValue = getRequestToken(r)
 				}
 
 				if headerValue == "" {
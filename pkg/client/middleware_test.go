@@ -531,6 +531,19 @@ func TestOrganizationAllowlistUsesValidatedTokenDownstream(t *testing.T) {
 	assert.Equal(t, http.StatusOK, rr.Code)
 	assert.Equal(t, "Bearer allowed-token", upstreamAuthorization)
 	assert.Equal(t, "allowed-token", downstreamToken)
+
+	// Behind a gateway that uses the Authorization header for its own authentication, the
+	// X-TFC-Token is validated and used, and the gateway credential is never sent upstream
+	req = httptest.NewRequest(http.MethodPost, "/mcp", nil)
+	req.Header.Set("Authorization", "Bearer gateway-credential")
+	req.Header.Set(TerraformTokenHeader, "allowed-token")
+	rr = httptest.NewRecorder()
+
+	handler.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "Bearer allowed-token", upstreamAuthorization)
+	assert.Equal(t, "allowed-token", downstreamToken)
 }
 
 func TestTokenHasAllowedOrganization(t *testing.T) {
@@ -761,6 +774,46 @@ func TestTerraformContextMiddleware(t *testing.T) {
 				TerraformToken: "bearer-token",
 			},
 		},
+		{
+			name: "X-TFC-Token header provides token",
+			headers: map[string]string{
+				TerraformTokenHeader: "gateway-token",
+			},
+			queryParams: map[string]string{},
+			envVars: map[string]string{
+				TerraformToken: "env-token",
+			},
+			expectedStatus: http.StatusOK,
+			expectedContextVals: map[string]string{
+				TerraformToken: "gateway-token",
+			},
+		},
+		{
+			name: "X-TFC-Token takes priority over standard header",
+			headers: map[string]string{
+				TerraformToken:       "standard-token",
+				TerraformTokenHeader: "gateway-token",
+			},
+			queryParams:    map[string]string{},
+			envVars:        map[string]string{},
+			expectedStatus: http.StatusOK,
+			expectedContextVals: map[string]string{
+				TerraformToken: "gateway-token",
+			},
+		},
+		{
+			name: "X-TFC-Token takes priority over the gateway's Authorization bearer",
+			headers: map[string]string{
+				"Authorization":      "Bearer gateway-credential",
+				TerraformTokenHeader: "terraform-token",
+			},
+			queryParams:    map[string]string{},
+			envVars:        map[string]string{},
+			expectedStatus: http.StatusOK,
+			expectedContextVals: map[string]string{
+				TerraformToken: "terraform-token",
+			},
+		},
 		{
 			name:    "query params take priority over env vars (except token)",
 			headers: map[string]string{},
@@ -0,0 +1,295 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-tfe"
+)
+
+const notificationConfigurationPageSize = 100
+
+// NotificationDestinationTypes are the destination types of notification configurations
+var NotificationDestinationTypes = []string{
+	string(tfe.NotificationDestinationTypeSlack),
+	string(tfe.NotificationDestinationTypeGeneric),
+	string(tfe.NotificationDestinationTypeEmail),
+	string(tfe.NotificationDestinationTypeMicrosoftTeams),
+}
+
+// NotificationTriggers are the events that can trigger workspace notifications
+var NotificationTriggers = []string{
+	string(tfe.NotificationTriggerCreated),
+	string(tfe.NotificationTriggerPlanning),
+	string(tfe.NotificationTriggerNeedsAttention),
+	string(tfe.NotificationTriggerApplying),
+	string(tfe.NotificationTriggerCompleted),
+	string(tfe.NotificationTriggerErrored),
+	string(tfe.NotificationTriggerAssessmentDrifted),
+	string(tfe.NotificationTriggerAssessmentFailed),
+	string(tfe.NotificationTriggerAssessmentCheckFailed),
+	string(tfe.NotificationTriggerWorkspaceAutoDestroyReminder),
+	string(tfe.NotificationTriggerWorkspaceAutoDestroyRunResults),
+}
+
+// NotificationConfigurationInfo is a notification configuration of a workspace. The URL of Slack and
+// Microsoft Teams webhooks is reduced to its host, as the full URL grants access to post messages.
+type NotificationConfigurationInfo struct {
+	ID              string   `json:"id"`
+	Name            string   `json:"name"`
+	DestinationType string   `json:"destination_type"`
+	Enabled         bool     `json:"enabled"`
+	URL             string   `json:"url,omitempty"`
+	Triggers        []string `json:"triggers"`
+	EmailAddresses  []string `json:"email_addresses,omitempty"`
+	EmailUserIDs    []string `json:"email_user_ids,omitempty"`
+}
+
+// NotificationConfigurationSpec describes a notification configuration to create or update. When
+// updating, empty fields are left unchanged.
+type NotificationConfigurationSpec struct {
+	Name            string
+	DestinationType string
+	URL             string
+	Token           string
+	Triggers        []string
+	EmailAddresses  []string
+	EmailUserIDs    []string
+	Enabled         *bool
+}
+
+// notificationConfigurationSource is the TFE service used to manage notification configurations
+type notificationConfigurationSource interface {
+	List(ctx context.Context, subscribableID string, options *tfe.NotificationConfigurationListOptions) (*tfe.NotificationConfigurationList, error)
+	Read(ctx context.Context, notificationConfigurationID string) (*tfe.NotificationConfiguration, error)
+	Create(ctx context.Context, subscribableID string, options tfe.NotificationConfigurationCreateOptions) (*tfe.NotificationConfiguration, error)
+	Update(ctx context.Context, notificationConfigurationID string, options tfe.NotificationConfigurationUpdateOptions) (*tfe.NotificationConfiguration, error)
+}
+
+// ListNotificationConfigurations lists the notification configurations of a workspace
+func ListNotificationConfigurations(ctx context.Context, tfeClient *tfe.Client, workspaceID string) ([]*NotificationConfigurationInfo, error) {
+	return listNotificationConfigurations(ctx, tfeClient.NotificationConfigurations, workspaceID)
+}
+
+// CreateNotificationConfiguration creates a notification configuration on a workspace
+func CreateNotificationConfiguration(ctx context.Context, tfeClient *tfe.Client, workspaceID string, spec NotificationConfigurationSpec) (*NotificationConfigurationInfo, error) {
+	return createNotificationConfiguration(ctx, tfeClient.NotificationConfigurations, workspaceID, spec)
+}
+
+// UpdateNotificationConfiguration updates the non-empty fields of a notification configuration
+func UpdateNotificationConfiguration(ctx context.Context, tfeClient *tfe.Client, notificationConfigurationID string, spec NotificationConfigurationSpec) (*NotificationConfigurationInfo, error) {
+	return updateNotificationConfiguration(ctx, tfeClient.NotificationConfigurations, notificationConfigurationID, spec)
+}
+
+// DeleteNotificationConfiguration deletes a notification configuration
+func DeleteNotificationConfiguration(ctx context.Context, tfeClient *tfe.Client, notificationConfigurationID string) error {
+	return tfeClient.NotificationConfigurations.Delete(ctx, notificationConfigurationID)
+}
+
+func listNotificationConfigurations(ctx context.Context, source notificationConfigurationSource, workspaceID string) ([]*NotificationConfigurationInfo, error) {
+	configurations := []*NotificationConfigurationInfo{}
+	options := &tfe.NotificationConfigurationListOptions{
+		ListOptions: tfe.ListOptions{PageNumber: 1, PageSize: notificationConfigurationPageSize},
+	}
+	for {
+		page, err := source.List(ctx, workspaceID, options)
+		if err != nil {
+			return nil, err
+		}
+		for _, nc := range page.Items {
+			configurations = append(configurations, notificationConfigurationInfo(nc))
+		}
+		if page.Pagination == nil || page.NextPage == 0 {
+			break
+		}
+		options.PageNumber = page.NextPage
+	}
+
+	sort.Slice(configurations, func(i, j int) bool { return configurations[i].Name < configurations[j].Name })
+	return configurations, nil
+}
+
+func createNotificationConfiguration(ctx context.Context, source notificationConfigurationSource, workspaceID string, spec NotificationConfigurationSpec) (*NotificationConfigurationInfo, error) {
+	options, err := notificationConfigurationCreateOptions(workspaceID, spec)
+	if err != nil {
+		return nil, err
+	}
+	nc, err := source.Create(ctx, workspaceID, options)
+	if err != nil {
+		return nil, err
+	}
+	return notificationConfigurationInfo(nc), nil
+}
+
+func updateNotificationConfiguration(ctx context.Context, source notificationConfigurationSource, notificationConfigurationID string, spec NotificationConfigurationSpec) (*NotificationConfigurationInfo, error) {
+	// The destination type cannot be changed, but it determines which fields may be updated
+	current, err := source.Read(ctx, notificationConfigurationID)
+	if err != nil {
+		return nil, err
+	}
+	options, err := notificationConfigurationUpdateOptions(current.DestinationType, spec)
+	if err != nil {
+		return nil, err
+	}
+	nc, err := source.Update(ctx, notificationConfigurationID, options)
+	if err != nil {
+		return nil, err
+	}
+	return notificationConfigurationInfo(nc), nil
+}
+
+// notificationConfigurationCreateOptions validates a notification configuration to create. Slack,
+// Microsoft Teams and generic webhooks need a URL, and emails need recipients. Notifications are
+// enabled unless the spec disables them.
+func notificationConfigurationCreateOptions(workspaceID string, spec NotificationConfigurationSpec) (tfe.NotificationConfigurationCreateOptions, error) {
+	if spec.Name == "" {
+		return tfe.NotificationConfigurationCreateOptions{}, fmt.Errorf("a name is required")
+	}
+	destinationType := tfe.NotificationDestinationType(spec.DestinationType)
+	if !slices.Contains(NotificationDestinationTypes, spec.DestinationType) {
+		return tfe.NotificationConfigurationCreateOptions{}, fmt.Errorf("invalid destination type '%s' - must be one of %s", spec.DestinationType, strings.Join(NotificationDestinationTypes, ", "))
+	}
+	if err := validateNotificationDestination(destinationType, spec); err != nil {
+		return tfe.NotificationConfigurationCreateOptions{}, err
+	}
+	if destinationType != tfe.NotificationDestinationTypeEmail && spec.URL == "" {
+		return tfe.NotificationConfigurationCreateOptions{}, fmt.Errorf("a URL is required for %s notifications", destinationType)
+	}
+	if destinationType == tfe.NotificationDestinationTypeEmail && len(spec.EmailAddresses) == 0 && len(spec.EmailUserIDs) == 0 {
+		return tfe.NotificationConfigurationCreateOptions{}, fmt.Errorf("email notifications need email addresses or user IDs")
+	}
+	triggers, err := notificationTriggers(spec.Triggers)
+	if err != nil {
+		return tfe.NotificationConfigurationCreateOptions{}, err
+	}
+
+	enabled := true
+	if spec.Enabled != nil {
+		enabled = *spec.Enabled
+	}
+	options := tfe.NotificationConfigurationCreateOptions{
+		Name:               tfe.String(spec.Name),
+		DestinationType:    &destinationType,
+		Enabled:            &enabled,
+		Triggers:           triggers,
+		EmailAddresses:     spec.EmailAddresses,
+		EmailUsers:         notificationEmailUsers(spec.EmailUserIDs),
+		SubscribableChoice: &tfe.NotificationConfigurationSubscribableChoice{Workspace: &tfe.Workspace{ID: workspaceID}},
+	}
+	if spec.URL != "" {
+		options.URL = tfe.String(spec.URL)
+	}
+	if spec.Token != "" {
+		options.Token = tfe.String(spec.Token)
+	}
+	return options, nil
+}
+
+// notificationConfigurationUpdateOptions validates the changes to a notification configuration
+func notificationConfigurationUpdateOptions(destinationType tfe.NotificationDestinationType, spec NotificationConfigurationSpec) (tfe.NotificationConfigurationUpdateOptions, error) {
+	if spec.DestinationType != "" && spec.DestinationType != string(destinationType) {
+		return tfe.NotificationConfigurationUpdateOptions{}, fmt.Errorf("the destination type of a notification configuration cannot be changed from %s to %s - create a new notification configuration instead", destinationType, spec.DestinationType)
+	}
+	if err := validateNotificationDestination(destinationType, spec); err != nil {
+		return tfe.NotificationConfigurationUpdateOptions{}, err
+	}
+	triggers, err := notificationTriggers(spec.Triggers)
+	if err != nil {
+		return tfe.NotificationConfigurationUpdateOptions{}, err
+	}
+
+	options := tfe.NotificationConfigurationUpdateOptions{
+		Enabled:        spec.Enabled,
+		Triggers:       triggers,
+		EmailAddresses: spec.EmailAddresses,
+		EmailUsers:     notificationEmailUsers(spec.EmailUserIDs),
+	}
+	if spec.Name != "" {
+		options.Name = tfe.String(spec.Name)
+	}
+	if spec.URL != "" {
+		options.URL = tfe.String(spec.URL)
+	}
+	if spec.Token != "" {
+		options.Token = tfe.String(spec.Token)
+	}
+	return options, nil
+}
+
+// validateNotificationDestination refuses fields that do not apply to the destination type
+func validateNotificationDestination(destinationType tfe.NotificationDestinationType, spec NotificationConfigurationSpec) error {
+	if destinationType == tfe.NotificationDestinationTypeEmail {
+		if spec.URL != "" || spec.Token != "" {
+			return fmt.Errorf("email notifications do not use a URL or token")
+		}
+		return nil
+	}
+	if len(spec.EmailAddresses) > 0 || len(spec.EmailUserIDs) > 0 {
+		return fmt.Errorf("email addresses and user IDs only apply to email notifications, not %s", destinationType)
+	}
+	if spec.Token != "" && destinationType != tfe.NotificationDestinationTypeGeneric {
+		return fmt.Errorf("a token only applies to generic webhook notifications, not %s", destinationType)
+	}
+	if spec.URL != "" {
+		if parsed, err := url.Parse(spec.URL); err != nil || parsed.Scheme != "https" && parsed.Scheme != "http" || parsed.Host == "" {
+			return fmt.Errorf("invalid notification URL '%s' - must be an http or https URL", spec.URL)
+		}
+	}
+	return nil
+}
+
+func notificationTriggers(values []string) ([]tfe.NotificationTriggerType, error) {
+	triggers := make([]tfe.NotificationTriggerType, 0, len(values))
+	for _, value := range values {
+		if !slices.Contains(NotificationTriggers, value) {
+			return nil, fmt.Errorf("invalid notification trigger '%s' - must be one of %s", value, strings.Join(NotificationTriggers, ", "))
+		}
+		triggers = append(triggers, tfe.NotificationTriggerType(value))
+	}
+	if len(triggers) == 0 {
+		return nil, nil
+	}
+	return triggers, nil
+}
+
+func notificationEmailUsers(userIDs []string) []*tfe.User {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	users := make([]*tfe.User, 0, len(userIDs))
+	for _, id := range userIDs {
+		users = append(users, &tfe.User{ID: id})
+	}
+	return users
+}
+
+func notificationConfigurationInfo(nc *tfe.NotificationConfiguration) *NotificationConfigurationInfo {
+	info := &NotificationConfigurationInfo{
+		ID:              nc.ID,
+		Name:            nc.Name,
+		DestinationType: string(nc.DestinationType),
+		Enabled:         nc.Enabled,
+		URL:             nc.URL,
+		Triggers:        nc.Triggers,
+		EmailAddresses:  nc.EmailAddresses,
+	}
+	if info.Triggers == nil {
+		info.Triggers = []string{}
+	}
+	if nc.DestinationType == tfe.NotificationDestinationTypeSlack || nc.DestinationType == tfe.NotificationDestinationTypeMicrosoftTeams {
+		if parsed, err := url.Parse(nc.URL); err == nil && parsed.Host != "" {
+			info.URL = parsed.Scheme + "://" + parsed.Host + "/..."
+		}
+	}
+	for _, user := range nc.EmailUsers {
+		info.EmailUserIDs = append(info.EmailUserIDs, user.ID)
+	}
+	return info
+}
@@ -0,0 +1,116 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeNotificationConfigurations struct {
+	pages   [][]*tfe.NotificationConfiguration
+	current *tfe.NotificationConfiguration
+	updated *tfe.NotificationConfigurationUpdateOptions
+}
+
+func (f *fakeNotificationConfigurations) List(_ context.Context, _ string, options *tfe.NotificationConfigurationListOptions) (*tfe.NotificationConfigurationList, error) {
+	index := options.PageNumber - 1
+	list := &tfe.NotificationConfigurationList{Items: f.pages[index], Pagination: &tfe.Pagination{}}
+	if index+1 < len(f.pages) {
+		list.NextPage = options.PageNumber + 1
+	}
+	return list, nil
+}
+
+func (f *fakeNotificationConfigurations) Read(context.Context, string) (*tfe.NotificationConfiguration, error) {
+	return f.current, nil
+}
+
+func (f *fakeNotificationConfigurations) Create(_ context.Context, _ string, options tfe.NotificationConfigurationCreateOptions) (*tfe.NotificationConfiguration, error) {
+	return &tfe.NotificationConfiguration{ID: "nc-new", Name: *options.Name, DestinationType: *options.DestinationType, Enabled: *options.Enabled}, nil
+}
+
+func (f *fakeNotificationConfigurations) Update(_ context.Context, _ string, options tfe.NotificationConfigurationUpdateOptions) (*tfe.NotificationConfiguration, error) {
+	f.updated = &options
+	return f.current, nil
+}
+
+func TestListNotificationConfigurations(t *testing.T) {
+	source := &fakeNotificationConfigurations{pages: [][]*tfe.NotificationConfiguration{
+		{{ID: "nc-2", Name: "slack", DestinationType: tfe.NotificationDestinationTypeSlack, URL: "https://hooks.slack.com/services/T0/B0/secret", Triggers: []string{"run:errored"}}},
+		{{ID: "nc-1", Name: "email", DestinationType: tfe.NotificationDestinationTypeEmail, EmailUsers: []*tfe.User{{ID: "user-1"}}}},
+	}}
+
+	configurations, err := listNotificationConfigurations(context.Background(), source, "ws-1")
+	require.NoError(t, err)
+	require.Len(t, configurations, 2)
+	assert.Equal(t, "email", configurations[0].Name)
+	assert.Equal(t, []string{"user-1"}, configurations[0].EmailUserIDs)
+	assert.Equal(t, []string{}, configurations[0].Triggers)
+	assert.Equal(t, "https://hooks.slack.com/...", configurations[1].URL)
+}
+
+func TestNotificationConfigurationCreateOptions(t *testing.T) {
+	t.Run("slack", func(t *testing.T) {
+		options, err := notificationConfigurationCreateOptions("ws-1", NotificationConfigurationSpec{
+			Name:            "alerts",
+			DestinationType: "slack",
+			URL:             "https://hooks.slack.com/services/T0/B0/secret",
+			Triggers:        []string{"run:errored", "assessment:drifted"},
+		})
+		require.NoError(t, err)
+		assert.True(t, *options.Enabled)
+		assert.Equal(t, []tfe.NotificationTriggerType{tfe.NotificationTriggerErrored, tfe.NotificationTriggerAssessmentDrifted}, options.Triggers)
+		assert.Equal(t, "ws-1", options.SubscribableChoice.Workspace.ID)
+	})
+
+	t.Run("email users", func(t *testing.T) {
+		options, err := notificationConfigurationCreateOptions("ws-1", NotificationConfigurationSpec{
+			Name:            "alerts",
+			DestinationType: "email",
+			EmailUserIDs:    []string{"user-1"},
+		})
+		require.NoError(t, err)
+		require.Len(t, options.EmailUsers, 1)
+		assert.Equal(t, "user-1", options.EmailUsers[0].ID)
+		assert.Nil(t, options.URL)
+	})
+
+	invalid := map[string]NotificationConfigurationSpec{
+		"unknown destination type": {Name: "alerts", DestinationType: "pager"},
+		"webhook without url":      {Name: "alerts", DestinationType: "generic"},
+		"email without recipients": {Name: "alerts", DestinationType: "email"},
+		"email with url":           {Name: "alerts", DestinationType: "email", URL: "https://example.com", EmailUserIDs: []string{"user-1"}},
+		"slack with token":         {Name: "alerts", DestinationType: "slack", URL: "https://hooks.slack.com/x", Token: "secret"},
+		"invalid url":              {Name: "alerts", DestinationType: "generic", URL: "example.com/hook"},
+		"unknown trigger":          {Name: "alerts", DestinationType: "generic", URL: "https://example.com", Triggers: []string{"run:exploded"}},
+	}
+	for name, spec := range invalid {
+		t.Run(name, func(t *testing.T) {
+			_, err := notificationConfigurationCreateOptions("ws-1", spec)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestUpdateNotificationConfiguration(t *testing.T) {
+	source := &fakeNotificationConfigurations{current: &tfe.NotificationConfiguration{ID: "nc-1", DestinationType: tfe.NotificationDestinationTypeGeneric}}
+
+	enabled := false
+	_, err := updateNotificationConfiguration(context.Background(), source, "nc-1", NotificationConfigurationSpec{Enabled: &enabled, Triggers: []string{"run:completed"}})
+	require.NoError(t, err)
+	require.NotNil(t, source.updated)
+	assert.False(t, *source.updated.Enabled)
+	assert.Nil(t, source.updated.Name)
+	assert.Equal(t, []tfe.NotificationTriggerType{tfe.NotificationTriggerCompleted}, source.updated.Triggers)
+
+	source.updated = nil
+	_, err = updateNotificationConfiguration(context.Background(), source, "nc-1", NotificationConfigurationSpec{DestinationType: "slack"})
+	assert.ErrorContains(t, err, "cannot be changed")
+	assert.Nil(t, source.updated)
+}
@@ -0,0 +1,183 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/go-tfe"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	policyOverridePageSize = 100
+	policyOverrideMaxPages = 50
+)
+
+// PolicyOverride is a soft-mandatory policy failure that was overridden on a run
+type PolicyOverride struct {
+	OverriddenAt   time.Time `json:"overridden_at"`
+	OverriddenBy   string    `json:"overridden_by"`
+	ActorType      string    `json:"actor_type"`
+	AccessorID     string    `json:"accessor_id"`
+	ImpersonatorID string    `json:"impersonator_id,omitempty"`
+	ResourceType   string    `json:"resource_type"`
+	ResourceID     string    `json:"resource_id"`
+	RunID          string    `json:"run_id,omitempty"`
+	WorkspaceID    string    `json:"workspace_id,omitempty"`
+	WorkspaceName  string    `json:"workspace_name,omitempty"`
+	SoftFailed     *int      `json:"soft_failed_policies,omitempty"`
+	AdvisoryFailed *int      `json:"advisory_failed_policies,omitempty"`
+	Error          string    `json:"error,omitempty"`
+}
+
+// PolicyOverrideReport lists the policy overrides found in the audit trail
+type PolicyOverrideReport struct {
+	Since     time.Time         `json:"since"`
+	Overrides []*PolicyOverride `json:"overrides"`
+	Truncated bool              `json:"truncated,omitempty"`
+}
+
+// policyOverrideSources are the TFE services used to build a policy override report
+type policyOverrideSources struct {
+	auditTrails interface {
+		List(ctx context.Context, options *tfe.AuditTrailListOptions) (*tfe.AuditTrailList, error)
+	}
+	policyChecks interface {
+		Read(ctx context.Context, policyCheckID string) (*tfe.PolicyCheck, error)
+	}
+	taskStages interface {
+		Read(ctx context.Context, taskStageID string, options *tfe.TaskStageReadOptions) (*tfe.TaskStage, error)
+	}
+	runs interface {
+		ReadWithOptions(ctx context.Context, runID string, options *tfe.RunReadOptions) (*tfe.Run, error)
+	}
+}
+
+// ListPolicyOverrides reports the Sentinel policy check and policy evaluation task stage overrides
+// recorded in the organization audit trail since the given time, enriched with the run and
+// workspace they apply to. The audit trail requires an organization token.
+func ListPolicyOverrides(ctx context.Context, tfeClient *tfe.Client, since time.Time, logger *log.Logger) (*PolicyOverrideReport, error) {
+	return listPolicyOverrides(ctx, policyOverrideSources{
+		auditTrails:  tfeClient.AuditTrails,
+		policyChecks: tfeClient.PolicyChecks,
+		taskStages:   tfeClient.TaskStages,
+		runs:         tfeClient.Runs,
+	}, since, logger)
+}
+
+func listPolicyOverrides(ctx context.Context, sources policyOverrideSources, since time.Time, logger *log.Logger) (*PolicyOverrideReport, error) {
+	report := &PolicyOverrideReport{Since: since, Overrides: []*PolicyOverride{}}
+
+	options := &tfe.AuditTrailListOptions{
+		Since:       since,
+		ListOptions: &tfe.ListOptions{PageNumber: 1, PageSize: policyOverridePageSize},
+	}
+	for {
+		events, err := sources.auditTrails.List(ctx, options)
+		if err != nil {
+			return nil, err
+		}
+		for _, event := range events.Items {
+			if isPolicyOverrideEvent(event) {
+				report.Overrides = append(report.Overrides, newPolicyOverride(event))
+			}
+		}
+
+		if events.AuditTrailPagination == nil || events.NextPage == 0 {
+			break
+		}
+		if options.PageNumber >= policyOverrideMaxPages {
+			report.Truncated = true
+			break
+		}
+		options.PageNumber = events.NextPage
+	}
+
+	runs := make(map[string]*tfe.Run)
+	for _, override := range report.Overrides {
+		if err := sources.resolveRun(ctx, override, runs); err != nil {
+			logger.Debugf("failed to resolve the run of policy override %s: %v", override.ResourceID, err)
+			override.Error = err.Error()
+		}
+	}
+
+	sort.SliceStable(report.Overrides, func(i, j int) bool {
+		return report.Overrides[i].OverriddenAt.After(report.Overrides[j].OverriddenAt)
+	})
+	return report, nil
+}
+
+// isPolicyOverrideEvent reports whether an audit trail event is the override of a policy check or task stage
+func isPolicyOverrideEvent(event *tfe.AuditTrail) bool {
+	if event.Resource.Action != "override" {
+		return false
+	}
+	switch event.Resource.Type {
+	case "policy_check", "task_stage":
+		return true
+	}
+	return false
+}
+
+func newPolicyOverride(event *tfe.AuditTrail) *PolicyOverride {
+	override := &PolicyOverride{
+		OverriddenAt: event.Timestamp,
+		OverriddenBy: event.Auth.Description,
+		ActorType:    event.Auth.Type,
+		AccessorID:   event.Auth.AccessorID,
+		ResourceType: event.Resource.Type,
+		ResourceID:   event.Resource.ID,
+	}
+	if event.Auth.ImpersonatorID != nil {
+		override.ImpersonatorID = *event.Auth.ImpersonatorID
+	}
+	return override
+}
+
+// resolveRun fills in the run, workspace and policy results of an override
+func (s policyOverrideSources) resolveRun(ctx context.Context, override *PolicyOverride, runs map[string]*tfe.Run) error {
+	switch override.ResourceType {
+	case "policy_check":
+		check, err := s.policyChecks.Read(ctx, override.ResourceID)
+		if err != nil {
+			return err
+		}
+		if check.Result != nil {
+			override.SoftFailed = &check.Result.SoftFailed
+			override.AdvisoryFailed = &check.Result.AdvisoryFailed
+		}
+		if check.Run != nil {
+			override.RunID = check.Run.ID
+		}
+	case "task_stage":
+		stage, err := s.taskStages.Read(ctx, override.ResourceID, nil)
+		if err != nil {
+			return err
+		}
+		if stage.Run != nil {
+			override.RunID = stage.Run.ID
+		}
+	}
+	if override.RunID == "" {
+		return nil
+	}
+
+	run, ok := runs[override.RunID]
+	if !ok {
+		var err error
+		run, err = s.runs.ReadWithOptions(ctx, override.RunID, &tfe.RunReadOptions{Include: []tfe.RunIncludeOpt{tfe.RunWorkspace}})
+		if err != nil {
+			return err
+		}
+		runs[override.RunID] = run
+	}
+	if run.Workspace != nil {
+		override.WorkspaceID = run.Workspace.ID
+		override.WorkspaceName = run.Workspace.Name
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-tfe"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeAuditTrails struct {
+	pages []*tfe.AuditTrailList
+}
+
+func (f *fakeAuditTrails) List(_ context.Context, options *tfe.AuditTrailListOptions) (*tfe.AuditTrailList, error) {
+	return f.pages[options.PageNumber-1], nil
+}
+
+type fakePolicyChecks map[string]*tfe.PolicyCheck
+
+func (f fakePolicyChecks) Read(_ context.Context, id string) (*tfe.PolicyCheck, error) {
+	if check, ok := f[id]; ok {
+		return check, nil
+	}
+	return nil, errors.New("not found")
+}
+
+type fakeTaskStages map[string]*tfe.TaskStage
+
+func (f fakeTaskStages) Read(_ context.Context, id string, _ *tfe.TaskStageReadOptions) (*tfe.TaskStage, error) {
+	return f[id], nil
+}
+
+type fakeRuns struct {
+	runs  map[string]*tfe.Run
+	reads int
+}
+
+func (f *fakeRuns) ReadWithOptions(_ context.Context, id string, _ *tfe.RunReadOptions) (*tfe.Run, error) {
+	f.reads++
+	return f.runs[id], nil
+}
+
+func TestListPolicyOverrides(t *testing.T) {
+	logger := log.New()
+	logger.SetLevel(log.ErrorLevel)
+	first := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+	second := first.Add(time.Hour)
+
+	runs := &fakeRuns{runs: map[string]*tfe.Run{
+		"run-1": {ID: "run-1", Workspace: &tfe.Workspace{ID: "ws-1", Name: "prod-network"}},
+	}}
+	sources := policyOverrideSources{
+		auditTrails: &fakeAuditTrails{pages: []*tfe.AuditTrailList{
+			{
+				AuditTrailPagination: &tfe.AuditTrailPagination{CurrentPage: 1, NextPage: 2, TotalPages: 2},
+				Items: []*tfe.AuditTrail{
+					{Timestamp: first, Auth: tfe.AuditTrailAuth{Description: "jane", Type: "Client", AccessorID: "user-jane"}, Resource: tfe.AuditTrailResource{ID: "polchk-1", Type: "policy_check", Action: "override"}},
+					{Timestamp: first, Resource: tfe.AuditTrailResource{ID: "polchk-1", Type: "policy_check", Action: "create"}},
+				},
+			},
+			{
+				AuditTrailPagination: &tfe.AuditTrailPagination{CurrentPage: 2, TotalPages: 2},
+				Items: []*tfe.AuditTrail{
+					{Timestamp: second, Auth: tfe.AuditTrailAuth{Description: "john"}, Resource: tfe.AuditTrailResource{ID: "ts-1", Type: "task_stage", Action: "override"}},
+					{Timestamp: second.Add(time.Minute), Resource: tfe.AuditTrailResource{ID: "polchk-gone", Type: "policy_check", Action: "override"}},
+				},
+			},
+		}},
+		policyChecks: fakePolicyChecks{
+			"polchk-1": {ID: "polchk-1", Result: &tfe.PolicyResult{SoftFailed: 2}, Run: &tfe.Run{ID: "run-1"}},
+		},
+		taskStages: fakeTaskStages{
+			"ts-1": {ID: "ts-1", Run: &tfe.Run{ID: "run-1"}},
+		},
+		runs: runs,
+	}
+
+	report, err := listPolicyOverrides(context.Background(), sources, first.Add(-time.Hour), logger)
+	require.NoError(t, err)
+	require.Len(t, report.Overrides, 3)
+
+	// Newest first, unresolvable overrides are reported with an error
+	assert.Equal(t, "polchk-gone", report.Overrides[0].ResourceID)
+	assert.Equal(t, "not found", report.Overrides[0].Error)
+
+	assert.Equal(t, "john", report.Overrides[1].OverriddenBy)
+	assert.Equal(t, "run-1", report.Overrides[1].RunID)
+
+	override := report.Overrides[2]
+	assert.Equal(t, "jane", override.OverriddenBy)
+	assert.Equal(t, "run-1", override.RunID)
+	assert.Equal(t, "ws-1", override.WorkspaceID)
+	assert.Equal(t, "prod-network", override.WorkspaceName)
+	assert.Equal(t, 2, *override.SoftFailed)
+
+	// Runs are read once
+	assert.Equal(t, 1, runs.reads)
+}
@@ -0,0 +1,215 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-tfe"
+)
+
+const policyResultsPageSize = 100
+
+// PolicyCheckResult is the result of a Sentinel policy check on a run
+type PolicyCheckResult struct {
+	ID             string `json:"id"`
+	Status         string `json:"status"`
+	Scope          string `json:"scope"`
+	Passed         int    `json:"passed"`
+	AdvisoryFailed int    `json:"advisory_failed"`
+	SoftFailed     int    `json:"soft_failed"`
+	HardFailed     int    `json:"hard_failed"`
+	CanOverride    bool   `json:"can_override"`
+}
+
+// PolicyEvaluationResult is the result of a policy evaluation run in a task stage
+type PolicyEvaluationResult struct {
+	ID           string             `json:"id"`
+	TaskStageID  string             `json:"task_stage_id"`
+	Stage        string             `json:"stage"`
+	Status       string             `json:"status"`
+	Kind         string             `json:"kind"`
+	StatusCounts map[string]int     `json:"policy_status_counts,omitempty"`
+	PolicySets   []*PolicySetResult `json:"policy_sets,omitempty"`
+	Error        string             `json:"error,omitempty"`
+}
+
+// PolicySetResult is the outcome of one policy set within a policy evaluation
+type PolicySetResult struct {
+	Name        string                `json:"name"`
+	Overridable *bool                 `json:"overridable,omitempty"`
+	Error       string                `json:"error,omitempty"`
+	Policies    []*PolicyOutcomeEntry `json:"policies"`
+}
+
+// PolicyOutcomeEntry is the outcome of a single policy
+type PolicyOutcomeEntry struct {
+	Name             string `json:"name"`
+	EnforcementLevel string `json:"enforcement_level"`
+	Status           string `json:"status"`
+	Description      string `json:"description,omitempty"`
+}
+
+// RunPolicyResults lists the Sentinel policy checks and the policy evaluations of a run
+type RunPolicyResults struct {
+	RunID       string                    `json:"run_id"`
+	Checks      []*PolicyCheckResult      `json:"policy_checks"`
+	Evaluations []*PolicyEvaluationResult `json:"policy_evaluations"`
+}
+
+// policyResultSources are the TFE services used to collect the policy results of a run
+type policyResultSources struct {
+	policyChecks interface {
+		List(ctx context.Context, runID string, options *tfe.PolicyCheckListOptions) (*tfe.PolicyCheckList, error)
+	}
+	taskStages interface {
+		List(ctx context.Context, runID string, options *tfe.TaskStageListOptions) (*tfe.TaskStageList, error)
+	}
+	policyEvaluations interface {
+		List(ctx context.Context, taskStageID string, options *tfe.PolicyEvaluationListOptions) (*tfe.PolicyEvaluationList, error)
+	}
+	policySetOutcomes interface {
+		List(ctx context.Context, policyEvaluationID string, options *tfe.PolicySetOutcomeListOptions) (*tfe.PolicySetOutcomeList, error)
+	}
+}
+
+// ListRunPolicyResults returns the Sentinel policy checks of a run and the Sentinel or OPA
+// policy evaluations of its task stages, with the outcome of every evaluated policy.
+// Organizations use policy checks or policy evaluations depending on how policies are enforced,
+// so usually only one of the two lists is populated.
+func ListRunPolicyResults(ctx context.Context, tfeClient *tfe.Client, runID string) (*RunPolicyResults, error) {
+	return listRunPolicyResults(ctx, policyResultSources{
+		policyChecks:      tfeClient.PolicyChecks,
+		taskStages:        tfeClient.TaskStages,
+		policyEvaluations: tfeClient.PolicyEvaluations,
+		policySetOutcomes: tfeClient.PolicySetOutcomes,
+	}, runID)
+}
+
+func listRunPolicyResults(ctx context.Context, sources policyResultSources, runID string) (*RunPolicyResults, error) {
+	results := &RunPolicyResults{
+		RunID:       runID,
+		Checks:      []*PolicyCheckResult{},
+		Evaluations: []*PolicyEvaluationResult{},
+	}
+
+	checkOptions := &tfe.PolicyCheckListOptions{ListOptions: tfe.ListOptions{PageNumber: 1, PageSize: policyResultsPageSize}}
+	for {
+		checks, err := sources.policyChecks.List(ctx, runID, checkOptions)
+		if err != nil {
+			return nil, err
+		}
+		for _, check := range checks.Items {
+			results.Checks = append(results.Checks, newPolicyCheckResult(check))
+		}
+		if checks.Pagination == nil || checks.NextPage == 0 {
+			break
+		}
+		checkOptions.PageNumber = checks.NextPage
+	}
+
+	stageOptions := &tfe.TaskStageListOptions{ListOptions: tfe.ListOptions{PageNumber: 1, PageSize: policyResultsPageSize}}
+	for {
+		stages, err := sources.taskStages.List(ctx, runID, stageOptions)
+		if err != nil {
+			return nil, err
+		}
+		for _, stage := range stages.Items {
+			evaluations, err := sources.stageEvaluations(ctx, stage)
+			if err != nil {
+				return nil, err
+			}
+			results.Evaluations = append(results.Evaluations, evaluations...)
+		}
+		if stages.Pagination == nil || stages.NextPage == 0 {
+			break
+		}
+		stageOptions.PageNumber = stages.NextPage
+	}
+
+	return results, nil
+}
+
+// stageEvaluations reads the policy evaluations of a task stage and the outcome of each policy set.
+// A failure to read the outcomes of one evaluation is reported on that evaluation.
+func (s policyResultSources) stageEvaluations(ctx context.Context, stage *tfe.TaskStage) ([]*PolicyEvaluationResult, error) {
+	if len(stage.PolicyEvaluations) == 0 {
+		return nil, nil
+	}
+
+	evaluations, err := s.policyEvaluations.List(ctx, stage.ID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []*PolicyEvaluationResult
+	for _, evaluation := range evaluations.Items {
+		result := &PolicyEvaluationResult{
+			ID:          evaluation.ID,
+			TaskStageID: stage.ID,
+			Stage:       string(stage.Stage),
+			Status:      string(evaluation.Status),
+			Kind:        string(evaluation.PolicyKind),
+		}
+
+		outcomes, err := s.policySetOutcomes.List(ctx, evaluation.ID, nil)
+		if err != nil {
+			result.Error = err.Error()
+		} else {
+			for _, outcome := range outcomes.Items {
+				result.PolicySets = append(result.PolicySets, newPolicySetResult(outcome))
+			}
+			result.StatusCounts = countPolicyStatuses(result.PolicySets)
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+func newPolicyCheckResult(check *tfe.PolicyCheck) *PolicyCheckResult {
+	result := &PolicyCheckResult{
+		ID:     check.ID,
+		Status: string(check.Status),
+		Scope:  string(check.Scope),
+	}
+	if check.Result != nil {
+		result.Passed = check.Result.Passed
+		result.AdvisoryFailed = check.Result.AdvisoryFailed
+		result.SoftFailed = check.Result.SoftFailed
+		result.HardFailed = check.Result.HardFailed
+	}
+	if check.Actions != nil {
+		result.CanOverride = check.Actions.IsOverridable
+	}
+	return result
+}
+
+func newPolicySetResult(outcome *tfe.PolicySetOutcome) *PolicySetResult {
+	result := &PolicySetResult{
+		Name:        outcome.PolicySetName,
+		Overridable: outcome.Overridable,
+		Error:       outcome.Error,
+		Policies:    make([]*PolicyOutcomeEntry, 0, len(outcome.Outcomes)),
+	}
+	for _, policy := range outcome.Outcomes {
+		result.Policies = append(result.Policies, &PolicyOutcomeEntry{
+			Name:             policy.PolicyName,
+			EnforcementLevel: string(policy.EnforcementLevel),
+			Status:           policy.Status,
+			Description:      policy.Description,
+		})
+	}
+	return result
+}
+
+// countPolicyStatuses counts the evaluated policies by enforcement level and status, e.g. "mandatory/failed"
+func countPolicyStatuses(policySets []*PolicySetResult) map[string]int {
+	counts := make(map[string]int)
+	for _, set := range policySets {
+		for _, policy := range set.Policies {
+			counts[policy.EnforcementLevel+"/"+policy.Status]++
+		}
+	}
+	return counts
+}
@@ -0,0 +1,94 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakePolicyCheckList []*tfe.PolicyCheck
+
+func (f fakePolicyCheckList) List(_ context.Context, _ string, _ *tfe.PolicyCheckListOptions) (*tfe.PolicyCheckList, error) {
+	return &tfe.PolicyCheckList{Items: f}, nil
+}
+
+type fakeTaskStageList []*tfe.TaskStage
+
+func (f fakeTaskStageList) List(_ context.Context, _ string, _ *tfe.TaskStageListOptions) (*tfe.TaskStageList, error) {
+	return &tfe.TaskStageList{Items: f}, nil
+}
+
+type fakePolicyEvaluations map[string][]*tfe.PolicyEvaluation
+
+func (f fakePolicyEvaluations) List(_ context.Context, taskStageID string, _ *tfe.PolicyEvaluationListOptions) (*tfe.PolicyEvaluationList, error) {
+	return &tfe.PolicyEvaluationList{Items: f[taskStageID]}, nil
+}
+
+type fakePolicySetOutcomes map[string][]*tfe.PolicySetOutcome
+
+func (f fakePolicySetOutcomes) List(_ context.Context, policyEvaluationID string, _ *tfe.PolicySetOutcomeListOptions) (*tfe.PolicySetOutcomeList, error) {
+	outcomes, ok := f[policyEvaluationID]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return &tfe.PolicySetOutcomeList{Items: outcomes}, nil
+}
+
+func TestListRunPolicyResults(t *testing.T) {
+	overridable := true
+	sources := policyResultSources{
+		policyChecks: fakePolicyCheckList{
+			{ID: "polchk-1", Status: tfe.PolicySoftFailed, Scope: tfe.PolicyScopeOrganization, Result: &tfe.PolicyResult{Passed: 3, SoftFailed: 1}, Actions: &tfe.PolicyActions{IsOverridable: true}},
+		},
+		taskStages: fakeTaskStageList{
+			{ID: "ts-pre", Stage: tfe.PrePlan},
+			{ID: "ts-post", Stage: tfe.PostPlan, PolicyEvaluations: []*tfe.PolicyEvaluation{{ID: "poleval-1"}, {ID: "poleval-2"}}},
+		},
+		policyEvaluations: fakePolicyEvaluations{
+			"ts-post": {
+				{ID: "poleval-1", Status: tfe.PolicyEvaluationFailed, PolicyKind: tfe.OPA},
+				{ID: "poleval-2", Status: tfe.PolicyEvaluationPassed, PolicyKind: tfe.Sentinel},
+			},
+		},
+		policySetOutcomes: fakePolicySetOutcomes{
+			"poleval-1": {
+				{
+					PolicySetName: "security",
+					Overridable:   &overridable,
+					Outcomes: []tfe.Outcome{
+						{PolicyName: "no-public-buckets", EnforcementLevel: tfe.EnforcementMandatory, Status: "failed"},
+						{PolicyName: "tags-required", EnforcementLevel: tfe.EnforcementAdvisory, Status: "passed"},
+						{PolicyName: "encryption", EnforcementLevel: tfe.EnforcementMandatory, Status: "failed"},
+					},
+				},
+			},
+		},
+	}
+
+	results, err := listRunPolicyResults(context.Background(), sources, "run-1")
+	require.NoError(t, err)
+
+	assert.Equal(t, "run-1", results.RunID)
+	require.Len(t, results.Checks, 1)
+	assert.Equal(t, &PolicyCheckResult{ID: "polchk-1", Status: "soft_failed", Scope: "organization", Passed: 3, SoftFailed: 1, CanOverride: true}, results.Checks[0])
+
+	require.Len(t, results.Evaluations, 2)
+	failed := results.Evaluations[0]
+	assert.Equal(t, "ts-post", failed.TaskStageID)
+	assert.Equal(t, "post_plan", failed.Stage)
+	assert.Equal(t, "opa", failed.Kind)
+	require.Len(t, failed.PolicySets, 1)
+	assert.Equal(t, "security", failed.PolicySets[0].Name)
+	assert.Len(t, failed.PolicySets[0].Policies, 3)
+	assert.Equal(t, map[string]int{"mandatory/failed": 2, "advisory/passed": 1}, failed.StatusCounts)
+
+	assert.Equal(t, "not found", results.Evaluations[1].Error)
+	assert.Nil(t, results.Evaluations[1].PolicySets)
+}
@@ -0,0 +1,116 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const (
+	// PrometheusMetricsEnv enables the Prometheus /metrics endpoint of the HTTP transports when set to "true"
+	PrometheusMetricsEnv = "MCP_PROMETHEUS_METRICS"
+	// PrometheusMetricsPath is the path of the Prometheus metrics endpoint
+	PrometheusMetricsPath = "/metrics"
+
+	// Cache names of the cache lookup metric
+	CacheRegistry       = "registry"
+	CacheAdvisories     = "advisories"
+	CacheProviderSchema = "provider_schema"
+)
+
+// The Prometheus metrics are recorded whether or not the endpoint is enabled, as recording them is cheap
+var (
+	prometheusRegistry = prometheus.NewRegistry()
+
+	toolCallsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "terraform_mcp_tool_calls_total",
+		Help: "Total number of tool calls, by tool and status (success or error).",
+	}, []string{"tool", "status"})
+
+	toolCallDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "terraform_mcp_tool_call_duration_seconds",
+		Help:    "Duration of tool calls in seconds, by tool.",
+		Buckets: []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60},
+	}, []string{"tool"})
+
+	upstreamRequestsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "terraform_mcp_upstream_requests_total",
+		Help: "Total number of requests to upstream APIs, by host and status code. Requests that failed without a response have the code \"error\".",
+	}, []string{"host", "code"})
+
+	upstreamRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "terraform_mcp_upstream_request_duration_seconds",
+		Help:    "Duration of requests to upstream APIs in seconds, until the response headers are received, by host.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"host"})
+
+	cacheLookupsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "terraform_mcp_cache_lookups_total",
+		Help: "Total number of cache lookups, by cache and result (hit or miss).",
+	}, []string{"cache", "result"})
+)
+
+func init() {
+	prometheusRegistry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		toolCallsTotal,
+		toolCallDuration,
+		upstreamRequestsTotal,
+		upstreamRequestDuration,
+		cacheLookupsTotal,
+	)
+}
+
+// PrometheusMetricsHandler returns the handler of the Prometheus metrics endpoint
+func PrometheusMetricsHandler() http.Handler {
+	return promhttp.HandlerFor(prometheusRegistry, promhttp.HandlerOpts{})
+}
+
+// RecordPrometheusToolCall records the status and duration of a tool call. The duration is not
+// recorded when the start time is zero, as it is unknown.
+func RecordPrometheusToolCall(tool string, startTime time.Time, toolErr bool) {
+	status := "success"
+	if toolErr {
+		status = "error"
+	}
+	toolCallsTotal.WithLabelValues(tool, status).Inc()
+	if !startTime.IsZero() {
+		toolCallDuration.WithLabelValues(tool).Observe(time.Since(startTime).Seconds())
+	}
+}
+
+// RecordCacheLookup records whether a cache lookup was a hit or a miss
+func RecordCacheLookup(cache string, hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	cacheLookupsTotal.WithLabelValues(cache, result).Inc()
+}
+
+// upstreamMetricsTransport records the status code and duration of every request to upstream APIs,
+// including each retry attempt
+type upstreamMetricsTransport struct {
+	base http.RoundTripper
+}
+
+// RoundTrip implements the http.RoundTripper interface
+func (t *upstreamMetricsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req)
+	upstreamRequestDuration.WithLabelValues(req.URL.Host).Observe(time.Since(start).Seconds())
+	code := "error"
+	if err == nil {
+		code = strconv.Itoa(resp.StatusCode)
+	}
+	upstreamRequestsTotal.WithLabelValues(req.URL.Host, code).Inc()
+	return resp, err
+}
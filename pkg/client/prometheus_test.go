@@ -0,0 +1,47 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrometheusMetrics(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer upstream.Close()
+
+	httpClient := &http.Client{Transport: &upstreamMetricsTransport{base: http.DefaultTransport}}
+	resp, err := httpClient.Get(upstream.URL)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+
+	RecordPrometheusToolCall("list_workspaces", time.Now().Add(-time.Second), false)
+	RecordPrometheusToolCall("get_run_details", time.Now(), true)
+	RecordCacheLookup(CacheProviderSchema, true)
+	RecordCacheLookup(CacheProviderSchema, false)
+
+	recorder := httptest.NewRecorder()
+	PrometheusMetricsHandler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, PrometheusMetricsPath, nil))
+	require.Equal(t, http.StatusOK, recorder.Code)
+	body, err := io.ReadAll(recorder.Body)
+	require.NoError(t, err)
+
+	metrics := string(body)
+	assert.Contains(t, metrics, `terraform_mcp_tool_calls_total{status="success",tool="list_workspaces"} 1`)
+	assert.Contains(t, metrics, `terraform_mcp_tool_calls_total{status="error",tool="get_run_details"} 1`)
+	assert.Contains(t, metrics, `terraform_mcp_tool_call_duration_seconds_count{tool="list_workspaces"} 1`)
+	assert.Contains(t, metrics, `terraform_mcp_upstream_requests_total{code="429",host="`+upstream.Listener.Addr().String()+`"} 1`)
+	assert.Contains(t, metrics, `terraform_mcp_cache_lookups_total{cache="provider_schema",result="hit"} 1`)
+	assert.Contains(t, metrics, `terraform_mcp_cache_lookups_total{cache="provider_schema",result="miss"} 1`)
+	assert.Contains(t, metrics, "go_goroutines")
+}
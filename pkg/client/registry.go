@@ -4,14 +4,15 @@
 package client
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
-	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
-	"strconv"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/go-cleanhttp"
@@ -28,35 +29,33 @@ func createHTTPClient(insecureSkipVerify bool, logger *log.Logger) *http.Client
 	retryClient := retryablehttp.NewClient()
 	retryClient.Logger = logger
 
-	transport := &http.Transport{
-		TLSClientConfig: &tls.Config{InsecureSkipVerify: insecureSkipVerify},
-	}
-	transport.Proxy = http.ProxyFromEnvironment
-
+	config := getRetryConfig()
 	retryClient.HTTPClient = cleanhttp.DefaultClient()
-	retryClient.HTTPClient.Timeout = 10 * time.Second
-	retryClient.HTTPClient.Transport = transport
-	retryClient.RetryMax = 3
+	// The timeout is applied by the transport, so tools downloading large files can raise it
+	retryClient.HTTPClient.Transport = &timeoutTransport{
+		base:    &limitedTransport{base: newHTTPTransport(insecureSkipVerify, logger), limiters: getUpstreamLimiters()},
+		timeout: config.Timeout,
+	}
+	retryClient.RetryMax = config.RetryMax
 
 	retryClient.Backoff = func(min, max time.Duration, attemptNum int, resp *http.Response) time.Duration {
-		if resp != nil && resp.StatusCode == http.StatusTooManyRequests {
-			resetAfter := resp.Header.Get("x-ratelimit-reset")
-			resetAfterInt, err := strconv.ParseInt(resetAfter, 10, 64)
-			if err != nil {
-				return 0
-			}
-			resetAfterTime := time.Unix(resetAfterInt, 0)
-			return time.Until(resetAfterTime)
+		wait, ok := retryAfter(resp, time.Now())
+		if !ok {
+			return 0
 		}
-		return 0
+		if config.BackoffMax > 0 && wait > config.BackoffMax {
+			wait = config.BackoffMax
+		}
+		notifyThrottled(resp, wait)
+		return wait
 	}
 
 	retryClient.CheckRetry = func(ctx context.Context, resp *http.Response, err error) (bool, error) {
-		if resp != nil && resp.StatusCode == http.StatusTooManyRequests {
-			resetAfter := resp.Header.Get("x-ratelimit-reset")
-			return resetAfter != "", nil
+		if ctx.Err() != nil {
+			return false, ctx.Err()
 		}
-		return false, nil
+		_, ok := retryAfter(resp, time.Now())
+		return ok, nil
 	}
 
 	return retryClient.StandardClient()
@@ -74,11 +73,57 @@ func SendRegistryCall(ctx context.Context, client *http.Client, method string, u
 	}
 	logger.Debugf("Requested URL: %s", url)
 
-	req, err := http.NewRequest(method, url.String(), nil)
+	cache, ttl := getRegistryCache()
+	return sendRegistryRequest(ctx, client, method, url.String(), cache, ttl, logger)
+}
+
+// registryRevalidateWindow is how long a registry response with validators is kept after it
+// went stale, to be revalidated with a conditional request instead of downloaded again
+const registryRevalidateWindow = 24 * time.Hour
+
+// registryResponse is a cached registry response with the validators to revalidate it
+type registryResponse struct {
+	ETag         string    `json:"etag,omitempty"`
+	LastModified string    `json:"last_modified,omitempty"`
+	FetchedAt    time.Time `json:"fetched_at"`
+	Body         []byte    `json:"-"`
+}
+
+// sendRegistryRequest sends a request to the registry. Registry responses are public and identical
+// for every client, so GET responses are shared through the cache. Once stale, they are
+// revalidated with If-None-Match and If-Modified-Since so that unchanged documents, such as large
+// provider doc pages, aren't downloaded again.
+func sendRegistryRequest(ctx context.Context, client *http.Client, method string, rawURL string, cache Store, ttl time.Duration, logger *log.Logger) ([]byte, error) {
+	cacheable := method == http.MethodGet && ttl > 0
+	cacheKey := registryCacheKeyPrefix + rawURL
+
+	var cached *registryResponse
+	if cacheable {
+		cached = loadRegistryResponse(ctx, cache, cacheKey, logger)
+		fresh := cached != nil && time.Since(cached.FetchedAt) < ttl
+		RecordCacheLookup(CacheRegistry, fresh)
+		if fresh {
+			logger.Debugf("Registry cache hit: %s", rawURL)
+			return cached.Body, nil
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, rawURL, nil)
 	if err != nil {
 		return nil, err
 	}
 	req.Header.Set("User-Agent", fmt.Sprintf("terraform-mcp-server/%s", version.GetHumanVersion()))
+	// Requested explicitly rather than left to the transport, which only decompresses responses to
+	// requests it added the header to itself
+	req.Header.Set("Accept-Encoding", "gzip")
+	if cached != nil {
+		if cached.ETag != "" {
+			req.Header.Set("If-None-Match", cached.ETag)
+		}
+		if cached.LastModified != "" {
+			req.Header.Set("If-Modified-Since", cached.LastModified)
+		}
+	}
 	// Set X-Forwarded-For if client IP is in context
 	if clientIP, ok := ctx.Value(contextKey(ClientIPKey)).(string); ok && clientIP != "" {
 		req.Header.Set("X-Forwarded-For", clientIP)
@@ -88,22 +133,92 @@ func SendRegistryCall(ctx context.Context, client *http.Client, method string, u
 	if err != nil {
 		return nil, err
 	}
+	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusNotModified && cached != nil {
+		logger.Debugf("Registry response not modified: %s", rawURL)
+		cached.FetchedAt = time.Now()
+		storeRegistryResponse(ctx, cache, cacheKey, cached, ttl, logger)
+		return cached.Body, nil
+	}
 	if resp.StatusCode != http.StatusOK {
 		return nil, fmt.Errorf("error: %s", "404 Not Found")
 	}
 
-	defer resp.Body.Close()
-	// Read the response body
-	body, err := io.ReadAll(resp.Body)
+	body, err := readRegistryBody(resp)
 	if err != nil {
 		return nil, err
 	}
 	logger.Debugf("Response status: %s", resp.Status)
 	logger.Tracef("Response body: %s", string(body))
+	if cacheable {
+		storeRegistryResponse(ctx, cache, cacheKey, &registryResponse{
+			ETag:         resp.Header.Get("ETag"),
+			LastModified: resp.Header.Get("Last-Modified"),
+			FetchedAt:    time.Now(),
+			Body:         body,
+		}, ttl, logger)
+	}
+	return body, nil
+}
+
+// readRegistryBody reads the body of a registry response, decompressing it when gzip encoded
+func readRegistryBody(resp *http.Response) ([]byte, error) {
+	if !strings.EqualFold(resp.Header.Get("Content-Encoding"), "gzip") {
+		return io.ReadAll(resp.Body)
+	}
+	reader, err := gzip.NewReader(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("decompressing registry response: %w", err)
+	}
+	defer reader.Close()
+	body, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("decompressing registry response: %w", err)
+	}
 	return body, nil
 }
 
+// loadRegistryResponse returns a cached registry response, fresh or stale, or nil
+func loadRegistryResponse(ctx context.Context, cache Store, key string, logger *log.Logger) *registryResponse {
+	value, ok, err := cache.Get(ctx, key)
+	if err != nil {
+		logger.Warnf("Registry cache lookup failed: %v", err)
+		return nil
+	}
+	if !ok {
+		return nil
+	}
+	// The entry is the JSON encoded validators on the first line, followed by the body
+	header, body, ok := bytes.Cut(value, []byte("\n"))
+	if !ok {
+		return nil
+	}
+	var response registryResponse
+	if err := json.Unmarshal(header, &response); err != nil {
+		return nil
+	}
+	response.Body = body
+	return &response
+}
+
+// storeRegistryResponse caches a registry response. Responses with validators are kept past
+// their TTL so they can be revalidated.
+func storeRegistryResponse(ctx context.Context, cache Store, key string, response *registryResponse, ttl time.Duration, logger *log.Logger) {
+	header, err := json.Marshal(response)
+	if err != nil {
+		logger.Warnf("Registry cache update failed: %v", err)
+		return
+	}
+	if response.ETag != "" || response.LastModified != "" {
+		ttl += registryRevalidateWindow
+	}
+	value := append(append(header, '\n'), response.Body...)
+	if err := cache.Set(ctx, key, value, ttl); err != nil {
+		logger.Warnf("Registry cache update failed: %v", err)
+	}
+}
+
 func SendPaginatedRegistryCall(ctx context.Context, client *http.Client, uriPrefix string, logger *log.Logger) ([]ProviderDocData, error) {
 	var results []ProviderDocData
 	page := 1
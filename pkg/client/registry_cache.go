@@ -0,0 +1,224 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"container/list"
+	"context"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// RegistryCacheTTLEnv sets how long registry responses are cached, e.g. "10m". "0" disables the cache.
+	RegistryCacheTTLEnv = "MCP_REGISTRY_CACHE_TTL"
+	// RegistryCacheSizeEnv sets the maximum number of cached registry responses.
+	RegistryCacheSizeEnv = "MCP_REGISTRY_CACHE_SIZE"
+)
+
+// RegistryCacheConfig holds the registry response cache configuration
+type RegistryCacheConfig struct {
+	TTL        time.Duration
+	MaxEntries int
+}
+
+// DefaultRegistryCacheConfig returns the default registry cache configuration
+func DefaultRegistryCacheConfig() RegistryCacheConfig {
+	return RegistryCacheConfig{
+		TTL:        10 * time.Minute,
+		MaxEntries: 1000,
+	}
+}
+
+// LoadRegistryCacheConfigFromEnv loads the registry cache configuration from environment variables
+func LoadRegistryCacheConfigFromEnv() RegistryCacheConfig {
+	config := DefaultRegistryCacheConfig()
+
+	if ttl := strings.TrimSpace(os.Getenv(RegistryCacheTTLEnv)); ttl != "" {
+		if duration, err := time.ParseDuration(ttl); err == nil && duration >= 0 {
+			config.TTL = duration
+		} else {
+			log.Warnf("Invalid %s value %q, using default %s", RegistryCacheTTLEnv, ttl, config.TTL)
+		}
+	}
+
+	if size := strings.TrimSpace(os.Getenv(RegistryCacheSizeEnv)); size != "" {
+		if entries, err := strconv.Atoi(size); err == nil && entries > 0 {
+			config.MaxEntries = entries
+		} else {
+			log.Warnf("Invalid %s value %q, using default %d", RegistryCacheSizeEnv, size, config.MaxEntries)
+		}
+	}
+
+	return config
+}
+
+// registryCache is an in-memory LRU cache of registry responses whose entries expire after a TTL
+type registryCache struct {
+	mu         sync.Mutex
+	ttl        time.Duration
+	maxEntries int
+	entries    map[string]*list.Element
+	order      *list.List // front is the most recently used entry
+}
+
+type registryCacheEntry struct {
+	key       string
+	body      []byte
+	expiresAt time.Time
+}
+
+func newRegistryCache(config RegistryCacheConfig) *registryCache {
+	return &registryCache{
+		ttl:        config.TTL,
+		maxEntries: config.MaxEntries,
+		entries:    make(map[string]*list.Element),
+		order:      list.New(),
+	}
+}
+
+var (
+	sharedRegistryCache     *registryCache
+	sharedRegistryCacheOnce sync.Once
+
+	registryDiskCache     *diskStore
+	registryDiskCacheOnce sync.Once
+)
+
+// registryCacheKeyPrefix namespaces registry responses in a shared store
+const registryCacheKeyPrefix = "registry-response:"
+
+// getRegistryCache returns the registry cache shared by all sessions. Responses are kept in the
+// shared store when one is configured, so that every server instance benefits from them, or on
+// disk when MCP_REGISTRY_CACHE_DIR is set, so that they survive restarts.
+func getRegistryCache() (Store, time.Duration) {
+	cache := getMemoryRegistryCache()
+	if !cache.enabled() {
+		return cache, 0
+	}
+	if store := SharedStore(); store != nil {
+		return store, cache.ttl
+	}
+	if store := getRegistryDiskCache(); store != nil {
+		return store, cache.ttl
+	}
+	return cache, cache.ttl
+}
+
+// getRegistryDiskCache returns the on-disk registry cache, or nil when MCP_REGISTRY_CACHE_DIR
+// isn't set or the directory can't be used
+func getRegistryDiskCache() *diskStore {
+	registryDiskCacheOnce.Do(func() {
+		dir := strings.TrimSpace(os.Getenv(RegistryCacheDirEnv))
+		if dir == "" {
+			return
+		}
+		store, err := newDiskStore(dir)
+		if err != nil {
+			log.Warnf("Invalid %s value %q, caching registry responses in memory: %v", RegistryCacheDirEnv, dir, err)
+			return
+		}
+		registryDiskCache = store
+		log.Debugf("Registry cache directory %s", dir)
+	})
+	return registryDiskCache
+}
+
+// getMemoryRegistryCache returns the in-memory registry cache of the process
+func getMemoryRegistryCache() *registryCache {
+	sharedRegistryCacheOnce.Do(func() {
+		config := LoadRegistryCacheConfigFromEnv()
+		sharedRegistryCache = newRegistryCache(config)
+		log.Debugf("Registry cache TTL %s with up to %d entries", config.TTL, config.MaxEntries)
+	})
+	return sharedRegistryCache
+}
+
+func (c *registryCache) enabled() bool {
+	return c.ttl > 0 && c.maxEntries > 0
+}
+
+// get returns the cached response for a key, if present and not expired
+func (c *registryCache) get(key string, now time.Time) ([]byte, bool) {
+	if !c.enabled() {
+		return nil, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	entry := element.Value.(*registryCacheEntry)
+	if now.After(entry.expiresAt) {
+		c.order.Remove(element)
+		delete(c.entries, key)
+		return nil, false
+	}
+	c.order.MoveToFront(element)
+	return entry.body, true
+}
+
+// set caches a response for the TTL of the cache
+func (c *registryCache) set(key string, body []byte, now time.Time) {
+	c.setUntil(key, body, now.Add(c.ttl))
+}
+
+// setUntil caches a response until expiresAt, evicting the least recently used entries beyond the maximum size
+func (c *registryCache) setUntil(key string, body []byte, expiresAt time.Time) {
+	if !c.enabled() {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if element, ok := c.entries[key]; ok {
+		entry := element.Value.(*registryCacheEntry)
+		entry.body = body
+		entry.expiresAt = expiresAt
+		c.order.MoveToFront(element)
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(&registryCacheEntry{key: key, body: body, expiresAt: expiresAt})
+	for c.order.Len() > c.maxEntries {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*registryCacheEntry).key)
+	}
+}
+
+// Get returns a cached response, as a Store
+func (c *registryCache) Get(_ context.Context, key string) ([]byte, bool, error) {
+	body, ok := c.get(key, time.Now())
+	return body, ok, nil
+}
+
+// Set caches a response, as a Store. Entries expire after ttl, or the TTL the cache was configured with when ttl is zero.
+func (c *registryCache) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	if ttl <= 0 {
+		c.set(key, value, time.Now())
+		return nil
+	}
+	c.setUntil(key, value, time.Now().Add(ttl))
+	return nil
+}
+
+// Delete removes a cached response, as a Store
+func (c *registryCache) Delete(_ context.Context, key string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if element, ok := c.entries[key]; ok {
+		c.order.Remove(element)
+		delete(c.entries, key)
+	}
+	return nil
+}
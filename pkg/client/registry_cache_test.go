@@ -0,0 +1,175 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"compress/gzip"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistryCache(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("expires entries after the TTL", func(t *testing.T) {
+		cache := newRegistryCache(RegistryCacheConfig{TTL: time.Minute, MaxEntries: 10})
+		cache.set("a", []byte("1"), now)
+
+		body, ok := cache.get("a", now.Add(30*time.Second))
+		assert.True(t, ok)
+		assert.Equal(t, []byte("1"), body)
+
+		_, ok = cache.get("a", now.Add(2*time.Minute))
+		assert.False(t, ok)
+		assert.Equal(t, 0, cache.order.Len())
+	})
+
+	t.Run("evicts the least recently used entry", func(t *testing.T) {
+		cache := newRegistryCache(RegistryCacheConfig{TTL: time.Minute, MaxEntries: 2})
+		cache.set("a", []byte("1"), now)
+		cache.set("b", []byte("2"), now)
+		_, _ = cache.get("a", now)
+		cache.set("c", []byte("3"), now)
+
+		_, ok := cache.get("b", now)
+		assert.False(t, ok)
+		_, ok = cache.get("a", now)
+		assert.True(t, ok)
+		_, ok = cache.get("c", now)
+		assert.True(t, ok)
+	})
+
+	t.Run("disabled with a zero TTL", func(t *testing.T) {
+		cache := newRegistryCache(RegistryCacheConfig{TTL: 0, MaxEntries: 10})
+		cache.set("a", []byte("1"), now)
+
+		_, ok := cache.get("a", now)
+		assert.False(t, ok)
+	})
+}
+
+func TestLoadRegistryCacheConfigFromEnv(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		t.Setenv(RegistryCacheTTLEnv, "")
+		t.Setenv(RegistryCacheSizeEnv, "")
+		assert.Equal(t, DefaultRegistryCacheConfig(), LoadRegistryCacheConfigFromEnv())
+	})
+
+	t.Run("custom values", func(t *testing.T) {
+		t.Setenv(RegistryCacheTTLEnv, "1h")
+		t.Setenv(RegistryCacheSizeEnv, "50")
+		assert.Equal(t, RegistryCacheConfig{TTL: time.Hour, MaxEntries: 50}, LoadRegistryCacheConfigFromEnv())
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		t.Setenv(RegistryCacheTTLEnv, "0")
+		assert.Equal(t, time.Duration(0), LoadRegistryCacheConfigFromEnv().TTL)
+	})
+
+	t.Run("invalid values fall back to defaults", func(t *testing.T) {
+		t.Setenv(RegistryCacheTTLEnv, "soon")
+		t.Setenv(RegistryCacheSizeEnv, "-1")
+		assert.Equal(t, DefaultRegistryCacheConfig(), LoadRegistryCacheConfigFromEnv())
+	})
+}
+
+func TestSendRegistryRequestRevalidation(t *testing.T) {
+	const etag = `"v1"`
+	var requests, notModified int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Header.Get("If-None-Match") == etag {
+			notModified++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		assert.Equal(t, "gzip", r.Header.Get("Accept-Encoding"))
+		w.Header().Set("ETag", etag)
+		w.Header().Set("Content-Encoding", "gzip")
+		gz := gzip.NewWriter(w)
+		_, _ = gz.Write([]byte(`{"data":"docs"}`))
+		_ = gz.Close()
+	}))
+	defer server.Close()
+
+	t.Run("serves fresh responses from the cache", func(t *testing.T) {
+		requests, notModified = 0, 0
+		cache := newRegistryCache(RegistryCacheConfig{TTL: time.Minute, MaxEntries: 10})
+		for range 2 {
+			body, err := sendRegistryRequest(context.Background(), server.Client(), http.MethodGet, server.URL+"/fresh", cache, time.Minute, logger)
+			require.NoError(t, err)
+			assert.Equal(t, `{"data":"docs"}`, string(body))
+		}
+		assert.Equal(t, 1, requests)
+	})
+
+	t.Run("revalidates stale responses", func(t *testing.T) {
+		requests, notModified = 0, 0
+		cache := newRegistryCache(RegistryCacheConfig{TTL: time.Minute, MaxEntries: 10})
+		for range 2 {
+			body, err := sendRegistryRequest(context.Background(), server.Client(), http.MethodGet, server.URL+"/stale", cache, time.Nanosecond, logger)
+			require.NoError(t, err)
+			assert.Equal(t, `{"data":"docs"}`, string(body))
+		}
+		assert.Equal(t, 2, requests)
+		assert.Equal(t, 1, notModified)
+	})
+
+	t.Run("revalidates from the disk cache", func(t *testing.T) {
+		requests, notModified = 0, 0
+		store, err := newDiskStore(t.TempDir())
+		require.NoError(t, err)
+		for range 2 {
+			body, err := sendRegistryRequest(context.Background(), server.Client(), http.MethodGet, server.URL+"/disk", store, time.Nanosecond, logger)
+			require.NoError(t, err)
+			assert.Equal(t, `{"data":"docs"}`, string(body))
+		}
+		assert.Equal(t, 1, notModified)
+	})
+}
+
+func TestDiskStore(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	store, err := newDiskStore(dir)
+	require.NoError(t, err)
+
+	require.NoError(t, store.Set(ctx, "registry-response:https://registry.terraform.io/v1/providers", []byte("body\nwith lines"), time.Hour))
+	value, ok, err := store.Get(ctx, "registry-response:https://registry.terraform.io/v1/providers")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "body\nwith lines", string(value))
+
+	require.NoError(t, store.Set(ctx, "expired", []byte("old"), time.Nanosecond))
+	time.Sleep(time.Millisecond)
+	_, ok, err = store.Get(ctx, "expired")
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	require.NoError(t, store.Delete(ctx, "registry-response:https://registry.terraform.io/v1/providers"))
+	_, ok, _ = store.Get(ctx, "registry-response:https://registry.terraform.io/v1/providers")
+	assert.False(t, ok)
+	require.NoError(t, store.Delete(ctx, "missing"))
+
+	t.Run("prunes expired and corrupted entries", func(t *testing.T) {
+		require.NoError(t, store.Set(ctx, "kept", []byte("value"), 0))
+		require.NoError(t, store.Set(ctx, "expired", []byte("old"), time.Nanosecond))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "corrupted.cache"), []byte("not an entry"), 0o600))
+		time.Sleep(time.Millisecond)
+
+		_, err := newDiskStore(dir)
+		require.NoError(t, err)
+		paths, err := filepath.Glob(filepath.Join(dir, "*.cache"))
+		require.NoError(t, err)
+		assert.Equal(t, []string{store.path("kept")}, paths)
+	})
+}
@@ -45,6 +45,12 @@ func GetHttpClientFromContext(ctx context.Context, logger *log.Logger) (*http.Cl
 		return nil, fmt.Errorf("no active session")
 	}
 
+	// Stateless requests share no session ID, so their clients are not cached: the TLS settings
+	// of one request must not leak to another
+	if session.SessionID() == "" {
+		return createHTTPClient(parseTerraformSkipTLSVerify(ctx), logger), nil
+	}
+
 	// Try to get existing client
 	client := GetHttpClient(session.SessionID())
 	if client != nil {
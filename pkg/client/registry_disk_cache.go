@@ -0,0 +1,124 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+// RegistryCacheDirEnv sets a directory where registry responses are cached on disk, so they
+// survive restarts of the server. Responses are cached in memory when it isn't set.
+const RegistryCacheDirEnv = "MCP_REGISTRY_CACHE_DIR"
+
+// diskStore is a Store keeping each key in a file of a directory
+type diskStore struct {
+	dir string
+}
+
+// newDiskStore creates a store in dir, creating the directory and removing the expired entries left by previous runs
+func newDiskStore(dir string) (*diskStore, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("creating cache directory: %w", err)
+	}
+	store := &diskStore{dir: dir}
+	store.prune(time.Now())
+	return store, nil
+}
+
+// path returns the file of a key. Keys are hashed as they contain URLs.
+func (s *diskStore) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(s.dir, hex.EncodeToString(sum[:])+".cache")
+}
+
+// Get returns the value of a key, removing it if it expired
+func (s *diskStore) Get(_ context.Context, key string) ([]byte, bool, error) {
+	path := s.path(key)
+	expiresAt, value, err := readDiskStoreFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, false, nil
+	}
+	if err != nil || (!expiresAt.IsZero() && time.Now().After(expiresAt)) {
+		_ = os.Remove(path)
+		return nil, false, nil
+	}
+	return value, true, nil
+}
+
+// Set stores the value of a key. The file is written next to its destination and renamed, so
+// concurrent readers never see a partial entry.
+func (s *diskStore) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	// The first line of the file is the expiry, in Unix nanoseconds or 0, followed by the value
+	var expiresAt int64
+	if ttl > 0 {
+		expiresAt = time.Now().Add(ttl).UnixNano()
+	}
+	data := append([]byte(strconv.FormatInt(expiresAt, 10)+"\n"), value...)
+
+	file, err := os.CreateTemp(s.dir, ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	return os.Rename(file.Name(), s.path(key))
+}
+
+// Delete removes a key
+func (s *diskStore) Delete(_ context.Context, key string) error {
+	if err := os.Remove(s.path(key)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// prune removes the expired and unreadable entries of the directory
+func (s *diskStore) prune(now time.Time) {
+	paths, err := filepath.Glob(filepath.Join(s.dir, "*.cache"))
+	if err != nil {
+		return
+	}
+	for _, path := range paths {
+		expiresAt, _, err := readDiskStoreFile(path)
+		if err != nil || (!expiresAt.IsZero() && now.After(expiresAt)) {
+			_ = os.Remove(path)
+		}
+	}
+}
+
+// readDiskStoreFile returns the expiry, zero if the entry doesn't expire, and the value of a file
+func readDiskStoreFile(path string) (time.Time, []byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+	header, value, ok := bytes.Cut(data, []byte("\n"))
+	if !ok {
+		return time.Time{}, nil, fmt.Errorf("invalid cache entry %s", path)
+	}
+	expiresAt, err := strconv.ParseInt(string(header), 10, 64)
+	if err != nil {
+		return time.Time{}, nil, fmt.Errorf("invalid cache entry %s: %w", path, err)
+	}
+	if expiresAt == 0 {
+		return time.Time{}, value, nil
+	}
+	return time.Unix(0, expiresAt), value, nil
+}
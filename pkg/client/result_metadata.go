@@ -0,0 +1,80 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// Keys of the tool result metadata, in the _meta field of results and requests
+const (
+	// ResultETagMetaKey is the content hash of a tool result
+	ResultETagMetaKey = "etag"
+	// ResultGeneratedAtMetaKey is when a tool result was generated, in RFC 3339 format
+	ResultGeneratedAtMetaKey = "generated_at"
+	// ResultNotModifiedMetaKey is set on results whose content matches IfNoneMatchMetaKey
+	ResultNotModifiedMetaKey = "not_modified"
+	// IfNoneMatchMetaKey is the etag of a result the client has cached for the same tool call
+	IfNoneMatchMetaKey = "if_none_match"
+)
+
+// ResultMetadataMiddleware adds a content hash (etag) and generation timestamp to the _meta field of
+// successful tool results, so that clients can cache identical results, such as provider docs or
+// workspace details, within a conversation. When a request's _meta carries the etag of the result
+// in if_none_match, the content is replaced with a short not-modified notice.
+func ResultMetadataMiddleware(logger *log.Logger) server.ToolHandlerMiddleware {
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			result, err := next(ctx, request)
+			if err != nil || result == nil || result.IsError {
+				return result, err
+			}
+
+			etag, hashErr := resultETag(result)
+			if hashErr != nil {
+				logger.Debugf("failed to hash the result of %s: %v", request.Params.Name, hashErr)
+				return result, nil
+			}
+			if result.Meta == nil {
+				result.Meta = &mcp.Meta{}
+			}
+			if result.Meta.AdditionalFields == nil {
+				result.Meta.AdditionalFields = make(map[string]any)
+			}
+			result.Meta.AdditionalFields[ResultETagMetaKey] = etag
+			result.Meta.AdditionalFields[ResultGeneratedAtMetaKey] = time.Now().UTC().Format(time.RFC3339)
+
+			if request.Params.Meta != nil {
+				if cached, ok := request.Params.Meta.AdditionalFields[IfNoneMatchMetaKey].(string); ok && cached == etag {
+					result.Content = []mcp.Content{mcp.NewTextContent(fmt.Sprintf("Not modified: the result of %s is identical to the cached result %s", request.Params.Name, etag))}
+					result.StructuredContent = nil
+					result.Meta.AdditionalFields[ResultNotModifiedMetaKey] = true
+				}
+			}
+			return result, nil
+		}
+	}
+}
+
+// resultETag returns the SHA-256 hash of the content of a tool result
+func resultETag(result *mcp.CallToolResult) (string, error) {
+	content, err := json.Marshal(struct {
+		Content           []mcp.Content `json:"content"`
+		StructuredContent any           `json:"structuredContent,omitempty"`
+	}{result.Content, result.StructuredContent})
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(content)
+	return "sha256:" + hex.EncodeToString(sum[:]), nil
+}
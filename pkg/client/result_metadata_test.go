@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResultMetadataMiddleware(t *testing.T) {
+	logger := log.New()
+	logger.SetLevel(log.ErrorLevel)
+
+	handler := func(text string) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return mcp.NewToolResultText(text), nil
+		}
+	}
+	call := func(text string, meta *mcp.Meta) *mcp.CallToolResult {
+		request := mcp.CallToolRequest{}
+		request.Params.Name = "get_provider_details"
+		request.Params.Meta = meta
+		result, err := ResultMetadataMiddleware(logger)(handler(text))(context.Background(), request)
+		require.NoError(t, err)
+		return result
+	}
+
+	t.Run("adds etag and timestamp", func(t *testing.T) {
+		first := call("provider docs", nil)
+		second := call("provider docs", nil)
+		other := call("other docs", nil)
+
+		etag := first.Meta.AdditionalFields[ResultETagMetaKey]
+		assert.Regexp(t, `^sha256:[0-9a-f]{64}$`, etag)
+		assert.Equal(t, etag, second.Meta.AdditionalFields[ResultETagMetaKey])
+		assert.NotEqual(t, etag, other.Meta.AdditionalFields[ResultETagMetaKey])
+
+		generatedAt, err := time.Parse(time.RFC3339, first.Meta.AdditionalFields[ResultGeneratedAtMetaKey].(string))
+		require.NoError(t, err)
+		assert.WithinDuration(t, time.Now(), generatedAt, time.Minute)
+	})
+
+	t.Run("replaces content matching if_none_match", func(t *testing.T) {
+		etag := call("provider docs", nil).Meta.AdditionalFields[ResultETagMetaKey]
+
+		result := call("provider docs", &mcp.Meta{AdditionalFields: map[string]any{IfNoneMatchMetaKey: etag}})
+		assert.Equal(t, true, result.Meta.AdditionalFields[ResultNotModifiedMetaKey])
+		require.Len(t, result.Content, 1)
+		assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "Not modified")
+
+		result = call("changed docs", &mcp.Meta{AdditionalFields: map[string]any{IfNoneMatchMetaKey: etag}})
+		assert.NotContains(t, result.Meta.AdditionalFields, ResultNotModifiedMetaKey)
+		assert.Equal(t, "changed docs", result.Content[0].(mcp.TextContent).Text)
+	})
+
+	t.Run("leaves errors unchanged", func(t *testing.T) {
+		middleware := ResultMetadataMiddleware(logger)
+		result, err := middleware(func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return mcp.NewToolResultError("workspace not found"), nil
+		})(context.Background(), mcp.CallToolRequest{})
+		require.NoError(t, err)
+		assert.Nil(t, result.Meta)
+
+		_, err = middleware(func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return nil, errors.New("failed")
+		})(context.Background(), mcp.CallToolRequest{})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,160 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// HTTPRetryMaxEnv sets how many times a throttled request to HCP Terraform / TFE or the public registry is retried
+	HTTPRetryMaxEnv = "MCP_HTTP_RETRY_MAX"
+	// HTTPTimeoutEnv sets the timeout of each outbound request, e.g. "30s"
+	HTTPTimeoutEnv = "MCP_HTTP_TIMEOUT"
+	// HTTPBackoffMaxEnv caps how long a throttled request waits before it is retried, e.g. "1m". "0" leaves it uncapped.
+	HTTPBackoffMaxEnv = "MCP_HTTP_BACKOFF_MAX"
+	// HTTPDownloadTimeoutEnv sets the timeout of the outbound requests of tools that download
+	// configuration archives, state or plan files, e.g. "10m"
+	HTTPDownloadTimeoutEnv = "MCP_HTTP_DOWNLOAD_TIMEOUT"
+)
+
+// RetryConfig holds the retry and timeout settings of the API clients
+type RetryConfig struct {
+	RetryMax int
+	Timeout  time.Duration
+	// BackoffMax caps the wait requested by the Retry-After header of a throttled response, 0 for no cap
+	BackoffMax      time.Duration
+	DownloadTimeout time.Duration
+}
+
+// DefaultRetryConfig returns the default retry and timeout settings
+func DefaultRetryConfig() RetryConfig {
+	return RetryConfig{
+		RetryMax:        3,
+		Timeout:         10 * time.Second,
+		DownloadTimeout: 5 * time.Minute,
+	}
+}
+
+// LoadRetryConfigFromEnv loads the retry and timeout settings from environment variables
+func LoadRetryConfigFromEnv() RetryConfig {
+	config := DefaultRetryConfig()
+
+	if retryMax := strings.TrimSpace(os.Getenv(HTTPRetryMaxEnv)); retryMax != "" {
+		if value, err := strconv.Atoi(retryMax); err == nil && value >= 0 {
+			config.RetryMax = value
+			log.Infof("Outbound requests retried up to %d times", value)
+		} else {
+			log.Warnf("Invalid %s value %q, using default %d", HTTPRetryMaxEnv, retryMax, config.RetryMax)
+		}
+	}
+
+	config.Timeout = parseRetryDuration(HTTPTimeoutEnv, config.Timeout, false)
+	config.BackoffMax = parseRetryDuration(HTTPBackoffMaxEnv, config.BackoffMax, true)
+	config.DownloadTimeout = parseRetryDuration(HTTPDownloadTimeoutEnv, config.DownloadTimeout, false)
+	return config
+}
+
+// parseRetryDuration parses a duration environment variable, returning the default when it is unset or invalid
+func parseRetryDuration(env string, defaultValue time.Duration, allowZero bool) time.Duration {
+	value := strings.TrimSpace(os.Getenv(env))
+	if value == "" {
+		return defaultValue
+	}
+	duration, err := time.ParseDuration(value)
+	if err != nil || duration < 0 || (duration == 0 && !allowZero) {
+		log.Warnf("Invalid %s value %q, using default %s", env, value, defaultValue)
+		return defaultValue
+	}
+	log.Infof("%s set to %s", env, duration)
+	return duration
+}
+
+var (
+	sharedRetryConfig    RetryConfig
+	sharedRetryConfigSet bool
+	sharedRetryConfigMu  sync.Mutex
+)
+
+// SetRetryConfig sets the retry and timeout settings of the API clients created afterwards, e.g.
+// from command line flags. The settings are loaded from environment variables when it isn't called.
+func SetRetryConfig(config RetryConfig) {
+	sharedRetryConfigMu.Lock()
+	defer sharedRetryConfigMu.Unlock()
+	sharedRetryConfig = config
+	sharedRetryConfigSet = true
+}
+
+// getRetryConfig returns the retry and timeout settings shared by every HTTP client of the process
+func getRetryConfig() RetryConfig {
+	sharedRetryConfigMu.Lock()
+	defer sharedRetryConfigMu.Unlock()
+	if !sharedRetryConfigSet {
+		sharedRetryConfig = LoadRetryConfigFromEnv()
+		sharedRetryConfigSet = true
+	}
+	return sharedRetryConfig
+}
+
+// requestTimeoutKey is the context key of the timeout of the outbound requests made for a tool call
+type requestTimeoutKey struct{}
+
+// WithRequestTimeout returns a context whose outbound requests use a timeout other than the
+// default one, for tools whose requests are expected to take long
+func WithRequestTimeout(ctx context.Context, timeout time.Duration) context.Context {
+	return context.WithValue(ctx, requestTimeoutKey{}, timeout)
+}
+
+// WithDownloadTimeout returns a context whose outbound requests use the download timeout
+func WithDownloadTimeout(ctx context.Context) context.Context {
+	return WithRequestTimeout(ctx, getRetryConfig().DownloadTimeout)
+}
+
+// timeoutTransport bounds each request, including the read of its body, by the timeout of its
+// context or the default timeout. Unlike http.Client.Timeout it can be raised per tool call.
+type timeoutTransport struct {
+	base    http.RoundTripper
+	timeout time.Duration
+}
+
+// RoundTrip implements the http.RoundTripper interface
+func (t *timeoutTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	timeout := t.timeout
+	if override, ok := req.Context().Value(requestTimeoutKey{}).(time.Duration); ok && override > 0 {
+		timeout = override
+	}
+	if timeout <= 0 {
+		return t.base.RoundTrip(req)
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), timeout)
+	resp, err := t.base.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	resp.Body = &cancelOnCloseBody{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// cancelOnCloseBody releases the timeout of a request once its body is closed
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
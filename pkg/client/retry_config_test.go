@@ -0,0 +1,82 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadRetryConfigFromEnv(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		t.Setenv(HTTPRetryMaxEnv, "")
+		t.Setenv(HTTPTimeoutEnv, "")
+		t.Setenv(HTTPBackoffMaxEnv, "")
+		t.Setenv(HTTPDownloadTimeoutEnv, "")
+		assert.Equal(t, DefaultRetryConfig(), LoadRetryConfigFromEnv())
+	})
+
+	t.Run("custom values", func(t *testing.T) {
+		t.Setenv(HTTPRetryMaxEnv, "5")
+		t.Setenv(HTTPTimeoutEnv, "45s")
+		t.Setenv(HTTPBackoffMaxEnv, "1m")
+		t.Setenv(HTTPDownloadTimeoutEnv, "15m")
+		assert.Equal(t, RetryConfig{RetryMax: 5, Timeout: 45 * time.Second, BackoffMax: time.Minute, DownloadTimeout: 15 * time.Minute}, LoadRetryConfigFromEnv())
+	})
+
+	t.Run("retries disabled", func(t *testing.T) {
+		t.Setenv(HTTPRetryMaxEnv, "0")
+		assert.Equal(t, 0, LoadRetryConfigFromEnv().RetryMax)
+	})
+
+	t.Run("invalid values keep the defaults", func(t *testing.T) {
+		t.Setenv(HTTPRetryMaxEnv, "-1")
+		t.Setenv(HTTPTimeoutEnv, "0")
+		t.Setenv(HTTPBackoffMaxEnv, "soon")
+		t.Setenv(HTTPDownloadTimeoutEnv, "-5m")
+		assert.Equal(t, DefaultRetryConfig(), LoadRetryConfigFromEnv())
+	})
+}
+
+func TestTimeoutTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-time.After(200 * time.Millisecond):
+			_, _ = w.Write([]byte("ok"))
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: &timeoutTransport{base: http.DefaultTransport, timeout: 50 * time.Millisecond}}
+	get := func(ctx context.Context) (string, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+		require.NoError(t, err)
+		resp, err := client.Do(req)
+		if err != nil {
+			return "", err
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		return string(body), err
+	}
+
+	t.Run("default timeout", func(t *testing.T) {
+		_, err := get(context.Background())
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("timeout raised for the request", func(t *testing.T) {
+		body, err := get(WithRequestTimeout(context.Background(), 5*time.Second))
+		require.NoError(t, err)
+		assert.Equal(t, "ok", body)
+	})
+}
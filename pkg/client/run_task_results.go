@@ -0,0 +1,110 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-tfe"
+)
+
+// RunTaskResults lists the task stages of a run with the results reported by their run tasks
+type RunTaskResults struct {
+	RunID  string                `json:"run_id"`
+	Stages []*RunTaskStageResult `json:"task_stages"`
+	// Blocking describes the mandatory run tasks that failed, which stop the run unless overridden
+	Blocking []string `json:"blocking,omitempty"`
+}
+
+// RunTaskStageResult is a task stage of a run, e.g. post_plan, with the results of its run tasks
+type RunTaskStageResult struct {
+	ID          string           `json:"id"`
+	Stage       string           `json:"stage"`
+	Status      string           `json:"status"`
+	CanOverride bool             `json:"can_override"`
+	Results     []*RunTaskResult `json:"task_results"`
+}
+
+// RunTaskResult is the result a run task reported to its callback for a run
+type RunTaskResult struct {
+	ID               string `json:"id"`
+	TaskID           string `json:"task_id,omitempty"`
+	TaskName         string `json:"task_name"`
+	Status           string `json:"status"`
+	EnforcementLevel string `json:"enforcement_level"`
+	Message          string `json:"message,omitempty"`
+	URL              string `json:"url,omitempty"`
+}
+
+// runTaskResultSources are the TFE services used to collect the run task results of a run
+type runTaskResultSources struct {
+	taskStages interface {
+		List(ctx context.Context, runID string, options *tfe.TaskStageListOptions) (*tfe.TaskStageList, error)
+		Read(ctx context.Context, taskStageID string, options *tfe.TaskStageReadOptions) (*tfe.TaskStage, error)
+	}
+}
+
+// ListRunTaskResults returns the task stages of a run with the results of their run tasks, such as
+// the findings of a security scanner or cost tool integrated with HCP Terraform
+func ListRunTaskResults(ctx context.Context, tfeClient *tfe.Client, runID string) (*RunTaskResults, error) {
+	return listRunTaskResults(ctx, runTaskResultSources{taskStages: tfeClient.TaskStages}, runID)
+}
+
+func listRunTaskResults(ctx context.Context, sources runTaskResultSources, runID string) (*RunTaskResults, error) {
+	results := &RunTaskResults{RunID: runID, Stages: []*RunTaskStageResult{}}
+
+	options := &tfe.TaskStageListOptions{ListOptions: tfe.ListOptions{PageNumber: 1, PageSize: policyResultsPageSize}}
+	for {
+		stages, err := sources.taskStages.List(ctx, runID, options)
+		if err != nil {
+			return nil, err
+		}
+		for _, stage := range stages.Items {
+			// The list only references the task results, their attributes are included when reading the stage
+			if len(stage.TaskResults) > 0 {
+				stage, err = sources.taskStages.Read(ctx, stage.ID, &tfe.TaskStageReadOptions{Include: []tfe.TaskStageIncludeOpt{tfe.TaskStageTaskResults}})
+				if err != nil {
+					return nil, err
+				}
+			}
+			result := newRunTaskStageResult(stage)
+			results.Stages = append(results.Stages, result)
+			for _, task := range result.Results {
+				if task.EnforcementLevel == string(tfe.Mandatory) && (task.Status == string(tfe.TaskFailed) || task.Status == string(tfe.TaskErrored) || task.Status == string(tfe.TaskUnreachable)) {
+					results.Blocking = append(results.Blocking, fmt.Sprintf("%s %s in the %s stage: %s", task.TaskName, task.Status, result.Stage, task.Message))
+				}
+			}
+		}
+		if stages.Pagination == nil || stages.NextPage == 0 {
+			break
+		}
+		options.PageNumber = stages.NextPage
+	}
+	return results, nil
+}
+
+func newRunTaskStageResult(stage *tfe.TaskStage) *RunTaskStageResult {
+	result := &RunTaskStageResult{
+		ID:      stage.ID,
+		Stage:   string(stage.Stage),
+		Status:  string(stage.Status),
+		Results: make([]*RunTaskResult, 0, len(stage.TaskResults)),
+	}
+	if stage.Actions != nil && stage.Actions.IsOverridable != nil {
+		result.CanOverride = *stage.Actions.IsOverridable
+	}
+	for _, task := range stage.TaskResults {
+		result.Results = append(result.Results, &RunTaskResult{
+			ID:               task.ID,
+			TaskID:           task.TaskID,
+			TaskName:         task.TaskName,
+			Status:           string(task.Status),
+			EnforcementLevel: string(task.WorkspaceTaskEnforcementLevel),
+			Message:          task.Message,
+			URL:              task.URL,
+		})
+	}
+	return result
+}
@@ -0,0 +1,75 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeRunTaskStages struct {
+	fakeTaskStageList
+	read map[string]*tfe.TaskStage
+}
+
+func (f fakeRunTaskStages) Read(_ context.Context, taskStageID string, _ *tfe.TaskStageReadOptions) (*tfe.TaskStage, error) {
+	stage, ok := f.read[taskStageID]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return stage, nil
+}
+
+func TestListRunTaskResults(t *testing.T) {
+	overridable := true
+	sources := runTaskResultSources{
+		taskStages: fakeRunTaskStages{
+			fakeTaskStageList: fakeTaskStageList{
+				{ID: "ts-pre", Stage: tfe.PrePlan, Status: tfe.TaskStagePassed},
+				{ID: "ts-post", Stage: tfe.PostPlan, Status: tfe.TaskStageFailed, TaskResults: []*tfe.TaskResult{{ID: "taskrs-1"}, {ID: "taskrs-2"}}},
+			},
+			read: map[string]*tfe.TaskStage{
+				"ts-post": {
+					ID:      "ts-post",
+					Stage:   tfe.PostPlan,
+					Status:  tfe.TaskStageFailed,
+					Actions: &tfe.Actions{IsOverridable: &overridable},
+					TaskResults: []*tfe.TaskResult{
+						{ID: "taskrs-1", TaskID: "task-1", TaskName: "scanner", Status: tfe.TaskFailed, Message: "2 critical findings", URL: "https://scanner.example.com/r/1", WorkspaceTaskEnforcementLevel: tfe.Mandatory},
+						{ID: "taskrs-2", TaskID: "task-2", TaskName: "cost", Status: tfe.TaskFailed, Message: "over budget", WorkspaceTaskEnforcementLevel: tfe.Advisory},
+					},
+				},
+			},
+		},
+	}
+
+	results, err := listRunTaskResults(context.Background(), sources, "run-1")
+	require.NoError(t, err)
+
+	assert.Equal(t, "run-1", results.RunID)
+	require.Len(t, results.Stages, 2)
+	assert.Equal(t, &RunTaskStageResult{ID: "ts-pre", Stage: "pre_plan", Status: "passed", Results: []*RunTaskResult{}}, results.Stages[0])
+
+	post := results.Stages[1]
+	assert.True(t, post.CanOverride)
+	require.Len(t, post.Results, 2)
+	assert.Equal(t, &RunTaskResult{ID: "taskrs-1", TaskID: "task-1", TaskName: "scanner", Status: "failed", EnforcementLevel: "mandatory", Message: "2 critical findings", URL: "https://scanner.example.com/r/1"}, post.Results[0])
+	assert.Equal(t, []string{"scanner failed in the post_plan stage: 2 critical findings"}, results.Blocking)
+}
+
+func TestListRunTaskResultsReadError(t *testing.T) {
+	sources := runTaskResultSources{
+		taskStages: fakeRunTaskStages{
+			fakeTaskStageList: fakeTaskStageList{{ID: "ts-post", TaskResults: []*tfe.TaskResult{{ID: "taskrs-1"}}}},
+		},
+	}
+
+	_, err := listRunTaskResults(context.Background(), sources, "run-1")
+	assert.Error(t, err)
+}
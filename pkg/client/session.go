@@ -15,6 +15,12 @@ type contextKey string
 
 // NewSessionHandler initializes clients for the session
 func NewSessionHandler(ctx context.Context, session server.ClientSession, logger *log.Logger) {
+	// Stateless requests have no session ID: their clients are created per request from the request's
+	// own token and never cached, so that requests of different users don't share clients
+	if session.SessionID() == "" {
+		return
+	}
+
 	if _, ok := activeTfeClients.Load(session.SessionID()); ok {
 		return
 	}
@@ -48,7 +54,9 @@ func EndSessionHandler(_ context.Context, session server.ClientSession, rateLimi
 	}
 
 	DeleteTfeClient(session.SessionID())
+	ClearSessionCredentials(session.SessionID())
 	DeleteHttpClient(session.SessionID())
+	DeleteWorkspaceInventories(session.SessionID())
 	if rateLimiter != nil {
 		rateLimiter.DeleteSession(session.SessionID())
 	}
@@ -0,0 +1,105 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// sessionCredentials are the Terraform token and address set for a session with the
+// set_hcp_terraform_credentials tool. They are only kept in memory.
+type sessionCredentials struct {
+	address string
+	token   string
+}
+
+var (
+	sessionCredentialStore     sync.Map
+	sessionCredentialsDisabled atomic.Bool
+)
+
+// DisableSessionCredentials refuses the credentials set for sessions, for servers that must only
+// use the tokens their middleware validated, such as with an organization allowlist
+func DisableSessionCredentials() {
+	sessionCredentialsDisabled.Store(true)
+}
+
+// SetSessionCredentials checks a Terraform token against the address and stores them for the
+// session, replacing its TFE client. The credentials take precedence over the ones of the requests
+// and the environment until they are cleared or the session ends. The authenticated user is
+// returned when the platform tells it.
+func SetSessionCredentials(ctx context.Context, session server.ClientSession, address string, token string, logger *log.Logger) (*tfe.User, error) {
+	if sessionCredentialsDisabled.Load() {
+		return nil, fmt.Errorf("credentials cannot be set for a session when an organization allowlist is configured, send the token with the requests")
+	}
+	if session == nil || session.SessionID() == "" {
+		return nil, fmt.Errorf("credentials can only be stored for a session, stateless requests must send the token with every request")
+	}
+
+	clientIP, _ := ctx.Value(contextKey(ClientIPKey)).(string)
+	tfeClient, err := newTfeClient(address, parseTerraformSkipTLSVerify(ctx), token, clientIP, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	// Organization tokens can't read the account details, only a rejected token is an error
+	user, err := tfeClient.Users.ReadCurrent(ctx)
+	if errors.Is(err, tfe.ErrUnauthorized) {
+		return nil, fmt.Errorf("the token was rejected by %s", address)
+	}
+	if err != nil {
+		logger.WithError(err).Debug("Could not read the account of the session token")
+		user = nil
+	}
+
+	sessionCredentialStore.Store(session.SessionID(), sessionCredentials{address: address, token: token})
+	activeTfeClients.Store(session.SessionID(), cachedTfeClient{
+		client: tfeClient,
+		token:  sha256.Sum256([]byte(token)),
+	})
+	return user, nil
+}
+
+// ClearSessionCredentials forgets the credentials stored for a session along with its TFE client,
+// and reports whether any were stored
+func ClearSessionCredentials(sessionID string) bool {
+	if _, ok := sessionCredentialStore.LoadAndDelete(sessionID); !ok {
+		return false
+	}
+	DeleteTfeClient(sessionID)
+	return true
+}
+
+// RedactSessionTokens replaces the tokens stored for the sessions in a string
+func RedactSessionTokens(value string, replacement string) string {
+	sessionCredentialStore.Range(func(_, stored any) bool {
+		if token := stored.(sessionCredentials).token; token != "" {
+			value = strings.ReplaceAll(value, token, replacement)
+		}
+		return true
+	})
+	return value
+}
+
+// getSessionCredentials returns the credentials stored for a session
+func getSessionCredentials(sessionID string) (sessionCredentials, bool) {
+	if sessionID == "" {
+		return sessionCredentials{}, false
+	}
+	value, ok := sessionCredentialStore.Load(sessionID)
+	if !ok {
+		return sessionCredentials{}, false
+	}
+	return value.(sessionCredentials), true
+}
@@ -0,0 +1,103 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type credentialsSession struct{ id string }
+
+func (credentialsSession) Initialize()                                         {}
+func (credentialsSession) Initialized() bool                                   { return true }
+func (credentialsSession) NotificationChannel() chan<- mcp.JSONRPCNotification { return nil }
+func (s credentialsSession) SessionID() string                                 { return s.id }
+
+func newAccountServer(t *testing.T, validToken string) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		if r.Header.Get("Authorization") != "Bearer "+validToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"errors":[{"status":"401","title":"unauthorized"}]}`))
+			return
+		}
+		switch r.URL.Path {
+		case "/api/v2/account/details":
+			_, _ = w.Write([]byte(`{"data":{"id":"user-123","type":"users","attributes":{"username":"jdoe"}}}`))
+		default:
+			_, _ = w.Write([]byte(`{}`))
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestSetSessionCredentials(t *testing.T) {
+	logger := log.New()
+	logger.SetOutput(io.Discard)
+	srv := newAccountServer(t, "session-token")
+	ctx := context.Background()
+	session := credentialsSession{id: "session-credentials"}
+	t.Cleanup(func() { ClearSessionCredentials(session.id) })
+
+	t.Run("requires a session", func(t *testing.T) {
+		_, err := SetSessionCredentials(ctx, statelessSession{}, srv.URL, "session-token", logger)
+		assert.Error(t, err)
+	})
+
+	t.Run("refused with an organization allowlist", func(t *testing.T) {
+		DisableSessionCredentials()
+		defer sessionCredentialsDisabled.Store(false)
+		_, err := SetSessionCredentials(ctx, session, srv.URL, "session-token", logger)
+		assert.Error(t, err)
+	})
+
+	t.Run("rejected tokens are not stored", func(t *testing.T) {
+		_, err := SetSessionCredentials(ctx, session, srv.URL, "wrong-token", logger)
+		require.Error(t, err)
+		_, ok := getSessionCredentials(session.id)
+		assert.False(t, ok)
+	})
+
+	t.Run("valid tokens are stored and take precedence", func(t *testing.T) {
+		user, err := SetSessionCredentials(ctx, session, srv.URL, "session-token", logger)
+		require.NoError(t, err)
+		require.NotNil(t, user)
+		assert.Equal(t, "jdoe", user.Username)
+
+		creds, ok := getSessionCredentials(session.id)
+		require.True(t, ok)
+		assert.Equal(t, srv.URL, creds.address)
+		assert.NotNil(t, GetTfeClient(session.id))
+
+		sessionCtx := server.NewMCPServer("test", "1.0.0").WithContext(ctx, session)
+		tfeClient, err := GetTfeClientFromContext(context.WithValue(sessionCtx, contextKey(TerraformToken), "header-token"), logger)
+		require.NoError(t, err)
+		assert.Same(t, GetTfeClient(session.id), tfeClient)
+	})
+
+	t.Run("stored tokens are redacted", func(t *testing.T) {
+		assert.Equal(t, "token=[REDACTED]", RedactSessionTokens("token=session-token", "[REDACTED]"))
+	})
+
+	t.Run("clearing forgets the credentials and the client", func(t *testing.T) {
+		assert.True(t, ClearSessionCredentials(session.id))
+		assert.False(t, ClearSessionCredentials(session.id))
+		_, ok := getSessionCredentials(session.id)
+		assert.False(t, ok)
+		assert.Nil(t, GetTfeClient(session.id))
+		assert.Equal(t, "token=session-token", RedactSessionTokens("token=session-token", "[REDACTED]"))
+	})
+}
@@ -0,0 +1,85 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// sessionStoreTTL is how long a session ID stays valid in a shared store
+	sessionStoreTTL = 24 * time.Hour
+
+	sessionActive     = "active"
+	sessionTerminated = "terminated"
+
+	// unrecordedSessionPrefix marks the session IDs that could not be recorded in the store. Validate
+	// rejects them, so that the client initializes a new session.
+	unrecordedSessionPrefix = "mcp-session-unrecorded-"
+)
+
+// StoreSessionIdManager keeps the streamable HTTP session IDs in a shared store, so that any
+// server instance behind a load balancer accepts the sessions initialized on another one.
+type StoreSessionIdManager struct {
+	store  Store
+	logger *log.Logger
+}
+
+// NewStoreSessionIdManager creates a session ID manager backed by a shared store
+func NewStoreSessionIdManager(store Store, logger *log.Logger) *StoreSessionIdManager {
+	return &StoreSessionIdManager{store: store, logger: logger}
+}
+
+// Generate creates and records a new session ID. When it can't be recorded, the returned ID is
+// one that Validate rejects.
+func (m *StoreSessionIdManager) Generate() string {
+	id := newSessionID()
+	if err := m.store.Set(context.Background(), sessionStoreKey(id), []byte(sessionActive), sessionStoreTTL); err != nil {
+		m.logger.Errorf("Failed to record session in the store, the session will be rejected: %v", err)
+		return unrecordedSessionPrefix + strings.TrimPrefix(id, "mcp-session-")
+	}
+	return id
+}
+
+// Validate reports whether a session ID was terminated, and an error if it is unknown
+func (m *StoreSessionIdManager) Validate(sessionID string) (isTerminated bool, err error) {
+	if strings.HasPrefix(sessionID, unrecordedSessionPrefix) {
+		return false, fmt.Errorf("session %s was not recorded in the store", sessionID)
+	}
+	state, ok, err := m.store.Get(context.Background(), sessionStoreKey(sessionID))
+	if err != nil {
+		return false, fmt.Errorf("failed to look up session: %w", err)
+	}
+	if !ok {
+		return false, fmt.Errorf("invalid session id: %s", sessionID)
+	}
+	return string(state) == sessionTerminated, nil
+}
+
+// Terminate marks a session ID as terminated
+func (m *StoreSessionIdManager) Terminate(sessionID string) (isNotAllowed bool, err error) {
+	if err := m.store.Set(context.Background(), sessionStoreKey(sessionID), []byte(sessionTerminated), sessionStoreTTL); err != nil {
+		return false, fmt.Errorf("failed to terminate session: %w", err)
+	}
+	return false, nil
+}
+
+func sessionStoreKey(sessionID string) string {
+	return "session:" + sessionID
+}
+
+// newSessionID returns a random session ID in the same format as the default session ID manager
+func newSessionID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("mcp-session-%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
@@ -0,0 +1,84 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	redisDialTimeout = 5 * time.Second
+	redisIOTimeout   = 5 * time.Second
+)
+
+// redisStore is a Store backed by a Redis server, through the pooled go-redis client, which handles
+// TLS, authentication, database selection and reconnections
+type redisStore struct {
+	client *redis.Client
+	prefix string
+}
+
+// newRedisStore creates a Redis store from a redis:// or rediss:// URL
+func newRedisStore(rawURL string, prefix string) (*redisStore, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Redis URL: %w", err)
+	}
+	if u.Scheme != "redis" && u.Scheme != "rediss" {
+		return nil, fmt.Errorf("invalid Redis URL scheme %q - must be redis or rediss", u.Scheme)
+	}
+	options, err := redis.ParseURL(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Redis URL: %w", err)
+	}
+	// redis://password@host authenticates the default user, as with redis-cli
+	if options.Password == "" && options.Username != "" {
+		options.Password, options.Username = options.Username, ""
+	}
+	// Timeouts set in the query of the URL, such as ?dial_timeout=10s, are kept
+	if options.DialTimeout == 0 {
+		options.DialTimeout = redisDialTimeout
+	}
+	if options.ReadTimeout == 0 {
+		options.ReadTimeout = redisIOTimeout
+	}
+	if options.WriteTimeout == 0 {
+		options.WriteTimeout = redisIOTimeout
+	}
+	return &redisStore{client: redis.NewClient(options), prefix: prefix}, nil
+}
+
+func (s *redisStore) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	value, err := s.client.Get(ctx, s.prefix+key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return value, true, nil
+}
+
+func (s *redisStore) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	return s.client.Set(ctx, s.prefix+key, value, ttl).Err()
+}
+
+func (s *redisStore) Delete(ctx context.Context, key string) error {
+	return s.client.Del(ctx, s.prefix+key).Err()
+}
+
+func (s *redisStore) ping(ctx context.Context) error {
+	return s.client.Ping(ctx).Err()
+}
+
+// addr returns the address of the Redis server
+func (s *redisStore) addr() string {
+	return s.client.Options().Addr
+}
@@ -0,0 +1,132 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// TerraformReleasesURLEnv is the HashiCorp releases API the official Terraform versions are read from,
+	// e.g. a mirror in air-gapped deployments
+	TerraformReleasesURLEnv = "MCP_TERRAFORM_RELEASES_URL"
+
+	defaultTerraformReleasesURL = "https://api.releases.hashicorp.com"
+
+	// terraformReleasesPageSize is the largest page of the releases API
+	terraformReleasesPageSize = 20
+
+	// terraformReleasesCacheKeyPrefix namespaces releases API responses in the registry cache
+	terraformReleasesCacheKeyPrefix = "terraform-releases:"
+)
+
+// TerraformRelease is an official release of Terraform
+type TerraformRelease struct {
+	Version    string    `json:"version"`
+	Prerelease bool      `json:"prerelease"`
+	Withdrawn  bool      `json:"withdrawn,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+	Changelog  string    `json:"changelog_url,omitempty"`
+}
+
+// terraformReleaseResponse is a release of the releases API
+type terraformReleaseResponse struct {
+	Version          string    `json:"version"`
+	IsPrerelease     bool      `json:"is_prerelease"`
+	TimestampCreated time.Time `json:"timestamp_created"`
+	URLChangelog     string    `json:"url_changelog"`
+	Status           struct {
+		State string `json:"state"`
+	} `json:"status"`
+}
+
+// ListTerraformReleases returns up to limit official Terraform releases, newest first, from the
+// HashiCorp releases API
+func ListTerraformReleases(ctx context.Context, httpClient *http.Client, limit int, logger *log.Logger) ([]TerraformRelease, error) {
+	baseURL := strings.TrimSuffix(utils.GetEnv(TerraformReleasesURLEnv, defaultTerraformReleasesURL), "/")
+	releases := []TerraformRelease{}
+	var after time.Time
+	for len(releases) < limit {
+		query := url.Values{"limit": {strconv.Itoa(terraformReleasesPageSize)}}
+		if !after.IsZero() {
+			query.Set("after", after.Format(time.RFC3339Nano))
+		}
+		body, err := getTerraformReleases(ctx, httpClient, baseURL+"/v1/releases/terraform?"+query.Encode(), logger)
+		if err != nil {
+			return nil, err
+		}
+
+		var page []terraformReleaseResponse
+		if err := json.Unmarshal(body, &page); err != nil {
+			return nil, fmt.Errorf("parsing Terraform releases: %w", err)
+		}
+		for _, release := range page {
+			releases = append(releases, TerraformRelease{
+				Version:    release.Version,
+				Prerelease: release.IsPrerelease,
+				Withdrawn:  release.Status.State == "withdrawn",
+				CreatedAt:  release.TimestampCreated,
+				Changelog:  release.URLChangelog,
+			})
+		}
+		if len(page) < terraformReleasesPageSize {
+			break
+		}
+		after = page[len(page)-1].TimestampCreated
+	}
+	if len(releases) > limit {
+		releases = releases[:limit]
+	}
+	return releases, nil
+}
+
+// getTerraformReleases reads a page of the releases API, from the registry cache when enabled
+func getTerraformReleases(ctx context.Context, httpClient *http.Client, rawURL string, logger *log.Logger) ([]byte, error) {
+	cache, ttl := getRegistryCache()
+	cacheKey := terraformReleasesCacheKeyPrefix + rawURL
+	if ttl > 0 {
+		if cached, ok, err := cache.Get(ctx, cacheKey); err == nil {
+			RecordCacheLookup(CacheRegistry, ok)
+			if ok {
+				return cached, nil
+			}
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("querying the Terraform releases API: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("querying the Terraform releases API: %s", resp.Status)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading the Terraform releases API response: %w", err)
+	}
+
+	if ttl > 0 {
+		if err := cache.Set(ctx, cacheKey, body, ttl); err != nil {
+			logger.Warnf("Terraform releases cache update failed: %v", err)
+		}
+	}
+	return body, nil
+}
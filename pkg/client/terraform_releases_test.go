@@ -0,0 +1,74 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListTerraformReleases(t *testing.T) {
+	logger := log.New()
+	logger.SetLevel(log.PanicLevel)
+	ctx := context.Background()
+
+	// 25 releases, one a minute, newest first: 1.10.24 down to 1.10.0
+	newest := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	release := func(i int) string {
+		state := "supported"
+		if i == 2 {
+			state = "withdrawn"
+		}
+		return fmt.Sprintf(`{"version":"1.10.%d","is_prerelease":%t,"timestamp_created":%q,"url_changelog":"https://example.com/%d","status":{"state":%q}}`,
+			24-i, i == 0, newest.Add(-time.Duration(i)*time.Minute).Format(time.RFC3339), 24-i, state)
+	}
+
+	var afters []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/releases/terraform", r.URL.Path)
+		assert.Equal(t, "20", r.URL.Query().Get("limit"))
+		start := 0
+		if after := r.URL.Query().Get("after"); after != "" {
+			afters = append(afters, after)
+			start = 20
+		}
+		var items []string
+		for i := start; i < start+20 && i < 25; i++ {
+			items = append(items, release(i))
+		}
+		_, _ = w.Write([]byte("[" + strings.Join(items, ",") + "]"))
+	}))
+	defer server.Close()
+	t.Setenv(TerraformReleasesURLEnv, server.URL+"/")
+	t.Setenv(RegistryCacheTTLEnv, "0")
+
+	releases, err := ListTerraformReleases(ctx, server.Client(), 100, logger)
+	require.NoError(t, err)
+	require.Len(t, releases, 25)
+	assert.Equal(t, TerraformRelease{Version: "1.10.24", Prerelease: true, CreatedAt: newest, Changelog: "https://example.com/24"}, releases[0])
+	assert.True(t, releases[2].Withdrawn)
+	assert.Equal(t, "1.10.0", releases[24].Version)
+	assert.Equal(t, []string{newest.Add(-19 * time.Minute).Format(time.RFC3339Nano)}, afters)
+
+	releases, err = ListTerraformReleases(ctx, server.Client(), 5, logger)
+	require.NoError(t, err)
+	assert.Len(t, releases, 5)
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer failing.Close()
+	t.Setenv(TerraformReleasesURLEnv, failing.URL)
+	_, err = ListTerraformReleases(ctx, failing.Client(), 20, logger)
+	assert.ErrorContains(t, err, "502")
+}
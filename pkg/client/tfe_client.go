@@ -26,10 +26,22 @@ const (
 	ClientIPKey             = "CLIENT_IP"
 	SharedSecretEnv         = "TF_MCP_SHARED_SECRET"
 	SharedSecretHeader      = "X-Tf-Mcp-Secret"
+	// TerraformTokenHeader is an alternative request header carrying the Terraform token, for
+	// gateways that reserve the Authorization header for their own authentication
+	TerraformTokenHeader = "X-TFC-Token"
 )
 
 var activeTfeClients sync.Map
 
+// requestTfeClientKey is the context key of a TFE client created for a single stateless request
+type requestTfeClientKey struct{}
+
+// ContextWithTfeClient returns a context carrying a TFE client created for the current request, which
+// GetTfeClientFromContext returns for stateless requests instead of creating another one
+func ContextWithTfeClient(ctx context.Context, client *tfe.Client) context.Context {
+	return context.WithValue(ctx, requestTfeClientKey{}, client)
+}
+
 type cachedTfeClient struct {
 	client *tfe.Client
 	token  [32]byte // Store the hash of the token instead of raw value
@@ -68,6 +80,7 @@ func newTfeClient(terraformAddress string, terraformSkipTLSVerify bool, terrafor
 		logger.Warnf("Failed to create a Terraform Cloud/Enterprise client: %v", err)
 		return nil, utils.LogAndReturnError(logger, "creating TFE client", err)
 	}
+	logTFEPlatform(client, logger)
 
 	return client, nil
 }
@@ -130,6 +143,9 @@ func GetTfeClientFromContext(ctx context.Context, logger *log.Logger) (*tfe.Clie
 
 	// In a stateless mode the server does not assign any session ID to requests. We need to create new TF clients for every request in that case.
 	if session.SessionID() == "" {
+		if requestClient, ok := ctx.Value(requestTfeClientKey{}).(*tfe.Client); ok && requestClient != nil {
+			return requestClient, nil
+		}
 		logger.Info("Session ID is empty. Creating a new TF client.")
 		currentAddress, _ := ctx.Value(contextKey(TerraformAddress)).(string)
 		if currentAddress == "" {
@@ -139,6 +155,12 @@ func GetTfeClientFromContext(ctx context.Context, logger *log.Logger) (*tfe.Clie
 		return NewTfeClientForToken(currentAddress, parseTerraformSkipTLSVerify(ctx), currentToken, clientIP, logger)
 	}
 
+	// Credentials set for the session with set_hcp_terraform_credentials take precedence over the
+	// ones of the request and the environment
+	if stored, ok := getSessionCredentials(session.SessionID()); ok {
+		currentToken = stored.token
+	}
+
 	// Check if the cached session ID's token+address match the current token+address
 	if value, ok := activeTfeClients.Load(session.SessionID()); ok {
 		cachedClient := value.(cachedTfeClient)
@@ -156,6 +178,12 @@ func GetTfeClientFromContext(ctx context.Context, logger *log.Logger) (*tfe.Clie
 // CreateTfeClientForSession creates only a TFE client for the session
 func CreateTfeClientForSession(ctx context.Context, session server.ClientSession, logger *log.Logger) (*tfe.Client, error) {
 	var err error
+	// Get client IP from context for X-Forwarded-For header
+	clientIP, _ := ctx.Value(contextKey(ClientIPKey)).(string)
+	if stored, ok := getSessionCredentials(session.SessionID()); ok {
+		return NewTfeClient(session.SessionID(), stored.address, parseTerraformSkipTLSVerify(ctx), stored.token, clientIP, logger)
+	}
+
 	terraformAddress, ok := ctx.Value(contextKey(TerraformAddress)).(string)
 	if !ok || terraformAddress == "" {
 		terraformAddress = utils.GetEnv(TerraformAddress, DefaultTerraformAddress)
@@ -173,8 +201,6 @@ func CreateTfeClientForSession(ctx context.Context, session server.ClientSession
 		logger.Info("Read TFE_TOKEN from credentials.tfrc.json")
 	}
 
-	// Get client IP from context for X-Forwarded-For header
-	clientIP, _ := ctx.Value(contextKey(ClientIPKey)).(string)
 	client, err := NewTfeClient(session.SessionID(), terraformAddress, parseTerraformSkipTLSVerify(ctx), terraformToken, clientIP, logger)
 	return client, err
 }
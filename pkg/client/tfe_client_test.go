@@ -4,11 +4,16 @@
 package client
 
 import (
+	"context"
 	"io"
 	"testing"
 
+	"github.com/hashicorp/go-tfe"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // This tests the buildTFEConfig directly due to tfe.NewClient consuming the config and
@@ -45,3 +50,41 @@ func TestBuildTFEConfig_ForwardedFor(t *testing.T) {
 		assert.Empty(t, cfg.Headers.Get("X-Forwarded-For"))
 	})
 }
+
+// statelessSession is the ephemeral session of a request in the stateless streamable HTTP mode
+type statelessSession struct{}
+
+func (statelessSession) Initialize()                                         {}
+func (statelessSession) Initialized() bool                                   { return true }
+func (statelessSession) NotificationChannel() chan<- mcp.JSONRPCNotification { return nil }
+func (statelessSession) SessionID() string                                   { return "" }
+
+func TestStatelessRequestClients(t *testing.T) {
+	logger := log.New()
+	logger.SetOutput(io.Discard)
+	t.Setenv(TerraformToken, "")
+	ctx := server.NewMCPServer("test", "1.0.0").WithContext(context.Background(), statelessSession{})
+
+	t.Run("no clients are cached for the empty session ID", func(t *testing.T) {
+		NewSessionHandler(context.WithValue(ctx, contextKey(TerraformToken), "token-of-user-a"), statelessSession{}, logger)
+		assert.Nil(t, GetTfeClient(""))
+		assert.Nil(t, GetHttpClient(""))
+
+		httpClient, err := GetHttpClientFromContext(ctx, logger)
+		require.NoError(t, err)
+		assert.NotNil(t, httpClient)
+		assert.Nil(t, GetHttpClient(""))
+	})
+
+	t.Run("requests without a token get no client", func(t *testing.T) {
+		_, err := GetTfeClientFromContext(ctx, logger)
+		assert.Error(t, err)
+	})
+
+	t.Run("the client of the request is reused", func(t *testing.T) {
+		requestClient := &tfe.Client{}
+		tfeClient, err := GetTfeClientFromContext(ContextWithTfeClient(ctx, requestClient), logger)
+		require.NoError(t, err)
+		assert.Same(t, requestClient, tfeClient)
+	})
+}
@@ -0,0 +1,152 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-tfe"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	PlatformHCPTerraform        = "HCP Terraform"
+	PlatformTerraformEnterprise = "Terraform Enterprise"
+)
+
+// TFEFeature is an API feature that Terraform Enterprise added in a monthly release
+type TFEFeature struct {
+	Name string
+	// MinRelease is the first Terraform Enterprise release with the feature, e.g. v202410-1
+	MinRelease string
+}
+
+var (
+	// FeatureTagBindings is key-value tags on workspaces and projects
+	FeatureTagBindings = TFEFeature{Name: "key-value tags", MinRelease: "v202410-1"}
+	// FeatureHealthAssessments is drift detection and continuous validation
+	FeatureHealthAssessments = TFEFeature{Name: "health assessments", MinRelease: "v202302-1"}
+)
+
+// monthlyReleasePattern matches the monthly Terraform Enterprise releases, e.g. v202410-1
+var monthlyReleasePattern = regexp.MustCompile(`^v(\d{6})-(\d+)$`)
+
+// loggedPlatforms are the addresses whose platform was logged, so it is logged once per address
+var loggedPlatforms sync.Map
+
+// TFEPlatform is the platform and release of the HCP Terraform or Terraform Enterprise instance a
+// client is connected to, from the headers of the /api/v2/ping request go-tfe sends when creating the client
+type TFEPlatform struct {
+	Name       string `json:"name"`
+	Address    string `json:"address"`
+	APIVersion string `json:"api_version,omitempty"`
+	// Release is the monthly release, e.g. v202410-1, reported by Terraform Enterprise v202208-3 and later
+	Release string `json:"release,omitempty"`
+	// Version is the numeric version, e.g. 1.0.3, reported by Terraform Enterprise 1.0.0 and later
+	Version string `json:"version,omitempty"`
+}
+
+// PlatformOf returns the platform a TFE client is connected to
+func PlatformOf(tfeClient *tfe.Client) *TFEPlatform {
+	baseURL := tfeClient.BaseURL()
+	platform := &TFEPlatform{
+		Name:       PlatformTerraformEnterprise,
+		Address:    baseURL.Scheme + "://" + baseURL.Host,
+		APIVersion: tfeClient.RemoteAPIVersion(),
+		Release:    tfeClient.RemoteTFEVersion(),
+		Version:    tfeClient.RemoteTFENumericVersion(),
+	}
+	// Not all Terraform Enterprise releases send the app name header, nor did HCP Terraform before it was renamed
+	if tfeClient.IsCloud() || (platform.Release == "" && platform.Version == "" && isHCPTerraformHost(baseURL.Hostname())) {
+		platform.Name = PlatformHCPTerraform
+	}
+	return platform
+}
+
+// IsEnterprise reports whether the platform is Terraform Enterprise
+func (p *TFEPlatform) IsEnterprise() bool {
+	return p.Name == PlatformTerraformEnterprise
+}
+
+// String describes the platform and its release, e.g. "Terraform Enterprise v202410-1"
+func (p *TFEPlatform) String() string {
+	switch {
+	case !p.IsEnterprise():
+		return p.Name
+	case p.Version != "":
+		return p.Name + " " + p.Version
+	case p.Release != "":
+		return p.Name + " " + p.Release
+	default:
+		return p.Name + " (release older than v202208-3)"
+	}
+}
+
+// Supports returns an error describing why the platform lacks a feature, or nil. HCP Terraform and
+// Terraform Enterprise 1.0.0 and later, which followed the monthly releases, support all features.
+func (p *TFEPlatform) Supports(feature TFEFeature) error {
+	if !p.IsEnterprise() || p.Version != "" {
+		return nil
+	}
+	if p.Release != "" && compareMonthlyReleases(p.Release, feature.MinRelease) >= 0 {
+		return nil
+	}
+	return fmt.Errorf("%s requires Terraform Enterprise %s or later, %s runs %s", feature.Name, feature.MinRelease, p.Address, p)
+}
+
+// ExplainNotFound adds the platform release to a not found error from Terraform Enterprise, as
+// older releases answer 404 for APIs they don't implement yet
+func (p *TFEPlatform) ExplainNotFound(message string) string {
+	if !p.IsEnterprise() {
+		return message
+	}
+	if !strings.Contains(message, tfe.ErrResourceNotFound.Error()) {
+		return message
+	}
+	return fmt.Sprintf("%s. %s runs %s: if the resource exists, this release may not support the API used by this tool, upgrade Terraform Enterprise or check the API documentation of your release.", strings.TrimSuffix(message, "."), p.Address, p)
+}
+
+// logTFEPlatform logs the platform of the first client created for an address
+func logTFEPlatform(tfeClient *tfe.Client, logger *log.Logger) {
+	platform := PlatformOf(tfeClient)
+	if _, logged := loggedPlatforms.LoadOrStore(platform.Address, true); logged {
+		return
+	}
+	logger.Infof("Connected to %s at %s, API version %s", platform, platform.Address, platform.APIVersion)
+}
+
+// isHCPTerraformHost reports whether a host is an HCP Terraform instance
+func isHCPTerraformHost(host string) bool {
+	host = strings.ToLower(host)
+	return host == "app.terraform.io" || strings.HasSuffix(host, ".terraform.io")
+}
+
+// compareMonthlyReleases compares two monthly releases, e.g. v202410-1, returning -1, 0 or 1.
+// Releases that cannot be parsed are older than all others.
+func compareMonthlyReleases(a, b string) int {
+	am, bm := monthlyReleasePattern.FindStringSubmatch(a), monthlyReleasePattern.FindStringSubmatch(b)
+	switch {
+	case am == nil && bm == nil:
+		return 0
+	case am == nil:
+		return -1
+	case bm == nil:
+		return 1
+	}
+	for i := 1; i <= 2; i++ {
+		x, _ := strconv.Atoi(am[i])
+		y, _ := strconv.Atoi(bm[i])
+		if x != y {
+			if x < y {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newPlatformTestClient creates a TFE client against a server answering the ping request with headers
+func newPlatformTestClient(t *testing.T, headers map[string]string) *tfe.Client {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for key, value := range headers {
+			w.Header().Set(key, value)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(server.Close)
+
+	tfeClient, err := tfe.NewClient(&tfe.Config{Address: server.URL, Token: "test-token"})
+	require.NoError(t, err)
+	return tfeClient
+}
+
+func TestPlatformOf(t *testing.T) {
+	t.Run("HCP Terraform", func(t *testing.T) {
+		platform := PlatformOf(newPlatformTestClient(t, map[string]string{"TFP-AppName": "HCP Terraform", "TFP-API-Version": "2.6"}))
+		assert.False(t, platform.IsEnterprise())
+		assert.Equal(t, "2.6", platform.APIVersion)
+		assert.NoError(t, platform.Supports(FeatureTagBindings))
+	})
+
+	t.Run("Terraform Enterprise monthly release", func(t *testing.T) {
+		platform := PlatformOf(newPlatformTestClient(t, map[string]string{"TFP-AppName": "Terraform Enterprise", "X-TFE-Version": "v202402-1"}))
+		assert.True(t, platform.IsEnterprise())
+		assert.Equal(t, "Terraform Enterprise v202402-1", platform.String())
+		assert.NoError(t, platform.Supports(FeatureHealthAssessments))
+		assert.ErrorContains(t, platform.Supports(FeatureTagBindings), "key-value tags requires Terraform Enterprise v202410-1 or later")
+	})
+
+	t.Run("Terraform Enterprise numeric version", func(t *testing.T) {
+		platform := PlatformOf(newPlatformTestClient(t, map[string]string{"X-TFE-Version": "v202507-1", "X-TFE-Current-Version": "1.0.3"}))
+		assert.Equal(t, "Terraform Enterprise 1.0.3", platform.String())
+		assert.NoError(t, platform.Supports(FeatureTagBindings))
+	})
+
+	t.Run("Terraform Enterprise without version headers", func(t *testing.T) {
+		platform := PlatformOf(newPlatformTestClient(t, nil))
+		assert.True(t, platform.IsEnterprise())
+		assert.Error(t, platform.Supports(FeatureHealthAssessments))
+	})
+}
+
+func TestExplainNotFound(t *testing.T) {
+	enterprise := &TFEPlatform{Name: PlatformTerraformEnterprise, Address: "https://tfe.example.com", Release: "v202301-1"}
+	assert.Equal(t,
+		"failed to list tag bindings: resource not found. https://tfe.example.com runs Terraform Enterprise v202301-1: if the resource exists, this release may not support the API used by this tool, upgrade Terraform Enterprise or check the API documentation of your release.",
+		enterprise.ExplainNotFound("failed to list tag bindings: resource not found"))
+	assert.Equal(t, "invalid input", enterprise.ExplainNotFound("invalid input"))
+
+	cloud := &TFEPlatform{Name: PlatformHCPTerraform, Address: "https://app.terraform.io"}
+	assert.Equal(t, "failed: resource not found", cloud.ExplainNotFound("failed: resource not found"))
+}
+
+func TestCompareMonthlyReleases(t *testing.T) {
+	assert.Equal(t, 0, compareMonthlyReleases("v202410-1", "v202410-1"))
+	assert.Equal(t, 1, compareMonthlyReleases("v202410-2", "v202410-1"))
+	assert.Equal(t, -1, compareMonthlyReleases("v202409-3", "v202410-1"))
+	assert.Equal(t, 1, compareMonthlyReleases("v202501-1", "v202412-2"))
+	assert.Equal(t, -1, compareMonthlyReleases("unknown", "v202410-1"))
+}
@@ -0,0 +1,150 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// throttleKeepaliveInterval is how often a client is told that a throttled call is still waiting
+const throttleKeepaliveInterval = 15 * time.Second
+
+// throttleNotifierKey is the context key of the function told about upstream throttling
+type throttleNotifierKey struct{}
+
+// ThrottleNotifier is called before a request throttled by an upstream API is retried
+type ThrottleNotifier func(wait time.Duration)
+
+// WithThrottleNotifier returns a context whose throttled upstream requests are reported to notify
+func WithThrottleNotifier(ctx context.Context, notify ThrottleNotifier) context.Context {
+	return context.WithValue(ctx, throttleNotifierKey{}, notify)
+}
+
+// notifyThrottled reports that the request of a throttled response will be retried after wait
+func notifyThrottled(resp *http.Response, wait time.Duration) {
+	if resp == nil || resp.Request == nil {
+		return
+	}
+	notifyWait(resp.Request.Context(), wait)
+}
+
+// notifyWait reports that a request of ctx waits before it is sent
+func notifyWait(ctx context.Context, wait time.Duration) {
+	if notify, ok := ctx.Value(throttleNotifierKey{}).(ThrottleNotifier); ok && notify != nil {
+		notify(wait)
+	}
+}
+
+// retryAfter returns how long to wait before retrying a throttled response. It understands the
+// standard Retry-After header, in seconds or as an HTTP date, and the X-RateLimit-Reset header,
+// which HCP Terraform sends as seconds and the public registry as a Unix timestamp.
+func retryAfter(resp *http.Response, now time.Time) (time.Duration, bool) {
+	if resp == nil || resp.StatusCode != http.StatusTooManyRequests {
+		return 0, false
+	}
+
+	if value := strings.TrimSpace(resp.Header.Get("Retry-After")); value != "" {
+		if seconds, err := strconv.ParseFloat(value, 64); err == nil && seconds >= 0 {
+			return time.Duration(seconds * float64(time.Second)), true
+		}
+		if date, err := http.ParseTime(value); err == nil {
+			return nonNegative(date.Sub(now)), true
+		}
+	}
+
+	if value := strings.TrimSpace(resp.Header.Get("X-RateLimit-Reset")); value != "" {
+		reset, err := strconv.ParseFloat(value, 64)
+		if err != nil || reset < 0 {
+			return 0, false
+		}
+		// Values this large can only be timestamps, a reset is never decades away
+		if reset > 1e9 {
+			return nonNegative(time.Unix(int64(reset), 0).Sub(now)), true
+		}
+		return time.Duration(reset * float64(time.Second)), true
+	}
+	return 0, false
+}
+
+func nonNegative(d time.Duration) time.Duration {
+	if d < 0 {
+		return 0
+	}
+	return d
+}
+
+// ThrottleKeepaliveMiddleware keeps clients informed while a tool call waits for an upstream API
+// that throttled it. Streamable HTTP clients commonly give up on calls that send nothing for 60
+// seconds, so a notification is sent as soon as a retry is scheduled and then periodically until
+// the retry, as progress when the client asked for it and as a log message otherwise.
+func ThrottleKeepaliveMiddleware(logger *log.Logger) server.ToolHandlerMiddleware {
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			mcpServer := server.ServerFromContext(ctx)
+			if mcpServer == nil {
+				return next(ctx, request)
+			}
+
+			var progress atomic.Int64
+			send := func(message string) {
+				var err error
+				if request.Params.Meta != nil && request.Params.Meta.ProgressToken != nil {
+					err = mcpServer.SendNotificationToClient(ctx, string(mcp.MethodNotificationProgress), map[string]any{
+						"progressToken": request.Params.Meta.ProgressToken,
+						"progress":      progress.Add(1),
+						"message":       message,
+					})
+				} else {
+					err = mcpServer.SendNotificationToClient(ctx, "notifications/message", map[string]any{
+						"level":  mcp.LoggingLevelInfo,
+						"logger": "terraform-mcp-server",
+						"data":   message,
+					})
+				}
+				if err != nil {
+					logger.Debugf("failed to send throttling keepalive: %v", err)
+				}
+			}
+
+			notify := func(wait time.Duration) {
+				logger.WithFields(log.Fields{
+					"tool":        request.Params.Name,
+					"retry_after": wait.String(),
+				}).Info("Upstream API throttled a tool call")
+				send(fmt.Sprintf("%s is waiting %s for an upstream API rate limit before retrying", request.Params.Name, wait.Round(time.Second)))
+				if wait <= throttleKeepaliveInterval {
+					return
+				}
+				go func() {
+					deadline := time.NewTimer(wait)
+					defer deadline.Stop()
+					ticker := time.NewTicker(throttleKeepaliveInterval)
+					defer ticker.Stop()
+					for {
+						select {
+						case <-ctx.Done():
+							return
+						case <-deadline.C:
+							return
+						case <-ticker.C:
+							send(fmt.Sprintf("%s is still waiting for an upstream API rate limit", request.Params.Name))
+						}
+					}
+				}()
+			}
+
+			return next(WithThrottleNotifier(ctx, notify), request)
+		}
+	}
+}
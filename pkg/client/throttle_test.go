@@ -0,0 +1,112 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		status   int
+		headers  map[string]string
+		expected time.Duration
+		ok       bool
+	}{
+		{
+			name:     "retry-after seconds",
+			status:   http.StatusTooManyRequests,
+			headers:  map[string]string{"Retry-After": "30"},
+			expected: 30 * time.Second,
+			ok:       true,
+		},
+		{
+			name:     "retry-after http date",
+			status:   http.StatusTooManyRequests,
+			headers:  map[string]string{"Retry-After": now.Add(45 * time.Second).Format(http.TimeFormat)},
+			expected: 45 * time.Second,
+			ok:       true,
+		},
+		{
+			name:     "ratelimit reset seconds",
+			status:   http.StatusTooManyRequests,
+			headers:  map[string]string{"X-RateLimit-Reset": "0.5"},
+			expected: 500 * time.Millisecond,
+			ok:       true,
+		},
+		{
+			name:     "ratelimit reset timestamp",
+			status:   http.StatusTooManyRequests,
+			headers:  map[string]string{"X-RateLimit-Reset": fmt.Sprint(now.Add(90 * time.Second).Unix())},
+			expected: 90 * time.Second,
+			ok:       true,
+		},
+		{
+			name:     "ratelimit reset in the past",
+			status:   http.StatusTooManyRequests,
+			headers:  map[string]string{"X-RateLimit-Reset": fmt.Sprint(now.Add(-time.Minute).Unix())},
+			expected: 0,
+			ok:       true,
+		},
+		{
+			name:    "no headers",
+			status:  http.StatusTooManyRequests,
+			headers: map[string]string{},
+		},
+		{
+			name:    "invalid header",
+			status:  http.StatusTooManyRequests,
+			headers: map[string]string{"X-RateLimit-Reset": "soon"},
+		},
+		{
+			name:    "not throttled",
+			status:  http.StatusServiceUnavailable,
+			headers: map[string]string{"Retry-After": "30"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &http.Response{StatusCode: tt.status, Header: http.Header{}}
+			for k, v := range tt.headers {
+				resp.Header.Set(k, v)
+			}
+			wait, ok := retryAfter(resp, now)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.expected, wait)
+		})
+	}
+
+	_, ok := retryAfter(nil, now)
+	assert.False(t, ok)
+}
+
+func TestNotifyThrottled(t *testing.T) {
+	var notified []time.Duration
+	ctx := WithThrottleNotifier(context.Background(), func(wait time.Duration) {
+		notified = append(notified, wait)
+	})
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://registry.terraform.io", nil)
+	require.NoError(t, err)
+	notifyThrottled(&http.Response{Request: req}, 20*time.Second)
+	assert.Equal(t, []time.Duration{20 * time.Second}, notified)
+
+	// Requests made outside of a tool call have no notifier
+	req, err = http.NewRequest(http.MethodGet, "https://registry.terraform.io", nil)
+	require.NoError(t, err)
+	notifyThrottled(&http.Response{Request: req}, 20*time.Second)
+	notifyThrottled(nil, 20*time.Second)
+	assert.Len(t, notified, 1)
+}
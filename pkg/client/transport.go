@@ -0,0 +1,235 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptrace"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// HTTPKeepAliveEnv sets the TCP keep-alive period of outbound connections, e.g. "15s", or "off"
+	HTTPKeepAliveEnv = "MCP_HTTP_KEEP_ALIVE"
+	// HTTPIdleConnTimeoutEnv sets how long idle outbound connections are kept open for reuse, e.g. "30s"
+	HTTPIdleConnTimeoutEnv = "MCP_HTTP_IDLE_CONN_TIMEOUT"
+	// DNSCacheTTLEnv sets how long resolved upstream addresses are cached before they are refreshed, e.g. "1m". "0" disables the cache.
+	DNSCacheTTLEnv = "MCP_DNS_CACHE_TTL"
+)
+
+// TransportConfig holds the connection settings of the HTTP transport of the API clients. In
+// containers, idle connections silently dropped by NAT or conntrack tables and intermittent DNS
+// failures are common, so idle connections are closed before such timeouts and resolved
+// addresses can be cached, falling back to the last known addresses when a lookup fails.
+type TransportConfig struct {
+	DialTimeout     time.Duration
+	KeepAlive       time.Duration // TCP keep-alive period, negative to disable keep-alive probes
+	IdleConnTimeout time.Duration
+	DNSCacheTTL     time.Duration // 0 disables the DNS cache
+}
+
+// DefaultTransportConfig returns the connection settings of Go's default transport, without DNS caching
+func DefaultTransportConfig() TransportConfig {
+	return TransportConfig{
+		DialTimeout:     30 * time.Second,
+		KeepAlive:       30 * time.Second,
+		IdleConnTimeout: 90 * time.Second,
+	}
+}
+
+// LoadTransportConfigFromEnv loads the transport connection settings from environment variables
+func LoadTransportConfigFromEnv() TransportConfig {
+	config := DefaultTransportConfig()
+
+	if keepAlive := strings.TrimSpace(os.Getenv(HTTPKeepAliveEnv)); keepAlive != "" {
+		if strings.EqualFold(keepAlive, "off") {
+			config.KeepAlive = -1
+			log.Infof("TCP keep-alive of outbound connections disabled")
+		} else if duration, err := time.ParseDuration(keepAlive); err == nil && duration > 0 {
+			config.KeepAlive = duration
+			log.Infof("TCP keep-alive of outbound connections set to %s", duration)
+		} else {
+			log.Warnf("Invalid %s value %q, using default %s", HTTPKeepAliveEnv, keepAlive, config.KeepAlive)
+		}
+	}
+
+	if timeout := strings.TrimSpace(os.Getenv(HTTPIdleConnTimeoutEnv)); timeout != "" {
+		if duration, err := time.ParseDuration(timeout); err == nil && duration > 0 {
+			config.IdleConnTimeout = duration
+			log.Infof("Idle timeout of outbound connections set to %s", duration)
+		} else {
+			log.Warnf("Invalid %s value %q, using default %s", HTTPIdleConnTimeoutEnv, timeout, config.IdleConnTimeout)
+		}
+	}
+
+	if ttl := strings.TrimSpace(os.Getenv(DNSCacheTTLEnv)); ttl != "" {
+		if duration, err := time.ParseDuration(ttl); err == nil && duration >= 0 {
+			config.DNSCacheTTL = duration
+			log.Infof("DNS cache TTL set to %s", duration)
+		} else {
+			log.Warnf("Invalid %s value %q, DNS cache disabled", DNSCacheTTLEnv, ttl)
+		}
+	}
+
+	return config
+}
+
+var (
+	sharedTransportConfig     TransportConfig
+	sharedDNSCache            *dnsCache
+	sharedTransportConfigOnce sync.Once
+)
+
+// getTransportSettings returns the transport configuration and the DNS cache shared by every HTTP
+// client of the process. The DNS cache is nil when it is disabled.
+func getTransportSettings() (TransportConfig, *dnsCache) {
+	sharedTransportConfigOnce.Do(func() {
+		sharedTransportConfig = LoadTransportConfigFromEnv()
+		if sharedTransportConfig.DNSCacheTTL > 0 {
+			sharedDNSCache = newDNSCache(sharedTransportConfig.DNSCacheTTL, net.DefaultResolver.LookupHost)
+		}
+	})
+	return sharedTransportConfig, sharedDNSCache
+}
+
+// newHTTPTransport creates the transport of the API clients with the shared connection settings
+func newHTTPTransport(insecureSkipVerify bool, logger *log.Logger) http.RoundTripper {
+	config, cache := getTransportSettings()
+	dialer := &net.Dialer{Timeout: config.DialTimeout, KeepAlive: config.KeepAlive}
+
+	transport := &http.Transport{
+		Proxy:           http.ProxyFromEnvironment,
+		DialContext:     dialContext(dialer, cache, logger),
+		TLSClientConfig: &tls.Config{InsecureSkipVerify: insecureSkipVerify},
+		IdleConnTimeout: config.IdleConnTimeout,
+	}
+	return &connTraceTransport{base: &upstreamMetricsTransport{base: transport}, logger: logger}
+}
+
+// dialContext dials through the DNS cache when it is enabled, and logs connection attempts at debug level
+func dialContext(dialer *net.Dialer, cache *dnsCache, logger *log.Logger) func(ctx context.Context, network, address string) (net.Conn, error) {
+	return func(ctx context.Context, network, address string) (net.Conn, error) {
+		start := time.Now()
+		var conn net.Conn
+		var err error
+		if cache == nil {
+			conn, err = dialer.DialContext(ctx, network, address)
+		} else {
+			conn, err = cache.dial(ctx, dialer, network, address, logger)
+		}
+		if err != nil {
+			logger.Debugf("Connection to %s failed after %s: %v", address, time.Since(start), err)
+			return nil, err
+		}
+		logger.Debugf("Connected to %s (%s) in %s", address, conn.RemoteAddr(), time.Since(start))
+		return conn, nil
+	}
+}
+
+// connTraceTransport logs whether each request reuses an idle connection, at debug level
+type connTraceTransport struct {
+	base   http.RoundTripper
+	logger *log.Logger
+}
+
+// RoundTrip implements the http.RoundTripper interface
+func (t *connTraceTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !t.logger.IsLevelEnabled(log.DebugLevel) {
+		return t.base.RoundTrip(req)
+	}
+	trace := &httptrace.ClientTrace{
+		GotConn: func(info httptrace.GotConnInfo) {
+			if info.Reused {
+				t.logger.Debugf("Reusing connection to %s, idle for %s", req.URL.Host, info.IdleTime)
+			}
+		},
+	}
+	return t.base.RoundTrip(req.WithContext(httptrace.WithClientTrace(req.Context(), trace)))
+}
+
+// dnsCache caches the addresses of upstream hosts for a TTL. Addresses are looked up again once the
+// TTL has passed, and the last known addresses are used while lookups fail.
+type dnsCache struct {
+	ttl        time.Duration
+	lookupHost func(ctx context.Context, host string) ([]string, error)
+	now        func() time.Time
+	mu         sync.Mutex
+	entries    map[string]*dnsCacheEntry
+}
+
+type dnsCacheEntry struct {
+	addrs      []string
+	resolvedAt time.Time
+}
+
+func newDNSCache(ttl time.Duration, lookupHost func(ctx context.Context, host string) ([]string, error)) *dnsCache {
+	return &dnsCache{
+		ttl:        ttl,
+		lookupHost: lookupHost,
+		now:        time.Now,
+		entries:    make(map[string]*dnsCacheEntry),
+	}
+}
+
+// lookup returns the addresses of a host from the cache, resolving them when they are missing or expired
+func (c *dnsCache) lookup(ctx context.Context, host string, logger *log.Logger) ([]string, error) {
+	c.mu.Lock()
+	entry := c.entries[host]
+	c.mu.Unlock()
+	if entry != nil && c.now().Sub(entry.resolvedAt) < c.ttl {
+		return entry.addrs, nil
+	}
+
+	addrs, err := c.lookupHost(ctx, host)
+	if err == nil && len(addrs) == 0 {
+		err = errors.New("no addresses found")
+	}
+	if err != nil {
+		if entry != nil {
+			logger.Debugf("DNS lookup of %s failed, using the addresses resolved %s ago: %v", host, c.now().Sub(entry.resolvedAt).Round(time.Second), err)
+			return entry.addrs, nil
+		}
+		return nil, err
+	}
+
+	c.mu.Lock()
+	c.entries[host] = &dnsCacheEntry{addrs: addrs, resolvedAt: c.now()}
+	c.mu.Unlock()
+	logger.Debugf("DNS lookup of %s resolved %s", host, strings.Join(addrs, ", "))
+	return addrs, nil
+}
+
+// dial connects to the first reachable address of a host
+func (c *dnsCache) dial(ctx context.Context, dialer *net.Dialer, network, address string, logger *log.Logger) (net.Conn, error) {
+	host, port, err := net.SplitHostPort(address)
+	if err != nil || net.ParseIP(host) != nil {
+		return dialer.DialContext(ctx, network, address)
+	}
+
+	addrs, err := c.lookup(ctx, host, logger)
+	if err != nil {
+		return nil, err
+	}
+	var errs []error
+	for _, addr := range addrs {
+		conn, err := dialer.DialContext(ctx, network, net.JoinHostPort(addr, port))
+		if err == nil {
+			return conn, nil
+		}
+		errs = append(errs, err)
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	return nil, errors.Join(errs...)
+}
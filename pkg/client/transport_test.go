@@ -0,0 +1,105 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadTransportConfigFromEnv(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		t.Setenv(HTTPKeepAliveEnv, "")
+		t.Setenv(HTTPIdleConnTimeoutEnv, "")
+		t.Setenv(DNSCacheTTLEnv, "")
+		assert.Equal(t, DefaultTransportConfig(), LoadTransportConfigFromEnv())
+	})
+
+	t.Run("custom values", func(t *testing.T) {
+		t.Setenv(HTTPKeepAliveEnv, "15s")
+		t.Setenv(HTTPIdleConnTimeoutEnv, "30s")
+		t.Setenv(DNSCacheTTLEnv, "1m")
+		config := LoadTransportConfigFromEnv()
+		assert.Equal(t, 15*time.Second, config.KeepAlive)
+		assert.Equal(t, 30*time.Second, config.IdleConnTimeout)
+		assert.Equal(t, time.Minute, config.DNSCacheTTL)
+	})
+
+	t.Run("keep-alive disabled", func(t *testing.T) {
+		t.Setenv(HTTPKeepAliveEnv, "off")
+		assert.Negative(t, LoadTransportConfigFromEnv().KeepAlive)
+	})
+
+	t.Run("invalid values keep the defaults", func(t *testing.T) {
+		t.Setenv(HTTPKeepAliveEnv, "forever")
+		t.Setenv(HTTPIdleConnTimeoutEnv, "0")
+		t.Setenv(DNSCacheTTLEnv, "-1m")
+		assert.Equal(t, DefaultTransportConfig(), LoadTransportConfigFromEnv())
+	})
+}
+
+func TestDNSCache(t *testing.T) {
+	logger := log.New()
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	lookups := 0
+	var lookupErr error
+	cache := newDNSCache(time.Minute, func(ctx context.Context, host string) ([]string, error) {
+		lookups++
+		if lookupErr != nil {
+			return nil, lookupErr
+		}
+		return []string{"127.0.0.1"}, nil
+	})
+	cache.now = func() time.Time { return now }
+
+	addrs, err := cache.lookup(context.Background(), "app.terraform.io", logger)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"127.0.0.1"}, addrs)
+
+	_, err = cache.lookup(context.Background(), "app.terraform.io", logger)
+	require.NoError(t, err)
+	assert.Equal(t, 1, lookups, "cached addresses are reused within the TTL")
+
+	now = now.Add(2 * time.Minute)
+	_, err = cache.lookup(context.Background(), "app.terraform.io", logger)
+	require.NoError(t, err)
+	assert.Equal(t, 2, lookups, "expired addresses are looked up again")
+
+	now = now.Add(2 * time.Minute)
+	lookupErr = errors.New("i/o timeout")
+	addrs, err = cache.lookup(context.Background(), "app.terraform.io", logger)
+	require.NoError(t, err, "the last known addresses are used when a lookup fails")
+	assert.Equal(t, []string{"127.0.0.1"}, addrs)
+
+	_, err = cache.lookup(context.Background(), "registry.terraform.io", logger)
+	assert.ErrorContains(t, err, "i/o timeout")
+}
+
+func TestDNSCacheDial(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+	_, port, err := net.SplitHostPort(server.Listener.Addr().String())
+	require.NoError(t, err)
+
+	cache := newDNSCache(time.Minute, func(ctx context.Context, host string) ([]string, error) {
+		assert.Equal(t, "upstream.internal", host)
+		return []string{"127.0.0.2", "127.0.0.1"}, nil
+	})
+	dialer := &net.Dialer{Timeout: time.Second}
+
+	// The server only listens on 127.0.0.1, so the first address is refused and the next one is dialed
+	conn, err := cache.dial(context.Background(), dialer, "tcp", net.JoinHostPort("upstream.internal", port), log.New())
+	require.NoError(t, err)
+	assert.Equal(t, server.Listener.Addr().String(), conn.RemoteAddr().String())
+	require.NoError(t, conn.Close())
+}
@@ -217,6 +217,16 @@ type ProviderList struct {
 	} `json:"meta"`
 }
 
+// ProviderVersions is the list of available versions of a provider with their plugin protocols.
+// https://registry.terraform.io/v1/providers/hashicorp/aws/versions
+type ProviderVersions struct {
+	ID       string `json:"id"`
+	Versions []struct {
+		Version   string   `json:"version"`
+		Protocols []string `json:"protocols"`
+	} `json:"versions"`
+}
+
 // ProviderVersion represents structure with list of provider versions.
 type ProviderVersionList struct {
 	Data struct {
@@ -0,0 +1,201 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// UpstreamRateLimitEnv sets the outbound requests per second to each API, in "rps:burst" format or "off"
+	UpstreamRateLimitEnv = "MCP_UPSTREAM_RATE_LIMIT"
+	// UpstreamConcurrencyEnv sets the most requests in flight to each API, 0 for no limit
+	UpstreamConcurrencyEnv = "MCP_UPSTREAM_CONCURRENCY"
+
+	// upstreamNotifyWait is the shortest wait for the rate limit that is reported to the client
+	upstreamNotifyWait = time.Second
+)
+
+// UpstreamLimitConfig holds the limits of outbound requests to HCP Terraform, Terraform Enterprise
+// and the public registry. The limits apply to each API base URL separately and are shared by
+// every session, so that parallel tool calls stay below the API rate limits instead of reacting to 429s.
+type UpstreamLimitConfig struct {
+	Limit          rate.Limit // Requests per second to each API
+	Burst          int        // Burst capacity for each API
+	MaxConcurrency int        // Requests in flight to each API, 0 for no limit
+}
+
+// DefaultUpstreamLimitConfig returns limits below the 30 requests per second HCP Terraform allows
+func DefaultUpstreamLimitConfig() UpstreamLimitConfig {
+	return UpstreamLimitConfig{
+		Limit:          25,
+		Burst:          25,
+		MaxConcurrency: 10,
+	}
+}
+
+// LoadUpstreamLimitConfigFromEnv loads the upstream limits from environment variables
+func LoadUpstreamLimitConfigFromEnv() UpstreamLimitConfig {
+	config := DefaultUpstreamLimitConfig()
+
+	if limit := strings.TrimSpace(os.Getenv(UpstreamRateLimitEnv)); limit != "" {
+		if strings.EqualFold(limit, "off") {
+			config.Limit = rate.Inf
+			log.Infof("Upstream rate limit disabled")
+		} else if rps, burst := parseRateLimit(limit); rps > 0 && burst > 0 {
+			config.Limit = rate.Limit(rps)
+			config.Burst = burst
+			log.Infof("Upstream rate limit set to %f rps with burst %d", rps, burst)
+		} else {
+			log.Warnf("Invalid %s format, using default %f rps with burst %d", UpstreamRateLimitEnv, config.Limit, config.Burst)
+		}
+	}
+
+	if concurrency := strings.TrimSpace(os.Getenv(UpstreamConcurrencyEnv)); concurrency != "" {
+		if value, err := strconv.Atoi(concurrency); err == nil && value >= 0 {
+			config.MaxConcurrency = value
+			log.Infof("Upstream concurrency set to %d", value)
+		} else {
+			log.Warnf("Invalid %s value, using default %d", UpstreamConcurrencyEnv, config.MaxConcurrency)
+		}
+	}
+
+	return config
+}
+
+// upstreamLimiter is the token bucket and the concurrency slots of one API
+type upstreamLimiter struct {
+	limiter *rate.Limiter
+	slots   chan struct{}
+}
+
+// upstreamLimiters holds the limiters of every API, keyed by base URL
+type upstreamLimiters struct {
+	config   UpstreamLimitConfig
+	mu       sync.Mutex
+	limiters map[string]*upstreamLimiter
+}
+
+var (
+	sharedUpstreamLimiters     *upstreamLimiters
+	sharedUpstreamLimitersOnce sync.Once
+)
+
+// getUpstreamLimiters returns the upstream limiters shared by every HTTP client of the process
+func getUpstreamLimiters() *upstreamLimiters {
+	sharedUpstreamLimitersOnce.Do(func() {
+		sharedUpstreamLimiters = newUpstreamLimiters(LoadUpstreamLimitConfigFromEnv())
+	})
+	return sharedUpstreamLimiters
+}
+
+func newUpstreamLimiters(config UpstreamLimitConfig) *upstreamLimiters {
+	return &upstreamLimiters{
+		config:   config,
+		limiters: make(map[string]*upstreamLimiter),
+	}
+}
+
+// get returns the limiter of an API base URL, creating it on first use
+func (u *upstreamLimiters) get(baseURL string) *upstreamLimiter {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	if limiter, ok := u.limiters[baseURL]; ok {
+		return limiter
+	}
+	limiter := &upstreamLimiter{limiter: rate.NewLimiter(u.config.Limit, u.config.Burst)}
+	if u.config.MaxConcurrency > 0 {
+		limiter.slots = make(chan struct{}, u.config.MaxConcurrency)
+	}
+	u.limiters[baseURL] = limiter
+	return limiter
+}
+
+// wait blocks until the rate limit allows a request. Long waits are reported like upstream throttling.
+func (l *upstreamLimiter) wait(ctx context.Context) error {
+	reservation := l.limiter.Reserve()
+	if !reservation.OK() {
+		// A zero burst allows no request at all, fall back to waiting on the limiter for its error
+		return l.limiter.Wait(ctx)
+	}
+	delay := reservation.Delay()
+	if delay == 0 {
+		return nil
+	}
+	if delay >= upstreamNotifyWait {
+		notifyWait(ctx, delay)
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		reservation.Cancel()
+		return ctx.Err()
+	}
+}
+
+// acquire takes a concurrency slot and returns the function releasing it
+func (l *upstreamLimiter) acquire(ctx context.Context) (func(), error) {
+	if l.slots == nil {
+		return func() {}, nil
+	}
+	select {
+	case l.slots <- struct{}{}:
+		var once sync.Once
+		return func() { once.Do(func() { <-l.slots }) }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// limitedTransport applies the upstream limits of each API base URL to the requests of a transport
+type limitedTransport struct {
+	base     http.RoundTripper
+	limiters *upstreamLimiters
+}
+
+// RoundTrip implements the http.RoundTripper interface. The concurrency slot of a request is held
+// until its response body is closed.
+func (t *limitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	limiter := t.limiters.get(req.URL.Scheme + "://" + req.URL.Host)
+	if err := limiter.wait(req.Context()); err != nil {
+		return nil, err
+	}
+	release, err := limiter.acquire(req.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		release()
+		return nil, err
+	}
+	resp.Body = &releasingBody{ReadCloser: resp.Body, release: release}
+	return resp, nil
+}
+
+// releasingBody releases the concurrency slot of a request when its response body is closed
+type releasingBody struct {
+	io.ReadCloser
+	release func()
+}
+
+func (b *releasingBody) Close() error {
+	defer b.release()
+	return b.ReadCloser.Close()
+}
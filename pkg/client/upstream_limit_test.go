@@ -0,0 +1,115 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+)
+
+func TestLoadUpstreamLimitConfigFromEnv(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		t.Setenv(UpstreamRateLimitEnv, "")
+		t.Setenv(UpstreamConcurrencyEnv, "")
+		assert.Equal(t, DefaultUpstreamLimitConfig(), LoadUpstreamLimitConfigFromEnv())
+	})
+
+	t.Run("custom values", func(t *testing.T) {
+		t.Setenv(UpstreamRateLimitEnv, "10:5")
+		t.Setenv(UpstreamConcurrencyEnv, "3")
+		assert.Equal(t, UpstreamLimitConfig{Limit: 10, Burst: 5, MaxConcurrency: 3}, LoadUpstreamLimitConfigFromEnv())
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		t.Setenv(UpstreamRateLimitEnv, "off")
+		t.Setenv(UpstreamConcurrencyEnv, "0")
+		config := LoadUpstreamLimitConfigFromEnv()
+		assert.Equal(t, rate.Inf, config.Limit)
+		assert.Equal(t, 0, config.MaxConcurrency)
+	})
+
+	t.Run("invalid values keep the defaults", func(t *testing.T) {
+		t.Setenv(UpstreamRateLimitEnv, "fast")
+		t.Setenv(UpstreamConcurrencyEnv, "-1")
+		assert.Equal(t, DefaultUpstreamLimitConfig(), LoadUpstreamLimitConfigFromEnv())
+	})
+}
+
+func TestLimitedTransport(t *testing.T) {
+	t.Run("bounds concurrent requests until the body is closed", func(t *testing.T) {
+		var inFlight, maxInFlight atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			current := inFlight.Add(1)
+			defer inFlight.Add(-1)
+			for {
+				seen := maxInFlight.Load()
+				if current <= seen || maxInFlight.CompareAndSwap(seen, current) {
+					break
+				}
+			}
+			time.Sleep(20 * time.Millisecond)
+			_, _ = w.Write([]byte("ok"))
+		}))
+		defer server.Close()
+
+		client := &http.Client{Transport: &limitedTransport{
+			base:     http.DefaultTransport,
+			limiters: newUpstreamLimiters(UpstreamLimitConfig{Limit: rate.Inf, Burst: 1, MaxConcurrency: 2}),
+		}}
+
+		done := make(chan struct{})
+		for i := 0; i < 6; i++ {
+			go func() {
+				defer func() { done <- struct{}{} }()
+				resp, err := client.Get(server.URL)
+				if !assert.NoError(t, err) {
+					return
+				}
+				_, _ = io.ReadAll(resp.Body)
+				_ = resp.Body.Close()
+			}()
+		}
+		for i := 0; i < 6; i++ {
+			<-done
+		}
+		assert.LessOrEqual(t, maxInFlight.Load(), int32(2))
+	})
+
+	t.Run("rate limits each base URL separately", func(t *testing.T) {
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+		first := httptest.NewServer(handler)
+		defer first.Close()
+		second := httptest.NewServer(handler)
+		defer second.Close()
+
+		limiters := newUpstreamLimiters(UpstreamLimitConfig{Limit: rate.Every(time.Hour), Burst: 1})
+		client := &http.Client{Transport: &limitedTransport{base: http.DefaultTransport, limiters: limiters}}
+
+		for _, url := range []string{first.URL, second.URL} {
+			resp, err := client.Get(url)
+			require.NoError(t, err)
+			_ = resp.Body.Close()
+		}
+
+		// The bucket of the first server is empty, so the next request waits until the context ends
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		var notified time.Duration
+		ctx = WithThrottleNotifier(ctx, func(wait time.Duration) { notified = wait })
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, first.URL, nil)
+		require.NoError(t, err)
+		_, err = client.Do(req)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Greater(t, notified, time.Minute)
+	})
+}
@@ -0,0 +1,183 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package prompts
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// RegisterPrompts adds the prompts for common Terraform workflows
+func RegisterPrompts(hcServer *server.MCPServer, logger *log.Logger) {
+	hcServer.AddPrompt(GenerateModuleSkeletonPrompt(logger))
+	hcServer.AddPrompt(ReviewPlanPrompt(logger))
+	hcServer.AddPrompt(MigrateTerraformVersionPrompt(logger))
+}
+
+// GenerateModuleSkeletonPrompt returns the prompt and handler to generate a new module
+func GenerateModuleSkeletonPrompt(logger *log.Logger) (mcp.Prompt, server.PromptHandlerFunc) {
+	return mcp.NewPrompt("generate_module_skeleton",
+			mcp.WithPromptTitle("Generate a Terraform module skeleton"),
+			mcp.WithPromptDescription("Generates the files of a new Terraform module for a provider, following the Terraform style guide and module structure conventions"),
+			mcp.WithArgument("provider",
+				mcp.ArgumentDescription("The provider the module manages resources with, e.g. 'aws' or 'hashicorp/azurerm'"),
+				mcp.RequiredArgument(),
+			),
+			mcp.WithArgument("module_name",
+				mcp.ArgumentDescription("The name of the module, e.g. 'static-website'"),
+				mcp.RequiredArgument(),
+			),
+			mcp.WithArgument("description",
+				mcp.ArgumentDescription("What the module should create"),
+			),
+		),
+		func(ctx context.Context, request mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+			args, err := promptArguments(request, "provider", "module_name")
+			if err != nil {
+				return nil, utils.LogAndReturnError(logger, "getting prompt "+request.Params.Name, err)
+			}
+			namespace, name := providerAddress(args["provider"])
+
+			var b strings.Builder
+			fmt.Fprintf(&b, "Generate the skeleton of a Terraform module named %q for the %s/%s provider.\n", args["module_name"], namespace, name)
+			if args["description"] != "" {
+				fmt.Fprintf(&b, "The module should: %s\n", args["description"])
+			}
+			fmt.Fprintf(&b, `
+Before writing any code:
+1. Call get_latest_provider_version for %[1]s/%[2]s and use it as the lower bound of the version constraint.
+2. Read the /terraform/style-guide and /terraform/module-development resources and follow them.
+3. Use search_providers and get_provider_details (or get_provider_schema) for every resource type you use, so that arguments match the provider version.
+4. Check search_modules for an existing public module that already does this, and mention it if one fits.
+
+Create these files:
+- versions.tf with required_version and required_providers, pinning %[1]s/%[2]s with a "~>" constraint
+- main.tf with the resources, without a provider block
+- variables.tf with a type and description for every variable, and validation blocks where inputs are constrained
+- outputs.tf with a description for every output
+- README.md describing the module, its inputs and outputs, with a usage example
+- examples/basic/main.tf calling the module with its required inputs
+
+Keep the module focused on a single purpose, and return every file in its own code block with its path.`, namespace, name)
+
+			return mcp.NewGetPromptResult(
+				fmt.Sprintf("Generate the %s module skeleton", args["module_name"]),
+				[]mcp.PromptMessage{mcp.NewPromptMessage(mcp.RoleUser, mcp.NewTextContent(b.String()))},
+			), nil
+		}
+}
+
+// ReviewPlanPrompt returns the prompt and handler to review a plan
+func ReviewPlanPrompt(logger *log.Logger) (mcp.Prompt, server.PromptHandlerFunc) {
+	return mcp.NewPrompt("review_plan",
+			mcp.WithPromptTitle("Review a Terraform plan"),
+			mcp.WithPromptDescription("Reviews the output of a Terraform plan, or the plan of an HCP Terraform run, for risky changes before it is applied"),
+			mcp.WithArgument("plan_output",
+				mcp.ArgumentDescription("The output of 'terraform plan' or 'terraform show -json'. Either plan_output or run_id is required"),
+			),
+			mcp.WithArgument("run_id",
+				mcp.ArgumentDescription("The ID of an HCP Terraform run whose plan to review, e.g. 'run-abc123'. Either plan_output or run_id is required"),
+			),
+		),
+		func(ctx context.Context, request mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+			args, err := promptArguments(request)
+			if err != nil {
+				return nil, utils.LogAndReturnError(logger, "getting prompt "+request.Params.Name, err)
+			}
+			if args["plan_output"] == "" && args["run_id"] == "" {
+				return nil, utils.LogAndReturnError(logger, "getting prompt "+request.Params.Name, fmt.Errorf("either plan_output or run_id is required"))
+			}
+
+			var b strings.Builder
+			if args["run_id"] != "" {
+				fmt.Fprintf(&b, "Review the plan of the HCP Terraform run %s. Call get_run_details and get_plan_details for it, and get_plan_json_output for the resource changes; use get_plan_logs if the plan errored.\n", args["run_id"])
+			} else {
+				b.WriteString("Review this Terraform plan:\n\n```\n" + args["plan_output"] + "\n```\n")
+			}
+			b.WriteString(`
+Report, most severe first:
+1. Resources that are destroyed or replaced, and the attribute forcing each replacement. Call out stateful resources (databases, storage, DNS, keys) explicitly.
+2. Changes to security-sensitive settings: IAM policies and roles, security groups and firewall rules, public access, encryption and logging.
+3. Changes that are likely unintended, such as drift being reverted, values changing to null or defaults, or many resources changing because of one variable.
+4. Errors, warnings and policy check results.
+
+Finish with a summary of the counts to add, change and destroy, and a clear recommendation: safe to apply, apply with care (and what to verify), or do not apply (and why). Do not apply or discard the run yourself.`)
+
+			return mcp.NewGetPromptResult(
+				"Review a Terraform plan",
+				[]mcp.PromptMessage{mcp.NewPromptMessage(mcp.RoleUser, mcp.NewTextContent(b.String()))},
+			), nil
+		}
+}
+
+// MigrateTerraformVersionPrompt returns the prompt and handler to move a workspace to a new Terraform version
+func MigrateTerraformVersionPrompt(logger *log.Logger) (mcp.Prompt, server.PromptHandlerFunc) {
+	return mcp.NewPrompt("migrate_workspace_terraform_version",
+			mcp.WithPromptTitle("Migrate a workspace to a new Terraform version"),
+			mcp.WithPromptDescription("Checks and migrates an HCP Terraform workspace to a new Terraform version, with a plan to confirm that nothing changes"),
+			mcp.WithArgument("terraform_org_name",
+				mcp.ArgumentDescription("The HCP Terraform or Terraform Enterprise organization name"),
+				mcp.RequiredArgument(),
+			),
+			mcp.WithArgument("workspace_name",
+				mcp.ArgumentDescription("The name of the workspace to migrate"),
+				mcp.RequiredArgument(),
+			),
+			mcp.WithArgument("target_version",
+				mcp.ArgumentDescription("The Terraform version to migrate to, e.g. '1.9.8'"),
+				mcp.RequiredArgument(),
+			),
+		),
+		func(ctx context.Context, request mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+			args, err := promptArguments(request, "terraform_org_name", "workspace_name", "target_version")
+			if err != nil {
+				return nil, utils.LogAndReturnError(logger, "getting prompt "+request.Params.Name, err)
+			}
+
+			text := fmt.Sprintf(`Migrate the workspace %[2]q in the organization %[1]q to Terraform %[3]s.
+
+1. Call get_workspace_details to find the current Terraform version, the latest run and whether the workspace is locked. Stop if the current run is still in progress.
+2. Read the upgrade notes and changelog of every Terraform minor version between the current version and %[3]s, and list the changes that can affect this workspace.
+3. Call check_module_terraform_compatibility for the modules the workspace uses, and check that the required_version constraints of the configuration allow %[3]s.
+4. Summarize the risks and ask me to confirm before changing anything.
+5. After I confirm, call update_workspace with terraform_version %[3]s, then create_run with run_type plan_only and wait_for_run.
+6. The migration succeeded if the plan has no changes. If it has changes or errors, show them, and offer to set terraform_version back to the previous version with update_workspace.
+
+Do not apply any run as part of the migration.`, args["terraform_org_name"], args["workspace_name"], args["target_version"])
+
+			return mcp.NewGetPromptResult(
+				fmt.Sprintf("Migrate %s to Terraform %s", args["workspace_name"], args["target_version"]),
+				[]mcp.PromptMessage{mcp.NewPromptMessage(mcp.RoleUser, mcp.NewTextContent(text))},
+			), nil
+		}
+}
+
+// promptArguments returns the trimmed arguments of a prompt request, and an error if a required one is missing
+func promptArguments(request mcp.GetPromptRequest, required ...string) (map[string]string, error) {
+	args := make(map[string]string, len(request.Params.Arguments))
+	for name, value := range request.Params.Arguments {
+		args[name] = strings.TrimSpace(value)
+	}
+	for _, name := range required {
+		if args[name] == "" {
+			return nil, fmt.Errorf("missing required argument: %s", name)
+		}
+	}
+	return args, nil
+}
+
+// providerAddress splits a provider into its namespace and name, defaulting to the hashicorp namespace
+func providerAddress(provider string) (string, string) {
+	provider = strings.ToLower(strings.TrimPrefix(provider, "registry.terraform.io/"))
+	if namespace, name, ok := strings.Cut(provider, "/"); ok {
+		return namespace, name
+	}
+	return "hashicorp", provider
+}
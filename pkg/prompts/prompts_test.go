@@ -0,0 +1,79 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package prompts
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func getPrompt(t *testing.T, handler server.PromptHandlerFunc, arguments map[string]string) (string, error) {
+	t.Helper()
+	request := mcp.GetPromptRequest{}
+	request.Params.Arguments = arguments
+	result, err := handler(context.Background(), request)
+	if err != nil {
+		return "", err
+	}
+	require.Len(t, result.Messages, 1)
+	assert.Equal(t, mcp.RoleUser, result.Messages[0].Role)
+	text, ok := result.Messages[0].Content.(mcp.TextContent)
+	require.True(t, ok)
+	return text.Text, nil
+}
+
+func TestPrompts(t *testing.T) {
+	logger := log.New()
+	logger.SetLevel(log.ErrorLevel)
+
+	t.Run("generate module skeleton", func(t *testing.T) {
+		prompt, handler := GenerateModuleSkeletonPrompt(logger)
+		assert.Equal(t, "generate_module_skeleton", prompt.Name)
+
+		text, err := getPrompt(t, handler, map[string]string{"provider": "AWS", "module_name": "static-website"})
+		require.NoError(t, err)
+		assert.Contains(t, text, `"static-website" for the hashicorp/aws provider`)
+		assert.Contains(t, text, "get_latest_provider_version for hashicorp/aws")
+
+		text, err = getPrompt(t, handler, map[string]string{"provider": "integrations/github", "module_name": "repo"})
+		require.NoError(t, err)
+		assert.Contains(t, text, "integrations/github")
+
+		_, err = getPrompt(t, handler, map[string]string{"provider": "aws", "module_name": " "})
+		assert.ErrorContains(t, err, "module_name")
+	})
+
+	t.Run("review plan", func(t *testing.T) {
+		_, handler := ReviewPlanPrompt(logger)
+
+		text, err := getPrompt(t, handler, map[string]string{"run_id": "run-abc123"})
+		require.NoError(t, err)
+		assert.Contains(t, text, "run run-abc123")
+
+		text, err = getPrompt(t, handler, map[string]string{"plan_output": "Plan: 1 to add, 0 to change, 1 to destroy."})
+		require.NoError(t, err)
+		assert.Contains(t, text, "Plan: 1 to add, 0 to change, 1 to destroy.")
+
+		_, err = getPrompt(t, handler, nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("migrate workspace terraform version", func(t *testing.T) {
+		prompt, handler := MigrateTerraformVersionPrompt(logger)
+		assert.Len(t, prompt.Arguments, 3)
+
+		text, err := getPrompt(t, handler, map[string]string{"terraform_org_name": "acme", "workspace_name": "prod", "target_version": "1.9.8"})
+		require.NoError(t, err)
+		assert.Contains(t, text, `"prod" in the organization "acme" to Terraform 1.9.8`)
+
+		_, err = getPrompt(t, handler, map[string]string{"terraform_org_name": "acme", "workspace_name": "prod"})
+		assert.ErrorContains(t, err, "target_version")
+	})
+}
@@ -0,0 +1,245 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// MODULE_BASE_PATH is the URI prefix of module doc resources
+	MODULE_BASE_PATH = "registry://modules"
+
+	// maxListedDocResources bounds the docs listed by resources/list
+	maxListedDocResources = 100
+)
+
+// providerDocTemplate returns the template of provider doc resources, e.g.
+// registry://providers/hashicorp/aws/5.100.0/docs/8894603
+func providerDocTemplate(logger *log.Logger) (mcp.ResourceTemplate, server.ResourceTemplateHandlerFunc) {
+	return mcp.NewResourceTemplate(
+			utils.PROVIDER_BASE_PATH+"/{namespace}/{name}/{version}/docs/{id}",
+			"Provider documentation",
+			mcp.WithTemplateDescription("A resource, data source, function or guide page of a Terraform provider's documentation. The id is a provider_doc_id returned by 'search_providers'"),
+			mcp.WithTemplateMIMEType("text/markdown"),
+		),
+		func(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+			namespace, name, version, docID, err := parseProviderDocURI(request.Params.URI)
+			if err != nil {
+				return nil, utils.LogAndReturnError(logger, "parsing provider doc URI", err)
+			}
+
+			httpClient, err := client.GetHttpClientFromContext(ctx, logger)
+			if err != nil {
+				return nil, utils.LogAndReturnError(logger, "getting http client for public Terraform registry", err)
+			}
+			response, err := client.SendRegistryCall(ctx, httpClient, "GET", path.Join("provider-docs", docID), logger, "v2")
+			if err != nil {
+				return nil, utils.LogAndReturnError(logger, fmt.Sprintf("getting provider doc %s", docID), err)
+			}
+			var details client.ProviderResourceDetails
+			if err := json.Unmarshal(response, &details); err != nil {
+				return nil, utils.LogAndReturnError(logger, "unmarshalling provider doc", err)
+			}
+
+			title := details.Data.Attributes.Title
+			if title == "" {
+				title = details.Data.Attributes.Slug
+			}
+			listDocResource(ctx, mcp.NewResource(
+				request.Params.URI,
+				fmt.Sprintf("%s/%s %s: %s", namespace, name, version, title),
+				mcp.WithResourceDescription(fmt.Sprintf("%s documentation of the %s/%s provider version %s", details.Data.Attributes.Category, namespace, name, version)),
+				mcp.WithMIMEType("text/markdown"),
+			), logger)
+
+			return []mcp.ResourceContents{
+				mcp.TextResourceContents{
+					MIMEType: "text/markdown",
+					URI:      request.Params.URI,
+					Text:     details.Data.Attributes.Content,
+				},
+			}, nil
+		}
+}
+
+// moduleDocTemplate returns the template of module doc resources, e.g.
+// registry://modules/terraform-aws-modules/vpc/aws/5.21.0/docs
+func moduleDocTemplate(logger *log.Logger) (mcp.ResourceTemplate, server.ResourceTemplateHandlerFunc) {
+	return mcp.NewResourceTemplate(
+			MODULE_BASE_PATH+"/{namespace}/{name}/{provider}/{version}/docs",
+			"Module documentation",
+			mcp.WithTemplateDescription("The README of a Terraform module version in the public registry, or of its latest version when the version is 'latest'"),
+			mcp.WithTemplateMIMEType("text/markdown"),
+		),
+		func(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+			moduleID, version, err := parseModuleDocURI(request.Params.URI)
+			if err != nil {
+				return nil, utils.LogAndReturnError(logger, "parsing module doc URI", err)
+			}
+
+			httpClient, err := client.GetHttpClientFromContext(ctx, logger)
+			if err != nil {
+				return nil, utils.LogAndReturnError(logger, "getting http client for public Terraform registry", err)
+			}
+			module, err := getModuleVersion(ctx, httpClient, moduleID, version, logger)
+			if err != nil {
+				return nil, utils.LogAndReturnError(logger, fmt.Sprintf("getting module %s version %s", moduleID, version), err)
+			}
+
+			listDocResource(ctx, mcp.NewResource(
+				request.Params.URI,
+				fmt.Sprintf("%s %s", moduleID, version),
+				mcp.WithResourceDescription(module.Description),
+				mcp.WithMIMEType("text/markdown"),
+			), logger)
+
+			return []mcp.ResourceContents{
+				mcp.TextResourceContents{
+					MIMEType: "text/markdown",
+					URI:      request.Params.URI,
+					Text:     moduleDocMarkdown(module),
+				},
+			}, nil
+		}
+}
+
+// parseProviderDocURI parses registry://providers/{namespace}/{name}/{version}/docs/{id}
+func parseProviderDocURI(uri string) (string, string, string, string, error) {
+	parts := strings.Split(strings.TrimPrefix(uri, utils.PROVIDER_BASE_PATH+"/"), "/")
+	if !strings.HasPrefix(uri, utils.PROVIDER_BASE_PATH+"/") || len(parts) != 5 || parts[3] != "docs" {
+		return "", "", "", "", fmt.Errorf("invalid provider doc URI %q, expected %s/{namespace}/{name}/{version}/docs/{id}", uri, utils.PROVIDER_BASE_PATH)
+	}
+	for _, part := range parts {
+		if part == "" {
+			return "", "", "", "", fmt.Errorf("invalid provider doc URI %q, expected %s/{namespace}/{name}/{version}/docs/{id}", uri, utils.PROVIDER_BASE_PATH)
+		}
+	}
+	if _, err := strconv.Atoi(parts[4]); err != nil {
+		return "", "", "", "", fmt.Errorf("provider doc id %q must be a number - use search_providers to find valid IDs", parts[4])
+	}
+	return parts[0], parts[1], parts[2], parts[4], nil
+}
+
+// parseModuleDocURI parses registry://modules/{namespace}/{name}/{provider}/{version}/docs into a
+// module ID (namespace/name/provider) and version
+func parseModuleDocURI(uri string) (string, string, error) {
+	parts := strings.Split(strings.TrimPrefix(uri, MODULE_BASE_PATH+"/"), "/")
+	if !strings.HasPrefix(uri, MODULE_BASE_PATH+"/") || len(parts) != 5 || parts[4] != "docs" {
+		return "", "", fmt.Errorf("invalid module doc URI %q, expected %s/{namespace}/{name}/{provider}/{version}/docs", uri, MODULE_BASE_PATH)
+	}
+	for _, part := range parts {
+		if part == "" {
+			return "", "", fmt.Errorf("invalid module doc URI %q, expected %s/{namespace}/{name}/{provider}/{version}/docs", uri, MODULE_BASE_PATH)
+		}
+	}
+	return strings.ToLower(path.Join(parts[0], parts[1], parts[2])), parts[3], nil
+}
+
+// getModuleVersion fetches a module version, or its latest version
+func getModuleVersion(ctx context.Context, httpClient *http.Client, moduleID string, version string, logger *log.Logger) (*client.TerraformModuleVersionDetails, error) {
+	uri := path.Join("modules", moduleID)
+	if version != "latest" {
+		uri = path.Join(uri, version)
+	}
+	response, err := client.SendRegistryCall(ctx, httpClient, "GET", uri, logger)
+	if err != nil {
+		return nil, err
+	}
+	var module client.TerraformModuleVersionDetails
+	if err := json.Unmarshal(response, &module); err != nil {
+		return nil, fmt.Errorf("unmarshalling module details: %w", err)
+	}
+	return &module, nil
+}
+
+// moduleDocMarkdown renders the README of a module version with a short header
+func moduleDocMarkdown(module *client.TerraformModuleVersionDetails) string {
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "# %s/%s/%s %s\n\n", module.Namespace, module.Name, module.Provider, module.Version)
+	if module.Description != "" {
+		fmt.Fprintf(&builder, "%s\n\n", module.Description)
+	}
+	fmt.Fprintf(&builder, "**Source:** %s\n\n", module.Source)
+	builder.WriteString(module.Root.Readme)
+	return builder.String()
+}
+
+// docResources tracks the doc resources read through the templates. They are added to the server as
+// concrete resources, so that clients listing resources can find and pin them without a template.
+type docResources struct {
+	mu   sync.Mutex
+	uris []string
+}
+
+var listedDocResources = &docResources{}
+
+// listDocResource adds a doc resource to resources/list, removing the least recently read one once
+// maxListedDocResources is reached
+func listDocResource(ctx context.Context, resource mcp.Resource, logger *log.Logger) {
+	mcpServer := server.ServerFromContext(ctx)
+	if mcpServer == nil {
+		return
+	}
+	evicted, added := listedDocResources.touch(resource.URI, maxListedDocResources)
+	if len(evicted) > 0 {
+		mcpServer.DeleteResources(evicted...)
+	}
+	if !added {
+		return
+	}
+	mcpServer.AddResource(resource, func(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+		// Doc resources are read through the template handlers, which match the same URIs
+		handler := docTemplateHandler(request.Params.URI, logger)
+		if handler == nil {
+			return nil, fmt.Errorf("no template for resource %s", request.Params.URI)
+		}
+		return handler(ctx, request)
+	})
+}
+
+// touch records that a URI was read, and returns the URIs to remove and whether the URI is new
+func (d *docResources) touch(uri string, limit int) ([]string, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for i, listed := range d.uris {
+		if listed == uri {
+			d.uris = append(append(d.uris[:i:i], d.uris[i+1:]...), uri)
+			return nil, false
+		}
+	}
+	d.uris = append(d.uris, uri)
+	var evicted []string
+	if len(d.uris) > limit {
+		evicted = append(evicted, d.uris[:len(d.uris)-limit]...)
+		d.uris = append([]string(nil), d.uris[len(d.uris)-limit:]...)
+	}
+	return evicted, true
+}
+
+// docTemplateHandler returns the template handler of a doc resource URI
+func docTemplateHandler(uri string, logger *log.Logger) server.ResourceTemplateHandlerFunc {
+	switch {
+	case strings.HasPrefix(uri, utils.PROVIDER_BASE_PATH+"/"):
+		_, handler := providerDocTemplate(logger)
+		return handler
+	case strings.HasPrefix(uri, MODULE_BASE_PATH+"/"):
+		_, handler := moduleDocTemplate(logger)
+		return handler
+	}
+	return nil
+}
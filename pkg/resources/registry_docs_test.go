@@ -0,0 +1,62 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseProviderDocURI(t *testing.T) {
+	namespace, name, version, docID, err := parseProviderDocURI("registry://providers/hashicorp/aws/5.100.0/docs/8894603")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"hashicorp", "aws", "5.100.0", "8894603"}, []string{namespace, name, version, docID})
+
+	for _, uri := range []string{
+		"registry://providers/hashicorp/aws/5.100.0/docs/instance",
+		"registry://providers/hashicorp/aws/5.100.0/docs",
+		"registry://providers/hashicorp/name/aws/version/5.100.0",
+		"registry://modules/hashicorp/aws/5.100.0/docs/8894603",
+		"registry://providers/hashicorp//5.100.0/docs/8894603",
+	} {
+		_, _, _, _, err := parseProviderDocURI(uri)
+		assert.Error(t, err, uri)
+	}
+}
+
+func TestParseModuleDocURI(t *testing.T) {
+	moduleID, version, err := parseModuleDocURI("registry://modules/terraform-aws-modules/VPC/aws/latest/docs")
+	require.NoError(t, err)
+	assert.Equal(t, "terraform-aws-modules/vpc/aws", moduleID)
+	assert.Equal(t, "latest", version)
+
+	for _, uri := range []string{
+		"registry://modules/terraform-aws-modules/vpc/aws/docs",
+		"registry://modules/terraform-aws-modules/vpc/aws/5.21.0/readme",
+		"registry://providers/terraform-aws-modules/vpc/aws/5.21.0/docs",
+	} {
+		_, _, err := parseModuleDocURI(uri)
+		assert.Error(t, err, uri)
+	}
+}
+
+func TestDocResourcesTouch(t *testing.T) {
+	docs := &docResources{}
+
+	evicted, added := docs.touch("a", 2)
+	assert.True(t, added)
+	assert.Empty(t, evicted)
+	_, added = docs.touch("b", 2)
+	assert.True(t, added)
+
+	// Reading a listed doc again keeps it from being evicted
+	_, added = docs.touch("a", 2)
+	assert.False(t, added)
+	evicted, added = docs.touch("c", 2)
+	assert.True(t, added)
+	assert.Equal(t, []string{"b"}, evicted)
+	assert.Equal(t, []string{"a", "c"}, docs.uris)
+}
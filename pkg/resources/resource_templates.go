@@ -24,6 +24,8 @@ func RegisterResourceTemplates(hcServer *server.MCPServer, logger *log.Logger) {
 			logger,
 		),
 	)
+	hcServer.AddResourceTemplate(providerDocTemplate(logger))
+	hcServer.AddResourceTemplate(moduleDocTemplate(logger))
 }
 
 func providerResourceTemplate(resourceURI string, description string, logger *log.Logger) (mcp.ResourceTemplate, server.ResourceTemplateHandlerFunc) {
@@ -0,0 +1,204 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// AuditLogEnv sets where an audit record of every tool call is written as a JSON line: "stdout",
+// "stderr" or the path of a file the records are appended to. Audit logging is disabled when it is empty.
+// With the stdio transport, "stdout" carries the MCP messages and records go to stderr instead.
+const AuditLogEnv = "MCP_AUDIT_LOG"
+
+const (
+	redactedValue        = utils.RedactedValue
+	maxAuditStringLength = 1024
+	maxAuditErrorLength  = 512
+)
+
+// AuditRecord is the audit log entry of a tool call
+type AuditRecord struct {
+	Time       string         `json:"time"`
+	Tool       string         `json:"tool"`
+	ReadOnly   bool           `json:"read_only"`
+	SessionID  string         `json:"session_id,omitempty"`
+	Arguments  map[string]any `json:"arguments,omitempty"`
+	DurationMs int64          `json:"duration_ms"`
+	Success    bool           `json:"success"`
+	Error      string         `json:"error,omitempty"`
+}
+
+// auditWriter writes audit records as JSON lines, one call at a time
+type auditWriter struct {
+	mu  sync.Mutex
+	out io.Writer
+}
+
+func (w *auditWriter) write(record *AuditRecord) error {
+	line, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	_, err = w.out.Write(append(line, '\n'))
+	return err
+}
+
+var (
+	sharedAuditWriter     *auditWriter
+	sharedAuditWriterOnce sync.Once
+)
+
+// configureAuditLog opens the audit log shared by every tool for the transport the tools are served
+// over. It is called once before the tools are registered.
+func configureAuditLog(transport Transport, logger *log.Logger) {
+	sharedAuditWriterOnce.Do(func() {
+		sharedAuditWriter = newAuditWriter(strings.TrimSpace(utils.GetEnv(AuditLogEnv, "")), transport, logger)
+	})
+}
+
+// newAuditWriter opens an audit log destination, or returns nil when audit logging is disabled
+func newAuditWriter(destination string, transport Transport, logger *log.Logger) *auditWriter {
+	var writer *auditWriter
+	switch destination {
+	case "":
+		return nil
+	case "stdout":
+		if transport == TransportStdio {
+			logger.Warnf("%s=stdout is not supported with the stdio transport, which carries the MCP messages on stdout, writing the audit log to stderr", AuditLogEnv)
+			destination = "stderr"
+			writer = &auditWriter{out: os.Stderr}
+		} else {
+			writer = &auditWriter{out: os.Stdout}
+		}
+	case "stderr":
+		writer = &auditWriter{out: os.Stderr}
+	default:
+		file, err := os.OpenFile(destination, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+		if err != nil {
+			logger.Errorf("Failed to open the audit log %s, audit logging disabled: %v", destination, err)
+			return nil
+		}
+		writer = &auditWriter{out: file}
+	}
+	logger.Infof("Audit logging of tool calls enabled to %s", destination)
+	return writer
+}
+
+// getAuditWriter returns the audit writer shared by every tool, or nil when audit logging is disabled
+func getAuditWriter() *auditWriter {
+	return sharedAuditWriter
+}
+
+// withAuditLog wraps the handler of a tool to write an audit record of every call, with its
+// redacted arguments, session, duration and outcome, when audit logging is enabled
+func withAuditLog(tool server.ServerTool, logger *log.Logger) server.ServerTool {
+	writer := getAuditWriter()
+	if writer == nil {
+		return tool
+	}
+	return auditedTool(tool, writer, logger)
+}
+
+// auditedTool wraps the handler of a tool to write the audit record of every call with a writer
+func auditedTool(tool server.ServerTool, writer *auditWriter, logger *log.Logger) server.ServerTool {
+	handler := tool.Handler
+	readOnly := isReadOnlyTool(tool.Tool)
+	tool.Handler = func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		start := time.Now()
+		result, err := handler(ctx, request)
+
+		record := &AuditRecord{
+			Time:       start.UTC().Format(time.RFC3339Nano),
+			Tool:       tool.Tool.Name,
+			ReadOnly:   readOnly,
+			Arguments:  redactArguments(request.GetArguments()),
+			DurationMs: time.Since(start).Milliseconds(),
+			Success:    err == nil && (result == nil || !result.IsError),
+		}
+		if session := server.ClientSessionFromContext(ctx); session != nil {
+			record.SessionID = session.SessionID()
+		}
+		if err != nil {
+			record.Error = truncateAuditString(err.Error(), maxAuditErrorLength)
+		} else if result != nil && result.IsError {
+			record.Error = truncateAuditString(resultText(result), maxAuditErrorLength)
+		}
+
+		if writeErr := writer.write(record); writeErr != nil {
+			logger.Errorf("Failed to write the audit record of tool %s: %v", tool.Tool.Name, writeErr)
+		}
+		return result, err
+	}
+	return tool
+}
+
+// redactArguments returns a copy of tool arguments with the values of arguments named like secrets,
+// the values of variables marked sensitive and embedded secrets redacted, and long strings truncated
+func redactArguments(arguments map[string]any) map[string]any {
+	if len(arguments) == 0 {
+		return nil
+	}
+	redacted, _ := redactValue(arguments).(map[string]any)
+	return redacted
+}
+
+func redactValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		sensitive, _ := v["sensitive"].(bool)
+		redacted := make(map[string]any, len(v))
+		for key, item := range v {
+			if utils.IsSecretName(key) || (sensitive && key == "value") {
+				redacted[key] = redactedValue
+				continue
+			}
+			redacted[key] = redactValue(item)
+		}
+		return redacted
+	case []any:
+		redacted := make([]any, len(v))
+		for i, item := range v {
+			redacted[i] = redactValue(item)
+		}
+		return redacted
+	case string:
+		return truncateAuditString(utils.RedactSecrets(v), maxAuditStringLength)
+	default:
+		return v
+	}
+}
+
+// truncateAuditString shortens long strings, such as uploaded configurations, in audit records
+func truncateAuditString(s string, maxLength int) string {
+	if len(s) <= maxLength {
+		return s
+	}
+	return fmt.Sprintf("%s... (%d characters truncated)", s[:maxLength], len(s)-maxLength)
+}
+
+// resultText returns the text contents of a result, such as the message of an error result
+func resultText(result *mcp.CallToolResult) string {
+	var texts []string
+	for _, content := range result.Content {
+		if text, ok := content.(mcp.TextContent); ok {
+			texts = append(texts, text.Text)
+		}
+	}
+	return strings.Join(texts, "\n")
+}
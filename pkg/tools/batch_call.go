@@ -0,0 +1,204 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// maxBatchCalls bounds the tool calls of a batch, as a batch counts as a single call for rate limiting
+	maxBatchCalls = 10
+	// batchConcurrency is the number of calls of a batch run at the same time
+	batchConcurrency = 5
+)
+
+// batchExcludedTools can't be called in a batch, even though they are annotated as read-only:
+// batch_call itself, and the credentials tools whose effect on the session must not race other calls
+var batchExcludedTools = map[string]bool{
+	"batch_call":                    true,
+	"set_hcp_terraform_credentials": true,
+	"clear_credentials":             true,
+}
+
+// BatchCallResult are the results of the tool calls of a batch, in the order of the calls
+type BatchCallResult struct {
+	Results        []*BatchCallItem `json:"results"`
+	SucceededCount int              `json:"succeeded_count"`
+	FailedCount    int              `json:"failed_count"`
+}
+
+// BatchCallItem is the result of a tool call of a batch. Result is the structured content of
+// the tool result, or Text when the tool doesn't return JSON.
+type BatchCallItem struct {
+	Tool    string `json:"tool"`
+	Success bool   `json:"success"`
+	Result  any    `json:"result,omitempty"`
+	Text    string `json:"text,omitempty"`
+	Error   string `json:"error,omitempty"`
+}
+
+// batchCall is a tool call of a batch
+type batchCall struct {
+	Tool      string         `json:"tool"`
+	Arguments map[string]any `json:"arguments"`
+}
+
+// BatchCall creates a tool to call several read-only tools of the server in one request.
+func BatchCall(hcServer *server.MCPServer, logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("batch_call",
+			mcp.WithDescription(fmt.Sprintf(`Calls up to %d read-only tools of this server concurrently in a single request and returns their results in the order of the calls. A call that fails doesn't fail the others: its error is returned in its result.
+Use it to assemble overviews that need several independent reads, e.g. get_workspace_details, list_runs and get_workspace_outputs of a workspace, instead of calling them one after another. Tools that modify anything can't be batched.`, maxBatchCalls)),
+			mcp.WithTitleAnnotation("Call several read-only tools at once"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithArray("calls",
+				mcp.Required(),
+				mcp.Description("The tool calls, each with the name of the tool and its arguments"),
+				mcp.MinItems(1),
+				mcp.MaxItems(maxBatchCalls),
+				mcp.Items(map[string]any{
+					"type": "object",
+					"properties": map[string]any{
+						"tool":      map[string]any{"type": "string", "description": "The name of a read-only tool, e.g. 'get_workspace_details'"},
+						"arguments": map[string]any{"type": "object", "description": "The arguments of the tool"},
+					},
+					"required": []string{"tool"},
+				}),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return batchCallHandler(ctx, request, hcServer.GetTool, logger)
+		},
+	}
+}
+
+func batchCallHandler(ctx context.Context, request mcp.CallToolRequest, getTool func(string) *server.ServerTool, logger *log.Logger) (*mcp.CallToolResult, error) {
+	calls, err := parseBatchCalls(request.GetArguments()["calls"])
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	result := &BatchCallResult{Results: make([]*BatchCallItem, len(calls))}
+	var wg sync.WaitGroup
+	slots := make(chan struct{}, batchConcurrency)
+	for i, call := range calls {
+		wg.Add(1)
+		go func(i int, call batchCall) {
+			defer wg.Done()
+			slots <- struct{}{}
+			defer func() { <-slots }()
+			result.Results[i] = runBatchCall(ctx, call, getTool, logger)
+		}(i, call)
+	}
+	wg.Wait()
+
+	for _, item := range result.Results {
+		if item.Success {
+			result.SucceededCount++
+		} else {
+			result.FailedCount++
+		}
+	}
+	logger.WithFields(log.Fields{
+		"calls":     len(calls),
+		"succeeded": result.SucceededCount,
+		"failed":    result.FailedCount,
+	}).Debug("Ran batch of tool calls")
+
+	buf, err := json.Marshal(result)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to marshal batch results: %v", err)), nil
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+// parseBatchCalls validates the calls argument of batch_call
+func parseBatchCalls(value any) ([]batchCall, error) {
+	items, ok := value.([]any)
+	if !ok || len(items) == 0 {
+		return nil, fmt.Errorf("calls must be a non-empty array of tool calls")
+	}
+	if len(items) > maxBatchCalls {
+		return nil, fmt.Errorf("a batch can make at most %d calls, got %d", maxBatchCalls, len(items))
+	}
+
+	calls := make([]batchCall, len(items))
+	for i, item := range items {
+		object, ok := item.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("calls[%d] must be an object with a tool and its arguments", i)
+		}
+		name, _ := object["tool"].(string)
+		calls[i].Tool = strings.TrimSpace(name)
+		if calls[i].Tool == "" {
+			return nil, fmt.Errorf("calls[%d] is missing the name of the tool", i)
+		}
+		switch arguments := object["arguments"].(type) {
+		case nil:
+			calls[i].Arguments = map[string]any{}
+		case map[string]any:
+			calls[i].Arguments = arguments
+		default:
+			return nil, fmt.Errorf("calls[%d].arguments must be an object", i)
+		}
+	}
+	return calls, nil
+}
+
+// runBatchCall calls a tool of a batch, turning errors and panics into the error of its result
+func runBatchCall(ctx context.Context, call batchCall, getTool func(string) *server.ServerTool, logger *log.Logger) (item *BatchCallItem) {
+	item = &BatchCallItem{Tool: call.Tool}
+	tool := getTool(call.Tool)
+	switch {
+	case tool == nil:
+		item.Error = fmt.Sprintf("tool '%s' not found", call.Tool)
+		return item
+	case batchExcludedTools[call.Tool]:
+		item.Error = fmt.Sprintf("tool '%s' can't be called in a batch", call.Tool)
+		return item
+	case !isReadOnlyTool(tool.Tool):
+		item.Error = fmt.Sprintf("tool '%s' is not read-only and can't be called in a batch", call.Tool)
+		return item
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			logger.Errorf("Tool %s panicked in a batch: %v", call.Tool, r)
+			item.Success = false
+			item.Error = fmt.Sprintf("tool '%s' failed unexpectedly", call.Tool)
+		}
+	}()
+
+	request := mcp.CallToolRequest{}
+	request.Params.Name = call.Tool
+	request.Params.Arguments = call.Arguments
+	callResult, err := tool.Handler(ctx, request)
+	switch {
+	case err != nil:
+		item.Error = err.Error()
+	case callResult == nil:
+		item.Error = "the tool returned no result"
+	case callResult.IsError:
+		item.Error = resultText(callResult)
+	default:
+		item.Success = true
+		if callResult.StructuredContent != nil {
+			item.Result = callResult.StructuredContent
+		} else {
+			item.Text = resultText(callResult)
+		}
+	}
+	return item
+}
@@ -0,0 +1,103 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatchCall(t *testing.T) {
+	logger := log.New()
+	logger.SetLevel(log.PanicLevel)
+
+	newTool := func(name string, readOnly bool, handler server.ToolHandlerFunc) *server.ServerTool {
+		return &server.ServerTool{Tool: mcp.NewTool(name, mcp.WithReadOnlyHintAnnotation(readOnly)), Handler: handler}
+	}
+	tools := map[string]*server.ServerTool{
+		"get_workspace_details": newTool("get_workspace_details", true, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			result := mcp.NewToolResultText(`{"name":"app"}`)
+			result.StructuredContent = map[string]any{"name": request.GetString("workspace_name", "")}
+			return result, nil
+		}),
+		"get_provider_details": newTool("get_provider_details", true, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return mcp.NewToolResultText("# aws_instance"), nil
+		}),
+		"list_runs": newTool("list_runs", true, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return mcp.NewToolResultError("workspace not found"), nil
+		}),
+		"get_plan_logs": newTool("get_plan_logs", true, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return nil, errors.New("connection reset")
+		}),
+		"get_run_details": newTool("get_run_details", true, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			panic("nil pointer")
+		}),
+		"create_run": newTool("create_run", false, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			t.Fatal("tools that are not read-only must not be called")
+			return nil, nil
+		}),
+		"set_hcp_terraform_credentials": newTool("set_hcp_terraform_credentials", true, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			t.Fatal("credentials tools must not be called")
+			return nil, nil
+		}),
+	}
+	getTool := func(name string) *server.ServerTool { return tools[name] }
+	call := func(calls any) *mcp.CallToolResult {
+		request := mcp.CallToolRequest{}
+		request.Params.Arguments = map[string]any{"calls": calls}
+		result, err := batchCallHandler(context.Background(), request, getTool, logger)
+		require.NoError(t, err)
+		return result
+	}
+
+	t.Run("isolates the errors of the calls", func(t *testing.T) {
+		result := call([]any{
+			map[string]any{"tool": "get_workspace_details", "arguments": map[string]any{"workspace_name": "app"}},
+			map[string]any{"tool": "get_provider_details"},
+			map[string]any{"tool": "list_runs", "arguments": map[string]any{}},
+			map[string]any{"tool": "get_plan_logs"},
+			map[string]any{"tool": "get_run_details"},
+			map[string]any{"tool": "create_run"},
+			map[string]any{"tool": "set_hcp_terraform_credentials"},
+			map[string]any{"tool": "missing_tool"},
+		})
+		require.False(t, result.IsError)
+
+		var batch BatchCallResult
+		require.NoError(t, json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &batch))
+		assert.Equal(t, 2, batch.SucceededCount)
+		assert.Equal(t, 6, batch.FailedCount)
+		require.Len(t, batch.Results, 8)
+		assert.Equal(t, &BatchCallItem{Tool: "get_workspace_details", Success: true, Result: map[string]any{"name": "app"}}, batch.Results[0])
+		assert.Equal(t, &BatchCallItem{Tool: "get_provider_details", Success: true, Text: "# aws_instance"}, batch.Results[1])
+		assert.Equal(t, "workspace not found", batch.Results[2].Error)
+		assert.Equal(t, "connection reset", batch.Results[3].Error)
+		assert.Equal(t, "tool 'get_run_details' failed unexpectedly", batch.Results[4].Error)
+		assert.Contains(t, batch.Results[5].Error, "not read-only")
+		assert.Contains(t, batch.Results[6].Error, "can't be called in a batch")
+		assert.Equal(t, "tool 'missing_tool' not found", batch.Results[7].Error)
+	})
+
+	t.Run("validates the calls", func(t *testing.T) {
+		assert.True(t, call(nil).IsError)
+		assert.True(t, call([]any{}).IsError)
+		assert.True(t, call([]any{"get_workspace_details"}).IsError)
+		assert.True(t, call([]any{map[string]any{"arguments": map[string]any{}}}).IsError)
+		assert.True(t, call([]any{map[string]any{"tool": "list_runs", "arguments": "workspace_name=app"}}).IsError)
+
+		tooMany := make([]any, maxBatchCalls+1)
+		for i := range tooMany {
+			tooMany[i] = map[string]any{"tool": "get_provider_details"}
+		}
+		assert.True(t, call(tooMany).IsError)
+	})
+}
@@ -0,0 +1,182 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// SessionCredentialsResult is returned when credentials are stored for a session. The token itself
+// is never returned.
+type SessionCredentialsResult struct {
+	Address  string `json:"address"`
+	Username string `json:"username,omitempty"`
+	Message  string `json:"message"`
+}
+
+// SetCredentials creates a tool to store a Terraform token for the session. It is only served over
+// the stdio transport, as it lets the client choose the instance the server sends the token to.
+func SetCredentials(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("set_hcp_terraform_credentials",
+			mcp.WithDescription(`Stores an HCP Terraform or Terraform Enterprise API token, and optionally the address of the instance, in server memory for the current session, so that the following tool calls use it without the token being repeated.
+The token is checked against the instance before it is stored, is never returned or logged, and is forgotten when the session ends or clear_credentials is called. It takes precedence over TFE_TOKEN and the token sent with the requests.`),
+			mcp.WithTitleAnnotation("Set the Terraform credentials of the session"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(false),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("token",
+				mcp.Required(),
+				mcp.Description("The user, team or organization API token"),
+			),
+			mcp.WithString("address",
+				mcp.Description("The base URL of the HCP Terraform or Terraform Enterprise instance. Defaults to TFE_ADDRESS or https://app.terraform.io"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return setCredentialsHandler(ctx, request, logger)
+		},
+	}
+}
+
+// ClearCredentials creates a tool to forget the Terraform token stored for the session.
+func ClearCredentials(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("clear_credentials",
+			mcp.WithDescription(`Forgets the Terraform token stored for the current session with set_hcp_terraform_credentials. The following tool calls use TFE_TOKEN or the token sent with the requests again, if any.`),
+			mcp.WithTitleAnnotation("Clear the Terraform credentials of the session"),
+			mcp.WithOpenWorldHintAnnotation(false),
+			mcp.WithReadOnlyHintAnnotation(false),
+			mcp.WithDestructiveHintAnnotation(false),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return clearCredentialsHandler(ctx, logger)
+		},
+	}
+}
+
+func setCredentialsHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	token, err := request.RequireString("token")
+	if err != nil || strings.TrimSpace(token) == "" {
+		return mcp.NewToolResultError("missing required input: token"), nil
+	}
+	token = strings.TrimSpace(token)
+
+	address, err := parseCredentialsAddress(request.GetString("address", ""))
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	session := server.ClientSessionFromContext(ctx)
+	user, err := client.SetSessionCredentials(ctx, session, address, token, logger)
+	if err != nil {
+		logger.WithError(err).Warn("Failed to set the credentials of the session")
+		return mcp.NewToolResultError(fmt.Sprintf("failed to set credentials: %v", err)), nil
+	}
+	if registry := GetDynamicToolRegistry(); registry != nil {
+		registry.RegisterSessionWithTFE(session.SessionID())
+	}
+
+	result := SessionCredentialsResult{
+		Address: address,
+		Message: "Credentials stored for this session. Terraform tools use them until clear_credentials is called or the session ends.",
+	}
+	if user != nil {
+		result.Username = user.Username
+	}
+	logger.WithField("address", address).Info("Stored the credentials of the session")
+
+	buf, err := json.Marshal(result)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to marshal result: %v", err)), nil
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+func clearCredentialsHandler(ctx context.Context, logger *log.Logger) (*mcp.CallToolResult, error) {
+	session := server.ClientSessionFromContext(ctx)
+	if session == nil || session.SessionID() == "" || !client.ClearSessionCredentials(session.SessionID()) {
+		return mcp.NewToolResultText("No credentials were stored for this session."), nil
+	}
+	logger.Info("Cleared the credentials of the session")
+
+	// Fall back to the token of the environment or the requests, when there is one
+	registry := GetDynamicToolRegistry()
+	if _, err := client.CreateTfeClientForSession(ctx, session, logger); err != nil {
+		if registry != nil {
+			registry.UnregisterSessionWithTFE(session.SessionID())
+		}
+		return mcp.NewToolResultText("Credentials cleared. No other Terraform token is configured, Terraform tools are unavailable until credentials are set again."), nil
+	}
+	return mcp.NewToolResultText("Credentials cleared. Terraform tools use the token configured for the server again."), nil
+}
+
+// parseCredentialsAddress validates the address of the instance credentials are set for
+func parseCredentialsAddress(address string) (string, error) {
+	address = strings.TrimSpace(address)
+	if address == "" {
+		address = strings.TrimSpace(utils.GetEnv(client.TerraformAddress, ""))
+	}
+	if address == "" {
+		address = client.DefaultTerraformAddress
+	}
+	parsed, err := url.Parse(address)
+	if err != nil || (parsed.Scheme != "https" && parsed.Scheme != "http") || parsed.Host == "" {
+		return "", fmt.Errorf("invalid address '%s': must be a URL such as https://app.terraform.io", address)
+	}
+	return strings.TrimSuffix(address, "/"), nil
+}
+
+var standardLoggerRedactionOnce sync.Once
+
+// addCredentialRedactionHook redacts the Terraform tokens from the logs of the server logger and of
+// the packages that log through the logrus standard logger
+func addCredentialRedactionHook(logger *log.Logger) {
+	if logger != log.StandardLogger() {
+		logger.AddHook(credentialRedactionHook{})
+	}
+	standardLoggerRedactionOnce.Do(func() {
+		log.AddHook(credentialRedactionHook{})
+	})
+}
+
+// credentialRedactionHook redacts Terraform tokens, including the ones stored for the sessions,
+// from log messages and fields
+type credentialRedactionHook struct{}
+
+// Levels implements the logrus.Hook interface
+func (credentialRedactionHook) Levels() []log.Level {
+	return log.AllLevels
+}
+
+// Fire implements the logrus.Hook interface
+func (credentialRedactionHook) Fire(entry *log.Entry) error {
+	entry.Message = redactCredentials(entry.Message)
+	for key, value := range entry.Data {
+		switch v := value.(type) {
+		case string:
+			entry.Data[key] = redactCredentials(v)
+		case error:
+			if redacted := redactCredentials(v.Error()); redacted != v.Error() {
+				entry.Data[key] = redacted
+			}
+		}
+	}
+	return nil
+}
+
+func redactCredentials(value string) string {
+	return client.RedactSessionTokens(utils.RedactSecrets(value), redactedValue)
+}
@@ -0,0 +1,86 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCredentialsAddress(t *testing.T) {
+	t.Setenv("TFE_ADDRESS", "")
+
+	tests := []struct {
+		name     string
+		address  string
+		expected string
+		wantErr  bool
+	}{
+		{name: "defaults to HCP Terraform", address: "", expected: "https://app.terraform.io"},
+		{name: "trims the trailing slash", address: " https://tfe.example.com/ ", expected: "https://tfe.example.com"},
+		{name: "rejects addresses without a scheme", address: "tfe.example.com", wantErr: true},
+		{name: "rejects other schemes", address: "ftp://tfe.example.com", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			address, err := parseCredentialsAddress(tt.address)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, address)
+		})
+	}
+}
+
+func TestCredentialRedactionHook(t *testing.T) {
+	logger := log.New()
+	logger.SetOutput(io.Discard)
+	logger.AddHook(credentialRedactionHook{})
+	hook := test.NewLocal(logger)
+	token := "abcdefghijklmn.atlasv1.abcdefghijklmnopqrstuvwxyz"
+
+	logger.WithField("token", token).WithError(errors.New("bad token " + token)).Info("using " + token)
+
+	entry := hook.LastEntry()
+	require.NotNil(t, entry)
+	assert.NotContains(t, entry.Message, token)
+	assert.Equal(t, redactedValue, entry.Data["token"])
+	assert.Equal(t, "bad token "+redactedValue, entry.Data["error"])
+}
+
+func TestCredentialsTools(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	ctx := server.NewMCPServer("test", "1.0.0").WithContext(context.Background(), statelessSession{})
+
+	t.Run("set requires a session", func(t *testing.T) {
+		request := mcp.CallToolRequest{}
+		request.Params.Arguments = map[string]any{"token": "abc", "address": "https://tfe.example.com"}
+		result, err := SetCredentials(logger).Handler(ctx, request)
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+	})
+
+	t.Run("set requires a token", func(t *testing.T) {
+		result, err := SetCredentials(logger).Handler(ctx, mcp.CallToolRequest{})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+	})
+
+	t.Run("clear without stored credentials", func(t *testing.T) {
+		result, err := ClearCredentials(logger).Handler(ctx, mcp.CallToolRequest{})
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+	})
+}
@@ -0,0 +1,185 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package custom
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/toolsets"
+)
+
+// CustomToolsFile is the environment variable pointing to the custom REST tool definitions
+const CustomToolsFile = "MCP_CUSTOM_TOOLS_FILE"
+
+// DefaultTimeout is used for custom tools that don't declare a timeout
+const DefaultTimeout = 30 * time.Second
+
+var (
+	toolNamePattern  = regexp.MustCompile(`^[a-zA-Z0-9_-]{1,64}$`)
+	pathParamPattern = regexp.MustCompile(`\{([a-zA-Z0-9_]+)\}`)
+	// pathParamMarker replaces the placeholders of the URL when it is validated
+	pathParamMarker    = "mcp-path-param"
+	allowedHTTPMethods = []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete}
+)
+
+// Config is the content of the custom tools file
+type Config struct {
+	Tools []*Definition `json:"tools"`
+}
+
+// Definition declares a tool that forwards its arguments to a downstream REST API.
+//
+// The URL may contain {param} placeholders that are replaced with path-escaped arguments.
+// Header values may reference environment variables as ${VAR} so secrets stay out of the file.
+type Definition struct {
+	Name           string            `json:"name"`
+	Title          string            `json:"title,omitempty"`
+	Description    string            `json:"description"`
+	Method         string            `json:"method,omitempty"`
+	URL            string            `json:"url"`
+	Headers        map[string]string `json:"headers,omitempty"`
+	QueryParams    []string          `json:"query_params,omitempty"`
+	BodyParams     []string          `json:"body_params,omitempty"`
+	InputSchema    json.RawMessage   `json:"input_schema"`
+	ReadOnly       *bool             `json:"read_only,omitempty"`
+	TimeoutSeconds int               `json:"timeout_seconds,omitempty"`
+}
+
+type inputSchema struct {
+	Type       string                     `json:"type"`
+	Properties map[string]json.RawMessage `json:"properties"`
+	Required   []string                   `json:"required"`
+}
+
+// LoadConfig reads and validates the custom tool definitions from a file
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read custom tools file: %w", err)
+	}
+	return ParseConfig(data)
+}
+
+// ParseConfig parses and validates custom tool definitions
+func ParseConfig(data []byte) (*Config, error) {
+	var config Config
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&config); err != nil {
+		return nil, fmt.Errorf("failed to parse custom tools file: %w", err)
+	}
+
+	seen := make(map[string]bool)
+	for i, def := range config.Tools {
+		if def == nil {
+			return nil, fmt.Errorf("custom tool #%d is empty", i+1)
+		}
+		if err := def.validate(); err != nil {
+			return nil, fmt.Errorf("custom tool %q: %w", def.Name, err)
+		}
+		if seen[def.Name] {
+			return nil, fmt.Errorf("custom tool %q is declared more than once", def.Name)
+		}
+		seen[def.Name] = true
+	}
+	return &config, nil
+}
+
+func (d *Definition) validate() error {
+	if !toolNamePattern.MatchString(d.Name) {
+		return fmt.Errorf("name must match %s", toolNamePattern)
+	}
+	if _, exists := toolsets.GetToolsetForTool(d.Name); exists {
+		return fmt.Errorf("name conflicts with a built-in tool")
+	}
+	if strings.TrimSpace(d.Description) == "" {
+		return fmt.Errorf("description is required")
+	}
+
+	d.Method = strings.ToUpper(strings.TrimSpace(d.Method))
+	if d.Method == "" {
+		d.Method = http.MethodGet
+	}
+	if !slices.Contains(allowedHTTPMethods, d.Method) {
+		return fmt.Errorf("method must be one of %s", strings.Join(allowedHTTPMethods, ", "))
+	}
+
+	u, err := url.Parse(pathParamPattern.ReplaceAllString(d.URL, pathParamMarker))
+	if err != nil || u.Host == "" || (u.Scheme != "https" && u.Scheme != "http") {
+		return fmt.Errorf("url must be an absolute http or https URL")
+	}
+	// Parameters can't choose the host the request is sent to
+	if strings.Contains(u.Scheme+u.User.String()+u.Host+u.RawQuery+u.Fragment, pathParamMarker) {
+		return fmt.Errorf("url placeholders are only allowed in the path, use query_params for query parameters")
+	}
+
+	if d.TimeoutSeconds < 0 {
+		return fmt.Errorf("timeout_seconds must not be negative")
+	}
+
+	schema, err := d.schema()
+	if err != nil {
+		return err
+	}
+	params := append(append(d.PathParams(), d.QueryParams...), d.BodyParams...)
+	for _, param := range params {
+		if _, ok := schema.Properties[param]; !ok {
+			return fmt.Errorf("parameter %q is not declared in input_schema", param)
+		}
+	}
+	for _, param := range d.PathParams() {
+		if !slices.Contains(schema.Required, param) {
+			return fmt.Errorf("path parameter %q must be required in input_schema", param)
+		}
+	}
+	return nil
+}
+
+func (d *Definition) schema() (*inputSchema, error) {
+	if len(d.InputSchema) == 0 {
+		d.InputSchema = json.RawMessage(`{"type":"object","properties":{}}`)
+	}
+	var schema inputSchema
+	if err := json.Unmarshal(d.InputSchema, &schema); err != nil {
+		return nil, fmt.Errorf("input_schema is not a valid JSON schema: %w", err)
+	}
+	if schema.Type != "object" {
+		return nil, fmt.Errorf("input_schema must be of type object")
+	}
+	return &schema, nil
+}
+
+// PathParams returns the names of the {param} placeholders in the URL
+func (d *Definition) PathParams() []string {
+	var params []string
+	for _, match := range pathParamPattern.FindAllStringSubmatch(d.URL, -1) {
+		params = append(params, match[1])
+	}
+	return params
+}
+
+// IsReadOnly reports whether the tool only reads data. Unless declared it is derived from the method.
+func (d *Definition) IsReadOnly() bool {
+	if d.ReadOnly != nil {
+		return *d.ReadOnly
+	}
+	return d.Method == http.MethodGet
+}
+
+// Timeout returns the request timeout of the tool
+func (d *Definition) Timeout() time.Duration {
+	if d.TimeoutSeconds == 0 {
+		return DefaultTimeout
+	}
+	return time.Duration(d.TimeoutSeconds) * time.Second
+}
@@ -0,0 +1,115 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package custom
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseConfig(t *testing.T) {
+	t.Run("valid definitions", func(t *testing.T) {
+		config, err := ParseConfig([]byte(`{
+			"tools": [
+				{
+					"name": "cmdb_lookup",
+					"description": "Look up a host in the CMDB",
+					"url": "https://cmdb.example.com/api/hosts/{hostname}",
+					"headers": {"Authorization": "Bearer ${CMDB_TOKEN}"},
+					"query_params": ["fields"],
+					"input_schema": {
+						"type": "object",
+						"properties": {"hostname": {"type": "string"}, "fields": {"type": "string"}},
+						"required": ["hostname"]
+					}
+				},
+				{
+					"name": "request_approval",
+					"description": "Request a change approval",
+					"method": "post",
+					"url": "https://approvals.example.com/requests",
+					"body_params": ["summary"],
+					"timeout_seconds": 5,
+					"input_schema": {"type": "object", "properties": {"summary": {"type": "string"}}}
+				}
+			]
+		}`))
+		require.NoError(t, err)
+		require.Len(t, config.Tools, 2)
+
+		lookup := config.Tools[0]
+		assert.Equal(t, "GET", lookup.Method)
+		assert.Equal(t, []string{"hostname"}, lookup.PathParams())
+		assert.True(t, lookup.IsReadOnly())
+		assert.Equal(t, DefaultTimeout, lookup.Timeout())
+
+		approval := config.Tools[1]
+		assert.Equal(t, "POST", approval.Method)
+		assert.False(t, approval.IsReadOnly())
+		assert.Equal(t, 5*time.Second, approval.Timeout())
+	})
+
+	tests := []struct {
+		name     string
+		config   string
+		expected string
+	}{
+		{
+			name:     "unknown field",
+			config:   `{"tools": [{"name": "x", "description": "x", "url": "https://example.com", "verb": "GET"}]}`,
+			expected: "unknown field",
+		},
+		{
+			name:     "built-in tool name",
+			config:   `{"tools": [{"name": "list_workspaces", "description": "x", "url": "https://example.com"}]}`,
+			expected: "conflicts with a built-in tool",
+		},
+		{
+			name:     "relative url",
+			config:   `{"tools": [{"name": "x", "description": "x", "url": "/api/hosts"}]}`,
+			expected: "absolute http or https URL",
+		},
+		{
+			name:     "unsupported method",
+			config:   `{"tools": [{"name": "x", "description": "x", "method": "TRACE", "url": "https://example.com"}]}`,
+			expected: "method must be one of",
+		},
+		{
+			name:     "undeclared path parameter",
+			config:   `{"tools": [{"name": "x", "description": "x", "url": "https://example.com/{id}"}]}`,
+			expected: `parameter "id" is not declared`,
+		},
+		{
+			name:     "optional path parameter",
+			config:   `{"tools": [{"name": "x", "description": "x", "url": "https://example.com/{id}", "input_schema": {"type": "object", "properties": {"id": {"type": "string"}}}}]}`,
+			expected: `path parameter "id" must be required`,
+		},
+		{
+			name:     "placeholder in host",
+			config:   `{"tools": [{"name": "x", "description": "x", "url": "https://{host}/api", "input_schema": {"type": "object", "properties": {"host": {"type": "string"}}, "required": ["host"]}}]}`,
+			expected: "only allowed in the path",
+		},
+		{
+			name:     "placeholder in query",
+			config:   `{"tools": [{"name": "x", "description": "x", "url": "https://example.com/api?q={q}", "input_schema": {"type": "object", "properties": {"q": {"type": "string"}}, "required": ["q"]}}]}`,
+			expected: "only allowed in the path",
+		},
+		{
+			name:     "duplicate names",
+			config:   `{"tools": [{"name": "x", "description": "x", "url": "https://example.com"}, {"name": "x", "description": "x", "url": "https://example.com"}]}`,
+			expected: "declared more than once",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseConfig([]byte(tt.config))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expected)
+		})
+	}
+}
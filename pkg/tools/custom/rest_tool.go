@@ -0,0 +1,164 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package custom
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	registryTools "github.com/hashicorp/terraform-mcp-server/pkg/tools/registry"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// maxResponseSize caps how much of a downstream response is returned to the model
+const maxResponseSize = 1 << 20
+
+// RESTTool creates a tool that calls the downstream REST API declared by a definition.
+func RESTTool(def *Definition, logger *log.Logger) server.ServerTool {
+	tool := mcp.NewToolWithRawSchema(def.Name, def.Description, def.InputSchema)
+	readOnly := def.IsReadOnly()
+	destructive := !readOnly
+	openWorld := true
+	tool.Annotations = mcp.ToolAnnotation{
+		Title:           def.Title,
+		ReadOnlyHint:    &readOnly,
+		DestructiveHint: &destructive,
+		OpenWorldHint:   &openWorld,
+	}
+
+	httpClient := client.NewOutboundHTTPClient(def.Timeout(), logger)
+
+	return server.ServerTool{
+		Tool: tool,
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return restToolHandler(ctx, request, def, httpClient, logger)
+		},
+	}
+}
+
+func restToolHandler(ctx context.Context, request mcp.CallToolRequest, def *Definition, httpClient *http.Client, logger *log.Logger) (*mcp.CallToolResult, error) {
+	req, err := newRESTRequest(ctx, def, request.GetArguments())
+	if err != nil {
+		return registryTools.ToolError(logger, "invalid input", err)
+	}
+
+	logger.Debugf("Calling custom tool %s: %s %s", def.Name, req.Method, req.URL.Redacted())
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return registryTools.ToolErrorf(logger, "request to %s failed: %v", req.URL.Host, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize+1))
+	if err != nil {
+		return registryTools.ToolError(logger, "failed to read response body", err)
+	}
+	truncated := len(body) > maxResponseSize
+	if truncated {
+		body = body[:maxResponseSize]
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return registryTools.ToolErrorf(logger, "request to %s returned status %d: %s", req.URL.Host, resp.StatusCode, string(body))
+	}
+
+	text := string(body)
+	if truncated {
+		text += "\n[response truncated]"
+	}
+	return mcp.NewToolResultText(text), nil
+}
+
+// newRESTRequest builds the downstream request from the tool arguments
+func newRESTRequest(ctx context.Context, def *Definition, args map[string]any) (*http.Request, error) {
+	var missing, invalid []string
+	rawURL := pathParamPattern.ReplaceAllStringFunc(def.URL, func(placeholder string) string {
+		name := placeholder[1 : len(placeholder)-1]
+		value, ok := args[name]
+		if !ok || value == nil {
+			missing = append(missing, name)
+			return placeholder
+		}
+		// Dot segments would move the request outside the configured endpoint
+		segment := argumentString(value)
+		if segment == "" || segment == "." || segment == ".." {
+			invalid = append(invalid, name)
+			return placeholder
+		}
+		return url.PathEscape(segment)
+	})
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("missing required input: %s", strings.Join(missing, ", "))
+	}
+	if len(invalid) > 0 {
+		return nil, fmt.Errorf("invalid input: %s must not be empty, '.' or '..'", strings.Join(invalid, ", "))
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	query := u.Query()
+	for _, name := range def.QueryParams {
+		if value, ok := args[name]; ok && value != nil {
+			query.Set(name, argumentString(value))
+		}
+	}
+	u.RawQuery = query.Encode()
+
+	var body io.Reader
+	if len(def.BodyParams) > 0 {
+		payload := make(map[string]any)
+		for _, name := range def.BodyParams {
+			if value, ok := args[name]; ok {
+				payload[name] = value
+			}
+		}
+		buf, err := json.Marshal(payload)
+		if err != nil {
+			return nil, err
+		}
+		body = bytes.NewReader(buf)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, def.Method, u.String(), body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for key, value := range def.Headers {
+		req.Header.Set(key, os.ExpandEnv(value))
+	}
+	return req, nil
+}
+
+// argumentString renders a scalar argument for use in a path or query string
+func argumentString(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		buf, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(buf)
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package custom
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRESTTool(t *testing.T) {
+	logger := log.New()
+	logger.SetLevel(log.ErrorLevel)
+	t.Setenv("CMDB_TOKEN", "secret")
+
+	var received *http.Request
+	var receivedBody []byte
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		receivedBody, _ = io.ReadAll(r.Body)
+		if r.URL.Path == "/hosts/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error":"not found"}`))
+			return
+		}
+		w.Write([]byte(`{"hostname":"web 1"}`))
+	}))
+	defer srv.Close()
+
+	config, err := ParseConfig([]byte(`{"tools": [
+		{
+			"name": "cmdb_lookup",
+			"title": "Look up a host",
+			"description": "Look up a host in the CMDB",
+			"url": "` + srv.URL + `/hosts/{hostname}",
+			"headers": {"Authorization": "Bearer ${CMDB_TOKEN}"},
+			"query_params": ["limit"],
+			"input_schema": {
+				"type": "object",
+				"properties": {"hostname": {"type": "string"}, "limit": {"type": "number"}},
+				"required": ["hostname"]
+			}
+		},
+		{
+			"name": "request_approval",
+			"description": "Request a change approval",
+			"method": "POST",
+			"url": "` + srv.URL + `/approvals",
+			"body_params": ["summary"],
+			"input_schema": {"type": "object", "properties": {"summary": {"type": "string"}}}
+		}
+	]}`))
+	require.NoError(t, err)
+
+	t.Run("tool creation", func(t *testing.T) {
+		tool := RESTTool(config.Tools[0], logger)
+
+		assert.Equal(t, "cmdb_lookup", tool.Tool.Name)
+		assert.Equal(t, "Look up a host", tool.Tool.Annotations.Title)
+		assert.True(t, *tool.Tool.Annotations.ReadOnlyHint)
+		assert.False(t, *tool.Tool.Annotations.DestructiveHint)
+		assert.NotNil(t, tool.Handler)
+
+		buf, err := json.Marshal(tool.Tool)
+		require.NoError(t, err)
+		assert.Contains(t, string(buf), `"required":["hostname"]`)
+	})
+
+	t.Run("path, query and headers", func(t *testing.T) {
+		tool := RESTTool(config.Tools[0], logger)
+		request := mcp.CallToolRequest{}
+		request.Params.Arguments = map[string]any{"hostname": "web 1", "limit": float64(10)}
+
+		result, err := tool.Handler(context.Background(), request)
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+		assert.Equal(t, `{"hostname":"web 1"}`, result.Content[0].(mcp.TextContent).Text)
+
+		assert.Equal(t, http.MethodGet, received.Method)
+		assert.Equal(t, "/hosts/web%201", received.URL.EscapedPath())
+		assert.Equal(t, "10", received.URL.Query().Get("limit"))
+		assert.Equal(t, "Bearer secret", received.Header.Get("Authorization"))
+	})
+
+	t.Run("body parameters", func(t *testing.T) {
+		tool := RESTTool(config.Tools[1], logger)
+		request := mcp.CallToolRequest{}
+		request.Params.Arguments = map[string]any{"summary": "Scale web tier"}
+
+		result, err := tool.Handler(context.Background(), request)
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+		assert.Equal(t, http.MethodPost, received.Method)
+		assert.JSONEq(t, `{"summary":"Scale web tier"}`, string(receivedBody))
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		tool := RESTTool(config.Tools[0], logger)
+
+		result, err := tool.Handler(context.Background(), mcp.CallToolRequest{})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "missing required input: hostname")
+
+		for _, hostname := range []string{"..", ".", ""} {
+			request := mcp.CallToolRequest{}
+			request.Params.Arguments = map[string]any{"hostname": hostname}
+			result, err = tool.Handler(context.Background(), request)
+			require.NoError(t, err)
+			assert.True(t, result.IsError)
+			assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "hostname must not be empty, '.' or '..'")
+		}
+
+		request := mcp.CallToolRequest{}
+		request.Params.Arguments = map[string]any{"hostname": "missing"}
+		result, err = tool.Handler(context.Background(), request)
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "returned status 404")
+	})
+}
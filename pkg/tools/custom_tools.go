@@ -0,0 +1,32 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"github.com/hashicorp/terraform-mcp-server/pkg/tools/custom"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// registerCustomTools registers the operator-declared REST tools from MCP_CUSTOM_TOOLS_FILE, if set.
+// Custom tools are not part of a toolset and are registered whenever the file is configured.
+func registerCustomTools(hcServer *server.MCPServer, logger *log.Logger) {
+	path := utils.GetEnv(custom.CustomToolsFile, "")
+	if path == "" {
+		return
+	}
+
+	config, err := custom.LoadConfig(path)
+	if err != nil {
+		logger.Errorf("Custom tools are disabled: %v", err)
+		return
+	}
+
+	for _, def := range config.Tools {
+		tool := custom.RESTTool(def, logger)
+		addTool(hcServer, tool, logger)
+	}
+	logger.Infof("Registered %d custom tools from %s", len(config.Tools), path)
+}
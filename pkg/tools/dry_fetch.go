@@ -0,0 +1,99 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// dryFetchParam is the optional parameter accepted by tools that can return large responses
+const dryFetchParam = "dry_fetch"
+
+// charactersPerToken is the rough number of characters per token used for estimates
+const charactersPerToken = 4
+
+// dryFetchTools are the tools whose responses are often large enough to be worth estimating first
+var dryFetchTools = map[string]bool{
+	"get_provider_details":                   true,
+	"get_module_details":                     true,
+	"get_policy_details":                     true,
+	"compare_provider_versions":              true,
+	"get_plan_logs":                          true,
+	"get_apply_logs":                         true,
+	"get_plan_json_output":                   true,
+	"get_sentinel_mock":                      true,
+	"list_state_versions":                    true,
+	"get_state_version":                      true,
+	"compare_hcp_terraform_state_versions":   true,
+	"get_state_resources":                    true,
+	"get_workspace_outputs":                  true,
+	"list_workspace_drifted_resources":       true,
+	"get_workspace_inventory":                true,
+	"list_hcp_terraform_workspace_resources": true,
+	"get_workspace_resource_ownership":       true,
+}
+
+// ResponseEstimate describes the size of a response that was fetched but not returned
+type ResponseEstimate struct {
+	DryFetch        bool   `json:"dry_fetch"`
+	Characters      int    `json:"characters"`
+	EstimatedTokens int    `json:"estimated_tokens"`
+	ContentItems    int    `json:"content_items"`
+	Hint            string `json:"hint"`
+}
+
+// withDryFetch adds the "dry_fetch" parameter to heavyweight tools and wraps their handler to
+// return an estimate of the response size instead of the response when it is set. The estimate
+// is taken after result_filter is applied, so narrower filters can be compared before fetching.
+func withDryFetch(tool server.ServerTool) server.ServerTool {
+	if !dryFetchTools[tool.Tool.Name] || tool.Tool.RawInputSchema != nil {
+		return tool
+	}
+
+	if tool.Tool.InputSchema.Properties == nil {
+		tool.Tool.InputSchema.Properties = make(map[string]any)
+	}
+	tool.Tool.InputSchema.Properties[dryFetchParam] = map[string]any{
+		"type":        "boolean",
+		"description": "Only return the estimated size and token count of the response instead of the response, to decide whether a narrower query is needed before adding it to the context",
+		"default":     false,
+	}
+
+	handler := tool.Handler
+	tool.Handler = func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		if !request.GetBool(dryFetchParam, false) {
+			return handler(ctx, request)
+		}
+
+		result, err := handler(ctx, request)
+		if err != nil || result == nil || result.IsError {
+			return result, err
+		}
+		return estimateResult(result)
+	}
+	return tool
+}
+
+// estimateResult replaces a result with an estimate of its size
+func estimateResult(result *mcp.CallToolResult) (*mcp.CallToolResult, error) {
+	estimate := ResponseEstimate{DryFetch: true, ContentItems: len(result.Content)}
+	for _, c := range result.Content {
+		if text, ok := c.(mcp.TextContent); ok {
+			estimate.Characters += len([]rune(text.Text))
+		}
+	}
+	estimate.EstimatedTokens = (estimate.Characters + charactersPerToken - 1) / charactersPerToken
+	estimate.Hint = fmt.Sprintf("Call the tool again without %s to get the response, or narrow it first with result_filter, pagination or a more specific query", dryFetchParam)
+
+	buf, err := json.Marshal(estimate)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response estimate: %v", err)), nil
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
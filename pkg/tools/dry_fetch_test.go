@@ -0,0 +1,76 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithDryFetch(t *testing.T) {
+	logs := strings.Repeat("a", 4001)
+	newTool := func(name string, result *mcp.CallToolResult) server.ServerTool {
+		return withDryFetch(withResultFilter(server.ServerTool{
+			Tool: mcp.NewTool(name, mcp.WithString("run_id")),
+			Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+				return result, nil
+			},
+		}))
+	}
+	call := func(tool server.ServerTool, args map[string]any) *mcp.CallToolResult {
+		request := mcp.CallToolRequest{}
+		request.Params.Arguments = args
+		result, err := tool.Handler(context.Background(), request)
+		require.NoError(t, err)
+		return result
+	}
+
+	t.Run("only heavyweight tools", func(t *testing.T) {
+		assert.Contains(t, newTool("get_plan_logs", nil).Tool.InputSchema.Properties, "dry_fetch")
+		assert.NotContains(t, newTool("list_terraform_orgs", nil).Tool.InputSchema.Properties, "dry_fetch")
+		assert.NotContains(t, newTool("get_plan_logs", nil).Tool.InputSchema.Required, "dry_fetch")
+	})
+
+	t.Run("returns the response without dry_fetch", func(t *testing.T) {
+		result := call(newTool("get_plan_logs", mcp.NewToolResultText(logs)), map[string]any{})
+		assert.Equal(t, logs, result.Content[0].(mcp.TextContent).Text)
+	})
+
+	t.Run("estimates the response", func(t *testing.T) {
+		result := call(newTool("get_plan_logs", mcp.NewToolResultText(logs)), map[string]any{"dry_fetch": true})
+		require.False(t, result.IsError)
+
+		var estimate ResponseEstimate
+		require.NoError(t, json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &estimate))
+		assert.True(t, estimate.DryFetch)
+		assert.Equal(t, 4001, estimate.Characters)
+		assert.Equal(t, 1001, estimate.EstimatedTokens)
+		assert.Equal(t, 1, estimate.ContentItems)
+	})
+
+	t.Run("estimates the filtered response", func(t *testing.T) {
+		outputs := `{"outputs":[{"name":"vpc_id","value":"vpc-123"},{"name":"subnets","value":["a","b","c"]}]}`
+		result := call(newTool("get_workspace_outputs", mcp.NewToolResultText(outputs)), map[string]any{
+			"dry_fetch":     true,
+			"result_filter": "outputs[].name",
+		})
+
+		var estimate ResponseEstimate
+		require.NoError(t, json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &estimate))
+		assert.Equal(t, len(`["vpc_id","subnets"]`), estimate.Characters)
+	})
+
+	t.Run("errors are returned unchanged", func(t *testing.T) {
+		result := call(newTool("get_plan_logs", mcp.NewToolResultError("run not found")), map[string]any{"dry_fetch": true})
+		assert.True(t, result.IsError)
+		assert.Equal(t, "run not found", result.Content[0].(mcp.TextContent).Text)
+	})
+}
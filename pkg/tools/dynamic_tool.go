@@ -91,6 +91,17 @@ func (r *DynamicToolRegistry) HasAnySessionWithTFE() bool {
 	return len(r.sessionsWithTFE) > 0
 }
 
+// EnableStatelessMode registers the TFE tools up front for the stateless streamable HTTP mode. Stateless
+// requests carry no session ID and may reach any instance behind a load balancer, so the tools can't be
+// registered on the first session with a TFE client; their availability is checked against the token of
+// each request instead.
+func (r *DynamicToolRegistry) EnableStatelessMode() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.registerTFETools()
+}
+
 // isTerraformOperationsEnabled checks if ENABLE_TF_OPERATIONS is set to true
 func isTerraformOperationsEnabled() bool {
 	envVar := utils.GetEnv("ENABLE_TF_OPERATIONS", "false")
@@ -108,83 +119,335 @@ func (r *DynamicToolRegistry) registerTFETools() {
 	// Terraform toolset - Organization and Project tools
 	if toolsets.IsToolEnabled("list_terraform_orgs", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("list_terraform_orgs", tfeTools.ListTerraformOrgs)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, r.logger)
 	}
 
 	if toolsets.IsToolEnabled("list_terraform_projects", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("list_terraform_projects", tfeTools.ListTerraformProjects)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, r.logger)
 	}
 
 	// Terraform toolset - Workspace management tools
 	if toolsets.IsToolEnabled("list_workspaces", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("list_workspaces", tfeTools.ListWorkspaces)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, r.logger)
 	}
 
 	if toolsets.IsToolEnabled("get_workspace_details", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("get_workspace_details", tfeTools.GetWorkspaceDetails)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	if toolsets.IsToolEnabled("get_workspace_health_assessment", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("get_workspace_health_assessment", tfeTools.GetWorkspaceHealthAssessment)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	if toolsets.IsToolEnabled("list_workspace_drifted_resources", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("list_workspace_drifted_resources", tfeTools.ListWorkspaceDriftedResources)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	if toolsets.IsToolEnabled("start_workspace_drift_detection", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("start_workspace_drift_detection", tfeTools.StartWorkspaceDriftDetection)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	if toolsets.IsToolEnabled("get_workspace_outputs", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("get_workspace_outputs", tfeTools.GetWorkspaceOutputs)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	if toolsets.IsToolEnabled("list_hcp_terraform_workspace_resources", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("list_hcp_terraform_workspace_resources", tfeTools.ListWorkspaceResources)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	if toolsets.IsToolEnabled("get_workspace_resource_ownership", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("get_workspace_resource_ownership", tfeTools.GetWorkspaceResourceOwnership)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	if toolsets.IsToolEnabled("get_workspace_inventory", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("get_workspace_inventory", tfeTools.GetWorkspaceInventory)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	if toolsets.IsToolEnabled("query_hcp_terraform_explorer", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("query_hcp_terraform_explorer", tfeTools.QueryExplorer)
+		addTool(r.mcpServer, tool, r.logger)
 	}
 
 	if toolsets.IsToolEnabled("create_workspace", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("create_workspace", tfeTools.CreateWorkspace)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	if toolsets.IsToolEnabled("create_workspace_from_blueprint", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("create_workspace_from_blueprint", tfeTools.CreateWorkspaceFromBlueprint)
+		addTool(r.mcpServer, tool, r.logger)
 	}
 
 	if toolsets.IsToolEnabled("update_workspace", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("update_workspace", tfeTools.UpdateWorkspace)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, r.logger)
 	}
 
 	// Only register delete_workspace_safely if TF operations are enabled AND toolset is enabled
 	if isTerraformOperationsEnabled() && toolsets.IsToolEnabled("delete_workspace_safely", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("delete_workspace_safely", tfeTools.DeleteWorkspaceSafely)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+	// Only register delete_hcp_terraform_workspace if TF operations are enabled AND toolset is enabled
+	if isTerraformOperationsEnabled() && toolsets.IsToolEnabled("delete_hcp_terraform_workspace", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("delete_hcp_terraform_workspace", tfeTools.DeleteWorkspace)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	if toolsets.IsToolEnabled("get_hcp_terraform_activity_report", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("get_hcp_terraform_activity_report", tfeTools.GetActivityReport)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	if toolsets.IsToolEnabled("list_terraform_versions", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("list_terraform_versions", tfeTools.ListTerraformVersions)
+		addTool(r.mcpServer, tool, r.logger)
 	}
+
+	if toolsets.IsToolEnabled("check_workspace_terraform_versions", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("check_workspace_terraform_versions", tfeTools.CheckWorkspaceTerraformVersions)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	if toolsets.IsToolEnabled("list_locked_workspaces", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("list_locked_workspaces", tfeTools.ListLockedWorkspaces)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
 	// Only register force_unlock_workspace if TF operations are enabled AND toolset is enabled
 	if isTerraformOperationsEnabled() && toolsets.IsToolEnabled("force_unlock_workspace", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("force_unlock_workspace", tfeTools.ForceUnlockWorkspace)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, r.logger)
 	}
 
 	// Registry-private toolset - Private provider tools
 	if toolsets.IsToolEnabled("search_private_providers", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("search_private_providers", tfeTools.SearchPrivateProviders)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, r.logger)
 	}
 
 	if toolsets.IsToolEnabled("get_private_provider_details", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("get_private_provider_details", tfeTools.GetPrivateProviderDetails)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	if toolsets.IsToolEnabled("list_registry_gpg_keys", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("list_registry_gpg_keys", tfeTools.ListRegistryGPGKeys)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	if toolsets.IsToolEnabled("create_registry_gpg_key", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("create_registry_gpg_key", tfeTools.CreateRegistryGPGKey)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	if toolsets.IsToolEnabled("delete_registry_gpg_key", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("delete_registry_gpg_key", tfeTools.DeleteRegistryGPGKey)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	if toolsets.IsToolEnabled("create_private_provider_version", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("create_private_provider_version", tfeTools.CreatePrivateProviderVersion)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	if toolsets.IsToolEnabled("create_private_provider_platform", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("create_private_provider_platform", tfeTools.CreatePrivateProviderPlatform)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	if toolsets.IsToolEnabled("get_private_provider_upload_urls", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("get_private_provider_upload_urls", tfeTools.GetPrivateProviderUploadURLs)
+		addTool(r.mcpServer, tool, r.logger)
 	}
 
 	// Registry-private toolset - Private module tools
 	if toolsets.IsToolEnabled("search_private_modules", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("search_private_modules", tfeTools.SearchPrivateModules)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, r.logger)
 	}
 
 	if toolsets.IsToolEnabled("get_private_module_details", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("get_private_module_details", tfeTools.GetPrivateModuleDetails)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	if toolsets.IsToolEnabled("list_private_module_versions", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("list_private_module_versions", tfeTools.ListPrivateModuleVersions)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	if toolsets.IsToolEnabled("create_private_module", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("create_private_module", tfeTools.CreatePrivateModule)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	if toolsets.IsToolEnabled("create_private_module_version", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("create_private_module_version", tfeTools.CreatePrivateModuleVersion)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	if toolsets.IsToolEnabled("delete_private_module_version", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("delete_private_module_version", tfeTools.DeletePrivateModuleVersion)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	if toolsets.IsToolEnabled("list_no_code_modules", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("list_no_code_modules", tfeTools.ListNoCodeModules)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	if toolsets.IsToolEnabled("get_no_code_module", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("get_no_code_module", tfeTools.GetNoCodeModule)
+		addTool(r.mcpServer, tool, r.logger)
 	}
 
 	// Terraform toolset - Workspace tags tools
 	if toolsets.IsToolEnabled("create_workspace_tags", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("create_workspace_tags", tfeTools.CreateWorkspaceTags)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, r.logger)
 	}
 
 	if toolsets.IsToolEnabled("read_workspace_tags", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("read_workspace_tags", tfeTools.ReadWorkspaceTags)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	// Terraform toolset - Project tag tools
+	if toolsets.IsToolEnabled("create_project_tags", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("create_project_tags", tfeTools.CreateProjectTags)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	if toolsets.IsToolEnabled("read_project_tags", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("read_project_tags", tfeTools.ReadProjectTags)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	if toolsets.IsToolEnabled("delete_project_tags", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("delete_project_tags", tfeTools.DeleteProjectTags)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	// Terraform toolset - Organization tag tools
+	if toolsets.IsToolEnabled("list_organization_tags", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("list_organization_tags", tfeTools.ListOrganizationTags)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	if toolsets.IsToolEnabled("rename_organization_tag", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("rename_organization_tag", tfeTools.RenameOrganizationTag)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	if toolsets.IsToolEnabled("merge_organization_tags", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("merge_organization_tags", tfeTools.MergeOrganizationTags)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	// Terraform toolset - SSH key tools
+	if toolsets.IsToolEnabled("list_oauth_clients", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("list_oauth_clients", tfeTools.ListOAuthClients)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	if toolsets.IsToolEnabled("list_oauth_tokens", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("list_oauth_tokens", tfeTools.ListOAuthTokens)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	if toolsets.IsToolEnabled("list_github_app_installations", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("list_github_app_installations", tfeTools.ListGitHubAppInstallations)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	if toolsets.IsToolEnabled("list_ssh_keys", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("list_ssh_keys", tfeTools.ListSSHKeys)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	if toolsets.IsToolEnabled("create_ssh_key", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("create_ssh_key", tfeTools.CreateSSHKey)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	// Only register delete tool if TF operations are enabled AND toolset is enabled
+	if isTerraformOperationsEnabled() && toolsets.IsToolEnabled("delete_ssh_key", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("delete_ssh_key", tfeTools.DeleteSSHKey)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	if toolsets.IsToolEnabled("assign_workspace_ssh_key", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("assign_workspace_ssh_key", tfeTools.AssignWorkspaceSSHKey)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	if toolsets.IsToolEnabled("unassign_workspace_ssh_key", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("unassign_workspace_ssh_key", tfeTools.UnassignWorkspaceSSHKey)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	// Terraform toolset - Agent pool tools
+	if toolsets.IsToolEnabled("list_agent_pools", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("list_agent_pools", tfeTools.ListAgentPools)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	if toolsets.IsToolEnabled("get_agent_pool_details", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("get_agent_pool_details", tfeTools.GetAgentPoolDetails)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	if toolsets.IsToolEnabled("list_agent_pool_agents", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("list_agent_pool_agents", tfeTools.ListAgentPoolAgents)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	if toolsets.IsToolEnabled("assign_workspace_agent_pool", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("assign_workspace_agent_pool", tfeTools.AssignWorkspaceAgentPool)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	// Terraform toolset - Team and membership tools
+	if toolsets.IsToolEnabled("list_organization_memberships", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("list_organization_memberships", tfeTools.ListOrganizationMemberships)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	if toolsets.IsToolEnabled("list_teams", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("list_teams", tfeTools.ListTeams)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	if toolsets.IsToolEnabled("get_team_details", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("get_team_details", tfeTools.GetTeamDetails)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	if toolsets.IsToolEnabled("list_workspace_team_access", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("list_workspace_team_access", tfeTools.ListWorkspaceTeamAccess)
+		addTool(r.mcpServer, tool, r.logger)
 	}
 
 	// Terraform toolset - Run tools
 	if toolsets.IsToolEnabled("list_runs", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("list_runs", tfeTools.ListRuns)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	if toolsets.IsToolEnabled("get_hcp_terraform_org_run_queue", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("get_hcp_terraform_org_run_queue", tfeTools.GetOrgRunQueue)
+		addTool(r.mcpServer, tool, r.logger)
 	}
 
 	// Create run tool with conditional options based on TF operations setting
@@ -195,136 +458,305 @@ func (r *DynamicToolRegistry) registerTFETools() {
 		} else {
 			tool = r.createDynamicTFETool("create_run", tfeTools.CreateRunSafe)
 		}
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	if toolsets.IsToolEnabled("upload_hcp_terraform_configuration", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("upload_hcp_terraform_configuration", tfeTools.UploadConfiguration)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	if toolsets.IsToolEnabled("retry_hcp_terraform_run", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("retry_hcp_terraform_run", tfeTools.RetryRun)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	if toolsets.IsToolEnabled("diagnose_hcp_terraform_run", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("diagnose_hcp_terraform_run", tfeTools.DiagnoseRun)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	if toolsets.IsToolEnabled("promote_workspace_config", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("promote_workspace_config", tfeTools.PromoteWorkspaceConfig)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	if toolsets.IsToolEnabled("enforce_terraform_version_policy", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("enforce_terraform_version_policy", tfeTools.EnforceTerraformVersionPolicy)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	if toolsets.IsToolEnabled("bulk_update_workspaces", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("bulk_update_workspaces", tfeTools.BulkUpdateWorkspaces)
+		addTool(r.mcpServer, tool, r.logger)
 	}
 
 	// Only register action_run if TF operations are enabled AND toolset is enabled
 	if isTerraformOperationsEnabled() && toolsets.IsToolEnabled("action_run", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("action_run", tfeTools.ActionRun)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	// Only register run_guarded_deployment if TF operations are enabled AND toolset is enabled
+	if isTerraformOperationsEnabled() && toolsets.IsToolEnabled("run_guarded_deployment", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("run_guarded_deployment", tfeTools.GuardedDeployment)
+		addTool(r.mcpServer, tool, r.logger)
 	}
 
 	if toolsets.IsToolEnabled("create_no_code_workspace", r.enabledToolsets) {
 		tool := r.createDynamicTFEToolWithElicitation("create_no_code_workspace", tfeTools.CreateNoCodeWorkspace)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, r.logger)
 	}
 
 	if toolsets.IsToolEnabled("get_run_details", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("get_run_details", tfeTools.GetRunDetails)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	if toolsets.IsToolEnabled("list_run_comments", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("list_run_comments", tfeTools.ListRunComments)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	if toolsets.IsToolEnabled("create_run_comment", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("create_run_comment", tfeTools.CreateRunComment)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	if toolsets.IsToolEnabled("get_hcp_terraform_cost_estimate", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("get_hcp_terraform_cost_estimate", tfeTools.GetCostEstimate)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	if toolsets.IsToolEnabled("wait_for_run", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("wait_for_run", tfeTools.WaitForRun)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	if toolsets.IsToolEnabled("wait_for_configuration_version", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("wait_for_configuration_version", tfeTools.WaitForConfigurationVersion)
+		addTool(r.mcpServer, tool, r.logger)
 	}
 
 	if toolsets.IsToolEnabled("get_plan_details", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("get_plan_details", tfeTools.GetPlanDetails)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, r.logger)
 	}
 
 	if toolsets.IsToolEnabled("get_plan_logs", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("get_plan_logs", tfeTools.GetPlanLogs)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, r.logger)
 	}
 
 	if toolsets.IsToolEnabled("get_plan_json_output", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("get_plan_json_output", tfeTools.GetPlanJSONOutput)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, r.logger)
 	}
 
 	if toolsets.IsToolEnabled("get_apply_details", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("get_apply_details", tfeTools.GetApplyDetails)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, r.logger)
 	}
 
 	if toolsets.IsToolEnabled("get_apply_logs", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("get_apply_logs", tfeTools.GetApplyLogs)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, r.logger)
 	}
 	if toolsets.IsToolEnabled("get_sentinel_mock", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("get_sentinel_mock", tfeTools.GetSentinelMock)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, r.logger)
 	}
 
 	// Terraform toolset - Variable set tools
+	if toolsets.IsToolEnabled("explain_hcp_terraform_variable_resolution", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("explain_hcp_terraform_variable_resolution", tfeTools.ExplainVariableResolution)
+		addTool(r.mcpServer, tool, r.logger)
+	}
 	if toolsets.IsToolEnabled("list_variable_sets", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("list_variable_sets", tfeTools.ListVariableSets)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, r.logger)
 	}
 
 	if toolsets.IsToolEnabled("create_variable_set", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("create_variable_set", tfeTools.CreateVariableSet)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	if toolsets.IsToolEnabled("update_variable_set", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("update_variable_set", tfeTools.UpdateVariableSet)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	// Only register delete_variable_set if TF operations are enabled AND toolset is enabled
+	if isTerraformOperationsEnabled() && toolsets.IsToolEnabled("delete_variable_set", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("delete_variable_set", tfeTools.DeleteVariableSet)
+		addTool(r.mcpServer, tool, r.logger)
 	}
 
 	if toolsets.IsToolEnabled("create_variable_in_variable_set", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("create_variable_in_variable_set", tfeTools.CreateVariableInVariableSet)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, r.logger)
 	}
 
 	if toolsets.IsToolEnabled("delete_variable_in_variable_set", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("delete_variable_in_variable_set", tfeTools.DeleteVariableInVariableSet)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, r.logger)
 	}
 
 	// Attach/detach variable sets to/from workspaces
 	if toolsets.IsToolEnabled("attach_variable_set_to_workspaces", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("attach_variable_set_to_workspaces", tfeTools.AttachVariableSetToWorkspaces)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, r.logger)
 	}
 
 	if toolsets.IsToolEnabled("detach_variable_set_from_workspaces", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("detach_variable_set_from_workspaces", tfeTools.DetachVariableSetFromWorkspaces)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	// Attach/detach variable sets to/from projects
+	if toolsets.IsToolEnabled("attach_variable_set_to_projects", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("attach_variable_set_to_projects", tfeTools.AttachVariableSetToProjects)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	if toolsets.IsToolEnabled("detach_variable_set_from_projects", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("detach_variable_set_from_projects", tfeTools.DetachVariableSetFromProjects)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	if toolsets.IsToolEnabled("list_project_variable_sets", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("list_project_variable_sets", tfeTools.ListProjectVariableSets)
+		addTool(r.mcpServer, tool, r.logger)
 	}
 
 	if toolsets.IsToolEnabled("attach_policy_set_to_workspaces", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("attach_policy_set_to_workspaces", tfeTools.AttachPolicySetToWorkspaces)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, r.logger)
 	}
 
 	if toolsets.IsToolEnabled("list_workspace_policy_sets", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("list_workspace_policy_sets", tfeTools.ListWorkspacePolicySets)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	if toolsets.IsToolEnabled("detach_policy_set_from_workspaces", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("detach_policy_set_from_workspaces", tfeTools.DetachPolicySetFromWorkspaces)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	if toolsets.IsToolEnabled("list_policy_sets", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("list_policy_sets", tfeTools.ListPolicySets)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	if toolsets.IsToolEnabled("get_policy_set_details", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("get_policy_set_details", tfeTools.GetPolicySetDetails)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	if toolsets.IsToolEnabled("list_run_policy_results", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("list_run_policy_results", tfeTools.ListRunPolicyResults)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	if toolsets.IsToolEnabled("list_run_tasks", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("list_run_tasks", tfeTools.ListRunTasks)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	if toolsets.IsToolEnabled("attach_run_task_to_workspace", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("attach_run_task_to_workspace", tfeTools.AttachRunTaskToWorkspace)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	if toolsets.IsToolEnabled("list_run_task_results", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("list_run_task_results", tfeTools.ListRunTaskResults)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	if toolsets.IsToolEnabled("list_policy_overrides", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("list_policy_overrides", tfeTools.ListPolicyOverrides)
+		addTool(r.mcpServer, tool, r.logger)
 	}
 
 	// Terraform toolset - Variable tools
 	if toolsets.IsToolEnabled("list_workspace_variables", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("list_workspace_variables", tfeTools.ListWorkspaceVariables)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	if toolsets.IsToolEnabled("get_workspace_variable_history", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("get_workspace_variable_history", tfeTools.GetWorkspaceVariableHistory)
+		addTool(r.mcpServer, tool, r.logger)
 	}
 
 	if toolsets.IsToolEnabled("create_workspace_variable", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("create_workspace_variable", tfeTools.CreateWorkspaceVariable)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, r.logger)
 	}
 
 	if toolsets.IsToolEnabled("update_workspace_variable", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("update_workspace_variable", tfeTools.UpdateWorkspaceVariable)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	// Terraform toolset - Notification tools
+	if toolsets.IsToolEnabled("list_workspace_notification_configurations", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("list_workspace_notification_configurations", tfeTools.ListWorkspaceNotificationConfigurations)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	if toolsets.IsToolEnabled("create_workspace_notification_configuration", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("create_workspace_notification_configuration", tfeTools.CreateWorkspaceNotificationConfiguration)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	if toolsets.IsToolEnabled("update_workspace_notification_configuration", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("update_workspace_notification_configuration", tfeTools.UpdateWorkspaceNotificationConfiguration)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	// Only register delete tool if TF operations are enabled AND toolset is enabled
+	if isTerraformOperationsEnabled() && toolsets.IsToolEnabled("delete_workspace_notification_configuration", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("delete_workspace_notification_configuration", tfeTools.DeleteWorkspaceNotificationConfiguration)
+		addTool(r.mcpServer, tool, r.logger)
 	}
 
 	if toolsets.IsToolEnabled("get_token_permissions", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("get_token_permissions", tfeTools.GetTokenPermissions)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, r.logger)
 	}
 
 	// Terraform toolset - Stacks
 	if toolsets.IsToolEnabled("list_stacks", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("list_stacks", tfeTools.ListStacks)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, r.logger)
 	}
 	if toolsets.IsToolEnabled("get_stack_details", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("get_stack_details", tfeTools.GetStackDetails)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, r.logger)
 	}
 
 	// Terraform State-Version Toolsets
 	if toolsets.IsToolEnabled("list_state_versions", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("list_state_versions", tfeTools.ListStateVersions)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, r.logger)
 	}
 
 	if toolsets.IsToolEnabled("get_state_version", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("get_state_version", tfeTools.GetStateVersion)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	if toolsets.IsToolEnabled("compare_hcp_terraform_state_versions", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("compare_hcp_terraform_state_versions", tfeTools.CompareStateVersions)
+		addTool(r.mcpServer, tool, r.logger)
+	}
+
+	if toolsets.IsToolEnabled("get_state_resources", r.enabledToolsets) {
+		tool := r.createDynamicTFETool("get_state_resources", tfeTools.GetStateResources)
+		addTool(r.mcpServer, tool, r.logger)
 	}
 
 	r.tfeToolsRegistered = true
@@ -332,7 +764,7 @@ func (r *DynamicToolRegistry) registerTFETools() {
 
 // createDynamicTFETool creates a TFE tool with dynamic availability checking
 func (r *DynamicToolRegistry) createDynamicTFETool(toolName string, toolFactory func(*log.Logger) server.ServerTool) server.ServerTool {
-	originalTool := toolFactory(r.logger)
+	originalTool := withPlatformSupport(toolFactory(r.logger), r.logger)
 	return server.ServerTool{
 		Tool:    originalTool.Tool,
 		Handler: r.wrapWithAvailabilityCheck(toolName, originalTool.Handler),
@@ -341,7 +773,7 @@ func (r *DynamicToolRegistry) createDynamicTFETool(toolName string, toolFactory
 
 // createDynamicTFEToolWithElicitation creates a TFE tool with dynamic availability checking that also needs MCPServer for elicitation
 func (r *DynamicToolRegistry) createDynamicTFEToolWithElicitation(toolName string, toolFactory func(*log.Logger, *server.MCPServer) server.ServerTool) server.ServerTool {
-	originalTool := toolFactory(r.logger, r.mcpServer)
+	originalTool := withPlatformSupport(toolFactory(r.logger, r.mcpServer), r.logger)
 	return server.ServerTool{
 		Tool:    originalTool.Tool,
 		Handler: r.wrapWithAvailabilityCheck(toolName, originalTool.Handler),
@@ -358,8 +790,21 @@ func (r *DynamicToolRegistry) wrapWithAvailabilityCheck(toolName string, origina
 			return mcp.NewToolResultError("This tool requires an active session with valid Terraform Cloud/Enterprise configuration."), nil
 		}
 
-		// Check if this session has a valid TFE client
+		// Stateless requests have no session to track, check the request's own token
 		sessionID := session.SessionID()
+		if sessionID == "" {
+			tfeClient, err := client.GetTfeClientFromContext(ctx, r.logger)
+			if err != nil {
+				r.logger.WithFields(log.Fields{
+					"tool": toolName,
+				}).Warn("TFE tool called but the request has no valid TFE token")
+
+				return mcp.NewToolResultError("This tool is not available. This tool requires a valid Terraform Cloud/Enterprise token. Please send the token with the request or ensure the TFE_TOKEN environment variable is set."), nil
+			}
+			return originalHandler(client.ContextWithTfeClient(ctx, tfeClient), req)
+		}
+
+		// Check if this session has a valid TFE client
 		if !r.HasSessionWithTFE(sessionID) {
 			// Double-check by looking at the actual client state
 			tfeClient := client.GetTfeClient(sessionID)
@@ -4,10 +4,19 @@
 package tools
 
 import (
+	"context"
+	"io"
 	"os"
 	"testing"
 
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/toolsets"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestIsTerraformOperationsEnabled(t *testing.T) {
@@ -40,3 +49,46 @@ func TestIsTerraformOperationsEnabled(t *testing.T) {
 		})
 	}
 }
+
+// statelessSession is the ephemeral session of a request in the stateless streamable HTTP mode
+type statelessSession struct{}
+
+func (statelessSession) Initialize()                                         {}
+func (statelessSession) Initialized() bool                                   { return true }
+func (statelessSession) NotificationChannel() chan<- mcp.JSONRPCNotification { return nil }
+func (statelessSession) SessionID() string                                   { return "" }
+
+func TestDynamicToolRegistryStatelessMode(t *testing.T) {
+	t.Setenv("TFE_TOKEN", "")
+	logger := log.New()
+	logger.SetOutput(io.Discard)
+
+	mcpServer := server.NewMCPServer("test", "1.0.0", server.WithToolCapabilities(true))
+	registry := &DynamicToolRegistry{
+		sessionsWithTFE: make(map[string]bool),
+		mcpServer:       mcpServer,
+		logger:          logger,
+		enabledToolsets: []string{toolsets.All},
+	}
+	registry.EnableStatelessMode()
+	require.NotNil(t, mcpServer.GetTool("list_workspaces"), "TFE tools are registered without a session")
+	assert.False(t, registry.HasAnySessionWithTFE())
+
+	called := false
+	handler := registry.wrapWithAvailabilityCheck("list_workspaces", func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		called = true
+		return mcp.NewToolResultText("ok"), nil
+	})
+	ctx := mcpServer.WithContext(context.Background(), statelessSession{})
+
+	result, err := handler(ctx, mcp.CallToolRequest{})
+	require.NoError(t, err)
+	assert.True(t, result.IsError, "requests without a token are rejected")
+	assert.False(t, called)
+
+	result, err = handler(client.ContextWithTfeClient(ctx, &tfe.Client{}), mcp.CallToolRequest{})
+	require.NoError(t, err)
+	assert.False(t, result.IsError)
+	assert.True(t, called)
+	assert.False(t, registry.HasSessionWithTFE(""), "stateless requests are not tracked as a session")
+}
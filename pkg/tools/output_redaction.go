@@ -0,0 +1,158 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// RedactOutputEnv disables the redaction of secrets from tool results when set to false
+const RedactOutputEnv = "MCP_REDACT_OUTPUT"
+
+// includeSensitiveParam is the argument of the tools that return sensitive values on request
+const includeSensitiveParam = "include_sensitive"
+
+// outputRedactionEnabled reports whether secrets are redacted from tool results, which is the default
+func outputRedactionEnabled(logger *log.Logger) bool {
+	value := strings.TrimSpace(utils.GetEnv(RedactOutputEnv, "true"))
+	enabled, err := strconv.ParseBool(value)
+	if err != nil {
+		logger.Warnf("Invalid %s value %q, secrets are redacted from tool results", RedactOutputEnv, value)
+		return true
+	}
+	return enabled
+}
+
+// withOutputRedaction wraps the handler of a tool to mask secrets before its result reaches the
+// model: the values of variables and outputs marked sensitive, unless include_sensitive was
+// requested from a tool that declares include_sensitive, the fields named like secrets, and the
+// secrets utils.RedactSecrets finds in any text.
+func withOutputRedaction(tool server.ServerTool, logger *log.Logger) server.ServerTool {
+	if !outputRedactionEnabled(logger) {
+		return tool
+	}
+
+	// Other tools ignore include_sensitive, so that it can't switch off the redaction of their results
+	_, declaresIncludeSensitive := tool.Tool.InputSchema.Properties[includeSensitiveParam]
+	handler := tool.Handler
+	tool.Handler = func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		result, err := handler(ctx, request)
+		if err != nil || result == nil {
+			return result, err
+		}
+		redactResult(result, !declaresIncludeSensitive || !request.GetBool(includeSensitiveParam, false))
+		return result, nil
+	}
+	return tool
+}
+
+// redactResult masks the secrets of the text and structured content of a result in place
+func redactResult(result *mcp.CallToolResult, redactSensitive bool) {
+	for i, c := range result.Content {
+		if text, ok := c.(mcp.TextContent); ok {
+			text.Text = redactOutputText(text.Text, redactSensitive)
+			result.Content[i] = text
+		}
+	}
+	if result.StructuredContent != nil {
+		result.StructuredContent = redactStructuredContent(result.StructuredContent, redactSensitive)
+	}
+}
+
+// redactOutputText masks the secrets of a text result. JSON results are redacted field by field and
+// only re-encoded when a secret was found, other texts, such as logs, by pattern.
+func redactOutputText(text string, redactSensitive bool) string {
+	trimmed := strings.TrimSpace(text)
+	if strings.HasPrefix(trimmed, "{") || strings.HasPrefix(trimmed, "[") {
+		decoder := json.NewDecoder(strings.NewReader(trimmed))
+		decoder.UseNumber()
+		var data any
+		if err := decoder.Decode(&data); err == nil && !decoder.More() {
+			redacted := redactOutputValue(data, redactSensitive)
+			if reflect.DeepEqual(redacted, data) {
+				return text
+			}
+			if buf, err := marshalOutput(redacted); err == nil {
+				return buf
+			}
+		}
+	}
+	return utils.RedactSecrets(text)
+}
+
+// redactStructuredContent masks the secrets of structured content set by a tool
+func redactStructuredContent(content any, redactSensitive bool) any {
+	buf, err := json.Marshal(content)
+	if err != nil {
+		return content
+	}
+	decoder := json.NewDecoder(bytes.NewReader(buf))
+	decoder.UseNumber()
+	var data any
+	if err := decoder.Decode(&data); err != nil {
+		return content
+	}
+	redacted := redactOutputValue(data, redactSensitive)
+	if reflect.DeepEqual(redacted, data) {
+		return content
+	}
+	return redacted
+}
+
+func redactOutputValue(value any, redactSensitive bool) any {
+	switch v := value.(type) {
+	case map[string]any:
+		sensitive, _ := v["sensitive"].(bool)
+		redacted := make(map[string]any, len(v))
+		for key, item := range v {
+			_, isString := item.(string)
+			if isSecretValue(item) && ((isString && utils.IsSecretName(key)) || (redactSensitive && sensitive && key == "value")) {
+				redacted[key] = redactedValue
+				continue
+			}
+			redacted[key] = redactOutputValue(item, redactSensitive)
+		}
+		return redacted
+	case []any:
+		redacted := make([]any, len(v))
+		for i, item := range v {
+			redacted[i] = redactOutputValue(item, redactSensitive)
+		}
+		return redacted
+	case string:
+		return utils.RedactSecrets(v)
+	default:
+		return v
+	}
+}
+
+// isSecretValue reports whether a value marked sensitive holds anything to redact, as the API
+// returns empty values for sensitive variables
+func isSecretValue(value any) bool {
+	if s, ok := value.(string); ok {
+		return s != "" && s != redactedValue
+	}
+	return value != nil
+}
+
+// marshalOutput encodes a redacted JSON result without escaping HTML characters
+func marshalOutput(value any) (string, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(value); err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
@@ -0,0 +1,56 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// toolFeatures are the Terraform Enterprise features the tools can't work without. Tools that
+// only use a feature for part of their result check it themselves.
+var toolFeatures = map[string]client.TFEFeature{
+	"get_workspace_health_assessment":  client.FeatureHealthAssessments,
+	"list_workspace_drifted_resources": client.FeatureHealthAssessments,
+	"start_workspace_drift_detection":  client.FeatureHealthAssessments,
+}
+
+// withPlatformSupport wraps the handler of a TFE tool to return an informative error when the
+// Terraform Enterprise release lacks a feature the tool requires, and to explain the not found
+// errors older releases return for APIs they don't implement.
+func withPlatformSupport(tool server.ServerTool, logger *log.Logger) server.ServerTool {
+	handler := tool.Handler
+	tool.Handler = func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+		if err != nil || tfeClient == nil {
+			return handler(ctx, request)
+		}
+		platform := client.PlatformOf(tfeClient)
+
+		if feature, ok := toolFeatures[tool.Tool.Name]; ok {
+			if err := platform.Supports(feature); err != nil {
+				logger.WithField("tool", tool.Tool.Name).Warnf("Tool is not supported: %v", err)
+				return mcp.NewToolResultError(fmt.Sprintf("%s is not available: %v", tool.Tool.Name, err)), nil
+			}
+		}
+
+		result, err := handler(ctx, request)
+		if err != nil || result == nil || !result.IsError || !platform.IsEnterprise() {
+			return result, err
+		}
+		for i, c := range result.Content {
+			if text, ok := c.(mcp.TextContent); ok {
+				text.Text = platform.ExplainNotFound(text.Text)
+				result.Content[i] = text
+			}
+		}
+		return result, nil
+	}
+	return tool
+}
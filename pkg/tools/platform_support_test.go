@@ -0,0 +1,80 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithPlatformSupport(t *testing.T) {
+	logger := log.New()
+	logger.SetOutput(io.Discard)
+
+	// A Terraform Enterprise release older than health assessments
+	tfeServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("TFP-AppName", "Terraform Enterprise")
+		w.Header().Set("X-TFE-Version", "v202210-1")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer tfeServer.Close()
+	tfeClient, err := tfe.NewClient(&tfe.Config{Address: tfeServer.URL, Token: "test-token"})
+	require.NoError(t, err)
+
+	mcpServer := server.NewMCPServer("test", "1.0.0")
+	ctx := client.ContextWithTfeClient(mcpServer.WithContext(context.Background(), statelessSession{}), tfeClient)
+
+	newTool := func(name string, result *mcp.CallToolResult, called *bool) server.ServerTool {
+		return withPlatformSupport(server.ServerTool{
+			Tool: mcp.NewTool(name),
+			Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+				*called = true
+				return result, nil
+			},
+		}, logger)
+	}
+
+	t.Run("unsupported feature", func(t *testing.T) {
+		called := false
+		tool := newTool("get_workspace_health_assessment", mcp.NewToolResultText("ok"), &called)
+
+		result, err := tool.Handler(ctx, mcp.CallToolRequest{})
+		require.NoError(t, err)
+		assert.False(t, called)
+		assert.True(t, result.IsError)
+		assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "health assessments requires Terraform Enterprise v202302-1 or later")
+		assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "Terraform Enterprise v202210-1")
+	})
+
+	t.Run("not found error", func(t *testing.T) {
+		called := false
+		tool := newTool("list_workspaces", mcp.NewToolResultError("failed to list workspaces: resource not found"), &called)
+
+		result, err := tool.Handler(ctx, mcp.CallToolRequest{})
+		require.NoError(t, err)
+		assert.True(t, called)
+		assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "this release may not support the API used by this tool")
+	})
+
+	t.Run("success", func(t *testing.T) {
+		called := false
+		tool := newTool("list_workspaces", mcp.NewToolResultText("ok"), &called)
+
+		result, err := tool.Handler(ctx, mcp.CallToolRequest{})
+		require.NoError(t, err)
+		assert.True(t, called)
+		assert.Equal(t, "ok", result.Content[0].(mcp.TextContent).Text)
+	})
+}
@@ -0,0 +1,260 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const defaultSlugSuggestions = 10
+
+// SlugSuggestion is a resource or data source slug matching a partial service slug
+type SlugSuggestion struct {
+	Slug          string `json:"slug"`
+	TypeName      string `json:"type_name"`
+	Category      string `json:"category"`
+	Title         string `json:"title"`
+	ProviderDocID string `json:"provider_doc_id"`
+}
+
+// SlugSuggestions lists the slugs matching a partial service slug, best matches first
+type SlugSuggestions struct {
+	Provider        string            `json:"provider"`
+	ProviderVersion string            `json:"provider_version"`
+	Suggestions     []*SlugSuggestion `json:"suggestions"`
+}
+
+// AutocompleteServiceSlug creates a tool to complete a partial service slug from a provider's doc index.
+func AutocompleteServiceSlug(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("autocomplete_service_slug",
+			mcp.WithDescription(`Completes a partial service slug into the exact resource and data source slugs of a Terraform provider, e.g. 'inst' or 'aws_inst' into 'instance' (aws_instance). Exact and prefix matches come first, then slugs containing the partial slug and fuzzy matches.
+Use it to resolve the exact 'service_slug' before calling 'search_providers' when unsure of a resource name. Lookups use the cached provider doc index and are cheap.`),
+			mcp.WithTitleAnnotation("Autocomplete a provider resource or data source slug"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("provider_name",
+				mcp.Required(),
+				mcp.Description("The name of the Terraform provider, e.g., 'aws', 'azurerm', 'google', etc."),
+			),
+			mcp.WithString("provider_namespace",
+				mcp.Description("The publisher of the Terraform provider (defaults to 'hashicorp')"),
+			),
+			mcp.WithString("partial_slug",
+				mcp.Required(),
+				mcp.Description("The partial service slug to complete, with or without the provider name prefix, e.g., 'inst', 'aws_inst' or 'security_gr'"),
+			),
+			mcp.WithString("provider_document_type",
+				mcp.Description("Only complete resource or data source slugs (defaults to both)"),
+				mcp.Enum("resources", "data-sources"),
+			),
+			mcp.WithString("provider_version",
+				mcp.Description("The version of the Terraform provider in the format 'x.y.z', or 'latest' to use the latest version"),
+			),
+			mcp.WithNumber("limit",
+				mcp.Description("Maximum number of suggestions to return (defaults to 10)"),
+				mcp.Min(1),
+				mcp.Max(50),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return autocompleteServiceSlugHandler(ctx, request, logger)
+		},
+	}
+}
+
+func autocompleteServiceSlugHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	partialSlug, err := request.RequireString("partial_slug")
+	if err != nil {
+		return ToolError(logger, "missing required input: partial_slug", err)
+	}
+	partialSlug = strings.ToLower(strings.TrimSpace(partialSlug))
+	if partialSlug == "" {
+		return ToolError(logger, "partial_slug cannot be empty", nil)
+	}
+
+	documentType := request.GetString("provider_document_type", "")
+	limit := request.GetInt("limit", defaultSlugSuggestions)
+	if limit < 1 {
+		limit = defaultSlugSuggestions
+	}
+
+	httpClient, err := client.GetHttpClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get http client for public Terraform registry", err)
+	}
+
+	providerDetail, err := resolveProviderDetails(ctx, request, httpClient, logger)
+	if err != nil {
+		return ToolErrorf(logger, "failed to resolve provider: %v", err)
+	}
+
+	// The v1 provider response lists every doc of the version and is served from the registry cache
+	uri := path.Join("providers", providerDetail.ProviderNamespace, providerDetail.ProviderName, providerDetail.ProviderVersion)
+	response, err := client.SendRegistryCall(ctx, httpClient, "GET", uri, logger)
+	if err != nil {
+		return ToolErrorf(logger, "failed to get provider '%s' version '%s' in namespace '%s'",
+			providerDetail.ProviderName, providerDetail.ProviderVersion, providerDetail.ProviderNamespace)
+	}
+
+	var providerDocs client.ProviderDocs
+	if err := json.Unmarshal(response, &providerDocs); err != nil {
+		return ToolError(logger, "failed to parse provider docs", err)
+	}
+
+	suggestions := suggestSlugs(providerDocs.Docs, providerDetail.ProviderName, partialSlug, documentType, limit)
+	if len(suggestions) == 0 {
+		return ToolErrorf(logger, "no resource or data source slug of provider %s/%s matches '%s'", providerDetail.ProviderNamespace, providerDetail.ProviderName, partialSlug)
+	}
+
+	buf, err := json.Marshal(&SlugSuggestions{
+		Provider:        providerDetail.ProviderNamespace + "/" + providerDetail.ProviderName,
+		ProviderVersion: providerDetail.ProviderVersion,
+		Suggestions:     suggestions,
+	})
+	if err != nil {
+		return ToolError(logger, "failed to marshal slug suggestions", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+// suggestSlugs ranks the resource and data source docs whose slug matches a partial slug
+func suggestSlugs(docs []client.ProviderDoc, providerName, partialSlug, documentType string, limit int) []*SlugSuggestion {
+	partialSlug = strings.TrimPrefix(partialSlug, providerName+"_")
+
+	type match struct {
+		suggestion *SlugSuggestion
+		rank       int
+	}
+	var matches []match
+	for _, doc := range docs {
+		if doc.Language != "hcl" || (doc.Category != "resources" && doc.Category != "data-sources") {
+			continue
+		}
+		if documentType != "" && doc.Category != documentType {
+			continue
+		}
+		rank, ok := slugRank(strings.ToLower(doc.Slug), partialSlug)
+		if !ok {
+			continue
+		}
+		matches = append(matches, match{
+			suggestion: &SlugSuggestion{
+				Slug:          doc.Slug,
+				TypeName:      providerName + "_" + doc.Slug,
+				Category:      doc.Category,
+				Title:         doc.Title,
+				ProviderDocID: doc.ID,
+			},
+			rank: rank,
+		})
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].rank != matches[j].rank {
+			return matches[i].rank < matches[j].rank
+		}
+		if len(matches[i].suggestion.Slug) != len(matches[j].suggestion.Slug) {
+			return len(matches[i].suggestion.Slug) < len(matches[j].suggestion.Slug)
+		}
+		if matches[i].suggestion.Slug != matches[j].suggestion.Slug {
+			return matches[i].suggestion.Slug < matches[j].suggestion.Slug
+		}
+		return matches[i].suggestion.Category < matches[j].suggestion.Category
+	})
+
+	suggestions := make([]*SlugSuggestion, 0, min(limit, len(matches)))
+	for _, m := range matches {
+		if len(suggestions) == limit {
+			break
+		}
+		suggestions = append(suggestions, m.suggestion)
+	}
+	return suggestions
+}
+
+// slugRank ranks how well a slug matches a partial slug, lower is better: an exact match, a prefix,
+// a prefix of one of its words, a substring, then the characters of the partial slug in order
+func slugRank(slug, partialSlug string) (int, bool) {
+	switch {
+	case slug == partialSlug:
+		return 0, true
+	case strings.HasPrefix(slug, partialSlug):
+		return 1, true
+	case strings.Contains(slug, "_"+partialSlug):
+		return 2, true
+	case strings.Contains(slug, partialSlug):
+		return 3, true
+	}
+
+	remaining := partialSlug
+	for _, r := range slug {
+		if remaining == "" {
+			break
+		}
+		if strings.HasPrefix(remaining, string(r)) {
+			remaining = remaining[len(string(r)):]
+		}
+	}
+	return 4, remaining == ""
+}
+
+// slugSimilarity scores how close a slug is to a service slug between 0 and 1, so that misspelled or
+// differently split slugs, e.g. 'securitygroup' or 'instnace', still find their docs. It is the edit
+// distance of the slugs without their underscores, raised by the share of words they have in common.
+func slugSimilarity(slug, serviceSlug string) float64 {
+	a := strings.ReplaceAll(slug, "_", "")
+	b := strings.ReplaceAll(serviceSlug, "_", "")
+	if a == "" || b == "" {
+		return 0
+	}
+	editSimilarity := 1 - float64(levenshtein(a, b))/float64(max(len(a), len(b)))
+
+	words := strings.Split(slug, "_")
+	serviceWords := strings.Split(serviceSlug, "_")
+	common := 0
+	for _, word := range serviceWords {
+		for _, candidate := range words {
+			if word != "" && (candidate == word || (len(word) > 2 && strings.HasPrefix(candidate, word))) {
+				common++
+				break
+			}
+		}
+	}
+	wordSimilarity := float64(common) / float64(len(serviceWords))
+
+	return max(editSimilarity, 0.7*editSimilarity+0.3*wordSimilarity)
+}
+
+// levenshtein returns the edit distance between two strings
+func levenshtein(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
@@ -0,0 +1,67 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAutocompleteServiceSlug(t *testing.T) {
+	t.Run("tool creation", func(t *testing.T) {
+		tool := AutocompleteServiceSlug(log.New())
+
+		assert.Equal(t, "autocomplete_service_slug", tool.Tool.Name)
+		assert.True(t, *tool.Tool.Annotations.ReadOnlyHint)
+		assert.Equal(t, []string{"provider_name", "partial_slug"}, tool.Tool.InputSchema.Required)
+	})
+
+	docs := []client.ProviderDoc{
+		{ID: "1", Slug: "instance", Category: "resources", Language: "hcl"},
+		{ID: "2", Slug: "instance", Category: "data-sources", Language: "hcl"},
+		{ID: "3", Slug: "instances", Category: "data-sources", Language: "hcl"},
+		{ID: "4", Slug: "ec2_instance_state", Category: "resources", Language: "hcl"},
+		{ID: "5", Slug: "spot_instance_request", Category: "resources", Language: "hcl"},
+		{ID: "6", Slug: "security_group", Category: "resources", Language: "hcl"},
+		{ID: "7", Slug: "instance", Category: "resources", Language: "python"},
+		{ID: "8", Slug: "instance", Category: "guides", Language: "hcl"},
+	}
+
+	slugs := func(suggestions []*SlugSuggestion) []string {
+		var ids []string
+		for _, s := range suggestions {
+			ids = append(ids, s.ProviderDocID)
+		}
+		return ids
+	}
+
+	t.Run("ranking", func(t *testing.T) {
+		suggestions := suggestSlugs(docs, "aws", "aws_instance", "", 10)
+		assert.Equal(t, []string{"2", "1", "3", "4", "5"}, slugs(suggestions))
+		assert.Equal(t, "aws_instance", suggestions[0].TypeName)
+
+		assert.Equal(t, []string{"6"}, slugs(suggestSlugs(docs, "aws", "secgr", "", 10)))
+		assert.Equal(t, []string{"1", "4"}, slugs(suggestSlugs(docs, "aws", "inst", "resources", 2)))
+		assert.Empty(t, suggestSlugs(docs, "aws", "bucket", "", 10))
+	})
+
+	t.Run("slug rank", func(t *testing.T) {
+		for partial, expected := range map[string]int{
+			"security_group": 0,
+			"secu":           1,
+			"group":          2,
+			"ity_gr":         3,
+			"sgroup":         4,
+		} {
+			rank, ok := slugRank("security_group", partial)
+			assert.True(t, ok, partial)
+			assert.Equal(t, expected, rank, partial)
+		}
+		_, ok := slugRank("security_group", "bucket")
+		assert.False(t, ok)
+	})
+}
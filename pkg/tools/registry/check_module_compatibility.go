@@ -0,0 +1,329 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclparse"
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// maxModuleArchiveSize bounds the size of the module source archives that are downloaded
+const maxModuleArchiveSize = 50 << 20
+
+// ModuleCompatibility reports the Terraform versions a module version supports
+type ModuleCompatibility struct {
+	ModuleID               string                     `json:"module_id"`
+	Source                 string                     `json:"source"`
+	Tag                    string                     `json:"tag"`
+	Modules                []*ModuleVersionConstraint `json:"modules"`
+	TerraformVersion       string                     `json:"terraform_version,omitempty"`
+	TerraformVersionSource string                     `json:"terraform_version_source,omitempty"`
+	Compatible             *bool                      `json:"compatible,omitempty"`
+	Note                   string                     `json:"note,omitempty"`
+}
+
+// ModuleVersionConstraint is the required_version of the root module or a submodule
+type ModuleVersionConstraint struct {
+	Path            string   `json:"path"`
+	RequiredVersion []string `json:"required_version"`
+	Compatible      *bool    `json:"compatible,omitempty"`
+}
+
+// CheckModuleCompatibility creates a tool to check the Terraform versions supported by a module version.
+func CheckModuleCompatibility(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("check_module_terraform_compatibility",
+			mcp.WithDescription(`Reports the Terraform core version constraints (required_version) declared by a public registry module version, for its root module and submodules, and checks them against a Terraform version or the Terraform version of an HCP Terraform workspace. Call this before recommending a module version for an existing workspace.`),
+			mcp.WithTitleAnnotation("Check a module version against a Terraform version"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("module_id",
+				mcp.Required(),
+				mcp.Description("Exact module_id retrieved from search_modules, including the version (e.g., 'terraform-aws-modules/vpc/aws/5.8.1')"),
+			),
+			mcp.WithString("terraform_version",
+				mcp.Description("Optional Terraform version to check, e.g. '1.5.7'"),
+			),
+			mcp.WithString("terraform_org_name",
+				mcp.Description("Optional HCP Terraform organization of the workspace whose Terraform version is checked"),
+			),
+			mcp.WithString("workspace_name",
+				mcp.Description("Optional HCP Terraform workspace whose Terraform version is checked, used when terraform_version is not given"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return checkModuleCompatibilityHandler(ctx, request, logger)
+		},
+	}
+}
+
+func checkModuleCompatibilityHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	moduleID, err := request.RequireString("module_id")
+	if err != nil {
+		return ToolError(logger, "missing required input: module_id", err)
+	}
+	moduleID = strings.ToLower(strings.TrimSpace(moduleID))
+	if err := validateModuleID(moduleID); err != nil {
+		return ToolError(logger, err.Error(), nil)
+	}
+
+	result := &ModuleCompatibility{ModuleID: moduleID}
+	result.TerraformVersion = strings.TrimSpace(request.GetString("terraform_version", ""))
+	if result.TerraformVersion != "" {
+		result.TerraformVersionSource = "input"
+	} else if workspaceName := strings.TrimSpace(request.GetString("workspace_name", "")); workspaceName != "" {
+		orgName := strings.TrimSpace(request.GetString("terraform_org_name", ""))
+		if orgName == "" {
+			return ToolError(logger, "terraform_org_name is required when workspace_name is provided", nil)
+		}
+		tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+		if err != nil {
+			return ToolError(logger, "failed to get Terraform client to read the workspace", err)
+		}
+		workspace, err := tfeClient.Workspaces.Read(ctx, orgName, workspaceName)
+		if err != nil {
+			return ToolErrorf(logger, "workspace '%s' not found in org '%s'", workspaceName, orgName)
+		}
+		result.TerraformVersion = workspace.TerraformVersion
+		result.TerraformVersionSource = fmt.Sprintf("workspace %s/%s", orgName, workspaceName)
+	}
+
+	httpClient, err := client.GetHttpClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get http client for public Terraform registry", err)
+	}
+
+	response, err := getModuleDetails(ctx, httpClient, moduleID, 0, logger)
+	if err != nil {
+		return ToolErrorf(logger, "module not found: %s - use search_modules first to find valid module IDs", moduleID)
+	}
+	var details client.TerraformModuleVersionDetails
+	if err := json.Unmarshal(response, &details); err != nil {
+		return ToolError(logger, "failed to parse module details", err)
+	}
+	result.Source = details.Source
+	result.Tag = details.Tag
+
+	archiveURL, err := sourceArchiveURL(details.Source, details.Tag)
+	if err != nil {
+		return ToolErrorf(logger, "can't read the source of module %s: %v", moduleID, err)
+	}
+	files, err := downloadModuleFiles(ctx, httpClient, archiveURL)
+	if err != nil {
+		return ToolErrorf(logger, "failed to download the source of module %s: %v", moduleID, err)
+	}
+
+	paths := []string{""}
+	for _, submodule := range details.Submodules {
+		paths = append(paths, submodule.Path)
+	}
+	for _, modulePath := range paths {
+		constraints, err := requiredVersions(files[modulePath])
+		if err != nil {
+			return ToolErrorf(logger, "failed to parse the Terraform files of module %s at '%s': %v", moduleID, modulePath, err)
+		}
+		result.Modules = append(result.Modules, &ModuleVersionConstraint{Path: modulePath, RequiredVersion: constraints})
+	}
+
+	checkModuleCompatibility(result)
+
+	buf, err := json.Marshal(result)
+	if err != nil {
+		return ToolError(logger, "failed to marshal module compatibility", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+// sourceArchiveURL returns the URL of the source archive of a module or policy library version.
+// Modules and policy libraries of the public registry are published from GitHub repositories, one
+// release per tag.
+func sourceArchiveURL(source string, tag string) (string, error) {
+	u, err := url.Parse(source)
+	if err != nil {
+		return "", err
+	}
+	repo := strings.Trim(strings.TrimSuffix(u.Path, ".git"), "/")
+	if u.Host != "github.com" || strings.Count(repo, "/") != 1 || tag == "" {
+		return "", fmt.Errorf("only sources published from a GitHub repository tag are supported, got source '%s' and tag '%s'", source, tag)
+	}
+	return fmt.Sprintf("https://codeload.github.com/%s/tar.gz/refs/tags/%s", repo, url.PathEscape(tag)), nil
+}
+
+// downloadModuleFiles downloads a module source archive and returns its Terraform files grouped
+// by directory, relative to the top-level directory of the archive
+func downloadModuleFiles(ctx context.Context, httpClient *http.Client, archiveURL string) (map[string]map[string][]byte, error) {
+	archive, err := openSourceArchive(ctx, httpClient, archiveURL)
+	if err != nil {
+		return nil, err
+	}
+	defer archive.Close()
+	return moduleFiles(io.LimitReader(archive, maxModuleArchiveSize))
+}
+
+// openSourceArchive starts the download of a source archive
+func openSourceArchive(ctx context.Context, httpClient *http.Client, archiveURL string) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, archiveURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return resp.Body, nil
+}
+
+func moduleFiles(archive io.Reader) (map[string]map[string][]byte, error) {
+	gz, err := gzip.NewReader(archive)
+	if err != nil {
+		return nil, err
+	}
+	defer gz.Close()
+
+	files := make(map[string]map[string][]byte)
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if header.Typeflag != tar.TypeReg || !(strings.HasSuffix(header.Name, ".tf") || strings.HasSuffix(header.Name, ".tf.json")) {
+			continue
+		}
+
+		// GitHub archives put the repository in a "<repo>-<ref>" directory
+		name := path.Clean(header.Name)
+		if i := strings.Index(name, "/"); i >= 0 {
+			name = name[i+1:]
+		}
+		dir := path.Dir(name)
+		if dir == "." {
+			dir = ""
+		}
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, err
+		}
+		if files[dir] == nil {
+			files[dir] = make(map[string][]byte)
+		}
+		files[dir][name] = data
+	}
+	return files, nil
+}
+
+var terraformBlockSchema = &hcl.BodySchema{
+	Blocks: []hcl.BlockHeaderSchema{{Type: "terraform"}},
+}
+
+var requiredVersionSchema = &hcl.BodySchema{
+	Attributes: []hcl.AttributeSchema{{Name: "required_version"}},
+}
+
+// requiredVersions returns the required_version constraints of the terraform blocks of a module
+func requiredVersions(files map[string][]byte) ([]string, error) {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	parser := hclparse.NewParser()
+	constraints := []string{}
+	for _, name := range names {
+		var file *hcl.File
+		var diags hcl.Diagnostics
+		if strings.HasSuffix(name, ".json") {
+			file, diags = parser.ParseJSON(files[name], name)
+		} else {
+			file, diags = parser.ParseHCL(files[name], name)
+		}
+		if diags.HasErrors() {
+			return nil, diags
+		}
+
+		content, _, diags := file.Body.PartialContent(terraformBlockSchema)
+		if diags.HasErrors() {
+			return nil, diags
+		}
+		for _, block := range content.Blocks {
+			attrs, _, diags := block.Body.PartialContent(requiredVersionSchema)
+			if diags.HasErrors() {
+				return nil, diags
+			}
+			attr, ok := attrs.Attributes["required_version"]
+			if !ok {
+				continue
+			}
+			value, diags := attr.Expr.Value(nil)
+			if diags.HasErrors() {
+				return nil, diags
+			}
+			if value.Type() != cty.String || value.IsNull() {
+				return nil, fmt.Errorf("%s: required_version must be a string", name)
+			}
+			constraints = append(constraints, value.AsString())
+		}
+	}
+	return constraints, nil
+}
+
+// checkModuleCompatibility checks every module against the Terraform version of the result. The
+// check is skipped when the Terraform version is not an exact version, such as "latest".
+func checkModuleCompatibility(result *ModuleCompatibility) {
+	if result.TerraformVersion == "" {
+		result.Note = "No terraform_version or workspace given, only the declared constraints are reported"
+		return
+	}
+	v, err := version.NewVersion(result.TerraformVersion)
+	if err != nil {
+		result.Note = fmt.Sprintf("Terraform version '%s' is not an exact version, compare it with the declared constraints manually", result.TerraformVersion)
+		return
+	}
+
+	compatible := true
+	for _, module := range result.Modules {
+		ok := true
+		for _, c := range module.RequiredVersion {
+			constraint, err := version.NewConstraint(c)
+			if err != nil {
+				result.Note = fmt.Sprintf("Invalid required_version '%s' in module path '%s'", c, module.Path)
+				return
+			}
+			ok = ok && constraint.Check(v)
+		}
+		module.Compatible = &ok
+		compatible = compatible && ok
+	}
+	result.Compatible = &compatible
+	if !compatible {
+		result.Note = fmt.Sprintf("Terraform %s does not satisfy the required_version of every module, pick another module version or upgrade Terraform", v)
+	}
+}
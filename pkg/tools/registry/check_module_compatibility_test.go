@@ -0,0 +1,103 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckModuleCompatibility(t *testing.T) {
+	t.Run("tool creation", func(t *testing.T) {
+		tool := CheckModuleCompatibility(log.New())
+
+		assert.Equal(t, "check_module_terraform_compatibility", tool.Tool.Name)
+		assert.True(t, *tool.Tool.Annotations.ReadOnlyHint)
+		assert.Equal(t, []string{"module_id"}, tool.Tool.InputSchema.Required)
+	})
+
+	t.Run("archive url", func(t *testing.T) {
+		archiveURL, err := sourceArchiveURL("https://github.com/terraform-aws-modules/terraform-aws-vpc", "v5.8.1")
+		require.NoError(t, err)
+		assert.Equal(t, "https://codeload.github.com/terraform-aws-modules/terraform-aws-vpc/tar.gz/refs/tags/v5.8.1", archiveURL)
+
+		_, err = sourceArchiveURL("https://gitlab.com/org/module", "v1.0.0")
+		assert.Error(t, err)
+		_, err = sourceArchiveURL("https://github.com/org/module", "")
+		assert.Error(t, err)
+	})
+
+	t.Run("module files", func(t *testing.T) {
+		files, err := moduleFiles(bytes.NewReader(moduleArchive(t, map[string]string{
+			"terraform-aws-vpc-5.8.1/versions.tf":                   `terraform { required_version = ">= 1.0" }`,
+			"terraform-aws-vpc-5.8.1/main.tf":                       `resource "aws_vpc" "this" {}`,
+			"terraform-aws-vpc-5.8.1/README.md":                     "# VPC",
+			"terraform-aws-vpc-5.8.1/modules/endpoints/versions.tf": `terraform { required_version = ">= 1.3" }`,
+		})))
+		require.NoError(t, err)
+		assert.Len(t, files[""], 2)
+		assert.Len(t, files["modules/endpoints"], 1)
+
+		constraints, err := requiredVersions(files[""])
+		require.NoError(t, err)
+		assert.Equal(t, []string{">= 1.0"}, constraints)
+
+		constraints, err = requiredVersions(files["modules/missing"])
+		require.NoError(t, err)
+		assert.Empty(t, constraints)
+	})
+
+	t.Run("compatibility", func(t *testing.T) {
+		newResult := func(terraformVersion string) *ModuleCompatibility {
+			return &ModuleCompatibility{
+				TerraformVersion: terraformVersion,
+				Modules: []*ModuleVersionConstraint{
+					{Path: "", RequiredVersion: []string{">= 1.0"}},
+					{Path: "modules/endpoints", RequiredVersion: []string{">= 1.3", "< 2.0"}},
+				},
+			}
+		}
+
+		result := newResult("1.5.7")
+		checkModuleCompatibility(result)
+		require.NotNil(t, result.Compatible)
+		assert.True(t, *result.Compatible)
+
+		result = newResult("1.2.0")
+		checkModuleCompatibility(result)
+		require.NotNil(t, result.Compatible)
+		assert.False(t, *result.Compatible)
+		assert.True(t, *result.Modules[0].Compatible)
+		assert.False(t, *result.Modules[1].Compatible)
+
+		result = newResult("latest")
+		checkModuleCompatibility(result)
+		assert.Nil(t, result.Compatible)
+		assert.Contains(t, result.Note, "not an exact version")
+
+		result = newResult("")
+		checkModuleCompatibility(result)
+		assert.Nil(t, result.Compatible)
+	})
+}
+
+func moduleArchive(t *testing.T, files map[string]string) []byte {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for name, content := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(content)), Typeflag: tar.TypeReg}))
+		_, err := tw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+	return buf.Bytes()
+}
@@ -0,0 +1,390 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	// maxCompareResourceTypes bounds the number of docs fetched for attribute comparison
+	maxCompareResourceTypes = 10
+	// renameSimilarity is the minimum name similarity for a removed and an added item to be reported as renamed
+	renameSimilarity = 0.75
+)
+
+// attributePattern matches the argument and attribute bullets of provider docs, e.g. "* `name` - (Required) ..."
+var attributePattern = regexp.MustCompile("^\\s*[*-]\\s+`([A-Za-z0-9_.]+)`")
+
+// ProviderVersionComparison is the result of comparing the docs of two provider versions
+type ProviderVersionComparison struct {
+	Provider    string                         `json:"provider"`
+	FromVersion string                         `json:"from_version"`
+	ToVersion   string                         `json:"to_version"`
+	Categories  map[string]*NameDiff           `json:"categories"`
+	Attributes  map[string]*ResourceAttributes `json:"attributes,omitempty"`
+}
+
+// NameDiff lists the names added, removed and likely renamed between two versions
+type NameDiff struct {
+	Added     []string  `json:"added"`
+	Removed   []string  `json:"removed"`
+	Renamed   []Renamed `json:"possibly_renamed,omitempty"`
+	Unchanged int       `json:"unchanged"`
+}
+
+// Renamed pairs a removed name with the added name that most likely replaces it
+type Renamed struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// ResourceAttributes is the attribute comparison of a single resource or data source
+type ResourceAttributes struct {
+	Category string    `json:"category"`
+	Diff     *NameDiff `json:"diff,omitempty"`
+	Error    string    `json:"error,omitempty"`
+}
+
+// CompareProviderVersions creates a tool to compare the schema of two provider versions.
+func CompareProviderVersions(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("compare_provider_versions",
+			mcp.WithDescription(`Compares the documented schema of two versions of a Terraform provider from the public registry. Returns the resources, data sources, ephemeral resources, functions and other documented items added, removed or likely renamed between the versions.
+Pass resource_types to also compare the arguments and attributes of specific resources or data sources, e.g. before a major provider upgrade.`),
+			mcp.WithTitleAnnotation("Compare the schema of two Terraform provider versions"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("namespace",
+				mcp.Required(),
+				mcp.Description("The namespace of the Terraform provider, e.g., 'hashicorp'")),
+			mcp.WithString("name",
+				mcp.Required(),
+				mcp.Description("The name of the Terraform provider, e.g., 'aws', 'azurerm', 'google', etc.")),
+			mcp.WithString("from_version",
+				mcp.Required(),
+				mcp.Description("The provider version to compare from, e.g., '5.100.0'")),
+			mcp.WithString("to_version",
+				mcp.Description("The provider version to compare to (defaults to 'latest')")),
+			mcp.WithString("resource_types",
+				mcp.Description(fmt.Sprintf("Optional comma-separated list of up to %d resource or data source types whose arguments and attributes are compared, e.g., 'aws_instance,aws_s3_bucket'", maxCompareResourceTypes))),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return compareProviderVersionsHandler(ctx, request, logger)
+		},
+	}
+}
+
+func compareProviderVersionsHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	namespace, err := request.RequireString("namespace")
+	if err != nil {
+		return ToolError(logger, "missing required input: namespace", err)
+	}
+	namespace = strings.ToLower(namespace)
+
+	name, err := request.RequireString("name")
+	if err != nil {
+		return ToolError(logger, "missing required input: name", err)
+	}
+	name = strings.ToLower(name)
+
+	fromVersion, err := request.RequireString("from_version")
+	if err != nil {
+		return ToolError(logger, "missing required input: from_version", err)
+	}
+	fromVersion = strings.TrimPrefix(strings.TrimSpace(fromVersion), "v")
+	if !utils.IsValidProviderVersionFormat(fromVersion) {
+		return ToolErrorf(logger, "invalid from_version %q - expected a version such as '5.100.0'", fromVersion)
+	}
+
+	var resourceTypes []string
+	for _, resourceType := range strings.Split(request.GetString("resource_types", ""), ",") {
+		if resourceType = strings.TrimSpace(resourceType); resourceType != "" {
+			resourceTypes = append(resourceTypes, resourceType)
+		}
+	}
+	if len(resourceTypes) > maxCompareResourceTypes {
+		return ToolErrorf(logger, "at most %d resource_types can be compared at once", maxCompareResourceTypes)
+	}
+
+	httpClient, err := client.GetHttpClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get http client for public Terraform registry", err)
+	}
+
+	toVersion := strings.TrimPrefix(strings.TrimSpace(request.GetString("to_version", "latest")), "v")
+	if toVersion == "" || toVersion == "latest" || !utils.IsValidProviderVersionFormat(toVersion) {
+		latestVersion, err := client.GetLatestProviderVersion(ctx, httpClient, namespace, name, logger)
+		if err != nil {
+			return ToolErrorf(logger, "provider not found: %s/%s - verify the namespace and provider name are correct", namespace, name)
+		}
+		toVersion = latestVersion
+	}
+
+	fromDocs, err := fetchProviderDocs(ctx, httpClient, namespace, name, fromVersion, logger)
+	if err != nil {
+		return ToolErrorf(logger, "failed to fetch provider docs for %s/%s:%s - verify the provider version exists", namespace, name, fromVersion)
+	}
+	toDocs, err := fetchProviderDocs(ctx, httpClient, namespace, name, toVersion, logger)
+	if err != nil {
+		return ToolErrorf(logger, "failed to fetch provider docs for %s/%s:%s - verify the provider version exists", namespace, name, toVersion)
+	}
+
+	comparison := &ProviderVersionComparison{
+		Provider:    fmt.Sprintf("%s/%s", namespace, name),
+		FromVersion: fromVersion,
+		ToVersion:   toVersion,
+		Categories:  compareDocCategories(fromDocs, toDocs),
+	}
+
+	if len(resourceTypes) > 0 {
+		comparison.Attributes = make(map[string]*ResourceAttributes, len(resourceTypes))
+		for _, resourceType := range resourceTypes {
+			comparison.Attributes[resourceType] = compareResourceAttributes(ctx, httpClient, name, resourceType, fromDocs, toDocs, logger)
+		}
+	}
+
+	buf, err := json.Marshal(comparison)
+	if err != nil {
+		return ToolError(logger, "failed to marshal provider comparison", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+func fetchProviderDocs(ctx context.Context, httpClient *http.Client, namespace, name, version string, logger *log.Logger) (*client.ProviderDocs, error) {
+	response, err := client.SendRegistryCall(ctx, httpClient, "GET", fmt.Sprintf("providers/%s/%s/%s", namespace, name, version), logger)
+	if err != nil {
+		return nil, err
+	}
+
+	var providerDocs client.ProviderDocs
+	if err := json.Unmarshal(response, &providerDocs); err != nil {
+		return nil, err
+	}
+	return &providerDocs, nil
+}
+
+// docName returns the name a doc is compared by
+func docName(doc client.ProviderDoc) string {
+	if doc.Slug != "" {
+		return doc.Slug
+	}
+	return doc.Title
+}
+
+// docNamesByCategory groups the names of the HCL docs of a provider version by category
+func docNamesByCategory(docs *client.ProviderDocs) map[string][]string {
+	names := make(map[string][]string)
+	for _, doc := range docs.Docs {
+		if doc.Language != "hcl" {
+			continue
+		}
+		category := strings.ToLower(doc.Category)
+		names[category] = append(names[category], docName(doc))
+	}
+	return names
+}
+
+func compareDocCategories(fromDocs, toDocs *client.ProviderDocs) map[string]*NameDiff {
+	fromNames := docNamesByCategory(fromDocs)
+	toNames := docNamesByCategory(toDocs)
+
+	categories := make(map[string]*NameDiff)
+	for category := range fromNames {
+		categories[category] = diffNames(fromNames[category], toNames[category])
+	}
+	for category := range toNames {
+		if _, ok := categories[category]; !ok {
+			categories[category] = diffNames(nil, toNames[category])
+		}
+	}
+	return categories
+}
+
+// findResourceDoc finds the doc of a resource or data source by its type, with or without the provider prefix
+func findResourceDoc(docs *client.ProviderDocs, providerName, resourceType string) (client.ProviderDoc, bool) {
+	short := strings.TrimPrefix(resourceType, providerName+"_")
+	for _, category := range []string{"resources", "data-sources", "ephemeral-resources"} {
+		for _, doc := range docs.Docs {
+			if doc.Language != "hcl" || strings.ToLower(doc.Category) != category {
+				continue
+			}
+			if name := docName(doc); name == short || name == resourceType || doc.Title == resourceType {
+				return doc, true
+			}
+		}
+	}
+	return client.ProviderDoc{}, false
+}
+
+func compareResourceAttributes(ctx context.Context, httpClient *http.Client, providerName, resourceType string, fromDocs, toDocs *client.ProviderDocs, logger *log.Logger) *ResourceAttributes {
+	toDoc, inTo := findResourceDoc(toDocs, providerName, resourceType)
+	fromDoc, inFrom := findResourceDoc(fromDocs, providerName, resourceType)
+	switch {
+	case !inFrom && !inTo:
+		return &ResourceAttributes{Error: "not documented in either version"}
+	case !inFrom:
+		return &ResourceAttributes{Category: toDoc.Category, Error: fmt.Sprintf("not documented in version %s", fromDocs.Version)}
+	case !inTo:
+		return &ResourceAttributes{Category: fromDoc.Category, Error: fmt.Sprintf("not documented in version %s", toDocs.Version)}
+	}
+
+	fromAttributes, err := fetchDocAttributes(ctx, httpClient, fromDoc.ID, logger)
+	if err != nil {
+		return &ResourceAttributes{Category: fromDoc.Category, Error: fmt.Sprintf("failed to fetch doc %s: %v", fromDoc.ID, err)}
+	}
+	toAttributes, err := fetchDocAttributes(ctx, httpClient, toDoc.ID, logger)
+	if err != nil {
+		return &ResourceAttributes{Category: toDoc.Category, Error: fmt.Sprintf("failed to fetch doc %s: %v", toDoc.ID, err)}
+	}
+
+	return &ResourceAttributes{Category: toDoc.Category, Diff: diffNames(fromAttributes, toAttributes)}
+}
+
+func fetchDocAttributes(ctx context.Context, httpClient *http.Client, docID string, logger *log.Logger) ([]string, error) {
+	response, err := client.SendRegistryCall(ctx, httpClient, "GET", path.Join("provider-docs", docID), logger, "v2")
+	if err != nil {
+		return nil, err
+	}
+
+	var details client.ProviderResourceDetails
+	if err := json.Unmarshal(response, &details); err != nil {
+		return nil, err
+	}
+	return docAttributes(details.Data.Attributes.Content), nil
+}
+
+// docAttributes extracts the argument and attribute names documented in a resource doc
+func docAttributes(content string) []string {
+	var attributes []string
+	for _, line := range strings.Split(content, "\n") {
+		if match := attributePattern.FindStringSubmatch(line); match != nil {
+			attributes = append(attributes, match[1])
+		}
+	}
+	return attributes
+}
+
+// diffNames compares two lists of names. Removed and added names that are similar enough are
+// reported as possibly renamed instead.
+func diffNames(from, to []string) *NameDiff {
+	fromSet := make(map[string]bool, len(from))
+	for _, name := range from {
+		fromSet[name] = true
+	}
+	toSet := make(map[string]bool, len(to))
+	for _, name := range to {
+		toSet[name] = true
+	}
+
+	diff := &NameDiff{Added: []string{}, Removed: []string{}}
+	var added, removed []string
+	for name := range toSet {
+		if fromSet[name] {
+			diff.Unchanged++
+		} else {
+			added = append(added, name)
+		}
+	}
+	for name := range fromSet {
+		if !toSet[name] {
+			removed = append(removed, name)
+		}
+	}
+	sort.Strings(added)
+	sort.Strings(removed)
+
+	renamedTo := make(map[string]bool)
+	for _, oldName := range removed {
+		best, bestScore := "", 0.0
+		for _, newName := range added {
+			if renamedTo[newName] {
+				continue
+			}
+			if score := nameSimilarity(oldName, newName); score > bestScore {
+				best, bestScore = newName, score
+			}
+		}
+		if bestScore >= renameSimilarity {
+			renamedTo[best] = true
+			diff.Renamed = append(diff.Renamed, Renamed{From: oldName, To: best})
+			continue
+		}
+		diff.Removed = append(diff.Removed, oldName)
+	}
+	for _, name := range added {
+		if !renamedTo[name] {
+			diff.Added = append(diff.Added, name)
+		}
+	}
+	return diff
+}
+
+// nameSimilarity returns the similarity of two names between 0 and 1, the higher of their
+// normalized edit distance and the overlap of their underscore separated words
+func nameSimilarity(a, b string) float64 {
+	if a == b {
+		return 1
+	}
+	return max(editSimilarity(a, b), wordSimilarity(a, b))
+}
+
+// wordSimilarity returns the Dice coefficient of the underscore separated words of two names
+func wordSimilarity(a, b string) float64 {
+	aWords := strings.Split(a, "_")
+	bWords := make(map[string]int)
+	for _, word := range strings.Split(b, "_") {
+		bWords[word]++
+	}
+
+	shared := 0
+	for _, word := range aWords {
+		if bWords[word] > 0 {
+			bWords[word]--
+			shared++
+		}
+	}
+	return 2 * float64(shared) / float64(len(aWords)+len(strings.Split(b, "_")))
+}
+
+// editSimilarity returns the Levenshtein distance of two names normalized to a similarity between 0 and 1
+func editSimilarity(a, b string) float64 {
+	longest := max(len(a), len(b))
+	if longest == 0 {
+		return 1
+	}
+
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return 1 - float64(previous[len(b)])/float64(longest)
+}
@@ -0,0 +1,79 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompareProviderVersions(t *testing.T) {
+	t.Run("tool creation", func(t *testing.T) {
+		tool := CompareProviderVersions(log.New())
+
+		assert.Equal(t, "compare_provider_versions", tool.Tool.Name)
+		assert.True(t, *tool.Tool.Annotations.ReadOnlyHint)
+		assert.ElementsMatch(t, []string{"namespace", "name", "from_version"}, tool.Tool.InputSchema.Required)
+	})
+
+	t.Run("categories", func(t *testing.T) {
+		fromDocs := &client.ProviderDocs{Version: "5.0.0", Docs: []client.ProviderDoc{
+			{Category: "resources", Slug: "instance", Language: "hcl"},
+			{Category: "resources", Slug: "s3_bucket_object", Language: "hcl"},
+			{Category: "resources", Slug: "opsworks_stack", Language: "hcl"},
+			{Category: "data-sources", Slug: "ami", Language: "hcl"},
+			{Category: "resources", Slug: "instance", Language: "python"},
+		}}
+		toDocs := &client.ProviderDocs{Version: "6.0.0", Docs: []client.ProviderDoc{
+			{Category: "resources", Slug: "instance", Language: "hcl"},
+			{Category: "resources", Slug: "s3_object", Language: "hcl"},
+			{Category: "resources", Slug: "vpc_lattice_service", Language: "hcl"},
+			{Category: "data-sources", Slug: "ami", Language: "hcl"},
+			{Category: "functions", Slug: "arn_parse", Language: "hcl"},
+		}}
+
+		categories := compareDocCategories(fromDocs, toDocs)
+
+		resources := categories["resources"]
+		assert.Equal(t, 1, resources.Unchanged)
+		assert.Equal(t, []string{"vpc_lattice_service"}, resources.Added)
+		assert.Equal(t, []string{"opsworks_stack"}, resources.Removed)
+		assert.Equal(t, []Renamed{{From: "s3_bucket_object", To: "s3_object"}}, resources.Renamed)
+
+		assert.Equal(t, 1, categories["data-sources"].Unchanged)
+		assert.Equal(t, []string{"arn_parse"}, categories["functions"].Added)
+		assert.Empty(t, categories["functions"].Removed)
+	})
+
+	t.Run("doc attributes", func(t *testing.T) {
+		content := "## Argument Reference\n\n* `ami` - (Required) AMI to use.\n* `instance_type` - (Optional) Type.\n  * `nested.value` - Nested.\n\n## Attribute Reference\n\n- `arn` - ARN of the instance.\nSee `not_an_attribute` for details.\n"
+
+		assert.Equal(t, []string{"ami", "instance_type", "nested.value", "arn"}, docAttributes(content))
+	})
+
+	t.Run("find resource doc", func(t *testing.T) {
+		docs := &client.ProviderDocs{Docs: []client.ProviderDoc{
+			{ID: "1", Category: "data-sources", Slug: "instance", Language: "hcl"},
+			{ID: "2", Category: "resources", Slug: "instance", Language: "hcl"},
+		}}
+
+		doc, ok := findResourceDoc(docs, "aws", "aws_instance")
+		assert.True(t, ok)
+		assert.Equal(t, "2", doc.ID)
+
+		_, ok = findResourceDoc(docs, "aws", "aws_vpc")
+		assert.False(t, ok)
+	})
+
+	t.Run("name similarity", func(t *testing.T) {
+		assert.Equal(t, 1.0, nameSimilarity("ami", "ami"))
+		assert.GreaterOrEqual(t, nameSimilarity("s3_bucket_object", "s3_object"), renameSimilarity)
+		assert.GreaterOrEqual(t, nameSimilarity("enable_classiclink", "enable_classic_link"), renameSimilarity)
+		assert.Less(t, nameSimilarity("instance", "instance_state"), renameSimilarity)
+		assert.Less(t, nameSimilarity("opsworks_stack", "vpc_lattice_service"), renameSimilarity)
+	})
+}
@@ -0,0 +1,89 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// ConnectivityDiagnosis is the result of the connectivity checks of the upstream endpoints
+type ConnectivityDiagnosis struct {
+	ProxySettings client.ProxySettings        `json:"proxy_settings"`
+	Endpoints     []*client.EndpointDiagnosis `json:"endpoints"`
+	AllReachable  bool                        `json:"all_reachable"`
+}
+
+// DiagnoseConnectivity creates a tool to check the network path to the upstream endpoints of the server.
+func DiagnoseConnectivity(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("diagnose_connectivity",
+			mcp.WithDescription(`Checks the network path from the MCP server to its upstream endpoints: HCP Terraform or Terraform Enterprise, the public Terraform registry and the security advisory database.
+For each endpoint, reports the DNS resolution, the proxy in use from HTTPS_PROXY/NO_PROXY, the TCP connection and an HTTP request, with hints to fix failed checks. Use it when other tools fail with network errors or time out.`),
+			mcp.WithTitleAnnotation("Diagnose Upstream Connectivity"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("url",
+				mcp.Description("An additional endpoint URL to check, e.g., 'https://registry.example.com'"),
+			),
+		),
+		Handler: func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return diagnoseConnectivityHandler(ctx, req, logger)
+		},
+	}
+}
+
+func diagnoseConnectivityHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	endpoints := client.UpstreamEndpoints(ctx)
+	if rawURL := strings.TrimSpace(request.GetString("url", "")); rawURL != "" {
+		u, err := url.Parse(rawURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return ToolErrorf(logger, "invalid url %q, expected an http or https URL", rawURL)
+		}
+		endpoints = append(endpoints, client.UpstreamEndpoint{Name: "Additional endpoint", URL: rawURL})
+	}
+
+	result := diagnoseEndpoints(ctx, endpoints, logger)
+	buf, err := json.Marshal(result)
+	if err != nil {
+		return ToolError(logger, "failed to marshal connectivity diagnosis", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+// diagnoseEndpoints checks the endpoints concurrently, keeping their order in the result
+func diagnoseEndpoints(ctx context.Context, endpoints []client.UpstreamEndpoint, logger *log.Logger) *ConnectivityDiagnosis {
+	result := &ConnectivityDiagnosis{
+		ProxySettings: client.ProxySettingsFromEnv(),
+		Endpoints:     make([]*client.EndpointDiagnosis, len(endpoints)),
+		AllReachable:  true,
+	}
+
+	var wg sync.WaitGroup
+	for i, endpoint := range endpoints {
+		wg.Add(1)
+		go func(i int, endpoint client.UpstreamEndpoint) {
+			defer wg.Done()
+			result.Endpoints[i] = client.DiagnoseEndpoint(ctx, endpoint, logger)
+		}(i, endpoint)
+	}
+	wg.Wait()
+
+	for _, diagnosis := range result.Endpoints {
+		if !diagnosis.Reachable {
+			result.AllReachable = false
+			logger.Warnf("Upstream endpoint %s is not reachable", diagnosis.URL)
+		}
+	}
+	return result
+}
@@ -0,0 +1,487 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/ext/typeexpr"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+	"github.com/zclconf/go-cty/cty"
+)
+
+const (
+	scaffoldSourceSchema = "schema"
+	scaffoldSourceDocs   = "docs"
+)
+
+// TerraformScaffold is the generated configuration of a resource or data source
+type TerraformScaffold struct {
+	Provider          string   `json:"provider"`
+	Version           string   `json:"version"`
+	SchemaType        string   `json:"schema_type"`
+	TypeName          string   `json:"type_name"`
+	Source            string   `json:"source"`
+	MainTF            string   `json:"main_tf"`
+	VariablesTF       string   `json:"variables_tf,omitempty"`
+	VersionsTF        string   `json:"versions_tf"`
+	RequiredArguments []string `json:"required_arguments"`
+	OptionalArguments []string `json:"optional_arguments,omitempty"`
+	Notes             []string `json:"notes,omitempty"`
+}
+
+// scaffoldOptions selects what a scaffold contains
+type scaffoldOptions struct {
+	blockName        string
+	includeOptional  bool
+	includeVariables bool
+}
+
+// schemaBlock is a block of the `terraform providers schema -json` output
+type schemaBlock struct {
+	Attributes map[string]*schemaAttribute `json:"attributes"`
+	BlockTypes map[string]*schemaBlockType `json:"block_types"`
+}
+
+type schemaAttribute struct {
+	Type        json.RawMessage `json:"type"`
+	Description string          `json:"description"`
+	Required    bool            `json:"required"`
+	Optional    bool            `json:"optional"`
+	Computed    bool            `json:"computed"`
+	Sensitive   bool            `json:"sensitive"`
+	Deprecated  bool            `json:"deprecated"`
+}
+
+type schemaBlockType struct {
+	NestingMode string       `json:"nesting_mode"`
+	Block       *schemaBlock `json:"block"`
+	MinItems    int          `json:"min_items"`
+}
+
+// scaffoldVariable is a variable of a scaffold, for a required argument
+type scaffoldVariable struct {
+	name        string
+	description string
+	typ         *cty.Type
+	sensitive   bool
+}
+
+// GenerateTerraformScaffold creates a tool to generate the configuration of a provider resource or data source.
+func GenerateTerraformScaffold(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("generate_terraform_scaffold",
+			mcp.WithDescription(`Generates a ready-to-use HCL block for a resource or data source of a Terraform provider, with its required arguments and nested blocks, a variables.tf stub declaring a typed variable for each required argument, and the required_providers block.
+The scaffold is built from the provider schema, extracted with the Terraform CLI as in get_provider_schema. Without the Terraform CLI, it is built from the provider docs, which don't include the argument types. Review the optional arguments with get_provider_details before applying it.`),
+			mcp.WithTitleAnnotation("Generate the configuration of a Terraform resource"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("type_name",
+				mcp.Required(),
+				mcp.Description("The resource or data source type, e.g., 'aws_s3_bucket'")),
+			mcp.WithString("namespace",
+				mcp.Description("The namespace of the Terraform provider, e.g., 'hashicorp'"),
+				mcp.DefaultString("hashicorp")),
+			mcp.WithString("name",
+				mcp.Description("The name of the Terraform provider, defaults to the prefix of type_name, e.g., 'aws' for 'aws_s3_bucket'")),
+			mcp.WithString("version",
+				mcp.Description("The version of the provider (defaults to 'latest')")),
+			mcp.WithString("schema_type",
+				mcp.Description("Whether to generate a resource or a data source block"),
+				mcp.Enum("resource", "data-source"),
+				mcp.DefaultString("resource")),
+			mcp.WithString("block_name",
+				mcp.Description("The name of the generated block, e.g., 'this' in resource \"aws_s3_bucket\" \"this\""),
+				mcp.DefaultString("this")),
+			mcp.WithBoolean("include_optional",
+				mcp.Description("List the optional arguments and blocks as comments in the generated block"),
+				mcp.DefaultBool(false)),
+			mcp.WithBoolean("include_variables",
+				mcp.Description("Set the required arguments from variables and generate a variables.tf stub, instead of placeholder values"),
+				mcp.DefaultBool(true)),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return generateTerraformScaffoldHandler(ctx, request, logger)
+		},
+	}
+}
+
+func generateTerraformScaffoldHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	typeName, err := request.RequireString("type_name")
+	if err != nil {
+		return ToolError(logger, "missing required input: type_name", err)
+	}
+	typeName = strings.ToLower(strings.TrimSpace(typeName))
+
+	namespace := strings.ToLower(strings.TrimSpace(request.GetString("namespace", "hashicorp")))
+	name := strings.ToLower(strings.TrimSpace(request.GetString("name", "")))
+	if name == "" {
+		prefix, _, found := strings.Cut(typeName, "_")
+		if !found {
+			return ToolErrorf(logger, "cannot infer the provider of %s, set name", typeName)
+		}
+		name = prefix
+	}
+
+	schemaType := request.GetString("schema_type", "resource")
+	if schemaType != "resource" && schemaType != "data-source" {
+		return ToolErrorf(logger, "invalid schema_type: %s - must be 'resource' or 'data-source'", schemaType)
+	}
+
+	blockName := strings.TrimSpace(request.GetString("block_name", "this"))
+	if !hclsyntax.ValidIdentifier(blockName) {
+		return ToolErrorf(logger, "invalid block_name: %q is not a valid Terraform identifier", blockName)
+	}
+	opts := scaffoldOptions{
+		blockName:        blockName,
+		includeOptional:  request.GetBool("include_optional", false),
+		includeVariables: request.GetBool("include_variables", true),
+	}
+
+	httpClient, err := client.GetHttpClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get http client for public Terraform registry", err)
+	}
+
+	providerVersion := request.GetString("version", "latest")
+	if providerVersion == "latest" || !utils.IsValidProviderVersionFormat(providerVersion) {
+		latestVersion, err := client.GetLatestProviderVersion(ctx, httpClient, namespace, name, logger)
+		if err != nil {
+			return ToolErrorf(logger, "provider not found: %s/%s - verify the namespace and provider name are correct", namespace, name)
+		}
+		providerVersion = latestVersion
+	}
+
+	var scaffold *TerraformScaffold
+	schemas, schemaErr := providerSchemas(ctx, namespace, name, providerVersion, logger)
+	if schemaErr == nil {
+		schema, err := selectProviderSchema(schemas, namespace, name, schemaType, typeName)
+		if err != nil {
+			return ToolError(logger, err.Error(), nil)
+		}
+		scaffold, err = scaffoldFromSchema(schema, schemaType, typeName, opts)
+		if err != nil {
+			return ToolErrorf(logger, "failed to generate the scaffold of %s: %v", typeName, err)
+		}
+	} else {
+		logger.Warnf("Generating the scaffold of %s from the provider docs: %v", typeName, schemaErr)
+		content, err := resourceDocContent(ctx, httpClient, namespace, name, providerVersion, schemaType, typeName, logger)
+		if err != nil {
+			return ToolErrorf(logger, "failed to generate the scaffold of %s from the provider docs: %v", typeName, err)
+		}
+		scaffold = scaffoldFromDocs(content, schemaType, typeName, opts)
+		scaffold.Notes = append(scaffold.Notes, fmt.Sprintf("Generated from the provider docs, as the provider schema could not be extracted: %v. The variable types are not set.", schemaErr))
+	}
+
+	scaffold.Provider = namespace + "/" + name
+	scaffold.Version = providerVersion
+	scaffold.VersionsTF = scaffoldVersionsConfig(namespace, name, providerVersion)
+
+	buf, err := json.Marshal(scaffold)
+	if err != nil {
+		return ToolError(logger, "failed to marshal scaffold", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+// scaffoldFromSchema generates the configuration of a resource or data source from its schema
+func scaffoldFromSchema(raw json.RawMessage, schemaType, typeName string, opts scaffoldOptions) (*TerraformScaffold, error) {
+	var schema struct {
+		Block *schemaBlock `json:"block"`
+	}
+	if err := json.Unmarshal(raw, &schema); err != nil {
+		return nil, fmt.Errorf("failed to parse schema: %w", err)
+	}
+	if schema.Block == nil {
+		return nil, fmt.Errorf("schema has no block")
+	}
+
+	scaffold := &TerraformScaffold{
+		SchemaType:        schemaType,
+		TypeName:          typeName,
+		Source:            scaffoldSourceSchema,
+		RequiredArguments: []string{},
+	}
+	file := hclwrite.NewEmptyFile()
+	block := file.Body().AppendNewBlock(blockKeyword(schemaType), []string{typeName, opts.blockName})
+
+	var variables []*scaffoldVariable
+	if err := writeSchemaBlock(block.Body(), schema.Block, "", typeName, opts, scaffold, &variables); err != nil {
+		return nil, err
+	}
+	scaffold.MainTF = string(hclwrite.Format(file.Bytes()))
+	if opts.includeVariables {
+		scaffold.VariablesTF = variablesFile(variables)
+	}
+	return scaffold, nil
+}
+
+// writeSchemaBlock writes the required attributes and blocks of a schema block, and the optional
+// ones as comments. Nested arguments are prefixed with the path of their block.
+func writeSchemaBlock(body *hclwrite.Body, block *schemaBlock, prefix, typeName string, opts scaffoldOptions, scaffold *TerraformScaffold, variables *[]*scaffoldVariable) error {
+	for _, name := range sortedKeys(block.Attributes) {
+		attribute := block.Attributes[name]
+		switch {
+		case attribute.Required:
+			var ty cty.Type
+			if len(attribute.Type) > 0 {
+				if err := json.Unmarshal(attribute.Type, &ty); err != nil {
+					return fmt.Errorf("failed to parse the type of %s: %w", prefix+name, err)
+				}
+			} else {
+				ty = cty.DynamicPseudoType
+			}
+			scaffold.RequiredArguments = append(scaffold.RequiredArguments, prefix+name)
+			variable := &scaffoldVariable{
+				name:        strings.ReplaceAll(prefix, ".", "_") + name,
+				description: attributeDescription(attribute.Description, prefix+name, typeName),
+				typ:         &ty,
+				sensitive:   attribute.Sensitive,
+			}
+			setArgument(body, name, variable, opts)
+			*variables = append(*variables, variable)
+		case attribute.Optional && !attribute.Deprecated && name != "id":
+			scaffold.OptionalArguments = append(scaffold.OptionalArguments, prefix+name)
+			if opts.includeOptional {
+				appendComment(body, fmt.Sprintf("%s = null", name))
+			}
+		}
+	}
+
+	for _, name := range sortedKeys(block.BlockTypes) {
+		blockType := block.BlockTypes[name]
+		if blockType.Block == nil {
+			continue
+		}
+		if blockType.MinItems == 0 {
+			scaffold.OptionalArguments = append(scaffold.OptionalArguments, prefix+name)
+			if opts.includeOptional {
+				appendComment(body, fmt.Sprintf("%s {}", name))
+			}
+			continue
+		}
+		scaffold.RequiredArguments = append(scaffold.RequiredArguments, prefix+name)
+		var labels []string
+		if blockType.NestingMode == "map" {
+			labels = []string{"key"}
+		}
+		nested := body.AppendNewBlock(name, labels)
+		if err := writeSchemaBlock(nested.Body(), blockType.Block, prefix+name+".", typeName, opts, scaffold, variables); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// scaffoldFromDocs generates the configuration of a resource or data source from the arguments of
+// its doc, without their types
+func scaffoldFromDocs(content, schemaType, typeName string, opts scaffoldOptions) *TerraformScaffold {
+	required, optional := docArguments(content)
+	scaffold := &TerraformScaffold{
+		SchemaType:        schemaType,
+		TypeName:          typeName,
+		Source:            scaffoldSourceDocs,
+		RequiredArguments: required,
+		OptionalArguments: optional,
+	}
+
+	file := hclwrite.NewEmptyFile()
+	body := file.Body().AppendNewBlock(blockKeyword(schemaType), []string{typeName, opts.blockName}).Body()
+	var variables []*scaffoldVariable
+	for _, name := range required {
+		variable := &scaffoldVariable{name: name, description: attributeDescription("", name, typeName)}
+		setArgument(body, name, variable, opts)
+		variables = append(variables, variable)
+	}
+	if opts.includeOptional {
+		for _, name := range optional {
+			appendComment(body, fmt.Sprintf("%s = null", name))
+		}
+	}
+	scaffold.MainTF = string(hclwrite.Format(file.Bytes()))
+	if opts.includeVariables {
+		scaffold.VariablesTF = variablesFile(variables)
+	}
+	return scaffold
+}
+
+// docArguments returns the required and optional top-level arguments of the Argument Reference
+// section of a resource doc. Arguments of nested blocks, documented in subsections, are ignored.
+func docArguments(content string) ([]string, []string) {
+	required := []string{}
+	var optional []string
+	inArguments := false
+	inCode := false
+	for _, line := range strings.Split(content, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") {
+			inCode = !inCode
+			continue
+		}
+		if inCode {
+			continue
+		}
+		if strings.HasPrefix(trimmed, "#") {
+			if inArguments {
+				break
+			}
+			inArguments = strings.HasPrefix(trimmed, "## ") && strings.Contains(trimmed, "Argument")
+			continue
+		}
+		if !inArguments {
+			continue
+		}
+		match := attributePattern.FindStringSubmatch(line)
+		if match == nil || strings.Contains(match[1], ".") {
+			continue
+		}
+		switch {
+		case strings.Contains(line, "(Required)"):
+			required = append(required, match[1])
+		case strings.Contains(line, "(Optional)"):
+			optional = append(optional, match[1])
+		}
+	}
+	return required, optional
+}
+
+// resourceDocContent returns the content of the doc of a resource or data source
+func resourceDocContent(ctx context.Context, httpClient *http.Client, namespace, name, version, schemaType, typeName string, logger *log.Logger) (string, error) {
+	docs, err := fetchProviderDocs(ctx, httpClient, namespace, name, version, logger)
+	if err != nil {
+		return "", err
+	}
+
+	category := "resources"
+	if schemaType == "data-source" {
+		category = "data-sources"
+	}
+	short := strings.TrimPrefix(typeName, name+"_")
+	for _, doc := range docs.Docs {
+		if doc.Language != "hcl" || strings.ToLower(doc.Category) != category {
+			continue
+		}
+		if docName := docName(doc); docName != short && docName != typeName {
+			continue
+		}
+
+		response, err := client.SendRegistryCall(ctx, httpClient, "GET", path.Join("provider-docs", doc.ID), logger, "v2")
+		if err != nil {
+			return "", err
+		}
+		var details client.ProviderResourceDetails
+		if err := json.Unmarshal(response, &details); err != nil {
+			return "", err
+		}
+		return details.Data.Attributes.Content, nil
+	}
+	return "", fmt.Errorf("%s '%s' is not documented in provider %s/%s %s", schemaType, typeName, namespace, name, version)
+}
+
+// scaffoldVersionsConfig requires the major version of the provider the scaffold was generated for
+func scaffoldVersionsConfig(namespace, name, providerVersion string) string {
+	constraint := providerVersion
+	if v, err := version.NewVersion(providerVersion); err == nil {
+		constraint = fmt.Sprintf("~> %d.0", v.Segments()[0])
+	}
+	return fmt.Sprintf(`terraform {
+  required_providers {
+    %s = {
+      source  = %q
+      version = %q
+    }
+  }
+}
+`, name, namespace+"/"+name, constraint)
+}
+
+// setArgument sets a required argument from its variable, or to a placeholder value of its type
+func setArgument(body *hclwrite.Body, name string, variable *scaffoldVariable, opts scaffoldOptions) {
+	if opts.includeVariables {
+		body.SetAttributeTraversal(name, hcl.Traversal{
+			hcl.TraverseRoot{Name: "var"},
+			hcl.TraverseAttr{Name: variable.name},
+		})
+		return
+	}
+	body.SetAttributeValue(name, placeholderValue(variable.typ))
+}
+
+// placeholderValue returns the empty value of a type, to be replaced in the generated configuration
+func placeholderValue(ty *cty.Type) cty.Value {
+	switch {
+	case ty == nil || *ty == cty.DynamicPseudoType || *ty == cty.String:
+		return cty.StringVal("")
+	case *ty == cty.Number:
+		return cty.Zero
+	case *ty == cty.Bool:
+		return cty.False
+	case ty.IsListType() || ty.IsSetType() || ty.IsTupleType():
+		return cty.EmptyTupleVal
+	default:
+		return cty.EmptyObjectVal
+	}
+}
+
+// variablesFile declares the variables of a scaffold
+func variablesFile(variables []*scaffoldVariable) string {
+	file := hclwrite.NewEmptyFile()
+	for i, variable := range variables {
+		if i > 0 {
+			file.Body().AppendNewline()
+		}
+		body := file.Body().AppendNewBlock("variable", []string{variable.name}).Body()
+		body.SetAttributeValue("description", cty.StringVal(variable.description))
+		if variable.typ != nil {
+			body.SetAttributeRaw("type", hclwrite.Tokens{{Type: hclsyntax.TokenIdent, Bytes: []byte(typeexpr.TypeString(*variable.typ))}})
+		}
+		if variable.sensitive {
+			body.SetAttributeValue("sensitive", cty.True)
+		}
+	}
+	return string(hclwrite.Format(file.Bytes()))
+}
+
+// attributeDescription returns the first line of an attribute description, or a generic description
+func attributeDescription(description, argument, typeName string) string {
+	if first, _, _ := strings.Cut(strings.TrimSpace(description), "\n"); first != "" {
+		return first
+	}
+	return fmt.Sprintf("The %s argument of %s", argument, typeName)
+}
+
+func appendComment(body *hclwrite.Body, comment string) {
+	body.AppendUnstructuredTokens(hclwrite.Tokens{{Type: hclsyntax.TokenComment, Bytes: []byte("# " + comment + "\n")}})
+}
+
+func blockKeyword(schemaType string) string {
+	if schemaType == "data-source" {
+		return "data"
+	}
+	return "resource"
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,122 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testBucketSchema = `{
+  "version": 0,
+  "block": {
+    "attributes": {
+      "id": {"type": "string", "optional": true, "computed": true},
+      "bucket": {"type": "string", "required": true, "description": "Name of the bucket.\nMust be unique."},
+      "tags": {"type": ["map", "string"], "optional": true},
+      "acl": {"type": "string", "optional": true, "deprecated": true},
+      "arn": {"type": "string", "computed": true}
+    },
+    "block_types": {
+      "lifecycle_rule": {
+        "nesting_mode": "list",
+        "min_items": 1,
+        "block": {
+          "attributes": {
+            "status": {"type": "string", "required": true},
+            "prefixes": {"type": ["list", "string"], "required": true},
+            "api_key": {"type": "string", "required": true, "sensitive": true}
+          }
+        }
+      },
+      "timeouts": {"nesting_mode": "single", "block": {"attributes": {"create": {"type": "string", "optional": true}}}}
+    }
+  }
+}`
+
+const testBucketDoc = "---\nsubcategory: S3\n---\n\n# Resource: aws_s3_bucket\n\n## Example Usage\n\n```terraform\nresource \"aws_s3_bucket\" \"example\" {\n  * `ignored` - (Required) in code\n}\n```\n\n## Argument Reference\n\n* `bucket` - (Required) Name of the bucket.\n* `tags` - (Optional) Map of tags.\n* `lifecycle_rule.status` - (Required) Nested.\n\n### lifecycle_rule\n\n* `prefix` - (Required) Nested argument.\n\n## Attribute Reference\n\n* `arn` - ARN of the bucket.\n"
+
+func TestGenerateTerraformScaffold(t *testing.T) {
+	t.Run("tool creation", func(t *testing.T) {
+		tool := GenerateTerraformScaffold(log.New())
+		assert.Equal(t, "generate_terraform_scaffold", tool.Tool.Name)
+		assert.True(t, *tool.Tool.Annotations.ReadOnlyHint)
+		assert.Equal(t, []string{"type_name"}, tool.Tool.InputSchema.Required)
+	})
+
+	t.Run("from schema", func(t *testing.T) {
+		scaffold, err := scaffoldFromSchema([]byte(testBucketSchema), "resource", "aws_s3_bucket", scaffoldOptions{blockName: "this", includeOptional: true, includeVariables: true})
+		require.NoError(t, err)
+
+		assert.Equal(t, scaffoldSourceSchema, scaffold.Source)
+		assert.Equal(t, `resource "aws_s3_bucket" "this" {
+  bucket = var.bucket
+  # tags = null
+  lifecycle_rule {
+    api_key  = var.lifecycle_rule_api_key
+    prefixes = var.lifecycle_rule_prefixes
+    status   = var.lifecycle_rule_status
+  }
+  # timeouts {}
+}
+`, scaffold.MainTF)
+		assert.Equal(t, `variable "bucket" {
+  description = "Name of the bucket."
+  type        = string
+}
+
+variable "lifecycle_rule_api_key" {
+  description = "The lifecycle_rule.api_key argument of aws_s3_bucket"
+  type        = string
+  sensitive   = true
+}
+
+variable "lifecycle_rule_prefixes" {
+  description = "The lifecycle_rule.prefixes argument of aws_s3_bucket"
+  type        = list(string)
+}
+
+variable "lifecycle_rule_status" {
+  description = "The lifecycle_rule.status argument of aws_s3_bucket"
+  type        = string
+}
+`, scaffold.VariablesTF)
+		assert.Equal(t, []string{"bucket", "lifecycle_rule", "lifecycle_rule.api_key", "lifecycle_rule.prefixes", "lifecycle_rule.status"}, scaffold.RequiredArguments)
+		assert.Equal(t, []string{"tags", "timeouts"}, scaffold.OptionalArguments)
+	})
+
+	t.Run("placeholders", func(t *testing.T) {
+		scaffold, err := scaffoldFromSchema([]byte(testBucketSchema), "data-source", "aws_s3_bucket", scaffoldOptions{blockName: "main"})
+		require.NoError(t, err)
+
+		assert.Equal(t, `data "aws_s3_bucket" "main" {
+  bucket = ""
+  lifecycle_rule {
+    api_key  = ""
+    prefixes = []
+    status   = ""
+  }
+}
+`, scaffold.MainTF)
+		assert.Empty(t, scaffold.VariablesTF)
+	})
+
+	t.Run("from docs", func(t *testing.T) {
+		scaffold := scaffoldFromDocs(testBucketDoc, "resource", "aws_s3_bucket", scaffoldOptions{blockName: "this", includeVariables: true})
+
+		assert.Equal(t, scaffoldSourceDocs, scaffold.Source)
+		assert.Equal(t, []string{"bucket"}, scaffold.RequiredArguments)
+		assert.Equal(t, []string{"tags"}, scaffold.OptionalArguments)
+		assert.Equal(t, "resource \"aws_s3_bucket\" \"this\" {\n  bucket = var.bucket\n}\n", scaffold.MainTF)
+		assert.Equal(t, "variable \"bucket\" {\n  description = \"The bucket argument of aws_s3_bucket\"\n}\n", scaffold.VariablesTF)
+	})
+
+	t.Run("versions", func(t *testing.T) {
+		assert.Contains(t, scaffoldVersionsConfig("hashicorp", "aws", "6.2.0"), `version = "~> 6.0"`)
+		assert.Contains(t, scaffoldVersionsConfig("hashicorp", "aws", "6.2.0"), `source  = "hashicorp/aws"`)
+	})
+}
@@ -11,6 +11,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
 	log "github.com/sirupsen/logrus"
@@ -20,7 +21,7 @@ import (
 func GetLatestModuleVersion(logger *log.Logger) server.ServerTool {
 	return server.ServerTool{
 		Tool: mcp.NewTool("get_latest_module_version",
-			mcp.WithDescription("Fetches the latest version of a Terraform module from the public registry"),
+			mcp.WithDescription("Fetches the latest version of a Terraform module from the public registry. By default only stable releases are considered; set release_channel to include pre-releases. Pre-release results are labeled with their channel."),
 			mcp.WithTitleAnnotation("Get Latest Module Version"),
 			mcp.WithOpenWorldHintAnnotation(true),
 			mcp.WithReadOnlyHintAnnotation(true),
@@ -34,6 +35,7 @@ func GetLatestModuleVersion(logger *log.Logger) server.ServerTool {
 			mcp.WithString("module_provider",
 				mcp.Required(),
 				mcp.Description("The name of the Terraform provider for the module, e.g., 'aws', 'google', 'azurerm' etc.")),
+			withReleaseChannel(),
 		),
 		Handler: func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			return getLatestModuleVersionHandler(ctx, req, logger)
@@ -60,6 +62,11 @@ func getLatestModuleVersionHandler(ctx context.Context, request mcp.CallToolRequ
 	}
 	moduleProvider = strings.ToLower(moduleProvider)
 
+	channel, err := releaseChannelParam(request)
+	if err != nil {
+		return ToolError(logger, "invalid input", err)
+	}
+
 	// Get a simple http client to access the public Terraform registry from context
 	httpClient, err := client.GetHttpClientFromContext(ctx, logger)
 	if err != nil {
@@ -77,5 +84,14 @@ func getLatestModuleVersionHandler(ctx context.Context, request mcp.CallToolRequ
 		return ToolErrorf(logger, "unmarshalling module information for %s/%s from the %s provider: %v", modulePublisher, moduleName, moduleProvider, err)
 	}
 
-	return mcp.NewToolResultText(moduleVersionDetails.Version), nil
+	if channel == utils.ReleaseChannelStable {
+		return latestVersionResult(moduleVersionDetails.Version), nil
+	}
+
+	latest, err := utils.LatestVersionForChannel(moduleVersionDetails.Versions, channel)
+	if err != nil {
+		return ToolErrorf(logger, "no %s release found for module %s/%s/%s: %v", channel, modulePublisher, moduleName, moduleProvider, err)
+	}
+
+	return latestVersionResult(latest), nil
 }
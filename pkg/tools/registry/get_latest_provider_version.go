@@ -5,9 +5,11 @@ package tools
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
 	log "github.com/sirupsen/logrus"
@@ -17,7 +19,7 @@ import (
 func GetLatestProviderVersion(logger *log.Logger) server.ServerTool {
 	return server.ServerTool{
 		Tool: mcp.NewTool("get_latest_provider_version",
-			mcp.WithDescription("Fetches the latest version of a Terraform provider from the public registry"),
+			mcp.WithDescription("Fetches the latest version of a Terraform provider from the public registry. By default only stable releases are considered; set release_channel to include pre-releases. Pre-release results are labeled with their channel."),
 			mcp.WithTitleAnnotation("Get Latest Provider Version"),
 			mcp.WithOpenWorldHintAnnotation(true),
 			mcp.WithReadOnlyHintAnnotation(true),
@@ -28,6 +30,7 @@ func GetLatestProviderVersion(logger *log.Logger) server.ServerTool {
 			mcp.WithString("name",
 				mcp.Required(),
 				mcp.Description("The name of the Terraform provider, e.g., 'aws', 'azurerm', 'google', etc.")),
+			withReleaseChannel(),
 		),
 		Handler: func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			return getLatestProviderVersionHandler(ctx, req, logger)
@@ -48,15 +51,69 @@ func getLatestProviderVersionHandler(ctx context.Context, request mcp.CallToolRe
 	}
 	name = strings.ToLower(name)
 
+	channel, err := releaseChannelParam(request)
+	if err != nil {
+		return ToolError(logger, "invalid input", err)
+	}
+
 	httpClient, err := client.GetHttpClientFromContext(ctx, logger)
 	if err != nil {
 		return ToolError(logger, "failed to get http client for public Terraform registry", err)
 	}
 
-	version, err := client.GetLatestProviderVersion(ctx, httpClient, namespace, name, logger)
+	version, err := client.GetLatestProviderVersionForChannel(ctx, httpClient, namespace, name, channel, logger)
 	if err != nil {
+		if channel != utils.ReleaseChannelStable {
+			return ToolErrorf(logger, "no %s release found for provider %s/%s: %v", channel, namespace, name, err)
+		}
 		return ToolErrorf(logger, "provider not found: %s/%s - verify the namespace and provider name are correct", namespace, name)
 	}
 
-	return mcp.NewToolResultText(version), nil
+	return latestVersionResult(version), nil
+}
+
+// withReleaseChannel adds the optional release_channel parameter shared by the latest version tools.
+func withReleaseChannel() mcp.ToolOption {
+	return mcp.WithString("release_channel",
+		mcp.Description("Optional release channel to pick the latest version from: 'stable' (default, excludes pre-releases), 'any' (includes pre-releases), or a specific pre-release channel 'alpha', 'beta' or 'rc'"),
+		mcp.Enum(utils.ReleaseChannels()...),
+		mcp.DefaultString(utils.ReleaseChannelStable),
+	)
+}
+
+// releaseChannelParam reads and validates the release_channel parameter.
+func releaseChannelParam(request mcp.CallToolRequest) (string, error) {
+	channel := strings.ToLower(strings.TrimSpace(request.GetString("release_channel", utils.ReleaseChannelStable)))
+	if channel == "" {
+		return utils.ReleaseChannelStable, nil
+	}
+	for _, valid := range utils.ReleaseChannels() {
+		if channel == valid {
+			return channel, nil
+		}
+	}
+	return "", fmt.Errorf("release_channel must be one of %s, got %q", strings.Join(utils.ReleaseChannels(), ", "), channel)
+}
+
+// LatestVersion is the structured result of the latest version tools
+type LatestVersion struct {
+	Version        string `json:"version"`
+	ReleaseChannel string `json:"release_channel"`
+}
+
+// latestVersionResult returns the labeled version as text, with the version and its release channel as structured content
+func latestVersionResult(version string) *mcp.CallToolResult {
+	return mcp.NewToolResultStructured(&LatestVersion{
+		Version:        version,
+		ReleaseChannel: utils.ReleaseChannelOf(version),
+	}, labelVersion(version))
+}
+
+// labelVersion appends the release channel to pre-release versions so they are not mistaken for stable releases.
+func labelVersion(version string) string {
+	channel := utils.ReleaseChannelOf(version)
+	if channel == utils.ReleaseChannelStable {
+		return version
+	}
+	return fmt.Sprintf("%s (pre-release: %s)", version, channel)
 }
@@ -6,8 +6,10 @@ package tools
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"path"
 	"strings"
 
 	"github.com/hashicorp/terraform-mcp-server/pkg/client"
@@ -71,10 +73,26 @@ func getModuleDetailsHandler(ctx context.Context, request mcp.CallToolRequest, l
 	if moduleData == "" {
 		return ToolErrorf(logger, "no module data returned for %s - try a different module_id", moduleID)
 	}
+	if advisories := moduleAdvisories(ctx, httpClient, response, logger); advisories != "" {
+		moduleData += "\n" + advisories
+	}
 
 	return mcp.NewToolResultText(moduleData), nil
 }
 
+// moduleAdvisories looks up the security advisories affecting the module version of a registry response
+func moduleAdvisories(ctx context.Context, httpClient *http.Client, response []byte, logger *log.Logger) string {
+	var module client.TerraformModuleVersionDetails
+	if err := json.Unmarshal(response, &module); err != nil || module.Version == "" {
+		return ""
+	}
+	advisories, err := client.LookupAdvisories(ctx, httpClient, client.AdvisoryKindModule, path.Join(module.Namespace, module.Name, module.Provider), module.Version, logger)
+	if err != nil && !errors.Is(err, client.ErrNoAdvisorySource) {
+		logger.Warnf("Security advisory lookup for module %s/%s/%s %s: %v", module.Namespace, module.Name, module.Provider, module.Version, err)
+	}
+	return client.FormatAdvisories(advisories, err)
+}
+
 func getModuleDetails(ctx context.Context, httpClient *http.Client, moduleID string, currentOffset int, logger *log.Logger) ([]byte, error) {
 	uri := "modules"
 	if moduleID != "" {
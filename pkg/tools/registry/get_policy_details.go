@@ -22,7 +22,7 @@ import (
 func PolicyDetails(logger *log.Logger) server.ServerTool {
 	return server.ServerTool{
 		Tool: mcp.NewTool("get_policy_details",
-			mcp.WithDescription(`Fetches up-to-date documentation for a specific policy from the Terraform registry. You must call 'search_policies' first to obtain the exact terraform_policy_id required to use this tool.`),
+			mcp.WithDescription(`Fetches up-to-date documentation for a specific policy from the Terraform registry. You must call 'search_policies' first to obtain the exact terraform_policy_id required to use this tool. Set include_source to also fetch the Sentinel code of the policies and policy modules and the test cases of the policies, to review or adapt them.`),
 			mcp.WithTitleAnnotation("Fetch detailed Terraform policy documentation using a terraform_policy_id"),
 			mcp.WithOpenWorldHintAnnotation(true),
 			mcp.WithReadOnlyHintAnnotation(true),
@@ -31,6 +31,13 @@ func PolicyDetails(logger *log.Logger) server.ServerTool {
 				mcp.Required(),
 				mcp.Description("Matching terraform_policy_id retrieved from the 'search_policies' tool (e.g., 'policies/hashicorp/CIS-Policy-Set-for-AWS-Terraform/1.0.1')"),
 			),
+			mcp.WithBoolean("include_source",
+				mcp.DefaultBool(false),
+				mcp.Description("Whether to include the Sentinel code of the policies and policy modules and the test cases of the policies, read from the source of the policy library"),
+			),
+			mcp.WithString("policy_names",
+				mcp.Description("Optional comma-separated names of the policies whose source to include, all policies by default"),
+			),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			return getPolicyDetailsHandler(ctx, request, logger)
@@ -129,6 +136,16 @@ policy "<<POLICY_NAME>>" {
 	builder.WriteString(fmt.Sprintf("Available policies with SHA for %s are: \n\n", terraformPolicyID))
 	builder.WriteString(policyList)
 
+	if request.GetBool("include_source", false) {
+		selected := make(map[string]bool)
+		for _, name := range strings.Split(request.GetString("policy_names", ""), ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				selected[name] = true
+			}
+		}
+		writePolicySources(&builder, fetchPolicySources(ctx, httpClient, terraformPolicyID, &policyDetails, selected, logger))
+	}
+
 	policyData := builder.String()
 	return mcp.NewToolResultText(policyData), nil
 }
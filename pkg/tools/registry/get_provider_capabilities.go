@@ -6,6 +6,7 @@ package tools
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -98,6 +99,13 @@ func getProviderCapabilitiesHandler(ctx context.Context, request mcp.CallToolReq
 	}
 
 	output := analyzeAndFormatCapabilities(providerDocs, namespace, name, version)
+	advisories, err := client.LookupAdvisories(ctx, httpClient, client.AdvisoryKindProvider, namespace+"/"+name, version, logger)
+	if err != nil && !errors.Is(err, client.ErrNoAdvisorySource) {
+		logger.Warnf("Security advisory lookup for provider %s/%s %s: %v", namespace, name, version, err)
+	}
+	if section := client.FormatAdvisories(advisories, err); section != "" {
+		output += "\n" + section
+	}
 	return mcp.NewToolResultText(output), nil
 }
 
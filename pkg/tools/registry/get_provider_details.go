@@ -6,21 +6,32 @@ package tools
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"path"
 	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
 	log "github.com/sirupsen/logrus"
 )
 
+// Detail levels of get_provider_details
+const (
+	ProviderDocSummary = "summary"
+	ProviderDocFull    = "full"
+)
+
 // GetProviderDocs creates a tool to get provider docs for a specific service from registry.
 func GetProviderDocs(logger *log.Logger) server.ServerTool {
 	return server.ServerTool{
 		Tool: mcp.NewTool("get_provider_details",
 			mcp.WithDescription(`Fetches up-to-date documentation for a specific service from a Terraform provider. 
-You must call 'search_providers' tool first to obtain the exact tfprovider-compatible provider_doc_id required to use this tool.`),
+You must call 'search_providers' tool first to obtain the exact tfprovider-compatible provider_doc_id required to use this tool.
+By default, returns a compact summary with the description and the names of the arguments and attributes; use detail 'full' for the complete documentation with examples and argument descriptions before generating code.
+Documents larger than the byte budget of the server are not returned at once: an index of their sections is returned instead, then request a section by its number, and continue sections larger than the budget with byte_offset.`),
 			mcp.WithTitleAnnotation("Fetch detailed Terraform provider documentation using a document ID"),
 			mcp.WithOpenWorldHintAnnotation(true),
 			mcp.WithReadOnlyHintAnnotation(true),
@@ -28,6 +39,20 @@ You must call 'search_providers' tool first to obtain the exact tfprovider-compa
 			mcp.WithString("provider_doc_id",
 				mcp.Required(),
 				mcp.Description("Exact tfprovider-compatible provider_doc_id, (e.g., '8894603', '8906901') retrieved from 'search_providers'")),
+			mcp.WithString("detail",
+				mcp.Description("'summary' for the description and argument names only, 'full' for the complete documentation"),
+				mcp.Enum(ProviderDocSummary, ProviderDocFull),
+				mcp.DefaultString(ProviderDocSummary),
+			),
+			mcp.WithNumber("section",
+				mcp.Description("Number of the section to return from the section index of a large document. Implies detail 'full'"),
+				mcp.Min(1),
+			),
+			mcp.WithNumber("byte_offset",
+				mcp.Description("Byte offset to continue a document or section from, as told at the end of the previous part. Implies detail 'full'"),
+				mcp.DefaultNumber(0),
+				mcp.Min(0),
+			),
 		),
 		Handler: func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			return getProviderDocsHandler(ctx, req, logger)
@@ -46,6 +71,15 @@ func getProviderDocsHandler(ctx context.Context, request mcp.CallToolRequest, lo
 	if _, err := strconv.Atoi(providerDocID); err != nil {
 		return ToolError(logger, "provider_doc_id must be a valid number - use search_providers first to find valid IDs", err)
 	}
+	detail := request.GetString("detail", ProviderDocSummary)
+	if detail != ProviderDocSummary && detail != ProviderDocFull {
+		return ToolErrorf(logger, "invalid detail '%s' - must be '%s' or '%s'", detail, ProviderDocSummary, ProviderDocFull)
+	}
+	section := request.GetInt("section", 0)
+	byteOffset := request.GetInt("byte_offset", 0)
+	if section < 0 || byteOffset < 0 {
+		return ToolError(logger, "section and byte_offset cannot be negative", nil)
+	}
 
 	httpClient, err := client.GetHttpClientFromContext(ctx, logger)
 	if err != nil {
@@ -62,5 +96,156 @@ func getProviderDocsHandler(ctx context.Context, request mcp.CallToolRequest, lo
 		return ToolErrorf(logger, "failed to parse provider docs for %s", providerDocID)
 	}
 
-	return mcp.NewToolResultText(details.Data.Attributes.Content), nil
+	maxBytes := providerDocMaxBytes(logger)
+	title, content := details.Data.Attributes.Title, details.Data.Attributes.Content
+	if detail == ProviderDocSummary && section == 0 && byteOffset == 0 {
+		// Summaries of guides are the whole guide, which is split like full documents when too large
+		if summary := providerDocSummary(providerDocID, title, content); len(summary) <= maxBytes {
+			return mcp.NewToolResultText(summary), nil
+		}
+	}
+
+	text, name := content, "the document"
+	if section > 0 {
+		sections := splitProviderDoc(content)
+		if section > len(sections) {
+			return ToolErrorf(logger, "section %d not found - the document has %d sections", section, len(sections))
+		}
+		text, name = sections[section-1].content, fmt.Sprintf("section %d (%s)", section, sections[section-1].heading)
+	} else if byteOffset == 0 && len(content) > maxBytes {
+		return mcp.NewToolResultText(providerDocIndex(providerDocID, title, splitProviderDoc(content), len(content), maxBytes)), nil
+	}
+	if byteOffset >= len(text) && byteOffset > 0 {
+		return ToolErrorf(logger, "byte_offset %d is beyond the end of %s, which is %d bytes", byteOffset, name, len(text))
+	}
+
+	part, next := utils.TextRange(text, byteOffset, maxBytes)
+	if next > 0 {
+		continuation := fmt.Sprintf("provider_doc_id '%s' and byte_offset %d", providerDocID, next)
+		if section > 0 {
+			continuation = fmt.Sprintf("provider_doc_id '%s', section %d and byte_offset %d", providerDocID, section, next)
+		}
+		part += fmt.Sprintf("\n\n[Bytes %d-%d of %d of %s. Call get_provider_details with %s for the rest.]", byteOffset, next, len(text), name, continuation)
+	}
+	return mcp.NewToolResultText(part), nil
+}
+
+// docSection is a heading of a provider doc with the argument or attribute names documented under it
+type docSection struct {
+	heading string
+	names   []string
+}
+
+// providerDocSummary reduces a provider doc to its description and the names of its arguments and
+// attributes, grouped by block. Docs without arguments or attributes, such as guides, are returned in full.
+func providerDocSummary(providerDocID, title, content string) string {
+	description, body := docDescription(content)
+
+	var sections []*docSection
+	var current *docSection
+	inReference := false
+	inCode := false
+	for _, line := range strings.Split(body, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") {
+			inCode = !inCode
+			continue
+		}
+		if inCode {
+			continue
+		}
+		switch {
+		case strings.HasPrefix(trimmed, "## "):
+			heading := strings.TrimPrefix(trimmed, "## ")
+			inReference = strings.Contains(heading, "Argument") || strings.Contains(heading, "Attribute")
+			current = nil
+			if inReference {
+				current = &docSection{heading: heading}
+				sections = append(sections, current)
+			}
+		case strings.HasPrefix(trimmed, "### ") && inReference:
+			current = &docSection{heading: strings.TrimPrefix(trimmed, "### ")}
+			sections = append(sections, current)
+		case current != nil:
+			if match := attributePattern.FindStringSubmatch(line); match != nil {
+				name := match[1]
+				if strings.Contains(line, "(Required)") {
+					name += " (required)"
+				}
+				current.names = append(current.names, name)
+			}
+		}
+	}
+
+	var builder strings.Builder
+	if title != "" {
+		fmt.Fprintf(&builder, "# %s\n\n", title)
+	}
+	if description != "" {
+		fmt.Fprintf(&builder, "%s\n\n", description)
+	}
+	documented := false
+	for _, section := range sections {
+		if len(section.names) == 0 {
+			continue
+		}
+		documented = true
+		fmt.Fprintf(&builder, "**%s**: %s\n\n", strings.Trim(section.heading, "`"), strings.Join(section.names, ", "))
+	}
+	if !documented {
+		return content
+	}
+	fmt.Fprintf(&builder, "Call get_provider_details with provider_doc_id '%s' and detail 'full' for the argument descriptions, nested block details and examples.", providerDocID)
+	return builder.String()
+}
+
+// docDescription returns the description of a provider doc from its front matter, or else its
+// first paragraph, and the content after the front matter
+func docDescription(content string) (string, string) {
+	body := content
+	var description []string
+	if rest, ok := strings.CutPrefix(content, "---\n"); ok {
+		if frontMatter, after, found := strings.Cut(rest, "\n---"); found {
+			body = after
+			inDescription := false
+			for _, line := range strings.Split(frontMatter, "\n") {
+				if value, ok := strings.CutPrefix(line, "description:"); ok {
+					value = strings.TrimSpace(value)
+					inDescription = value == "|-" || value == "|" || value == ">-" || value == ">"
+					if !inDescription && value != "" {
+						description = append(description, strings.Trim(value, `"'`))
+					}
+					continue
+				}
+				if inDescription && (strings.HasPrefix(line, " ") || strings.TrimSpace(line) == "") {
+					description = append(description, strings.TrimSpace(line))
+					continue
+				}
+				inDescription = false
+			}
+		}
+	}
+	if text := strings.TrimSpace(strings.Join(description, " ")); text != "" {
+		return text, body
+	}
+
+	// Without a front matter description, use the first paragraph below the title
+	var paragraph []string
+	for _, line := range strings.Split(body, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "#") || strings.HasPrefix(trimmed, "-> ") || strings.HasPrefix(trimmed, "~> ") {
+			if len(paragraph) > 0 {
+				break
+			}
+			continue
+		}
+		if trimmed == "" || strings.HasPrefix(trimmed, "```") {
+			if len(paragraph) > 0 {
+				break
+			}
+			continue
+		}
+		paragraph = append(paragraph, trimmed)
+	}
+	return strings.Join(paragraph, " "), body
 }
@@ -0,0 +1,52 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetProviderDocs(t *testing.T) {
+	logger := log.New()
+	logger.SetLevel(log.ErrorLevel)
+
+	t.Run("tool creation", func(t *testing.T) {
+		tool := GetProviderDocs(logger)
+
+		assert.Equal(t, "get_provider_details", tool.Tool.Name)
+		assert.Equal(t, []string{"provider_doc_id"}, tool.Tool.InputSchema.Required)
+		assert.Contains(t, tool.Tool.InputSchema.Properties, "detail")
+		assert.Contains(t, tool.Tool.InputSchema.Properties, "section")
+		assert.Contains(t, tool.Tool.InputSchema.Properties, "byte_offset")
+	})
+
+	t.Run("summary", func(t *testing.T) {
+		content := "---\nsubcategory: \"EC2\"\npage_title: \"AWS: aws_instance\"\ndescription: |-\n  Provides an EC2 instance resource.\n---\n\n# Resource: aws_instance\n\n## Example Usage\n\n```terraform\nresource \"aws_instance\" \"web\" {\n  * `not_an_argument` - x\n}\n```\n\n## Argument Reference\n\n* `ami` - (Required) AMI to use.\n* `instance_type` - (Optional) Type.\n\n### `ebs_block_device`\n\n* `device_name` - (Required) Name of the device.\n\n## Attribute Reference\n\n* `arn` - ARN of the instance.\n\n## Import\n\n* `id` - x\n"
+
+		summary := providerDocSummary("8894603", "aws_instance", content)
+		assert.Contains(t, summary, "# aws_instance\n\nProvides an EC2 instance resource.")
+		assert.Contains(t, summary, "**Argument Reference**: ami (required), instance_type\n")
+		assert.Contains(t, summary, "**ebs_block_device**: device_name (required)\n")
+		assert.Contains(t, summary, "**Attribute Reference**: arn\n")
+		assert.Contains(t, summary, "provider_doc_id '8894603' and detail 'full'")
+		assert.NotContains(t, summary, "not_an_argument")
+		assert.NotContains(t, summary, "`id`")
+		assert.Less(t, len(summary), len(content))
+	})
+
+	t.Run("guides are returned in full", func(t *testing.T) {
+		content := "# Authentication\n\nThe provider reads credentials from the environment.\n\n## Environment variables\n\nSet `AWS_PROFILE`.\n"
+
+		assert.Equal(t, content, providerDocSummary("1", "Authentication", content))
+	})
+
+	t.Run("description from the first paragraph", func(t *testing.T) {
+		description, _ := docDescription("# Guide\n\n-> **Note** Read this.\n\nThis guide explains\nthe provider.\n\nMore.")
+
+		assert.Equal(t, "This guide explains the provider.", description)
+	})
+}
@@ -0,0 +1,295 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// TerraformBinaryEnv sets the Terraform CLI used to extract provider schemas, "terraform" on the PATH by default
+	TerraformBinaryEnv = "MCP_TERRAFORM_BINARY"
+	// ProviderSchemaCacheDirEnv sets the directory where provider plugins and extracted schemas are cached
+	ProviderSchemaCacheDirEnv = "MCP_PROVIDER_SCHEMA_CACHE_DIR"
+
+	// providerSchemaTimeout bounds the provider download and the schema extraction
+	providerSchemaTimeout = 5 * time.Minute
+)
+
+// schemaTypes maps the schema_type parameter to the keys of the `terraform providers schema -json` output
+var schemaTypes = map[string]string{
+	"resource":           "resource_schemas",
+	"data-source":        "data_source_schemas",
+	"ephemeral-resource": "ephemeral_resource_schemas",
+	"function":           "functions",
+}
+
+// providerSchemaLocks serializes the extraction of a provider version, so concurrent calls reuse
+// the first download
+var providerSchemaLocks sync.Map // map[string]*sync.Mutex
+
+// ProviderSchema is the schema of a resource, data source, function or provider configuration
+type ProviderSchema struct {
+	Provider   string          `json:"provider"`
+	Version    string          `json:"version"`
+	SchemaType string          `json:"schema_type"`
+	TypeName   string          `json:"type_name,omitempty"`
+	Schema     json.RawMessage `json:"schema"`
+}
+
+// GetProviderSchema creates a tool to get the machine-readable schema of a provider resource or data source.
+func GetProviderSchema(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("get_provider_schema",
+			mcp.WithDescription(`Returns the exact schema of a resource, data source, ephemeral resource, function or the provider configuration of a Terraform provider version, as reported by 'terraform providers schema -json': attribute types, required/optional/computed flags, nested blocks and their nesting modes. Use it when generating configuration that must match the provider exactly; the provider docs are better for explanations and examples.
+The provider is downloaded and its schema extracted with the Terraform CLI on the first call for a version, which can take a while for large providers; later calls use a cache.`),
+			mcp.WithTitleAnnotation("Get the schema of a Terraform provider resource or data source"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("namespace",
+				mcp.Required(),
+				mcp.Description("The namespace of the Terraform provider, e.g., 'hashicorp'")),
+			mcp.WithString("name",
+				mcp.Required(),
+				mcp.Description("The name of the Terraform provider, e.g., 'aws', 'azurerm', 'google', etc.")),
+			mcp.WithString("version",
+				mcp.Description("The version of the provider (defaults to 'latest')")),
+			mcp.WithString("schema_type",
+				mcp.Description("The kind of schema to return, 'provider' returns the provider configuration schema"),
+				mcp.Enum("resource", "data-source", "ephemeral-resource", "function", "provider"),
+				mcp.DefaultString("resource")),
+			mcp.WithString("type_name",
+				mcp.Description("The resource, data source or function name, e.g., 'aws_instance'. Required unless schema_type is 'provider'; when it is not found, the available names are listed")),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return getProviderSchemaHandler(ctx, request, logger)
+		},
+	}
+}
+
+func getProviderSchemaHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	namespace, err := request.RequireString("namespace")
+	if err != nil {
+		return ToolError(logger, "missing required input: namespace", err)
+	}
+	namespace = strings.ToLower(strings.TrimSpace(namespace))
+
+	name, err := request.RequireString("name")
+	if err != nil {
+		return ToolError(logger, "missing required input: name", err)
+	}
+	name = strings.ToLower(strings.TrimSpace(name))
+
+	schemaType := request.GetString("schema_type", "resource")
+	typeName := strings.TrimSpace(request.GetString("type_name", ""))
+	if schemaType != "provider" {
+		if _, ok := schemaTypes[schemaType]; !ok {
+			return ToolErrorf(logger, "invalid schema_type: %s - must be 'resource', 'data-source', 'ephemeral-resource', 'function' or 'provider'", schemaType)
+		}
+		if typeName == "" {
+			return ToolErrorf(logger, "type_name is required for schema_type '%s'", schemaType)
+		}
+	}
+
+	version := request.GetString("version", "latest")
+	if version == "latest" || !utils.IsValidProviderVersionFormat(version) {
+		httpClient, err := client.GetHttpClientFromContext(ctx, logger)
+		if err != nil {
+			return ToolError(logger, "failed to get http client for public Terraform registry", err)
+		}
+		latestVersion, err := client.GetLatestProviderVersion(ctx, httpClient, namespace, name, logger)
+		if err != nil {
+			return ToolErrorf(logger, "provider not found: %s/%s - verify the namespace and provider name are correct", namespace, name)
+		}
+		version = latestVersion
+	}
+
+	schemas, err := providerSchemas(ctx, namespace, name, version, logger)
+	if err != nil {
+		return ToolErrorf(logger, "failed to extract the schema of provider %s/%s %s: %v", namespace, name, version, err)
+	}
+
+	schema, err := selectProviderSchema(schemas, namespace, name, schemaType, typeName)
+	if err != nil {
+		return ToolError(logger, err.Error(), nil)
+	}
+
+	buf, err := json.Marshal(&ProviderSchema{
+		Provider:   namespace + "/" + name,
+		Version:    version,
+		SchemaType: schemaType,
+		TypeName:   typeName,
+		Schema:     schema,
+	})
+	if err != nil {
+		return ToolError(logger, "failed to marshal provider schema", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+// providerSchemas returns the `terraform providers schema -json` output for a provider version,
+// extracting it with the Terraform CLI unless it is cached
+func providerSchemas(ctx context.Context, namespace, name, version string, logger *log.Logger) ([]byte, error) {
+	cacheDir, err := providerSchemaCacheDir()
+	if err != nil {
+		return nil, err
+	}
+	cacheFile := filepath.Join(cacheDir, "schemas", namespace, name, version+".json")
+
+	lock, _ := providerSchemaLocks.LoadOrStore(cacheFile, &sync.Mutex{})
+	lock.(*sync.Mutex).Lock()
+	defer lock.(*sync.Mutex).Unlock()
+
+	schemas, err := os.ReadFile(cacheFile)
+	client.RecordCacheLookup(client.CacheProviderSchema, err == nil)
+	if err == nil {
+		logger.Debugf("Using cached schema of provider %s/%s %s", namespace, name, version)
+		return schemas, nil
+	}
+
+	terraformBinary, err := exec.LookPath(utils.GetEnv(TerraformBinaryEnv, "terraform"))
+	if err != nil {
+		return nil, fmt.Errorf("the Terraform CLI is required to extract provider schemas and was not found, install it or set %s (use get_provider_docs instead): %w", TerraformBinaryEnv, err)
+	}
+
+	workDir, err := os.MkdirTemp("", "terraform-mcp-schema-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(workDir)
+
+	if err := os.WriteFile(filepath.Join(workDir, "main.tf"), []byte(providerSchemaConfig(namespace, name, version)), 0o600); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, providerSchemaTimeout)
+	defer cancel()
+
+	// Provider plugins are shared between versions and calls through the plugin cache
+	pluginCacheDir := filepath.Join(cacheDir, "plugins")
+	if err := os.MkdirAll(pluginCacheDir, 0o700); err != nil {
+		return nil, err
+	}
+	env := append(os.Environ(), "TF_PLUGIN_CACHE_DIR="+pluginCacheDir, "TF_IN_AUTOMATION=1", "CHECKPOINT_DISABLE=1")
+
+	logger.Infof("Extracting the schema of provider %s/%s %s", namespace, name, version)
+	if _, err := runTerraform(ctx, terraformBinary, workDir, env, "init", "-backend=false", "-input=false", "-no-color"); err != nil {
+		return nil, err
+	}
+	schemas, err = runTerraform(ctx, terraformBinary, workDir, env, "providers", "schema", "-json")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(cacheFile), 0o700); err != nil {
+		logger.Warnf("Failed to cache the schema of provider %s/%s %s: %v", namespace, name, version, err)
+	} else if err := os.WriteFile(cacheFile, schemas, 0o600); err != nil {
+		logger.Warnf("Failed to cache the schema of provider %s/%s %s: %v", namespace, name, version, err)
+	}
+	return schemas, nil
+}
+
+func runTerraform(ctx context.Context, terraformBinary, dir string, env []string, args ...string) ([]byte, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, terraformBinary, args...)
+	cmd.Dir = dir
+	cmd.Env = env
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("terraform %s failed: %w: %s", strings.Join(args, " "), err, strings.TrimSpace(stderr.String()))
+	}
+	return stdout.Bytes(), nil
+}
+
+// providerSchemaCacheDir returns the directory where provider plugins and schemas are cached
+func providerSchemaCacheDir() (string, error) {
+	if dir := os.Getenv(ProviderSchemaCacheDirEnv); dir != "" {
+		return dir, nil
+	}
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		dir = os.TempDir()
+	}
+	return filepath.Join(dir, "terraform-mcp-server", "provider-schemas"), nil
+}
+
+// providerSchemaConfig returns a configuration that only requires the provider version
+func providerSchemaConfig(namespace, name, version string) string {
+	return fmt.Sprintf(`terraform {
+  required_providers {
+    %s = {
+      source  = %q
+      version = %q
+    }
+  }
+}
+`, name, namespace+"/"+name, "= "+version)
+}
+
+// selectProviderSchema picks the schema of the provider configuration or of a type name out of the
+// `terraform providers schema -json` output
+func selectProviderSchema(schemas []byte, namespace, name, schemaType, typeName string) (json.RawMessage, error) {
+	var output struct {
+		ProviderSchemas map[string]map[string]json.RawMessage `json:"provider_schemas"`
+	}
+	if err := json.Unmarshal(schemas, &output); err != nil {
+		return nil, fmt.Errorf("failed to parse provider schemas: %w", err)
+	}
+
+	// The provider is keyed by its full source address, e.g. registry.terraform.io/hashicorp/aws
+	var provider map[string]json.RawMessage
+	for source, p := range output.ProviderSchemas {
+		if strings.HasSuffix(strings.ToLower(source), "/"+namespace+"/"+name) {
+			provider = p
+			break
+		}
+	}
+	if provider == nil {
+		return nil, fmt.Errorf("no schema found for provider %s/%s", namespace, name)
+	}
+
+	if schemaType == "provider" {
+		return provider["provider"], nil
+	}
+
+	var named map[string]json.RawMessage
+	if raw, ok := provider[schemaTypes[schemaType]]; ok {
+		if err := json.Unmarshal(raw, &named); err != nil {
+			return nil, fmt.Errorf("failed to parse %s schemas: %w", schemaType, err)
+		}
+	}
+	if schema, ok := named[typeName]; ok {
+		return schema, nil
+	}
+
+	names := make([]string, 0, len(named))
+	for n := range named {
+		if strings.Contains(n, typeName) {
+			names = append(names, n)
+		}
+	}
+	sort.Strings(names)
+	if len(names) == 0 {
+		return nil, fmt.Errorf("%s '%s' not found in provider %s/%s, it has %d %s schemas", schemaType, typeName, namespace, name, len(named), schemaType)
+	}
+	return nil, fmt.Errorf("%s '%s' not found in provider %s/%s, similar names: %s", schemaType, typeName, namespace, name, strings.Join(names, ", "))
+}
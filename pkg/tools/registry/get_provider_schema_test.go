@@ -0,0 +1,67 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testProviderSchemas = `{
+  "format_version": "1.0",
+  "provider_schemas": {
+    "registry.terraform.io/hashicorp/random": {
+      "provider": {"version": 0, "block": {}},
+      "resource_schemas": {
+        "random_id": {"version": 0, "block": {"attributes": {"byte_length": {"type": "number", "required": true}}}},
+        "random_string": {"version": 2, "block": {"attributes": {"length": {"type": "number", "required": true}}}}
+      },
+      "functions": {
+        "random_fn": {"return_type": "string"}
+      }
+    }
+  }
+}`
+
+func TestGetProviderSchema(t *testing.T) {
+	t.Run("tool creation", func(t *testing.T) {
+		tool := GetProviderSchema(log.New())
+
+		assert.Equal(t, "get_provider_schema", tool.Tool.Name)
+		assert.True(t, *tool.Tool.Annotations.ReadOnlyHint)
+		assert.Equal(t, []string{"namespace", "name"}, tool.Tool.InputSchema.Required)
+	})
+
+	t.Run("config", func(t *testing.T) {
+		config := providerSchemaConfig("hashicorp", "random", "3.6.2")
+		assert.Contains(t, config, `source  = "hashicorp/random"`)
+		assert.Contains(t, config, `version = "= 3.6.2"`)
+	})
+
+	t.Run("select schema", func(t *testing.T) {
+		schema, err := selectProviderSchema([]byte(testProviderSchemas), "hashicorp", "random", "resource", "random_id")
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"version": 0, "block": {"attributes": {"byte_length": {"type": "number", "required": true}}}}`, string(schema))
+
+		schema, err = selectProviderSchema([]byte(testProviderSchemas), "hashicorp", "random", "provider", "")
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"version": 0, "block": {}}`, string(schema))
+
+		schema, err = selectProviderSchema([]byte(testProviderSchemas), "hashicorp", "random", "function", "random_fn")
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"return_type": "string"}`, string(schema))
+
+		_, err = selectProviderSchema([]byte(testProviderSchemas), "hashicorp", "random", "resource", "random")
+		assert.ErrorContains(t, err, "similar names: random_id, random_string")
+
+		_, err = selectProviderSchema([]byte(testProviderSchemas), "hashicorp", "random", "data-source", "random_id")
+		assert.ErrorContains(t, err, "it has 0 data-source schemas")
+
+		_, err = selectProviderSchema([]byte(testProviderSchemas), "hashicorp", "aws", "resource", "aws_instance")
+		assert.ErrorContains(t, err, "no schema found for provider hashicorp/aws")
+	})
+}
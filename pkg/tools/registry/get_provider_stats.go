@@ -0,0 +1,194 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// staleProviderReleaseAge is the time without a stable release after which a provider is flagged as
+// possibly unmaintained
+const staleProviderReleaseAge = 365 * 24 * time.Hour
+
+// ProviderStats are the trust signals of a provider in the public registry
+type ProviderStats struct {
+	Provider    string                 `json:"provider"`
+	Tier        string                 `json:"tier"`
+	Owner       string                 `json:"owner,omitempty"`
+	Source      string                 `json:"source,omitempty"`
+	Downloads   int64                  `json:"downloads"`
+	Featured    bool                   `json:"featured"`
+	Unlisted    bool                   `json:"unlisted,omitempty"`
+	Warning     string                 `json:"warning,omitempty"`
+	Description string                 `json:"description,omitempty"`
+	Release     ProviderReleaseCadence `json:"releases"`
+	Signals     []string               `json:"signals,omitempty"`
+}
+
+// ProviderReleaseCadence summarizes the stable releases of a provider
+type ProviderReleaseCadence struct {
+	LatestVersion             string     `json:"latest_version,omitempty"`
+	LatestPublishedAt         *time.Time `json:"latest_published_at,omitempty"`
+	DaysSinceLatestRelease    int        `json:"days_since_latest_release"`
+	FirstPublishedAt          *time.Time `json:"first_published_at,omitempty"`
+	StableVersions            int        `json:"stable_versions"`
+	ReleasesLastYear          int        `json:"releases_last_year"`
+	MedianDaysBetweenReleases float64    `json:"median_days_between_releases"`
+}
+
+// GetProviderStats creates a tool to get the trust signals of a provider from the public registry.
+func GetProviderStats(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("get_provider_stats",
+			mcp.WithDescription(`Returns the trust signals of a Terraform provider from the public registry: its tier (official providers are maintained by HashiCorp, partner providers by a verified technology partner, community providers by individuals or organizations), total downloads, the latest stable release and its date, and the release cadence over the last year.
+Use it when advising on a choice between providers, alongside their documentation.`),
+			mcp.WithTitleAnnotation("Get the registry statistics of a provider"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("namespace",
+				mcp.Required(),
+				mcp.Description("The namespace of the Terraform provider, typically the name of the company, or their GitHub organization name that created the provider e.g., 'hashicorp'")),
+			mcp.WithString("name",
+				mcp.Required(),
+				mcp.Description("The name of the Terraform provider, e.g., 'aws', 'azurerm', 'google', etc.")),
+		),
+		Handler: func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return getProviderStatsHandler(ctx, req, logger)
+		},
+	}
+}
+
+func getProviderStatsHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	namespace, err := request.RequireString("namespace")
+	if err != nil {
+		return ToolError(logger, "missing required input: namespace", err)
+	}
+	namespace = strings.ToLower(strings.TrimSpace(namespace))
+
+	name, err := request.RequireString("name")
+	if err != nil {
+		return ToolError(logger, "missing required input: name", err)
+	}
+	name = strings.ToLower(strings.TrimSpace(name))
+
+	httpClient, err := client.GetHttpClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get http client for public Terraform registry", err)
+	}
+
+	provider, err := client.GetProviderWithVersions(ctx, httpClient, namespace, name, logger)
+	if err != nil {
+		return ToolErrorf(logger, "provider not found: %s/%s - verify the namespace and provider name are correct", namespace, name)
+	}
+
+	buf, err := json.Marshal(providerStats(fmt.Sprintf("%s/%s", namespace, name), provider, time.Now()))
+	if err != nil {
+		return ToolError(logger, "failed to marshal provider stats", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+// providerStats summarizes the registry metadata of a provider and the publication dates of its
+// stable versions
+func providerStats(providerName string, provider *client.ProviderVersionList, now time.Time) *ProviderStats {
+	attributes := provider.Data.Attributes
+	stats := &ProviderStats{
+		Provider:    providerName,
+		Tier:        attributes.Tier,
+		Owner:       attributes.OwnerName,
+		Source:      attributes.Source,
+		Downloads:   attributes.Downloads,
+		Featured:    attributes.Featured,
+		Unlisted:    attributes.Unlisted,
+		Warning:     attributes.Warning,
+		Description: attributes.Description,
+	}
+
+	type release struct {
+		version     string
+		publishedAt time.Time
+	}
+	var releases []release
+	for _, included := range provider.Included {
+		if included.Type != "provider-versions" || included.Attributes.PublishedAt.IsZero() {
+			continue
+		}
+		if !utils.IsVersionInChannel(included.Attributes.Version, utils.ReleaseChannelStable) {
+			continue
+		}
+		releases = append(releases, release{version: included.Attributes.Version, publishedAt: included.Attributes.PublishedAt})
+	}
+	sort.Slice(releases, func(i, j int) bool { return releases[i].publishedAt.Before(releases[j].publishedAt) })
+
+	cadence := &stats.Release
+	cadence.StableVersions = len(releases)
+	if len(releases) > 0 {
+		first, latest := releases[0], releases[len(releases)-1]
+		cadence.FirstPublishedAt = &first.publishedAt
+		cadence.LatestVersion = latest.version
+		cadence.LatestPublishedAt = &latest.publishedAt
+		cadence.DaysSinceLatestRelease = int(now.Sub(latest.publishedAt).Hours() / 24)
+	}
+
+	yearAgo := now.AddDate(-1, 0, 0)
+	var intervals []float64
+	for i, r := range releases {
+		if r.publishedAt.After(yearAgo) {
+			cadence.ReleasesLastYear++
+			if i > 0 {
+				intervals = append(intervals, r.publishedAt.Sub(releases[i-1].publishedAt).Hours()/24)
+			}
+		}
+	}
+	if len(intervals) > 0 {
+		sort.Float64s(intervals)
+		median := intervals[len(intervals)/2]
+		if len(intervals)%2 == 0 {
+			median = (intervals[len(intervals)/2-1] + intervals[len(intervals)/2]) / 2
+		}
+		cadence.MedianDaysBetweenReleases = math.Round(median*10) / 10
+	}
+
+	stats.Signals = providerSignals(stats, now)
+	return stats
+}
+
+// providerSignals explains the statistics of a provider that matter when choosing it
+func providerSignals(stats *ProviderStats, now time.Time) []string {
+	var signals []string
+	switch stats.Tier {
+	case "official":
+		signals = append(signals, "Official provider, owned and maintained by HashiCorp.")
+	case "partner":
+		signals = append(signals, fmt.Sprintf("Partner provider, maintained by %s, a verified HashiCorp technology partner.", stats.Owner))
+	case "community":
+		signals = append(signals, "Community provider, published by an individual or organization that HashiCorp hasn't verified. Check its source repository and maintainers.")
+	}
+	if stats.Warning != "" {
+		signals = append(signals, fmt.Sprintf("The registry warns: %s", stats.Warning))
+	}
+	if stats.Unlisted {
+		signals = append(signals, "The provider is unlisted from the registry search.")
+	}
+	switch latest := stats.Release.LatestPublishedAt; {
+	case latest == nil:
+		signals = append(signals, "The provider has no stable release.")
+	case now.Sub(*latest) > staleProviderReleaseAge:
+		signals = append(signals, fmt.Sprintf("No stable release for %d days, the provider may be unmaintained.", stats.Release.DaysSinceLatestRelease))
+	}
+	return signals
+}
@@ -0,0 +1,53 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProviderStats(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	response := `{
+		"data": {"type": "providers", "id": "323", "attributes": {"tier": "partner", "owner-name": "Acme", "downloads": 123456, "source": "https://github.com/acme/terraform-provider-acme"}},
+		"included": [
+			{"type": "provider-versions", "id": "1", "attributes": {"version": "1.0.0", "published-at": "2023-01-01T00:00:00Z"}},
+			{"type": "provider-versions", "id": "2", "attributes": {"version": "1.1.0", "published-at": "2024-07-01T00:00:00Z"}},
+			{"type": "provider-versions", "id": "3", "attributes": {"version": "1.2.0", "published-at": "2024-09-01T00:00:00Z"}},
+			{"type": "provider-versions", "id": "4", "attributes": {"version": "2.0.0-beta1", "published-at": "2025-04-01T00:00:00Z"}},
+			{"type": "provider-versions", "id": "5", "attributes": {"version": "1.3.0", "published-at": "2025-01-01T00:00:00Z"}}
+		]
+	}`
+	var provider client.ProviderVersionList
+	require.NoError(t, json.Unmarshal([]byte(response), &provider))
+
+	stats := providerStats("acme/acme", &provider, now)
+	assert.Equal(t, "partner", stats.Tier)
+	assert.Equal(t, int64(123456), stats.Downloads)
+	assert.Equal(t, 4, stats.Release.StableVersions)
+	assert.Equal(t, "1.3.0", stats.Release.LatestVersion, "pre-releases are not counted")
+	assert.Equal(t, 151, stats.Release.DaysSinceLatestRelease)
+	assert.Equal(t, 3, stats.Release.ReleasesLastYear)
+	assert.Equal(t, 122.0, stats.Release.MedianDaysBetweenReleases)
+	require.NotEmpty(t, stats.Signals)
+	assert.Contains(t, stats.Signals[0], "Acme")
+
+	t.Run("providers without recent releases", func(t *testing.T) {
+		stale := providerStats("acme/acme", &provider, now.AddDate(2, 0, 0))
+		assert.Zero(t, stale.Release.ReleasesLastYear)
+		assert.Contains(t, stale.Signals[len(stale.Signals)-1], "may be unmaintained")
+	})
+
+	t.Run("providers without stable releases", func(t *testing.T) {
+		empty := providerStats("acme/empty", &client.ProviderVersionList{}, now)
+		assert.Nil(t, empty.Release.LatestPublishedAt)
+		assert.Contains(t, empty.Signals, "The provider has no stable release.")
+	})
+}
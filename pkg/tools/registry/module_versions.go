@@ -0,0 +1,494 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// defaultModuleVersionsLimit is the number of versions list_module_versions returns by default
+const defaultModuleVersionsLimit = 20
+
+// ModuleVersionList is the list of available versions of a module, newest first
+type ModuleVersionList struct {
+	Module     string               `json:"module"`
+	TotalCount int                  `json:"total_count"`
+	Versions   []*ModuleVersionInfo `json:"versions"`
+}
+
+// ModuleVersionInfo is an available version of a module
+type ModuleVersionInfo struct {
+	Version        string `json:"version"`
+	ReleaseChannel string `json:"release_channel"`
+}
+
+// ModuleVersionDiff is the difference of the interface of the root module between two module versions
+type ModuleVersionDiff struct {
+	Module               string                       `json:"module"`
+	FromVersion          string                       `json:"from_version"`
+	ToVersion            string                       `json:"to_version"`
+	BreakingChanges      []string                     `json:"breaking_changes"`
+	Inputs               *ModuleInputsDiff            `json:"inputs"`
+	Outputs              *NameDiff                    `json:"outputs"`
+	Resources            *NameDiff                    `json:"resources"`
+	ProviderRequirements []*ProviderRequirementChange `json:"provider_requirements,omitempty"`
+	Submodules           *NameDiff                    `json:"submodules"`
+}
+
+// ModuleInputsDiff lists the input variables added, removed, likely renamed and changed between two versions
+type ModuleInputsDiff struct {
+	Added     []*ModuleInputSummary `json:"added"`
+	Removed   []string              `json:"removed"`
+	Renamed   []Renamed             `json:"possibly_renamed,omitempty"`
+	Changed   []*ModuleInputChange  `json:"changed"`
+	Unchanged int                   `json:"unchanged"`
+}
+
+// ModuleInputSummary is an input variable added in the newer version
+type ModuleInputSummary struct {
+	Name     string `json:"name"`
+	Type     string `json:"type,omitempty"`
+	Required bool   `json:"required"`
+}
+
+// ModuleInputChange is an input variable whose type, default or requiredness changed
+type ModuleInputChange struct {
+	Name         string `json:"name"`
+	FromType     string `json:"from_type,omitempty"`
+	ToType       string `json:"to_type,omitempty"`
+	FromDefault  any    `json:"from_default,omitempty"`
+	ToDefault    any    `json:"to_default,omitempty"`
+	FromRequired bool   `json:"from_required"`
+	ToRequired   bool   `json:"to_required"`
+}
+
+// ProviderRequirementChange is a provider whose version constraint changed, was added or was removed
+type ProviderRequirementChange struct {
+	Provider    string `json:"provider"`
+	FromVersion string `json:"from_version,omitempty"`
+	ToVersion   string `json:"to_version,omitempty"`
+}
+
+// ListModuleVersions creates a tool to list the available versions of a module from the public registry.
+func ListModuleVersions(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("list_module_versions",
+			mcp.WithDescription("Lists the available versions of a Terraform module from the public registry, newest first, with their release channel. By default only stable releases are listed; set release_channel to include pre-releases."),
+			mcp.WithTitleAnnotation("List Module Versions"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			withModuleAddress(),
+			withReleaseChannel(),
+			mcp.WithNumber("limit",
+				mcp.Description("The maximum number of versions to return, newest first"),
+				mcp.DefaultNumber(defaultModuleVersionsLimit),
+				mcp.Min(1),
+			),
+		),
+		Handler: func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return listModuleVersionsHandler(ctx, req, logger)
+		},
+	}
+}
+
+// GetModuleVersionDiff creates a tool to compare the interface of two versions of a module.
+func GetModuleVersionDiff(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("get_module_version_diff",
+			mcp.WithDescription(`Compares two versions of a Terraform module from the public registry to assess the impact of an upgrade. Returns the input variables, outputs, resources, provider requirements and submodules of the root module that were added, removed, likely renamed or changed, and a list of the breaking changes: removed or renamed inputs and outputs, new required inputs, input type changes, removed resources and changed provider requirements.`),
+			mcp.WithTitleAnnotation("Compare two Terraform module versions"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			withModuleAddress(),
+			mcp.WithString("from_version",
+				mcp.Required(),
+				mcp.Description("The module version to compare from, e.g., '3.19.0'")),
+			mcp.WithString("to_version",
+				mcp.Description("The module version to compare to (defaults to 'latest')")),
+		),
+		Handler: func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return getModuleVersionDiffHandler(ctx, req, logger)
+		},
+	}
+}
+
+// withModuleAddress adds the module_publisher, module_name and module_provider parameters
+func withModuleAddress() mcp.ToolOption {
+	return func(t *mcp.Tool) {
+		mcp.WithString("module_publisher",
+			mcp.Required(),
+			mcp.Description("The publisher of the module, e.g., 'hashicorp', 'aws-ia', 'terraform-aws-modules', 'Azure' etc."))(t)
+		mcp.WithString("module_name",
+			mcp.Required(),
+			mcp.Description("The name of the module, e.g., 'vpc', 'security-group' etc."))(t)
+		mcp.WithString("module_provider",
+			mcp.Required(),
+			mcp.Description("The name of the Terraform provider for the module, e.g., 'aws', 'google', 'azurerm' etc."))(t)
+	}
+}
+
+// moduleAddressParams reads the module address parameters as a publisher/name/provider path
+func moduleAddressParams(request mcp.CallToolRequest) (string, error) {
+	var parts []string
+	for _, param := range []string{"module_publisher", "module_name", "module_provider"} {
+		value, err := request.RequireString(param)
+		if err != nil {
+			return "", fmt.Errorf("missing required input: %s", param)
+		}
+		value = strings.ToLower(strings.TrimSpace(value))
+		if value == "" || strings.Contains(value, "/") {
+			return "", fmt.Errorf("invalid %s %q", param, value)
+		}
+		parts = append(parts, value)
+	}
+	return strings.Join(parts, "/"), nil
+}
+
+func listModuleVersionsHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	module, err := moduleAddressParams(request)
+	if err != nil {
+		return ToolError(logger, "invalid input", err)
+	}
+
+	channel, err := releaseChannelParam(request)
+	if err != nil {
+		return ToolError(logger, "invalid input", err)
+	}
+
+	limit := request.GetInt("limit", defaultModuleVersionsLimit)
+	if limit < 1 {
+		return ToolErrorf(logger, "limit must be at least 1, got %d", limit)
+	}
+
+	httpClient, err := client.GetHttpClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get http client for public Terraform registry", err)
+	}
+
+	details, err := fetchModuleVersion(ctx, httpClient, module, "", logger)
+	if err != nil {
+		return ToolErrorf(logger, "module not found: %s - use search_modules first to find valid modules", module)
+	}
+
+	result := moduleVersionList(module, details.Versions, channel, limit)
+	if result.TotalCount == 0 {
+		return ToolErrorf(logger, "no %s release found for module %s", channel, module)
+	}
+	buf, err := json.Marshal(result)
+	if err != nil {
+		return ToolError(logger, "failed to marshal module versions", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+func getModuleVersionDiffHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	module, err := moduleAddressParams(request)
+	if err != nil {
+		return ToolError(logger, "invalid input", err)
+	}
+
+	fromVersion, err := request.RequireString("from_version")
+	if err != nil {
+		return ToolError(logger, "missing required input: from_version", err)
+	}
+	fromVersion = strings.TrimPrefix(strings.TrimSpace(fromVersion), "v")
+	if _, err := version.NewVersion(fromVersion); err != nil {
+		return ToolErrorf(logger, "invalid from_version %q - expected a version such as '3.19.0'", fromVersion)
+	}
+
+	toVersion := strings.TrimPrefix(strings.TrimSpace(request.GetString("to_version", "latest")), "v")
+	if toVersion == "latest" {
+		toVersion = ""
+	} else if _, err := version.NewVersion(toVersion); err != nil {
+		return ToolErrorf(logger, "invalid to_version %q - expected a version such as '5.8.1' or 'latest'", toVersion)
+	}
+
+	httpClient, err := client.GetHttpClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get http client for public Terraform registry", err)
+	}
+
+	from, err := fetchModuleVersion(ctx, httpClient, module, fromVersion, logger)
+	if err != nil {
+		return ToolErrorf(logger, "module version not found: %s/%s - use list_module_versions to find valid versions", module, fromVersion)
+	}
+	to, err := fetchModuleVersion(ctx, httpClient, module, toVersion, logger)
+	if err != nil {
+		return ToolErrorf(logger, "module version not found: %s/%s - use list_module_versions to find valid versions", module, toVersion)
+	}
+
+	buf, err := json.Marshal(diffModuleVersions(module, from, to))
+	if err != nil {
+		return ToolError(logger, "failed to marshal module version diff", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+// fetchModuleVersion reads the details of a module version, or of its latest version when version is empty
+func fetchModuleVersion(ctx context.Context, httpClient *http.Client, module, version string, logger *log.Logger) (*client.TerraformModuleVersionDetails, error) {
+	uri := fmt.Sprintf("modules/%s", module)
+	if version != "" {
+		uri = fmt.Sprintf("%s/%s", uri, version)
+	}
+	response, err := client.SendRegistryCall(ctx, httpClient, http.MethodGet, uri, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	var details client.TerraformModuleVersionDetails
+	if err := json.Unmarshal(response, &details); err != nil {
+		return nil, err
+	}
+	return &details, nil
+}
+
+// moduleVersionList returns up to limit versions of the release channel, newest first.
+// Versions that cannot be parsed are ignored.
+func moduleVersionList(module string, versions []string, channel string, limit int) *ModuleVersionList {
+	var parsed []*version.Version
+	raw := make(map[*version.Version]string)
+	for _, v := range versions {
+		if !utils.IsVersionInChannel(v, channel) {
+			continue
+		}
+		ver, err := version.NewVersion(v)
+		if err != nil {
+			continue
+		}
+		parsed = append(parsed, ver)
+		raw[ver] = v
+	}
+	sort.SliceStable(parsed, func(i, j int) bool {
+		return parsed[i].GreaterThan(parsed[j])
+	})
+
+	result := &ModuleVersionList{
+		Module:     module,
+		TotalCount: len(parsed),
+		Versions:   []*ModuleVersionInfo{},
+	}
+	for _, ver := range parsed {
+		if len(result.Versions) == limit {
+			break
+		}
+		result.Versions = append(result.Versions, &ModuleVersionInfo{
+			Version:        raw[ver],
+			ReleaseChannel: utils.ReleaseChannelOf(raw[ver]),
+		})
+	}
+	return result
+}
+
+// diffModuleVersions compares the root module of two module versions
+func diffModuleVersions(module string, from, to *client.TerraformModuleVersionDetails) *ModuleVersionDiff {
+	diff := &ModuleVersionDiff{
+		Module:          module,
+		FromVersion:     from.Version,
+		ToVersion:       to.Version,
+		BreakingChanges: []string{},
+		Inputs:          diffModuleInputs(from.Root.Inputs, to.Root.Inputs),
+		Outputs:         diffNames(moduleOutputNames(from.Root.Outputs), moduleOutputNames(to.Root.Outputs)),
+		Resources:       diffNames(moduleResourceAddresses(from.Root.Resources), moduleResourceAddresses(to.Root.Resources)),
+		Submodules:      diffNames(submodulePaths(from.Submodules), submodulePaths(to.Submodules)),
+	}
+	// Resources are compared by address, a similar address is a different resource
+	diff.Resources.Removed = append(diff.Resources.Removed, renamedFrom(diff.Resources.Renamed)...)
+	diff.Resources.Added = append(diff.Resources.Added, renamedTo(diff.Resources.Renamed)...)
+	sort.Strings(diff.Resources.Removed)
+	sort.Strings(diff.Resources.Added)
+	diff.Resources.Renamed = nil
+
+	diff.ProviderRequirements = diffProviderRequirements(from.Root.ProviderDependencies, to.Root.ProviderDependencies)
+
+	for _, name := range diff.Inputs.Removed {
+		diff.BreakingChanges = append(diff.BreakingChanges, fmt.Sprintf("input %q was removed", name))
+	}
+	for _, renamed := range diff.Inputs.Renamed {
+		diff.BreakingChanges = append(diff.BreakingChanges, fmt.Sprintf("input %q was removed, possibly renamed to %q", renamed.From, renamed.To))
+	}
+	for _, input := range diff.Inputs.Added {
+		if input.Required {
+			diff.BreakingChanges = append(diff.BreakingChanges, fmt.Sprintf("new input %q is required", input.Name))
+		}
+	}
+	for _, change := range diff.Inputs.Changed {
+		if change.ToRequired && !change.FromRequired {
+			diff.BreakingChanges = append(diff.BreakingChanges, fmt.Sprintf("input %q is now required", change.Name))
+		}
+		if change.FromType != change.ToType {
+			diff.BreakingChanges = append(diff.BreakingChanges, fmt.Sprintf("the type of input %q changed from %s to %s", change.Name, typeOrAny(change.FromType), typeOrAny(change.ToType)))
+		}
+	}
+	for _, name := range diff.Outputs.Removed {
+		diff.BreakingChanges = append(diff.BreakingChanges, fmt.Sprintf("output %q was removed", name))
+	}
+	for _, renamed := range diff.Outputs.Renamed {
+		diff.BreakingChanges = append(diff.BreakingChanges, fmt.Sprintf("output %q was removed, possibly renamed to %q", renamed.From, renamed.To))
+	}
+	for _, address := range diff.Resources.Removed {
+		diff.BreakingChanges = append(diff.BreakingChanges, fmt.Sprintf("resource %s was removed, it is destroyed unless the new version moves it", address))
+	}
+	for _, change := range diff.ProviderRequirements {
+		if change.FromVersion != "" && change.ToVersion != "" {
+			diff.BreakingChanges = append(diff.BreakingChanges, fmt.Sprintf("provider %s requirement changed from %q to %q", change.Provider, change.FromVersion, change.ToVersion))
+		}
+	}
+	return diff
+}
+
+// diffModuleInputs compares the input variables of two module versions by name, then the
+// type, default and requiredness of the inputs in both
+func diffModuleInputs(from, to []client.ModuleInput) *ModuleInputsDiff {
+	fromInputs := make(map[string]client.ModuleInput, len(from))
+	for _, input := range from {
+		fromInputs[input.Name] = input
+	}
+	toInputs := make(map[string]client.ModuleInput, len(to))
+	for _, input := range to {
+		toInputs[input.Name] = input
+	}
+
+	names := diffNames(moduleInputNames(from), moduleInputNames(to))
+	diff := &ModuleInputsDiff{
+		Added:   []*ModuleInputSummary{},
+		Removed: names.Removed,
+		Renamed: names.Renamed,
+		Changed: []*ModuleInputChange{},
+	}
+	for _, name := range names.Added {
+		input := toInputs[name]
+		diff.Added = append(diff.Added, &ModuleInputSummary{Name: name, Type: input.Type, Required: input.Required})
+	}
+
+	var common []string
+	for name := range fromInputs {
+		if _, ok := toInputs[name]; ok {
+			common = append(common, name)
+		}
+	}
+	sort.Strings(common)
+	for _, name := range common {
+		fromInput, toInput := fromInputs[name], toInputs[name]
+		if fromInput.Type == toInput.Type && fromInput.Required == toInput.Required && reflect.DeepEqual(fromInput.Default, toInput.Default) {
+			diff.Unchanged++
+			continue
+		}
+		change := &ModuleInputChange{
+			Name:         name,
+			FromType:     fromInput.Type,
+			ToType:       toInput.Type,
+			FromRequired: fromInput.Required,
+			ToRequired:   toInput.Required,
+		}
+		if !reflect.DeepEqual(fromInput.Default, toInput.Default) {
+			change.FromDefault = fromInput.Default
+			change.ToDefault = toInput.Default
+		}
+		diff.Changed = append(diff.Changed, change)
+	}
+	return diff
+}
+
+// diffProviderRequirements compares the provider version constraints of two module versions
+func diffProviderRequirements(from, to []client.ModuleProviderDependency) []*ProviderRequirementChange {
+	constraints := func(dependencies []client.ModuleProviderDependency) map[string]string {
+		byProvider := make(map[string]string, len(dependencies))
+		for _, dependency := range dependencies {
+			provider := dependency.Source
+			if provider == "" {
+				provider = dependency.Name
+			}
+			byProvider[provider] = dependency.Version
+		}
+		return byProvider
+	}
+	fromConstraints, toConstraints := constraints(from), constraints(to)
+
+	var changes []*ProviderRequirementChange
+	for provider, fromVersion := range fromConstraints {
+		if toVersion, ok := toConstraints[provider]; !ok || toVersion != fromVersion {
+			changes = append(changes, &ProviderRequirementChange{Provider: provider, FromVersion: fromVersion, ToVersion: toVersion})
+		}
+	}
+	for provider, toVersion := range toConstraints {
+		if _, ok := fromConstraints[provider]; !ok {
+			changes = append(changes, &ProviderRequirementChange{Provider: provider, ToVersion: toVersion})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Provider < changes[j].Provider
+	})
+	return changes
+}
+
+func moduleInputNames(inputs []client.ModuleInput) []string {
+	names := make([]string, 0, len(inputs))
+	for _, input := range inputs {
+		names = append(names, input.Name)
+	}
+	return names
+}
+
+func moduleOutputNames(outputs []client.ModuleOutput) []string {
+	names := make([]string, 0, len(outputs))
+	for _, output := range outputs {
+		names = append(names, output.Name)
+	}
+	return names
+}
+
+func moduleResourceAddresses(resources []client.ModuleResource) []string {
+	addresses := make([]string, 0, len(resources))
+	for _, resource := range resources {
+		addresses = append(addresses, fmt.Sprintf("%s.%s", resource.Type, resource.Name))
+	}
+	return addresses
+}
+
+func submodulePaths(submodules []client.ModulePart) []string {
+	paths := make([]string, 0, len(submodules))
+	for _, submodule := range submodules {
+		paths = append(paths, submodule.Path)
+	}
+	return paths
+}
+
+func renamedFrom(renames []Renamed) []string {
+	names := make([]string, 0, len(renames))
+	for _, renamed := range renames {
+		names = append(names, renamed.From)
+	}
+	return names
+}
+
+func renamedTo(renames []Renamed) []string {
+	names := make([]string, 0, len(renames))
+	for _, renamed := range renames {
+		names = append(names, renamed.To)
+	}
+	return names
+}
+
+// typeOrAny returns the type of an input variable, which is any when it is not declared
+func typeOrAny(typ string) string {
+	if typ == "" {
+		return "any"
+	}
+	return typ
+}
@@ -0,0 +1,103 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestModuleVersionList(t *testing.T) {
+	list := moduleVersionList("terraform-aws-modules/vpc/aws", []string{"3.19.0", "5.8.1", "4.0.2", "6.0.0-rc1", "5.10.0"}, "stable", 3)
+	assert.Equal(t, 4, list.TotalCount)
+	assert.Equal(t, []*ModuleVersionInfo{
+		{Version: "5.10.0", ReleaseChannel: "stable"},
+		{Version: "5.8.1", ReleaseChannel: "stable"},
+		{Version: "4.0.2", ReleaseChannel: "stable"},
+	}, list.Versions)
+
+	list = moduleVersionList("terraform-aws-modules/vpc/aws", []string{"5.8.1", "6.0.0-rc1"}, "rc", 20)
+	assert.Equal(t, []*ModuleVersionInfo{{Version: "6.0.0-rc1", ReleaseChannel: "rc"}}, list.Versions)
+}
+
+func TestDiffModuleVersions(t *testing.T) {
+	from := &client.TerraformModuleVersionDetails{
+		Version: "3.19.0",
+		Root: client.ModulePart{
+			Inputs: []client.ModuleInput{
+				{Name: "name", Type: "string", Default: ""},
+				{Name: "cidr", Type: "string", Default: "0.0.0.0/0"},
+				{Name: "enable_classiclink", Type: "bool"},
+				{Name: "azs", Type: "list(string)", Default: []any{}},
+				{Name: "tags", Type: "map(string)", Default: map[string]any{}},
+			},
+			Outputs: []client.ModuleOutput{{Name: "vpc_id"}, {Name: "vpc_classiclink_id"}},
+			Resources: []client.ModuleResource{
+				{Type: "aws_vpc", Name: "this"},
+				{Type: "aws_eip", Name: "nat"},
+			},
+			ProviderDependencies: []client.ModuleProviderDependency{
+				{Name: "aws", Namespace: "hashicorp", Source: "hashicorp/aws", Version: ">= 3.73"},
+			},
+		},
+	}
+	to := &client.TerraformModuleVersionDetails{
+		Version: "5.8.1",
+		Root: client.ModulePart{
+			Inputs: []client.ModuleInput{
+				{Name: "name", Type: "string", Default: ""},
+				{Name: "cidr", Type: "string", Default: "10.0.0.0/16"},
+				{Name: "azs", Type: "list(string)", Required: true},
+				{Name: "tags", Type: "map(string)", Default: map[string]any{}},
+				{Name: "ipv6_cidr", Type: "string"},
+				{Name: "private_subnet_names", Type: "list(string)", Required: true},
+			},
+			Outputs: []client.ModuleOutput{{Name: "vpc_id"}, {Name: "vpc_arn"}},
+			Resources: []client.ModuleResource{
+				{Type: "aws_vpc", Name: "this"},
+				{Type: "aws_eip", Name: "nat_gateway"},
+			},
+			ProviderDependencies: []client.ModuleProviderDependency{
+				{Name: "aws", Namespace: "hashicorp", Source: "hashicorp/aws", Version: ">= 5.30"},
+			},
+		},
+		Submodules: []client.ModulePart{{Path: "modules/vpc-endpoints"}},
+	}
+
+	diff := diffModuleVersions("terraform-aws-modules/vpc/aws", from, to)
+
+	assert.Equal(t, "3.19.0", diff.FromVersion)
+	assert.Equal(t, "5.8.1", diff.ToVersion)
+	assert.Equal(t, []*ModuleInputSummary{
+		{Name: "ipv6_cidr", Type: "string"},
+		{Name: "private_subnet_names", Type: "list(string)", Required: true},
+	}, diff.Inputs.Added)
+	assert.Equal(t, []string{"enable_classiclink"}, diff.Inputs.Removed)
+	assert.Equal(t, []*ModuleInputChange{
+		{Name: "azs", FromType: "list(string)", ToType: "list(string)", FromDefault: []any{}, ToRequired: true},
+		{Name: "cidr", FromType: "string", ToType: "string", FromDefault: "0.0.0.0/0", ToDefault: "10.0.0.0/16"},
+	}, diff.Inputs.Changed)
+	assert.Equal(t, 2, diff.Inputs.Unchanged)
+
+	assert.Equal(t, []string{"vpc_arn"}, diff.Outputs.Added)
+	assert.Equal(t, []string{"vpc_classiclink_id"}, diff.Outputs.Removed)
+	assert.Equal(t, []string{"aws_eip.nat_gateway"}, diff.Resources.Added)
+	assert.Equal(t, []string{"aws_eip.nat"}, diff.Resources.Removed)
+	assert.Empty(t, diff.Resources.Renamed)
+	assert.Equal(t, []string{"modules/vpc-endpoints"}, diff.Submodules.Added)
+	assert.Equal(t, []*ProviderRequirementChange{
+		{Provider: "hashicorp/aws", FromVersion: ">= 3.73", ToVersion: ">= 5.30"},
+	}, diff.ProviderRequirements)
+
+	assert.Equal(t, []string{
+		`input "enable_classiclink" was removed`,
+		`new input "private_subnet_names" is required`,
+		`input "azs" is now required`,
+		`output "vpc_classiclink_id" was removed`,
+		"resource aws_eip.nat was removed, it is destroyed unless the new version moves it",
+		`provider hashicorp/aws requirement changed from ">= 3.73" to ">= 5.30"`,
+	}, diff.BreakingChanges)
+}
@@ -0,0 +1,247 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	log "github.com/sirupsen/logrus"
+)
+
+// policySourceFile is a file of a policy library: the code of a policy or policy module, or a test case
+type policySourceFile struct {
+	Kind             string
+	Name             string
+	Path             string
+	Content          string
+	ChecksumVerified bool
+}
+
+// policySources are the files of the policies of a policy library version
+type policySources struct {
+	Files []*policySourceFile
+	// Mocks are the paths of the mock data of the test cases, which are not returned
+	Mocks   []string
+	Missing []string
+	Note    string
+}
+
+// fetchPolicySources returns the code and test cases of the selected policies of a policy library
+// version, and the code of its policy modules. The files are read from the source archive of the
+// library, or only the code is read from the registry when the archive can't be.
+func fetchPolicySources(ctx context.Context, httpClient *http.Client, policyID string, details *client.TerraformPolicyDetails, selected map[string]bool, logger *log.Logger) *policySources {
+	var policies, modules []policyFileRef
+	for _, included := range details.Included {
+		ref := policyFileRef{name: included.Attributes.Name, shasum: included.Attributes.Shasum}
+		switch included.Type {
+		case "policies":
+			if len(selected) == 0 || selected[ref.name] {
+				policies = append(policies, ref)
+			}
+		case "policy-modules":
+			modules = append(modules, ref)
+		}
+	}
+
+	files, err := downloadPolicyLibraryFiles(ctx, httpClient, details.Data.Attributes.Source, details.Data.Attributes.Tag)
+	if err != nil {
+		logger.WithError(err).Warn("failed to read the policy library source archive")
+		sources := registryPolicySources(ctx, httpClient, policyID, policies, modules, logger)
+		sources.Note = fmt.Sprintf("The test cases are unavailable, the source of the policy library could not be read: %v", err)
+		return sources
+	}
+	return archivePolicySources(files, policies, modules)
+}
+
+// policyFileRef is a policy or policy module of a policy library version with its published checksum
+type policyFileRef struct {
+	name   string
+	shasum string
+}
+
+func (r policyFileRef) verify(content []byte) bool {
+	sum := sha256.Sum256(content)
+	return r.shasum != "" && strings.EqualFold(hex.EncodeToString(sum[:]), r.shasum)
+}
+
+// downloadPolicyLibraryFiles downloads the source archive of a policy library version and returns its
+// Sentinel, HCL and JSON files by path, relative to the top-level directory of the archive
+func downloadPolicyLibraryFiles(ctx context.Context, httpClient *http.Client, source string, tag string) (map[string][]byte, error) {
+	archiveURL, err := sourceArchiveURL(source, tag)
+	if err != nil {
+		return nil, err
+	}
+	archive, err := openSourceArchive(ctx, httpClient, archiveURL)
+	if err != nil {
+		return nil, err
+	}
+	defer archive.Close()
+	return policyLibraryFiles(io.LimitReader(archive, maxModuleArchiveSize))
+}
+
+func policyLibraryFiles(archive io.Reader) (map[string][]byte, error) {
+	gz, err := gzip.NewReader(archive)
+	if err != nil {
+		return nil, err
+	}
+	defer gz.Close()
+
+	files := make(map[string][]byte)
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		switch path.Ext(header.Name) {
+		case ".sentinel", ".hcl", ".json":
+		default:
+			continue
+		}
+
+		// GitHub archives put the repository in a "<repo>-<ref>" directory
+		name := path.Clean(header.Name)
+		if i := strings.Index(name, "/"); i >= 0 {
+			name = name[i+1:]
+		}
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, err
+		}
+		files[name] = data
+	}
+	return files, nil
+}
+
+// isPolicyTestPath reports whether a file of a policy library belongs to the test cases of a policy,
+// which Sentinel reads from test/<policy>/ directories
+func isPolicyTestPath(name string) bool {
+	return strings.HasPrefix(name, "test/") || strings.Contains(name, "/test/")
+}
+
+// archivePolicySources matches the policies and policy modules of a library version to the files of
+// its source archive, preferring the files whose checksum matches the published one
+func archivePolicySources(files map[string][]byte, policies []policyFileRef, modules []policyFileRef) *policySources {
+	paths := make([]string, 0, len(files))
+	for name := range files {
+		paths = append(paths, name)
+	}
+	sort.Strings(paths)
+
+	sources := &policySources{}
+	findCode := func(kind string, ref policyFileRef) {
+		var match *policySourceFile
+		for _, name := range paths {
+			if isPolicyTestPath(name) || path.Base(name) != ref.name+".sentinel" {
+				continue
+			}
+			file := &policySourceFile{Kind: kind, Name: ref.name, Path: name, Content: string(files[name]), ChecksumVerified: ref.verify(files[name])}
+			if match == nil || (file.ChecksumVerified && !match.ChecksumVerified) {
+				match = file
+			}
+		}
+		if match == nil {
+			sources.Missing = append(sources.Missing, fmt.Sprintf("%s %s", kind, ref.name))
+			return
+		}
+		sources.Files = append(sources.Files, match)
+	}
+
+	for _, ref := range policies {
+		findCode("policy", ref)
+		testDir := "test/" + ref.name + "/"
+		for _, name := range paths {
+			if !strings.HasPrefix(name, testDir) && !strings.Contains(name, "/"+testDir) {
+				continue
+			}
+			// Test cases are the HCL and JSON files of the test directory; the Sentinel files and
+			// subdirectories next to them hold the mock data
+			if ext := path.Ext(name); (ext == ".hcl" || ext == ".json") && strings.HasSuffix(path.Dir(name)+"/", testDir) {
+				sources.Files = append(sources.Files, &policySourceFile{Kind: "test", Name: ref.name, Path: name, Content: string(files[name])})
+			} else {
+				sources.Mocks = append(sources.Mocks, name)
+			}
+		}
+	}
+	for _, ref := range modules {
+		findCode("module", ref)
+	}
+	return sources
+}
+
+// registryPolicySources reads the code of the policies and policy modules of a library version from
+// the registry, which serves them without their test cases
+func registryPolicySources(ctx context.Context, httpClient *http.Client, policyID string, policies []policyFileRef, modules []policyFileRef, logger *log.Logger) *policySources {
+	sources := &policySources{}
+	fetch := func(kind string, segment string, ref policyFileRef) {
+		content, err := client.SendRegistryCall(ctx, httpClient, http.MethodGet, fmt.Sprintf("%s/%s/%s.sentinel", strings.Trim(policyID, "/"), segment, ref.name), logger, "v2")
+		if err != nil {
+			sources.Missing = append(sources.Missing, fmt.Sprintf("%s %s", kind, ref.name))
+			return
+		}
+		sources.Files = append(sources.Files, &policySourceFile{
+			Kind:             kind,
+			Name:             ref.name,
+			Path:             fmt.Sprintf("%s/%s.sentinel", segment, ref.name),
+			Content:          string(content),
+			ChecksumVerified: ref.verify(content),
+		})
+	}
+	for _, ref := range policies {
+		fetch("policy", "policy", ref)
+	}
+	for _, ref := range modules {
+		fetch("module", "policy-module", ref)
+	}
+	return sources
+}
+
+// writePolicySources renders the files of a policy library as markdown
+func writePolicySources(builder *strings.Builder, sources *policySources) {
+	builder.WriteString("\n---\n## Policy source\n\n")
+	if sources.Note != "" {
+		builder.WriteString(sources.Note + "\n\n")
+	}
+	for _, file := range sources.Files {
+		language := "sentinel"
+		if file.Kind == "test" {
+			language = strings.TrimPrefix(path.Ext(file.Path), ".")
+		}
+		checksum := ""
+		if file.Kind != "test" {
+			checksum = " (checksum not verified)"
+			if file.ChecksumVerified {
+				checksum = " (checksum verified)"
+			}
+		}
+		fmt.Fprintf(builder, "### %s `%s`: %s%s\n\n```%s\n%s\n```\n\n", file.Kind, file.Name, file.Path, checksum, language, strings.TrimRight(file.Content, "\n"))
+	}
+	if len(sources.Mocks) > 0 {
+		builder.WriteString("Mock data of the test cases, not included:\n")
+		for _, name := range sources.Mocks {
+			builder.WriteString("- " + name + "\n")
+		}
+		builder.WriteString("\n")
+	}
+	if len(sources.Missing) > 0 {
+		builder.WriteString("Not found in the policy library: " + strings.Join(sources.Missing, ", ") + "\n")
+	}
+}
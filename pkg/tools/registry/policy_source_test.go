@@ -0,0 +1,64 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func sha256Hex(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
+
+func TestArchivePolicySources(t *testing.T) {
+	const policy = "main = rule { true }\n"
+	const module = "func ok() { return true }\n"
+	archive := moduleArchive(t, map[string]string{
+		"policy-library-1.0.0/policies/s3/require-encryption.sentinel":                  policy,
+		"policy-library-1.0.0/policies/s3/test/require-encryption/pass.hcl":             "test { rules = { main = true } }",
+		"policy-library-1.0.0/policies/s3/test/require-encryption/fail.json":            `{"test": {"main": false}}`,
+		"policy-library-1.0.0/policies/s3/test/require-encryption/mock-tfplan.sentinel": "resource_changes = {}",
+		"policy-library-1.0.0/policies/s3/test/other/pass.hcl":                          "test {}",
+		"policy-library-1.0.0/modules/helpers.sentinel":                                 module,
+		"policy-library-1.0.0/old/helpers.sentinel":                                     "outdated",
+		"policy-library-1.0.0/README.md":                                                "# Library",
+	})
+
+	files, err := policyLibraryFiles(bytes.NewReader(archive))
+	require.NoError(t, err)
+	assert.NotContains(t, files, "README.md")
+
+	sources := archivePolicySources(files,
+		[]policyFileRef{{name: "require-encryption", shasum: sha256Hex(policy)}, {name: "missing"}},
+		[]policyFileRef{{name: "helpers", shasum: sha256Hex(module)}},
+	)
+
+	byPath := make(map[string]*policySourceFile)
+	for _, file := range sources.Files {
+		byPath[file.Path] = file
+	}
+	require.Len(t, sources.Files, 4)
+	assert.Equal(t, &policySourceFile{Kind: "policy", Name: "require-encryption", Path: "policies/s3/require-encryption.sentinel", Content: policy, ChecksumVerified: true}, byPath["policies/s3/require-encryption.sentinel"])
+	assert.Equal(t, "test", byPath["policies/s3/test/require-encryption/pass.hcl"].Kind)
+	assert.Equal(t, "test", byPath["policies/s3/test/require-encryption/fail.json"].Kind)
+	assert.True(t, byPath["modules/helpers.sentinel"].ChecksumVerified, "the module whose checksum matches is preferred")
+	assert.Equal(t, []string{"policies/s3/test/require-encryption/mock-tfplan.sentinel"}, sources.Mocks)
+	assert.Equal(t, []string{"policy missing"}, sources.Missing)
+
+	var builder strings.Builder
+	writePolicySources(&builder, sources)
+	text := builder.String()
+	assert.Contains(t, text, "### policy `require-encryption`: policies/s3/require-encryption.sentinel (checksum verified)\n\n```sentinel\nmain = rule { true }\n```")
+	assert.Contains(t, text, "```hcl\ntest { rules = { main = true } }\n```")
+	assert.Contains(t, text, "- policies/s3/test/require-encryption/mock-tfplan.sentinel")
+	assert.Contains(t, text, "Not found in the policy library: policy missing")
+}
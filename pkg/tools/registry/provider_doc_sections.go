@@ -0,0 +1,85 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// ProviderDocMaxBytesEnv sets the largest part of a provider doc returned by get_provider_details
+	ProviderDocMaxBytesEnv = "MCP_PROVIDER_DOC_MAX_BYTES"
+	// defaultProviderDocMaxBytes is about 10k tokens
+	defaultProviderDocMaxBytes = 40000
+	minProviderDocMaxBytes     = 1000
+	// docBytesPerToken is the rough number of bytes per token used for estimates
+	docBytesPerToken = 4
+)
+
+// providerDocMaxBytes returns the byte budget of the provider docs returned at once
+func providerDocMaxBytes(logger *log.Logger) int {
+	value := utils.GetEnv(ProviderDocMaxBytesEnv, "")
+	if value == "" {
+		return defaultProviderDocMaxBytes
+	}
+	maxBytes, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil || maxBytes < minProviderDocMaxBytes {
+		logger.Warnf("Invalid %s '%s', must be a number of bytes of at least %d, using %d", ProviderDocMaxBytesEnv, value, minProviderDocMaxBytes, defaultProviderDocMaxBytes)
+		return defaultProviderDocMaxBytes
+	}
+	return maxBytes
+}
+
+// providerDocSection is a part of a provider doc under a second level heading. The text before the
+// first heading is the introduction.
+type providerDocSection struct {
+	heading string
+	content string
+}
+
+// splitProviderDoc splits a provider doc into its sections, ignoring the headings in code blocks
+func splitProviderDoc(content string) []providerDocSection {
+	var sections []providerDocSection
+	current := providerDocSection{heading: "Introduction"}
+	var builder strings.Builder
+	inCode := false
+	for _, line := range strings.SplitAfter(content, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") {
+			inCode = !inCode
+		}
+		if !inCode && strings.HasPrefix(trimmed, "## ") {
+			if current.content = builder.String(); strings.TrimSpace(current.content) != "" {
+				sections = append(sections, current)
+			}
+			current = providerDocSection{heading: strings.Trim(strings.TrimPrefix(trimmed, "## "), "` ")}
+			builder.Reset()
+		}
+		builder.WriteString(line)
+	}
+	if current.content = builder.String(); strings.TrimSpace(current.content) != "" {
+		sections = append(sections, current)
+	}
+	return sections
+}
+
+// providerDocIndex lists the sections of a provider doc too large to be returned at once
+func providerDocIndex(providerDocID, title string, sections []providerDocSection, total, maxBytes int) string {
+	var builder strings.Builder
+	if title != "" {
+		fmt.Fprintf(&builder, "# %s\n\n", title)
+	}
+	fmt.Fprintf(&builder, "This document is %d bytes (about %d tokens), more than the %d bytes returned at once. Call get_provider_details with provider_doc_id '%s', detail 'full' and the section number of the part you need:\n\n", total, total/docBytesPerToken, maxBytes, providerDocID)
+	builder.WriteString("| Section | Heading | Bytes |\n|---|---|---|\n")
+	for i, section := range sections {
+		fmt.Fprintf(&builder, "| %d | %s | %d |\n", i+1, strings.ReplaceAll(section.heading, "|", `\|`), len(section.content))
+	}
+	builder.WriteString("\nSections larger than the budget are returned in parts, continue them with byte_offset.")
+	return builder.String()
+}
@@ -0,0 +1,53 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProviderDocSections(t *testing.T) {
+	content := "---\npage_title: aws_instance\n---\n\n# Resource: aws_instance\n\nIntro.\n\n## Example Usage\n\n```terraform\n## not a heading\n```\n\n## Argument Reference\n\n* `ami` - (Required) AMI.\n\n## `timeouts`\n\n* `create` - Default 10m.\n"
+
+	t.Run("split on second level headings outside code", func(t *testing.T) {
+		sections := splitProviderDoc(content)
+		require.Len(t, sections, 4)
+		headings := []string{sections[0].heading, sections[1].heading, sections[2].heading, sections[3].heading}
+		assert.Equal(t, []string{"Introduction", "Example Usage", "Argument Reference", "timeouts"}, headings)
+		assert.Contains(t, sections[1].content, "## not a heading")
+
+		var joined strings.Builder
+		for _, section := range sections {
+			joined.WriteString(section.content)
+		}
+		assert.Equal(t, content, joined.String())
+	})
+
+	t.Run("index of the sections", func(t *testing.T) {
+		index := providerDocIndex("8894603", "aws_instance", splitProviderDoc(content), len(content), 1000)
+		assert.Contains(t, index, "# aws_instance")
+		assert.Contains(t, index, "| 3 | Argument Reference |")
+		assert.Contains(t, index, "provider_doc_id '8894603'")
+	})
+}
+
+func TestProviderDocMaxBytes(t *testing.T) {
+	logger := log.New()
+	logger.SetOutput(io.Discard)
+
+	t.Setenv(ProviderDocMaxBytesEnv, "")
+	assert.Equal(t, defaultProviderDocMaxBytes, providerDocMaxBytes(logger))
+
+	t.Setenv(ProviderDocMaxBytesEnv, "20000")
+	assert.Equal(t, 20000, providerDocMaxBytes(logger))
+
+	t.Setenv(ProviderDocMaxBytesEnv, "10")
+	assert.Equal(t, defaultProviderDocMaxBytes, providerDocMaxBytes(logger))
+}
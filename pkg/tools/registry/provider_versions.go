@@ -0,0 +1,278 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// defaultProviderVersionsLimit is the number of versions list_provider_versions returns by default
+const defaultProviderVersionsLimit = 20
+
+// ProviderVersionList is the list of available versions of a provider, newest first
+type ProviderVersionList struct {
+	Provider   string                 `json:"provider"`
+	TotalCount int                    `json:"total_count"`
+	Versions   []*ProviderVersionInfo `json:"versions"`
+}
+
+// ProviderVersionInfo is an available version of a provider
+type ProviderVersionInfo struct {
+	Version        string   `json:"version"`
+	ReleaseChannel string   `json:"release_channel"`
+	Protocols      []string `json:"protocols,omitempty"`
+}
+
+// ProviderVersionResolution is the provider version that best matches a version constraint
+type ProviderVersionResolution struct {
+	Provider          string `json:"provider"`
+	VersionConstraint string `json:"version_constraint"`
+	Version           string `json:"version"`
+	ReleaseChannel    string `json:"release_channel"`
+	LatestVersion     string `json:"latest_version"`
+	IsLatest          bool   `json:"is_latest"`
+	MatchingVersions  int    `json:"matching_versions"`
+}
+
+// ListProviderVersions creates a tool to list the available versions of a provider from the public registry.
+func ListProviderVersions(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("list_provider_versions",
+			mcp.WithDescription("Lists the available versions of a Terraform provider from the public registry, newest first, with their release channel and plugin protocols. By default only stable releases are listed; set release_channel to include pre-releases."),
+			mcp.WithTitleAnnotation("List Provider Versions"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("namespace",
+				mcp.Required(),
+				mcp.Description("The namespace of the Terraform provider, typically the name of the company, or their GitHub organization name that created the provider e.g., 'hashicorp'")),
+			mcp.WithString("name",
+				mcp.Required(),
+				mcp.Description("The name of the Terraform provider, e.g., 'aws', 'azurerm', 'google', etc.")),
+			withReleaseChannel(),
+			mcp.WithNumber("limit",
+				mcp.Description("The maximum number of versions to return, newest first"),
+				mcp.DefaultNumber(defaultProviderVersionsLimit),
+				mcp.Min(1),
+			),
+		),
+		Handler: func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return listProviderVersionsHandler(ctx, req, logger)
+		},
+	}
+}
+
+// ResolveProviderVersion creates a tool to resolve a version constraint against the versions of a provider.
+func ResolveProviderVersion(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("resolve_provider_version",
+			mcp.WithDescription(`Resolves a version constraint, as written in a required_providers block, to the newest version of a Terraform provider from the public registry that satisfies it, the way terraform init does.
+Pre-releases only match constraints that name them exactly, e.g. '6.0.0-beta1'. Also returns the latest version so outdated constraints can be spotted.`),
+			mcp.WithTitleAnnotation("Resolve a Provider Version Constraint"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("namespace",
+				mcp.Required(),
+				mcp.Description("The namespace of the Terraform provider, typically the name of the company, or their GitHub organization name that created the provider e.g., 'hashicorp'")),
+			mcp.WithString("name",
+				mcp.Required(),
+				mcp.Description("The name of the Terraform provider, e.g., 'aws', 'azurerm', 'google', etc.")),
+			mcp.WithString("version_constraint",
+				mcp.Required(),
+				mcp.Description("The version constraint to resolve, e.g., '~> 5.0' or '>= 4.0, < 6.0'")),
+		),
+		Handler: func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return resolveProviderVersionHandler(ctx, req, logger)
+		},
+	}
+}
+
+func listProviderVersionsHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	namespace, err := request.RequireString("namespace")
+	if err != nil {
+		return ToolError(logger, "missing required input: namespace", err)
+	}
+	namespace = strings.ToLower(namespace)
+
+	name, err := request.RequireString("name")
+	if err != nil {
+		return ToolError(logger, "missing required input: name", err)
+	}
+	name = strings.ToLower(name)
+
+	channel, err := releaseChannelParam(request)
+	if err != nil {
+		return ToolError(logger, "invalid input", err)
+	}
+
+	limit := request.GetInt("limit", defaultProviderVersionsLimit)
+	if limit < 1 {
+		return ToolErrorf(logger, "limit must be at least 1, got %d", limit)
+	}
+
+	httpClient, err := client.GetHttpClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get http client for public Terraform registry", err)
+	}
+
+	providerVersions, err := client.ListProviderVersions(ctx, httpClient, namespace, name, logger)
+	if err != nil {
+		return ToolErrorf(logger, "provider not found: %s/%s - verify the namespace and provider name are correct", namespace, name)
+	}
+
+	result := providerVersionList(fmt.Sprintf("%s/%s", namespace, name), providerVersions, channel, limit)
+	if result.TotalCount == 0 {
+		return ToolErrorf(logger, "no %s release found for provider %s/%s", channel, namespace, name)
+	}
+	buf, err := json.Marshal(result)
+	if err != nil {
+		return ToolError(logger, "failed to marshal provider versions", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+func resolveProviderVersionHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	namespace, err := request.RequireString("namespace")
+	if err != nil {
+		return ToolError(logger, "missing required input: namespace", err)
+	}
+	namespace = strings.ToLower(namespace)
+
+	name, err := request.RequireString("name")
+	if err != nil {
+		return ToolError(logger, "missing required input: name", err)
+	}
+	name = strings.ToLower(name)
+
+	constraint, err := request.RequireString("version_constraint")
+	if err != nil {
+		return ToolError(logger, "missing required input: version_constraint", err)
+	}
+	constraint = strings.TrimSpace(constraint)
+
+	httpClient, err := client.GetHttpClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get http client for public Terraform registry", err)
+	}
+
+	providerVersions, err := client.ListProviderVersions(ctx, httpClient, namespace, name, logger)
+	if err != nil {
+		return ToolErrorf(logger, "provider not found: %s/%s - verify the namespace and provider name are correct", namespace, name)
+	}
+
+	versions := make([]string, 0, len(providerVersions.Versions))
+	for _, v := range providerVersions.Versions {
+		versions = append(versions, v.Version)
+	}
+	resolution, err := resolveVersionConstraint(versions, constraint)
+	if err != nil {
+		return ToolErrorf(logger, "failed to resolve %q for provider %s/%s: %v", constraint, namespace, name, err)
+	}
+	resolution.Provider = fmt.Sprintf("%s/%s", namespace, name)
+	buf, err := json.Marshal(resolution)
+	if err != nil {
+		return ToolError(logger, "failed to marshal provider version resolution", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+// providerVersionList returns up to limit versions of the release channel, newest first.
+// Versions that cannot be parsed are ignored.
+func providerVersionList(provider string, providerVersions *client.ProviderVersions, channel string, limit int) *ProviderVersionList {
+	type parsedVersion struct {
+		version *version.Version
+		info    *ProviderVersionInfo
+	}
+
+	var parsed []parsedVersion
+	for _, v := range providerVersions.Versions {
+		if !utils.IsVersionInChannel(v.Version, channel) {
+			continue
+		}
+		ver, err := version.NewVersion(v.Version)
+		if err != nil {
+			continue
+		}
+		parsed = append(parsed, parsedVersion{
+			version: ver,
+			info: &ProviderVersionInfo{
+				Version:        v.Version,
+				ReleaseChannel: utils.ReleaseChannelOf(v.Version),
+				Protocols:      v.Protocols,
+			},
+		})
+	}
+	sort.SliceStable(parsed, func(i, j int) bool {
+		return parsed[i].version.GreaterThan(parsed[j].version)
+	})
+
+	result := &ProviderVersionList{
+		Provider:   provider,
+		TotalCount: len(parsed),
+		Versions:   []*ProviderVersionInfo{},
+	}
+	for _, p := range parsed {
+		if len(result.Versions) == limit {
+			break
+		}
+		result.Versions = append(result.Versions, p.info)
+	}
+	return result
+}
+
+// resolveVersionConstraint returns the newest of versions that satisfies the constraint. As in
+// Terraform, pre-releases only satisfy constraints that name them exactly.
+func resolveVersionConstraint(versions []string, constraint string) (*ProviderVersionResolution, error) {
+	constraints, err := version.NewConstraint(constraint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid version constraint: %w", err)
+	}
+
+	var best, latest *version.Version
+	var bestRaw, latestRaw string
+	matching := 0
+	for _, raw := range versions {
+		v, err := version.NewVersion(raw)
+		if err != nil {
+			continue
+		}
+		if v.Prerelease() == "" && (latest == nil || v.GreaterThan(latest)) {
+			latest, latestRaw = v, raw
+		}
+		if !constraints.Check(v) {
+			continue
+		}
+		matching++
+		if best == nil || v.GreaterThan(best) {
+			best, bestRaw = v, raw
+		}
+	}
+
+	if best == nil {
+		if latestRaw == "" {
+			return nil, fmt.Errorf("no version satisfies the constraint")
+		}
+		return nil, fmt.Errorf("no version satisfies the constraint, the latest version is %s", latestRaw)
+	}
+	return &ProviderVersionResolution{
+		VersionConstraint: constraints.String(),
+		Version:           bestRaw,
+		ReleaseChannel:    utils.ReleaseChannelOf(bestRaw),
+		LatestVersion:     latestRaw,
+		IsLatest:          latest != nil && best.Equal(latest),
+		MatchingVersions:  matching,
+	}, nil
+}
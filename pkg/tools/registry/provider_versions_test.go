@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testProviderVersions = []string{"4.67.0", "5.0.0", "5.9.0", "5.10.0", "5.100.0", "6.0.0-beta1", "6.0.0", "6.2.0", "not-a-version"}
+
+func TestResolveVersionConstraint(t *testing.T) {
+	tests := []struct {
+		constraint string
+		version    string
+		isLatest   bool
+		matching   int
+	}{
+		{"~> 5.0", "5.100.0", false, 4},
+		{"~> 5.9.0", "5.9.0", false, 1},
+		{">= 4.0, < 6.0", "5.100.0", false, 5},
+		{">= 5.10", "6.2.0", true, 4},
+		{"6.0.0-beta1", "6.0.0-beta1", false, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.constraint, func(t *testing.T) {
+			resolution, err := resolveVersionConstraint(testProviderVersions, tt.constraint)
+			require.NoError(t, err)
+			assert.Equal(t, tt.version, resolution.Version)
+			assert.Equal(t, "6.2.0", resolution.LatestVersion)
+			assert.Equal(t, tt.isLatest, resolution.IsLatest)
+			assert.Equal(t, tt.matching, resolution.MatchingVersions)
+		})
+	}
+
+	t.Run("no matching version", func(t *testing.T) {
+		_, err := resolveVersionConstraint(testProviderVersions, "~> 3.0")
+		assert.ErrorContains(t, err, "the latest version is 6.2.0")
+	})
+
+	t.Run("invalid constraint", func(t *testing.T) {
+		_, err := resolveVersionConstraint(testProviderVersions, "about 5")
+		assert.ErrorContains(t, err, "invalid version constraint")
+	})
+}
+
+func TestProviderVersionList(t *testing.T) {
+	var providerVersions client.ProviderVersions
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"id": "hashicorp/aws",
+		"versions": [
+			{"version": "5.9.0", "protocols": ["5.0"]},
+			{"version": "6.0.0-beta1", "protocols": ["5.0"]},
+			{"version": "5.10.0", "protocols": ["5.0"]},
+			{"version": "6.0.0", "protocols": ["5.0"]},
+			{"version": "bad"}
+		]
+	}`), &providerVersions))
+
+	list := providerVersionList("hashicorp/aws", &providerVersions, "stable", 2)
+	assert.Equal(t, 3, list.TotalCount)
+	assert.Equal(t, []*ProviderVersionInfo{
+		{Version: "6.0.0", ReleaseChannel: "stable", Protocols: []string{"5.0"}},
+		{Version: "5.10.0", ReleaseChannel: "stable", Protocols: []string{"5.0"}},
+	}, list.Versions)
+
+	list = providerVersionList("hashicorp/aws", &providerVersions, "any", 20)
+	assert.Equal(t, 4, list.TotalCount)
+	assert.Equal(t, "6.0.0-beta1", list.Versions[1].Version)
+	assert.Equal(t, "beta", list.Versions[1].ReleaseChannel)
+}
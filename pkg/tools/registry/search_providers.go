@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"net/http"
 	"path"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform-mcp-server/pkg/client"
@@ -30,7 +31,8 @@ When selecting the best match, consider the following:
 	- Title similarity to the query
 	- Category relevance
 Return the selected 'provider_doc_id' and explain your choice.
-If there are multiple good matches, mention this but proceed with the most relevant one.`),
+If there are multiple good matches, mention this but proceed with the most relevant one.
+If no resource or data source matches the 'service_slug' exactly, the closest candidates are returned with a similarity score instead.`),
 			mcp.WithTitleAnnotation("Identify the most relevant provider document ID for a Terraform service"),
 			mcp.WithOpenWorldHintAnnotation(true),
 			mcp.WithReadOnlyHintAnnotation(true),
@@ -141,12 +143,63 @@ func resolveProviderDocIDHandler(ctx context.Context, request mcp.CallToolReques
 	}
 
 	if !contentAvailable {
-		return ToolErrorf(logger, "no documentation found for service_slug '%s' - try a more relevant service_slug, or use the provider_name as the value", serviceSlug)
+		candidates := fuzzyDocCandidates(providerDocs.Docs, providerDetail.ProviderName, providerDetail.ProviderDocumentType, serviceSlug, maxFuzzyCandidates)
+		if len(candidates) == 0 {
+			return ToolErrorf(logger, "no documentation found for service_slug '%s' - try a more relevant service_slug, or use the provider_name as the value", serviceSlug)
+		}
+
+		var fuzzy strings.Builder
+		fuzzy.WriteString(fmt.Sprintf("No %s documentation matches service_slug '%s' in Terraform provider %s/%s version: %s\n\n", providerDetail.ProviderDocumentType, serviceSlug, providerDetail.ProviderNamespace, providerDetail.ProviderName, providerDetail.ProviderVersion))
+		fuzzy.WriteString("Closest candidates, best first. Each result includes:\n- providerDocID: tfprovider-compatible identifier\n- Title: Service or resource name\n- Category: Type of document\n- Score: Similarity to the service_slug, from 0 to 1\n")
+		fuzzy.WriteString("Pick the intended document if one of them matches, otherwise call 'autocomplete_service_slug' or try another service_slug.\n\n---\n\n")
+		for _, candidate := range candidates {
+			fuzzy.WriteString(fmt.Sprintf("- providerDocID: %s\n- Title: %s\n- Category: %s\n- Score: %.2f\n---\n", candidate.doc.ID, candidate.doc.Title, candidate.doc.Category, candidate.score))
+		}
+		return mcp.NewToolResultText(fuzzy.String()), nil
 	}
 
 	return mcp.NewToolResultText(builder.String()), nil
 }
 
+const (
+	// maxFuzzyCandidates is the number of docs suggested when no doc matches the service slug
+	maxFuzzyCandidates = 5
+	// minFuzzyScore is the lowest similarity of a suggested doc
+	minFuzzyScore = 0.5
+)
+
+// fuzzyDocCandidate is a doc suggested for a service slug that matches no doc, with its similarity
+type fuzzyDocCandidate struct {
+	doc   client.ProviderDoc
+	score float64
+}
+
+// fuzzyDocCandidates returns the docs of a category whose slug is most similar to a service slug, best first
+func fuzzyDocCandidates(docs []client.ProviderDoc, providerName, documentType, serviceSlug string, limit int) []fuzzyDocCandidate {
+	serviceSlug = strings.TrimPrefix(serviceSlug, providerName+"_")
+
+	var candidates []fuzzyDocCandidate
+	for _, doc := range docs {
+		if doc.Language != "hcl" || doc.Category != documentType {
+			continue
+		}
+		score := slugSimilarity(strings.ToLower(doc.Slug), serviceSlug)
+		if score >= minFuzzyScore {
+			candidates = append(candidates, fuzzyDocCandidate{doc: doc, score: score})
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].score != candidates[j].score {
+			return candidates[i].score > candidates[j].score
+		}
+		return candidates[i].doc.Slug < candidates[j].doc.Slug
+	})
+	if len(candidates) > limit {
+		candidates = candidates[:limit]
+	}
+	return candidates
+}
+
 func resolveProviderDetails(ctx context.Context, request mcp.CallToolRequest, httpClient *http.Client, logger *log.Logger) (client.ProviderDetail, error) {
 	providerDetail := client.ProviderDetail{}
 	providerName := request.GetString("provider_name", "")
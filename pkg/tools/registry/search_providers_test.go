@@ -0,0 +1,58 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFuzzyDocCandidates(t *testing.T) {
+	docs := []client.ProviderDoc{
+		{ID: "1", Slug: "instance", Category: "resources", Language: "hcl"},
+		{ID: "2", Slug: "security_group", Category: "resources", Language: "hcl"},
+		{ID: "3", Slug: "security_group_rule", Category: "resources", Language: "hcl"},
+		{ID: "4", Slug: "s3_bucket", Category: "resources", Language: "hcl"},
+		{ID: "5", Slug: "security_group", Category: "data-sources", Language: "hcl"},
+		{ID: "6", Slug: "security_group", Category: "resources", Language: "python"},
+	}
+
+	ids := func(candidates []fuzzyDocCandidate) []string {
+		var ids []string
+		for _, c := range candidates {
+			ids = append(ids, c.doc.ID)
+		}
+		return ids
+	}
+
+	t.Run("misspelled slug", func(t *testing.T) {
+		candidates := fuzzyDocCandidates(docs, "aws", "resources", "instnace", 5)
+		assert.Equal(t, []string{"1"}, ids(candidates))
+		assert.Greater(t, candidates[0].score, 0.7)
+	})
+
+	t.Run("slug without underscores", func(t *testing.T) {
+		candidates := fuzzyDocCandidates(docs, "aws", "resources", "aws_securitygroup", 5)
+		assert.Equal(t, []string{"2", "3"}, ids(candidates))
+		assert.Greater(t, candidates[0].score, candidates[1].score)
+	})
+
+	t.Run("limit and category", func(t *testing.T) {
+		assert.Equal(t, []string{"5"}, ids(fuzzyDocCandidates(docs, "aws", "data-sources", "security_groups", 5)))
+		assert.Len(t, fuzzyDocCandidates(docs, "aws", "resources", "security_groups", 1), 1)
+	})
+
+	t.Run("unrelated slug", func(t *testing.T) {
+		assert.Empty(t, fuzzyDocCandidates(docs, "aws", "resources", "kubernetes_cluster", 5))
+	})
+}
+
+func TestSlugSimilarity(t *testing.T) {
+	assert.InDelta(t, 1.0, slugSimilarity("security_group", "security_group"), 0.001)
+	assert.Greater(t, slugSimilarity("s3_bucket", "s3bucket"), slugSimilarity("instance", "s3bucket"))
+	assert.Zero(t, slugSimilarity("instance", ""))
+	assert.Equal(t, 2, levenshtein("instnace", "instance"))
+}
@@ -0,0 +1,452 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// LocalExecutionEnv enables the tools that run the Terraform CLI on configuration passed in by the client
+	LocalExecutionEnv = "ENABLE_TF_LOCAL_EXECUTION"
+	// ProviderMirrorEnv sets the only source the tools that run the Terraform CLI on configuration passed
+	// in by the client install providers from: a directory in the filesystem mirror layout, or the
+	// https:// URL of a network mirror
+	ProviderMirrorEnv = "MCP_TERRAFORM_PROVIDER_MIRROR"
+
+	// terraformChecksTimeout bounds the provider downloads and all the checks of a call
+	terraformChecksTimeout = 5 * time.Minute
+	// maxCheckedConfigurationSize bounds the total size of the checked configuration files
+	maxCheckedConfigurationSize = 10 << 20
+)
+
+// Terraform checks
+const (
+	TerraformCheckFmt      = "fmt"
+	TerraformCheckValidate = "validate"
+)
+
+// passthroughEnv are the environment variables the Terraform CLI keeps when checking a
+// configuration, to reach a network mirror through a proxy. Credentials are not passed through.
+var passthroughEnv = []string{
+	"PATH", "SYSTEMROOT", "TMPDIR", "TEMP", "TMP",
+	"HTTPS_PROXY", "https_proxy", "HTTP_PROXY", "http_proxy", "NO_PROXY", "no_proxy",
+	"SSL_CERT_FILE", "SSL_CERT_DIR",
+}
+
+var (
+	sharedLocalExecutionMu sync.Mutex
+	sharedLocalExecution   bool
+	sharedProviderMirror   string
+)
+
+// SetLocalExecution enables the tools that run the Terraform CLI on configuration passed in by the
+// client and sets the provider mirror they install providers from, such as with the
+// --enable-local-execution and --terraform-provider-mirror flags. ENABLE_TF_LOCAL_EXECUTION and
+// MCP_TERRAFORM_PROVIDER_MIRROR are used when they aren't set.
+func SetLocalExecution(enabled bool, providerMirror string) {
+	sharedLocalExecutionMu.Lock()
+	defer sharedLocalExecutionMu.Unlock()
+	sharedLocalExecution = enabled
+	sharedProviderMirror = providerMirror
+}
+
+// IsLocalExecutionEnabled reports whether local execution is enabled with SetLocalExecution or
+// ENABLE_TF_LOCAL_EXECUTION
+func IsLocalExecutionEnabled() bool {
+	sharedLocalExecutionMu.Lock()
+	defer sharedLocalExecutionMu.Unlock()
+	return sharedLocalExecution || strings.ToLower(utils.GetEnv(LocalExecutionEnv, "false")) == "true"
+}
+
+func providerMirror() string {
+	sharedLocalExecutionMu.Lock()
+	defer sharedLocalExecutionMu.Unlock()
+	if sharedProviderMirror != "" {
+		return sharedProviderMirror
+	}
+	return utils.GetEnv(ProviderMirrorEnv, "")
+}
+
+// CheckLocalExecutionConfig reports why the tools that run the Terraform CLI on configuration passed
+// in by the client can't be used, when the provider mirror is missing or invalid
+func CheckLocalExecutionConfig() error {
+	_, err := providerInstallationConfig(providerMirror())
+	return err
+}
+
+// providerInstallationConfig returns the CLI configuration that installs providers only from the
+// provider mirror, so that a configuration can't make the CLI download and run any provider
+func providerInstallationConfig(mirror string) (string, error) {
+	mirror = strings.TrimSpace(mirror)
+	switch {
+	case mirror == "":
+		return "", fmt.Errorf("no provider mirror is configured, set %s or --terraform-provider-mirror", ProviderMirrorEnv)
+	case strings.HasPrefix(mirror, "https://"):
+		u, err := url.Parse(mirror)
+		if err != nil || u.Host == "" {
+			return "", fmt.Errorf("invalid provider mirror URL '%s'", mirror)
+		}
+		// Terraform requires the URL of a network mirror to end with a slash
+		if !strings.HasSuffix(mirror, "/") {
+			mirror += "/"
+		}
+		return fmt.Sprintf("disable_checkpoint = true\n\nprovider_installation {\n  network_mirror {\n    url = %q\n  }\n}\n", mirror), nil
+	case filepath.IsAbs(mirror):
+		info, err := os.Stat(mirror)
+		if err != nil || !info.IsDir() {
+			return "", fmt.Errorf("the provider mirror '%s' is not a directory", mirror)
+		}
+		return fmt.Sprintf("disable_checkpoint = true\n\nprovider_installation {\n  filesystem_mirror {\n    path = %q\n  }\n}\n", filepath.ToSlash(mirror)), nil
+	default:
+		return "", fmt.Errorf("invalid provider mirror '%s': must be an absolute directory path or an https:// URL", mirror)
+	}
+}
+
+// TerraformChecks is the result of checking a configuration with the Terraform CLI
+type TerraformChecks struct {
+	// Passed is true when every check that ran passed
+	Passed   bool            `json:"passed"`
+	Files    []string        `json:"files"`
+	Init     *TerraformCheck `json:"init,omitempty"`
+	Fmt      *FormatCheck    `json:"fmt,omitempty"`
+	Validate *TerraformCheck `json:"validate,omitempty"`
+}
+
+// TerraformCheck is the result of a check reporting diagnostics
+type TerraformCheck struct {
+	Passed      bool                  `json:"passed"`
+	Diagnostics []TerraformDiagnostic `json:"diagnostics"`
+}
+
+// FormatCheck is the result of terraform fmt -check
+type FormatCheck struct {
+	Passed      bool                  `json:"passed"`
+	Unformatted []string              `json:"unformatted"`
+	Diff        string                `json:"diff,omitempty"`
+	Diagnostics []TerraformDiagnostic `json:"diagnostics,omitempty"`
+}
+
+// TerraformDiagnostic is an error or warning reported by the Terraform CLI
+type TerraformDiagnostic struct {
+	Severity string `json:"severity"`
+	Summary  string `json:"summary"`
+	Detail   string `json:"detail,omitempty"`
+	Filename string `json:"filename,omitempty"`
+	Line     int    `json:"line,omitempty"`
+	Column   int    `json:"column,omitempty"`
+}
+
+// jsonDiagnostic is a diagnostic of the Terraform CLI JSON output
+type jsonDiagnostic struct {
+	Severity string `json:"severity"`
+	Summary  string `json:"summary"`
+	Detail   string `json:"detail"`
+	Range    *struct {
+		Filename string `json:"filename"`
+		Start    struct {
+			Line   int `json:"line"`
+			Column int `json:"column"`
+		} `json:"start"`
+	} `json:"range"`
+}
+
+func (d jsonDiagnostic) diagnostic() TerraformDiagnostic {
+	diagnostic := TerraformDiagnostic{Severity: d.Severity, Summary: d.Summary, Detail: d.Detail}
+	if d.Range != nil {
+		diagnostic.Filename = d.Range.Filename
+		diagnostic.Line = d.Range.Start.Line
+		diagnostic.Column = d.Range.Start.Column
+	}
+	return diagnostic
+}
+
+// ValidateTerraformConfiguration creates a tool to check configuration files with terraform fmt and validate.
+func ValidateTerraformConfiguration(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("validate_terraform_configuration",
+			mcp.WithDescription(`Checks Terraform configuration files passed in as a map of file paths to their content, or read from a directory within the roots of the client, with the local Terraform CLI: 'terraform fmt -check' and 'terraform validate'. Returns the unformatted files with their diff, and the errors and warnings with their file and line, so generated configuration can be fixed before it is planned. The configuration is never planned, applied or tested, so no provisioner or data source is run.
+Isolation: the CLI runs as the user of the server in a temporary directory that is deleted afterwards. It is not a sandbox, there is no process, filesystem or network isolation. The CLI runs without a backend and without the CLI configuration, credentials and environment of the server, and installs providers only from the provider mirror configured for the server. Modules are downloaded from their sources.`),
+			mcp.WithTitleAnnotation("Check Terraform configuration with fmt and validate"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithObject("files",
+				mcp.Description(`Map of relative file paths to their content, e.g. {"main.tf": "...", "variables.tf": "..."}. Required unless directory is set`),
+			),
+			mcp.WithString("directory",
+				mcp.Description("Absolute path of a configuration directory to read the Terraform files from instead of passing files. It must be within the roots of the client and the directories of MCP_LOCAL_ROOTS"),
+			),
+			mcp.WithArray("checks",
+				mcp.Description("The checks to run, 'fmt' and 'validate' by default"),
+				mcp.WithStringEnumItems([]string{TerraformCheckFmt, TerraformCheckValidate}),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return validateTerraformConfigurationHandler(ctx, request, logger)
+		},
+	}
+}
+
+func validateTerraformConfigurationHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	files, _ := request.GetArguments()["files"].(map[string]any)
+	directory := strings.TrimSpace(request.GetString("directory", ""))
+	switch {
+	case directory != "" && len(files) > 0:
+		return ToolError(logger, "set either files or directory, not both", nil)
+	case directory != "":
+		read, err := utils.ReadConfigurationDirectory(ctx, directory, maxCheckedConfigurationSize)
+		if err != nil {
+			return ToolError(logger, "failed to read the configuration directory", err)
+		}
+		files = make(map[string]any, len(read))
+		for name, content := range read {
+			files[name] = content
+		}
+	case len(files) == 0:
+		return ToolError(logger, "files must be a non-empty map of file paths to their content, or directory must be set", nil)
+	}
+	checks := request.GetStringSlice("checks", []string{TerraformCheckFmt, TerraformCheckValidate})
+	enabled := map[string]bool{}
+	for _, check := range checks {
+		switch check {
+		case TerraformCheckFmt, TerraformCheckValidate:
+			enabled[check] = true
+		default:
+			return ToolErrorf(logger, "invalid check: %s - must be 'fmt' or 'validate'", check)
+		}
+	}
+	cliConfig, err := providerInstallationConfig(providerMirror())
+	if err != nil {
+		return ToolError(logger, "local execution is not configured", err)
+	}
+
+	terraformBinary, err := exec.LookPath(utils.GetEnv(TerraformBinaryEnv, "terraform"))
+	if err != nil {
+		return ToolErrorf(logger, "the Terraform CLI is required to check configuration and was not found, install it or set %s: %v", TerraformBinaryEnv, err)
+	}
+
+	workDir, err := os.MkdirTemp("", "terraform-mcp-checks-")
+	if err != nil {
+		return ToolError(logger, "failed to create a working directory", err)
+	}
+	defer os.RemoveAll(workDir)
+
+	// The configuration gets its own directory, so that its files can't replace the CLI configuration
+	configDir := filepath.Join(workDir, "configuration")
+	names, err := writeConfigurationFiles(configDir, files)
+	if err != nil {
+		return ToolError(logger, "invalid files", err)
+	}
+	env, err := terraformChecksEnv(workDir, cliConfig)
+	if err != nil {
+		return ToolError(logger, "failed to prepare the Terraform CLI", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, terraformChecksTimeout)
+	defer cancel()
+
+	result := &TerraformChecks{Passed: true, Files: names}
+	if enabled[TerraformCheckFmt] {
+		result.Fmt, err = checkFormat(ctx, terraformBinary, configDir, env)
+		if err != nil {
+			return ToolError(logger, "failed to run terraform fmt", err)
+		}
+		result.Passed = result.Passed && result.Fmt.Passed
+	}
+
+	if enabled[TerraformCheckValidate] {
+		logger.Debugf("Initializing the configuration in %s", configDir)
+		_, stderr, exitCode, err := execTerraform(ctx, terraformBinary, configDir, env, "init", "-backend=false", "-input=false", "-no-color")
+		if err != nil {
+			return ToolError(logger, "failed to run terraform init", err)
+		}
+		if exitCode != 0 {
+			result.Passed = false
+			result.Init = &TerraformCheck{Diagnostics: []TerraformDiagnostic{{
+				Severity: "error",
+				Summary:  "terraform init failed, the providers or modules of the configuration could not be installed. Providers are only installed from the provider mirror of the server",
+				Detail:   strings.TrimSpace(stderr),
+			}}}
+			return marshalTerraformChecks(result, logger)
+		}
+	}
+
+	if enabled[TerraformCheckValidate] {
+		stdout, stderr, _, err := execTerraform(ctx, terraformBinary, configDir, env, "validate", "-json")
+		if err != nil {
+			return ToolError(logger, "failed to run terraform validate", err)
+		}
+		result.Validate, err = parseValidateOutput([]byte(stdout))
+		if err != nil {
+			return ToolErrorf(logger, "failed to parse the terraform validate output: %v: %s", err, strings.TrimSpace(stderr))
+		}
+		result.Passed = result.Passed && result.Validate.Passed
+	}
+
+	return marshalTerraformChecks(result, logger)
+}
+
+func marshalTerraformChecks(result *TerraformChecks, logger *log.Logger) (*mcp.CallToolResult, error) {
+	buf, err := json.Marshal(result)
+	if err != nil {
+		return ToolError(logger, "failed to marshal the check results", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+// writeConfigurationFiles writes the configuration files to dir and returns their paths. Paths
+// must be relative and stay within the configuration, and at least one Terraform file is required.
+func writeConfigurationFiles(dir string, files map[string]any) ([]string, error) {
+	names := make([]string, 0, len(files))
+	seen := make(map[string]bool, len(files))
+	size := 0
+	hasTerraformFile := false
+	for name, raw := range files {
+		content, ok := raw.(string)
+		if !ok {
+			return nil, fmt.Errorf("the content of %s must be a string", name)
+		}
+		clean := path.Clean(strings.ReplaceAll(strings.TrimSpace(name), "\\", "/"))
+		if clean == "." || path.IsAbs(clean) || clean == ".." || strings.HasPrefix(clean, "../") || filepath.VolumeName(clean) != "" {
+			return nil, fmt.Errorf("invalid file path %q - paths must be relative to the configuration root", name)
+		}
+		if seen[clean] {
+			return nil, fmt.Errorf("file path %q is set more than once", clean)
+		}
+		seen[clean] = true
+		size += len(content)
+		if size > maxCheckedConfigurationSize {
+			return nil, fmt.Errorf("configuration files exceed %d MB", maxCheckedConfigurationSize>>20)
+		}
+		if strings.HasSuffix(clean, ".tf") || strings.HasSuffix(clean, ".tf.json") {
+			hasTerraformFile = true
+		}
+
+		target := filepath.Join(dir, filepath.FromSlash(clean))
+		if err := os.MkdirAll(filepath.Dir(target), 0o700); err != nil {
+			return nil, err
+		}
+		if err := os.WriteFile(target, []byte(content), 0o600); err != nil {
+			return nil, err
+		}
+		names = append(names, clean)
+	}
+	if !hasTerraformFile {
+		return nil, fmt.Errorf("no Terraform file (.tf or .tf.json) in the configuration files")
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// terraformChecksEnv writes the CLI configuration of the Terraform CLI to a home directory in workDir
+// and returns its environment, so that the CLI configuration, credentials and plugin cache of the
+// server's user are not used and providers are only installed from the provider mirror
+func terraformChecksEnv(workDir string, cliConfig string) ([]string, error) {
+	homeDir := filepath.Join(workDir, "home")
+	if err := os.MkdirAll(homeDir, 0o700); err != nil {
+		return nil, err
+	}
+	cliConfigFile := filepath.Join(homeDir, ".terraformrc")
+	if err := os.WriteFile(cliConfigFile, []byte(cliConfig), 0o600); err != nil {
+		return nil, err
+	}
+
+	env := []string{
+		"HOME=" + homeDir,
+		"TF_CLI_CONFIG_FILE=" + cliConfigFile,
+		"TF_DATA_DIR=" + filepath.Join(workDir, "data"),
+		"TF_IN_AUTOMATION=1",
+		"CHECKPOINT_DISABLE=1",
+	}
+	for _, name := range passthroughEnv {
+		if value, ok := os.LookupEnv(name); ok {
+			env = append(env, name+"="+value)
+		}
+	}
+	return env, nil
+}
+
+// execTerraform runs the Terraform CLI and returns its output and exit code. The error is only
+// set when the CLI could not be run.
+func execTerraform(ctx context.Context, terraformBinary, dir string, env []string, args ...string) (string, string, int, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, terraformBinary, args...)
+	cmd.Dir = dir
+	cmd.Env = env
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	err := cmd.Run()
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && ctx.Err() == nil {
+		return stdout.String(), stderr.String(), exitErr.ExitCode(), nil
+	}
+	if err != nil {
+		return "", "", 0, fmt.Errorf("terraform %s: %w", strings.Join(args, " "), err)
+	}
+	return stdout.String(), stderr.String(), 0, nil
+}
+
+// checkFormat lists the files that are not in the canonical format, with their diff
+func checkFormat(ctx context.Context, terraformBinary, dir string, env []string) (*FormatCheck, error) {
+	stdout, stderr, exitCode, err := execTerraform(ctx, terraformBinary, dir, env, "fmt", "-check", "-recursive", "-no-color")
+	if err != nil {
+		return nil, err
+	}
+	check := &FormatCheck{Passed: exitCode == 0, Unformatted: []string{}}
+	if exitCode == 0 {
+		return check, nil
+	}
+	for _, line := range strings.Split(stdout, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			check.Unformatted = append(check.Unformatted, filepath.ToSlash(line))
+		}
+	}
+	if len(check.Unformatted) == 0 {
+		// fmt reports the files it cannot parse on stderr
+		check.Diagnostics = []TerraformDiagnostic{{Severity: "error", Summary: "terraform fmt failed", Detail: strings.TrimSpace(stderr)}}
+		return check, nil
+	}
+
+	diff, _, _, err := execTerraform(ctx, terraformBinary, dir, env, "fmt", "-check", "-recursive", "-diff", "-list=false", "-no-color")
+	if err != nil {
+		return nil, err
+	}
+	check.Diff = diff
+	return check, nil
+}
+
+// parseValidateOutput parses the output of terraform validate -json
+func parseValidateOutput(output []byte) (*TerraformCheck, error) {
+	var validate struct {
+		Valid       bool             `json:"valid"`
+		Diagnostics []jsonDiagnostic `json:"diagnostics"`
+	}
+	if err := json.Unmarshal(output, &validate); err != nil {
+		return nil, err
+	}
+	check := &TerraformCheck{Passed: validate.Valid, Diagnostics: make([]TerraformDiagnostic, 0, len(validate.Diagnostics))}
+	for _, d := range validate.Diagnostics {
+		check.Diagnostics = append(check.Diagnostics, d.diagnostic())
+	}
+	return check, nil
+}
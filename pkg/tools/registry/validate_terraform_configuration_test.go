@@ -0,0 +1,173 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeTerraform answers like the Terraform CLI for a configuration with an unformatted file and a
+// validation warning. init fails unless it runs with the CLI configuration of the provider mirror.
+const fakeTerraform = `#!/bin/sh
+case "$1" in
+init)
+  grep -q filesystem_mirror "$TF_CLI_CONFIG_FILE" || exit 1
+  exit 0 ;;
+fmt)
+  case "$*" in
+  *-diff*) echo "--- old/main.tf" ;;
+  *) echo "main.tf" ;;
+  esac
+  exit 3 ;;
+validate)
+  echo '{"valid":true,"error_count":0,"warning_count":1,"diagnostics":[{"severity":"warning","summary":"Deprecated attribute","detail":"Use bucket_prefix","range":{"filename":"main.tf","start":{"line":3,"column":5}}}]}'
+  exit 0 ;;
+esac
+exit 1
+`
+
+func TestValidateTerraformConfiguration(t *testing.T) {
+	logger := log.New()
+
+	t.Run("tool creation", func(t *testing.T) {
+		tool := ValidateTerraformConfiguration(logger)
+		assert.Equal(t, "validate_terraform_configuration", tool.Tool.Name)
+		assert.True(t, *tool.Tool.Annotations.ReadOnlyHint)
+		assert.Empty(t, tool.Tool.InputSchema.Required)
+		assert.Contains(t, tool.Tool.InputSchema.Properties, "directory")
+	})
+
+	t.Run("local execution opt-in", func(t *testing.T) {
+		t.Setenv(LocalExecutionEnv, "")
+		assert.False(t, IsLocalExecutionEnabled())
+		t.Setenv(LocalExecutionEnv, "TRUE")
+		assert.True(t, IsLocalExecutionEnabled())
+
+		t.Setenv(LocalExecutionEnv, "")
+		SetLocalExecution(true, "")
+		t.Cleanup(func() { SetLocalExecution(false, "") })
+		assert.True(t, IsLocalExecutionEnabled())
+	})
+
+	t.Run("provider mirror", func(t *testing.T) {
+		t.Setenv(ProviderMirrorEnv, "")
+		assert.ErrorContains(t, CheckLocalExecutionConfig(), "no provider mirror is configured")
+
+		mirror := t.TempDir()
+		t.Setenv(ProviderMirrorEnv, mirror)
+		assert.NoError(t, CheckLocalExecutionConfig())
+		config, err := providerInstallationConfig(mirror)
+		require.NoError(t, err)
+		assert.Contains(t, config, "filesystem_mirror {\n    path = \""+filepath.ToSlash(mirror)+"\"")
+		assert.NotContains(t, config, "direct")
+
+		config, err = providerInstallationConfig("https://mirror.example.com/providers")
+		require.NoError(t, err)
+		assert.Contains(t, config, `url = "https://mirror.example.com/providers/"`)
+
+		for _, mirror := range []string{"relative/path", "http://mirror.example.com/", filepath.Join(t.TempDir(), "missing")} {
+			_, err := providerInstallationConfig(mirror)
+			assert.Error(t, err, mirror)
+		}
+	})
+
+	t.Run("configuration files", func(t *testing.T) {
+		dir := t.TempDir()
+		names, err := writeConfigurationFiles(dir, map[string]any{
+			"main.tf":               `resource "null_resource" "this" {}`,
+			"./modules/app/main.tf": `variable "name" {}`,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"main.tf", "modules/app/main.tf"}, names)
+		assert.FileExists(t, filepath.Join(dir, "modules", "app", "main.tf"))
+
+		_, err = writeConfigurationFiles(t.TempDir(), map[string]any{"../main.tf": ""})
+		assert.ErrorContains(t, err, "paths must be relative")
+		_, err = writeConfigurationFiles(t.TempDir(), map[string]any{"/etc/main.tf": ""})
+		assert.ErrorContains(t, err, "paths must be relative")
+		_, err = writeConfigurationFiles(t.TempDir(), map[string]any{"main.tf": "", "./main.tf": ""})
+		assert.ErrorContains(t, err, "set more than once")
+		_, err = writeConfigurationFiles(t.TempDir(), map[string]any{"README.md": ""})
+		assert.ErrorContains(t, err, "no Terraform file")
+		_, err = writeConfigurationFiles(t.TempDir(), map[string]any{"main.tf": 1})
+		assert.ErrorContains(t, err, "must be a string")
+	})
+
+	t.Run("validate output", func(t *testing.T) {
+		check, err := parseValidateOutput([]byte(`{"format_version":"1.0","valid":false,"error_count":1,"warning_count":0,"diagnostics":[{"severity":"error","summary":"Missing required argument","detail":"The argument \"bucket\" is required.","range":{"filename":"main.tf","start":{"line":1,"column":33,"byte":32}}},{"severity":"error","summary":"No range"}]}`))
+		require.NoError(t, err)
+		assert.False(t, check.Passed)
+		assert.Equal(t, []TerraformDiagnostic{
+			{Severity: "error", Summary: "Missing required argument", Detail: `The argument "bucket" is required.`, Filename: "main.tf", Line: 1, Column: 33},
+			{Severity: "error", Summary: "No range"},
+		}, check.Diagnostics)
+
+		_, err = parseValidateOutput([]byte("Error: not JSON"))
+		assert.Error(t, err)
+	})
+
+	t.Run("checks", func(t *testing.T) {
+		if runtime.GOOS == "windows" {
+			t.Skip("the fake Terraform CLI is a shell script")
+		}
+		binary := filepath.Join(t.TempDir(), "terraform")
+		require.NoError(t, os.WriteFile(binary, []byte(fakeTerraform), 0o700))
+		t.Setenv(TerraformBinaryEnv, binary)
+		t.Setenv(ProviderMirrorEnv, t.TempDir())
+
+		request := mcp.CallToolRequest{}
+		request.Params.Arguments = map[string]any{
+			// A .terraformrc of the configuration doesn't replace the CLI configuration of the mirror
+			"files":  map[string]any{"main.tf": `resource "aws_s3_bucket" "this" {}`, ".terraformrc": `provider_installation { direct {} }`},
+			"checks": []any{"fmt", "validate"},
+		}
+		result, err := validateTerraformConfigurationHandler(context.Background(), request, logger)
+		require.NoError(t, err)
+		require.False(t, result.IsError, result.Content)
+
+		var checks TerraformChecks
+		require.NoError(t, json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &checks))
+		assert.False(t, checks.Passed)
+		assert.Equal(t, []string{".terraformrc", "main.tf"}, checks.Files)
+		assert.Nil(t, checks.Init)
+		assert.Equal(t, []string{"main.tf"}, checks.Fmt.Unformatted)
+		assert.Contains(t, checks.Fmt.Diff, "--- old/main.tf")
+		assert.True(t, checks.Validate.Passed)
+		assert.Equal(t, "Deprecated attribute", checks.Validate.Diagnostics[0].Summary)
+	})
+
+	t.Run("files or directory", func(t *testing.T) {
+		for _, args := range []map[string]any{
+			{},
+			{"files": map[string]any{"main.tf": ""}, "directory": "/configuration"},
+		} {
+			request := mcp.CallToolRequest{}
+			request.Params.Arguments = args
+			result, err := validateTerraformConfigurationHandler(context.Background(), request, logger)
+			require.NoError(t, err)
+			assert.True(t, result.IsError, args)
+		}
+	})
+
+	t.Run("invalid check", func(t *testing.T) {
+		request := mcp.CallToolRequest{}
+		request.Params.Arguments = map[string]any{
+			"files":  map[string]any{"main.tf": ""},
+			"checks": []any{"test"},
+		}
+		result, err := validateTerraformConfigurationHandler(context.Background(), request, logger)
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+	})
+}
@@ -0,0 +1,41 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// withRequestLog logs every call of a tool with its name, session, duration and status as fields,
+// so that log aggregators can index them when the JSON log format is used
+func withRequestLog(tool server.ServerTool, logger *log.Logger) server.ServerTool {
+	handler := tool.Handler
+	tool.Handler = func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		start := time.Now()
+		result, err := handler(ctx, request)
+
+		entry := logger.WithFields(log.Fields{
+			"tool":        tool.Tool.Name,
+			"duration_ms": time.Since(start).Milliseconds(),
+		})
+		if session := server.ClientSessionFromContext(ctx); session != nil && session.SessionID() != "" {
+			entry = entry.WithField("session", session.SessionID())
+		}
+		switch {
+		case err != nil:
+			entry.WithField("status", "error").WithError(err).Warn("Tool call failed")
+		case result != nil && result.IsError:
+			entry.WithField("status", "error").Info("Tool call completed with an error result")
+		default:
+			entry.WithField("status", "success").Info("Tool call completed")
+		}
+		return result, err
+	}
+	return tool
+}
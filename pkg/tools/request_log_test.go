@@ -0,0 +1,49 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithRequestLog(t *testing.T) {
+	logger, hook := test.NewNullLogger()
+
+	call := func(result *mcp.CallToolResult, err error) *log.Entry {
+		hook.Reset()
+		tool := withRequestLog(server.ServerTool{
+			Tool: mcp.NewTool("list_workspaces"),
+			Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+				return result, err
+			},
+		}, logger)
+		_, _ = tool.Handler(context.Background(), mcp.CallToolRequest{})
+		require.Len(t, hook.Entries, 1)
+		return hook.LastEntry()
+	}
+
+	entry := call(mcp.NewToolResultText("ok"), nil)
+	assert.Equal(t, log.InfoLevel, entry.Level)
+	assert.Equal(t, "list_workspaces", entry.Data["tool"])
+	assert.Equal(t, "success", entry.Data["status"])
+	assert.Contains(t, entry.Data, "duration_ms")
+	assert.NotContains(t, entry.Data, "session")
+
+	entry = call(mcp.NewToolResultError("workspace not found"), nil)
+	assert.Equal(t, "error", entry.Data["status"])
+
+	entry = call(nil, errors.New("boom"))
+	assert.Equal(t, log.WarnLevel, entry.Level)
+	assert.Equal(t, "error", entry.Data["status"])
+	assert.Contains(t, entry.Data, log.ErrorKey)
+}
@@ -0,0 +1,83 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// ToolTimeoutsEnv overrides the timeout of the outbound requests of individual tools, as a
+// comma-separated list of tool=duration pairs, e.g. "get_plan_json_output=10m,list_runs=1m"
+const ToolTimeoutsEnv = "MCP_HTTP_TOOL_TIMEOUTS"
+
+// downloadTools are the tools that download configuration archives, state or plan files, whose
+// requests use the download timeout instead of the default one
+var downloadTools = map[string]bool{
+	"get_plan_json_output":                 true,
+	"get_sentinel_mock":                    true,
+	"compare_hcp_terraform_state_versions": true,
+	"promote_workspace_config":             true,
+	"upload_hcp_terraform_configuration":   true,
+	"get_workspace_resource_ownership":     true,
+	"get_workspace_health_assessment":      true,
+	"list_workspace_drifted_resources":     true,
+	"create_run":                           true,
+}
+
+var (
+	toolTimeouts     map[string]time.Duration
+	toolTimeoutsOnce sync.Once
+)
+
+// withRequestTimeout raises the timeout of the outbound requests of the download tools and of the
+// tools listed in MCP_HTTP_TOOL_TIMEOUTS
+func withRequestTimeout(tool server.ServerTool, logger *log.Logger) server.ServerTool {
+	toolTimeoutsOnce.Do(func() {
+		toolTimeouts = parseToolTimeouts(utils.GetEnv(ToolTimeoutsEnv, ""), logger)
+	})
+
+	timeout, ok := toolTimeouts[tool.Tool.Name]
+	if !ok && !downloadTools[tool.Tool.Name] {
+		return tool
+	}
+
+	handler := tool.Handler
+	tool.Handler = func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		if ok {
+			ctx = client.WithRequestTimeout(ctx, timeout)
+		} else {
+			ctx = client.WithDownloadTimeout(ctx)
+		}
+		return handler(ctx, request)
+	}
+	return tool
+}
+
+// parseToolTimeouts parses tool=duration pairs, skipping the invalid ones
+func parseToolTimeouts(value string, logger *log.Logger) map[string]time.Duration {
+	timeouts := make(map[string]time.Duration)
+	for _, pair := range strings.Split(value, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		name, rawTimeout, found := strings.Cut(pair, "=")
+		timeout, err := time.ParseDuration(strings.TrimSpace(rawTimeout))
+		if !found || err != nil || timeout <= 0 {
+			logger.Warnf("Invalid %s entry %q ignored, expected tool=duration", ToolTimeoutsEnv, pair)
+			continue
+		}
+		timeouts[strings.TrimSpace(name)] = timeout
+	}
+	return timeouts
+}
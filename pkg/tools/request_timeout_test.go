@@ -0,0 +1,25 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"testing"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseToolTimeouts(t *testing.T) {
+	logger := log.New()
+	logger.SetLevel(log.ErrorLevel)
+
+	timeouts := parseToolTimeouts(" get_plan_json_output=10m, list_runs = 1m ,invalid,list_workspaces=0,get_run_details=soon", logger)
+	assert.Equal(t, map[string]time.Duration{
+		"get_plan_json_output": 10 * time.Minute,
+		"list_runs":            time.Minute,
+	}, timeouts)
+
+	assert.Empty(t, parseToolTimeouts("", logger))
+}
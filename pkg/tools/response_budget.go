@@ -0,0 +1,208 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// MaxResponseBytesEnv sets the largest text returned by a tool call, in bytes. 0 disables the budget.
+const MaxResponseBytesEnv = "MCP_MAX_RESPONSE_BYTES"
+
+// responseOffsetParam is the optional parameter of the heavyweight tools to continue a truncated response
+const responseOffsetParam = "response_offset"
+
+const (
+	// DefaultMaxResponseBytes is about 50k tokens
+	DefaultMaxResponseBytes = 200000
+	minMaxResponseBytes     = 1000
+)
+
+// ResponseTruncation is appended to the results truncated to the response budget
+type ResponseTruncation struct {
+	Truncated          bool   `json:"truncated"`
+	ReturnedBytes      int    `json:"returned_bytes"`
+	TotalBytes         int    `json:"total_bytes"`
+	ResponseOffset     int    `json:"response_offset,omitempty"`
+	NextResponseOffset int    `json:"next_response_offset,omitempty"`
+	Hint               string `json:"hint"`
+}
+
+var (
+	sharedMaxResponseBytes    int
+	sharedMaxResponseBytesSet bool
+	sharedMaxResponseBytesMu  sync.Mutex
+)
+
+// SetMaxResponseBytes sets the response budget of the tools registered afterwards, such as the one
+// given on the command line. Negative values fall back to MCP_MAX_RESPONSE_BYTES.
+func SetMaxResponseBytes(maxBytes int) {
+	sharedMaxResponseBytesMu.Lock()
+	defer sharedMaxResponseBytesMu.Unlock()
+	sharedMaxResponseBytes = maxBytes
+	sharedMaxResponseBytesSet = maxBytes >= 0
+}
+
+// maxResponseBytes returns the response budget of the tools, or 0 when it is disabled
+func maxResponseBytes(logger *log.Logger) int {
+	sharedMaxResponseBytesMu.Lock()
+	defer sharedMaxResponseBytesMu.Unlock()
+	if sharedMaxResponseBytesSet {
+		return sharedMaxResponseBytes
+	}
+
+	value := strings.TrimSpace(utils.GetEnv(MaxResponseBytesEnv, ""))
+	if value == "" {
+		return DefaultMaxResponseBytes
+	}
+	maxBytes, err := strconv.Atoi(value)
+	if err != nil || maxBytes < 0 || (maxBytes > 0 && maxBytes < minMaxResponseBytes) {
+		logger.Warnf("Invalid %s '%s', must be 0 or a number of bytes of at least %d, using %d", MaxResponseBytesEnv, value, minMaxResponseBytes, DefaultMaxResponseBytes)
+		return DefaultMaxResponseBytes
+	}
+	return maxBytes
+}
+
+// withResponseBudget truncates the text of the results larger than the response budget and appends
+// a truncation notice. The heavyweight tools, whose responses are the same when called again, accept
+// a "response_offset" to continue a truncated response where it stopped.
+func withResponseBudget(tool server.ServerTool, logger *log.Logger) server.ServerTool {
+	maxBytes := maxResponseBytes(logger)
+	if maxBytes == 0 {
+		return tool
+	}
+
+	continuable := dryFetchTools[tool.Tool.Name] && tool.Tool.RawInputSchema == nil
+	if continuable {
+		if tool.Tool.InputSchema.Properties == nil {
+			tool.Tool.InputSchema.Properties = make(map[string]any)
+		}
+		tool.Tool.InputSchema.Properties[responseOffsetParam] = map[string]any{
+			"type":        "integer",
+			"description": "Byte offset to continue a truncated response from, given by next_response_offset in its truncation notice. Call the tool again with the same arguments",
+			"minimum":     0,
+		}
+	}
+
+	handler := tool.Handler
+	tool.Handler = func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		offset := 0
+		if continuable {
+			offset = request.GetInt(responseOffsetParam, 0)
+			if offset < 0 {
+				return mcp.NewToolResultError(fmt.Sprintf("%s cannot be negative", responseOffsetParam)), nil
+			}
+		}
+
+		result, err := handler(ctx, request)
+		if err != nil || result == nil || result.IsError {
+			return result, err
+		}
+		return budgetResult(result, offset, maxBytes, continuable), nil
+	}
+	return tool
+}
+
+// budgetResult returns the part of a result starting at offset that fits the budget, with a
+// truncation notice when the result doesn't fit
+func budgetResult(result *mcp.CallToolResult, offset, maxBytes int, continuable bool) *mcp.CallToolResult {
+	text, ok := singleTextContent(result)
+	if offset > 0 {
+		switch {
+		case !ok:
+			return mcp.NewToolResultError(fmt.Sprintf("%s can only continue text responses", responseOffsetParam))
+		case offset >= len(text):
+			return mcp.NewToolResultError(fmt.Sprintf("%s %d is beyond the end of the response, which is %d bytes", responseOffsetParam, offset, len(text)))
+		}
+	} else if resultTextBytes(result) <= maxBytes {
+		return result
+	}
+
+	notice := ResponseTruncation{Truncated: true, ResponseOffset: offset}
+	var content []mcp.Content
+	if ok {
+		part, next := utils.TextRange(text, offset, maxBytes)
+		content = []mcp.Content{mcp.NewTextContent(part)}
+		notice.ReturnedBytes = len(part)
+		notice.TotalBytes = len(text)
+		if next > 0 && continuable {
+			notice.NextResponseOffset = next
+		}
+		if next == 0 {
+			notice.Truncated = false
+		}
+	} else {
+		content, notice.ReturnedBytes = budgetContents(result.Content, maxBytes)
+		notice.TotalBytes = resultTextBytes(result)
+	}
+
+	switch {
+	case !notice.Truncated:
+		notice.Hint = "This is the last part of the response"
+	case notice.NextResponseOffset > 0:
+		notice.Hint = fmt.Sprintf("The response is larger than the %d bytes budget of the server. Call the tool again with the same arguments and %s %d to get the next part, or narrow it with result_filter, pagination or a more specific query", maxBytes, responseOffsetParam, notice.NextResponseOffset)
+	default:
+		notice.Hint = fmt.Sprintf("The response is larger than the %d bytes budget of the server and was cut. Narrow it with result_filter, pagination or a more specific query", maxBytes)
+	}
+	buf, err := json.Marshal(notice)
+	if err == nil {
+		content = append(content, mcp.NewTextContent(string(buf)))
+	}
+
+	// The structured content would carry the whole response, the truncated text is returned instead
+	return &mcp.CallToolResult{Result: result.Result, Content: content}
+}
+
+// singleTextContent returns the text of a result made of a single text content
+func singleTextContent(result *mcp.CallToolResult) (string, bool) {
+	if len(result.Content) != 1 {
+		return "", false
+	}
+	text, ok := result.Content[0].(mcp.TextContent)
+	return text.Text, ok
+}
+
+// resultTextBytes returns the size of the text contents of a result
+func resultTextBytes(result *mcp.CallToolResult) int {
+	size := 0
+	for _, c := range result.Content {
+		if text, ok := c.(mcp.TextContent); ok {
+			size += len(text.Text)
+		}
+	}
+	return size
+}
+
+// budgetContents keeps the contents of a result until their text reaches the budget, cutting the
+// text content that crosses it, and returns them with the size of their text
+func budgetContents(contents []mcp.Content, maxBytes int) ([]mcp.Content, int) {
+	var kept []mcp.Content
+	remaining := maxBytes
+	for _, c := range contents {
+		text, ok := c.(mcp.TextContent)
+		if !ok {
+			kept = append(kept, c)
+			continue
+		}
+		if remaining <= 0 {
+			break
+		}
+		if len(text.Text) > remaining {
+			text.Text, _ = utils.TextRange(text.Text, 0, remaining)
+		}
+		remaining -= len(text.Text)
+		kept = append(kept, text)
+	}
+	return kept, maxBytes - remaining
+}
@@ -0,0 +1,139 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaxResponseBytes(t *testing.T) {
+	logger := log.New()
+	logger.SetOutput(io.Discard)
+	t.Cleanup(func() { SetMaxResponseBytes(-1) })
+
+	SetMaxResponseBytes(-1)
+	t.Setenv(MaxResponseBytesEnv, "")
+	assert.Equal(t, DefaultMaxResponseBytes, maxResponseBytes(logger))
+
+	t.Setenv(MaxResponseBytesEnv, "50000")
+	assert.Equal(t, 50000, maxResponseBytes(logger))
+
+	t.Setenv(MaxResponseBytesEnv, "0")
+	assert.Zero(t, maxResponseBytes(logger))
+
+	for _, invalid := range []string{"10", "-1", "lots"} {
+		t.Setenv(MaxResponseBytesEnv, invalid)
+		assert.Equal(t, DefaultMaxResponseBytes, maxResponseBytes(logger), invalid)
+	}
+
+	SetMaxResponseBytes(2000)
+	assert.Equal(t, 2000, maxResponseBytes(logger))
+}
+
+func TestWithResponseBudget(t *testing.T) {
+	logger := log.New()
+	logger.SetOutput(io.Discard)
+	SetMaxResponseBytes(1000)
+	t.Cleanup(func() { SetMaxResponseBytes(-1) })
+
+	logs := strings.Repeat(strings.Repeat("x", 99)+"\n", 25)
+	newTool := func(name string, result func() *mcp.CallToolResult) server.ServerTool {
+		return withResponseBudget(server.ServerTool{
+			Tool: mcp.NewTool(name),
+			Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+				return result(), nil
+			},
+		}, logger)
+	}
+	call := func(tool server.ServerTool, args map[string]any) *mcp.CallToolResult {
+		request := mcp.CallToolRequest{}
+		request.Params.Arguments = args
+		result, err := tool.Handler(context.Background(), request)
+		require.NoError(t, err)
+		return result
+	}
+	notice := func(result *mcp.CallToolResult) ResponseTruncation {
+		require.Len(t, result.Content, 2)
+		var truncation ResponseTruncation
+		require.NoError(t, json.Unmarshal([]byte(result.Content[1].(mcp.TextContent).Text), &truncation))
+		return truncation
+	}
+
+	t.Run("results within the budget are unchanged", func(t *testing.T) {
+		result := mcp.NewToolResultText("small")
+		result.StructuredContent = map[string]any{"items": []any{}}
+		tool := newTool("list_runs", func() *mcp.CallToolResult { return result })
+		assert.NotContains(t, tool.Tool.InputSchema.Properties, responseOffsetParam)
+		assert.Same(t, result, call(tool, map[string]any{}))
+	})
+
+	t.Run("heavyweight tools continue truncated responses", func(t *testing.T) {
+		tool := newTool("get_plan_logs", func() *mcp.CallToolResult { return mcp.NewToolResultText(logs) })
+		assert.Contains(t, tool.Tool.InputSchema.Properties, responseOffsetParam)
+
+		var joined strings.Builder
+		offset := 0
+		for parts := 0; ; parts++ {
+			require.Less(t, parts, 5)
+			result := call(tool, map[string]any{responseOffsetParam: offset})
+			require.False(t, result.IsError)
+			truncation := notice(result)
+			assert.Equal(t, len(logs), truncation.TotalBytes)
+			assert.LessOrEqual(t, truncation.ReturnedBytes, 1000)
+			joined.WriteString(result.Content[0].(mcp.TextContent).Text)
+			if !truncation.Truncated {
+				assert.Zero(t, truncation.NextResponseOffset)
+				break
+			}
+			offset = truncation.NextResponseOffset
+		}
+		assert.Equal(t, logs, joined.String())
+
+		result := call(tool, map[string]any{responseOffsetParam: len(logs)})
+		assert.True(t, result.IsError)
+	})
+
+	t.Run("other tools are cut", func(t *testing.T) {
+		tool := newTool("list_runs", func() *mcp.CallToolResult {
+			result := mcp.NewToolResultText(logs)
+			result.StructuredContent = map[string]any{"logs": logs}
+			return result
+		})
+		result := call(tool, map[string]any{responseOffsetParam: 1000})
+		truncation := notice(result)
+		assert.True(t, truncation.Truncated)
+		assert.Zero(t, truncation.ResponseOffset)
+		assert.Zero(t, truncation.NextResponseOffset)
+		assert.Contains(t, truncation.Hint, "was cut")
+		assert.Nil(t, result.StructuredContent)
+	})
+
+	t.Run("results with several contents", func(t *testing.T) {
+		tool := newTool("list_runs", func() *mcp.CallToolResult {
+			return &mcp.CallToolResult{Content: []mcp.Content{mcp.NewTextContent(logs[:600]), mcp.NewTextContent(logs[:600]), mcp.NewTextContent("dropped")}}
+		})
+		result := call(tool, map[string]any{})
+		require.Len(t, result.Content, 3)
+		assert.Equal(t, logs[:600], result.Content[0].(mcp.TextContent).Text)
+		assert.Equal(t, logs[:400], result.Content[1].(mcp.TextContent).Text)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		SetMaxResponseBytes(0)
+		t.Cleanup(func() { SetMaxResponseBytes(1000) })
+		tool := newTool("get_plan_logs", func() *mcp.CallToolResult { return mcp.NewToolResultText(logs) })
+		assert.NotContains(t, tool.Tool.InputSchema.Properties, responseOffsetParam)
+		assert.Equal(t, logs, call(tool, map[string]any{}).Content[0].(mcp.TextContent).Text)
+	})
+}
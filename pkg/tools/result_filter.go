@@ -0,0 +1,88 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/jmespath/go-jmespath"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// resultFilterParam is the optional parameter accepted by every built-in tool
+const resultFilterParam = "result_filter"
+
+// withResultFilter adds the "result_filter" parameter to a tool and wraps its handler to apply
+// the JMESPath expression to the JSON result before it is returned. Tools declared with a raw
+// input schema are returned unchanged.
+func withResultFilter(tool server.ServerTool) server.ServerTool {
+	if tool.Tool.RawInputSchema != nil {
+		return tool
+	}
+
+	if tool.Tool.InputSchema.Properties == nil {
+		tool.Tool.InputSchema.Properties = make(map[string]any)
+	}
+	tool.Tool.InputSchema.Properties[resultFilterParam] = map[string]any{
+		"type":        "string",
+		"description": "Optional JMESPath expression applied to the JSON result to return only the fields you need, e.g. 'items[].{id: id, name: workspace_name}' or 'data[?attributes.status==`errored`].id'",
+	}
+
+	handler := tool.Handler
+	tool.Handler = func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		expression := request.GetString(resultFilterParam, "")
+		if expression == "" {
+			return handler(ctx, request)
+		}
+
+		filter, err := jmespath.Compile(expression)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("invalid result_filter %q: %v", expression, err)), nil
+		}
+
+		result, err := handler(ctx, request)
+		if err != nil || result == nil || result.IsError {
+			return result, err
+		}
+		return filterResult(result, filter)
+	}
+	return tool
+}
+
+// filterResult applies a compiled JMESPath expression to every text content of a result
+func filterResult(result *mcp.CallToolResult, filter *jmespath.JMESPath) (*mcp.CallToolResult, error) {
+	content := make([]mcp.Content, len(result.Content))
+	for i, c := range result.Content {
+		text, ok := c.(mcp.TextContent)
+		if !ok {
+			content[i] = c
+			continue
+		}
+
+		var data any
+		if err := json.Unmarshal([]byte(text.Text), &data); err != nil {
+			return mcp.NewToolResultError("result_filter can only be applied to JSON results, request the JSON output format of this tool"), nil
+		}
+
+		filtered, err := filter.Search(data)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("failed to apply result_filter: %v", err)), nil
+		}
+
+		buf, err := json.Marshal(filtered)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("failed to marshal filtered result: %v", err)), nil
+		}
+		text.Text = string(buf)
+		content[i] = text
+	}
+
+	filteredResult := *result
+	filteredResult.Content = content
+	filteredResult.StructuredContent = nil
+	return &filteredResult, nil
+}
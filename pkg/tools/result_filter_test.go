@@ -0,0 +1,82 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithResultFilter(t *testing.T) {
+	newTool := func(text string) server.ServerTool {
+		return withResultFilter(server.ServerTool{
+			Tool: mcp.NewTool("test_tool", mcp.WithString("name")),
+			Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+				return mcp.NewToolResultText(text), nil
+			},
+		})
+	}
+	call := func(tool server.ServerTool, filter string) *mcp.CallToolResult {
+		request := mcp.CallToolRequest{}
+		request.Params.Arguments = map[string]any{"result_filter": filter}
+		result, err := tool.Handler(context.Background(), request)
+		require.NoError(t, err)
+		return result
+	}
+	text := func(result *mcp.CallToolResult) string {
+		return result.Content[0].(mcp.TextContent).Text
+	}
+
+	workspaces, err := json.Marshal(map[string]any{
+		"items": []map[string]any{
+			{"id": "ws-1", "workspace_name": "one", "environment": "prod"},
+			{"id": "ws-2", "workspace_name": "two", "environment": "dev"},
+		},
+	})
+	require.NoError(t, err)
+
+	t.Run("adds the parameter", func(t *testing.T) {
+		tool := newTool(string(workspaces))
+		assert.Contains(t, tool.Tool.InputSchema.Properties, "result_filter")
+		assert.Contains(t, tool.Tool.InputSchema.Properties, "name")
+		assert.NotContains(t, tool.Tool.InputSchema.Required, "result_filter")
+	})
+
+	t.Run("unfiltered", func(t *testing.T) {
+		result := call(newTool(string(workspaces)), "")
+		assert.False(t, result.IsError)
+		assert.Equal(t, string(workspaces), text(result))
+	})
+
+	t.Run("filtered", func(t *testing.T) {
+		result := call(newTool(string(workspaces)), "items[?environment=='prod'].workspace_name")
+		assert.False(t, result.IsError)
+		assert.JSONEq(t, `["one"]`, text(result))
+	})
+
+	t.Run("invalid expression", func(t *testing.T) {
+		result := call(newTool(string(workspaces)), "items[")
+		assert.True(t, result.IsError)
+		assert.Contains(t, text(result), "invalid result_filter")
+	})
+
+	t.Run("non JSON result", func(t *testing.T) {
+		result := call(newTool("# Workspace"), "items")
+		assert.True(t, result.IsError)
+		assert.Contains(t, text(result), "JSON results")
+	})
+
+	t.Run("raw schema tools are unchanged", func(t *testing.T) {
+		tool := withResultFilter(server.ServerTool{
+			Tool: mcp.NewToolWithRawSchema("raw_tool", "", json.RawMessage(`{"type":"object"}`)),
+		})
+		assert.Nil(t, tool.Tool.InputSchema.Properties)
+	})
+}
@@ -0,0 +1,151 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	registryTools "github.com/hashicorp/terraform-mcp-server/pkg/tools/registry"
+	tfeTools "github.com/hashicorp/terraform-mcp-server/pkg/tools/tfe"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// structuredItems is the structured content of tools whose JSON result is an array, as structured
+// content must be a JSON object
+type structuredItems[T any] struct {
+	Items []T `json:"items"`
+}
+
+// toolOutputSchemas are the output schemas of the tools whose successful results are always JSON
+var toolOutputSchemas = map[string]mcp.ToolOption{
+	// Registry tools
+	"get_latest_provider_version":          mcp.WithOutputSchema[registryTools.LatestVersion](),
+	"list_provider_versions":               mcp.WithOutputSchema[registryTools.ProviderVersionList](),
+	"resolve_provider_version":             mcp.WithOutputSchema[registryTools.ProviderVersionResolution](),
+	"get_latest_module_version":            mcp.WithOutputSchema[registryTools.LatestVersion](),
+	"autocomplete_service_slug":            mcp.WithOutputSchema[registryTools.SlugSuggestions](),
+	"get_provider_stats":                   mcp.WithOutputSchema[registryTools.ProviderStats](),
+	"compare_provider_versions":            mcp.WithOutputSchema[registryTools.ProviderVersionComparison](),
+	"get_provider_schema":                  mcp.WithOutputSchema[registryTools.ProviderSchema](),
+	"generate_terraform_scaffold":          mcp.WithOutputSchema[registryTools.TerraformScaffold](),
+	"validate_terraform_configuration":     mcp.WithOutputSchema[registryTools.TerraformChecks](),
+	"check_module_terraform_compatibility": mcp.WithOutputSchema[registryTools.ModuleCompatibility](),
+	"list_module_versions":                 mcp.WithOutputSchema[registryTools.ModuleVersionList](),
+	"get_module_version_diff":              mcp.WithOutputSchema[registryTools.ModuleVersionDiff](),
+	"batch_call":                           mcp.WithOutputSchema[BatchCallResult](),
+	"diagnose_connectivity":                mcp.WithOutputSchema[registryTools.ConnectivityDiagnosis](),
+
+	// Terraform tools
+	"list_terraform_orgs":                         mcp.WithOutputSchema[tfeTools.OrganizationSummaryList](),
+	"list_terraform_projects":                     mcp.WithOutputSchema[tfeTools.ProjectSummaryList](),
+	"list_workspaces":                             mcp.WithOutputSchema[tfeTools.WorkspaceSummaryList](),
+	"get_workspace_resource_ownership":            mcp.WithOutputSchema[tfeTools.ResourceOwnership](),
+	"get_workspace_inventory":                     mcp.WithOutputSchema[tfeTools.WorkspaceInventoryResult](),
+	"query_hcp_terraform_explorer":                mcp.WithOutputSchema[client.ExplorerResult](),
+	"get_workspace_health_assessment":             mcp.WithOutputSchema[tfeTools.WorkspaceHealthAssessment](),
+	"list_workspace_drifted_resources":            mcp.WithOutputSchema[tfeTools.DriftedResources](),
+	"get_state_resources":                         mcp.WithOutputSchema[tfeTools.StateResources](),
+	"list_runs":                                   mcp.WithOutputSchema[tfeTools.RunSummaryList](),
+	"get_hcp_terraform_org_run_queue":             mcp.WithOutputSchema[tfeTools.OrgRunQueue](),
+	"list_stacks":                                 mcp.WithOutputSchema[tfeTools.StackSummaryList](),
+	"wait_for_run":                                mcp.WithOutputSchema[tfeTools.RunWaitResult](),
+	"wait_for_configuration_version":              mcp.WithOutputSchema[tfeTools.ConfigurationVersionWaitResult](),
+	"upload_hcp_terraform_configuration":          mcp.WithOutputSchema[tfeTools.ConfigurationUpload](),
+	"retry_hcp_terraform_run":                     mcp.WithOutputSchema[tfeTools.RunRetry](),
+	"diagnose_hcp_terraform_run":                  mcp.WithOutputSchema[tfeTools.RunDiagnosis](),
+	"get_hcp_terraform_cost_estimate":             mcp.WithOutputSchema[tfeTools.RunCostEstimate](),
+	"promote_workspace_config":                    mcp.WithOutputSchema[tfeTools.WorkspacePromotion](),
+	"run_guarded_deployment":                      mcp.WithOutputSchema[tfeTools.GuardedDeploymentResult](),
+	"delete_hcp_terraform_workspace":              mcp.WithOutputSchema[tfeTools.WorkspaceDeletion](),
+	"explain_hcp_terraform_variable_resolution":   mcp.WithOutputSchema[tfeTools.VariableResolution](),
+	"create_workspace_from_blueprint":             mcp.WithOutputSchema[tfeTools.BlueprintWorkspace](),
+	"bulk_update_workspaces":                      mcp.WithOutputSchema[tfeTools.WorkspaceBulkUpdate](),
+	"enforce_terraform_version_policy":            mcp.WithOutputSchema[tfeTools.TerraformVersionPolicyReport](),
+	"list_terraform_versions":                     mcp.WithOutputSchema[tfeTools.TerraformVersionList](),
+	"check_workspace_terraform_versions":          mcp.WithOutputSchema[tfeTools.WorkspaceTerraformVersionReport](),
+	"list_policy_overrides":                       mcp.WithOutputSchema[client.PolicyOverrideReport](),
+	"list_run_tasks":                              mcp.WithOutputSchema[structuredItems[tfeTools.RunTaskSummary]](),
+	"attach_run_task_to_workspace":                mcp.WithOutputSchema[tfeTools.WorkspaceRunTaskResult](),
+	"list_run_task_results":                       mcp.WithOutputSchema[client.RunTaskResults](),
+	"list_agent_pools":                            mcp.WithOutputSchema[structuredItems[client.AgentPoolSummary]](),
+	"get_agent_pool_details":                      mcp.WithOutputSchema[client.AgentPoolDetails](),
+	"list_agent_pool_agents":                      mcp.WithOutputSchema[structuredItems[client.AgentInfo]](),
+	"assign_workspace_agent_pool":                 mcp.WithOutputSchema[tfeTools.WorkspaceAgentPoolResult](),
+	"list_registry_gpg_keys":                      mcp.WithOutputSchema[structuredItems[tfeTools.GPGKeySummary]](),
+	"create_registry_gpg_key":                     mcp.WithOutputSchema[tfeTools.GPGKeySummary](),
+	"create_private_provider_version":             mcp.WithOutputSchema[tfeTools.PrivateProviderVersionUploads](),
+	"create_private_provider_platform":            mcp.WithOutputSchema[tfeTools.PrivateProviderPlatformUpload](),
+	"get_private_provider_upload_urls":            mcp.WithOutputSchema[tfeTools.PrivateProviderVersionUploads](),
+	"create_private_module":                       mcp.WithOutputSchema[tfeTools.PrivateModuleSummary](),
+	"create_private_module_version":               mcp.WithOutputSchema[tfeTools.PrivateModuleVersionUpload](),
+	"list_no_code_modules":                        mcp.WithOutputSchema[structuredItems[tfeTools.NoCodeModuleSummary]](),
+	"set_hcp_terraform_credentials":               mcp.WithOutputSchema[SessionCredentialsResult](),
+	"list_run_comments":                           mcp.WithOutputSchema[structuredItems[tfeTools.RunComment]](),
+	"create_run_comment":                          mcp.WithOutputSchema[tfeTools.RunComment](),
+	"get_hcp_terraform_activity_report":           mcp.WithOutputSchema[tfeTools.ActivityReport](),
+	"list_locked_workspaces":                      mcp.WithOutputSchema[tfeTools.LockedWorkspaces](),
+	"create_project_tags":                         mcp.WithOutputSchema[tfeTools.ProjectTags](),
+	"read_project_tags":                           mcp.WithOutputSchema[tfeTools.ProjectTags](),
+	"delete_project_tags":                         mcp.WithOutputSchema[tfeTools.ProjectTags](),
+	"list_oauth_clients":                          mcp.WithOutputSchema[structuredItems[tfeTools.OAuthClientSummary]](),
+	"list_oauth_tokens":                           mcp.WithOutputSchema[structuredItems[tfeTools.OAuthTokenSummary]](),
+	"list_github_app_installations":               mcp.WithOutputSchema[structuredItems[tfeTools.GitHubAppInstallation]](),
+	"list_ssh_keys":                               mcp.WithOutputSchema[structuredItems[tfeTools.SSHKeySummary]](),
+	"create_ssh_key":                              mcp.WithOutputSchema[tfeTools.SSHKeySummary](),
+	"assign_workspace_ssh_key":                    mcp.WithOutputSchema[tfeTools.WorkspaceSSHKeyResult](),
+	"unassign_workspace_ssh_key":                  mcp.WithOutputSchema[tfeTools.WorkspaceSSHKeyResult](),
+	"list_workspace_notification_configurations":  mcp.WithOutputSchema[structuredItems[client.NotificationConfigurationInfo]](),
+	"create_workspace_notification_configuration": mcp.WithOutputSchema[client.NotificationConfigurationInfo](),
+	"update_workspace_notification_configuration": mcp.WithOutputSchema[client.NotificationConfigurationInfo](),
+}
+
+// withStructuredContent returns the JSON results of a tool as structuredContent alongside their text,
+// wrapping arrays in an "items" object, and declares the tool's output schema when it is known. Text
+// results that are not JSON, such as Markdown docs and logs, are returned unchanged.
+func withStructuredContent(tool server.ServerTool) server.ServerTool {
+	if option, ok := toolOutputSchemas[tool.Tool.Name]; ok && tool.Tool.OutputSchema.Type == "" && tool.Tool.RawOutputSchema == nil {
+		option(&tool.Tool)
+	}
+
+	handler := tool.Handler
+	tool.Handler = func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		result, err := handler(ctx, request)
+		if err != nil || result == nil || result.IsError || result.StructuredContent != nil {
+			return result, err
+		}
+		if structured, ok := structuredContent(result); ok {
+			result.StructuredContent = structured
+		}
+		return result, nil
+	}
+	return tool
+}
+
+// structuredContent parses the text of a result made of a single JSON text content
+func structuredContent(result *mcp.CallToolResult) (any, bool) {
+	if len(result.Content) != 1 {
+		return nil, false
+	}
+	text, ok := result.Content[0].(mcp.TextContent)
+	if !ok {
+		return nil, false
+	}
+	trimmed := strings.TrimSpace(text.Text)
+	if !strings.HasPrefix(trimmed, "{") && !strings.HasPrefix(trimmed, "[") {
+		return nil, false
+	}
+
+	var data any
+	if err := json.Unmarshal([]byte(trimmed), &data); err != nil {
+		return nil, false
+	}
+	if object, ok := data.(map[string]any); ok {
+		return object, true
+	}
+	return map[string]any{"items": data}, true
+}
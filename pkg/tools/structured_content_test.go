@@ -0,0 +1,62 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithStructuredContent(t *testing.T) {
+	call := func(name string, result *mcp.CallToolResult) (server.ServerTool, *mcp.CallToolResult) {
+		tool := withStructuredContent(server.ServerTool{
+			Tool: mcp.NewTool(name),
+			Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+				return result, nil
+			},
+		})
+		result, err := tool.Handler(context.Background(), mcp.CallToolRequest{})
+		require.NoError(t, err)
+		return tool, result
+	}
+
+	t.Run("JSON object", func(t *testing.T) {
+		_, result := call("test_tool", mcp.NewToolResultText(`{"id":"ws-1","name":"one"}`))
+		assert.Equal(t, map[string]any{"id": "ws-1", "name": "one"}, result.StructuredContent)
+	})
+
+	t.Run("JSON array", func(t *testing.T) {
+		_, result := call("test_tool", mcp.NewToolResultText(`[{"id":"sshkey-1"}]`))
+		assert.Equal(t, map[string]any{"items": []any{map[string]any{"id": "sshkey-1"}}}, result.StructuredContent)
+	})
+
+	t.Run("non JSON result", func(t *testing.T) {
+		_, result := call("test_tool", mcp.NewToolResultText("# Workspace"))
+		assert.Nil(t, result.StructuredContent)
+	})
+
+	t.Run("error result", func(t *testing.T) {
+		_, result := call("test_tool", mcp.NewToolResultError(`{"error":"not found"}`))
+		assert.Nil(t, result.StructuredContent)
+	})
+
+	t.Run("existing structured content", func(t *testing.T) {
+		_, result := call("test_tool", mcp.NewToolResultStructured(map[string]any{"version": "1.0.0"}, "1.0.0 (stable)"))
+		assert.Equal(t, map[string]any{"version": "1.0.0"}, result.StructuredContent)
+	})
+
+	t.Run("output schema", func(t *testing.T) {
+		tool, _ := call("list_ssh_keys", mcp.NewToolResultText(`[]`))
+		assert.Equal(t, "object", tool.Tool.OutputSchema.Type)
+		assert.Contains(t, tool.Tool.OutputSchema.Properties, "items")
+
+		tool, _ = call("test_tool", mcp.NewToolResultText(`{}`))
+		assert.Empty(t, tool.Tool.OutputSchema.Type)
+	})
+}
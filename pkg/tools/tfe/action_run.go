@@ -6,6 +6,8 @@ package tools
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"time"
 
 	"github.com/hashicorp/go-tfe"
 	"github.com/hashicorp/terraform-mcp-server/pkg/client"
@@ -14,18 +16,24 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
+// forceCancelStateRisk explains what force canceling a run during its apply may do to the state
+const forceCancelStateRisk = "The run is applying. Force canceling it ends the apply immediately, without letting Terraform finish its current operations or write the final state. " +
+	"Resources being created, updated or destroyed may be left partially changed, the state may not record resources that were created or may be missing the changes made, " +
+	"and the workspace lock may need to be released with force_unlock_workspace. Review the workspace state and the provider's infrastructure after the cancellation, " +
+	"and reconcile them with a refresh-only or import run before applying again."
+
 // ActionRun creates a tool to apply, discard or cancel a Terraform run
 func ActionRun(logger *log.Logger) server.ServerTool {
 	return server.ServerTool{
 		Tool: mcp.NewTool("action_run",
-			mcp.WithDescription(`Performs a variety of actions on a Terraform run. It can be used to approve and apply, discard or cancel a run.`),
+			mcp.WithDescription(`Performs a variety of actions on a Terraform run. It can be used to approve and apply, discard, cancel or force cancel a run. Force canceling is only possible once a cancel request was sent and the cool-off period passed; force canceling a run during its apply requires acknowledge_state_risk, because it can leave the state out of sync with the infrastructure.`),
 			mcp.WithTitleAnnotation("Apply, Discard or Cancel a Terraform run"),
 			mcp.WithReadOnlyHintAnnotation(false),
 			mcp.WithDestructiveHintAnnotation(true),
 			mcp.WithString("run_action",
 				mcp.Required(),
-				mcp.Description("The action to perform on the run (e.g., 'apply', 'discard', 'cancel')"),
-				mcp.Enum("apply", "discard", "cancel"),
+				mcp.Description("The action to perform on the run (e.g., 'apply', 'discard', 'cancel', 'force_cancel')"),
+				mcp.Enum("apply", "discard", "cancel", "force_cancel"),
 			),
 			mcp.WithString("run_id",
 				mcp.Required(),
@@ -35,6 +43,11 @@ func ActionRun(logger *log.Logger) server.ServerTool {
 			mcp.WithString("comment",
 				mcp.Description("Optional comment for the action"),
 			),
+			mcp.WithBoolean("acknowledge_state_risk",
+				mcp.Description("Required to force cancel a run that is applying, confirming the user accepts that the state may be left partially updated or out of sync with the infrastructure"),
+				mcp.DefaultBool(false),
+			),
+			withOnBehalfOf(),
 		),
 		Handler: func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			return actionRunHandler(ctx, req, logger)
@@ -53,14 +66,15 @@ func actionRunHandler(ctx context.Context, request mcp.CallToolRequest, logger *
 		return ToolError(logger, "missing required input: run_id", err)
 	}
 
-	comment := request.GetString("comment", "Triggered via Terraform MCP Server")
+	requester := onBehalfOf(request)
+	comment := annotateRequester(request.GetString("comment", "Triggered via Terraform MCP Server"), requester)
 
 	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
 	if err != nil {
 		return ToolError(logger, "failed to get Terraform client", err)
 	}
 
-	var msg string
+	var msg, stateRisk string
 	switch runAction {
 	case "apply":
 		err = tfeClient.Runs.Apply(ctx, runID, tfe.RunApplyOptions{Comment: &comment})
@@ -71,19 +85,37 @@ func actionRunHandler(ctx context.Context, request mcp.CallToolRequest, logger *
 	case "cancel":
 		err = tfeClient.Runs.Cancel(ctx, runID, tfe.RunCancelOptions{Comment: &comment})
 		msg = "Run canceled successfully"
+	case "force_cancel":
+		var run *tfe.Run
+		run, err = tfeClient.Runs.ReadWithOptions(ctx, runID, &tfe.RunReadOptions{Include: []tfe.RunIncludeOpt{tfe.RunApply}})
+		if err != nil {
+			return ToolErrorf(logger, "failed to read run %s: %v", runID, err)
+		}
+		stateRisk, err = checkForceCancel(run, request.GetBool("acknowledge_state_risk", false), time.Now())
+		if err != nil {
+			return ToolError(logger, err.Error(), nil)
+		}
+		err = tfeClient.Runs.ForceCancel(ctx, runID, tfe.RunForceCancelOptions{Comment: &comment})
+		msg = "Run force canceled successfully"
 	default:
-		return ToolErrorf(logger, "invalid run_action: %s - must be 'apply', 'discard', or 'cancel'", runAction)
+		return ToolErrorf(logger, "invalid run_action: %s - must be 'apply', 'discard', 'cancel' or 'force_cancel'", runAction)
 	}
 
 	if err != nil {
 		return ToolErrorf(logger, "failed to %s run %s: %v", runAction, runID, err)
 	}
+	auditLog(logger, runAction+"_run", requester, log.Fields{
+		"run_id": runID,
+	})
 
 	result := map[string]interface{}{
 		"success": true,
 		"message": msg,
 		"run_id":  runID,
 	}
+	if stateRisk != "" {
+		result["state_risk"] = stateRisk
+	}
 
 	resultJSON, err := json.Marshal(result)
 	if err != nil {
@@ -91,3 +123,33 @@ func actionRunHandler(ctx context.Context, request mcp.CallToolRequest, logger *
 	}
 	return mcp.NewToolResultText(string(resultJSON)), nil
 }
+
+// isApplying reports whether a run is in its apply phase, when canceling it can leave the state
+// partially updated
+func isApplying(run *tfe.Run) bool {
+	if run.Status == tfe.RunApplying {
+		return true
+	}
+	return run.Apply != nil && run.Apply.Status == tfe.ApplyRunning
+}
+
+// checkForceCancel checks that a run can be force canceled. It returns the state corruption risk
+// to report when the run is applying, which requires the risk to be acknowledged.
+func checkForceCancel(run *tfe.Run, acknowledgeStateRisk bool, now time.Time) (string, error) {
+	if run.Actions != nil && !run.Actions.IsForceCancelable {
+		if run.ForceCancelAvailableAt.IsZero() {
+			return "", fmt.Errorf("run %s cannot be force canceled (status %s) - cancel it with run_action 'cancel' first, force canceling is available after a cool-off period", run.ID, run.Status)
+		}
+		if wait := run.ForceCancelAvailableAt.Sub(now); wait > 0 {
+			return "", fmt.Errorf("run %s cannot be force canceled yet - the cancel request cool-off period ends in %s, at %s", run.ID, wait.Round(time.Second), run.ForceCancelAvailableAt.Format(time.RFC3339))
+		}
+	}
+
+	if !isApplying(run) {
+		return "", nil
+	}
+	if !acknowledgeStateRisk {
+		return "", fmt.Errorf("run %s is applying and force canceling it risks corrupting the state. %s Ask the user to confirm, then retry with acknowledge_state_risk set to true", run.ID, forceCancelStateRisk)
+	}
+	return forceCancelStateRisk, nil
+}
@@ -0,0 +1,63 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-tfe"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestActionRun(t *testing.T) {
+	logger := log.New()
+	logger.SetLevel(log.ErrorLevel)
+
+	t.Run("tool creation", func(t *testing.T) {
+		tool := ActionRun(logger)
+
+		assert.Equal(t, "action_run", tool.Tool.Name)
+		assert.True(t, *tool.Tool.Annotations.DestructiveHint)
+		assert.Contains(t, tool.Tool.InputSchema.Properties, "acknowledge_state_risk")
+		assert.NotContains(t, tool.Tool.InputSchema.Required, "acknowledge_state_risk")
+	})
+
+	t.Run("force cancel checks", func(t *testing.T) {
+		now := time.Date(2026, 1, 2, 15, 4, 5, 0, time.UTC)
+
+		// A run that wasn't canceled first cannot be force canceled
+		run := &tfe.Run{ID: "run-123", Status: tfe.RunPlanning, Actions: &tfe.RunActions{}}
+		_, err := checkForceCancel(run, true, now)
+		assert.ErrorContains(t, err, "cancel it with run_action 'cancel' first")
+
+		// The cool-off period after the cancel request has not passed
+		run.ForceCancelAvailableAt = now.Add(90 * time.Second)
+		_, err = checkForceCancel(run, true, now)
+		assert.ErrorContains(t, err, "cool-off period ends in 1m30s")
+
+		run.Actions.IsForceCancelable = true
+		risk, err := checkForceCancel(run, false, now)
+		require.NoError(t, err)
+		assert.Empty(t, risk)
+
+		// A run that is applying requires the state risk to be acknowledged
+		run.Status = tfe.RunApplying
+		_, err = checkForceCancel(run, false, now)
+		assert.ErrorContains(t, err, "acknowledge_state_risk")
+
+		risk, err = checkForceCancel(run, true, now)
+		require.NoError(t, err)
+		assert.Equal(t, forceCancelStateRisk, risk)
+	})
+
+	t.Run("apply phase detection", func(t *testing.T) {
+		assert.True(t, isApplying(&tfe.Run{Status: tfe.RunApplying}))
+		assert.True(t, isApplying(&tfe.Run{Status: tfe.RunCanceled, Apply: &tfe.Apply{Status: tfe.ApplyRunning}}))
+		assert.False(t, isApplying(&tfe.Run{Status: tfe.RunApplyQueued, Apply: &tfe.Apply{Status: tfe.ApplyQueued}}))
+		assert.False(t, isApplying(&tfe.Run{Status: tfe.RunPlanning}))
+	})
+}
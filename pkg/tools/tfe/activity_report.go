@@ -0,0 +1,351 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// maxActivityWorkspaces bounds the workspaces whose runs are read for an activity report
+	maxActivityWorkspaces = 2000
+	// maxActivityRunsPerWorkspace bounds the runs read for each workspace
+	maxActivityRunsPerWorkspace = 1000
+	defaultActivityDays         = 7
+	maxActivityDays             = 90
+	defaultActivityConcurrency  = 5
+	maxActivityConcurrency      = 20
+)
+
+// ActivityReport aggregates the runs of the workspaces of an organization over a time window
+type ActivityReport struct {
+	Organization string    `json:"organization"`
+	Since        time.Time `json:"since"`
+	Until        time.Time `json:"until"`
+	ActivityStats
+	ScannedWorkspaces int `json:"scanned_workspaces"`
+	ActiveWorkspaces  int `json:"active_workspaces"`
+	// Workspaces are the workspaces with runs in the window, the most active first
+	Workspaces []*WorkspaceActivity `json:"workspaces"`
+	// UnreadableWorkspaces are the workspaces whose runs could not be listed
+	UnreadableWorkspaces []string `json:"unreadable_workspaces,omitempty"`
+	Truncated            bool     `json:"truncated,omitempty"`
+}
+
+// WorkspaceActivity aggregates the runs of a workspace
+type WorkspaceActivity struct {
+	WorkspaceID   string `json:"workspace_id"`
+	WorkspaceName string `json:"workspace_name"`
+	ActivityStats
+	// RunsTruncated is set when the workspace had more runs in the window than were read
+	RunsTruncated bool `json:"runs_truncated,omitempty"`
+}
+
+// ActivityStats are the run counts, failure rate and average durations of a set of runs.
+// FailureRate is the share of the finished runs that errored.
+type ActivityStats struct {
+	RunCount                 int     `json:"run_count"`
+	AppliedCount             int     `json:"applied_count"`
+	PlannedOnlyCount         int     `json:"planned_only_count"`
+	ErroredCount             int     `json:"errored_count"`
+	CanceledCount            int     `json:"canceled_count"`
+	DiscardedCount           int     `json:"discarded_count"`
+	InProgressCount          int     `json:"in_progress_count"`
+	FailureRate              float64 `json:"failure_rate"`
+	AveragePlanDurationSecs  float64 `json:"average_plan_duration_seconds,omitempty"`
+	AverageApplyDurationSecs float64 `json:"average_apply_duration_seconds,omitempty"`
+	planDurationTotal        time.Duration
+	planDurationCount        int
+	applyDurationTotal       time.Duration
+	applyDurationCount       int
+}
+
+// GetActivityReport creates a tool to report the run activity of the workspaces of an organization.
+func GetActivityReport(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("get_hcp_terraform_activity_report",
+			mcp.WithDescription(`Reports the run activity of an HCP Terraform or Terraform Enterprise organization over the last days: for the organization and for each workspace with runs, the number of runs by outcome (applied, planned only, errored, canceled, discarded, in progress), the failure rate of the finished runs and the average plan and apply durations.
+The runs of the workspaces are listed concurrently, so use it for weekly or monthly summaries instead of calling list_runs for every workspace. Workspaces are ordered by number of runs, the most active first.`),
+			mcp.WithTitleAnnotation("Report the run activity of an organization"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("terraform_org_name",
+				mcp.Required(),
+				mcp.Description("The name of the Terraform organization"),
+			),
+			mcp.WithString("project_id",
+				mcp.Description("Optional ID of a project, to only report on its workspaces"),
+			),
+			mcp.WithNumber("days",
+				mcp.Description("The number of days before now the runs are reported for"),
+				mcp.DefaultNumber(defaultActivityDays),
+				mcp.Min(1),
+				mcp.Max(maxActivityDays),
+			),
+			mcp.WithNumber("concurrency",
+				mcp.Description("The number of workspaces whose runs are listed at the same time"),
+				mcp.DefaultNumber(defaultActivityConcurrency),
+				mcp.Min(1),
+				mcp.Max(maxActivityConcurrency),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return getActivityReportHandler(ctx, request, logger)
+		},
+	}
+}
+
+func getActivityReportHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	orgName, err := request.RequireString("terraform_org_name")
+	if err != nil {
+		return ToolError(logger, "missing required input: terraform_org_name", err)
+	}
+	orgName = strings.TrimSpace(orgName)
+	projectID := strings.TrimSpace(request.GetString("project_id", ""))
+	days := request.GetInt("days", defaultActivityDays)
+	if days < 1 || days > maxActivityDays {
+		return ToolErrorf(logger, "days must be between 1 and %d, got %d", maxActivityDays, days)
+	}
+	concurrency := request.GetInt("concurrency", defaultActivityConcurrency)
+	if concurrency < 1 || concurrency > maxActivityConcurrency {
+		return ToolErrorf(logger, "concurrency must be between 1 and %d, got %d", maxActivityConcurrency, concurrency)
+	}
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+
+	until := time.Now().UTC()
+	report := &ActivityReport{
+		Organization: orgName,
+		Since:        until.AddDate(0, 0, -days),
+		Until:        until,
+		Workspaces:   []*WorkspaceActivity{},
+	}
+	options := &tfe.WorkspaceListOptions{
+		ListOptions: tfe.ListOptions{PageNumber: 1, PageSize: 100},
+		ProjectID:   projectID,
+	}
+	var workspaces []*tfe.Workspace
+	for {
+		page, err := tfeClient.Workspaces.List(ctx, orgName, options)
+		if err != nil {
+			return ToolErrorf(logger, "failed to list workspaces in org '%s': %v", orgName, err)
+		}
+		for _, workspace := range page.Items {
+			if workspace != nil {
+				workspaces = append(workspaces, workspace)
+			}
+		}
+		if page.Pagination == nil || page.NextPage == 0 {
+			break
+		}
+		if len(workspaces) >= maxActivityWorkspaces {
+			report.Truncated = true
+			break
+		}
+		options.PageNumber = page.NextPage
+	}
+
+	activities, unreadable := collectWorkspaceActivity(ctx, workspaces, report.Since, concurrency, func(ctx context.Context, workspaceID string, pageNumber int) (*tfe.RunList, error) {
+		return tfeClient.Runs.List(ctx, workspaceID, &tfe.RunListOptions{
+			ListOptions: tfe.ListOptions{PageNumber: pageNumber, PageSize: 100},
+		})
+	}, logger)
+
+	report.ScannedWorkspaces = len(workspaces)
+	report.UnreadableWorkspaces = unreadable
+	for _, activity := range activities {
+		if activity.RunCount == 0 {
+			continue
+		}
+		report.ActiveWorkspaces++
+		report.add(&activity.ActivityStats)
+		activity.finish()
+		report.Workspaces = append(report.Workspaces, activity)
+	}
+	report.finish()
+	sortWorkspaceActivity(report.Workspaces)
+	logger.WithFields(log.Fields{
+		"organization": orgName,
+		"days":         days,
+		"workspaces":   report.ScannedWorkspaces,
+		"runs":         report.RunCount,
+	}).Info("Built activity report")
+
+	buf, err := json.Marshal(report)
+	if err != nil {
+		return ToolError(logger, "failed to marshal activity report", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+// collectWorkspaceActivity lists the runs of the workspaces created since a time, at most
+// concurrency workspaces at a time, and aggregates them by workspace in the order of the
+// workspaces. The names of the workspaces whose runs could not be listed are returned sorted.
+func collectWorkspaceActivity(ctx context.Context, workspaces []*tfe.Workspace, since time.Time, concurrency int, listRuns func(context.Context, string, int) (*tfe.RunList, error), logger *log.Logger) ([]*WorkspaceActivity, []string) {
+	activities := make([]*WorkspaceActivity, len(workspaces))
+	var unreadable []string
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	slots := make(chan struct{}, concurrency)
+	for i, workspace := range workspaces {
+		activities[i] = &WorkspaceActivity{WorkspaceID: workspace.ID, WorkspaceName: workspace.Name}
+		wg.Add(1)
+		go func(activity *WorkspaceActivity) {
+			defer wg.Done()
+			slots <- struct{}{}
+			defer func() { <-slots }()
+
+			if err := readWorkspaceActivity(ctx, activity, since, listRuns); err != nil {
+				logger.WithError(err).WithField("workspace_id", activity.WorkspaceID).Debug("Could not list the runs of the workspace")
+				mu.Lock()
+				unreadable = append(unreadable, activity.WorkspaceName)
+				mu.Unlock()
+			}
+		}(activities[i])
+	}
+	wg.Wait()
+	sort.Strings(unreadable)
+	return activities, unreadable
+}
+
+// readWorkspaceActivity pages through the runs of a workspace, newest first, until the runs
+// created before since
+func readWorkspaceActivity(ctx context.Context, activity *WorkspaceActivity, since time.Time, listRuns func(context.Context, string, int) (*tfe.RunList, error)) error {
+	read := 0
+	for pageNumber := 1; ; {
+		page, err := listRuns(ctx, activity.WorkspaceID, pageNumber)
+		if err != nil {
+			return err
+		}
+		for _, run := range page.Items {
+			if run == nil {
+				continue
+			}
+			if run.CreatedAt.Before(since) {
+				return nil
+			}
+			if read >= maxActivityRunsPerWorkspace {
+				activity.RunsTruncated = true
+				return nil
+			}
+			activity.addRun(run)
+			read++
+		}
+		if page.Pagination == nil || page.NextPage == 0 {
+			return nil
+		}
+		pageNumber = page.NextPage
+	}
+}
+
+// addRun counts a run by outcome and adds its plan and apply durations
+func (s *ActivityStats) addRun(run *tfe.Run) {
+	s.RunCount++
+	switch run.Status {
+	case tfe.RunApplied:
+		s.AppliedCount++
+	case tfe.RunPlannedAndFinished, tfe.RunPlannedAndSaved:
+		s.PlannedOnlyCount++
+	case tfe.RunErrored:
+		s.ErroredCount++
+	case tfe.RunCanceled, "force_canceled":
+		s.CanceledCount++
+	case tfe.RunDiscarded:
+		s.DiscardedCount++
+	default:
+		s.InProgressCount++
+	}
+
+	if timestamps := run.StatusTimestamps; timestamps != nil {
+		if duration, ok := runPhaseDuration(timestamps.PlanningAt, timestamps.PlannedAt, timestamps.PlannedAndFinishedAt, timestamps.PlannedAndSavedAt, erroredBefore(timestamps, timestamps.ApplyingAt)); ok {
+			s.planDurationTotal += duration
+			s.planDurationCount++
+		}
+		if duration, ok := runPhaseDuration(timestamps.ApplyingAt, timestamps.AppliedAt, timestamps.ErroredAt); ok {
+			s.applyDurationTotal += duration
+			s.applyDurationCount++
+		}
+	}
+}
+
+// erroredBefore returns when a run errored if it did before a phase started, to end the
+// previous phase with the error
+func erroredBefore(timestamps *tfe.RunStatusTimestamps, phaseStart time.Time) time.Time {
+	if !phaseStart.IsZero() {
+		return time.Time{}
+	}
+	return timestamps.ErroredAt
+}
+
+// runPhaseDuration returns the time from the start of a phase to the first of its possible ends
+func runPhaseDuration(start time.Time, ends ...time.Time) (time.Duration, bool) {
+	if start.IsZero() {
+		return 0, false
+	}
+	for _, end := range ends {
+		if !end.IsZero() && !end.Before(start) {
+			return end.Sub(start), true
+		}
+	}
+	return 0, false
+}
+
+// add adds the runs of other stats, such as those of a workspace to the organization
+func (s *ActivityStats) add(other *ActivityStats) {
+	s.RunCount += other.RunCount
+	s.AppliedCount += other.AppliedCount
+	s.PlannedOnlyCount += other.PlannedOnlyCount
+	s.ErroredCount += other.ErroredCount
+	s.CanceledCount += other.CanceledCount
+	s.DiscardedCount += other.DiscardedCount
+	s.InProgressCount += other.InProgressCount
+	s.planDurationTotal += other.planDurationTotal
+	s.planDurationCount += other.planDurationCount
+	s.applyDurationTotal += other.applyDurationTotal
+	s.applyDurationCount += other.applyDurationCount
+}
+
+// finish computes the failure rate and the average durations
+func (s *ActivityStats) finish() {
+	if finished := s.RunCount - s.InProgressCount; finished > 0 {
+		s.FailureRate = roundTo(float64(s.ErroredCount)/float64(finished), 3)
+	}
+	if s.planDurationCount > 0 {
+		s.AveragePlanDurationSecs = roundTo(s.planDurationTotal.Seconds()/float64(s.planDurationCount), 1)
+	}
+	if s.applyDurationCount > 0 {
+		s.AverageApplyDurationSecs = roundTo(s.applyDurationTotal.Seconds()/float64(s.applyDurationCount), 1)
+	}
+}
+
+func roundTo(value float64, decimals int) float64 {
+	scale := math.Pow(10, float64(decimals))
+	return math.Round(value*scale) / scale
+}
+
+// sortWorkspaceActivity orders the most active workspaces first, then by workspace name
+func sortWorkspaceActivity(workspaces []*WorkspaceActivity) {
+	sort.SliceStable(workspaces, func(i, j int) bool {
+		if workspaces[i].RunCount != workspaces[j].RunCount {
+			return workspaces[i].RunCount > workspaces[j].RunCount
+		}
+		return workspaces[i].WorkspaceName < workspaces[j].WorkspaceName
+	})
+}
@@ -0,0 +1,121 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-tfe"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestActivityStats(t *testing.T) {
+	start := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	at := func(seconds int) time.Time { return start.Add(time.Duration(seconds) * time.Second) }
+
+	var stats ActivityStats
+	stats.addRun(&tfe.Run{Status: tfe.RunApplied, StatusTimestamps: &tfe.RunStatusTimestamps{
+		PlanningAt: at(0), PlannedAt: at(60), ApplyingAt: at(120), AppliedAt: at(300),
+	}})
+	stats.addRun(&tfe.Run{Status: tfe.RunPlannedAndFinished, StatusTimestamps: &tfe.RunStatusTimestamps{
+		PlanningAt: at(0), PlannedAndFinishedAt: at(30),
+	}})
+	stats.addRun(&tfe.Run{Status: tfe.RunErrored, StatusTimestamps: &tfe.RunStatusTimestamps{
+		PlanningAt: at(0), ErroredAt: at(30),
+	}})
+	stats.addRun(&tfe.Run{Status: tfe.RunErrored, StatusTimestamps: &tfe.RunStatusTimestamps{
+		PlanningAt: at(0), PlannedAt: at(30), ApplyingAt: at(40), ErroredAt: at(100),
+	}})
+	stats.addRun(&tfe.Run{Status: tfe.RunDiscarded})
+	stats.addRun(&tfe.Run{Status: tfe.RunPlanning, StatusTimestamps: &tfe.RunStatusTimestamps{PlanningAt: at(0)}})
+	stats.finish()
+
+	assert.Equal(t, 6, stats.RunCount)
+	assert.Equal(t, 1, stats.AppliedCount)
+	assert.Equal(t, 1, stats.PlannedOnlyCount)
+	assert.Equal(t, 2, stats.ErroredCount)
+	assert.Equal(t, 1, stats.DiscardedCount)
+	assert.Equal(t, 1, stats.InProgressCount)
+	assert.Equal(t, 0.4, stats.FailureRate)
+	assert.Equal(t, 37.5, stats.AveragePlanDurationSecs)
+	assert.Equal(t, 120.0, stats.AverageApplyDurationSecs)
+
+	var total ActivityStats
+	total.add(&stats)
+	total.add(&ActivityStats{RunCount: 4, AppliedCount: 4})
+	total.finish()
+	assert.Equal(t, 10, total.RunCount)
+	assert.Equal(t, 0.222, total.FailureRate)
+	assert.Equal(t, 37.5, total.AveragePlanDurationSecs)
+}
+
+func TestCollectWorkspaceActivity(t *testing.T) {
+	logger := log.New()
+	logger.SetOutput(io.Discard)
+	since := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	workspaces := []*tfe.Workspace{
+		{ID: "ws-1", Name: "app"},
+		{ID: "ws-2", Name: "network"},
+		{ID: "ws-3", Name: "broken"},
+	}
+
+	var inFlight, maxInFlight atomic.Int32
+	listRuns := func(ctx context.Context, workspaceID string, pageNumber int) (*tfe.RunList, error) {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			seen := maxInFlight.Load()
+			if current <= seen || maxInFlight.CompareAndSwap(seen, current) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+
+		switch workspaceID {
+		case "ws-1":
+			if pageNumber == 1 {
+				return &tfe.RunList{
+					Items:      []*tfe.Run{{Status: tfe.RunApplied, CreatedAt: since.Add(72 * time.Hour)}, {Status: tfe.RunErrored, CreatedAt: since.Add(48 * time.Hour)}},
+					Pagination: &tfe.Pagination{CurrentPage: 1, NextPage: 2},
+				}, nil
+			}
+			return &tfe.RunList{
+				Items:      []*tfe.Run{{Status: tfe.RunApplied, CreatedAt: since.Add(time.Hour)}, {Status: tfe.RunApplied, CreatedAt: since.Add(-time.Hour)}},
+				Pagination: &tfe.Pagination{CurrentPage: 2, NextPage: 3},
+			}, nil
+		case "ws-2":
+			return &tfe.RunList{Items: []*tfe.Run{{Status: tfe.RunApplied, CreatedAt: since.Add(-time.Hour)}}}, nil
+		default:
+			return nil, errors.New("forbidden")
+		}
+	}
+
+	activities, unreadable := collectWorkspaceActivity(context.Background(), workspaces, since, 2, listRuns, logger)
+	require.Len(t, activities, 3)
+	assert.Equal(t, "app", activities[0].WorkspaceName)
+	assert.Equal(t, 3, activities[0].RunCount)
+	assert.Equal(t, 1, activities[0].ErroredCount)
+	assert.Equal(t, 0, activities[1].RunCount)
+	assert.Equal(t, []string{"broken"}, unreadable)
+	assert.LessOrEqual(t, maxInFlight.Load(), int32(2))
+}
+
+func TestSortWorkspaceActivity(t *testing.T) {
+	workspaces := []*WorkspaceActivity{
+		{WorkspaceName: "quiet", ActivityStats: ActivityStats{RunCount: 1}},
+		{WorkspaceName: "busy-b", ActivityStats: ActivityStats{RunCount: 5}},
+		{WorkspaceName: "busy-a", ActivityStats: ActivityStats{RunCount: 5}},
+	}
+	sortWorkspaceActivity(workspaces)
+	assert.Equal(t, "busy-a", workspaces[0].WorkspaceName)
+	assert.Equal(t, "busy-b", workspaces[1].WorkspaceName)
+	assert.Equal(t, "quiet", workspaces[2].WorkspaceName)
+}
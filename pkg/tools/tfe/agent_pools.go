@@ -0,0 +1,242 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// WorkspaceAgentPoolResult is returned by the agent pool assignment tool
+type WorkspaceAgentPoolResult struct {
+	WorkspaceID   string `json:"workspace_id"`
+	WorkspaceName string `json:"workspace_name"`
+	ExecutionMode string `json:"execution_mode"`
+	AgentPoolID   string `json:"agent_pool_id"`
+	AgentPoolName string `json:"agent_pool_name"`
+}
+
+// ListAgentPools creates a tool to list the agent pools of an organization.
+func ListAgentPools(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("list_agent_pools",
+			mcp.WithDescription(`Lists the agent pools of an organization with their ID, whether every workspace may use them, their number of agents and of workspaces using them. Use this to find the agent_pool_id for workspaces with the 'agent' execution mode.`),
+			mcp.WithTitleAnnotation("List the agent pools of an organization"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("terraform_org_name",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+			mcp.WithString("query",
+				mcp.Description("Optional search on the agent pool name"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return listAgentPoolsHandler(ctx, request, logger)
+		},
+	}
+}
+
+// GetAgentPoolDetails creates a tool to describe an agent pool.
+func GetAgentPoolDetails(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("get_agent_pool_details",
+			mcp.WithDescription(`Returns the details of an agent pool: the workspaces using it, the workspaces allowed to use it when it is not available to the whole organization, and its agents with a count per status (idle, busy, unknown, errored, exited).`),
+			mcp.WithTitleAnnotation("Get the details of an agent pool"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("agent_pool_id",
+				mcp.Required(),
+				mcp.Description("The ID of the agent pool (e.g. 'apool-abc123')"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return getAgentPoolDetailsHandler(ctx, request, logger)
+		},
+	}
+}
+
+// ListAgentPoolAgents creates a tool to list the agents of an agent pool.
+func ListAgentPoolAgents(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("list_agent_pool_agents",
+			mcp.WithDescription(`Lists the agents registered in an agent pool with their name, IP address, status and last ping time. Use this to check whether a pool has idle agents before queueing runs on it.`),
+			mcp.WithTitleAnnotation("List the agents of an agent pool"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("agent_pool_id",
+				mcp.Required(),
+				mcp.Description("The ID of the agent pool (e.g. 'apool-abc123')"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return listAgentPoolAgentsHandler(ctx, request, logger)
+		},
+	}
+}
+
+// AssignWorkspaceAgentPool creates a tool to run a workspace on an agent pool.
+func AssignWorkspaceAgentPool(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("assign_workspace_agent_pool",
+			mcp.WithDescription(`Assigns an agent pool to a Terraform workspace and switches the workspace to the 'agent' execution mode, so its runs are executed by the agents of the pool.`),
+			mcp.WithTitleAnnotation("Assign an agent pool to a workspace"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(false),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("terraform_org_name",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+			mcp.WithString("workspace_name",
+				mcp.Required(),
+				mcp.Description("Workspace name"),
+			),
+			mcp.WithString("agent_pool_id",
+				mcp.Required(),
+				mcp.Description("The ID of the agent pool (e.g. 'apool-abc123'), see list_agent_pools"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return assignWorkspaceAgentPoolHandler(ctx, request, logger)
+		},
+	}
+}
+
+func listAgentPoolsHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	orgName, err := request.RequireString("terraform_org_name")
+	if err != nil {
+		return ToolError(logger, "missing required input: terraform_org_name", err)
+	}
+	orgName = strings.TrimSpace(orgName)
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+
+	pools, err := client.ListAgentPools(ctx, tfeClient, orgName, strings.TrimSpace(request.GetString("query", "")))
+	if err != nil {
+		return ToolErrorf(logger, "failed to list agent pools in org '%s': %v", orgName, err)
+	}
+
+	buf, err := json.Marshal(pools)
+	if err != nil {
+		return ToolError(logger, "failed to marshal agent pools", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+func getAgentPoolDetailsHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	agentPoolID, err := request.RequireString("agent_pool_id")
+	if err != nil {
+		return ToolError(logger, "missing required input: agent_pool_id", err)
+	}
+	agentPoolID = strings.TrimSpace(agentPoolID)
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+
+	details, err := client.GetAgentPool(ctx, tfeClient, agentPoolID)
+	if err != nil {
+		return ToolErrorf(logger, "failed to read agent pool '%s': %v", agentPoolID, err)
+	}
+
+	buf, err := json.Marshal(details)
+	if err != nil {
+		return ToolError(logger, "failed to marshal agent pool details", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+func listAgentPoolAgentsHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	agentPoolID, err := request.RequireString("agent_pool_id")
+	if err != nil {
+		return ToolError(logger, "missing required input: agent_pool_id", err)
+	}
+	agentPoolID = strings.TrimSpace(agentPoolID)
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+
+	agents, err := client.ListAgents(ctx, tfeClient, agentPoolID)
+	if err != nil {
+		return ToolErrorf(logger, "failed to list the agents of agent pool '%s': %v", agentPoolID, err)
+	}
+
+	buf, err := json.Marshal(agents)
+	if err != nil {
+		return ToolError(logger, "failed to marshal agents", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+func assignWorkspaceAgentPoolHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	orgName, err := request.RequireString("terraform_org_name")
+	if err != nil {
+		return ToolError(logger, "missing required input: terraform_org_name", err)
+	}
+	orgName = strings.TrimSpace(orgName)
+
+	workspaceName, err := request.RequireString("workspace_name")
+	if err != nil {
+		return ToolError(logger, "missing required input: workspace_name", err)
+	}
+	workspaceName = strings.TrimSpace(workspaceName)
+
+	agentPoolID, err := request.RequireString("agent_pool_id")
+	if err != nil {
+		return ToolError(logger, "missing required input: agent_pool_id", err)
+	}
+	agentPoolID = strings.TrimSpace(agentPoolID)
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+
+	pool, err := tfeClient.AgentPools.Read(ctx, agentPoolID)
+	if err != nil {
+		return ToolErrorf(logger, "agent pool '%s' not found: %v", agentPoolID, err)
+	}
+
+	workspace, err := tfeClient.Workspaces.Update(ctx, orgName, workspaceName, tfe.WorkspaceUpdateOptions{
+		ExecutionMode: tfe.String("agent"),
+		AgentPoolID:   tfe.String(pool.ID),
+	})
+	if err != nil {
+		if !pool.OrganizationScoped {
+			return ToolErrorf(logger, "failed to assign agent pool '%s' to workspace '%s', check that the workspace is allowed to use the pool with get_agent_pool_details: %v", pool.Name, workspaceName, err)
+		}
+		return ToolErrorf(logger, "failed to assign agent pool '%s' to workspace '%s': %v", pool.Name, workspaceName, err)
+	}
+
+	buf, err := json.Marshal(WorkspaceAgentPoolResult{
+		WorkspaceID:   workspace.ID,
+		WorkspaceName: workspace.Name,
+		ExecutionMode: workspace.ExecutionMode,
+		AgentPoolID:   pool.ID,
+		AgentPoolName: pool.Name,
+	})
+	if err != nil {
+		return ToolError(logger, "failed to marshal result", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
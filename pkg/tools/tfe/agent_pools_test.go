@@ -0,0 +1,52 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"testing"
+
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAgentPoolTools(t *testing.T) {
+	logger := log.New()
+	logger.SetLevel(log.ErrorLevel)
+
+	t.Run("read-only tools", func(t *testing.T) {
+		for name, tool := range map[string]func(*log.Logger) server.ServerTool{
+			"list_agent_pools":       ListAgentPools,
+			"get_agent_pool_details": GetAgentPoolDetails,
+			"list_agent_pool_agents": ListAgentPoolAgents,
+		} {
+			created := tool(logger)
+			assert.Equal(t, name, created.Tool.Name)
+			assert.NotNil(t, created.Handler)
+			assert.NotNil(t, created.Tool.Annotations.ReadOnlyHint)
+			assert.True(t, *created.Tool.Annotations.ReadOnlyHint)
+		}
+
+		assert.Contains(t, ListAgentPools(logger).Tool.InputSchema.Required, "terraform_org_name")
+		assert.Contains(t, GetAgentPoolDetails(logger).Tool.InputSchema.Required, "agent_pool_id")
+		assert.Contains(t, ListAgentPoolAgents(logger).Tool.InputSchema.Required, "agent_pool_id")
+	})
+
+	t.Run("assign tool creation", func(t *testing.T) {
+		tool := AssignWorkspaceAgentPool(logger)
+
+		assert.Equal(t, "assign_workspace_agent_pool", tool.Tool.Name)
+		assert.Contains(t, tool.Tool.Description, "'agent' execution mode")
+		assert.NotNil(t, tool.Handler)
+
+		assert.NotNil(t, tool.Tool.Annotations.ReadOnlyHint)
+		assert.False(t, *tool.Tool.Annotations.ReadOnlyHint)
+		assert.NotNil(t, tool.Tool.Annotations.DestructiveHint)
+		assert.False(t, *tool.Tool.Annotations.DestructiveHint)
+
+		assert.Contains(t, tool.Tool.InputSchema.Required, "terraform_org_name")
+		assert.Contains(t, tool.Tool.InputSchema.Required, "workspace_name")
+		assert.Contains(t, tool.Tool.InputSchema.Required, "agent_pool_id")
+	})
+}
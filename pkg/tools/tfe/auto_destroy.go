@@ -0,0 +1,133 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/jsonapi"
+	"github.com/mark3labs/mcp-go/mcp"
+	log "github.com/sirupsen/logrus"
+)
+
+// autoDestroyDurationPattern matches the inactivity durations accepted by HCP Terraform, e.g. "14d" or "12h"
+var autoDestroyDurationPattern = regexp.MustCompile(`^[1-9][0-9]*[dh]$`)
+
+// autoDestroySettings is a change to the scheduled destruction of a workspace. Unspecified
+// attributes are left unchanged, null attributes clear the schedule.
+type autoDestroySettings struct {
+	At       jsonapi.NullableAttr[time.Time]
+	Duration jsonapi.NullableAttr[string]
+}
+
+// withAutoDestroy adds the parameters to schedule the destruction of a workspace
+func withAutoDestroy() mcp.ToolOption {
+	return func(t *mcp.Tool) {
+		for _, option := range []mcp.ToolOption{
+			mcp.WithString("auto_destroy_at",
+				mcp.Description("Optional RFC 3339 timestamp at which all resources of the workspace are destroyed (e.g. '2025-12-31T18:00:00Z'), or 'none' to clear it. Scheduling requires confirm_auto_destroy"),
+			),
+			mcp.WithString("auto_destroy_activity_duration",
+				mcp.Description("Optional period of inactivity after which all resources of the workspace are destroyed, in days or hours (e.g. '14d' or '12h'), or 'none' to clear it. Scheduling requires confirm_auto_destroy"),
+			),
+			mcp.WithBoolean("confirm_auto_destroy",
+				mcp.Description("Must be true to schedule the destruction of the workspace's resources with auto_destroy_at or auto_destroy_activity_duration. Only set it after the user explicitly confirmed the schedule for this workspace"),
+				mcp.DefaultBool(false),
+			),
+		} {
+			option(t)
+		}
+	}
+}
+
+// parseAutoDestroy reads the auto-destroy parameters of a request
+func parseAutoDestroy(request mcp.CallToolRequest, now time.Time) (autoDestroySettings, error) {
+	var settings autoDestroySettings
+
+	if at := strings.TrimSpace(request.GetString("auto_destroy_at", "")); at != "" {
+		if strings.EqualFold(at, "none") {
+			settings.At = jsonapi.NewNullNullableAttr[time.Time]()
+		} else {
+			t, err := time.Parse(time.RFC3339, at)
+			if err != nil {
+				return settings, fmt.Errorf("auto_destroy_at must be an RFC 3339 timestamp or 'none': %w", err)
+			}
+			if !t.After(now) {
+				return settings, fmt.Errorf("auto_destroy_at %s is not in the future", at)
+			}
+			settings.At = jsonapi.NewNullableAttrWithValue(t.UTC())
+		}
+	}
+
+	if duration := strings.TrimSpace(request.GetString("auto_destroy_activity_duration", "")); duration != "" {
+		if strings.EqualFold(duration, "none") {
+			settings.Duration = jsonapi.NewNullNullableAttr[string]()
+		} else {
+			if !autoDestroyDurationPattern.MatchString(duration) {
+				return settings, fmt.Errorf("invalid auto_destroy_activity_duration '%s' - must be a number of days or hours, e.g. '14d' or '12h'", duration)
+			}
+			settings.Duration = jsonapi.NewNullableAttrWithValue(duration)
+		}
+	}
+
+	return settings, nil
+}
+
+// schedules reports whether the settings schedule the destruction of the workspace
+func (s autoDestroySettings) schedules() bool {
+	return (s.At.IsSpecified() && !s.At.IsNull()) || (s.Duration.IsSpecified() && !s.Duration.IsNull())
+}
+
+// changed reports whether the settings change the auto-destroy schedule at all
+func (s autoDestroySettings) changed() bool {
+	return s.At.IsSpecified() || s.Duration.IsSpecified()
+}
+
+// fields describes the settings for the audit log
+func (s autoDestroySettings) fields() log.Fields {
+	fields := log.Fields{}
+	if s.At.IsSpecified() {
+		fields["auto_destroy_at"] = "none"
+		if at, err := s.At.Get(); err == nil {
+			fields["auto_destroy_at"] = at.Format(time.RFC3339)
+		}
+	}
+	if s.Duration.IsSpecified() {
+		fields["auto_destroy_activity_duration"] = "none"
+		if duration, err := s.Duration.Get(); err == nil {
+			fields["auto_destroy_activity_duration"] = duration
+		}
+	}
+	return fields
+}
+
+// checkAutoDestroy refuses to schedule the destruction of a workspace without an explicit confirmation.
+// Refused attempts are recorded in the audit log as well.
+func checkAutoDestroy(logger *log.Logger, settings autoDestroySettings, confirmed bool, requester string, workspace string) error {
+	if !settings.schedules() || confirmed {
+		return nil
+	}
+	fields := settings.fields()
+	fields["workspace"] = workspace
+	auditProtectedLog(logger, "schedule_auto_destroy_refused", requester, fields)
+	return fmt.Errorf("scheduling the destruction of workspace '%s' destroys all of its resources - confirm the schedule with the user and set confirm_auto_destroy to true", workspace)
+}
+
+// auditAutoDestroy records a change to the auto-destroy schedule of a workspace
+func auditAutoDestroy(logger *log.Logger, settings autoDestroySettings, requester string, workspaceID string, workspace string) {
+	if !settings.changed() {
+		return
+	}
+	action := "clear_auto_destroy"
+	if settings.schedules() {
+		action = "schedule_auto_destroy"
+	}
+	fields := settings.fields()
+	fields["workspace_id"] = workspaceID
+	fields["workspace"] = workspace
+	auditProtectedLog(logger, action, requester, fields)
+}
@@ -0,0 +1,106 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	log "github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAutoDestroy(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	parse := func(arguments map[string]any) (autoDestroySettings, error) {
+		request := mcp.CallToolRequest{}
+		request.Params.Arguments = arguments
+		return parseAutoDestroy(request, now)
+	}
+
+	t.Run("workspace tools accept auto-destroy settings", func(t *testing.T) {
+		logger := log.New()
+		for _, tool := range []mcp.Tool{CreateWorkspace(logger).Tool, UpdateWorkspace(logger).Tool} {
+			assert.Contains(t, tool.InputSchema.Properties, "auto_destroy_at", tool.Name)
+			assert.Contains(t, tool.InputSchema.Properties, "auto_destroy_activity_duration", tool.Name)
+			assert.Contains(t, tool.InputSchema.Properties, "confirm_auto_destroy", tool.Name)
+		}
+	})
+
+	t.Run("parse", func(t *testing.T) {
+		settings, err := parse(map[string]any{})
+		require.NoError(t, err)
+		assert.False(t, settings.changed())
+
+		settings, err = parse(map[string]any{"auto_destroy_at": "2025-06-02T09:00:00+02:00", "auto_destroy_activity_duration": "14d"})
+		require.NoError(t, err)
+		assert.True(t, settings.schedules())
+		at, err := settings.At.Get()
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2025, 6, 2, 7, 0, 0, 0, time.UTC), at)
+		duration, err := settings.Duration.Get()
+		require.NoError(t, err)
+		assert.Equal(t, "14d", duration)
+
+		settings, err = parse(map[string]any{"auto_destroy_at": "none", "auto_destroy_activity_duration": "None"})
+		require.NoError(t, err)
+		assert.True(t, settings.changed())
+		assert.False(t, settings.schedules())
+		assert.True(t, settings.At.IsNull())
+		assert.True(t, settings.Duration.IsNull())
+	})
+
+	t.Run("invalid settings", func(t *testing.T) {
+		for _, arguments := range []map[string]any{
+			{"auto_destroy_at": "tomorrow"},
+			{"auto_destroy_at": "2025-05-31T12:00:00Z"},
+			{"auto_destroy_activity_duration": "2w"},
+			{"auto_destroy_activity_duration": "0d"},
+		} {
+			_, err := parse(arguments)
+			assert.Error(t, err, arguments)
+		}
+	})
+
+	t.Run("scheduling requires confirmation", func(t *testing.T) {
+		logger, hook := test.NewNullLogger()
+		settings, err := parse(map[string]any{"auto_destroy_activity_duration": "12h"})
+		require.NoError(t, err)
+
+		err = checkAutoDestroy(logger, settings, false, "jane@example.com", "prod")
+		assert.ErrorContains(t, err, "confirm_auto_destroy")
+		entry := hook.LastEntry()
+		require.NotNil(t, entry)
+		assert.Equal(t, log.WarnLevel, entry.Level)
+		assert.Equal(t, "schedule_auto_destroy_refused", entry.Data["action"])
+		assert.Equal(t, true, entry.Data["protected"])
+
+		assert.NoError(t, checkAutoDestroy(logger, settings, true, "jane@example.com", "prod"))
+
+		cleared, err := parse(map[string]any{"auto_destroy_at": "none"})
+		require.NoError(t, err)
+		assert.NoError(t, checkAutoDestroy(logger, cleared, false, "", "prod"))
+	})
+
+	t.Run("audit log entry", func(t *testing.T) {
+		logger, hook := test.NewNullLogger()
+		settings, err := parse(map[string]any{"auto_destroy_at": "2025-06-02T07:00:00Z"})
+		require.NoError(t, err)
+
+		auditAutoDestroy(logger, settings, "jane@example.com", "ws-123", "prod")
+		entry := hook.LastEntry()
+		require.NotNil(t, entry)
+		assert.Equal(t, "schedule_auto_destroy", entry.Data["action"])
+		assert.Equal(t, "2025-06-02T07:00:00Z", entry.Data["auto_destroy_at"])
+		assert.Equal(t, "ws-123", entry.Data["workspace_id"])
+		assert.Equal(t, "jane@example.com", entry.Data["on_behalf_of"])
+
+		hook.Reset()
+		auditAutoDestroy(logger, autoDestroySettings{}, "", "ws-123", "prod")
+		assert.Nil(t, hook.LastEntry())
+	})
+}
@@ -0,0 +1,329 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// Actions of the bulk workspace update tool
+const (
+	BulkActionSetTerraformVersion = "set_terraform_version"
+	BulkActionSetAutoApply        = "set_auto_apply"
+	BulkActionSetExecutionMode    = "set_execution_mode"
+)
+
+// Statuses of a workspace in a bulk update
+const (
+	BulkWorkspaceWouldChange = "would_change"
+	BulkWorkspaceUnchanged   = "unchanged"
+	BulkWorkspaceUpdated     = "updated"
+	BulkWorkspaceFailed      = "failed"
+)
+
+// WorkspaceBulkUpdate is the preview or the outcome of a bulk update of workspaces
+type WorkspaceBulkUpdate struct {
+	Organization   string                 `json:"organization"`
+	Action         string                 `json:"action"`
+	Value          string                 `json:"value"`
+	DryRun         bool                   `json:"dry_run"`
+	MatchedCount   int                    `json:"matched_count"`
+	ChangeCount    int                    `json:"change_count"`
+	UnchangedCount int                    `json:"unchanged_count"`
+	UpdatedCount   int                    `json:"updated_count"`
+	FailedCount    int                    `json:"failed_count"`
+	Workspaces     []*BulkWorkspaceChange `json:"workspaces"`
+}
+
+// BulkWorkspaceChange is the change of a setting of a workspace matching the filters of a bulk update
+type BulkWorkspaceChange struct {
+	ID       string `json:"id"`
+	Name     string `json:"workspace_name"`
+	Current  string `json:"current_value"`
+	NewValue string `json:"new_value"`
+	Status   string `json:"status"`
+	Error    string `json:"error,omitempty"`
+}
+
+// bulkWorkspaceAction is a validated action of a bulk update, with the value it sets
+type bulkWorkspaceAction struct {
+	name        string
+	value       string
+	agentPoolID string
+}
+
+// BulkUpdateWorkspaces creates a tool to change a setting of every workspace matching tag filters.
+func BulkUpdateWorkspaces(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("bulk_update_workspaces",
+			mcp.WithDescription(`Applies one settings change to every workspace of an organization matching tag filters, e.g. "set the Terraform version of everything tagged team:payments to 1.9.8": set_terraform_version, set_auto_apply or set_execution_mode.
+The tool previews the change by default (dry_run), listing each matching workspace with its current and new value. Show the preview to the user and only call it again with dry_run false once they confirmed it. Workspaces already set to the value are left unchanged.`),
+			mcp.WithTitleAnnotation("Update the settings of the workspaces matching tags"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(false),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("terraform_org_name",
+				mcp.Required(),
+				mcp.Description("The Terraform organization name"),
+			),
+			mcp.WithString("tags",
+				mcp.Description("Comma-separated tag names the workspaces must all have, e.g. 'team:payments,prod'. At least one of tags or tag_bindings is required"),
+			),
+			mcp.WithString("tag_bindings",
+				mcp.Description("Comma-separated key-value tags the workspaces must all have, as 'key:value' or 'key', e.g. 'team:payments'. At least one of tags or tag_bindings is required"),
+			),
+			mcp.WithString("exclude_tags",
+				mcp.Description("Optional comma-separated tag names of workspaces to leave out"),
+			),
+			mcp.WithString("project_id",
+				mcp.Description("Optional project ID to restrict the update to"),
+			),
+			mcp.WithString("action",
+				mcp.Required(),
+				mcp.Description("The setting to change on every matching workspace"),
+				mcp.Enum(BulkActionSetTerraformVersion, BulkActionSetAutoApply, BulkActionSetExecutionMode),
+			),
+			mcp.WithString("terraform_version",
+				mcp.Description("The Terraform version to set, e.g. '1.9.8', for set_terraform_version"),
+			),
+			mcp.WithBoolean("auto_apply",
+				mcp.Description("Whether successful plans are applied automatically, for set_auto_apply"),
+			),
+			mcp.WithString("execution_mode",
+				mcp.Description("The execution mode to set, for set_execution_mode"),
+				mcp.Enum("remote", "local", "agent"),
+			),
+			mcp.WithString("agent_pool_id",
+				mcp.Description("The agent pool the workspaces run on, required when execution_mode is 'agent'"),
+			),
+			mcp.WithBoolean("dry_run",
+				mcp.Description("Only preview the change without updating any workspace"),
+				mcp.DefaultBool(true),
+			),
+			withOnBehalfOf(),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return bulkUpdateWorkspacesHandler(ctx, request, logger)
+		},
+	}
+}
+
+func bulkUpdateWorkspacesHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	orgName, err := request.RequireString("terraform_org_name")
+	if err != nil {
+		return ToolError(logger, "missing required input: terraform_org_name", err)
+	}
+	orgName = strings.TrimSpace(orgName)
+
+	tags := splitCommaList(request.GetString("tags", ""))
+	tagBindings := parseTagBindingFilters(request.GetString("tag_bindings", ""))
+	if len(tags) == 0 && len(tagBindings) == 0 {
+		return ToolError(logger, "at least one of tags or tag_bindings is required, to avoid updating every workspace of the organization", nil)
+	}
+
+	action, err := parseBulkWorkspaceAction(request)
+	if err != nil {
+		return ToolError(logger, err.Error(), nil)
+	}
+	dryRun := request.GetBool("dry_run", true)
+	requester := onBehalfOf(request)
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+	if len(tagBindings) > 0 {
+		if err := client.PlatformOf(tfeClient).Supports(client.FeatureTagBindings); err != nil {
+			return ToolErrorf(logger, "%v - use tags instead of tag_bindings", err)
+		}
+	}
+
+	options := &tfe.WorkspaceListOptions{
+		ListOptions: tfe.ListOptions{PageNumber: 1, PageSize: 100},
+		ProjectID:   strings.TrimSpace(request.GetString("project_id", "")),
+		Tags:        strings.Join(tags, ","),
+		ExcludeTags: strings.Join(splitCommaList(request.GetString("exclude_tags", "")), ","),
+		TagBindings: tagBindings,
+	}
+	var workspaces []*tfe.Workspace
+	for {
+		page, err := tfeClient.Workspaces.List(ctx, orgName, options)
+		if err != nil {
+			return ToolErrorf(logger, "failed to list workspaces in org '%s': %v", orgName, err)
+		}
+		workspaces = append(workspaces, page.Items...)
+		if page.Pagination == nil || page.NextPage == 0 {
+			break
+		}
+		options.PageNumber = page.NextPage
+	}
+
+	result := planBulkWorkspaceUpdate(orgName, workspaces, action)
+	result.DryRun = dryRun
+
+	if !dryRun {
+		for _, change := range result.Workspaces {
+			if change.Status != BulkWorkspaceWouldChange {
+				continue
+			}
+			if _, err := tfeClient.Workspaces.UpdateByID(ctx, change.ID, action.updateOptions()); err != nil {
+				change.Status = BulkWorkspaceFailed
+				change.Error = err.Error()
+				result.FailedCount++
+				logger.Warnf("Failed to %s on workspace %s: %v", action.name, change.ID, err)
+				continue
+			}
+			change.Status = BulkWorkspaceUpdated
+			result.UpdatedCount++
+			auditLog(logger, "bulk_update_workspaces", requester, log.Fields{
+				"workspace_id":  change.ID,
+				"workspace":     change.Name,
+				"bulk_action":   action.name,
+				"current_value": change.Current,
+				"new_value":     change.NewValue,
+			})
+		}
+	}
+
+	buf, err := json.Marshal(result)
+	if err != nil {
+		return ToolError(logger, "failed to marshal bulk update", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+// parseBulkWorkspaceAction validates the action of a request and the value it sets
+func parseBulkWorkspaceAction(request mcp.CallToolRequest) (*bulkWorkspaceAction, error) {
+	name, err := request.RequireString("action")
+	if err != nil {
+		return nil, fmt.Errorf("missing required input: action")
+	}
+	action := &bulkWorkspaceAction{name: name}
+
+	switch name {
+	case BulkActionSetTerraformVersion:
+		action.value = strings.TrimSpace(request.GetString("terraform_version", ""))
+		if action.value == "" {
+			return nil, fmt.Errorf("terraform_version is required for %s", name)
+		}
+	case BulkActionSetAutoApply:
+		arguments := request.GetArguments()
+		if _, ok := arguments["auto_apply"]; !ok {
+			return nil, fmt.Errorf("auto_apply is required for %s", name)
+		}
+		action.value = strconv.FormatBool(request.GetBool("auto_apply", false))
+	case BulkActionSetExecutionMode:
+		action.value = strings.TrimSpace(request.GetString("execution_mode", ""))
+		action.agentPoolID = strings.TrimSpace(request.GetString("agent_pool_id", ""))
+		switch action.value {
+		case "remote", "local":
+		case "agent":
+			if action.agentPoolID == "" {
+				return nil, fmt.Errorf("agent_pool_id is required for the 'agent' execution mode")
+			}
+		default:
+			return nil, fmt.Errorf("invalid execution_mode '%s' - must be 'remote', 'local', or 'agent'", action.value)
+		}
+	default:
+		return nil, fmt.Errorf("invalid action '%s' - must be %s, %s or %s", name, BulkActionSetTerraformVersion, BulkActionSetAutoApply, BulkActionSetExecutionMode)
+	}
+	return action, nil
+}
+
+// current returns the value a workspace has for the setting of the action
+func (a *bulkWorkspaceAction) current(ws *tfe.Workspace) string {
+	switch a.name {
+	case BulkActionSetTerraformVersion:
+		return ws.TerraformVersion
+	case BulkActionSetAutoApply:
+		return strconv.FormatBool(ws.AutoApply)
+	default:
+		if ws.ExecutionMode == "agent" && ws.AgentPool != nil {
+			return "agent (" + ws.AgentPool.ID + ")"
+		}
+		return ws.ExecutionMode
+	}
+}
+
+// newValue returns the value a workspace has once the action is applied, in the format of current
+func (a *bulkWorkspaceAction) newValue() string {
+	if a.name == BulkActionSetExecutionMode && a.value == "agent" {
+		return "agent (" + a.agentPoolID + ")"
+	}
+	return a.value
+}
+
+func (a *bulkWorkspaceAction) updateOptions() tfe.WorkspaceUpdateOptions {
+	switch a.name {
+	case BulkActionSetTerraformVersion:
+		return tfe.WorkspaceUpdateOptions{TerraformVersion: tfe.String(a.value)}
+	case BulkActionSetAutoApply:
+		return tfe.WorkspaceUpdateOptions{AutoApply: tfe.Bool(a.value == "true")}
+	default:
+		options := tfe.WorkspaceUpdateOptions{ExecutionMode: tfe.String(a.value)}
+		if a.value == "agent" {
+			options.AgentPoolID = tfe.String(a.agentPoolID)
+		}
+		return options
+	}
+}
+
+// planBulkWorkspaceUpdate lists the matching workspaces with the change the action makes to each of them
+func planBulkWorkspaceUpdate(orgName string, workspaces []*tfe.Workspace, action *bulkWorkspaceAction) *WorkspaceBulkUpdate {
+	result := &WorkspaceBulkUpdate{
+		Organization: orgName,
+		Action:       action.name,
+		Value:        action.newValue(),
+		MatchedCount: len(workspaces),
+		Workspaces:   make([]*BulkWorkspaceChange, 0, len(workspaces)),
+	}
+	for _, ws := range workspaces {
+		change := &BulkWorkspaceChange{
+			ID:       ws.ID,
+			Name:     ws.Name,
+			Current:  action.current(ws),
+			NewValue: action.newValue(),
+			Status:   BulkWorkspaceWouldChange,
+		}
+		if change.Current == change.NewValue {
+			change.Status = BulkWorkspaceUnchanged
+			result.UnchangedCount++
+		} else {
+			result.ChangeCount++
+		}
+		result.Workspaces = append(result.Workspaces, change)
+	}
+	return result
+}
+
+// parseTagBindingFilters parses comma-separated 'key:value' or 'key' filters of key-value tags
+func parseTagBindingFilters(value string) []*tfe.TagBinding {
+	var bindings []*tfe.TagBinding
+	for _, entry := range splitCommaList(value) {
+		key, tagValue, _ := strings.Cut(entry, ":")
+		bindings = append(bindings, &tfe.TagBinding{Key: strings.TrimSpace(key), Value: strings.TrimSpace(tagValue)})
+	}
+	return bindings
+}
+
+// splitCommaList splits a comma-separated list, dropping empty entries
+func splitCommaList(value string) []string {
+	var entries []string
+	for _, entry := range strings.Split(value, ",") {
+		if entry = strings.TrimSpace(entry); entry != "" {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
@@ -0,0 +1,90 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/mark3labs/mcp-go/mcp"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBulkUpdateWorkspaces(t *testing.T) {
+	logger := log.New()
+	logger.SetLevel(log.ErrorLevel)
+
+	t.Run("tool creation", func(t *testing.T) {
+		tool := BulkUpdateWorkspaces(logger)
+
+		assert.Equal(t, "bulk_update_workspaces", tool.Tool.Name)
+		assert.Contains(t, tool.Tool.Description, "tag filters")
+		assert.NotNil(t, tool.Handler)
+
+		assert.False(t, *tool.Tool.Annotations.ReadOnlyHint)
+		assert.False(t, *tool.Tool.Annotations.DestructiveHint)
+		assert.Equal(t, []string{"terraform_org_name", "action"}, tool.Tool.InputSchema.Required)
+	})
+}
+
+func TestParseBulkWorkspaceAction(t *testing.T) {
+	request := func(arguments map[string]any) mcp.CallToolRequest {
+		return mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: arguments}}
+	}
+
+	action, err := parseBulkWorkspaceAction(request(map[string]any{"action": BulkActionSetTerraformVersion, "terraform_version": " 1.9.8 "}))
+	require.NoError(t, err)
+	assert.Equal(t, "1.9.8", action.value)
+	assert.Equal(t, "1.9.8", *action.updateOptions().TerraformVersion)
+
+	action, err = parseBulkWorkspaceAction(request(map[string]any{"action": BulkActionSetAutoApply, "auto_apply": false}))
+	require.NoError(t, err)
+	assert.Equal(t, "false", action.value)
+	assert.False(t, *action.updateOptions().AutoApply)
+
+	action, err = parseBulkWorkspaceAction(request(map[string]any{"action": BulkActionSetExecutionMode, "execution_mode": "agent", "agent_pool_id": "apool-1"}))
+	require.NoError(t, err)
+	assert.Equal(t, "agent (apool-1)", action.newValue())
+	assert.Equal(t, "apool-1", *action.updateOptions().AgentPoolID)
+
+	for _, arguments := range []map[string]any{
+		{"action": BulkActionSetTerraformVersion},
+		{"action": BulkActionSetAutoApply},
+		{"action": BulkActionSetExecutionMode, "execution_mode": "agent"},
+		{"action": BulkActionSetExecutionMode, "execution_mode": "cloud"},
+		{"action": "delete"},
+	} {
+		_, err := parseBulkWorkspaceAction(request(arguments))
+		assert.Error(t, err, arguments)
+	}
+}
+
+func TestPlanBulkWorkspaceUpdate(t *testing.T) {
+	workspaces := []*tfe.Workspace{
+		{ID: "ws-1", Name: "payments-api", TerraformVersion: "1.5.7"},
+		{ID: "ws-2", Name: "payments-db", TerraformVersion: "1.9.8"},
+		{ID: "ws-3", Name: "payments-agent", ExecutionMode: "agent", AgentPool: &tfe.AgentPool{ID: "apool-1"}},
+	}
+
+	result := planBulkWorkspaceUpdate("acme", workspaces, &bulkWorkspaceAction{name: BulkActionSetTerraformVersion, value: "1.9.8"})
+	assert.Equal(t, 3, result.MatchedCount)
+	assert.Equal(t, 2, result.ChangeCount)
+	assert.Equal(t, 1, result.UnchangedCount)
+	assert.Equal(t, &BulkWorkspaceChange{ID: "ws-1", Name: "payments-api", Current: "1.5.7", NewValue: "1.9.8", Status: BulkWorkspaceWouldChange}, result.Workspaces[0])
+	assert.Equal(t, BulkWorkspaceUnchanged, result.Workspaces[1].Status)
+
+	result = planBulkWorkspaceUpdate("acme", workspaces, &bulkWorkspaceAction{name: BulkActionSetExecutionMode, value: "agent", agentPoolID: "apool-1"})
+	assert.Equal(t, 2, result.ChangeCount)
+	assert.Equal(t, BulkWorkspaceUnchanged, result.Workspaces[2].Status)
+}
+
+func TestParseTagBindingFilters(t *testing.T) {
+	assert.Equal(t, []*tfe.TagBinding{
+		{Key: "team", Value: "payments"},
+		{Key: "critical"},
+	}, parseTagBindingFilters(" team:payments, ,critical"))
+	assert.Empty(t, parseTagBindingFilters(""))
+}
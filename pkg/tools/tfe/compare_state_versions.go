@@ -0,0 +1,388 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// StateVersionDiff is the difference between the resources and outputs of two state versions
+type StateVersionDiff struct {
+	WorkspaceName    string             `json:"workspace_name"`
+	BaseStateVersion *StateVersionRef   `json:"base_state_version"`
+	StateVersion     *StateVersionRef   `json:"state_version"`
+	Resources        ResourceDiff       `json:"resources"`
+	Outputs          OutputDiff         `json:"outputs"`
+	Summary          *StateVersionTally `json:"summary"`
+}
+
+// StateVersionRef identifies one side of a state version comparison
+type StateVersionRef struct {
+	ID        string `json:"id"`
+	Serial    int64  `json:"serial"`
+	RunID     string `json:"run_id,omitempty"`
+	CreatedAt string `json:"created_at"`
+}
+
+// ResourceDiff lists the resource instances added, removed and changed between two state versions
+type ResourceDiff struct {
+	Added   []string          `json:"added"`
+	Removed []string          `json:"removed"`
+	Changed []*ResourceChange `json:"changed"`
+}
+
+// ResourceChange is a resource instance whose attributes differ between two state versions
+type ResourceChange struct {
+	Address    string   `json:"address"`
+	Attributes []string `json:"changed_attributes"`
+}
+
+// OutputDiff lists the outputs added, removed and changed between two state versions
+type OutputDiff struct {
+	Added   []*OutputChange `json:"added"`
+	Removed []*OutputChange `json:"removed"`
+	Changed []*OutputChange `json:"changed"`
+}
+
+// OutputChange is an output value on either side of a comparison. Sensitive values are redacted unless requested.
+type OutputChange struct {
+	Name      string `json:"name"`
+	Sensitive bool   `json:"sensitive"`
+	Before    any    `json:"before,omitempty"`
+	After     any    `json:"after,omitempty"`
+}
+
+// StateVersionTally counts the differences between two state versions
+type StateVersionTally struct {
+	ResourcesAdded   int `json:"resources_added"`
+	ResourcesRemoved int `json:"resources_removed"`
+	ResourcesChanged int `json:"resources_changed"`
+	OutputsAdded     int `json:"outputs_added"`
+	OutputsRemoved   int `json:"outputs_removed"`
+	OutputsChanged   int `json:"outputs_changed"`
+}
+
+// terraformState is the subset of the Terraform state file format compared by the tool
+type terraformState struct {
+	Outputs   map[string]terraformStateOutput `json:"outputs"`
+	Resources []terraformStateResource        `json:"resources"`
+}
+
+type terraformStateOutput struct {
+	Value     any  `json:"value"`
+	Sensitive bool `json:"sensitive"`
+}
+
+type terraformStateResource struct {
+	Module    string                   `json:"module"`
+	Mode      string                   `json:"mode"`
+	Type      string                   `json:"type"`
+	Name      string                   `json:"name"`
+	Provider  string                   `json:"provider"`
+	Instances []terraformStateInstance `json:"instances"`
+}
+
+type terraformStateInstance struct {
+	IndexKey            any             `json:"index_key"`
+	Attributes          map[string]any  `json:"attributes"`
+	SensitiveAttributes json.RawMessage `json:"sensitive_attributes"`
+}
+
+// CompareStateVersions creates a tool to diff the resources and outputs of two state versions of a workspace.
+func CompareStateVersions(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("compare_hcp_terraform_state_versions",
+			mcp.WithDescription(`Downloads two state versions of a workspace and returns the resource instances and outputs that were added, removed or changed between them, with the names of the changed attributes.
+By default the current state version is compared with the one before it. Use list_state_versions to find state version IDs. Sensitive output values are redacted unless include_sensitive is set; attribute values are never returned.`),
+			mcp.WithTitleAnnotation("Compare two state versions of a workspace"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("terraform_org_name",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+			mcp.WithString("workspace_name",
+				mcp.Required(),
+				mcp.Description("Workspace name"),
+			),
+			mcp.WithString("base_state_version_id",
+				mcp.Description("Optional state version to compare from. Defaults to the state version before state_version_id"),
+			),
+			mcp.WithString("state_version_id",
+				mcp.Description("Optional state version to compare to. Defaults to the current state version"),
+			),
+			mcp.WithBoolean("include_sensitive",
+				mcp.Description("Include the values of sensitive outputs. Exposes secrets to the conversation, only set it when the user asked for them"),
+				mcp.DefaultBool(false),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return compareStateVersionsHandler(ctx, request, logger)
+		},
+	}
+}
+
+func compareStateVersionsHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	orgName, err := request.RequireString("terraform_org_name")
+	if err != nil {
+		return ToolError(logger, "missing required input: terraform_org_name", err)
+	}
+	orgName = strings.TrimSpace(orgName)
+
+	workspaceName, err := request.RequireString("workspace_name")
+	if err != nil {
+		return ToolError(logger, "missing required input: workspace_name", err)
+	}
+	workspaceName = strings.TrimSpace(workspaceName)
+
+	baseID := strings.TrimSpace(request.GetString("base_state_version_id", ""))
+	targetID := strings.TrimSpace(request.GetString("state_version_id", ""))
+	includeSensitive := request.GetBool("include_sensitive", false)
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+
+	target, base, err := resolveStateVersionPair(ctx, tfeClient, orgName, workspaceName, baseID, targetID)
+	if err != nil {
+		return ToolErrorf(logger, "failed to find the state versions to compare: %v", err)
+	}
+
+	baseState, err := downloadState(ctx, tfeClient, base)
+	if err != nil {
+		return ToolErrorf(logger, "failed to download state version %s: %v", base.ID, err)
+	}
+	targetState, err := downloadState(ctx, tfeClient, target)
+	if err != nil {
+		return ToolErrorf(logger, "failed to download state version %s: %v", target.ID, err)
+	}
+
+	diff := diffStates(baseState, targetState, includeSensitive)
+	diff.WorkspaceName = workspaceName
+	diff.BaseStateVersion = newStateVersionRef(base)
+	diff.StateVersion = newStateVersionRef(target)
+
+	buf, err := json.Marshal(diff)
+	if err != nil {
+		return ToolError(logger, "failed to marshal state version diff", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+// resolveStateVersionPair reads the state versions to compare, defaulting to the current state
+// version and the one created before the compared state version
+func resolveStateVersionPair(ctx context.Context, tfeClient *tfe.Client, orgName, workspaceName, baseID, targetID string) (*tfe.StateVersion, *tfe.StateVersion, error) {
+	var target *tfe.StateVersion
+	var err error
+	if targetID != "" {
+		target, err = tfeClient.StateVersions.Read(ctx, targetID)
+	} else {
+		var workspace *tfe.Workspace
+		workspace, err = tfeClient.Workspaces.Read(ctx, orgName, workspaceName)
+		if err != nil {
+			return nil, nil, fmt.Errorf("workspace '%s' not found in org '%s': %w", workspaceName, orgName, err)
+		}
+		target, err = tfeClient.StateVersions.ReadCurrent(ctx, workspace.ID)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if baseID != "" {
+		base, err := tfeClient.StateVersions.Read(ctx, baseID)
+		return target, base, err
+	}
+
+	options := &tfe.StateVersionListOptions{
+		Organization: orgName,
+		Workspace:    workspaceName,
+		ListOptions:  tfe.ListOptions{PageNumber: 1, PageSize: 100},
+	}
+	for {
+		list, err := tfeClient.StateVersions.List(ctx, options)
+		if err != nil {
+			return nil, nil, err
+		}
+		if base := previousStateVersion(list.Items, target); base != nil {
+			return target, base, nil
+		}
+		if list.Pagination == nil || list.NextPage == 0 {
+			return nil, nil, fmt.Errorf("state version %s has no earlier state version to compare with", target.ID)
+		}
+		options.PageNumber = list.NextPage
+	}
+}
+
+// previousStateVersion returns the newest state version with a lower serial than the target
+func previousStateVersion(versions []*tfe.StateVersion, target *tfe.StateVersion) *tfe.StateVersion {
+	var previous *tfe.StateVersion
+	for _, sv := range versions {
+		if sv.ID == target.ID || sv.Serial >= target.Serial {
+			continue
+		}
+		if previous == nil || sv.Serial > previous.Serial {
+			previous = sv
+		}
+	}
+	return previous
+}
+
+func downloadState(ctx context.Context, tfeClient *tfe.Client, sv *tfe.StateVersion) (*terraformState, error) {
+	if sv.DownloadURL == "" {
+		return nil, fmt.Errorf("state version has no download URL")
+	}
+	data, err := tfeClient.StateVersions.Download(ctx, sv.DownloadURL)
+	if err != nil {
+		return nil, err
+	}
+	var state terraformState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("failed to parse state: %w", err)
+	}
+	return &state, nil
+}
+
+func newStateVersionRef(sv *tfe.StateVersion) *StateVersionRef {
+	ref := &StateVersionRef{
+		ID:        sv.ID,
+		Serial:    sv.Serial,
+		CreatedAt: sv.CreatedAt.UTC().Format(time.RFC3339),
+	}
+	if sv.Run != nil {
+		ref.RunID = sv.Run.ID
+	}
+	return ref
+}
+
+// diffStates compares the resource instances and outputs of two states
+func diffStates(base, target *terraformState, includeSensitive bool) *StateVersionDiff {
+	diff := &StateVersionDiff{
+		Resources: ResourceDiff{Added: []string{}, Removed: []string{}, Changed: []*ResourceChange{}},
+		Outputs:   OutputDiff{Added: []*OutputChange{}, Removed: []*OutputChange{}, Changed: []*OutputChange{}},
+	}
+
+	baseInstances := stateInstances(base)
+	targetInstances := stateInstances(target)
+	for _, address := range sortedKeys(targetInstances) {
+		before, ok := baseInstances[address]
+		if !ok {
+			diff.Resources.Added = append(diff.Resources.Added, address)
+			continue
+		}
+		if changed := changedAttributes(before, targetInstances[address]); len(changed) > 0 {
+			diff.Resources.Changed = append(diff.Resources.Changed, &ResourceChange{Address: address, Attributes: changed})
+		}
+	}
+	for _, address := range sortedKeys(baseInstances) {
+		if _, ok := targetInstances[address]; !ok {
+			diff.Resources.Removed = append(diff.Resources.Removed, address)
+		}
+	}
+
+	redact := func(output terraformStateOutput) any {
+		if output.Sensitive && !includeSensitive {
+			return nil
+		}
+		return output.Value
+	}
+	for _, name := range sortedKeys(target.Outputs) {
+		after := target.Outputs[name]
+		before, ok := base.Outputs[name]
+		switch {
+		case !ok:
+			diff.Outputs.Added = append(diff.Outputs.Added, &OutputChange{Name: name, Sensitive: after.Sensitive, After: redact(after)})
+		case !reflect.DeepEqual(before.Value, after.Value) || before.Sensitive != after.Sensitive:
+			diff.Outputs.Changed = append(diff.Outputs.Changed, &OutputChange{
+				Name:      name,
+				Sensitive: before.Sensitive || after.Sensitive,
+				Before:    redact(before),
+				After:     redact(after),
+			})
+		}
+	}
+	for _, name := range sortedKeys(base.Outputs) {
+		if _, ok := target.Outputs[name]; !ok {
+			before := base.Outputs[name]
+			diff.Outputs.Removed = append(diff.Outputs.Removed, &OutputChange{Name: name, Sensitive: before.Sensitive, Before: redact(before)})
+		}
+	}
+
+	diff.Summary = &StateVersionTally{
+		ResourcesAdded:   len(diff.Resources.Added),
+		ResourcesRemoved: len(diff.Resources.Removed),
+		ResourcesChanged: len(diff.Resources.Changed),
+		OutputsAdded:     len(diff.Outputs.Added),
+		OutputsRemoved:   len(diff.Outputs.Removed),
+		OutputsChanged:   len(diff.Outputs.Changed),
+	}
+	return diff
+}
+
+// stateInstances indexes the attributes of every resource instance of a state by address
+func stateInstances(state *terraformState) map[string]map[string]any {
+	instances := make(map[string]map[string]any)
+	for _, resource := range state.Resources {
+		address := stateResourceAddress(resource)
+		for _, instance := range resource.Instances {
+			instances[stateInstanceAddress(address, instance.IndexKey)] = instance.Attributes
+		}
+	}
+	return instances
+}
+
+// stateResourceAddress returns the address of a state resource, without instance key
+func stateResourceAddress(resource terraformStateResource) string {
+	address := resource.Type + "." + resource.Name
+	if resource.Mode == "data" {
+		address = "data." + address
+	}
+	if resource.Module != "" {
+		address = resource.Module + "." + address
+	}
+	return address
+}
+
+// stateInstanceAddress returns the address of a resource instance from the address of its resource
+func stateInstanceAddress(address string, indexKey any) string {
+	switch key := indexKey.(type) {
+	case nil:
+		return address
+	case string:
+		return fmt.Sprintf("%s[%q]", address, key)
+	default:
+		return fmt.Sprintf("%s[%v]", address, key)
+	}
+}
+
+// changedAttributes returns the sorted names of the top-level attributes that differ
+func changedAttributes(before, after map[string]any) []string {
+	var changed []string
+	for name, value := range after {
+		if previous, ok := before[name]; !ok || !reflect.DeepEqual(previous, value) {
+			changed = append(changed, name)
+		}
+	}
+	for name := range before {
+		if _, ok := after[name]; !ok {
+			changed = append(changed, name)
+		}
+	}
+	sort.Strings(changed)
+	return changed
+}
@@ -0,0 +1,107 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/go-tfe"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const baseStateJSON = `{
+  "version": 4,
+  "serial": 7,
+  "outputs": {
+    "vpc_id": {"value": "vpc-1", "type": "string"},
+    "db_password": {"value": "old", "type": "string", "sensitive": true},
+    "legacy": {"value": 1, "type": "number"}
+  },
+  "resources": [
+    {"mode": "managed", "type": "aws_vpc", "name": "main", "instances": [{"attributes": {"id": "vpc-1", "cidr_block": "10.0.0.0/16"}}]},
+    {"mode": "managed", "type": "aws_subnet", "name": "private", "instances": [
+      {"index_key": 0, "attributes": {"id": "subnet-0"}},
+      {"index_key": 1, "attributes": {"id": "subnet-1"}}
+    ]},
+    {"mode": "data", "type": "aws_region", "name": "current", "instances": [{"attributes": {"name": "us-east-1"}}]}
+  ]
+}`
+
+const targetStateJSON = `{
+  "version": 4,
+  "serial": 8,
+  "outputs": {
+    "vpc_id": {"value": "vpc-1", "type": "string"},
+    "db_password": {"value": "new", "type": "string", "sensitive": true},
+    "endpoint": {"value": "db.internal", "type": "string"}
+  },
+  "resources": [
+    {"mode": "managed", "type": "aws_vpc", "name": "main", "instances": [{"attributes": {"id": "vpc-1", "cidr_block": "10.1.0.0/16", "tags": {"env": "prod"}}}]},
+    {"mode": "managed", "type": "aws_subnet", "name": "private", "instances": [
+      {"index_key": 0, "attributes": {"id": "subnet-0"}}
+    ]},
+    {"module": "module.db", "mode": "managed", "type": "aws_db_instance", "name": "this", "instances": [{"index_key": "primary", "attributes": {"id": "db-1"}}]},
+    {"mode": "data", "type": "aws_region", "name": "current", "instances": [{"attributes": {"name": "us-east-1"}}]}
+  ]
+}`
+
+func TestCompareStateVersions(t *testing.T) {
+	logger := log.New()
+	logger.SetLevel(log.ErrorLevel)
+
+	t.Run("tool creation", func(t *testing.T) {
+		tool := CompareStateVersions(logger)
+
+		assert.Equal(t, "compare_hcp_terraform_state_versions", tool.Tool.Name)
+		assert.NotNil(t, tool.Handler)
+		assert.True(t, *tool.Tool.Annotations.ReadOnlyHint)
+
+		assert.Contains(t, tool.Tool.InputSchema.Required, "terraform_org_name")
+		assert.Contains(t, tool.Tool.InputSchema.Required, "workspace_name")
+		assert.NotContains(t, tool.Tool.InputSchema.Required, "state_version_id")
+		assert.NotContains(t, tool.Tool.InputSchema.Required, "base_state_version_id")
+	})
+
+	var base, target terraformState
+	require.NoError(t, json.Unmarshal([]byte(baseStateJSON), &base))
+	require.NoError(t, json.Unmarshal([]byte(targetStateJSON), &target))
+
+	t.Run("resources", func(t *testing.T) {
+		diff := diffStates(&base, &target, false)
+
+		assert.Equal(t, []string{`module.db.aws_db_instance.this["primary"]`}, diff.Resources.Added)
+		assert.Equal(t, []string{"aws_subnet.private[1]"}, diff.Resources.Removed)
+		assert.Equal(t, []*ResourceChange{{Address: "aws_vpc.main", Attributes: []string{"cidr_block", "tags"}}}, diff.Resources.Changed)
+		assert.Equal(t, &StateVersionTally{
+			ResourcesAdded: 1, ResourcesRemoved: 1, ResourcesChanged: 1,
+			OutputsAdded: 1, OutputsRemoved: 1, OutputsChanged: 1,
+		}, diff.Summary)
+	})
+
+	t.Run("outputs", func(t *testing.T) {
+		diff := diffStates(&base, &target, false)
+
+		assert.Equal(t, []*OutputChange{{Name: "endpoint", After: "db.internal"}}, diff.Outputs.Added)
+		assert.Equal(t, []*OutputChange{{Name: "legacy", Before: float64(1)}}, diff.Outputs.Removed)
+		assert.Equal(t, []*OutputChange{{Name: "db_password", Sensitive: true}}, diff.Outputs.Changed)
+
+		diff = diffStates(&base, &target, true)
+		assert.Equal(t, []*OutputChange{{Name: "db_password", Sensitive: true, Before: "old", After: "new"}}, diff.Outputs.Changed)
+	})
+
+	t.Run("previous state version", func(t *testing.T) {
+		target := &tfe.StateVersion{ID: "sv-3", Serial: 8}
+		versions := []*tfe.StateVersion{
+			{ID: "sv-3", Serial: 8},
+			{ID: "sv-2", Serial: 7},
+			{ID: "sv-1", Serial: 5},
+		}
+
+		assert.Equal(t, "sv-2", previousStateVersion(versions, target).ID)
+		assert.Nil(t, previousStateVersion(versions[:1], target))
+	})
+}
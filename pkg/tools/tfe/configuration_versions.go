@@ -0,0 +1,195 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	defaultConfigurationWaitTimeout = 2 * time.Minute
+	maxConfigurationWaitTimeout     = 15 * time.Minute
+	configurationPollInterval       = time.Second
+)
+
+// ConfigurationVersionWaitResult is returned once a configuration version is usable or errored
+type ConfigurationVersionWaitResult struct {
+	ConfigurationVersionID string `json:"configuration_version_id"`
+	Status                 string `json:"status"`
+	Usable                 bool   `json:"usable"`
+	ElapsedSeconds         int    `json:"elapsed_seconds"`
+	Message                string `json:"message"`
+}
+
+// WaitForConfigurationVersion creates a tool that waits until a configuration version is uploaded and can be used by runs.
+func WaitForConfigurationVersion(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("wait_for_configuration_version",
+			mcp.WithDescription(`Waits until a configuration version is uploaded and usable by runs, or errored, then returns its status. Configuration versions are pending until their files are uploaded and processed, and runs created with a pending configuration version fail. Pass configuration_version_id, or terraform_org_name and workspace_name to wait for the workspace's current configuration version.`),
+			mcp.WithTitleAnnotation("Wait for a configuration version to be uploaded"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("configuration_version_id",
+				mcp.Description("The ID of the configuration version to wait for, e.g. 'cv-abc123'"),
+			),
+			mcp.WithString("terraform_org_name",
+				mcp.Description("The Terraform organization name, with workspace_name instead of configuration_version_id"),
+			),
+			mcp.WithString("workspace_name",
+				mcp.Description("The name of the workspace whose current configuration version to wait for"),
+			),
+			mcp.WithNumber("timeout_seconds",
+				mcp.Description(fmt.Sprintf("Maximum time to wait in seconds, at most %d", int(maxConfigurationWaitTimeout.Seconds()))),
+				mcp.DefaultNumber(defaultConfigurationWaitTimeout.Seconds()),
+				mcp.Min(1),
+				mcp.Max(maxConfigurationWaitTimeout.Seconds()),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return waitForConfigurationVersionHandler(ctx, request, logger)
+		},
+	}
+}
+
+func waitForConfigurationVersionHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	cvID := strings.TrimSpace(request.GetString("configuration_version_id", ""))
+	terraformOrgName := strings.TrimSpace(request.GetString("terraform_org_name", ""))
+	workspaceName := strings.TrimSpace(request.GetString("workspace_name", ""))
+	if cvID == "" && (terraformOrgName == "" || workspaceName == "") {
+		return ToolError(logger, "either configuration_version_id, or terraform_org_name and workspace_name are required", nil)
+	}
+
+	timeout := time.Duration(request.GetFloat("timeout_seconds", defaultConfigurationWaitTimeout.Seconds()) * float64(time.Second))
+	if timeout <= 0 || timeout > maxConfigurationWaitTimeout {
+		return ToolErrorf(logger, "timeout_seconds must be between 1 and %d", int(maxConfigurationWaitTimeout.Seconds()))
+	}
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+
+	if cvID == "" {
+		workspace, err := tfeClient.Workspaces.Read(ctx, terraformOrgName, workspaceName)
+		if err != nil {
+			return ToolErrorf(logger, "workspace '%s' not found in org '%s': %v", workspaceName, terraformOrgName, err)
+		}
+		if workspace.CurrentConfigurationVersion == nil {
+			return ToolErrorf(logger, "workspace '%s' has no configuration version", workspaceName)
+		}
+		cvID = workspace.CurrentConfigurationVersion.ID
+	}
+
+	readConfigurationVersion := func(ctx context.Context) (*tfe.ConfigurationVersion, error) {
+		return tfeClient.ConfigurationVersions.Read(ctx, cvID)
+	}
+	onStatus := func(elapsed time.Duration, status tfe.ConfigurationStatus) {
+		sendProgress(ctx, request, int(elapsed.Seconds()), int(timeout.Seconds()), fmt.Sprintf("Configuration version %s is %s", cvID, status), logger)
+	}
+
+	result, err := waitForConfigurationVersion(ctx, cvID, readConfigurationVersion, timeout, configurationPollInterval, onStatus)
+	if err != nil {
+		return ToolErrorf(logger, "failed to wait for configuration version %s: %v", cvID, err)
+	}
+
+	buf, err := json.Marshal(result)
+	if err != nil {
+		return ToolError(logger, "failed to marshal configuration version status", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+// waitForConfigurationVersion polls a configuration version until it is uploaded, errored or archived,
+// or the timeout expires
+func waitForConfigurationVersion(ctx context.Context, cvID string, readConfigurationVersion func(context.Context) (*tfe.ConfigurationVersion, error), timeout, pollInterval time.Duration, onStatus func(time.Duration, tfe.ConfigurationStatus)) (*ConfigurationVersionWaitResult, error) {
+	start := time.Now()
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	result := &ConfigurationVersionWaitResult{ConfigurationVersionID: cvID}
+	var lastStatus tfe.ConfigurationStatus
+	for {
+		cv, err := readConfigurationVersion(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		elapsed := time.Since(start)
+		result.Status = string(cv.Status)
+		result.ElapsedSeconds = int(elapsed.Seconds())
+		if cv.Status != lastStatus {
+			lastStatus = cv.Status
+			onStatus(elapsed, cv.Status)
+		}
+
+		switch cv.Status {
+		case tfe.ConfigurationUploaded:
+			result.Usable = true
+			result.Message = "Configuration version is uploaded and can be used by runs"
+			return result, nil
+		case tfe.ConfigurationErrored:
+			result.Message = fmt.Sprintf("Configuration version errored: %s", cv.ErrorMessage)
+			return result, nil
+		case tfe.ConfigurationArchived:
+			result.Message = "Configuration version is archived, upload a new configuration version to create runs"
+			return result, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-deadline.C:
+			result.Message = fmt.Sprintf("Configuration version is still %s after %s, call wait_for_configuration_version again to keep waiting", cv.Status, timeout)
+			return result, nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// withWaitForConfiguration adds the parameter to wait for the workspace's configuration before creating a run
+func withWaitForConfiguration() mcp.ToolOption {
+	return mcp.WithBoolean("wait_for_configuration",
+		mcp.Description("Wait until the workspace's current configuration version is uploaded before creating the run, so that runs are not created with a configuration that is still being uploaded"),
+		mcp.DefaultBool(true),
+	)
+}
+
+// waitForWorkspaceConfiguration waits until the current configuration version of a workspace is usable,
+// unless the request disables it. Runs use that configuration version unless another one is given.
+func waitForWorkspaceConfiguration(ctx context.Context, request mcp.CallToolRequest, tfeClient *tfe.Client, workspace *tfe.Workspace, logger *log.Logger) error {
+	if !request.GetBool("wait_for_configuration", true) || workspace.CurrentConfigurationVersion == nil {
+		return nil
+	}
+	cvID := workspace.CurrentConfigurationVersion.ID
+	readConfigurationVersion := func(ctx context.Context) (*tfe.ConfigurationVersion, error) {
+		return tfeClient.ConfigurationVersions.Read(ctx, cvID)
+	}
+	onStatus := func(elapsed time.Duration, status tfe.ConfigurationStatus) {
+		if status != tfe.ConfigurationUploaded {
+			sendProgress(ctx, request, int(elapsed.Seconds()), int(defaultConfigurationWaitTimeout.Seconds()), fmt.Sprintf("Waiting for configuration version %s, it is %s", cvID, status), logger)
+		}
+	}
+
+	result, err := waitForConfigurationVersion(ctx, cvID, readConfigurationVersion, defaultConfigurationWaitTimeout, configurationPollInterval, onStatus)
+	if err != nil {
+		return fmt.Errorf("reading configuration version %s: %w", cvID, err)
+	}
+	if !result.Usable {
+		return fmt.Errorf("configuration version %s cannot be used: %s", cvID, result.Message)
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-tfe"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWaitForConfigurationVersion(t *testing.T) {
+	logger := log.New()
+	logger.SetLevel(log.ErrorLevel)
+
+	t.Run("tool creation", func(t *testing.T) {
+		tool := WaitForConfigurationVersion(logger)
+
+		assert.Equal(t, "wait_for_configuration_version", tool.Tool.Name)
+		assert.True(t, *tool.Tool.Annotations.ReadOnlyHint)
+		assert.Empty(t, tool.Tool.InputSchema.Required)
+	})
+
+	t.Run("run tools accept wait_for_configuration", func(t *testing.T) {
+		assert.Contains(t, CreateRun(logger).Tool.InputSchema.Properties, "wait_for_configuration")
+		assert.Contains(t, CreateRunSafe(logger).Tool.InputSchema.Properties, "wait_for_configuration")
+		assert.Contains(t, GuardedDeployment(logger).Tool.InputSchema.Properties, "wait_for_configuration")
+	})
+
+	// readConfigurationVersions returns the given statuses in order, repeating the last one
+	readConfigurationVersions := func(statuses ...tfe.ConfigurationStatus) func(context.Context) (*tfe.ConfigurationVersion, error) {
+		calls := 0
+		return func(context.Context) (*tfe.ConfigurationVersion, error) {
+			status := statuses[min(calls, len(statuses)-1)]
+			calls++
+			return &tfe.ConfigurationVersion{ID: "cv-1", Status: status, ErrorMessage: "archive is invalid"}, nil
+		}
+	}
+
+	t.Run("uploaded", func(t *testing.T) {
+		var statuses []tfe.ConfigurationStatus
+		result, err := waitForConfigurationVersion(context.Background(), "cv-1", readConfigurationVersions(
+			tfe.ConfigurationPending,
+			tfe.ConfigurationPending,
+			tfe.ConfigurationFetching,
+			tfe.ConfigurationUploaded,
+		), time.Second, time.Millisecond, func(_ time.Duration, status tfe.ConfigurationStatus) {
+			statuses = append(statuses, status)
+		})
+		require.NoError(t, err)
+
+		assert.True(t, result.Usable)
+		assert.Equal(t, "uploaded", result.Status)
+		assert.Equal(t, []tfe.ConfigurationStatus{tfe.ConfigurationPending, tfe.ConfigurationFetching, tfe.ConfigurationUploaded}, statuses)
+	})
+
+	t.Run("errored", func(t *testing.T) {
+		result, err := waitForConfigurationVersion(context.Background(), "cv-1", readConfigurationVersions(
+			tfe.ConfigurationPending,
+			tfe.ConfigurationErrored,
+		), time.Second, time.Millisecond, func(time.Duration, tfe.ConfigurationStatus) {})
+		require.NoError(t, err)
+
+		assert.False(t, result.Usable)
+		assert.Equal(t, "errored", result.Status)
+		assert.Contains(t, result.Message, "archive is invalid")
+	})
+
+	t.Run("timed out", func(t *testing.T) {
+		result, err := waitForConfigurationVersion(context.Background(), "cv-1", readConfigurationVersions(
+			tfe.ConfigurationPending,
+		), 20*time.Millisecond, time.Millisecond, func(time.Duration, tfe.ConfigurationStatus) {})
+		require.NoError(t, err)
+
+		assert.False(t, result.Usable)
+		assert.Equal(t, "pending", result.Status)
+		assert.Contains(t, result.Message, "wait_for_configuration_version")
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := waitForConfigurationVersion(ctx, "cv-1", readConfigurationVersions(tfe.ConfigurationPending), time.Second, time.Millisecond, func(time.Duration, tfe.ConfigurationStatus) {})
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
@@ -23,7 +23,7 @@ import (
 func CreateNoCodeWorkspace(logger *log.Logger, mcpServer *server.MCPServer) server.ServerTool {
 	return server.ServerTool{
 		Tool: mcp.NewTool("create_no_code_workspace",
-			mcp.WithDescription(`Creates a new Terraform No Code module workspace. The tool uses the MCP elicitation feature to automatically discover and collect required variables from the user.`),
+			mcp.WithDescription(`Creates a new Terraform No Code module workspace. The input variables of the module can be passed in 'variables'; the tool uses the MCP elicitation feature to prompt the user for the ones that are missing, with the type, description and allowed values of each variable. Use get_no_code_module to see the input variables beforehand.`),
 			mcp.WithTitleAnnotation("Create a No Code module workspace"),
 			mcp.WithOpenWorldHintAnnotation(true),
 			mcp.WithReadOnlyHintAnnotation(false),
@@ -44,6 +44,9 @@ func CreateNoCodeWorkspace(logger *log.Logger, mcpServer *server.MCPServer) serv
 				mcp.Description("Whether to automatically apply changes in the workspace: 'true' or 'false'"),
 				mcp.DefaultBool(false),
 			),
+			mcp.WithObject("variables",
+				mcp.Description("Optional values of the input variables of the module, keyed by variable name. The user is prompted for the missing ones"),
+			),
 		),
 		Handler: func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			return createNoCodeWorkspaceHandler(ctx, req, logger, mcpServer)
@@ -74,14 +77,29 @@ func createNoCodeWorkspaceHandler(ctx context.Context, request mcp.CallToolReque
 		return ToolError(logger, err.Error(), nil)
 	}
 
-	elicitationProperties, requestedVars := buildElicitationSchema(moduleMetadata, noCodeModule)
-
-	result, err := requestVariableValues(ctx, mcpServer, params.noCodeModuleID, elicitationProperties, requestedVars)
-	if err != nil {
-		return ToolError(logger, err.Error(), nil)
+	values := make(map[string]any, len(params.variables))
+	for name, value := range params.variables {
+		values[name] = value
+	}
+	elicitationProperties, missingVars, requiredVars := buildElicitationSchema(moduleMetadata, noCodeModule, values)
+	if len(missingVars) > 0 {
+		result, err := requestVariableValues(ctx, mcpServer, params.noCodeModuleID, missingProperties(elicitationProperties, missingVars), requiredVars)
+		if err != nil {
+			if len(requiredVars) > 0 {
+				return ToolErrorf(logger, "%v - pass the required variables %s in 'variables' instead", err, strings.Join(requiredVars, ", "))
+			}
+		} else {
+			answers, err := processElicitationResponse(result)
+			if err != nil {
+				return ToolError(logger, err.Error(), nil)
+			}
+			for name, value := range answers {
+				values[name] = value
+			}
+		}
 	}
 
-	variables, err := processElicitationResponse(result, requestedVars, elicitationProperties)
+	variables, err := noCodeVariables(moduleMetadata, values, elicitationProperties)
 	if err != nil {
 		return ToolError(logger, err.Error(), nil)
 	}
@@ -110,6 +128,7 @@ type workspaceParams struct {
 	workspaceName  string
 	projectID      string
 	autoApply      bool
+	variables      map[string]any
 }
 
 func extractRequestParams(request mcp.CallToolRequest) (*workspaceParams, error) {
@@ -128,12 +147,20 @@ func extractRequestParams(request mcp.CallToolRequest) (*workspaceParams, error)
 		return nil, fmt.Errorf("missing required input: project_id")
 	}
 
-	return &workspaceParams{
+	params := &workspaceParams{
 		noCodeModuleID: noCodeModuleID,
 		workspaceName:  workspaceName,
 		projectID:      projectID,
 		autoApply:      request.GetBool("auto_apply", false),
-	}, nil
+	}
+	if raw, ok := request.GetArguments()["variables"]; ok && raw != nil {
+		variables, ok := raw.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("variables must be an object of variable names to values, got %T", raw)
+		}
+		params.variables = variables
+	}
+	return params, nil
 }
 
 func fetchModuleData(ctx context.Context, tfeClient *tfe.Client, projectID, noCodeModuleID string) (*tfe.Project, *tfe.RegistryNoCodeModule, *client.ModuleMetadata, error) {
@@ -142,6 +169,17 @@ func fetchModuleData(ctx context.Context, tfeClient *tfe.Client, projectID, noCo
 		return nil, nil, nil, fmt.Errorf("failed to read project: %w", err)
 	}
 
+	noCodeModule, _, moduleMetadata, err := fetchNoCodeModule(ctx, tfeClient, noCodeModuleID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return project, noCodeModule, moduleMetadata, nil
+}
+
+// fetchNoCodeModule reads a No Code module with its variable options, the
+// registry module it is built from and the metadata of its pinned version.
+func fetchNoCodeModule(ctx context.Context, tfeClient *tfe.Client, noCodeModuleID string) (*tfe.RegistryNoCodeModule, *tfe.RegistryModule, *client.ModuleMetadata, error) {
 	noCodeModule, err := tfeClient.RegistryNoCodeModules.Read(ctx, noCodeModuleID, &tfe.RegistryNoCodeModuleReadOptions{
 		Include: []tfe.RegistryNoCodeModuleIncludeOpt{tfe.RegistryNoCodeIncludeVariableOptions},
 	})
@@ -165,20 +203,38 @@ func fetchModuleData(ctx context.Context, tfeClient *tfe.Client, projectID, noCo
 		return nil, nil, nil, fmt.Errorf("failed to parse module metadata: %w", err)
 	}
 
-	return project, noCodeModule, &moduleMetadata, nil
+	return noCodeModule, registryModule, &moduleMetadata, nil
 }
 
-func buildElicitationSchema(moduleMetadata *client.ModuleMetadata, noCodeModule *tfe.RegistryNoCodeModule) (map[string]any, []string) {
+// buildElicitationSchema returns the schema of the input variables of a No Code module, with the
+// variables that have no value yet and those of them that are required
+func buildElicitationSchema(moduleMetadata *client.ModuleMetadata, noCodeModule *tfe.RegistryNoCodeModule, values map[string]any) (map[string]any, []string, []string) {
 	elicitationProperties := make(map[string]any)
-	requestedVars := make([]string, 0, len(moduleMetadata.Data.Attributes.InputVariables))
+	missingVars := make([]string, 0, len(moduleMetadata.Data.Attributes.InputVariables))
+	requiredVars := make([]string, 0, len(moduleMetadata.Data.Attributes.InputVariables))
 
 	for _, inputVar := range moduleMetadata.Data.Attributes.InputVariables {
 		property := buildPropertySchema(inputVar, noCodeModule)
 		elicitationProperties[inputVar.Name] = property
-		requestedVars = append(requestedVars, inputVar.Name)
+		if _, ok := values[inputVar.Name]; ok {
+			continue
+		}
+		missingVars = append(missingVars, inputVar.Name)
+		if inputVar.Required {
+			requiredVars = append(requiredVars, inputVar.Name)
+		}
 	}
 
-	return elicitationProperties, requestedVars
+	return elicitationProperties, missingVars, requiredVars
+}
+
+// missingProperties returns the schema of the variables the user is prompted for
+func missingProperties(elicitationProperties map[string]any, missingVars []string) map[string]any {
+	properties := make(map[string]any, len(missingVars))
+	for _, name := range missingVars {
+		properties[name] = elicitationProperties[name]
+	}
+	return properties
 }
 
 func buildPropertySchema(inputVar struct {
@@ -261,7 +317,7 @@ func convertToBoolEnum(options []string) []bool {
 func requestVariableValues(ctx context.Context, mcpServer *server.MCPServer, moduleID string, properties map[string]any, required []string) (*mcp.ElicitationResult, error) {
 	request := mcp.ElicitationRequest{
 		Params: mcp.ElicitationParams{
-			Message: fmt.Sprintf("The No Code module '%s' requires %d variable(s) to create the workspace. Please provide values for the required variables.", moduleID, len(required)),
+			Message: fmt.Sprintf("The No Code module '%s' requires %d variable(s) to create the workspace. Please provide values for the required variables, optional ones can be left empty to use their default.", moduleID, len(required)),
 			RequestedSchema: map[string]any{
 				"type":       "object",
 				"properties": properties,
@@ -278,34 +334,52 @@ func requestVariableValues(ctx context.Context, mcpServer *server.MCPServer, mod
 	return result, nil
 }
 
-func processElicitationResponse(result *mcp.ElicitationResult, requestedVars []string, elicitationProperties map[string]any) ([]*tfe.Variable, error) {
+// processElicitationResponse returns the values the user answered with
+func processElicitationResponse(result *mcp.ElicitationResult) (map[string]any, error) {
 	switch result.Action {
 	case mcp.ElicitationResponseActionDecline:
 		return nil, fmt.Errorf("workspace creation declined by user")
 	case mcp.ElicitationResponseActionCancel:
 		return nil, fmt.Errorf("workspace creation cancelled by user")
 	case mcp.ElicitationResponseActionAccept:
-		return extractVariablesFromResponse(result.Content, requestedVars, elicitationProperties)
+		data, ok := result.Content.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("elicitation response content is not a map, got %T", result.Content)
+		}
+		return data, nil
 	default:
 		return nil, fmt.Errorf("unexpected elicitation response action: %s", result.Action)
 	}
 }
 
-func extractVariablesFromResponse(content any, requestedVars []string, elicitationProperties map[string]any) ([]*tfe.Variable, error) {
-	data, ok := content.(map[string]any)
-	if !ok {
-		return nil, fmt.Errorf("elicitation response content is not a map, got %T", content)
-	}
-
-	variables := make([]*tfe.Variable, 0, len(requestedVars))
-	for _, varName := range requestedVars {
-		variable, err := createVariable(varName, data, elicitationProperties)
+// noCodeVariables converts the values of the input variables of a No Code module to workspace
+// variables. Optional variables without a value are left out so that their default applies.
+func noCodeVariables(moduleMetadata *client.ModuleMetadata, values map[string]any, elicitationProperties map[string]any) ([]*tfe.Variable, error) {
+	inputs := moduleMetadata.Data.Attributes.InputVariables
+	known := make(map[string]bool, len(inputs))
+	variables := make([]*tfe.Variable, 0, len(inputs))
+	for _, inputVar := range inputs {
+		known[inputVar.Name] = true
+		value, exists := values[inputVar.Name]
+		if !exists || value == nil || value == "" {
+			if inputVar.Required {
+				return nil, fmt.Errorf("required variable '%s' is missing", inputVar.Name)
+			}
+			continue
+		}
+		variable, err := createVariable(inputVar.Name, values, elicitationProperties)
 		if err != nil {
 			return nil, err
 		}
+		variable.Sensitive = inputVar.Sensitive
 		variables = append(variables, variable)
 	}
 
+	for name := range values {
+		if !known[name] {
+			return nil, fmt.Errorf("variable '%s' is not an input variable of the module", name)
+		}
+	}
 	return variables, nil
 }
 
@@ -353,6 +427,11 @@ func convertVariableValue(varName, varType string, valueRaw any) (string, error)
 		return convertNumberValue(varName, valueRaw)
 
 	case "boolean":
+		if strValue, ok := valueRaw.(string); ok {
+			if boolValue, err := strconv.ParseBool(strValue); err == nil {
+				return fmt.Sprintf("%t", boolValue), nil
+			}
+		}
 		boolValue, ok := valueRaw.(bool)
 		if !ok {
 			return "", fmt.Errorf("variable '%s' must be a boolean, got %T", varName, valueRaw)
@@ -4,11 +4,15 @@
 package tools
 
 import (
+	"encoding/json"
 	"testing"
 
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
 	"github.com/mark3labs/mcp-go/server"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestCreateNoCodeWorkspace(t *testing.T) {
@@ -35,6 +39,8 @@ func TestCreateNoCodeWorkspace(t *testing.T) {
 		assert.Contains(t, tool.Tool.InputSchema.Properties, "no_code_module_id")
 		assert.Contains(t, tool.Tool.InputSchema.Properties, "workspace_name")
 		assert.Contains(t, tool.Tool.InputSchema.Properties, "auto_apply")
+		assert.Contains(t, tool.Tool.InputSchema.Properties, "variables")
+		assert.NotContains(t, tool.Tool.InputSchema.Required, "variables")
 
 		// Verify the tool has elicitation capabilities through its configuration
 		// The WithOpenWorldHintAnnotation(true) allows for dynamic parameter acceptance
@@ -47,3 +53,56 @@ func TestCreateNoCodeWorkspace(t *testing.T) {
 		assert.NotNil(t, tool.Handler)
 	})
 }
+
+func TestNoCodeVariables(t *testing.T) {
+	var moduleMetadata client.ModuleMetadata
+	require.NoError(t, json.Unmarshal([]byte(`{"data":{"attributes":{"input-variables":[
+		{"name":"region","type":"string","required":true},
+		{"name":"instance_count","type":"number","required":true},
+		{"name":"monitoring","type":"bool","required":false},
+		{"name":"db_password","type":"string","required":true,"sensitive":true}
+	]}}}`), &moduleMetadata))
+	noCodeModule := &tfe.RegistryNoCodeModule{
+		VariableOptions: []*tfe.NoCodeVariableOption{{VariableName: "region", Options: []string{"us-east-1", "eu-west-1"}}},
+	}
+
+	t.Run("only missing variables are prompted", func(t *testing.T) {
+		values := map[string]any{"region": "eu-west-1"}
+		properties, missing, required := buildElicitationSchema(&moduleMetadata, noCodeModule, values)
+
+		assert.Equal(t, []string{"instance_count", "monitoring", "db_password"}, missing)
+		assert.Equal(t, []string{"instance_count", "db_password"}, required)
+		assert.Equal(t, []string{"us-east-1", "eu-west-1"}, properties["region"].(map[string]any)["enum"])
+		assert.NotContains(t, missingProperties(properties, missing), "region")
+	})
+
+	t.Run("optional variables without a value keep their default", func(t *testing.T) {
+		values := map[string]any{"region": "eu-west-1", "instance_count": float64(2), "db_password": "s3cret"}
+		properties, _, _ := buildElicitationSchema(&moduleMetadata, noCodeModule, values)
+
+		variables, err := noCodeVariables(&moduleMetadata, values, properties)
+		require.NoError(t, err)
+		require.Len(t, variables, 3)
+		assert.Equal(t, "2", variables[1].Value)
+		assert.True(t, variables[2].Sensitive)
+		assert.False(t, variables[0].Sensitive)
+	})
+
+	t.Run("missing required and unknown variables are rejected", func(t *testing.T) {
+		values := map[string]any{"region": "eu-west-1", "instance_count": "2"}
+		properties, _, _ := buildElicitationSchema(&moduleMetadata, noCodeModule, values)
+		_, err := noCodeVariables(&moduleMetadata, values, properties)
+		assert.ErrorContains(t, err, "db_password")
+
+		values["db_password"] = "s3cret"
+		values["unknown"] = "value"
+		_, err = noCodeVariables(&moduleMetadata, values, properties)
+		assert.ErrorContains(t, err, "not an input variable")
+	})
+
+	t.Run("booleans are accepted as strings", func(t *testing.T) {
+		value, err := convertVariableValue("monitoring", "boolean", "true")
+		require.NoError(t, err)
+		assert.Equal(t, "true", value)
+	})
+}
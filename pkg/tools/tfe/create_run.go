@@ -20,7 +20,7 @@ import (
 func CreateRunSafe(logger *log.Logger) server.ServerTool {
 	return server.ServerTool{
 		Tool: mcp.NewTool("create_run",
-			mcp.WithDescription(`Creates a new Terraform run in the specified workspace.`),
+			mcp.WithDescription(`Creates a new Terraform run in the specified workspace. Run-specific variables are validated against the variables declared in the workspace's configuration before the run is created.`),
 			mcp.WithTitleAnnotation("Create a new Terraform run"),
 			mcp.WithReadOnlyHintAnnotation(false),
 			mcp.WithDestructiveHintAnnotation(false),
@@ -41,6 +41,9 @@ func CreateRunSafe(logger *log.Logger) server.ServerTool {
 				mcp.Description("Optional message for the run"),
 				mcp.DefaultString("Triggered via Terraform MCP Server"),
 			),
+			withRunVariables(),
+			withWaitForConfiguration(),
+			withOnBehalfOf(),
 		),
 		Handler: func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			return createRunSafeHandler(ctx, req, logger)
@@ -63,6 +66,8 @@ func createRunSafeHandler(ctx context.Context, request mcp.CallToolRequest, logg
 
 	runType := request.GetString("run_type", "plan_and_apply")
 	message := request.GetString("message", "Triggered via Terraform MCP Server")
+	requester := onBehalfOf(request)
+	message = annotateRequester(message, requester)
 
 	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
 	if err != nil {
@@ -92,10 +97,22 @@ func createRunSafeHandler(ctx context.Context, request mcp.CallToolRequest, logg
 		options.Message = &message
 	}
 
+	if err := waitForWorkspaceConfiguration(ctx, request, tfeClient, workspace, logger); err != nil {
+		return ToolError(logger, "the workspace configuration is not ready", err)
+	}
+	if err := applyRunVariables(ctx, tfeClient, workspace, request, options, logger); err != nil {
+		return ToolError(logger, "failed to prepare run variables", err)
+	}
+
 	run, err := tfeClient.Runs.Create(ctx, *options)
 	if err != nil {
 		return ToolError(logger, "failed to create run", err)
 	}
+	auditLog(logger, "create_run", requester, log.Fields{
+		"workspace_id": workspace.ID,
+		"run_id":       run.ID,
+		"run_type":     runType,
+	})
 
 	var buf bytes.Buffer
 	if err := jsonapi.MarshalPayload(&buf, run); err != nil {
@@ -113,7 +130,7 @@ func createRunSafeHandler(ctx context.Context, request mcp.CallToolRequest, logg
 func CreateRun(logger *log.Logger) server.ServerTool {
 	return server.ServerTool{
 		Tool: mcp.NewTool("create_run",
-			mcp.WithDescription(`Creates a new Terraform run in the specified workspace.`),
+			mcp.WithDescription(`Creates a new Terraform run in the specified workspace. Run-specific variables are validated against the variables declared in the workspace's configuration before the run is created.`),
 			mcp.WithTitleAnnotation("Create a new Terraform run"),
 			mcp.WithReadOnlyHintAnnotation(false),
 			mcp.WithDestructiveHintAnnotation(true),
@@ -133,6 +150,9 @@ func CreateRun(logger *log.Logger) server.ServerTool {
 			mcp.WithString("message",
 				mcp.Description("Optional message for the run"),
 			),
+			withRunVariables(),
+			withWaitForConfiguration(),
+			withOnBehalfOf(),
 		),
 		Handler: func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			return createRunHandler(ctx, req, logger)
@@ -155,6 +175,8 @@ func createRunHandler(ctx context.Context, request mcp.CallToolRequest, logger *
 
 	runType := request.GetString("run_type", "plan_and_apply")
 	message := request.GetString("message", "Triggered via Terraform MCP Server")
+	requester := onBehalfOf(request)
+	message = annotateRequester(message, requester)
 
 	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
 	if err != nil {
@@ -188,10 +210,22 @@ func createRunHandler(ctx context.Context, request mcp.CallToolRequest, logger *
 		options.Message = &message
 	}
 
+	if err := waitForWorkspaceConfiguration(ctx, request, tfeClient, workspace, logger); err != nil {
+		return ToolError(logger, "the workspace configuration is not ready", err)
+	}
+	if err := applyRunVariables(ctx, tfeClient, workspace, request, options, logger); err != nil {
+		return ToolError(logger, "failed to prepare run variables", err)
+	}
+
 	run, err := tfeClient.Runs.Create(ctx, *options)
 	if err != nil {
 		return ToolError(logger, "failed to create run", err)
 	}
+	auditLog(logger, "create_run", requester, log.Fields{
+		"workspace_id": workspace.ID,
+		"run_id":       run.ID,
+		"run_type":     runType,
+	})
 
 	buf := bytes.NewBuffer(nil)
 	err = jsonapi.MarshalPayloadWithoutIncluded(buf, run)
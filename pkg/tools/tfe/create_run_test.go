@@ -32,6 +32,10 @@ func TestCreateRunSafe(t *testing.T) {
 		// Check that run_type property exists
 		runTypeProperty := tool.Tool.InputSchema.Properties["run_type"]
 		assert.NotNil(t, runTypeProperty)
+
+		// Check that run variables are optional
+		assert.NotNil(t, tool.Tool.InputSchema.Properties["variables"])
+		assert.NotContains(t, tool.Tool.InputSchema.Required, "variables")
 	})
 }
 
@@ -57,5 +61,9 @@ func TestCreateRun(t *testing.T) {
 		// Check that run_type property exists
 		runTypeProperty := tool.Tool.InputSchema.Properties["run_type"]
 		assert.NotNil(t, runTypeProperty)
+
+		// Check that run variables are optional
+		assert.NotNil(t, tool.Tool.InputSchema.Properties["variables"])
+		assert.NotContains(t, tool.Tool.InputSchema.Required, "variables")
 	})
 }
@@ -5,7 +5,10 @@ package tools
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/go-tfe"
 	"github.com/hashicorp/terraform-mcp-server/pkg/client"
@@ -21,7 +24,7 @@ const SourceName = "terraform-mcp-server"
 func CreateWorkspace(logger *log.Logger) server.ServerTool {
 	return server.ServerTool{
 		Tool: mcp.NewTool("create_workspace",
-			mcp.WithDescription(`Creates a new Terraform workspace in the specified organization. This is a destructive operation that will create new infrastructure resources.`),
+			mcp.WithDescription(`Creates a new Terraform workspace in the specified organization. This is a destructive operation that will create new infrastructure resources. Initial variables and variable sets can be set up in the same call; the workspace is deleted again if that fails.`),
 			mcp.WithTitleAnnotation("Create a new Terraform workspace"),
 			mcp.WithOpenWorldHintAnnotation(true),
 			mcp.WithReadOnlyHintAnnotation(false),
@@ -59,11 +62,34 @@ func CreateWorkspace(logger *log.Logger) server.ServerTool {
 				mcp.Description("Optional VCS repository branch (default: main/master)"),
 			),
 			mcp.WithString("vcs_repo_oauth_token_id",
-				mcp.Description("OAuth token ID for VCS integration"),
+				mcp.Description("OAuth token ID for VCS integration, e.g. 'ot-abc123'. Use list_oauth_tokens to find it"),
+			),
+			mcp.WithString("vcs_repo_github_app_installation_id",
+				mcp.Description("GitHub App installation ID for VCS integration instead of an OAuth token, e.g. 'ghain-abc123'. Use list_github_app_installations to find it"),
 			),
 			mcp.WithString("tags",
 				mcp.Description("Optional comma-separated list of tags to apply to the workspace"),
 			),
+			mcp.WithArray("variables",
+				mcp.Description("Optional variables to create in the workspace. If one can't be created, the new workspace is deleted"),
+				mcp.Items(map[string]any{
+					"type": "object",
+					"properties": map[string]any{
+						"key":         map[string]any{"type": "string", "description": "Variable key/name"},
+						"value":       map[string]any{"description": "Variable value. Non-string values of terraform variables are stored as HCL"},
+						"category":    map[string]any{"type": "string", "enum": []string{"terraform", "env"}, "description": "Variable category (default: terraform)"},
+						"description": map[string]any{"type": "string"},
+						"sensitive":   map[string]any{"type": "boolean"},
+						"hcl":         map[string]any{"type": "boolean"},
+					},
+					"required": []string{"key", "value"},
+				}),
+			),
+			mcp.WithString("variable_set_ids",
+				mcp.Description("Optional comma-separated list of variable set IDs to attach to the workspace. If one can't be attached, the new workspace is deleted"),
+			),
+			withAutoDestroy(),
+			withOnBehalfOf(),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			return createWorkspaceHandler(ctx, request, logger)
@@ -93,8 +119,29 @@ func createWorkspaceHandler(ctx context.Context, request mcp.CallToolRequest, lo
 	vcsRepoIdentifier := request.GetString("vcs_repo_identifier", "")
 	vcsRepoBranch := request.GetString("vcs_repo_branch", "")
 	vcsRepoOAuthTokenID := request.GetString("vcs_repo_oauth_token_id", "")
+	vcsRepoGHAInstallationID := request.GetString("vcs_repo_github_app_installation_id", "")
 	tagsStr := request.GetString("tags", "")
 
+	variables, err := initialWorkspaceVariables(request)
+	if err != nil {
+		return ToolError(logger, "invalid variables", err)
+	}
+	var varSetIDs []string
+	for _, id := range strings.Split(request.GetString("variable_set_ids", ""), ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			varSetIDs = append(varSetIDs, id)
+		}
+	}
+
+	requester := onBehalfOf(request)
+	autoDestroy, err := parseAutoDestroy(request, time.Now())
+	if err != nil {
+		return ToolError(logger, "invalid auto-destroy settings", err)
+	}
+	if err := checkAutoDestroy(logger, autoDestroy, request.GetBool("confirm_auto_destroy", false), requester, workspaceName); err != nil {
+		return ToolError(logger, err.Error(), nil)
+	}
+
 	autoApply := strings.ToLower(autoApplyStr) == "true"
 
 	executionMode := "remote"
@@ -127,10 +174,12 @@ func createWorkspaceHandler(ctx context.Context, request mcp.CallToolRequest, lo
 	}
 
 	options := &tfe.WorkspaceCreateOptions{
-		Name:       &workspaceName,
-		AutoApply:  &autoApply,
-		Tags:       tags,
-		SourceName: tfe.String(SourceName),
+		Name:                        &workspaceName,
+		AutoApply:                   &autoApply,
+		Tags:                        tags,
+		SourceName:                  tfe.String(SourceName),
+		AutoDestroyAt:               autoDestroy.At,
+		AutoDestroyActivityDuration: autoDestroy.Duration,
 	}
 
 	if description != "" {
@@ -158,13 +207,17 @@ func createWorkspaceHandler(ctx context.Context, request mcp.CallToolRequest, lo
 	}
 
 	if vcsRepoIdentifier != "" {
-		if vcsRepoOAuthTokenID == "" {
-			return ToolError(logger, "vcs_repo_oauth_token_id is required when vcs_repo_identifier is provided", nil)
+		if (vcsRepoOAuthTokenID == "") == (vcsRepoGHAInstallationID == "") {
+			return ToolError(logger, "exactly one of vcs_repo_oauth_token_id or vcs_repo_github_app_installation_id is required when vcs_repo_identifier is provided", nil)
 		}
 
 		vcsRepo := &tfe.VCSRepoOptions{
-			Identifier:   &vcsRepoIdentifier,
-			OAuthTokenID: &vcsRepoOAuthTokenID,
+			Identifier: &vcsRepoIdentifier,
+		}
+		if vcsRepoOAuthTokenID != "" {
+			vcsRepo.OAuthTokenID = &vcsRepoOAuthTokenID
+		} else {
+			vcsRepo.GHAInstallationID = &vcsRepoGHAInstallationID
 		}
 
 		if vcsRepoBranch != "" {
@@ -179,6 +232,14 @@ func createWorkspaceHandler(ctx context.Context, request mcp.CallToolRequest, lo
 		return ToolErrorf(logger, "failed to create workspace '%s' in org '%s': %v", workspaceName, terraformOrgName, err)
 	}
 
+	if err := setUpWorkspace(ctx, tfeClient, workspace, variables, varSetIDs); err != nil {
+		if deleteErr := tfeClient.Workspaces.DeleteByID(ctx, workspace.ID); deleteErr != nil {
+			return ToolErrorf(logger, "failed to set up workspace '%s': %v; deleting the workspace also failed, delete it manually: %v", workspaceName, err, deleteErr)
+		}
+		return ToolErrorf(logger, "failed to set up workspace '%s', the workspace was deleted: %v", workspaceName, err)
+	}
+	auditAutoDestroy(logger, autoDestroy, requester, workspace.ID, workspaceName)
+
 	buf, err := getWorkspaceDetailsForTools(ctx, "create_workspace", tfeClient, workspace, logger)
 	if err != nil {
 		return ToolError(logger, "failed to get workspace details", err)
@@ -186,3 +247,86 @@ func createWorkspaceHandler(ctx context.Context, request mcp.CallToolRequest, lo
 
 	return mcp.NewToolResultText(buf.String()), nil
 }
+
+// initialWorkspaceVariable is a variable to create in a new workspace
+type initialWorkspaceVariable struct {
+	Key         string `json:"key"`
+	Value       any    `json:"value"`
+	Category    string `json:"category"`
+	Description string `json:"description"`
+	Sensitive   bool   `json:"sensitive"`
+	HCL         bool   `json:"hcl"`
+}
+
+// initialWorkspaceVariables reads and validates the variables parameter so that mistakes are
+// reported before the workspace is created
+func initialWorkspaceVariables(request mcp.CallToolRequest) ([]tfe.VariableCreateOptions, error) {
+	raw, ok := request.GetArguments()["variables"]
+	if !ok || raw == nil {
+		return nil, nil
+	}
+	buf, err := json.Marshal(raw)
+	if err != nil {
+		return nil, err
+	}
+	var variables []initialWorkspaceVariable
+	if err := json.Unmarshal(buf, &variables); err != nil {
+		return nil, fmt.Errorf("variables must be an array of objects with a key and a value")
+	}
+
+	seen := make(map[string]bool)
+	options := make([]tfe.VariableCreateOptions, 0, len(variables))
+	for _, variable := range variables {
+		key := strings.TrimSpace(variable.Key)
+		if key == "" {
+			return nil, fmt.Errorf("every variable needs a key")
+		}
+
+		category := tfe.CategoryTerraform
+		switch variable.Category {
+		case "terraform", "":
+		case "env":
+			category = tfe.CategoryEnv
+		default:
+			return nil, fmt.Errorf("invalid category '%s' for variable '%s' - must be 'terraform' or 'env'", variable.Category, key)
+		}
+		if seen[string(category)+"/"+key] {
+			return nil, fmt.Errorf("variable '%s' is set more than once", key)
+		}
+		seen[string(category)+"/"+key] = true
+
+		value, hcl, err := encodeVariableValue(variable.Value, key, category)
+		if err != nil {
+			return nil, err
+		}
+		hcl = hcl || variable.HCL
+
+		options = append(options, tfe.VariableCreateOptions{
+			Key:         tfe.String(key),
+			Value:       tfe.String(value),
+			Category:    &category,
+			Description: tfe.String(variable.Description),
+			Sensitive:   tfe.Bool(variable.Sensitive),
+			HCL:         tfe.Bool(hcl),
+		})
+	}
+	return options, nil
+}
+
+// setUpWorkspace creates the initial variables of a new workspace and attaches its variable sets
+func setUpWorkspace(ctx context.Context, tfeClient *tfe.Client, workspace *tfe.Workspace, variables []tfe.VariableCreateOptions, varSetIDs []string) error {
+	for _, options := range variables {
+		if _, err := tfeClient.Variables.Create(ctx, workspace.ID, options); err != nil {
+			return fmt.Errorf("failed to create variable '%s': %w", *options.Key, err)
+		}
+	}
+	for _, varSetID := range varSetIDs {
+		err := tfeClient.VariableSets.ApplyToWorkspaces(ctx, varSetID, &tfe.VariableSetApplyToWorkspacesOptions{
+			Workspaces: []*tfe.Workspace{workspace},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to attach variable set '%s': %w", varSetID, err)
+		}
+	}
+	return nil
+}
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-tfe"
+	"github.com/mark3labs/mcp-go/mcp"
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -254,3 +255,51 @@ func TestCreateWorkspace(t *testing.T) {
 		}
 	})
 }
+
+func TestInitialWorkspaceVariables(t *testing.T) {
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]any{
+		"variables": []any{
+			map[string]any{"key": "region", "value": "us-east-1"},
+			map[string]any{"key": "AWS_PROFILE", "value": "dev", "category": "env", "sensitive": true},
+			map[string]any{"key": "instance_count", "value": 3},
+			map[string]any{"key": "tags", "value": map[string]any{"team": "platform"}},
+		},
+	}
+
+	variables, err := initialWorkspaceVariables(request)
+	require.NoError(t, err)
+	require.Len(t, variables, 4)
+
+	assert.Equal(t, "region", *variables[0].Key)
+	assert.Equal(t, tfe.CategoryTerraform, *variables[0].Category)
+	assert.False(t, *variables[0].HCL)
+
+	assert.Equal(t, tfe.CategoryEnv, *variables[1].Category)
+	assert.True(t, *variables[1].Sensitive)
+
+	assert.Equal(t, "3", *variables[2].Value)
+	assert.True(t, *variables[2].HCL)
+	assert.Contains(t, *variables[3].Value, `team = "platform"`)
+	assert.True(t, *variables[3].HCL)
+
+	request.Params.Arguments = map[string]any{}
+	variables, err = initialWorkspaceVariables(request)
+	require.NoError(t, err)
+	assert.Empty(t, variables)
+
+	for name, invalid := range map[string]any{
+		"not an array":     "region=us-east-1",
+		"missing key":      []any{map[string]any{"value": "x"}},
+		"missing value":    []any{map[string]any{"key": "region"}},
+		"invalid category": []any{map[string]any{"key": "region", "value": "x", "category": "secret"}},
+		"duplicate key":    []any{map[string]any{"key": "region", "value": "x"}, map[string]any{"key": "region", "value": "y"}},
+		"non-string env":   []any{map[string]any{"key": "COUNT", "value": 3, "category": "env"}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			request.Params.Arguments = map[string]any{"variables": invalid}
+			_, err := initialWorkspaceVariables(request)
+			assert.Error(t, err)
+		})
+	}
+}
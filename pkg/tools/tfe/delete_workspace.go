@@ -0,0 +1,169 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// Deletion modes of delete_hcp_terraform_workspace
+const (
+	WorkspaceDeleteSafe  = "safe"
+	WorkspaceDeleteForce = "force"
+)
+
+// WorkspaceDeletion is the result of deleting a workspace
+type WorkspaceDeletion struct {
+	WorkspaceID   string `json:"workspace_id"`
+	WorkspaceName string `json:"workspace_name"`
+	Organization  string `json:"organization"`
+	Mode          string `json:"mode"`
+	ResourceCount int    `json:"resource_count"`
+	Deleted       bool   `json:"deleted"`
+}
+
+// DeleteWorkspace creates a tool to delete a workspace, with the safe-delete or force-delete endpoint
+func DeleteWorkspace(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("delete_hcp_terraform_workspace",
+			mcp.WithDescription(`Deletes a Terraform workspace, identified by workspace_id or by terraform_org_name and workspace_name. This is a destructive operation that cannot be undone: the workspace's state, runs and variables are deleted.
+The 'safe' mode only deletes workspaces that manage no resources. The 'force' mode deletes the workspace even if it manages resources, which are then no longer tracked by Terraform but keep existing; it requires expected_resource_count to match the workspace's current resource count. Both modes require confirm.`),
+			mcp.WithTitleAnnotation("Delete a Terraform workspace"),
+			mcp.WithReadOnlyHintAnnotation(false),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(true),
+			mcp.WithString("workspace_id",
+				mcp.Description("The ID of the workspace to delete (e.g., 'ws-abc123def456'). Either workspace_id, or terraform_org_name and workspace_name are required"),
+			),
+			mcp.WithString("terraform_org_name",
+				mcp.Description("The Terraform organization name, with workspace_name"),
+			),
+			mcp.WithString("workspace_name",
+				mcp.Description("The name of the workspace to delete, with terraform_org_name"),
+			),
+			mcp.WithString("mode",
+				mcp.Description("'safe' to only delete a workspace without managed resources, 'force' to delete it regardless"),
+				mcp.Enum(WorkspaceDeleteSafe, WorkspaceDeleteForce),
+				mcp.DefaultString(WorkspaceDeleteSafe),
+			),
+			mcp.WithNumber("expected_resource_count",
+				mcp.Description("Required to force-delete a workspace that manages resources: the resource count the user confirmed to stop tracking. The deletion is refused if the workspace's current count differs"),
+				mcp.Min(0),
+			),
+			mcp.WithBoolean("confirm",
+				mcp.Required(),
+				mcp.Description("Must be true to delete the workspace. Only set it after the user explicitly confirmed the deletion of this workspace"),
+			),
+			withOnBehalfOf(),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return deleteWorkspaceHandler(ctx, request, logger)
+		},
+	}
+}
+
+func deleteWorkspaceHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	workspaceID := strings.TrimSpace(request.GetString("workspace_id", ""))
+	terraformOrgName := strings.TrimSpace(request.GetString("terraform_org_name", ""))
+	workspaceName := strings.TrimSpace(request.GetString("workspace_name", ""))
+	if workspaceID == "" && (terraformOrgName == "" || workspaceName == "") {
+		return ToolError(logger, "either workspace_id, or terraform_org_name and workspace_name are required", nil)
+	}
+	mode := request.GetString("mode", WorkspaceDeleteSafe)
+	if mode != WorkspaceDeleteSafe && mode != WorkspaceDeleteForce {
+		return ToolErrorf(logger, "invalid mode '%s' - must be '%s' or '%s'", mode, WorkspaceDeleteSafe, WorkspaceDeleteForce)
+	}
+	requester := onBehalfOf(request)
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client - ensure TFE_TOKEN and TFE_ADDRESS are configured", err)
+	}
+
+	var workspace *tfe.Workspace
+	if workspaceID != "" {
+		workspace, err = tfeClient.Workspaces.ReadByID(ctx, workspaceID)
+	} else {
+		workspace, err = tfeClient.Workspaces.Read(ctx, terraformOrgName, workspaceName)
+	}
+	if err != nil {
+		if workspaceID == "" {
+			return ToolErrorf(logger, "workspace '%s' not found in org '%s': %v", workspaceName, terraformOrgName, err)
+		}
+		return ToolErrorf(logger, "workspace not found: %s", workspaceID)
+	}
+
+	expectedResourceCount := request.GetInt("expected_resource_count", -1)
+	if err := checkWorkspaceDeletion(workspace, mode, request.GetBool("confirm", false), expectedResourceCount); err != nil {
+		auditProtectedLog(logger, "delete_workspace_refused", requester, workspaceDeletionFields(workspace, mode))
+		return ToolError(logger, err.Error(), nil)
+	}
+
+	if mode == WorkspaceDeleteForce {
+		err = tfeClient.Workspaces.DeleteByID(ctx, workspace.ID)
+	} else {
+		err = tfeClient.Workspaces.SafeDeleteByID(ctx, workspace.ID)
+	}
+	if err != nil {
+		return ToolErrorf(logger, "failed to delete workspace '%s': %v", workspace.Name, err)
+	}
+	auditProtectedLog(logger, "delete_workspace", requester, workspaceDeletionFields(workspace, mode))
+
+	result := &WorkspaceDeletion{
+		WorkspaceID:   workspace.ID,
+		WorkspaceName: workspace.Name,
+		Mode:          mode,
+		ResourceCount: workspace.ResourceCount,
+		Deleted:       true,
+	}
+	if workspace.Organization != nil {
+		result.Organization = workspace.Organization.Name
+	}
+	buf, err := json.Marshal(result)
+	if err != nil {
+		return ToolError(logger, "failed to marshal workspace deletion", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+// checkWorkspaceDeletion refuses deletions that are not confirmed, safe deletions of workspaces that
+// manage resources, and force deletions whose expected resource count does not match. A negative
+// expected resource count means that none was given.
+func checkWorkspaceDeletion(workspace *tfe.Workspace, mode string, confirmed bool, expectedResourceCount int) error {
+	if !confirmed {
+		return fmt.Errorf("deleting workspace '%s' cannot be undone - confirm the deletion with the user and set confirm to true", workspace.Name)
+	}
+	if workspace.ResourceCount == 0 {
+		return nil
+	}
+	if mode == WorkspaceDeleteSafe {
+		return fmt.Errorf("workspace '%s' manages %d resources and cannot be safely deleted - destroy them first, or force-delete the workspace to stop tracking them", workspace.Name, workspace.ResourceCount)
+	}
+	if expectedResourceCount < 0 {
+		return fmt.Errorf("workspace '%s' manages %d resources that are no longer tracked by Terraform once it is force-deleted - confirm this with the user and set expected_resource_count to %d", workspace.Name, workspace.ResourceCount, workspace.ResourceCount)
+	}
+	if expectedResourceCount != workspace.ResourceCount {
+		return fmt.Errorf("workspace '%s' manages %d resources, not the expected %d - review its resources with the user again before force-deleting it", workspace.Name, workspace.ResourceCount, expectedResourceCount)
+	}
+	return nil
+}
+
+// workspaceDeletionFields describes a workspace deletion for the audit log
+func workspaceDeletionFields(workspace *tfe.Workspace, mode string) log.Fields {
+	return log.Fields{
+		"workspace_id":   workspace.ID,
+		"workspace":      workspace.Name,
+		"mode":           mode,
+		"resource_count": workspace.ResourceCount,
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-tfe"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeleteWorkspace(t *testing.T) {
+	logger := log.New()
+	logger.SetLevel(log.ErrorLevel)
+
+	t.Run("tool creation", func(t *testing.T) {
+		tool := DeleteWorkspace(logger)
+
+		assert.Equal(t, "delete_hcp_terraform_workspace", tool.Tool.Name)
+		assert.True(t, *tool.Tool.Annotations.DestructiveHint)
+		assert.Equal(t, []string{"confirm"}, tool.Tool.InputSchema.Required)
+		assert.Contains(t, tool.Tool.InputSchema.Properties, "on_behalf_of")
+	})
+
+	t.Run("check deletion", func(t *testing.T) {
+		empty := &tfe.Workspace{Name: "empty"}
+		managing := &tfe.Workspace{Name: "managing", ResourceCount: 12}
+
+		tests := []struct {
+			name      string
+			workspace *tfe.Workspace
+			mode      string
+			confirmed bool
+			expected  int
+			err       string
+		}{
+			{name: "not confirmed", workspace: empty, mode: WorkspaceDeleteSafe, expected: -1, err: "set confirm to true"},
+			{name: "safe without resources", workspace: empty, mode: WorkspaceDeleteSafe, confirmed: true, expected: -1},
+			{name: "safe with resources", workspace: managing, mode: WorkspaceDeleteSafe, confirmed: true, expected: 12, err: "cannot be safely deleted"},
+			{name: "force without resources", workspace: empty, mode: WorkspaceDeleteForce, confirmed: true, expected: -1},
+			{name: "force without expected count", workspace: managing, mode: WorkspaceDeleteForce, confirmed: true, expected: -1, err: "set expected_resource_count to 12"},
+			{name: "force with another count", workspace: managing, mode: WorkspaceDeleteForce, confirmed: true, expected: 10, err: "not the expected 10"},
+			{name: "force with the expected count", workspace: managing, mode: WorkspaceDeleteForce, confirmed: true, expected: 12},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				err := checkWorkspaceDeletion(tt.workspace, tt.mode, tt.confirmed, tt.expected)
+				if tt.err == "" {
+					assert.NoError(t, err)
+				} else {
+					assert.ErrorContains(t, err, tt.err)
+				}
+			})
+		}
+	})
+}
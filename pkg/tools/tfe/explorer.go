@@ -0,0 +1,178 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	defaultExplorerPageSize = 20
+	maxExplorerPageSize     = 100
+	maxExplorerFilters      = 10
+)
+
+// explorerFieldPattern matches the field names of the Explorer API, e.g. workspace_name
+var explorerFieldPattern = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// QueryExplorer creates a tool to query the Explorer API of an organization.
+func QueryExplorer(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("query_hcp_terraform_explorer",
+			mcp.WithDescription(`Queries the Explorer of an HCP Terraform organization, which answers fleet-wide questions in a single call: which workspaces use a Terraform version, a provider or a module, which ones have drifted, failed checks or errored runs, and which versions of providers and modules are in use and by how many workspaces.
+Choose the view with type, narrow the rows with filters on their fields, e.g. {"field": "workspace_name", "operator": "contains", "value": "prod"} or {"field": "drifted", "operator": "is", "value": "true"}, and order them with sort, e.g. "-resources_count" for descending. Rows are returned with the same snake_case field names the filters and sort take; run an unfiltered query with a small page_size first to see them.
+The Explorer is available in HCP Terraform and recent Terraform Enterprise releases, and requires a token that can read the organization.`),
+			mcp.WithTitleAnnotation("Query the Explorer of an organization"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("terraform_org_name",
+				mcp.Required(),
+				mcp.Description("The name of the Terraform organization"),
+			),
+			mcp.WithString("type",
+				mcp.Required(),
+				mcp.Description("The view to query: 'workspaces', 'tf_versions', 'providers' or 'modules'"),
+				mcp.Enum(client.ExplorerTypes()...),
+			),
+			mcp.WithArray("filters",
+				mcp.Description("Optional conditions the rows must all match"),
+				mcp.Items(map[string]any{
+					"type": "object",
+					"properties": map[string]any{
+						"field":    map[string]any{"type": "string", "description": "The snake_case name of the field, e.g. workspace_name"},
+						"operator": map[string]any{"type": "string", "enum": client.ExplorerOperators()},
+						"value":    map[string]any{"description": "The value to compare with, not needed by is_empty and is_not_empty"},
+					},
+					"required": []string{"field", "operator"},
+				}),
+			),
+			mcp.WithString("sort",
+				mcp.Description("Optional field to sort the rows by, prefixed with '-' for descending order"),
+			),
+			mcp.WithNumber("page",
+				mcp.Description("The page of rows to return"),
+				mcp.DefaultNumber(1),
+				mcp.Min(1),
+			),
+			mcp.WithNumber("page_size",
+				mcp.Description("The number of rows per page"),
+				mcp.DefaultNumber(defaultExplorerPageSize),
+				mcp.Min(1),
+				mcp.Max(maxExplorerPageSize),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return queryExplorerHandler(ctx, request, logger)
+		},
+	}
+}
+
+func queryExplorerHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	orgName, err := request.RequireString("terraform_org_name")
+	if err != nil {
+		return ToolError(logger, "missing required input: terraform_org_name", err)
+	}
+	orgName = strings.TrimSpace(orgName)
+	query, err := explorerQuery(request)
+	if err != nil {
+		return ToolError(logger, err.Error(), nil)
+	}
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+
+	result, err := client.QueryExplorer(ctx, tfeClient, orgName, query)
+	if errors.Is(err, tfe.ErrResourceNotFound) {
+		return ToolErrorf(logger, "the Explorer of org '%s' was not found - it requires HCP Terraform or a recent Terraform Enterprise release (connected to %s), and a token that can read the organization", orgName, client.PlatformOf(tfeClient))
+	}
+	if err != nil {
+		return ToolErrorf(logger, "failed to query the Explorer of org '%s': %v", orgName, err)
+	}
+
+	buf, err := json.Marshal(result)
+	if err != nil {
+		return ToolError(logger, "failed to marshal Explorer rows", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+// explorerQuery reads and validates the query of a request
+func explorerQuery(request mcp.CallToolRequest) (client.ExplorerQuery, error) {
+	query := client.ExplorerQuery{
+		Type:    strings.TrimSpace(request.GetString("type", "")),
+		Sort:    strings.TrimSpace(request.GetString("sort", "")),
+		Page:    request.GetInt("page", 1),
+		PerPage: request.GetInt("page_size", defaultExplorerPageSize),
+	}
+	if !slices.Contains(client.ExplorerTypes(), query.Type) {
+		return query, fmt.Errorf("invalid type '%s' - must be one of: %s", query.Type, strings.Join(client.ExplorerTypes(), ", "))
+	}
+	if query.Sort != "" && !explorerFieldPattern.MatchString(strings.TrimPrefix(query.Sort, "-")) {
+		return query, fmt.Errorf("invalid sort '%s' - must be a snake_case field name, prefixed with '-' for descending order", query.Sort)
+	}
+	if query.Page < 1 {
+		return query, fmt.Errorf("page must be at least 1, got %d", query.Page)
+	}
+	if query.PerPage < 1 || query.PerPage > maxExplorerPageSize {
+		return query, fmt.Errorf("page_size must be between 1 and %d, got %d", maxExplorerPageSize, query.PerPage)
+	}
+
+	raw, ok := request.GetArguments()["filters"]
+	if !ok || raw == nil {
+		return query, nil
+	}
+	buf, err := json.Marshal(raw)
+	if err != nil {
+		return query, err
+	}
+	var filters []struct {
+		Field    string `json:"field"`
+		Operator string `json:"operator"`
+		Value    any    `json:"value"`
+	}
+	if err := json.Unmarshal(buf, &filters); err != nil {
+		return query, fmt.Errorf("filters must be an array of objects with a field, an operator and a value")
+	}
+	if len(filters) > maxExplorerFilters {
+		return query, fmt.Errorf("at most %d filters can be combined, got %d", maxExplorerFilters, len(filters))
+	}
+	for _, filter := range filters {
+		field, operator := strings.TrimSpace(filter.Field), strings.TrimSpace(filter.Operator)
+		if !explorerFieldPattern.MatchString(field) {
+			return query, fmt.Errorf("invalid filter field '%s' - must be a snake_case field name, e.g. workspace_name", filter.Field)
+		}
+		if !slices.Contains(client.ExplorerOperators(), operator) {
+			return query, fmt.Errorf("invalid operator '%s' for field '%s' - must be one of: %s", filter.Operator, field, strings.Join(client.ExplorerOperators(), ", "))
+		}
+		value := ""
+		switch v := filter.Value.(type) {
+		case nil:
+		case float64:
+			value = strconv.FormatFloat(v, 'f', -1, 64)
+		default:
+			value = strings.TrimSpace(fmt.Sprint(v))
+		}
+		if value == "" && operator != "is_empty" && operator != "is_not_empty" {
+			return query, fmt.Errorf("filter on field '%s' with operator '%s' needs a value", field, operator)
+		}
+		query.Filters = append(query.Filters, client.ExplorerFilter{Field: field, Operator: operator, Value: value})
+	}
+	return query, nil
+}
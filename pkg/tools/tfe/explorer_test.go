@@ -0,0 +1,61 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExplorerQuery(t *testing.T) {
+	newRequest := func(arguments map[string]any) mcp.CallToolRequest {
+		request := mcp.CallToolRequest{}
+		request.Params.Arguments = arguments
+		return request
+	}
+
+	t.Run("filters with typed values", func(t *testing.T) {
+		query, err := explorerQuery(newRequest(map[string]any{
+			"type": "workspaces",
+			"sort": "-resources_count",
+			"filters": []any{
+				map[string]any{"field": "drifted", "operator": "is", "value": true},
+				map[string]any{"field": "resources_count", "operator": "gt", "value": float64(100)},
+				map[string]any{"field": "vcs_repo_identifier", "operator": "is_empty"},
+			},
+		}))
+		require.NoError(t, err)
+		assert.Equal(t, []client.ExplorerFilter{
+			{Field: "drifted", Operator: "is", Value: "true"},
+			{Field: "resources_count", Operator: "gt", Value: "100"},
+			{Field: "vcs_repo_identifier", Operator: "is_empty"},
+		}, query.Filters)
+		assert.Equal(t, 1, query.Page)
+		assert.Equal(t, defaultExplorerPageSize, query.PerPage)
+	})
+
+	tests := []struct {
+		name      string
+		arguments map[string]any
+		errorText string
+	}{
+		{name: "unknown type", arguments: map[string]any{"type": "runs"}, errorText: "invalid type"},
+		{name: "invalid sort", arguments: map[string]any{"type": "modules", "sort": "name; drop"}, errorText: "invalid sort"},
+		{name: "page size too large", arguments: map[string]any{"type": "modules", "page_size": float64(500)}, errorText: "page_size"},
+		{name: "unknown operator", arguments: map[string]any{"type": "providers", "filters": []any{map[string]any{"field": "name", "operator": "like", "value": "aws"}}}, errorText: "invalid operator"},
+		{name: "field with brackets", arguments: map[string]any{"type": "providers", "filters": []any{map[string]any{"field": "name][is", "operator": "is", "value": "aws"}}}, errorText: "invalid filter field"},
+		{name: "missing value", arguments: map[string]any{"type": "providers", "filters": []any{map[string]any{"field": "name", "operator": "is"}}}, errorText: "needs a value"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := explorerQuery(newRequest(tt.arguments))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errorText)
+		})
+	}
+}
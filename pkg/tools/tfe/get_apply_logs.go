@@ -17,7 +17,7 @@ import (
 func GetApplyLogs(logger *log.Logger) server.ServerTool {
 	return server.ServerTool{
 		Tool: mcp.NewTool("get_apply_logs",
-			mcp.WithDescription(`Retrieves the logs of a specific Terraform apply.`),
+			mcp.WithDescription(`Retrieves the logs of a specific Terraform apply. Color codes are stripped by default and structured log lines can be returned as parsed entries.`),
 			mcp.WithTitleAnnotation("Get logs for a Terraform apply"),
 			mcp.WithReadOnlyHintAnnotation(true),
 			mcp.WithDestructiveHintAnnotation(false),
@@ -25,6 +25,7 @@ func GetApplyLogs(logger *log.Logger) server.ServerTool {
 				mcp.Required(),
 				mcp.Description("The ID of the apply to get logs for"),
 			),
+			withLogFormat(),
 		),
 		Handler: func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			return getApplyLogsHandler(ctx, req, logger)
@@ -53,5 +54,10 @@ func getApplyLogsHandler(ctx context.Context, request mcp.CallToolRequest, logge
 		return ToolError(logger, "failed to read apply logs", err)
 	}
 
-	return mcp.NewToolResultText(string(logBytes)), nil
+	logs, err := formatRunLogs(logBytes, request.GetString("log_format", LogFormatText))
+	if err != nil {
+		return ToolError(logger, "invalid log format", err)
+	}
+
+	return mcp.NewToolResultText(logs), nil
 }
@@ -30,5 +30,6 @@ func TestGetApplyLogs(t *testing.T) {
 
 		// Check that required parameters are defined
 		assert.Contains(t, tool.Tool.InputSchema.Required, "apply_id")
+		assert.NotContains(t, tool.Tool.InputSchema.Required, "log_format")
 	})
 }
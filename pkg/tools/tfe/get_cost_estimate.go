@@ -0,0 +1,230 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// costEstimateCurrency is the currency of HCP Terraform and Terraform Enterprise cost estimates
+const costEstimateCurrency = "USD"
+
+// RunCostEstimate is the cost estimate of a run
+type RunCostEstimate struct {
+	RunID                   string          `json:"run_id"`
+	CostEstimateID          string          `json:"cost_estimate_id"`
+	Status                  string          `json:"status"`
+	Currency                string          `json:"currency"`
+	PriorMonthlyCost        string          `json:"prior_monthly_cost,omitempty"`
+	ProposedMonthlyCost     string          `json:"proposed_monthly_cost,omitempty"`
+	DeltaMonthlyCost        string          `json:"delta_monthly_cost,omitempty"`
+	ResourcesCount          int             `json:"resources_count"`
+	MatchedResourcesCount   int             `json:"matched_resources_count"`
+	UnmatchedResourcesCount int             `json:"unmatched_resources_count"`
+	ErrorMessage            string          `json:"error_message,omitempty"`
+	Resources               []*ResourceCost `json:"resources,omitempty"`
+	UnmatchedResources      []string        `json:"unmatched_resources,omitempty"`
+	Message                 string          `json:"message,omitempty"`
+}
+
+// ResourceCost is the estimated monthly cost of a resource
+type ResourceCost struct {
+	Address             string `json:"address"`
+	Type                string `json:"type"`
+	PriorMonthlyCost    string `json:"prior_monthly_cost"`
+	ProposedMonthlyCost string `json:"proposed_monthly_cost"`
+	DeltaMonthlyCost    string `json:"delta_monthly_cost"`
+}
+
+// costAmount is a cost of the cost estimate output, which is encoded as a string or a number
+type costAmount string
+
+func (c *costAmount) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*c = costAmount(s)
+		return nil
+	}
+	var n json.Number
+	if err := json.Unmarshal(data, &n); err != nil {
+		return err
+	}
+	*c = costAmount(n.String())
+	return nil
+}
+
+// costEstimateOutput is the output of a finished cost estimate, with the cost of each resource
+type costEstimateOutput struct {
+	Resources struct {
+		Matched []struct {
+			Address             string     `json:"address"`
+			Type                string     `json:"type"`
+			PriorMonthlyCost    costAmount `json:"prior-monthly-cost"`
+			ProposedMonthlyCost costAmount `json:"proposed-monthly-cost"`
+			DeltaMonthlyCost    costAmount `json:"delta-monthly-cost"`
+		} `json:"matched"`
+		Unmatched []struct {
+			Address string `json:"address"`
+		} `json:"unmatched"`
+	} `json:"resources"`
+}
+
+// GetCostEstimate creates a tool to get the cost estimate of a run
+func GetCostEstimate(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("get_hcp_terraform_cost_estimate",
+			mcp.WithDescription(`Returns the cost estimate of a Terraform run: the prior, proposed and delta monthly cost, and the estimated monthly cost of each resource, largest change first. Resources whose type cost estimation does not support are listed as unmatched. Cost estimation must be enabled in the organization settings.`),
+			mcp.WithTitleAnnotation("Get the cost estimate of a Terraform run"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("run_id",
+				mcp.Required(),
+				mcp.Description("The ID of the run to get the cost estimate of"),
+			),
+			mcp.WithBoolean("include_resources",
+				mcp.Description("Include the estimated cost of each resource"),
+				mcp.DefaultBool(true),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return getCostEstimateHandler(ctx, request, logger)
+		},
+	}
+}
+
+func getCostEstimateHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	runID, err := request.RequireString("run_id")
+	if err != nil {
+		return ToolError(logger, "missing required input: run_id", err)
+	}
+	runID = strings.TrimSpace(runID)
+	includeResources := request.GetBool("include_resources", true)
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+
+	run, err := tfeClient.Runs.Read(ctx, runID)
+	if err != nil {
+		return ToolErrorf(logger, "run not found: %s", runID)
+	}
+	if run.CostEstimate == nil {
+		return ToolErrorf(logger, "run %s has no cost estimate, cost estimation may not be enabled for the organization or the run has not been planned yet", runID)
+	}
+
+	costEstimate, err := tfeClient.CostEstimates.Read(ctx, run.CostEstimate.ID)
+	if err != nil {
+		return ToolError(logger, "failed to read the cost estimate", err)
+	}
+
+	result := runCostEstimate(run.ID, costEstimate)
+	switch {
+	case costEstimate.Status == tfe.CostEstimatePending || costEstimate.Status == tfe.CostEstimateQueued:
+		result.Message = "The cost estimate has not finished yet, call this tool again once the run's cost estimation phase completed."
+	case costEstimate.Status == tfe.CostEstimateFinished && includeResources:
+		output, err := readCostEstimateOutput(ctx, tfeClient, costEstimate.ID)
+		if err != nil {
+			return ToolError(logger, "failed to read the resource costs of the cost estimate", err)
+		}
+		if err := addResourceCosts(result, output); err != nil {
+			return ToolError(logger, "failed to parse the resource costs of the cost estimate", err)
+		}
+	}
+
+	buf, err := json.Marshal(result)
+	if err != nil {
+		return ToolError(logger, "failed to marshal cost estimate", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+// runCostEstimate summarizes the cost estimate of a run
+func runCostEstimate(runID string, costEstimate *tfe.CostEstimate) *RunCostEstimate {
+	return &RunCostEstimate{
+		RunID:                   runID,
+		CostEstimateID:          costEstimate.ID,
+		Status:                  string(costEstimate.Status),
+		Currency:                costEstimateCurrency,
+		PriorMonthlyCost:        costEstimate.PriorMonthlyCost,
+		ProposedMonthlyCost:     costEstimate.ProposedMonthlyCost,
+		DeltaMonthlyCost:        costEstimate.DeltaMonthlyCost,
+		ResourcesCount:          costEstimate.ResourcesCount,
+		MatchedResourcesCount:   costEstimate.MatchedResourcesCount,
+		UnmatchedResourcesCount: costEstimate.UnmatchedResourcesCount,
+		ErrorMessage:            costEstimate.ErrorMessage,
+	}
+}
+
+// readCostEstimateOutput reads the output of a finished cost estimate. CostEstimates.Logs is not used
+// as it waits for queued estimates to finish.
+func readCostEstimateOutput(ctx context.Context, tfeClient *tfe.Client, costEstimateID string) ([]byte, error) {
+	req, err := tfeClient.NewRequest(http.MethodGet, fmt.Sprintf("cost-estimates/%s/output", url.PathEscape(costEstimateID)), nil)
+	if err != nil {
+		return nil, err
+	}
+	var output bytes.Buffer
+	if err := req.Do(ctx, &output); err != nil {
+		return nil, err
+	}
+	return output.Bytes(), nil
+}
+
+// addResourceCosts adds the resource costs of a cost estimate output to a cost estimate, sorted by
+// the absolute monthly cost change, largest first
+func addResourceCosts(result *RunCostEstimate, output []byte) error {
+	var parsed costEstimateOutput
+	if err := json.Unmarshal(output, &parsed); err != nil {
+		return err
+	}
+
+	result.Resources = make([]*ResourceCost, 0, len(parsed.Resources.Matched))
+	for _, resource := range parsed.Resources.Matched {
+		result.Resources = append(result.Resources, &ResourceCost{
+			Address:             resource.Address,
+			Type:                resource.Type,
+			PriorMonthlyCost:    string(resource.PriorMonthlyCost),
+			ProposedMonthlyCost: string(resource.ProposedMonthlyCost),
+			DeltaMonthlyCost:    string(resource.DeltaMonthlyCost),
+		})
+	}
+	sort.SliceStable(result.Resources, func(i, j int) bool {
+		di, dj := absoluteCost(result.Resources[i].DeltaMonthlyCost), absoluteCost(result.Resources[j].DeltaMonthlyCost)
+		if di != dj {
+			return di > dj
+		}
+		return result.Resources[i].Address < result.Resources[j].Address
+	})
+
+	for _, resource := range parsed.Resources.Unmatched {
+		result.UnmatchedResources = append(result.UnmatchedResources, resource.Address)
+	}
+	sort.Strings(result.UnmatchedResources)
+	return nil
+}
+
+// absoluteCost parses a cost amount, returning 0 for amounts that are not numbers
+func absoluteCost(amount string) float64 {
+	cost, err := strconv.ParseFloat(amount, 64)
+	if err != nil {
+		return 0
+	}
+	return math.Abs(cost)
+}
@@ -0,0 +1,64 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-tfe"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const costEstimateOutputJSON = `{
+  "delta-monthly-cost": "-3.21",
+  "resources": {
+    "matched": [
+      {"address": "aws_instance.web", "type": "aws_instance", "prior-monthly-cost": "8.35", "proposed-monthly-cost": "16.70", "delta-monthly-cost": "8.35"},
+      {"address": "aws_db_instance.main", "type": "aws_db_instance", "prior-monthly-cost": "24.82", "proposed-monthly-cost": "13.26", "delta-monthly-cost": "-11.56"},
+      {"address": "aws_ebs_volume.data", "type": "aws_ebs_volume", "prior-monthly-cost": 0, "proposed-monthly-cost": 0, "delta-monthly-cost": 0}
+    ],
+    "unmatched": [
+      {"address": "aws_s3_bucket.logs", "type": "aws_s3_bucket"},
+      {"address": "aws_iam_role.app", "type": "aws_iam_role"}
+    ]
+  }
+}`
+
+func TestGetCostEstimate(t *testing.T) {
+	t.Run("tool creation", func(t *testing.T) {
+		tool := GetCostEstimate(log.New())
+		assert.Equal(t, "get_hcp_terraform_cost_estimate", tool.Tool.Name)
+		assert.True(t, *tool.Tool.Annotations.ReadOnlyHint)
+		assert.Equal(t, []string{"run_id"}, tool.Tool.InputSchema.Required)
+	})
+
+	t.Run("resource costs", func(t *testing.T) {
+		result := runCostEstimate("run-abc123", &tfe.CostEstimate{
+			ID:                      "ce-abc123",
+			Status:                  tfe.CostEstimateFinished,
+			PriorMonthlyCost:        "33.17",
+			ProposedMonthlyCost:     "29.96",
+			DeltaMonthlyCost:        "-3.21",
+			ResourcesCount:          5,
+			MatchedResourcesCount:   3,
+			UnmatchedResourcesCount: 2,
+		})
+		require.NoError(t, addResourceCosts(result, []byte(costEstimateOutputJSON)))
+
+		assert.Equal(t, "USD", result.Currency)
+		assert.Equal(t, "-3.21", result.DeltaMonthlyCost)
+		assert.Equal(t, []*ResourceCost{
+			{Address: "aws_db_instance.main", Type: "aws_db_instance", PriorMonthlyCost: "24.82", ProposedMonthlyCost: "13.26", DeltaMonthlyCost: "-11.56"},
+			{Address: "aws_instance.web", Type: "aws_instance", PriorMonthlyCost: "8.35", ProposedMonthlyCost: "16.70", DeltaMonthlyCost: "8.35"},
+			{Address: "aws_ebs_volume.data", Type: "aws_ebs_volume", PriorMonthlyCost: "0", ProposedMonthlyCost: "0", DeltaMonthlyCost: "0"},
+		}, result.Resources)
+		assert.Equal(t, []string{"aws_iam_role.app", "aws_s3_bucket.logs"}, result.UnmatchedResources)
+	})
+
+	t.Run("invalid output", func(t *testing.T) {
+		assert.Error(t, addResourceCosts(&RunCostEstimate{}, []byte(`{"resources": {"matched": [{"delta-monthly-cost": true}]}}`)))
+	})
+}
@@ -0,0 +1,124 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// GetNoCodeModule creates a tool to get the metadata of a No Code module.
+func GetNoCodeModule(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("get_no_code_module",
+			mcp.WithDescription(`This tool retrieves the metadata of a No Code module in your Terraform Cloud/Enterprise organization: the private module it provisions, whether it is enabled, the pinned version,
+and the input variables a workspace created from it needs, including the allowed values configured for each variable. The no_code_module_id starts with 'nocode-' and can be obtained by calling 'search_private_modules'.
+This tool requires a valid Terraform token to be configured.`),
+			mcp.WithTitleAnnotation("Get the metadata of a No Code module"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("no_code_module_id",
+				mcp.Required(),
+				mcp.Description("The ID of the No Code module, starting with 'nocode-'"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return getNoCodeModuleHandler(ctx, request, logger)
+		},
+	}
+}
+
+func getNoCodeModuleHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	noCodeModuleID, err := request.RequireString("no_code_module_id")
+	if err != nil {
+		return ToolError(logger, "missing required input: no_code_module_id", err)
+	}
+	noCodeModuleID = strings.TrimSpace(noCodeModuleID)
+	if !strings.HasPrefix(noCodeModuleID, "nocode-") {
+		return ToolError(logger, "no_code_module_id must start with 'nocode-'", nil)
+	}
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client - ensure TFE_TOKEN and TFE_ADDRESS are configured", err)
+	}
+
+	logger.WithField("no_code_module_id", noCodeModuleID).Info("Getting No Code module")
+
+	noCodeModule, registryModule, moduleMetadata, err := fetchNoCodeModule(ctx, tfeClient, noCodeModuleID)
+	if err != nil {
+		return ToolError(logger, err.Error(), nil)
+	}
+
+	return buildNoCodeModuleResponse(noCodeModule, registryModule, moduleMetadata, logger), nil
+}
+
+func buildNoCodeModuleResponse(noCodeModule *tfe.RegistryNoCodeModule,
+	registryModule *tfe.RegistryModule,
+	moduleMetadata *client.ModuleMetadata,
+	logger *log.Logger) *mcp.CallToolResult {
+
+	var builder strings.Builder
+	builder.WriteString("No Code Module:\n")
+	builder.WriteString(fmt.Sprintf("- ID: %s\n", noCodeModule.ID))
+	builder.WriteString(fmt.Sprintf("- Enabled: %t\n", noCodeModule.Enabled))
+	builder.WriteString(fmt.Sprintf("- Version Pin: %s\n", noCodeModule.VersionPin))
+	if noCodeModule.Organization != nil {
+		builder.WriteString(fmt.Sprintf("- Organization: %s\n", noCodeModule.Organization.Name))
+	}
+	builder.WriteString(fmt.Sprintf("- private_module_id: %s/%s/%s\n", registryModule.Namespace, registryModule.Name, registryModule.Provider))
+	if moduleMetadata.Data.Attributes.SourceURL != "" {
+		builder.WriteString(fmt.Sprintf("- Source URL: %s\n", moduleMetadata.Data.Attributes.SourceURL))
+	}
+	builder.WriteString("\n")
+
+	inputs := moduleMetadata.Data.Attributes.InputVariables
+	if len(inputs) > 0 {
+		builder.WriteString("Input Variables:\n")
+		builder.WriteString(strings.Repeat("-", 20) + "\n")
+		builder.WriteString("| Name | Type | Description | Required | Sensitive | Allowed Values |\n")
+		builder.WriteString("|------|------|-------------|----------|-----------|----------------|\n")
+		for _, input := range inputs {
+			builder.WriteString(fmt.Sprintf("| %s | %s | %s | %t | %t | %s |\n",
+				input.Name,
+				input.Type,
+				input.Description,
+				input.Required,
+				input.Sensitive,
+				strings.Join(noCodeVariableOptions(input.Name, noCodeModule.VariableOptions), ", "),
+			))
+		}
+		builder.WriteString("\n")
+	}
+
+	builder.WriteString("(Use the 'no_code_module_id' value with create_no_code_workspace tool to provision a workspace from this module)\n")
+
+	logger.WithFields(log.Fields{
+		"no_code_module_id": noCodeModule.ID,
+		"registry_module":   registryModule.ID,
+		"inputs_count":      len(inputs),
+	}).Info("Successfully retrieved No Code module")
+
+	return mcp.NewToolResultText(builder.String())
+}
+
+// noCodeVariableOptions returns the allowed values configured for a variable
+// of a No Code module, or nil when the variable accepts any value.
+func noCodeVariableOptions(varName string, variableOptions []*tfe.NoCodeVariableOption) []string {
+	for _, varOpt := range variableOptions {
+		if varOpt.VariableName == varName {
+			return varOpt.Options
+		}
+	}
+	return nil
+}
@@ -30,5 +30,6 @@ func TestGetPlanLogs(t *testing.T) {
 
 		// Check that required parameters are defined
 		assert.Contains(t, tool.Tool.InputSchema.Required, "plan_id")
+		assert.NotContains(t, tool.Tool.InputSchema.Required, "log_format")
 	})
 }
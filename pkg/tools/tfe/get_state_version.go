@@ -6,10 +6,13 @@ package tools
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/go-tfe"
 	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
 	log "github.com/sirupsen/logrus"
@@ -30,6 +33,7 @@ func GetStateVersion(logger *log.Logger) server.ServerTool {
 			mcp.WithString("workspace_id",
 				mcp.Description("Optional Workspace id to fetch latest version"),
 			),
+			utils.WithOutputFormat(),
 		),
 
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -59,6 +63,11 @@ func getStateVersionWithIDHandler(
 	workspaceID := request.GetString("workspace_id", "")
 	workspaceID = strings.TrimLeft(strings.TrimSpace(workspaceID), "#")
 
+	outputFormat, err := utils.OutputFormatParam(request)
+	if err != nil {
+		return ToolError(logger, "Invalid output format", err)
+	}
+
 	var sv *tfe.StateVersion
 
 	if stateVersionID == "" && workspaceID == "" {
@@ -73,6 +82,10 @@ func getStateVersionWithIDHandler(
 		return ToolError(logger, "Failed to get state version", err)
 	}
 
+	if outputFormat == utils.OutputFormatMarkdown {
+		return mcp.NewToolResultStructured(sv, stateVersionMarkdown(sv, time.Now())), nil
+	}
+
 	svJSON, err := json.Marshal(sv)
 	if err != nil {
 		return ToolError(logger, "Failed to serialize state version", err)
@@ -80,3 +93,26 @@ func getStateVersionWithIDHandler(
 
 	return mcp.NewToolResultText(string(svJSON)), nil
 }
+
+// stateVersionMarkdown renders a state version as a Markdown summary with humanized sizes and timestamps
+func stateVersionMarkdown(sv *tfe.StateVersion, now time.Time) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "## State version %s\n\n", sv.ID)
+	fmt.Fprintf(&b, "- **Status**: %s\n", sv.Status)
+	fmt.Fprintf(&b, "- **Serial**: %d\n", sv.Serial)
+	fmt.Fprintf(&b, "- **Created**: %s\n", utils.HumanizeTimestamp(sv.CreatedAt, now))
+	fmt.Fprintf(&b, "- **Size**: %s\n", utils.HumanizeBytes(sv.Size))
+	if sv.TerraformVersion != "" {
+		fmt.Fprintf(&b, "- **Terraform version**: %s\n", sv.TerraformVersion)
+	}
+	if sv.ResourcesProcessed {
+		fmt.Fprintf(&b, "- **Resources**: %d\n", len(sv.Resources))
+	}
+	if sv.VCSCommitSHA != "" {
+		fmt.Fprintf(&b, "- **VCS commit**: %s\n", sv.VCSCommitSHA)
+	}
+	if sv.Run != nil && sv.Run.ID != "" {
+		fmt.Fprintf(&b, "- **Run**: %s\n", sv.Run.ID)
+	}
+	return b.String()
+}
@@ -10,10 +10,12 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/go-tfe"
 	"github.com/hashicorp/jsonapi"
 	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
 	log "github.com/sirupsen/logrus"
 
 	"github.com/mark3labs/mcp-go/mcp"
@@ -40,6 +42,7 @@ func GetWorkspaceDetails(logger *log.Logger) server.ServerTool {
 				mcp.Required(),
 				mcp.Description("The name of the workspace to get details for"),
 			),
+			utils.WithOutputFormat(),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			return getWorkspaceDetailsHandler(ctx, request, logger)
@@ -60,6 +63,11 @@ func getWorkspaceDetailsHandler(ctx context.Context, request mcp.CallToolRequest
 	}
 	workspaceName = strings.TrimSpace(workspaceName)
 
+	outputFormat, err := utils.OutputFormatParam(request)
+	if err != nil {
+		return ToolError(logger, "invalid output format", err)
+	}
+
 	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
 	if err != nil {
 		return ToolError(logger, "failed to get Terraform client - ensure TFE_TOKEN and TFE_ADDRESS are configured", err)
@@ -70,6 +78,11 @@ func getWorkspaceDetailsHandler(ctx context.Context, request mcp.CallToolRequest
 		return ToolErrorf(logger, "workspace '%s' not found in org '%s'", workspaceName, terraformOrgName)
 	}
 
+	if outputFormat == utils.OutputFormatMarkdown {
+		structured := &client.WorkspaceToolResponse{Success: true, Type: "get_workspace_details", Workspace: workspace}
+		return mcp.NewToolResultStructured(structured, workspaceMarkdown(workspace, time.Now())), nil
+	}
+
 	buf, err := getWorkspaceDetailsForTools(ctx, "get_workspace_details", tfeClient, workspace, logger, true)
 	if err != nil {
 		return ToolError(logger, "failed to get workspace details", err)
@@ -129,3 +142,30 @@ func getWorkspaceDetailsForTools(ctx context.Context, toolType string, tfeClient
 
 	return buf, nil
 }
+
+// workspaceMarkdown renders a workspace and its performance metrics as a Markdown summary
+// with humanized durations and timestamps
+func workspaceMarkdown(workspace *tfe.Workspace, now time.Time) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "## Workspace %s (%s)\n\n", workspace.Name, workspace.ID)
+	if workspace.Description != "" {
+		fmt.Fprintf(&b, "%s\n\n", workspace.Description)
+	}
+	fmt.Fprintf(&b, "- **Execution mode**: %s\n", workspace.ExecutionMode)
+	fmt.Fprintf(&b, "- **Terraform version**: %s\n", workspace.TerraformVersion)
+	fmt.Fprintf(&b, "- **Auto apply**: %t\n", workspace.AutoApply)
+	fmt.Fprintf(&b, "- **Locked**: %t\n", workspace.Locked)
+	fmt.Fprintf(&b, "- **Resources**: %d\n", workspace.ResourceCount)
+	fmt.Fprintf(&b, "- **Created**: %s\n", utils.HumanizeTimestamp(workspace.CreatedAt, now))
+	fmt.Fprintf(&b, "- **Updated**: %s\n", utils.HumanizeTimestamp(workspace.UpdatedAt, now))
+	if len(workspace.TagNames) > 0 {
+		fmt.Fprintf(&b, "- **Tags**: %s\n", strings.Join(workspace.TagNames, ", "))
+	}
+
+	b.WriteString("\n### Performance\n\n")
+	fmt.Fprintf(&b, "- **Average plan duration**: %s\n", utils.HumanizeDuration(workspace.PlanDurationAverage))
+	fmt.Fprintf(&b, "- **Average apply duration**: %s\n", utils.HumanizeDuration(workspace.ApplyDurationAverage))
+	fmt.Fprintf(&b, "- **Run failures**: %d\n", workspace.RunFailures)
+	fmt.Fprintf(&b, "- **Runs count**: %d\n", workspace.RunsCount)
+	return b.String()
+}
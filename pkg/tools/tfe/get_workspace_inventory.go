@@ -0,0 +1,128 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// GetWorkspaceInventory creates a tool to get a cached inventory of every workspace in an organization.
+func GetWorkspaceInventory(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("get_workspace_inventory",
+			mcp.WithDescription(`Returns an inventory of every workspace in an organization along with fleet-wide counts (execution modes, Terraform versions, current run statuses, locked workspaces). The inventory is cached for the session and refreshed incrementally on repeated calls, so use this tool instead of paging through list_workspaces for fleet-wide analyses.`),
+			mcp.WithTitleAnnotation("Get the workspace inventory of an organization"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("terraform_org_name",
+				mcp.Required(),
+				mcp.Description("The Terraform organization name"),
+			),
+			mcp.WithString("project_id",
+				mcp.Description("Optional project ID to restrict the returned workspaces to"),
+			),
+			mcp.WithBoolean("force_refresh",
+				mcp.Description("Discard the cached inventory and walk every workspace again"),
+				mcp.DefaultBool(false),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return getWorkspaceInventoryHandler(ctx, request, logger)
+		},
+	}
+}
+
+func getWorkspaceInventoryHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	terraformOrgName, err := request.RequireString("terraform_org_name")
+	if err != nil {
+		return ToolError(logger, "missing required input: terraform_org_name", err)
+	}
+	terraformOrgName = strings.TrimSpace(terraformOrgName)
+	projectID := strings.TrimSpace(request.GetString("project_id", ""))
+	forceRefresh := request.GetBool("force_refresh", false)
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client - ensure TFE_TOKEN and TFE_ADDRESS are configured", err)
+	}
+
+	var sessionID string
+	if session := server.ClientSessionFromContext(ctx); session != nil {
+		sessionID = session.SessionID()
+	}
+
+	inventory, err := client.GetWorkspaceInventory(ctx, tfeClient, sessionID, terraformOrgName, forceRefresh, logger)
+	if err != nil {
+		return ToolErrorf(logger, "failed to build workspace inventory for org '%s': %v", terraformOrgName, err)
+	}
+
+	buf, err := json.Marshal(newWorkspaceInventoryResult(inventory, projectID))
+	if err != nil {
+		return ToolError(logger, "failed to marshal workspace inventory", err)
+	}
+
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+// WorkspaceInventoryResult is the inventory returned by the get_workspace_inventory tool
+type WorkspaceInventoryResult struct {
+	Organization string                       `json:"organization"`
+	RefreshedAt  time.Time                    `json:"refreshed_at"`
+	RefreshMode  string                       `json:"refresh_mode"`
+	TotalCount   int                          `json:"total_count"`
+	Summary      WorkspaceInventorySummary    `json:"summary"`
+	Workspaces   []*client.InventoryWorkspace `json:"workspaces"`
+}
+
+// WorkspaceInventorySummary contains fleet-wide counts computed from the inventory
+type WorkspaceInventorySummary struct {
+	LockedCount        int            `json:"locked_count"`
+	ResourceCount      int            `json:"resource_count"`
+	ExecutionModes     map[string]int `json:"execution_modes"`
+	TerraformVersions  map[string]int `json:"terraform_versions"`
+	CurrentRunStatuses map[string]int `json:"current_run_statuses"`
+}
+
+func newWorkspaceInventoryResult(inventory *client.WorkspaceInventory, projectID string) *WorkspaceInventoryResult {
+	result := &WorkspaceInventoryResult{
+		Organization: inventory.Organization,
+		RefreshedAt:  inventory.RefreshedAt,
+		RefreshMode:  inventory.RefreshMode,
+		Workspaces:   make([]*client.InventoryWorkspace, 0, len(inventory.Workspaces)),
+		Summary: WorkspaceInventorySummary{
+			ExecutionModes:     make(map[string]int),
+			TerraformVersions:  make(map[string]int),
+			CurrentRunStatuses: make(map[string]int),
+		},
+	}
+
+	for _, ws := range inventory.Workspaces {
+		if projectID != "" && ws.ProjectID != projectID {
+			continue
+		}
+		result.Workspaces = append(result.Workspaces, ws)
+
+		if ws.Locked {
+			result.Summary.LockedCount++
+		}
+		result.Summary.ResourceCount += ws.ResourceCount
+		result.Summary.ExecutionModes[ws.ExecutionMode]++
+		result.Summary.TerraformVersions[ws.TerraformVersion]++
+		if ws.CurrentRunStatus != "" {
+			result.Summary.CurrentRunStatuses[ws.CurrentRunStatus]++
+		}
+	}
+	result.TotalCount = len(result.Workspaces)
+
+	return result
+}
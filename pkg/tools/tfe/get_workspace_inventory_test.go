@@ -0,0 +1,57 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetWorkspaceInventory(t *testing.T) {
+	logger := log.New()
+	logger.SetLevel(log.ErrorLevel)
+
+	t.Run("tool creation", func(t *testing.T) {
+		tool := GetWorkspaceInventory(logger)
+
+		assert.Equal(t, "get_workspace_inventory", tool.Tool.Name)
+		assert.Contains(t, tool.Tool.Description, "inventory of every workspace")
+		assert.NotNil(t, tool.Handler)
+
+		assert.NotNil(t, tool.Tool.Annotations.ReadOnlyHint)
+		assert.True(t, *tool.Tool.Annotations.ReadOnlyHint)
+
+		assert.Contains(t, tool.Tool.InputSchema.Required, "terraform_org_name")
+		assert.NotContains(t, tool.Tool.InputSchema.Required, "project_id")
+		assert.NotContains(t, tool.Tool.InputSchema.Required, "force_refresh")
+	})
+
+	t.Run("summary and project filter", func(t *testing.T) {
+		inventory := &client.WorkspaceInventory{
+			Organization: "my-org",
+			RefreshMode:  client.InventoryRefreshCached,
+			Workspaces: []*client.InventoryWorkspace{
+				{ID: "ws-1", ProjectID: "prj-a", ExecutionMode: "remote", TerraformVersion: "1.9.0", Locked: true, ResourceCount: 3, CurrentRunStatus: "applied"},
+				{ID: "ws-2", ProjectID: "prj-a", ExecutionMode: "agent", TerraformVersion: "1.9.0", ResourceCount: 5, CurrentRunStatus: "errored"},
+				{ID: "ws-3", ProjectID: "prj-b", ExecutionMode: "remote", TerraformVersion: "1.5.7"},
+			},
+		}
+
+		result := newWorkspaceInventoryResult(inventory, "")
+		assert.Equal(t, 3, result.TotalCount)
+		assert.Equal(t, client.InventoryRefreshCached, result.RefreshMode)
+		assert.Equal(t, 1, result.Summary.LockedCount)
+		assert.Equal(t, 8, result.Summary.ResourceCount)
+		assert.Equal(t, map[string]int{"remote": 2, "agent": 1}, result.Summary.ExecutionModes)
+		assert.Equal(t, map[string]int{"1.9.0": 2, "1.5.7": 1}, result.Summary.TerraformVersions)
+		assert.Equal(t, map[string]int{"applied": 1, "errored": 1}, result.Summary.CurrentRunStatuses)
+
+		result = newWorkspaceInventoryResult(inventory, "prj-b")
+		assert.Equal(t, 1, result.TotalCount)
+		assert.Equal(t, "ws-3", result.Workspaces[0].ID)
+	})
+}
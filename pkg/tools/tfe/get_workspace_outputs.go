@@ -0,0 +1,136 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// WorkspaceOutputs is the list of outputs of the current state version of a workspace
+type WorkspaceOutputs struct {
+	WorkspaceID   string             `json:"workspace_id"`
+	WorkspaceName string             `json:"workspace_name"`
+	Outputs       []*WorkspaceOutput `json:"outputs"`
+}
+
+// WorkspaceOutput is a single output of a workspace. Sensitive values are redacted unless requested.
+type WorkspaceOutput struct {
+	Name         string `json:"name"`
+	Type         string `json:"type"`
+	DetailedType any    `json:"detailed_type,omitempty"`
+	Sensitive    bool   `json:"sensitive"`
+	Redacted     bool   `json:"redacted,omitempty"`
+	Value        any    `json:"value"`
+}
+
+// GetWorkspaceOutputs creates a tool to get the outputs of the current state version of a workspace.
+func GetWorkspaceOutputs(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("get_workspace_outputs",
+			mcp.WithDescription(`Returns the name, type and value of the outputs of a Terraform workspace's current state version without downloading the state file. Sensitive values are redacted unless include_sensitive is set.`),
+			mcp.WithTitleAnnotation("Get the outputs of a Terraform workspace"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("terraform_org_name",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+			mcp.WithString("workspace_name",
+				mcp.Required(),
+				mcp.Description("Workspace name"),
+			),
+			mcp.WithBoolean("include_sensitive",
+				mcp.Description("Include the values of sensitive outputs. Requires permission to read state versions and exposes secrets to the conversation, only set it when the user asked for them"),
+				mcp.DefaultBool(false),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return getWorkspaceOutputsHandler(ctx, request, logger)
+		},
+	}
+}
+
+func getWorkspaceOutputsHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	orgName, err := request.RequireString("terraform_org_name")
+	if err != nil {
+		return ToolError(logger, "missing required input: terraform_org_name", err)
+	}
+	orgName = strings.TrimSpace(orgName)
+
+	workspaceName, err := request.RequireString("workspace_name")
+	if err != nil {
+		return ToolError(logger, "missing required input: workspace_name", err)
+	}
+	workspaceName = strings.TrimSpace(workspaceName)
+
+	includeSensitive := request.GetBool("include_sensitive", false)
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+
+	workspace, err := tfeClient.Workspaces.Read(ctx, orgName, workspaceName)
+	if err != nil {
+		return ToolErrorf(logger, "workspace '%s' not found in org '%s'", workspaceName, orgName)
+	}
+
+	outputs, err := tfeClient.StateVersionOutputs.ReadCurrent(ctx, workspace.ID)
+	if err != nil {
+		return ToolErrorf(logger, "failed to read the outputs of workspace '%s': %v", workspaceName, err)
+	}
+
+	// The current outputs endpoint never returns sensitive values, they are read one by one
+	if includeSensitive {
+		for i, output := range outputs.Items {
+			if !output.Sensitive {
+				continue
+			}
+			sensitiveOutput, err := tfeClient.StateVersionOutputs.Read(ctx, output.ID)
+			if err != nil {
+				return ToolErrorf(logger, "failed to read sensitive output '%s': %v", output.Name, err)
+			}
+			outputs.Items[i] = sensitiveOutput
+		}
+	}
+
+	buf, err := json.Marshal(&WorkspaceOutputs{
+		WorkspaceID:   workspace.ID,
+		WorkspaceName: workspace.Name,
+		Outputs:       workspaceOutputs(outputs.Items, includeSensitive),
+	})
+	if err != nil {
+		return ToolError(logger, "failed to marshal workspace outputs", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+// workspaceOutputs converts state version outputs, redacting sensitive values unless included
+func workspaceOutputs(items []*tfe.StateVersionOutput, includeSensitive bool) []*WorkspaceOutput {
+	outputs := make([]*WorkspaceOutput, len(items))
+	for i, item := range items {
+		outputs[i] = &WorkspaceOutput{
+			Name:         item.Name,
+			Type:         item.Type,
+			DetailedType: item.DetailedType,
+			Sensitive:    item.Sensitive,
+			Value:        item.Value,
+		}
+		if item.Sensitive && !includeSensitive {
+			outputs[i].Redacted = true
+			outputs[i].Value = nil
+		}
+	}
+	return outputs
+}
@@ -0,0 +1,46 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-tfe"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetWorkspaceOutputs(t *testing.T) {
+	logger := log.New()
+	logger.SetLevel(log.ErrorLevel)
+
+	t.Run("tool creation", func(t *testing.T) {
+		tool := GetWorkspaceOutputs(logger)
+
+		assert.Equal(t, "get_workspace_outputs", tool.Tool.Name)
+		assert.Contains(t, tool.Tool.Description, "Sensitive values are redacted")
+		assert.NotNil(t, tool.Handler)
+
+		assert.True(t, *tool.Tool.Annotations.ReadOnlyHint)
+
+		assert.Contains(t, tool.Tool.InputSchema.Required, "terraform_org_name")
+		assert.Contains(t, tool.Tool.InputSchema.Required, "workspace_name")
+		assert.NotContains(t, tool.Tool.InputSchema.Required, "include_sensitive")
+	})
+
+	t.Run("sensitive values", func(t *testing.T) {
+		items := []*tfe.StateVersionOutput{
+			{Name: "vpc_id", Type: "string", Value: "vpc-123"},
+			{Name: "db_password", Type: "string", Sensitive: true, Value: "hunter2"},
+		}
+
+		outputs := workspaceOutputs(items, false)
+		assert.Equal(t, &WorkspaceOutput{Name: "vpc_id", Type: "string", Value: "vpc-123"}, outputs[0])
+		assert.Equal(t, &WorkspaceOutput{Name: "db_password", Type: "string", Sensitive: true, Redacted: true}, outputs[1])
+
+		outputs = workspaceOutputs(items, true)
+		assert.Equal(t, "hunter2", outputs[1].Value)
+		assert.False(t, outputs[1].Redacted)
+	})
+}
@@ -0,0 +1,100 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// GitHubAppInstallation is an installation of the GitHub App of HCP Terraform or Terraform Enterprise
+// on a GitHub user or organization account
+type GitHubAppInstallation struct {
+	// ID is the github_app_installation_id of the vcs_repo of workspaces
+	ID string `json:"id"`
+	// InstallationID is the ID of the installation on GitHub
+	InstallationID   int    `json:"installation_id,omitempty"`
+	Name             string `json:"name,omitempty"`
+	InstallationType string `json:"installation_type,omitempty"`
+	InstallationURL  string `json:"installation_url,omitempty"`
+}
+
+// ListGitHubAppInstallations creates a tool to list the GitHub App installations available to the user.
+func ListGitHubAppInstallations(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("list_github_app_installations",
+			mcp.WithDescription(`Lists the installations of the HCP Terraform GitHub App on GitHub user and organization accounts that the user of the token can access. The id of an installation, e.g. 'ghain-abc123', is the vcs_repo_github_app_installation_id that connects a workspace created with create_workspace to a repository of that account without an OAuth token.
+Requires a user token of a user who has authorized the GitHub App; team and organization tokens can't list installations.`),
+			mcp.WithTitleAnnotation("List the GitHub App installations of the user"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return listGitHubAppInstallationsHandler(ctx, request, logger)
+		},
+	}
+}
+
+func listGitHubAppInstallationsHandler(ctx context.Context, _ mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+
+	var installations []*tfe.GHAInstallation
+	options := &tfe.GHAInstallationListOptions{ListOptions: tfe.ListOptions{PageNumber: 1, PageSize: 100}}
+	for {
+		page, err := tfeClient.GHAInstallations.List(ctx, options)
+		if err != nil {
+			if errors.Is(err, tfe.ErrResourceNotFound) || errors.Is(err, tfe.ErrUnauthorized) {
+				return ToolErrorf(logger, "failed to list GitHub App installations: %v. The API requires a user token of a user who has authorized the GitHub App, and the GitHub App must be configured on Terraform Enterprise", err)
+			}
+			return ToolErrorf(logger, "failed to list GitHub App installations: %v", err)
+		}
+		installations = append(installations, page.Items...)
+		if page.Pagination == nil || page.Pagination.NextPage == 0 {
+			break
+		}
+		options.PageNumber = page.Pagination.NextPage
+	}
+
+	buf, err := json.Marshal(gitHubAppInstallations(installations))
+	if err != nil {
+		return ToolError(logger, "failed to marshal GitHub App installations", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+func gitHubAppInstallations(items []*tfe.GHAInstallation) []GitHubAppInstallation {
+	installations := make([]GitHubAppInstallation, 0, len(items))
+	for _, item := range items {
+		if item == nil || item.ID == nil {
+			continue
+		}
+		installation := GitHubAppInstallation{ID: *item.ID}
+		if item.InstallationID != nil {
+			installation.InstallationID = *item.InstallationID
+		}
+		if item.Name != nil {
+			installation.Name = *item.Name
+		}
+		if item.InstallationType != nil {
+			installation.InstallationType = *item.InstallationType
+		}
+		if item.InstallationURL != nil {
+			installation.InstallationURL = *item.InstallationURL
+		}
+		installations = append(installations, installation)
+	}
+	return installations
+}
@@ -0,0 +1,51 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-tfe"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListGitHubAppInstallations(t *testing.T) {
+	logger := log.New()
+	logger.SetLevel(log.ErrorLevel)
+
+	t.Run("tool creation", func(t *testing.T) {
+		tool := ListGitHubAppInstallations(logger)
+		assert.Equal(t, "list_github_app_installations", tool.Tool.Name)
+		assert.True(t, *tool.Tool.Annotations.ReadOnlyHint)
+		assert.Empty(t, tool.Tool.InputSchema.Required)
+	})
+
+	t.Run("installations", func(t *testing.T) {
+		installations := gitHubAppInstallations([]*tfe.GHAInstallation{
+			{
+				ID:               tfe.String("ghain-abc123"),
+				InstallationID:   tfe.Int(4242),
+				Name:             tfe.String("example-corp"),
+				InstallationType: tfe.String("Organization"),
+				InstallationURL:  tfe.String("https://github.com/organizations/example-corp/settings/installations/4242"),
+			},
+			{ID: tfe.String("ghain-def456")},
+			{Name: tfe.String("no id")},
+			nil,
+		})
+
+		assert.Equal(t, []GitHubAppInstallation{
+			{
+				ID:               "ghain-abc123",
+				InstallationID:   4242,
+				Name:             "example-corp",
+				InstallationType: "Organization",
+				InstallationURL:  "https://github.com/organizations/example-corp/settings/installations/4242",
+			},
+			{ID: "ghain-def456"},
+		}, installations)
+		assert.Equal(t, []GitHubAppInstallation{}, gitHubAppInstallations(nil))
+	})
+}
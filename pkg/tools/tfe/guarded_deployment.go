@@ -0,0 +1,439 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// Rollback strategies of a guarded deployment
+const (
+	rollbackNone                  = "none"
+	rollbackPreviousConfiguration = "previous_configuration"
+	rollbackDestroy               = "destroy"
+)
+
+// Outcomes of a guarded deployment
+const (
+	deploymentOutcomeDeployed    = "deployed"
+	deploymentOutcomeNoChanges   = "no_changes"
+	deploymentOutcomeGateFailed  = "stopped_at_plan"
+	deploymentOutcomeNeedsAction = "awaiting_action"
+	deploymentOutcomeRolledBack  = "rollback_queued"
+	deploymentOutcomeCheckFailed = "verification_failed"
+	deploymentOutcomeTimedOut    = "timed_out"
+)
+
+const (
+	guardedDeploymentStages       = 5
+	guardedDeploymentMessage      = "Guarded deployment via Terraform MCP Server"
+	guardedDeploymentRollbackNote = "Rollback of run %s queued by a guarded deployment via Terraform MCP Server: %s"
+)
+
+// GuardedDeploymentResult reports every stage a guarded deployment went through
+type GuardedDeploymentResult struct {
+	WorkspaceID     string   `json:"workspace_id"`
+	RunID           string   `json:"run_id"`
+	RunStatus       string   `json:"run_status"`
+	Outcome         string   `json:"outcome"`
+	Message         string   `json:"message"`
+	Steps           []string `json:"steps"`
+	GateFailures    []string `json:"gate_failures,omitempty"`
+	CheckFailures   []string `json:"check_failures,omitempty"`
+	RollbackRunID   string   `json:"rollback_run_id,omitempty"`
+	RollbackType    string   `json:"rollback_type,omitempty"`
+	RollbackApplies bool     `json:"rollback_auto_applies,omitempty"`
+}
+
+// GuardedDeployment creates a tool that plans, gates, applies and verifies a run, and queues a rollback when verification fails.
+func GuardedDeployment(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("run_guarded_deployment",
+			mcp.WithDescription(`Deploys a workspace with a guarded pattern in a single call: creates a plan, checks the plan against gates (plan errors, policy failures, maximum number of destroyed resources), applies it only if every gate passes, then verifies expected output values and a health output on the new state.
+If the apply fails or a post-apply check fails, a rollback run is queued automatically, either with the configuration of the previous successful apply or as a destroy run. Use this instead of chaining create_run, wait_for_run and action_run by hand. Progress notifications are sent for each stage when the client provides a progress token.`),
+			mcp.WithTitleAnnotation("Plan, apply and verify a Terraform run with automatic rollback"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(false),
+			mcp.WithDestructiveHintAnnotation(true),
+			mcp.WithString("terraform_org_name",
+				mcp.Required(),
+				mcp.Description("The Terraform Cloud/Enterprise organization name"),
+			),
+			mcp.WithString("workspace_name",
+				mcp.Required(),
+				mcp.Description("The name of the workspace to deploy"),
+			),
+			mcp.WithString("message",
+				mcp.Description("Optional message for the run"),
+				mcp.DefaultString(guardedDeploymentMessage),
+			),
+			mcp.WithNumber("max_resource_destructions",
+				mcp.Description("Gate: the maximum number of resources the plan may destroy, including replacements. The run is discarded when the plan destroys more"),
+				mcp.DefaultNumber(0),
+				mcp.Min(0),
+			),
+			mcp.WithObject("expected_outputs",
+				mcp.Description("Post-apply check: output values the new state must have, keyed by output name"),
+			),
+			mcp.WithString("health_output",
+				mcp.Description("Post-apply check: name of an output that must be true (or the string 'true') once the apply finished"),
+			),
+			mcp.WithString("rollback",
+				mcp.Description("What to queue when the apply or a post-apply check fails: a run with the configuration of the previous successful apply, a destroy run (for disposable green environments), or nothing"),
+				mcp.Enum(rollbackPreviousConfiguration, rollbackDestroy, rollbackNone),
+				mcp.DefaultString(rollbackPreviousConfiguration),
+			),
+			mcp.WithBoolean("auto_apply_rollback",
+				mcp.Description("Apply the rollback run without waiting for a confirmation. When false the rollback run waits to be confirmed with action_run"),
+				mcp.DefaultBool(false),
+			),
+			mcp.WithNumber("timeout_seconds",
+				mcp.Description(fmt.Sprintf("Maximum time to wait for each of the plan, the apply and the new state, in seconds, at most %d", int(maxRunWaitTimeout.Seconds()))),
+				mcp.DefaultNumber(defaultRunWaitTimeout.Seconds()),
+				mcp.Min(1),
+				mcp.Max(maxRunWaitTimeout.Seconds()),
+			),
+			withRunVariables(),
+			withWaitForConfiguration(),
+			withOnBehalfOf(),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return guardedDeploymentHandler(ctx, request, logger)
+		},
+	}
+}
+
+func guardedDeploymentHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	terraformOrgName, err := request.RequireString("terraform_org_name")
+	if err != nil {
+		return ToolError(logger, "missing required input: terraform_org_name", err)
+	}
+	terraformOrgName = strings.TrimSpace(terraformOrgName)
+
+	workspaceName, err := request.RequireString("workspace_name")
+	if err != nil {
+		return ToolError(logger, "missing required input: workspace_name", err)
+	}
+	workspaceName = strings.TrimSpace(workspaceName)
+
+	maxDestructions := request.GetInt("max_resource_destructions", 0)
+	if maxDestructions < 0 {
+		return ToolErrorf(logger, "max_resource_destructions must be at least 0, got %d", maxDestructions)
+	}
+
+	var expectedOutputs map[string]any
+	if raw, ok := request.GetArguments()["expected_outputs"]; ok && raw != nil {
+		expectedOutputs, ok = raw.(map[string]any)
+		if !ok {
+			return ToolErrorf(logger, "expected_outputs must be an object keyed by output name, got %T", raw)
+		}
+	}
+	healthOutput := strings.TrimSpace(request.GetString("health_output", ""))
+
+	rollback := request.GetString("rollback", rollbackPreviousConfiguration)
+	switch rollback {
+	case rollbackPreviousConfiguration, rollbackDestroy, rollbackNone:
+	default:
+		return ToolErrorf(logger, "rollback must be one of %s, %s or %s", rollbackPreviousConfiguration, rollbackDestroy, rollbackNone)
+	}
+	autoApplyRollback := request.GetBool("auto_apply_rollback", false)
+
+	timeout := time.Duration(request.GetFloat("timeout_seconds", defaultRunWaitTimeout.Seconds()) * float64(time.Second))
+	if timeout <= 0 || timeout > maxRunWaitTimeout {
+		return ToolErrorf(logger, "timeout_seconds must be between 1 and %d", int(maxRunWaitTimeout.Seconds()))
+	}
+
+	requester := onBehalfOf(request)
+	message := annotateRequester(request.GetString("message", guardedDeploymentMessage), requester)
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+
+	workspace, err := tfeClient.Workspaces.Read(ctx, terraformOrgName, workspaceName)
+	if err != nil {
+		return ToolErrorf(logger, "workspace '%s' not found in org '%s': %v", workspaceName, terraformOrgName, err)
+	}
+
+	// Stage 1: plan
+	options := &tfe.RunCreateOptions{
+		Workspace: workspace,
+		AutoApply: tfe.Bool(false),
+		Message:   &message,
+	}
+	if err := waitForWorkspaceConfiguration(ctx, request, tfeClient, workspace, logger); err != nil {
+		return ToolError(logger, "the workspace configuration is not ready", err)
+	}
+	if err := applyRunVariables(ctx, tfeClient, workspace, request, options, logger); err != nil {
+		return ToolError(logger, "failed to prepare run variables", err)
+	}
+	run, err := tfeClient.Runs.Create(ctx, *options)
+	if err != nil {
+		return ToolError(logger, "failed to create run", err)
+	}
+	auditLog(logger, "run_guarded_deployment", requester, log.Fields{
+		"workspace_id": workspace.ID,
+		"run_id":       run.ID,
+	})
+
+	result := &GuardedDeploymentResult{WorkspaceID: workspace.ID, RunID: run.ID}
+	result.step(ctx, request, 1, fmt.Sprintf("Created run %s, waiting for the plan", run.ID), logger)
+
+	readRun := func(ctx context.Context) (*tfe.Run, error) {
+		return tfeClient.Runs.Read(ctx, run.ID)
+	}
+	noStatus := func(time.Duration, tfe.RunStatus) {}
+
+	planWait, err := waitForRun(ctx, run.ID, readRun, timeout, defaultRunPollInterval, noStatus)
+	if err != nil {
+		return ToolErrorf(logger, "failed to wait for the plan of run %s: %v", run.ID, err)
+	}
+	result.RunStatus = planWait.Status
+
+	switch planWait.Outcome {
+	case runWaitOutcomeTimedOut:
+		return result.finish(deploymentOutcomeTimedOut, planWait.Message+". The run was left as is", logger)
+	case runWaitOutcomeNeedsAction:
+		return result.finish(deploymentOutcomeNeedsAction, planWait.Message+". Nothing was applied", logger)
+	case runWaitOutcomeFinished:
+		if tfe.RunStatus(planWait.Status) == tfe.RunPlannedAndFinished {
+			return result.finish(deploymentOutcomeNoChanges, "The plan has no changes, nothing to apply", logger)
+		}
+		result.GateFailures = []string{fmt.Sprintf("run finished with status %s before it could be applied", planWait.Status)}
+		return result.finish(deploymentOutcomeGateFailed, "The plan did not succeed. Nothing was applied", logger)
+	}
+
+	// Stage 2: gates
+	current, err := tfeClient.Runs.Read(ctx, run.ID)
+	if err != nil {
+		return ToolErrorf(logger, "failed to read run %s: %v", run.ID, err)
+	}
+	if current.Plan == nil {
+		return ToolErrorf(logger, "run %s has no plan", run.ID)
+	}
+	plan, err := tfeClient.Plans.Read(ctx, current.Plan.ID)
+	if err != nil {
+		return ToolErrorf(logger, "failed to read the plan of run %s: %v", run.ID, err)
+	}
+	result.GateFailures = planGateFailures(plan, maxDestructions)
+	if len(result.GateFailures) > 0 {
+		comment := annotateRequester("Discarded by a guarded deployment: "+strings.Join(result.GateFailures, "; "), requester)
+		if err := tfeClient.Runs.Discard(ctx, run.ID, tfe.RunDiscardOptions{Comment: &comment}); err != nil {
+			return ToolErrorf(logger, "plan gates failed and run %s could not be discarded: %v", run.ID, err)
+		}
+		auditLog(logger, "run_guarded_deployment", requester, log.Fields{"run_id": run.ID, "action": "discard"})
+		result.RunStatus = string(tfe.RunDiscarded)
+		return result.finish(deploymentOutcomeGateFailed, "The plan did not pass the deployment gates and the run was discarded", logger)
+	}
+	result.step(ctx, request, 2, fmt.Sprintf("Plan passed the gates: %d to add, %d to change, %d to destroy", plan.ResourceAdditions, plan.ResourceChanges, plan.ResourceDestructions), logger)
+
+	// Stage 3: apply
+	comment := annotateRequester("Applied by a guarded deployment after the plan passed its gates", requester)
+	if err := tfeClient.Runs.Apply(ctx, run.ID, tfe.RunApplyOptions{Comment: &comment}); err != nil {
+		return ToolErrorf(logger, "failed to apply run %s: %v", run.ID, err)
+	}
+	auditLog(logger, "run_guarded_deployment", requester, log.Fields{"run_id": run.ID, "action": "apply"})
+	result.step(ctx, request, 3, "Applying the run", logger)
+
+	applyWait, err := waitForRun(ctx, run.ID, readRun, timeout, defaultRunPollInterval, noStatus)
+	if err != nil {
+		return ToolErrorf(logger, "failed to wait for the apply of run %s: %v", run.ID, err)
+	}
+	result.RunStatus = applyWait.Status
+	if applyWait.Outcome == runWaitOutcomeTimedOut {
+		return result.finish(deploymentOutcomeTimedOut, applyWait.Message+". No checks were run and no rollback was queued", logger)
+	}
+
+	// Stage 4: verify
+	if tfe.RunStatus(applyWait.Status) != tfe.RunApplied {
+		result.CheckFailures = []string{fmt.Sprintf("apply finished with status %s", applyWait.Status)}
+	} else if len(expectedOutputs) > 0 || healthOutput != "" {
+		outputs, err := readRunOutputs(ctx, tfeClient, workspace.ID, run.ID, timeout)
+		if err != nil {
+			result.CheckFailures = []string{fmt.Sprintf("failed to read the outputs of the new state: %v", err)}
+		} else {
+			result.CheckFailures = outputCheckFailures(outputs, expectedOutputs, healthOutput)
+		}
+	}
+	if len(result.CheckFailures) == 0 {
+		result.step(ctx, request, 4, "Post-apply checks passed", logger)
+		return result.finish(deploymentOutcomeDeployed, "The run was applied and every post-apply check passed", logger)
+	}
+	result.step(ctx, request, 4, "Post-apply checks failed: "+strings.Join(result.CheckFailures, "; "), logger)
+
+	// Stage 5: rollback
+	if rollback == rollbackNone {
+		return result.finish(deploymentOutcomeCheckFailed, "Post-apply checks failed and no rollback was requested", logger)
+	}
+	rollbackRun, err := queueRollback(ctx, tfeClient, workspace, run.ID, rollback, autoApplyRollback, annotateRequester(fmt.Sprintf(guardedDeploymentRollbackNote, run.ID, strings.Join(result.CheckFailures, "; ")), requester))
+	if err != nil {
+		return result.finish(deploymentOutcomeCheckFailed, fmt.Sprintf("Post-apply checks failed and the rollback could not be queued: %v", err), logger)
+	}
+	auditLog(logger, "run_guarded_deployment", requester, log.Fields{
+		"run_id":          run.ID,
+		"action":          "rollback",
+		"rollback":        rollback,
+		"rollback_run_id": rollbackRun.ID,
+	})
+	result.RollbackRunID = rollbackRun.ID
+	result.RollbackType = rollback
+	result.RollbackApplies = autoApplyRollback
+	result.step(ctx, request, 5, fmt.Sprintf("Queued %s rollback run %s", rollback, rollbackRun.ID), logger)
+
+	message = fmt.Sprintf("Post-apply checks failed and rollback run %s was queued, use wait_for_run to follow it", rollbackRun.ID)
+	if !autoApplyRollback {
+		message += " and confirm it with action_run once its plan is reviewed"
+	}
+	return result.finish(deploymentOutcomeRolledBack, message, logger)
+}
+
+// step records a stage of the deployment and reports it as progress
+func (r *GuardedDeploymentResult) step(ctx context.Context, request mcp.CallToolRequest, stage int, message string, logger *log.Logger) {
+	r.Steps = append(r.Steps, message)
+	sendProgress(ctx, request, stage, guardedDeploymentStages, message, logger)
+}
+
+func (r *GuardedDeploymentResult) finish(outcome, message string, logger *log.Logger) (*mcp.CallToolResult, error) {
+	r.Outcome = outcome
+	r.Message = message
+	buf, err := json.Marshal(r)
+	if err != nil {
+		return ToolError(logger, "failed to marshal deployment result", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+// planGateFailures lists the reasons a plan must not be applied
+func planGateFailures(plan *tfe.Plan, maxDestructions int) []string {
+	var failures []string
+	if plan.Status == tfe.PlanErrored || plan.Status == tfe.PlanCanceled {
+		failures = append(failures, fmt.Sprintf("plan is %s", plan.Status))
+	}
+	if plan.ResourceDestructions > maxDestructions {
+		failures = append(failures, fmt.Sprintf("plan destroys %d resource(s), at most %d allowed", plan.ResourceDestructions, maxDestructions))
+	}
+	return failures
+}
+
+// outputCheckFailures compares the outputs of the new state with the expected values and the health output
+func outputCheckFailures(outputs map[string]*tfe.StateVersionOutput, expected map[string]any, healthOutput string) []string {
+	var failures []string
+	for _, name := range sortedKeys(expected) {
+		output, ok := outputs[name]
+		switch {
+		case !ok:
+			failures = append(failures, fmt.Sprintf("output %q is missing", name))
+		case !reflect.DeepEqual(output.Value, expected[name]):
+			if output.Sensitive {
+				failures = append(failures, fmt.Sprintf("sensitive output %q does not have the expected value", name))
+			} else {
+				failures = append(failures, fmt.Sprintf("output %q is %v, expected %v", name, output.Value, expected[name]))
+			}
+		}
+	}
+
+	if healthOutput != "" {
+		output, ok := outputs[healthOutput]
+		switch {
+		case !ok:
+			failures = append(failures, fmt.Sprintf("health output %q is missing", healthOutput))
+		case output.Value != true && output.Value != "true":
+			failures = append(failures, fmt.Sprintf("health output %q is %v", healthOutput, output.Value))
+		}
+	}
+	return failures
+}
+
+// readRunOutputs waits until the current state version of a workspace is the one created by a run
+// and returns its outputs by name, with the values of sensitive outputs.
+func readRunOutputs(ctx context.Context, tfeClient *tfe.Client, workspaceID, runID string, timeout time.Duration) (map[string]*tfe.StateVersionOutput, error) {
+	deadline := time.Now().Add(timeout)
+	var stateVersion *tfe.StateVersion
+	for {
+		sv, err := tfeClient.StateVersions.ReadCurrent(ctx, workspaceID)
+		if err != nil {
+			return nil, err
+		}
+		if sv.Run != nil && sv.Run.ID == runID && sv.ResourcesProcessed {
+			stateVersion = sv
+			break
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("the state of run %s was not processed within %s", runID, timeout)
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(defaultRunPollInterval):
+		}
+	}
+
+	list, err := tfeClient.StateVersions.ListOutputs(ctx, stateVersion.ID, nil)
+	if err != nil {
+		return nil, err
+	}
+	outputs := make(map[string]*tfe.StateVersionOutput, len(list.Items))
+	for _, output := range list.Items {
+		if output.Sensitive {
+			output, err = tfeClient.StateVersionOutputs.Read(ctx, output.ID)
+			if err != nil {
+				return nil, err
+			}
+		}
+		outputs[output.Name] = output
+	}
+	return outputs, nil
+}
+
+// queueRollback creates a run that restores the configuration of the previous successful apply, or destroys the workspace
+func queueRollback(ctx context.Context, tfeClient *tfe.Client, workspace *tfe.Workspace, failedRunID, strategy string, autoApply bool, message string) (*tfe.Run, error) {
+	options := tfe.RunCreateOptions{
+		Workspace: workspace,
+		AutoApply: tfe.Bool(autoApply),
+		Message:   &message,
+	}
+
+	switch strategy {
+	case rollbackDestroy:
+		options.IsDestroy = tfe.Bool(true)
+	case rollbackPreviousConfiguration:
+		runs, err := tfeClient.Runs.List(ctx, workspace.ID, &tfe.RunListOptions{
+			Status:      string(tfe.RunApplied),
+			ListOptions: tfe.ListOptions{PageSize: 20},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list previous applied runs: %w", err)
+		}
+		options.ConfigurationVersion = previousConfigurationVersion(runs.Items, failedRunID)
+		if options.ConfigurationVersion == nil {
+			return nil, fmt.Errorf("no previous applied run to roll back to")
+		}
+	}
+
+	return tfeClient.Runs.Create(ctx, options)
+}
+
+// previousConfigurationVersion returns the configuration version of the newest applied run other than the failed one
+func previousConfigurationVersion(appliedRuns []*tfe.Run, failedRunID string) *tfe.ConfigurationVersion {
+	for _, run := range appliedRuns {
+		if run.ID == failedRunID || run.ConfigurationVersion == nil {
+			continue
+		}
+		return run.ConfigurationVersion
+	}
+	return nil
+}
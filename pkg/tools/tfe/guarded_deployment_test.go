@@ -0,0 +1,74 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-tfe"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuardedDeployment(t *testing.T) {
+	logger := log.New()
+	logger.SetLevel(log.ErrorLevel)
+
+	t.Run("tool creation", func(t *testing.T) {
+		tool := GuardedDeployment(logger)
+
+		assert.Equal(t, "run_guarded_deployment", tool.Tool.Name)
+		assert.NotNil(t, tool.Handler)
+		assert.False(t, *tool.Tool.Annotations.ReadOnlyHint)
+		assert.True(t, *tool.Tool.Annotations.DestructiveHint)
+
+		assert.Contains(t, tool.Tool.InputSchema.Required, "terraform_org_name")
+		assert.Contains(t, tool.Tool.InputSchema.Required, "workspace_name")
+		assert.Contains(t, tool.Tool.InputSchema.Properties, "expected_outputs")
+		assert.Contains(t, tool.Tool.InputSchema.Properties, "variables")
+		assert.NotContains(t, tool.Tool.InputSchema.Required, "rollback")
+	})
+
+	t.Run("plan gates", func(t *testing.T) {
+		assert.Empty(t, planGateFailures(&tfe.Plan{Status: tfe.PlanFinished, ResourceAdditions: 3}, 0))
+		assert.Empty(t, planGateFailures(&tfe.Plan{Status: tfe.PlanFinished, ResourceDestructions: 2}, 2))
+
+		failures := planGateFailures(&tfe.Plan{Status: tfe.PlanFinished, ResourceDestructions: 1}, 0)
+		assert.Equal(t, []string{"plan destroys 1 resource(s), at most 0 allowed"}, failures)
+
+		assert.Len(t, planGateFailures(&tfe.Plan{Status: tfe.PlanErrored}, 0), 1)
+	})
+
+	t.Run("output checks", func(t *testing.T) {
+		outputs := map[string]*tfe.StateVersionOutput{
+			"replicas": {Name: "replicas", Value: float64(3)},
+			"healthy":  {Name: "healthy", Value: true},
+			"token":    {Name: "token", Sensitive: true, Value: "s3cret"},
+		}
+
+		assert.Empty(t, outputCheckFailures(outputs, map[string]any{"replicas": float64(3)}, "healthy"))
+
+		failures := outputCheckFailures(outputs, map[string]any{
+			"replicas": float64(2),
+			"missing":  "x",
+			"token":    "other",
+		}, "replicas")
+		assert.Equal(t, []string{
+			`output "missing" is missing`,
+			`output "replicas" is 3, expected 2`,
+			`sensitive output "token" does not have the expected value`,
+			`health output "replicas" is 3`,
+		}, failures)
+	})
+
+	t.Run("previous configuration", func(t *testing.T) {
+		runs := []*tfe.Run{
+			{ID: "run-failed", ConfigurationVersion: &tfe.ConfigurationVersion{ID: "cv-new"}},
+			{ID: "run-old", ConfigurationVersion: &tfe.ConfigurationVersion{ID: "cv-old"}},
+		}
+
+		assert.Equal(t, "cv-old", previousConfigurationVersion(runs, "run-failed").ID)
+		assert.Nil(t, previousConfigurationVersion(runs[:1], "run-failed"))
+	})
+}
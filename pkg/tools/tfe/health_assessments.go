@@ -0,0 +1,385 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// Sources of drifted resources
+const (
+	DriftSourceAssessment = "assessment"
+	DriftSourceRun        = "run"
+)
+
+// assessmentResult is a health assessment result of the assessment results API
+type assessmentResult struct {
+	ID        string    `jsonapi:"primary,assessment-results"`
+	Drifted   bool      `jsonapi:"attr,drifted"`
+	Succeeded bool      `jsonapi:"attr,succeeded"`
+	ErrorMsg  string    `jsonapi:"attr,error-msg"`
+	CreatedAt time.Time `jsonapi:"attr,created-at,iso8601"`
+}
+
+// WorkspaceHealthAssessment summarizes the latest health assessment of a workspace
+type WorkspaceHealthAssessment struct {
+	WorkspaceID          string `json:"workspace_id"`
+	WorkspaceName        string `json:"workspace_name"`
+	AssessmentsEnabled   bool   `json:"assessments_enabled"`
+	AssessmentResultID   string `json:"assessment_result_id,omitempty"`
+	CreatedAt            string `json:"created_at,omitempty"`
+	Succeeded            bool   `json:"succeeded"`
+	Drifted              bool   `json:"drifted"`
+	DriftedResourceCount int    `json:"drifted_resource_count"`
+	ErrorMessage         string `json:"error_message,omitempty"`
+	Message              string `json:"message,omitempty"`
+}
+
+// DriftedResources lists the resources that changed outside of Terraform
+type DriftedResources struct {
+	WorkspaceName string             `json:"workspace_name"`
+	Source        string             `json:"source"`
+	SourceID      string             `json:"source_id"`
+	Resources     []*DriftedResource `json:"resources"`
+}
+
+// DriftedResource is a resource whose real infrastructure differs from the state. Only the names of
+// the changed attributes are returned, never their values, which may be sensitive.
+type DriftedResource struct {
+	Address           string   `json:"address"`
+	Module            string   `json:"module,omitempty"`
+	Type              string   `json:"type"`
+	Actions           []string `json:"actions"`
+	ChangedAttributes []string `json:"changed_attributes,omitempty"`
+}
+
+// GetWorkspaceHealthAssessment creates a tool to read the latest health assessment of a workspace
+func GetWorkspaceHealthAssessment(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("get_workspace_health_assessment",
+			mcp.WithDescription(`Returns the latest health assessment of a workspace: whether it succeeded, whether drift was detected and how many resources drifted. Health assessments run periodically on workspaces with assessments enabled. Use list_workspace_drifted_resources for the drifted resources.`),
+			mcp.WithTitleAnnotation("Get the health assessment of a Terraform workspace"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("terraform_org_name",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+			mcp.WithString("workspace_name",
+				mcp.Required(),
+				mcp.Description("Workspace name"),
+			),
+			mcp.WithString("assessment_result_id",
+				mcp.Description("Optional ID of a specific assessment result (e.g. 'asmtres-abc123'). Defaults to the workspace's current assessment result"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return getWorkspaceHealthAssessmentHandler(ctx, request, logger)
+		},
+	}
+}
+
+// ListWorkspaceDriftedResources creates a tool to list the drifted resources of a workspace
+func ListWorkspaceDriftedResources(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("list_workspace_drifted_resources",
+			mcp.WithDescription(`Lists the resources of a workspace that changed outside of Terraform, with the drift actions and the names of the changed attributes, from the workspace's current health assessment, a given assessment result, or the plan of a refresh-only run such as one started with start_workspace_drift_detection. Reading assessment results requires admin access to the workspace.`),
+			mcp.WithTitleAnnotation("List the drifted resources of a Terraform workspace"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("terraform_org_name",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+			mcp.WithString("workspace_name",
+				mcp.Required(),
+				mcp.Description("Workspace name"),
+			),
+			mcp.WithString("assessment_result_id",
+				mcp.Description("Optional ID of a specific assessment result. Defaults to the workspace's current assessment result"),
+			),
+			mcp.WithString("run_id",
+				mcp.Description("Optional ID of a run of the workspace to read the drift from its plan instead of an assessment result"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return listWorkspaceDriftedResourcesHandler(ctx, request, logger)
+		},
+	}
+}
+
+// StartWorkspaceDriftDetection creates a tool to check a workspace for drift on demand
+func StartWorkspaceDriftDetection(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("start_workspace_drift_detection",
+			mcp.WithDescription(`Checks a workspace for drift on demand by queuing a refresh-only, plan-only run, which compares the state with the real infrastructure like a health assessment does but can never be applied. The API does not start health assessments on demand; they run on their own schedule. Once the run's plan finished, list its drifted resources with list_workspace_drifted_resources and the returned run ID.`),
+			mcp.WithTitleAnnotation("Start drift detection on a Terraform workspace"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(false),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("terraform_org_name",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+			mcp.WithString("workspace_name",
+				mcp.Required(),
+				mcp.Description("Workspace name"),
+			),
+			withOnBehalfOf(),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return startWorkspaceDriftDetectionHandler(ctx, request, logger)
+		},
+	}
+}
+
+// readWorkspaceFromRequest reads the workspace named by the terraform_org_name and workspace_name parameters
+func readWorkspaceFromRequest(ctx context.Context, request mcp.CallToolRequest, tfeClient *tfe.Client) (*tfe.Workspace, error) {
+	orgName, err := request.RequireString("terraform_org_name")
+	if err != nil {
+		return nil, fmt.Errorf("missing required input: terraform_org_name")
+	}
+	workspaceName, err := request.RequireString("workspace_name")
+	if err != nil {
+		return nil, fmt.Errorf("missing required input: workspace_name")
+	}
+	orgName, workspaceName = strings.TrimSpace(orgName), strings.TrimSpace(workspaceName)
+
+	workspace, err := tfeClient.Workspaces.Read(ctx, orgName, workspaceName)
+	if err != nil {
+		return nil, fmt.Errorf("workspace '%s' not found in org '%s'", workspaceName, orgName)
+	}
+	return workspace, nil
+}
+
+func getWorkspaceHealthAssessmentHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+	workspace, err := readWorkspaceFromRequest(ctx, request, tfeClient)
+	if err != nil {
+		return ToolError(logger, err.Error(), nil)
+	}
+
+	assessment := &WorkspaceHealthAssessment{
+		WorkspaceID:        workspace.ID,
+		WorkspaceName:      workspace.Name,
+		AssessmentsEnabled: workspace.AssessmentsEnabled,
+	}
+	result, err := readAssessmentResult(ctx, tfeClient, workspace, strings.TrimSpace(request.GetString("assessment_result_id", "")))
+	if errors.Is(err, tfe.ErrResourceNotFound) {
+		assessment.Message = "The workspace has no health assessment result yet."
+		if !workspace.AssessmentsEnabled {
+			assessment.Message += " Health assessments are not enabled on the workspace; start_workspace_drift_detection checks it for drift on demand."
+		}
+		return marshalHealthAssessment(assessment, logger)
+	}
+	if err != nil {
+		return ToolError(logger, "failed to read the assessment result", err)
+	}
+
+	assessment.AssessmentResultID = result.ID
+	assessment.CreatedAt = result.CreatedAt.Format(time.RFC3339)
+	assessment.Succeeded = result.Succeeded
+	assessment.Drifted = result.Drifted
+	assessment.ErrorMessage = result.ErrorMsg
+	if result.Drifted {
+		resources, err := assessmentDriftedResources(ctx, tfeClient, result.ID)
+		if err != nil {
+			assessment.Message = fmt.Sprintf("The drifted resources could not be counted, which requires admin access to the workspace: %v", err)
+		} else {
+			assessment.DriftedResourceCount = len(resources)
+		}
+	}
+	return marshalHealthAssessment(assessment, logger)
+}
+
+func marshalHealthAssessment(assessment *WorkspaceHealthAssessment, logger *log.Logger) (*mcp.CallToolResult, error) {
+	buf, err := json.Marshal(assessment)
+	if err != nil {
+		return ToolError(logger, "failed to marshal health assessment", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+func listWorkspaceDriftedResourcesHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+	workspace, err := readWorkspaceFromRequest(ctx, request, tfeClient)
+	if err != nil {
+		return ToolError(logger, err.Error(), nil)
+	}
+
+	drift := &DriftedResources{WorkspaceName: workspace.Name}
+	if runID := strings.TrimSpace(request.GetString("run_id", "")); runID != "" {
+		run, err := tfeClient.Runs.Read(ctx, runID)
+		if err != nil {
+			return ToolErrorf(logger, "run not found: %s", runID)
+		}
+		if run.Workspace == nil || run.Workspace.ID != workspace.ID {
+			return ToolErrorf(logger, "run %s does not belong to workspace '%s'", runID, workspace.Name)
+		}
+		if run.Plan == nil || run.Plan.Status != tfe.PlanFinished {
+			return ToolErrorf(logger, "the plan of run %s has not finished yet (run status: %s)", runID, run.Status)
+		}
+		planJSON, err := tfeClient.Plans.ReadJSONOutput(ctx, run.Plan.ID)
+		if err != nil {
+			return ToolError(logger, "failed to read the JSON plan of the run", err)
+		}
+		drift.Source, drift.SourceID = DriftSourceRun, run.ID
+		drift.Resources, err = driftedResources(planJSON)
+		if err != nil {
+			return ToolError(logger, "failed to parse the JSON plan of the run", err)
+		}
+	} else {
+		result, err := readAssessmentResult(ctx, tfeClient, workspace, strings.TrimSpace(request.GetString("assessment_result_id", "")))
+		if errors.Is(err, tfe.ErrResourceNotFound) {
+			return ToolErrorf(logger, "workspace '%s' has no health assessment result, check it for drift with start_workspace_drift_detection", workspace.Name)
+		}
+		if err != nil {
+			return ToolError(logger, "failed to read the assessment result", err)
+		}
+		drift.Source, drift.SourceID = DriftSourceAssessment, result.ID
+		drift.Resources = []*DriftedResource{}
+		if result.Drifted {
+			drift.Resources, err = assessmentDriftedResources(ctx, tfeClient, result.ID)
+			if err != nil {
+				return ToolError(logger, "failed to read the drifted resources of the assessment, which requires admin access to the workspace", err)
+			}
+		}
+	}
+
+	buf, err := json.Marshal(drift)
+	if err != nil {
+		return ToolError(logger, "failed to marshal drifted resources", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+func startWorkspaceDriftDetectionHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+	workspace, err := readWorkspaceFromRequest(ctx, request, tfeClient)
+	if err != nil {
+		return ToolError(logger, err.Error(), nil)
+	}
+
+	requester := onBehalfOf(request)
+	message := annotateRequester("Drift detection triggered via Terraform MCP Server", requester)
+	run, err := tfeClient.Runs.Create(ctx, tfe.RunCreateOptions{
+		Workspace:   workspace,
+		Message:     &message,
+		RefreshOnly: tfe.Bool(true),
+		PlanOnly:    tfe.Bool(true),
+	})
+	if err != nil {
+		return ToolError(logger, "failed to create the drift detection run", err)
+	}
+	auditLog(logger, "start_workspace_drift_detection", requester, log.Fields{
+		"workspace_id": workspace.ID,
+		"run_id":       run.ID,
+	})
+
+	buf, err := json.Marshal(map[string]string{
+		"run_id":         run.ID,
+		"workspace_name": workspace.Name,
+		"status":         string(run.Status),
+		"message":        fmt.Sprintf("Drift detection run %s queued. Once its plan finished, call list_workspace_drifted_resources with run_id %s.", run.ID, run.ID),
+	})
+	if err != nil {
+		return ToolError(logger, "failed to marshal drift detection run", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+// readAssessmentResult reads an assessment result by ID, or the current assessment result of a
+// workspace. It returns tfe.ErrResourceNotFound when the workspace has no assessment result.
+func readAssessmentResult(ctx context.Context, tfeClient *tfe.Client, workspace *tfe.Workspace, assessmentResultID string) (*assessmentResult, error) {
+	path := fmt.Sprintf("workspaces/%s/current-assessment-result", url.PathEscape(workspace.ID))
+	if assessmentResultID != "" {
+		path = "assessment-results/" + url.PathEscape(assessmentResultID)
+	}
+	req, err := tfeClient.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	result := &assessmentResult{}
+	if err := req.Do(ctx, result); err != nil {
+		return nil, err
+	}
+	if result.ID == "" {
+		return nil, tfe.ErrResourceNotFound
+	}
+	return result, nil
+}
+
+// assessmentDriftedResources reads the JSON plan of an assessment result and returns its drifted resources
+func assessmentDriftedResources(ctx context.Context, tfeClient *tfe.Client, assessmentResultID string) ([]*DriftedResource, error) {
+	req, err := tfeClient.NewRequest(http.MethodGet, fmt.Sprintf("assessment-results/%s/json-output", url.PathEscape(assessmentResultID)), nil)
+	if err != nil {
+		return nil, err
+	}
+	var planJSON bytes.Buffer
+	if err := req.Do(ctx, &planJSON); err != nil {
+		return nil, err
+	}
+	return driftedResources(planJSON.Bytes())
+}
+
+// driftedResources returns the drifted resources of a JSON plan, from its resource_drift list
+func driftedResources(planJSON []byte) ([]*DriftedResource, error) {
+	var plan struct {
+		ResourceDrift []struct {
+			Address       string `json:"address"`
+			ModuleAddress string `json:"module_address"`
+			Type          string `json:"type"`
+			Change        struct {
+				Actions []string       `json:"actions"`
+				Before  map[string]any `json:"before"`
+				After   map[string]any `json:"after"`
+			} `json:"change"`
+		} `json:"resource_drift"`
+	}
+	if err := json.Unmarshal(planJSON, &plan); err != nil {
+		return nil, err
+	}
+
+	resources := make([]*DriftedResource, 0, len(plan.ResourceDrift))
+	for _, drift := range plan.ResourceDrift {
+		resource := &DriftedResource{
+			Address: drift.Address,
+			Module:  drift.ModuleAddress,
+			Type:    drift.Type,
+			Actions: drift.Change.Actions,
+		}
+		if drift.Change.Before != nil && drift.Change.After != nil {
+			resource.ChangedAttributes = changedAttributes(drift.Change.Before, drift.Change.After)
+		}
+		resources = append(resources, resource)
+	}
+	sort.Slice(resources, func(i, j int) bool { return resources[i].Address < resources[j].Address })
+	return resources, nil
+}
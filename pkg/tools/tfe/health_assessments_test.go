@@ -0,0 +1,75 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const driftPlanJSON = `{
+  "format_version": "1.2",
+  "resource_drift": [
+    {
+      "address": "module.network.aws_security_group.web",
+      "module_address": "module.network",
+      "type": "aws_security_group",
+      "change": {
+        "actions": ["update"],
+        "before": {"name": "web", "ingress": [{"from_port": 443}], "tags": {"team": "web"}},
+        "after": {"name": "web", "ingress": [{"from_port": 443}, {"from_port": 22}], "tags": {"team": "web", "owner": "ops"}}
+      }
+    },
+    {
+      "address": "aws_s3_bucket.logs",
+      "type": "aws_s3_bucket",
+      "change": {"actions": ["delete"], "before": {"bucket": "logs"}, "after": null}
+    }
+  ],
+  "resource_changes": []
+}`
+
+func TestDriftedResources(t *testing.T) {
+	resources, err := driftedResources([]byte(driftPlanJSON))
+	require.NoError(t, err)
+	assert.Equal(t, []*DriftedResource{
+		{Address: "aws_s3_bucket.logs", Type: "aws_s3_bucket", Actions: []string{"delete"}},
+		{
+			Address:           "module.network.aws_security_group.web",
+			Module:            "module.network",
+			Type:              "aws_security_group",
+			Actions:           []string{"update"},
+			ChangedAttributes: []string{"ingress", "tags"},
+		},
+	}, resources)
+
+	resources, err = driftedResources([]byte(`{"format_version": "1.2"}`))
+	require.NoError(t, err)
+	assert.Empty(t, resources)
+
+	_, err = driftedResources([]byte(`not json`))
+	assert.Error(t, err)
+}
+
+func TestHealthAssessmentTools(t *testing.T) {
+	logger := log.New()
+
+	tool := GetWorkspaceHealthAssessment(logger)
+	assert.Equal(t, "get_workspace_health_assessment", tool.Tool.Name)
+	assert.True(t, *tool.Tool.Annotations.ReadOnlyHint)
+	assert.Equal(t, []string{"terraform_org_name", "workspace_name"}, tool.Tool.InputSchema.Required)
+
+	tool = ListWorkspaceDriftedResources(logger)
+	assert.Equal(t, "list_workspace_drifted_resources", tool.Tool.Name)
+	assert.Contains(t, tool.Tool.InputSchema.Properties, "run_id")
+
+	tool = StartWorkspaceDriftDetection(logger)
+	assert.Equal(t, "start_workspace_drift_detection", tool.Tool.Name)
+	assert.False(t, *tool.Tool.Annotations.ReadOnlyHint)
+	assert.False(t, *tool.Tool.Annotations.DestructiveHint)
+	assert.Contains(t, tool.Tool.InputSchema.Properties, "on_behalf_of")
+}
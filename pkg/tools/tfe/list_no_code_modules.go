@@ -0,0 +1,120 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// NoCodeModuleSummary is a No Code module of an organization, a private module that workspaces can
+// be provisioned from without writing configuration
+type NoCodeModuleSummary struct {
+	ID              string `json:"no_code_module_id"`
+	PrivateModuleID string `json:"private_module_id"`
+	Enabled         bool   `json:"enabled"`
+	VersionPin      string `json:"version_pin,omitempty"`
+}
+
+// ListNoCodeModules creates a tool to list the No Code modules of an organization.
+func ListNoCodeModules(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("list_no_code_modules",
+			mcp.WithDescription(`This tool lists the No Code modules of your Terraform Cloud/Enterprise organization: the private modules that workspaces can be provisioned from without writing any configuration, which is the self-service path of HCP Terraform.
+Call get_no_code_module with a no_code_module_id to see its input variables, then create_no_code_workspace to provision a workspace from it. This tool requires a valid Terraform token to be configured.`),
+			mcp.WithTitleAnnotation("List the No Code modules of an organization"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("terraform_org_name",
+				mcp.Required(),
+				mcp.Description("The Terraform Cloud/Enterprise organization name"),
+			),
+			mcp.WithString("search_query",
+				mcp.Description("Optional query to filter the modules by name, namespace or provider"),
+			),
+			mcp.WithBoolean("include_disabled",
+				mcp.Description("Whether to include No Code modules that are disabled and cannot provision workspaces"),
+				mcp.DefaultBool(false),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return listNoCodeModulesHandler(ctx, request, logger)
+		},
+	}
+}
+
+func listNoCodeModulesHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	terraformOrgName, err := request.RequireString("terraform_org_name")
+	if err != nil {
+		return ToolError(logger, "missing required input: terraform_org_name", err)
+	}
+	terraformOrgName = strings.TrimSpace(terraformOrgName)
+	searchQuery := strings.TrimSpace(request.GetString("search_query", ""))
+	includeDisabled := request.GetBool("include_disabled", false)
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client - ensure TFE_TOKEN and TFE_ADDRESS are configured", err)
+	}
+
+	var modules []*tfe.RegistryModule
+	listOptions := &tfe.RegistryModuleListOptions{
+		ListOptions:  tfe.ListOptions{PageNumber: 1, PageSize: 100},
+		Include:      []tfe.RegistryModuleListIncludeOpt{tfe.IncludeNoCodeModules},
+		Search:       searchQuery,
+		RegistryName: tfe.PrivateRegistry,
+	}
+	for {
+		page, err := tfeClient.RegistryModules.List(ctx, terraformOrgName, listOptions)
+		if err != nil {
+			return ToolErrorf(logger, "failed to list private modules in org '%s': %v", terraformOrgName, err)
+		}
+		modules = append(modules, page.Items...)
+		if page.Pagination == nil || page.Pagination.NextPage == 0 {
+			break
+		}
+		listOptions.PageNumber = page.Pagination.NextPage
+	}
+
+	summaries := noCodeModuleSummaries(modules, includeDisabled)
+	logger.WithFields(log.Fields{
+		"organization":    terraformOrgName,
+		"no_code_modules": len(summaries),
+	}).Info("Listed No Code modules")
+
+	buf, err := json.Marshal(summaries)
+	if err != nil {
+		return ToolError(logger, "failed to marshal No Code modules", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+// noCodeModuleSummaries returns the No Code modules of the given private modules
+func noCodeModuleSummaries(modules []*tfe.RegistryModule, includeDisabled bool) []NoCodeModuleSummary {
+	summaries := make([]NoCodeModuleSummary, 0)
+	for _, module := range modules {
+		for _, noCodeModule := range module.RegistryNoCodeModule {
+			if noCodeModule == nil || (!noCodeModule.Enabled && !includeDisabled) {
+				continue
+			}
+			summaries = append(summaries, NoCodeModuleSummary{
+				ID:              noCodeModule.ID,
+				PrivateModuleID: fmt.Sprintf("%s/%s/%s", module.Namespace, module.Name, module.Provider),
+				Enabled:         noCodeModule.Enabled,
+				VersionPin:      noCodeModule.VersionPin,
+			})
+		}
+	}
+	return summaries
+}
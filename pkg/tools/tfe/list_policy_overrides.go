@@ -0,0 +1,63 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const defaultPolicyOverrideDays = 30
+
+// ListPolicyOverrides creates a tool to report recent policy overrides for governance review.
+func ListPolicyOverrides(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("list_policy_overrides",
+			mcp.WithDescription(`Lists recent overrides of soft-mandatory policy failures in the organization: who overrode the policy check or policy evaluation, when, and on which run and workspace. Built from the organization audit trail, which is only available on HCP Terraform plans that include it and requires an organization token.`),
+			mcp.WithTitleAnnotation("List recent policy overrides"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithNumber("days",
+				mcp.Description("Number of days of audit trail to search"),
+				mcp.DefaultNumber(defaultPolicyOverrideDays),
+				mcp.Min(1),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return listPolicyOverridesHandler(ctx, request, logger)
+		},
+	}
+}
+
+func listPolicyOverridesHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	days := request.GetInt("days", defaultPolicyOverrideDays)
+	if days < 1 {
+		return ToolErrorf(logger, "days must be at least 1, got %d", days)
+	}
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+
+	since := time.Now().UTC().AddDate(0, 0, -days).Truncate(time.Second)
+	report, err := client.ListPolicyOverrides(ctx, tfeClient, since, logger)
+	if err != nil {
+		return ToolErrorf(logger, "failed to read the audit trail, it requires an organization token and a plan that includes audit logging: %v", err)
+	}
+
+	buf, err := json.Marshal(report)
+	if err != nil {
+		return ToolError(logger, "failed to marshal policy overrides", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
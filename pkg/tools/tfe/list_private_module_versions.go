@@ -0,0 +1,135 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// ListPrivateModuleVersions creates a tool to list the published versions of a private module.
+func ListPrivateModuleVersions(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("list_private_module_versions",
+			mcp.WithDescription(`This tool lists every version of a private module in your Terraform Cloud/Enterprise organization, newest first, with the ingestion status of each version.
+The private_module_id format is 'module-namespace/module-name/module-provider-name' and can be obtained by calling 'search_private_modules'. This tool requires a valid Terraform token to be configured.`),
+			mcp.WithTitleAnnotation("List the versions of a private module"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("terraform_org_name",
+				mcp.Required(),
+				mcp.Description("The Terraform Cloud/Enterprise organization name"),
+			),
+			mcp.WithString("private_module_id",
+				mcp.Required(),
+				mcp.Description(`The private module ID in the format 'module-namespace/module-name/module-provider-name' (for example, 'my-tfc-org/vpc/aws'). Obtain this ID by calling 'search_private_modules'.`),
+			),
+			mcp.WithString("registry_name",
+				mcp.Description("The type of Terraform registry to search within Terraform Cloud/Enterprise (e.g., 'private', 'public')"),
+				mcp.Enum("private", "public"),
+				mcp.DefaultString("private"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return listPrivateModuleVersionsHandler(ctx, request, logger)
+		},
+	}
+}
+
+func listPrivateModuleVersionsHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	terraformOrgName, err := request.RequireString("terraform_org_name")
+	if err != nil {
+		return ToolError(logger, "missing required input: terraform_org_name", err)
+	}
+	terraformOrgName = strings.TrimSpace(terraformOrgName)
+
+	moduleID, err := request.RequireString("private_module_id")
+	if err != nil {
+		return ToolError(logger, "missing required input: private_module_id", err)
+	}
+	moduleID = strings.TrimSpace(moduleID)
+
+	registryName := strings.TrimSpace(request.GetString("registry_name", "private"))
+
+	parts := strings.Split(moduleID, "/")
+	if len(parts) != 3 {
+		return ToolError(logger, "private_module_id must be in format 'module-namespace/module-name/module-provider-name'", nil)
+	}
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client - ensure TFE_TOKEN and TFE_ADDRESS are configured", err)
+	}
+
+	logger.WithFields(log.Fields{
+		"terraform_org_name": terraformOrgName,
+		"private_module_id":  moduleID,
+	}).Info("Listing private module versions")
+
+	module, err := tfeClient.RegistryModules.Read(ctx, tfe.RegistryModuleID{
+		Organization: terraformOrgName,
+		Namespace:    parts[0],
+		Name:         parts[1],
+		Provider:     parts[2],
+		RegistryName: tfe.RegistryName(registryName),
+	})
+	if err != nil {
+		return ToolErrorf(logger, "module not found: %s - use search_private_modules to find valid module IDs", moduleID)
+	}
+
+	versions := sortModuleVersions(module.VersionStatuses)
+
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf("Versions of private module: %s\n", moduleID))
+	builder.WriteString(fmt.Sprintf("Found %d version(s):\n", len(versions)))
+	builder.WriteString("(Use a 'version' value as 'private_module_version' with get_private_module_details tool)\n\n")
+	if len(versions) == 0 {
+		builder.WriteString("No versions have been published for this module.\n")
+		return mcp.NewToolResultText(builder.String()), nil
+	}
+
+	builder.WriteString("| Version | Status | Error |\n")
+	builder.WriteString("|---------|--------|-------|\n")
+	for _, v := range versions {
+		builder.WriteString(fmt.Sprintf("| %s | %s | %s |\n", v.Version, v.Status, v.Error))
+	}
+
+	logger.WithFields(log.Fields{
+		"private_module_id": moduleID,
+		"versions_count":    len(versions),
+	}).Info("Successfully listed private module versions")
+
+	return mcp.NewToolResultText(builder.String()), nil
+}
+
+// sortModuleVersions returns the version statuses ordered from newest to
+// oldest. Versions that do not parse as semver are kept after the others.
+func sortModuleVersions(statuses []tfe.RegistryModuleVersionStatuses) []tfe.RegistryModuleVersionStatuses {
+	sorted := make([]tfe.RegistryModuleVersionStatuses, len(statuses))
+	copy(sorted, statuses)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		vi, errI := version.NewVersion(sorted[i].Version)
+		vj, errJ := version.NewVersion(sorted[j].Version)
+		switch {
+		case errI != nil:
+			return false
+		case errJ != nil:
+			return true
+		}
+		return vi.GreaterThan(vj)
+	})
+	return sorted
+}
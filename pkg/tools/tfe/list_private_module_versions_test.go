@@ -0,0 +1,100 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-tfe"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListPrivateModuleVersions(t *testing.T) {
+	logger := log.New()
+	logger.SetLevel(log.ErrorLevel)
+
+	t.Run("tool creation", func(t *testing.T) {
+		tool := ListPrivateModuleVersions(logger)
+
+		assert.Equal(t, "list_private_module_versions", tool.Tool.Name)
+		assert.NotNil(t, tool.Handler)
+		assert.True(t, *tool.Tool.Annotations.ReadOnlyHint)
+
+		assert.Contains(t, tool.Tool.InputSchema.Required, "terraform_org_name")
+		assert.Contains(t, tool.Tool.InputSchema.Required, "private_module_id")
+		assert.NotContains(t, tool.Tool.InputSchema.Required, "registry_name")
+	})
+
+	t.Run("versions are sorted newest first", func(t *testing.T) {
+		statuses := []tfe.RegistryModuleVersionStatuses{
+			{Version: "1.2.0"},
+			{Version: "not-a-version"},
+			{Version: "1.10.0"},
+			{Version: "0.9.1"},
+		}
+
+		sorted := sortModuleVersions(statuses)
+		var got []string
+		for _, s := range sorted {
+			got = append(got, s.Version)
+		}
+		assert.Equal(t, []string{"1.10.0", "1.2.0", "0.9.1", "not-a-version"}, got)
+		assert.Equal(t, "1.2.0", statuses[0].Version)
+	})
+}
+
+func TestGetNoCodeModule(t *testing.T) {
+	logger := log.New()
+	logger.SetLevel(log.ErrorLevel)
+
+	t.Run("tool creation", func(t *testing.T) {
+		tool := GetNoCodeModule(logger)
+
+		assert.Equal(t, "get_no_code_module", tool.Tool.Name)
+		assert.NotNil(t, tool.Handler)
+		assert.True(t, *tool.Tool.Annotations.ReadOnlyHint)
+		assert.Contains(t, tool.Tool.InputSchema.Required, "no_code_module_id")
+	})
+
+	t.Run("variable options", func(t *testing.T) {
+		options := []*tfe.NoCodeVariableOption{
+			{VariableName: "region", Options: []string{"us-east-1", "eu-west-1"}},
+		}
+
+		assert.Equal(t, []string{"us-east-1", "eu-west-1"}, noCodeVariableOptions("region", options))
+		assert.Nil(t, noCodeVariableOptions("instance_type", options))
+	})
+}
+
+func TestListNoCodeModules(t *testing.T) {
+	logger := log.New()
+	logger.SetLevel(log.ErrorLevel)
+
+	t.Run("tool creation", func(t *testing.T) {
+		tool := ListNoCodeModules(logger)
+
+		assert.Equal(t, "list_no_code_modules", tool.Tool.Name)
+		assert.NotNil(t, tool.Handler)
+		assert.True(t, *tool.Tool.Annotations.ReadOnlyHint)
+		assert.Contains(t, tool.Tool.InputSchema.Required, "terraform_org_name")
+	})
+
+	t.Run("disabled No Code modules are skipped by default", func(t *testing.T) {
+		modules := []*tfe.RegistryModule{
+			{Namespace: "my-org", Name: "vpc", Provider: "aws", RegistryNoCodeModule: []*tfe.RegistryNoCodeModule{
+				{ID: "nocode-1", Enabled: true, VersionPin: "1.2.0"},
+			}},
+			{Namespace: "my-org", Name: "bucket", Provider: "aws", RegistryNoCodeModule: []*tfe.RegistryNoCodeModule{
+				{ID: "nocode-2", Enabled: false},
+			}},
+			{Namespace: "my-org", Name: "plain", Provider: "aws"},
+		}
+
+		summaries := noCodeModuleSummaries(modules, false)
+		assert.Equal(t, []NoCodeModuleSummary{{ID: "nocode-1", PrivateModuleID: "my-org/vpc/aws", Enabled: true, VersionPin: "1.2.0"}}, summaries)
+		assert.Len(t, noCodeModuleSummaries(modules, true), 2)
+		assert.NotNil(t, noCodeModuleSummaries(nil, false))
+	})
+}
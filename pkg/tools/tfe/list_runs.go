@@ -21,7 +21,7 @@ import (
 func ListRuns(logger *log.Logger) server.ServerTool {
 	return server.ServerTool{
 		Tool: mcp.NewTool("list_runs",
-			mcp.WithDescription(`List or search Terraform runs in a specific workspace with optional filtering.`),
+			mcp.WithDescription(`List or search Terraform runs in a specific workspace with optional filtering. Use fetch_all to return the runs of several pages at once.`),
 			mcp.WithTitleAnnotation("List Terraform runs"),
 			mcp.WithReadOnlyHintAnnotation(true),
 			mcp.WithDestructiveHintAnnotation(false),
@@ -69,6 +69,7 @@ func ListRuns(logger *log.Logger) server.ServerTool {
 				),
 			),
 			utils.WithPagination(),
+			utils.WithFetchAll(),
 		),
 		Handler: func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			return listRunsHandler(ctx, req, logger)
@@ -118,7 +119,16 @@ func listRunsHandler(ctx context.Context, request mcp.CallToolRequest, logger *l
 			return ToolErrorf(logger, "workspace '%s' not found in org '%s'", workspaceName, terraformOrgName)
 		}
 
-		runs, err := tfeClient.Runs.List(ctx, workspace.ID, options)
+		runs := &tfe.RunList{}
+		runs.Items, err = utils.FetchPages(pagination, func(pageNumber int) ([]*tfe.Run, int, error) {
+			options.PageNumber = pageNumber
+			page, err := tfeClient.Runs.List(ctx, workspace.ID, options)
+			if err != nil {
+				return nil, 0, err
+			}
+			runs.Pagination = page.Pagination
+			return page.Items, nextPage(page.Pagination), nil
+		})
 		if err != nil {
 			return ToolError(logger, "failed to list runs in workspace", err)
 		}
@@ -165,7 +175,19 @@ func listRunsHandler(ctx context.Context, request mcp.CallToolRequest, logger *l
 			options.User = vcsUsername
 		}
 
-		runs, err := tfeClient.Runs.ListForOrganization(ctx, terraformOrgName, options)
+		runs := &tfe.OrganizationRunList{}
+		runs.Items, err = utils.FetchPages(pagination, func(pageNumber int) ([]*tfe.Run, int, error) {
+			options.PageNumber = pageNumber
+			page, err := tfeClient.Runs.ListForOrganization(ctx, terraformOrgName, options)
+			if err != nil {
+				return nil, 0, err
+			}
+			runs.PaginationNextPrev = page.PaginationNextPrev
+			if page.PaginationNextPrev == nil {
+				return page.Items, 0, nil
+			}
+			return page.Items, page.PaginationNextPrev.NextPage, nil
+		})
 		if err != nil {
 			return ToolErrorf(logger, "failed to list runs in org '%s'", terraformOrgName)
 		}
@@ -6,6 +6,7 @@ package tools
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"strings"
 	"time"
 
@@ -22,11 +23,12 @@ func ListStateVersions(logger *log.Logger) server.ServerTool {
 	return server.ServerTool{
 		Tool: mcp.NewTool(
 			"list_state_versions",
-			mcp.WithDescription("List all the State Versions for a given workspace and org name."),
+			mcp.WithDescription("List all the State Versions for a given workspace and org name, newest first. Use fetch_all to return the state versions of several pages at once, e.g. to analyze the state history of a workspace."),
 			mcp.WithTitleAnnotation(`List all States Versions`),
 			mcp.WithReadOnlyHintAnnotation(true),
 			mcp.WithDestructiveHintAnnotation(false),
 			utils.WithPagination(),
+			utils.WithFetchAll(),
 			mcp.WithString("terraform_org_name",
 				mcp.Required(),
 				mcp.Description("The Terraform organization name"),
@@ -35,6 +37,15 @@ func ListStateVersions(logger *log.Logger) server.ServerTool {
 				mcp.Required(),
 				mcp.Description("The workspace name to list state versions for"),
 			),
+			mcp.WithArray("status",
+				mcp.Description("Optional state version status filter, applied to the state versions of the fetched pages"),
+				mcp.WithStringEnumItems([]string{
+					string(tfe.StateVersionPending),
+					string(tfe.StateVersionFinalized),
+					string(tfe.StateVersionDiscarded),
+				}),
+			),
+			utils.WithOutputFormat(),
 		),
 
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -73,13 +84,33 @@ func listStateVersionsHandler(
 		return ToolError(logger, "Invalid pagination parameters", err)
 	}
 
-	sv, err := tfeClient.StateVersions.List(ctx, &tfe.StateVersionListOptions{
+	statuses := make(map[string]bool)
+	for _, status := range request.GetStringSlice("status", nil) {
+		statuses[strings.TrimSpace(status)] = true
+	}
+
+	outputFormat, err := utils.OutputFormatParam(request)
+	if err != nil {
+		return ToolError(logger, "Invalid output format", err)
+	}
+
+	options := &tfe.StateVersionListOptions{
 		Organization: terraformOrgName,
 		Workspace:    workspaceName,
 		ListOptions: tfe.ListOptions{
 			PageNumber: pagination.Page,
 			PageSize:   pagination.PageSize,
 		},
+	}
+	sv := &tfe.StateVersionList{}
+	sv.Items, err = utils.FetchPages(pagination, func(pageNumber int) ([]*tfe.StateVersion, int, error) {
+		options.PageNumber = pageNumber
+		page, err := tfeClient.StateVersions.List(ctx, options)
+		if err != nil {
+			return nil, 0, err
+		}
+		sv.Pagination = page.Pagination
+		return page.Items, nextPage(page.Pagination), nil
 	})
 	if err != nil {
 		return ToolError(logger, "Failed to list workspace state versions", err)
@@ -88,23 +119,17 @@ func listStateVersionsHandler(
 		return ToolError(logger, "Workspace has no StateVersions to list", err)
 	}
 
-	svSummaries := make([]*StateVersionsSummary, len(sv.Items))
-	for i, o := range sv.Items {
-		svSummaries[i] = &StateVersionsSummary{
-			ID:               o.ID,
-			CreatedAt:        o.CreatedAt,
-			Serial:           o.Serial,
-			TerraformVersion: o.TerraformVersion,
-			VCSCommitSHA:     o.VCSCommitSHA,
-			VCSCommitURL:     o.VCSCommitURL,
-			StateVersion:     o.StateVersion,
-		}
-	}
+	svSummaries := stateVersionSummaries(sv.Items, statuses)
 
-	svJSON, err := json.Marshal(&StateVersionsSummaryList{
+	svList := &StateVersionsSummaryList{
 		Items:      svSummaries,
 		Pagination: sv.Pagination,
-	})
+	}
+	if outputFormat == utils.OutputFormatMarkdown {
+		return mcp.NewToolResultStructured(svList, svList.markdown(workspaceName, time.Now())), nil
+	}
+
+	svJSON, err := json.Marshal(svList)
 	if err != nil {
 		return ToolError(logger, "Failed to marshal organization names", err)
 	}
@@ -113,11 +138,35 @@ func listStateVersionsHandler(
 
 }
 
+// stateVersionSummaries summarizes the state versions, keeping those with one of the statuses if any
+func stateVersionSummaries(items []*tfe.StateVersion, statuses map[string]bool) []*StateVersionsSummary {
+	summaries := make([]*StateVersionsSummary, 0, len(items))
+	for _, o := range items {
+		if len(statuses) > 0 && !statuses[string(o.Status)] {
+			continue
+		}
+		summaries = append(summaries, &StateVersionsSummary{
+			ID:               o.ID,
+			CreatedAt:        o.CreatedAt,
+			Status:           string(o.Status),
+			Serial:           o.Serial,
+			Size:             o.Size,
+			TerraformVersion: o.TerraformVersion,
+			VCSCommitSHA:     o.VCSCommitSHA,
+			VCSCommitURL:     o.VCSCommitURL,
+			StateVersion:     o.StateVersion,
+		})
+	}
+	return summaries
+}
+
 // StateVersionsSummary is a truncated summary of State Version details for listing
 type StateVersionsSummary struct {
 	ID               string    `json:"id"`
 	CreatedAt        time.Time `json:"created_at"`
+	Status           string    `json:"status"`
 	Serial           int64     `json:"serial"`
+	Size             int64     `json:"size"`
 	TerraformVersion string    `json:"terraform_version"`
 	VCSCommitSHA     string    `json:"vcs_commit_sha"`
 	VCSCommitURL     string    `json:"vcs_commit_url"`
@@ -129,3 +178,19 @@ type StateVersionsSummaryList struct {
 	Items []*StateVersionsSummary `json:"items"`
 	*tfe.Pagination
 }
+
+// markdown renders the state version list as a Markdown table with humanized sizes and timestamps
+func (l *StateVersionsSummaryList) markdown(workspaceName string, now time.Time) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "## State versions for workspace %s\n\n", workspaceName)
+	b.WriteString("| ID | Serial | Status | Created | Size | Terraform version | VCS commit |\n")
+	b.WriteString("|----|--------|--------|---------|------|-------------------|------------|\n")
+	for _, sv := range l.Items {
+		fmt.Fprintf(&b, "| %s | %d | %s | %s | %s | %s | %s |\n",
+			sv.ID, sv.Serial, sv.Status, utils.HumanizeTimestamp(sv.CreatedAt, now), utils.HumanizeBytes(sv.Size), sv.TerraformVersion, sv.VCSCommitSHA)
+	}
+	if l.Pagination != nil {
+		fmt.Fprintf(&b, "\nPage %d of %d (%d state versions in total)\n", l.CurrentPage, l.TotalPages, l.TotalCount)
+	}
+	return b.String()
+}
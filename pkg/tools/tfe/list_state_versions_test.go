@@ -6,8 +6,10 @@ package tools
 import (
 	"testing"
 
+	"github.com/hashicorp/go-tfe"
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestListStateVersions(t *testing.T) {
@@ -29,6 +31,8 @@ func TestListStateVersions(t *testing.T) {
 
 		assert.Contains(t, tool.Tool.InputSchema.Required, "terraform_org_name")
 		assert.Contains(t, tool.Tool.InputSchema.Required, "workspace_name")
+		assert.Contains(t, tool.Tool.InputSchema.Properties, "status")
+		assert.Contains(t, tool.Tool.InputSchema.Properties, "fetch_all")
 	})
 
 	// Required parameter validation
@@ -98,3 +102,28 @@ func TestListStateVersions(t *testing.T) {
 		}
 	})
 }
+
+func TestStateVersionSummaries(t *testing.T) {
+	items := []*tfe.StateVersion{
+		{ID: "sv-3", Serial: 3, Status: tfe.StateVersionPending},
+		{ID: "sv-2", Serial: 2, Status: tfe.StateVersionFinalized},
+		{ID: "sv-1", Serial: 1, Status: tfe.StateVersionDiscarded},
+	}
+
+	t.Run("no status filter", func(t *testing.T) {
+		summaries := stateVersionSummaries(items, nil)
+		require.Len(t, summaries, 3)
+		assert.Equal(t, "pending", summaries[0].Status)
+	})
+
+	t.Run("status filter", func(t *testing.T) {
+		summaries := stateVersionSummaries(items, map[string]bool{"finalized": true, "discarded": true})
+		require.Len(t, summaries, 2)
+		assert.Equal(t, "sv-2", summaries[0].ID)
+		assert.Equal(t, "sv-1", summaries[1].ID)
+	})
+
+	t.Run("no match", func(t *testing.T) {
+		assert.Empty(t, stateVersionSummaries(items[1:], map[string]bool{"pending": true}))
+	})
+}
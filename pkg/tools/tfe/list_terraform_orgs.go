@@ -20,11 +20,12 @@ import (
 func ListTerraformOrgs(logger *log.Logger) server.ServerTool {
 	return server.ServerTool{
 		Tool: mcp.NewTool("list_terraform_orgs",
-			mcp.WithDescription(`Fetches a list of all Terraform organizations. Supports Pagination for large result sets.`),
+			mcp.WithDescription(`Fetches a list of all Terraform organizations. Supports Pagination for large result sets, use fetch_all to return the organizations of several pages at once.`),
 			mcp.WithTitleAnnotation("List all Terraform organizations"),
 			mcp.WithReadOnlyHintAnnotation(true),
 			mcp.WithDestructiveHintAnnotation(false),
 			utils.WithPagination(),
+			utils.WithFetchAll(),
 		),
 		Handler: func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			return listTerraformOrgsHandler(ctx, req, logger)
@@ -46,11 +47,19 @@ func listTerraformOrgsHandler(ctx context.Context, request mcp.CallToolRequest,
 		return ToolError(logger, "invalid pagination parameters", err)
 	}
 
-	orgs, err := tfeClient.Organizations.List(ctx, &tfe.OrganizationListOptions{
-		ListOptions: tfe.ListOptions{
-			PageNumber: pagination.Page,
-			PageSize:   pagination.PageSize,
-		},
+	orgs := &tfe.OrganizationList{}
+	orgs.Items, err = utils.FetchPages(pagination, func(pageNumber int) ([]*tfe.Organization, int, error) {
+		page, err := tfeClient.Organizations.List(ctx, &tfe.OrganizationListOptions{
+			ListOptions: tfe.ListOptions{
+				PageNumber: pageNumber,
+				PageSize:   pagination.PageSize,
+			},
+		})
+		if err != nil {
+			return nil, 0, err
+		}
+		orgs.Pagination = page.Pagination
+		return page.Items, nextPage(page.Pagination), nil
 	})
 	if err != nil {
 		return ToolError(logger, "failed to list Terraform organizations", err)
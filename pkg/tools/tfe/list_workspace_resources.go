@@ -0,0 +1,175 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// WorkspaceResources lists the managed resources of a workspace
+type WorkspaceResources struct {
+	WorkspaceID   string               `json:"workspace_id"`
+	WorkspaceName string               `json:"workspace_name"`
+	TotalCount    int                  `json:"total_count"`
+	MatchedCount  int                  `json:"matched_count"`
+	CountsByType  map[string]int       `json:"counts_by_type"`
+	Resources     []*WorkspaceResource `json:"resources"`
+}
+
+// WorkspaceResource is a managed resource instance of a workspace
+type WorkspaceResource struct {
+	Address   string `json:"address"`
+	Type      string `json:"type"`
+	Name      string `json:"name"`
+	Index     string `json:"index,omitempty"`
+	Provider  string `json:"provider"`
+	Module    string `json:"module"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+// ListWorkspaceResources creates a tool to list the managed resources of a workspace.
+func ListWorkspaceResources(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("list_hcp_terraform_workspace_resources",
+			mcp.WithDescription(`Lists the managed resources of a workspace with their address, type, name, provider and module path, and a count per resource type, without downloading the state file. Filter by resource type or module to answer questions such as "which S3 buckets does this workspace manage".`),
+			mcp.WithTitleAnnotation("List the managed resources of a Terraform workspace"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("terraform_org_name",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+			mcp.WithString("workspace_name",
+				mcp.Required(),
+				mcp.Description("Workspace name"),
+			),
+			mcp.WithString("resource_type",
+				mcp.Description("Optional comma-separated list of resource types to return, e.g. 'aws_s3_bucket,aws_s3_bucket_policy'"),
+			),
+			mcp.WithString("module",
+				mcp.Description("Optional module path to return only the resources of a module and its child modules, e.g. 'module.network'. Use 'root' for the resources of the root module"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return listWorkspaceResourcesHandler(ctx, request, logger)
+		},
+	}
+}
+
+func listWorkspaceResourcesHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	orgName, err := request.RequireString("terraform_org_name")
+	if err != nil {
+		return ToolError(logger, "missing required input: terraform_org_name", err)
+	}
+	orgName = strings.TrimSpace(orgName)
+
+	workspaceName, err := request.RequireString("workspace_name")
+	if err != nil {
+		return ToolError(logger, "missing required input: workspace_name", err)
+	}
+	workspaceName = strings.TrimSpace(workspaceName)
+
+	filter := workspaceResourceFilter{
+		types:  make(map[string]bool),
+		module: strings.TrimSpace(request.GetString("module", "")),
+	}
+	for _, resourceType := range strings.Split(request.GetString("resource_type", ""), ",") {
+		if resourceType = strings.TrimSpace(resourceType); resourceType != "" {
+			filter.types[resourceType] = true
+		}
+	}
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+
+	workspace, err := tfeClient.Workspaces.Read(ctx, orgName, workspaceName)
+	if err != nil {
+		return ToolErrorf(logger, "workspace '%s' not found in org '%s'", workspaceName, orgName)
+	}
+
+	var items []*tfe.WorkspaceResource
+	options := &tfe.WorkspaceResourceListOptions{
+		ListOptions: tfe.ListOptions{PageNumber: 1, PageSize: 100},
+	}
+	for {
+		page, err := tfeClient.WorkspaceResources.List(ctx, workspace.ID, options)
+		if err != nil {
+			return ToolErrorf(logger, "failed to list the resources of workspace '%s': %v", workspaceName, err)
+		}
+		items = append(items, page.Items...)
+		if page.Pagination == nil || page.NextPage == 0 {
+			break
+		}
+		options.PageNumber = page.NextPage
+	}
+
+	buf, err := json.Marshal(workspaceResources(workspace, items, filter))
+	if err != nil {
+		return ToolError(logger, "failed to marshal workspace resources", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+// workspaceResourceFilter selects resources by type and module path
+type workspaceResourceFilter struct {
+	types  map[string]bool
+	module string
+}
+
+func (f workspaceResourceFilter) matches(resource *tfe.WorkspaceResource) bool {
+	if len(f.types) > 0 && !f.types[resource.ProviderType] {
+		return false
+	}
+	switch f.module {
+	case "":
+		return true
+	case "root":
+		return resource.Module == "root" || resource.Module == ""
+	default:
+		return resource.Module == f.module || strings.HasPrefix(resource.Module, f.module+".")
+	}
+}
+
+// workspaceResources converts and filters the resources of a workspace, counting the matches by type
+func workspaceResources(workspace *tfe.Workspace, items []*tfe.WorkspaceResource, filter workspaceResourceFilter) *WorkspaceResources {
+	result := &WorkspaceResources{
+		WorkspaceID:   workspace.ID,
+		WorkspaceName: workspace.Name,
+		TotalCount:    len(items),
+		CountsByType:  make(map[string]int),
+		Resources:     []*WorkspaceResource{},
+	}
+	for _, item := range items {
+		if !filter.matches(item) {
+			continue
+		}
+		resource := &WorkspaceResource{
+			Address:   item.Address,
+			Type:      item.ProviderType,
+			Name:      item.Name,
+			Provider:  item.Provider,
+			Module:    item.Module,
+			UpdatedAt: item.UpdatedAt,
+		}
+		if item.NameIndex != nil {
+			resource.Index = *item.NameIndex
+		}
+		result.Resources = append(result.Resources, resource)
+		result.CountsByType[resource.Type]++
+	}
+	result.MatchedCount = len(result.Resources)
+	return result
+}
@@ -0,0 +1,68 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-tfe"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListWorkspaceResources(t *testing.T) {
+	logger := log.New()
+	logger.SetLevel(log.ErrorLevel)
+
+	t.Run("tool creation", func(t *testing.T) {
+		tool := ListWorkspaceResources(logger)
+
+		assert.Equal(t, "list_hcp_terraform_workspace_resources", tool.Tool.Name)
+		assert.NotNil(t, tool.Handler)
+		assert.True(t, *tool.Tool.Annotations.ReadOnlyHint)
+
+		assert.Contains(t, tool.Tool.InputSchema.Required, "terraform_org_name")
+		assert.Contains(t, tool.Tool.InputSchema.Required, "workspace_name")
+		assert.NotContains(t, tool.Tool.InputSchema.Required, "resource_type")
+	})
+
+	index := "0"
+	workspace := &tfe.Workspace{ID: "ws-1", Name: "prod-network"}
+	items := []*tfe.WorkspaceResource{
+		{Address: "aws_vpc.main", Name: "main", ProviderType: "aws_vpc", Provider: "hashicorp/aws", Module: "root"},
+		{Address: "module.subnets.aws_subnet.private[0]", Name: "private", NameIndex: &index, ProviderType: "aws_subnet", Provider: "hashicorp/aws", Module: "module.subnets"},
+		{Address: "module.subnets.module.nat.aws_nat_gateway.this", Name: "this", ProviderType: "aws_nat_gateway", Provider: "hashicorp/aws", Module: "module.subnets.module.nat"},
+		{Address: "module.subnets_extra.aws_subnet.public", Name: "public", ProviderType: "aws_subnet", Provider: "hashicorp/aws", Module: "module.subnets_extra"},
+	}
+
+	t.Run("no filter", func(t *testing.T) {
+		result := workspaceResources(workspace, items, workspaceResourceFilter{})
+
+		assert.Equal(t, 4, result.TotalCount)
+		assert.Equal(t, 4, result.MatchedCount)
+		assert.Equal(t, map[string]int{"aws_vpc": 1, "aws_subnet": 2, "aws_nat_gateway": 1}, result.CountsByType)
+		assert.Equal(t, "0", result.Resources[1].Index)
+	})
+
+	t.Run("resource type filter", func(t *testing.T) {
+		result := workspaceResources(workspace, items, workspaceResourceFilter{types: map[string]bool{"aws_subnet": true}})
+
+		assert.Equal(t, 4, result.TotalCount)
+		assert.Equal(t, 2, result.MatchedCount)
+		assert.Equal(t, map[string]int{"aws_subnet": 2}, result.CountsByType)
+	})
+
+	t.Run("module filter", func(t *testing.T) {
+		result := workspaceResources(workspace, items, workspaceResourceFilter{module: "module.subnets"})
+		var addresses []string
+		for _, r := range result.Resources {
+			addresses = append(addresses, r.Address)
+		}
+		assert.Equal(t, []string{"module.subnets.aws_subnet.private[0]", "module.subnets.module.nat.aws_nat_gateway.this"}, addresses)
+
+		result = workspaceResources(workspace, items, workspaceResourceFilter{module: "root"})
+		assert.Equal(t, 1, result.MatchedCount)
+		assert.Equal(t, "aws_vpc.main", result.Resources[0].Address)
+	})
+}
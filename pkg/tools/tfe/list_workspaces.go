@@ -22,12 +22,13 @@ import (
 func ListWorkspaces(logger *log.Logger) server.ServerTool {
 	return server.ServerTool{
 		Tool: mcp.NewTool("list_workspaces",
-			mcp.WithDescription(`Search and list Terraform workspaces within a specified organization. Returns all workspaces when no filters are applied, or filters results based on name patterns, tags, or search queries. Supports pagination for large result sets. Returns a truncated summary of the workspace, use get_workspace_details to get the full details for a specific workspace.`),
+			mcp.WithDescription(`Search and list Terraform workspaces within a specified organization. Returns all workspaces when no filters are applied, or filters results based on name patterns, tags, or search queries. Supports pagination for large result sets, use fetch_all to return the workspaces of several pages at once. Returns a truncated summary of the workspace, use get_workspace_details to get the full details for a specific workspace.`),
 			mcp.WithTitleAnnotation("List Terraform workspaces with queries"),
 			mcp.WithOpenWorldHintAnnotation(true),
 			mcp.WithReadOnlyHintAnnotation(true),
 			mcp.WithDestructiveHintAnnotation(false),
 			utils.WithPagination(),
+			utils.WithFetchAll(),
 			mcp.WithString("terraform_org_name",
 				mcp.Required(),
 				mcp.Description("The Terraform organization name"),
@@ -47,6 +48,10 @@ func ListWorkspaces(logger *log.Logger) server.ServerTool {
 			mcp.WithString("wildcard_name",
 				mcp.Description("Optional wildcard pattern to match workspace names"),
 			),
+			mcp.WithBoolean("include_current_run",
+				mcp.Description("Include the ID, status and creation time of each workspace's current run, along with a count of workspaces per run status"),
+				mcp.DefaultBool(false),
+			),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			return searchTerraformWorkspacesHandler(ctx, request, logger)
@@ -66,6 +71,7 @@ func searchTerraformWorkspacesHandler(ctx context.Context, request mcp.CallToolR
 	tagsStr := request.GetString("tags", "")
 	excludeTagsStr := request.GetString("exclude_tags", "")
 	wildcardName := request.GetString("wildcard_name", "")
+	includeCurrentRun := request.GetBool("include_current_run", false)
 
 	var tags []string
 	if tagsStr != "" {
@@ -93,7 +99,7 @@ func searchTerraformWorkspacesHandler(ctx context.Context, request mcp.CallToolR
 		return ToolError(logger, "invalid pagination parameters", err)
 	}
 
-	workspaces, err := tfeClient.Workspaces.List(ctx, terraformOrgName, &tfe.WorkspaceListOptions{
+	options := &tfe.WorkspaceListOptions{
 		ProjectID:    projectID,
 		Search:       searchQuery,
 		Tags:         strings.Join(tags, ","),
@@ -103,6 +109,21 @@ func searchTerraformWorkspacesHandler(ctx context.Context, request mcp.CallToolR
 			PageNumber: pagination.Page,
 			PageSize:   pagination.PageSize,
 		},
+	}
+	if includeCurrentRun {
+		options.Include = []tfe.WSIncludeOpt{tfe.WSCurrentRun}
+	}
+
+	// The aggregated list carries the pagination details of the last page fetched
+	workspaces := &tfe.WorkspaceList{}
+	workspaces.Items, err = utils.FetchPages(pagination, func(pageNumber int) ([]*tfe.Workspace, int, error) {
+		options.PageNumber = pageNumber
+		page, err := tfeClient.Workspaces.List(ctx, terraformOrgName, options)
+		if err != nil {
+			return nil, 0, err
+		}
+		workspaces.Pagination = page.Pagination
+		return page.Items, nextPage(page.Pagination), nil
 	})
 	if err != nil {
 		return ToolErrorf(logger, "failed to list workspaces in org '%s'", terraformOrgName)
@@ -111,22 +132,7 @@ func searchTerraformWorkspacesHandler(ctx context.Context, request mcp.CallToolR
 		return ToolErrorf(logger, "no workspaces to list in organization %q", terraformOrgName)
 	}
 
-	summaries := make([]*WorkspaceSummary, len(workspaces.Items))
-	for i, w := range workspaces.Items {
-		summaries[i] = &WorkspaceSummary{
-			ID:            w.ID,
-			Name:          w.Name,
-			Description:   w.Description,
-			Environment:   w.Environment,
-			CreatedAt:     w.CreatedAt,
-			ExecutionMode: w.ExecutionMode,
-		}
-	}
-
-	buf, err := json.Marshal(&WorkspaceSummaryList{
-		Items:      summaries,
-		Pagination: workspaces.Pagination,
-	})
+	buf, err := json.Marshal(newWorkspaceSummaryList(workspaces, includeCurrentRun))
 	if err != nil {
 		return ToolError(logger, "failed to marshal workspaces", err)
 	}
@@ -142,10 +148,59 @@ type WorkspaceSummary struct {
 	Environment   string    `json:"environment"`
 	CreatedAt     time.Time `json:"created_at"`
 	ExecutionMode string    `json:"execution_mode"`
+
+	// CurrentRun is only set when include_current_run is requested
+	CurrentRun *WorkspaceCurrentRun `json:"current_run,omitempty"`
+}
+
+// WorkspaceCurrentRun is the quick status of the current run of a workspace
+type WorkspaceCurrentRun struct {
+	ID        string    `json:"id"`
+	Status    string    `json:"status"`
+	CreatedAt time.Time `json:"created_at"`
 }
 
 // WorkspaceSummaryList contains the list of workspace summaries and pagination details
 type WorkspaceSummaryList struct {
 	Items []*WorkspaceSummary `json:"items"`
+
+	// CurrentRunStatuses counts the workspaces of this page per current run status,
+	// it is only set when include_current_run is requested
+	CurrentRunStatuses map[string]int `json:"current_run_statuses,omitempty"`
 	*tfe.Pagination
 }
+
+func newWorkspaceSummaryList(workspaces *tfe.WorkspaceList, includeCurrentRun bool) *WorkspaceSummaryList {
+	list := &WorkspaceSummaryList{
+		Items:      make([]*WorkspaceSummary, len(workspaces.Items)),
+		Pagination: workspaces.Pagination,
+	}
+	if includeCurrentRun {
+		list.CurrentRunStatuses = make(map[string]int)
+	}
+
+	for i, w := range workspaces.Items {
+		list.Items[i] = &WorkspaceSummary{
+			ID:            w.ID,
+			Name:          w.Name,
+			Description:   w.Description,
+			Environment:   w.Environment,
+			CreatedAt:     w.CreatedAt,
+			ExecutionMode: w.ExecutionMode,
+		}
+		if !includeCurrentRun {
+			continue
+		}
+		if w.CurrentRun == nil {
+			list.CurrentRunStatuses["none"]++
+			continue
+		}
+		list.Items[i].CurrentRun = &WorkspaceCurrentRun{
+			ID:        w.CurrentRun.ID,
+			Status:    string(w.CurrentRun.Status),
+			CreatedAt: w.CurrentRun.CreatedAt,
+		}
+		list.CurrentRunStatuses[string(w.CurrentRun.Status)]++
+	}
+	return list
+}
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/go-tfe"
 	log "github.com/sirupsen/logrus"
@@ -65,6 +66,27 @@ func TestSearchWorkspaces(t *testing.T) {
 
 		// Check that terraform_org_name is in required parameters
 		assert.Contains(t, tool.Tool.InputSchema.Required, "terraform_org_name")
+		assert.NotContains(t, tool.Tool.InputSchema.Required, "include_current_run")
+	})
+
+	t.Run("current run status", func(t *testing.T) {
+		createdAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+		workspaces := &tfe.WorkspaceList{
+			Items: []*tfe.Workspace{
+				{ID: "ws-1", Name: "one", CurrentRun: &tfe.Run{ID: "run-1", Status: tfe.RunApplied, CreatedAt: createdAt}},
+				{ID: "ws-2", Name: "two", CurrentRun: &tfe.Run{ID: "run-2", Status: tfe.RunErrored, CreatedAt: createdAt}},
+				{ID: "ws-3", Name: "three"},
+			},
+		}
+
+		list := newWorkspaceSummaryList(workspaces, false)
+		assert.Nil(t, list.Items[0].CurrentRun)
+		assert.Nil(t, list.CurrentRunStatuses)
+
+		list = newWorkspaceSummaryList(workspaces, true)
+		assert.Equal(t, &WorkspaceCurrentRun{ID: "run-1", Status: "applied", CreatedAt: createdAt}, list.Items[0].CurrentRun)
+		assert.Nil(t, list.Items[2].CurrentRun)
+		assert.Equal(t, map[string]int{"applied": 1, "errored": 1, "none": 1}, list.CurrentRunStatuses)
 	})
 
 	t.Run("successful workspace search", func(t *testing.T) {
@@ -0,0 +1,285 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// Holders of a workspace lock
+const (
+	LockHolderRun     = "run"
+	LockHolderUser    = "user"
+	LockHolderTeam    = "team"
+	LockHolderUnknown = "unknown"
+)
+
+// Sources of the time a workspace was locked at. The API doesn't report it, so it is the creation
+// of the locking run, or the last update of the workspace for the locks of users and teams.
+const (
+	LockedSinceRunCreated       = "run_created_at"
+	LockedSinceWorkspaceUpdated = "workspace_updated_at"
+)
+
+const (
+	// maxLockScanWorkspaces bounds the workspaces read to find the locked ones
+	maxLockScanWorkspaces = 10000
+	// defaultLockScanConcurrency is the number of locking runs read at the same time
+	defaultLockScanConcurrency = 5
+	maxLockScanConcurrency     = 20
+	defaultStaleLockHours      = 24
+)
+
+// LockedWorkspaces lists the locked workspaces of an organization
+type LockedWorkspaces struct {
+	Organization      string             `json:"organization"`
+	ScannedWorkspaces int                `json:"scanned_workspaces"`
+	LockedCount       int                `json:"locked_count"`
+	StaleCount        int                `json:"stale_count"`
+	Workspaces        []*LockedWorkspace `json:"workspaces"`
+	Truncated         bool               `json:"truncated,omitempty"`
+}
+
+// LockedWorkspace is a locked workspace with the holder of its lock
+type LockedWorkspace struct {
+	WorkspaceID   string `json:"workspace_id"`
+	WorkspaceName string `json:"workspace_name"`
+	ProjectID     string `json:"project_id,omitempty"`
+	LockedByType  string `json:"locked_by_type"`
+	LockedByID    string `json:"locked_by_id,omitempty"`
+	LockedByName  string `json:"locked_by_name,omitempty"`
+	// LockingRunStatus is the status of the run holding the lock
+	LockingRunStatus  string     `json:"locking_run_status,omitempty"`
+	LockedSince       *time.Time `json:"locked_since,omitempty"`
+	LockedSinceSource string     `json:"locked_since_source,omitempty"`
+	LockedFor         string     `json:"locked_for,omitempty"`
+	Stale             bool       `json:"stale"`
+	StaleReason       string     `json:"stale_reason,omitempty"`
+}
+
+// ListLockedWorkspaces creates a tool to list the locked workspaces of an organization.
+func ListLockedWorkspaces(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("list_locked_workspaces",
+			mcp.WithDescription(`Scans all the workspaces of an HCP Terraform or Terraform Enterprise organization and lists the locked ones, stale locks first: who holds each lock (a run, a user or a team), since when, and whether the lock is stale.
+A lock is stale when its run has already finished, or when it has been held longer than stale_after_hours. The API doesn't report when a workspace was locked: the creation of the locking run is used, or the last update of the workspace for the locks of users and teams, as told by locked_since_source.
+Use action_run to discard or cancel a locking run, and force_unlock_workspace, when Terraform operations are enabled, as the last resort for stuck locks.`),
+			mcp.WithTitleAnnotation("List the locked workspaces of an organization"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("terraform_org_name",
+				mcp.Required(),
+				mcp.Description("The name of the Terraform organization"),
+			),
+			mcp.WithString("project_id",
+				mcp.Description("Optional ID of a project, to only scan its workspaces"),
+			),
+			mcp.WithNumber("stale_after_hours",
+				mcp.Description("The number of hours after which a lock is considered stale"),
+				mcp.DefaultNumber(defaultStaleLockHours),
+				mcp.Min(1),
+			),
+			mcp.WithNumber("concurrency",
+				mcp.Description("The number of locking runs read at the same time"),
+				mcp.DefaultNumber(defaultLockScanConcurrency),
+				mcp.Min(1),
+				mcp.Max(maxLockScanConcurrency),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return listLockedWorkspacesHandler(ctx, request, logger)
+		},
+	}
+}
+
+func listLockedWorkspacesHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	orgName, err := request.RequireString("terraform_org_name")
+	if err != nil {
+		return ToolError(logger, "missing required input: terraform_org_name", err)
+	}
+	orgName = strings.TrimSpace(orgName)
+	projectID := strings.TrimSpace(request.GetString("project_id", ""))
+	staleAfterHours := request.GetFloat("stale_after_hours", defaultStaleLockHours)
+	if staleAfterHours <= 0 {
+		return ToolErrorf(logger, "stale_after_hours must be positive, got %v", staleAfterHours)
+	}
+	concurrency := request.GetInt("concurrency", defaultLockScanConcurrency)
+	if concurrency < 1 || concurrency > maxLockScanConcurrency {
+		return ToolErrorf(logger, "concurrency must be between 1 and %d, got %d", maxLockScanConcurrency, concurrency)
+	}
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+
+	options := &tfe.WorkspaceListOptions{
+		ListOptions: tfe.ListOptions{PageNumber: 1, PageSize: 100},
+		ProjectID:   projectID,
+		Include:     []tfe.WSIncludeOpt{tfe.WSLockedBy},
+	}
+	result := &LockedWorkspaces{Organization: orgName, Workspaces: []*LockedWorkspace{}}
+	var locked []*tfe.Workspace
+	for {
+		page, err := tfeClient.Workspaces.List(ctx, orgName, options)
+		if err != nil {
+			return ToolErrorf(logger, "failed to list workspaces in org '%s': %v", orgName, err)
+		}
+		result.ScannedWorkspaces += len(page.Items)
+		for _, workspace := range page.Items {
+			if workspace != nil && workspace.Locked {
+				locked = append(locked, workspace)
+			}
+		}
+		if page.Pagination == nil || page.NextPage == 0 {
+			break
+		}
+		if result.ScannedWorkspaces >= maxLockScanWorkspaces {
+			result.Truncated = true
+			break
+		}
+		options.PageNumber = page.NextPage
+	}
+
+	runs := readLockingRuns(ctx, locked, concurrency, func(ctx context.Context, runID string) (*tfe.Run, error) {
+		return tfeClient.Runs.Read(ctx, runID)
+	}, logger)
+
+	staleAfter := time.Duration(staleAfterHours * float64(time.Hour))
+	now := time.Now()
+	for _, workspace := range locked {
+		result.Workspaces = append(result.Workspaces, lockedWorkspace(workspace, runs[workspace.ID], now, staleAfter))
+	}
+	sortLockedWorkspaces(result.Workspaces)
+	result.LockedCount = len(result.Workspaces)
+	for _, workspace := range result.Workspaces {
+		if workspace.Stale {
+			result.StaleCount++
+		}
+	}
+	logger.WithFields(log.Fields{
+		"organization": orgName,
+		"scanned":      result.ScannedWorkspaces,
+		"locked":       result.LockedCount,
+		"stale":        result.StaleCount,
+	}).Info("Listed locked workspaces")
+
+	buf, err := json.Marshal(result)
+	if err != nil {
+		return ToolError(logger, "failed to marshal locked workspaces", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+// readLockingRuns reads the runs holding the locks of the workspaces, at most concurrency at a
+// time, by workspace ID. Runs that can't be read are left out.
+func readLockingRuns(ctx context.Context, workspaces []*tfe.Workspace, concurrency int, readRun func(context.Context, string) (*tfe.Run, error), logger *log.Logger) map[string]*tfe.Run {
+	runs := make(map[string]*tfe.Run)
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	slots := make(chan struct{}, concurrency)
+	for _, workspace := range workspaces {
+		if workspace.LockedBy == nil || workspace.LockedBy.Run == nil || workspace.LockedBy.Run.ID == "" {
+			continue
+		}
+		wg.Add(1)
+		go func(workspaceID string, runID string) {
+			defer wg.Done()
+			slots <- struct{}{}
+			defer func() { <-slots }()
+
+			run, err := readRun(ctx, runID)
+			if err != nil {
+				logger.WithError(err).WithField("run_id", runID).Debug("Could not read the locking run")
+				return
+			}
+			mu.Lock()
+			runs[workspaceID] = run
+			mu.Unlock()
+		}(workspace.ID, workspace.LockedBy.Run.ID)
+	}
+	wg.Wait()
+	return runs
+}
+
+// lockedWorkspace describes the lock of a workspace. run is the locking run, when it was read.
+func lockedWorkspace(workspace *tfe.Workspace, run *tfe.Run, now time.Time, staleAfter time.Duration) *LockedWorkspace {
+	locked := &LockedWorkspace{
+		WorkspaceID:   workspace.ID,
+		WorkspaceName: workspace.Name,
+		LockedByType:  LockHolderUnknown,
+	}
+	if workspace.Project != nil {
+		locked.ProjectID = workspace.Project.ID
+	}
+
+	var since time.Time
+	switch lockedBy := workspace.LockedBy; {
+	case lockedBy != nil && lockedBy.Run != nil:
+		locked.LockedByType = LockHolderRun
+		locked.LockedByID = lockedBy.Run.ID
+		if run != nil {
+			locked.LockingRunStatus = string(run.Status)
+			since = run.CreatedAt
+			locked.LockedSinceSource = LockedSinceRunCreated
+		}
+	case lockedBy != nil && lockedBy.User != nil:
+		locked.LockedByType = LockHolderUser
+		locked.LockedByID = lockedBy.User.ID
+		locked.LockedByName = lockedBy.User.Username
+	case lockedBy != nil && lockedBy.Team != nil:
+		locked.LockedByType = LockHolderTeam
+		locked.LockedByID = lockedBy.Team.ID
+		locked.LockedByName = lockedBy.Team.Name
+	}
+	if since.IsZero() && locked.LockedByType != LockHolderRun && !workspace.UpdatedAt.IsZero() {
+		since = workspace.UpdatedAt
+		locked.LockedSinceSource = LockedSinceWorkspaceUpdated
+	}
+
+	if !since.IsZero() {
+		locked.LockedSince = &since
+		held := now.Sub(since)
+		locked.LockedFor = held.Round(time.Second).String()
+		if held > staleAfter {
+			locked.Stale = true
+			locked.StaleReason = fmt.Sprintf("locked for more than %s", staleAfter)
+		}
+	}
+	if run != nil && finalRunStatuses[run.Status] {
+		locked.Stale = true
+		locked.StaleReason = fmt.Sprintf("the locking run %s has already finished (%s)", run.ID, run.Status)
+	}
+	return locked
+}
+
+// sortLockedWorkspaces orders the stale locks first, then the oldest locks, then by workspace name
+func sortLockedWorkspaces(workspaces []*LockedWorkspace) {
+	sort.SliceStable(workspaces, func(i, j int) bool {
+		a, b := workspaces[i], workspaces[j]
+		if a.Stale != b.Stale {
+			return a.Stale
+		}
+		if (a.LockedSince == nil) != (b.LockedSince == nil) {
+			return a.LockedSince != nil
+		}
+		if a.LockedSince != nil && !a.LockedSince.Equal(*b.LockedSince) {
+			return a.LockedSince.Before(*b.LockedSince)
+		}
+		return a.WorkspaceName < b.WorkspaceName
+	})
+}
@@ -0,0 +1,117 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-tfe"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLockedWorkspace(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	staleAfter := 24 * time.Hour
+
+	t.Run("run locks are dated by the run", func(t *testing.T) {
+		workspace := &tfe.Workspace{ID: "ws-1", Name: "app", Locked: true, LockedBy: &tfe.LockedByChoice{Run: &tfe.Run{ID: "run-1"}}}
+		run := &tfe.Run{ID: "run-1", Status: tfe.RunPlanned, CreatedAt: now.Add(-2 * time.Hour)}
+
+		locked := lockedWorkspace(workspace, run, now, staleAfter)
+		assert.Equal(t, LockHolderRun, locked.LockedByType)
+		assert.Equal(t, "run-1", locked.LockedByID)
+		assert.Equal(t, "planned", locked.LockingRunStatus)
+		assert.Equal(t, LockedSinceRunCreated, locked.LockedSinceSource)
+		assert.Equal(t, "2h0m0s", locked.LockedFor)
+		assert.False(t, locked.Stale)
+	})
+
+	t.Run("locks of finished runs are stale", func(t *testing.T) {
+		workspace := &tfe.Workspace{ID: "ws-1", Locked: true, LockedBy: &tfe.LockedByChoice{Run: &tfe.Run{ID: "run-1"}}}
+		run := &tfe.Run{ID: "run-1", Status: tfe.RunErrored, CreatedAt: now.Add(-time.Hour)}
+
+		locked := lockedWorkspace(workspace, run, now, staleAfter)
+		assert.True(t, locked.Stale)
+		assert.Contains(t, locked.StaleReason, "already finished")
+	})
+
+	t.Run("user locks held too long are stale", func(t *testing.T) {
+		workspace := &tfe.Workspace{
+			ID:        "ws-2",
+			Locked:    true,
+			UpdatedAt: now.Add(-48 * time.Hour),
+			LockedBy:  &tfe.LockedByChoice{User: &tfe.User{ID: "user-1", Username: "jdoe"}},
+			Project:   &tfe.Project{ID: "prj-1"},
+		}
+
+		locked := lockedWorkspace(workspace, nil, now, staleAfter)
+		assert.Equal(t, LockHolderUser, locked.LockedByType)
+		assert.Equal(t, "jdoe", locked.LockedByName)
+		assert.Equal(t, "prj-1", locked.ProjectID)
+		assert.Equal(t, LockedSinceWorkspaceUpdated, locked.LockedSinceSource)
+		assert.True(t, locked.Stale)
+	})
+
+	t.Run("unread runs are not dated", func(t *testing.T) {
+		workspace := &tfe.Workspace{ID: "ws-3", Locked: true, UpdatedAt: now, LockedBy: &tfe.LockedByChoice{Run: &tfe.Run{ID: "run-3"}}}
+
+		locked := lockedWorkspace(workspace, nil, now, staleAfter)
+		assert.Nil(t, locked.LockedSince)
+		assert.False(t, locked.Stale)
+	})
+}
+
+func TestSortLockedWorkspaces(t *testing.T) {
+	older := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	newer := older.Add(time.Hour)
+	workspaces := []*LockedWorkspace{
+		{WorkspaceName: "undated"},
+		{WorkspaceName: "newer", LockedSince: &newer},
+		{WorkspaceName: "older", LockedSince: &older},
+		{WorkspaceName: "stale", LockedSince: &newer, Stale: true},
+	}
+
+	sortLockedWorkspaces(workspaces)
+	names := make([]string, 0, len(workspaces))
+	for _, workspace := range workspaces {
+		names = append(names, workspace.WorkspaceName)
+	}
+	assert.Equal(t, []string{"stale", "older", "newer", "undated"}, names)
+}
+
+func TestReadLockingRuns(t *testing.T) {
+	logger := log.New()
+	logger.SetOutput(io.Discard)
+	workspaces := []*tfe.Workspace{
+		{ID: "ws-1", LockedBy: &tfe.LockedByChoice{Run: &tfe.Run{ID: "run-1"}}},
+		{ID: "ws-2", LockedBy: &tfe.LockedByChoice{Run: &tfe.Run{ID: "run-2"}}},
+		{ID: "ws-3", LockedBy: &tfe.LockedByChoice{User: &tfe.User{ID: "user-1"}}},
+		{ID: "ws-4", LockedBy: &tfe.LockedByChoice{Run: &tfe.Run{ID: "run-missing"}}},
+	}
+
+	var inFlight, maxInFlight atomic.Int32
+	runs := readLockingRuns(context.Background(), workspaces, 1, func(ctx context.Context, runID string) (*tfe.Run, error) {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		if current > maxInFlight.Load() {
+			maxInFlight.Store(current)
+		}
+		if runID == "run-missing" {
+			return nil, errors.New("not found")
+		}
+		return &tfe.Run{ID: runID}, nil
+	}, logger)
+
+	require.Len(t, runs, 2)
+	assert.Equal(t, "run-1", runs["ws-1"].ID)
+	assert.Equal(t, "run-2", runs["ws-2"].ID)
+	assert.Equal(t, int32(1), maxInFlight.Load())
+}
@@ -0,0 +1,236 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// ListWorkspaceNotificationConfigurations creates a tool to list the notification configurations of a workspace.
+func ListWorkspaceNotificationConfigurations(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("list_workspace_notification_configurations",
+			mcp.WithDescription(`Lists the notification configurations of a workspace: their ID, name, destination type (slack, generic webhook, email or microsoft-teams), whether they are enabled, and the run and assessment events that trigger them. Slack and Microsoft Teams webhook URLs are only shown up to their host.`),
+			mcp.WithTitleAnnotation("List the notification configurations of a workspace"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("terraform_org_name", mcp.Required(), mcp.Description("Organization name")),
+			mcp.WithString("workspace_name", mcp.Required(), mcp.Description("Workspace name")),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			orgName, err := request.RequireString("terraform_org_name")
+			if err != nil {
+				return ToolError(logger, "missing required input: terraform_org_name", err)
+			}
+			workspaceName, err := request.RequireString("workspace_name")
+			if err != nil {
+				return ToolError(logger, "missing required input: workspace_name", err)
+			}
+
+			tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+			if err != nil {
+				return ToolError(logger, "failed to get Terraform client", err)
+			}
+
+			workspace, err := tfeClient.Workspaces.Read(ctx, orgName, workspaceName)
+			if err != nil {
+				return ToolErrorf(logger, "workspace '%s' not found in org '%s'", workspaceName, orgName)
+			}
+
+			configurations, err := client.ListNotificationConfigurations(ctx, tfeClient, workspace.ID)
+			if err != nil {
+				return ToolErrorf(logger, "failed to list notification configurations of workspace '%s': %v", workspaceName, err)
+			}
+
+			buf, err := json.Marshal(configurations)
+			if err != nil {
+				return ToolError(logger, "failed to marshal notification configurations", err)
+			}
+			return mcp.NewToolResultText(string(buf)), nil
+		},
+	}
+}
+
+// CreateWorkspaceNotificationConfiguration creates a tool to add a notification configuration to a workspace.
+func CreateWorkspaceNotificationConfiguration(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("create_workspace_notification_configuration",
+			mcp.WithDescription(`Creates a notification configuration on a workspace, to send alerts to Slack, Microsoft Teams, a generic webhook or by email when runs or health assessments reach the given triggers. Slack, Microsoft Teams and generic webhooks need a url; email notifications need email_addresses (Terraform Enterprise only) or email_user_ids. HCP Terraform sends a verification request when an enabled configuration is created.`),
+			mcp.WithTitleAnnotation("Create a workspace notification configuration"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(false),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("terraform_org_name", mcp.Required(), mcp.Description("Organization name")),
+			mcp.WithString("workspace_name", mcp.Required(), mcp.Description("Workspace name")),
+			mcp.WithString("name", mcp.Required(), mcp.Description("Name of the notification configuration")),
+			mcp.WithString("destination_type",
+				mcp.Required(),
+				mcp.Description("Where notifications are sent; 'generic' is a webhook receiving a JSON payload"),
+				mcp.Enum(client.NotificationDestinationTypes...),
+			),
+			withNotificationConfigurationOptions(),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			orgName, err := request.RequireString("terraform_org_name")
+			if err != nil {
+				return ToolError(logger, "missing required input: terraform_org_name", err)
+			}
+			workspaceName, err := request.RequireString("workspace_name")
+			if err != nil {
+				return ToolError(logger, "missing required input: workspace_name", err)
+			}
+			spec := notificationConfigurationSpec(request)
+			if spec.Name == "" || spec.DestinationType == "" {
+				return ToolError(logger, "missing required input: name and destination_type", nil)
+			}
+
+			tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+			if err != nil {
+				return ToolError(logger, "failed to get Terraform client", err)
+			}
+
+			workspace, err := tfeClient.Workspaces.Read(ctx, orgName, workspaceName)
+			if err != nil {
+				return ToolErrorf(logger, "workspace '%s' not found in org '%s'", workspaceName, orgName)
+			}
+
+			configuration, err := client.CreateNotificationConfiguration(ctx, tfeClient, workspace.ID, spec)
+			if err != nil {
+				return ToolErrorf(logger, "failed to create notification configuration '%s' on workspace '%s': %v", spec.Name, workspaceName, err)
+			}
+
+			buf, err := json.Marshal(configuration)
+			if err != nil {
+				return ToolError(logger, "failed to marshal notification configuration", err)
+			}
+			return mcp.NewToolResultText(string(buf)), nil
+		},
+	}
+}
+
+// UpdateWorkspaceNotificationConfiguration creates a tool to update a notification configuration.
+func UpdateWorkspaceNotificationConfiguration(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("update_workspace_notification_configuration",
+			mcp.WithDescription(`Updates a notification configuration, for example to enable or disable it or change its triggers. Only the given fields are changed; the destination type cannot be changed. Use list_workspace_notification_configurations to find the notification_configuration_id.`),
+			mcp.WithTitleAnnotation("Update a workspace notification configuration"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(false),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("notification_configuration_id", mcp.Required(), mcp.Description("Notification configuration ID to update, e.g. 'nc-abc123'")),
+			mcp.WithString("name", mcp.Description("New name of the notification configuration")),
+			withNotificationConfigurationOptions(),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			notificationConfigurationID, err := request.RequireString("notification_configuration_id")
+			if err != nil {
+				return ToolError(logger, "missing required input: notification_configuration_id", err)
+			}
+
+			tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+			if err != nil {
+				return ToolError(logger, "failed to get Terraform client", err)
+			}
+
+			configuration, err := client.UpdateNotificationConfiguration(ctx, tfeClient, notificationConfigurationID, notificationConfigurationSpec(request))
+			if err != nil {
+				return ToolErrorf(logger, "failed to update notification configuration %s: %v", notificationConfigurationID, err)
+			}
+
+			buf, err := json.Marshal(configuration)
+			if err != nil {
+				return ToolError(logger, "failed to marshal notification configuration", err)
+			}
+			return mcp.NewToolResultText(string(buf)), nil
+		},
+	}
+}
+
+// DeleteWorkspaceNotificationConfiguration creates a tool to delete a notification configuration.
+func DeleteWorkspaceNotificationConfiguration(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("delete_workspace_notification_configuration",
+			mcp.WithDescription(`Deletes a notification configuration, so that its destination no longer receives alerts for the workspace. To pause notifications instead, disable the configuration with update_workspace_notification_configuration.`),
+			mcp.WithTitleAnnotation("Delete a workspace notification configuration"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(false),
+			mcp.WithDestructiveHintAnnotation(true),
+			mcp.WithString("notification_configuration_id", mcp.Required(), mcp.Description("Notification configuration ID to delete")),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			notificationConfigurationID, err := request.RequireString("notification_configuration_id")
+			if err != nil {
+				return ToolError(logger, "missing required input: notification_configuration_id", err)
+			}
+
+			tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+			if err != nil {
+				return ToolError(logger, "failed to get Terraform client", err)
+			}
+
+			if err := client.DeleteNotificationConfiguration(ctx, tfeClient, notificationConfigurationID); err != nil {
+				return ToolErrorf(logger, "failed to delete notification configuration %s: %v", notificationConfigurationID, err)
+			}
+			return mcp.NewToolResultText(fmt.Sprintf("Notification configuration %s deleted", notificationConfigurationID)), nil
+		},
+	}
+}
+
+// withNotificationConfigurationOptions adds the parameters shared by the create and update tools
+func withNotificationConfigurationOptions() mcp.ToolOption {
+	return func(t *mcp.Tool) {
+		for _, option := range []mcp.ToolOption{
+			mcp.WithString("url",
+				mcp.Description("Webhook URL of slack, microsoft-teams and generic notifications"),
+			),
+			mcp.WithString("token",
+				mcp.Description("Optional secret of generic webhooks, used to sign the payload in the X-TFE-Notification-Signature header"),
+			),
+			mcp.WithArray("triggers",
+				mcp.Description("Events that send a notification. With no triggers, only verification requests are sent"),
+				mcp.WithStringEnumItems(client.NotificationTriggers),
+			),
+			mcp.WithArray("email_addresses",
+				mcp.Description("Email addresses to notify, for email notifications on Terraform Enterprise"),
+				mcp.WithStringItems(),
+			),
+			mcp.WithArray("email_user_ids",
+				mcp.Description("IDs of organization members to notify, for email notifications (e.g. 'user-abc123')"),
+				mcp.WithStringItems(),
+			),
+			mcp.WithBoolean("enabled",
+				mcp.Description("Whether notifications are sent. New configurations are enabled by default"),
+			),
+		} {
+			option(t)
+		}
+	}
+}
+
+// notificationConfigurationSpec reads the notification configuration parameters of a request
+func notificationConfigurationSpec(request mcp.CallToolRequest) client.NotificationConfigurationSpec {
+	spec := client.NotificationConfigurationSpec{
+		Name:            strings.TrimSpace(request.GetString("name", "")),
+		DestinationType: request.GetString("destination_type", ""),
+		URL:             strings.TrimSpace(request.GetString("url", "")),
+		Token:           request.GetString("token", ""),
+		Triggers:        request.GetStringSlice("triggers", nil),
+		EmailAddresses:  request.GetStringSlice("email_addresses", nil),
+		EmailUserIDs:    request.GetStringSlice("email_user_ids", nil),
+	}
+	if _, ok := request.GetArguments()["enabled"]; ok {
+		enabled := request.GetBool("enabled", true)
+		spec.Enabled = &enabled
+	}
+	return spec
+}
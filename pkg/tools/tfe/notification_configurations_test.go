@@ -0,0 +1,57 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotificationConfigurationTools(t *testing.T) {
+	logger := log.New()
+	logger.SetLevel(log.ErrorLevel)
+
+	t.Run("tool creation", func(t *testing.T) {
+		list := ListWorkspaceNotificationConfigurations(logger)
+		assert.Equal(t, "list_workspace_notification_configurations", list.Tool.Name)
+		assert.True(t, *list.Tool.Annotations.ReadOnlyHint)
+
+		create := CreateWorkspaceNotificationConfiguration(logger)
+		assert.Equal(t, "create_workspace_notification_configuration", create.Tool.Name)
+		assert.ElementsMatch(t, []string{"terraform_org_name", "workspace_name", "name", "destination_type"}, create.Tool.InputSchema.Required)
+		assert.Contains(t, create.Tool.InputSchema.Properties, "triggers")
+
+		update := UpdateWorkspaceNotificationConfiguration(logger)
+		assert.Equal(t, "update_workspace_notification_configuration", update.Tool.Name)
+		assert.Equal(t, []string{"notification_configuration_id"}, update.Tool.InputSchema.Required)
+
+		del := DeleteWorkspaceNotificationConfiguration(logger)
+		assert.Equal(t, "delete_workspace_notification_configuration", del.Tool.Name)
+		assert.True(t, *del.Tool.Annotations.DestructiveHint)
+	})
+
+	t.Run("spec from request", func(t *testing.T) {
+		request := mcp.CallToolRequest{}
+		request.Params.Arguments = map[string]any{
+			"name":             " alerts ",
+			"destination_type": "generic",
+			"url":              "https://example.com/hook",
+			"triggers":         []any{"run:errored", "run:needs_attention"},
+			"enabled":          false,
+		}
+
+		spec := notificationConfigurationSpec(request)
+		assert.Equal(t, "alerts", spec.Name)
+		assert.Equal(t, []string{"run:errored", "run:needs_attention"}, spec.Triggers)
+		require.NotNil(t, spec.Enabled)
+		assert.False(t, *spec.Enabled)
+
+		request.Params.Arguments = map[string]any{"name": "alerts"}
+		assert.Nil(t, notificationConfigurationSpec(request).Enabled)
+	})
+}
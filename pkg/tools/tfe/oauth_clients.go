@@ -0,0 +1,237 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// OAuthClientSummary is a VCS provider connection of an organization. Its key and secret are left out.
+type OAuthClientSummary struct {
+	ID                  string   `json:"id"`
+	Name                string   `json:"name,omitempty"`
+	ServiceProvider     string   `json:"service_provider"`
+	ServiceProviderName string   `json:"service_provider_name,omitempty"`
+	HTTPURL             string   `json:"http_url,omitempty"`
+	OrganizationScoped  *bool    `json:"organization_scoped,omitempty"`
+	ProjectIDs          []string `json:"project_ids,omitempty"`
+	OAuthTokenIDs       []string `json:"oauth_token_ids"`
+}
+
+// OAuthTokenSummary is the OAuth token of a VCS provider connection, whose ID is the
+// oauth_token_id of the vcs_repo of workspaces
+type OAuthTokenSummary struct {
+	OAuthTokenID        string     `json:"oauth_token_id"`
+	OAuthClientID       string     `json:"oauth_client_id"`
+	OAuthClientName     string     `json:"oauth_client_name,omitempty"`
+	ServiceProvider     string     `json:"service_provider"`
+	ServiceProviderUser string     `json:"service_provider_user,omitempty"`
+	HasSSHKey           bool       `json:"has_ssh_key"`
+	CreatedAt           *time.Time `json:"created_at,omitempty"`
+}
+
+// ListOAuthClients creates a tool to list the VCS provider connections of an organization.
+func ListOAuthClients(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("list_oauth_clients",
+			mcp.WithDescription(`Lists the OAuth clients of an organization, its connections to VCS providers such as GitHub, GitLab, Bitbucket or Azure DevOps, with their service provider, URL, the projects they are limited to and the IDs of their OAuth tokens. Use list_oauth_tokens to find the oauth_token_id to connect a workspace to a repository.`),
+			mcp.WithTitleAnnotation("List the VCS provider connections of an organization"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("terraform_org_name",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return listOAuthClientsHandler(ctx, request, logger)
+		},
+	}
+}
+
+// ListOAuthTokens creates a tool to list the OAuth tokens of the VCS provider connections of an organization.
+func ListOAuthTokens(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("list_oauth_tokens",
+			mcp.WithDescription(`Lists the OAuth tokens of the VCS provider connections of an organization with their OAuth client, service provider and the VCS user they act as. The oauth_token_id of a token is the vcs_repo_oauth_token_id that connects a workspace created with create_workspace to a repository of that provider.`),
+			mcp.WithTitleAnnotation("List the VCS OAuth tokens of an organization"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("terraform_org_name",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+			mcp.WithString("oauth_client",
+				mcp.Description("Optional ID (e.g. 'oc-abc123') or name of an OAuth client, to only list its tokens"),
+			),
+			mcp.WithString("service_provider",
+				mcp.Description("Optional service provider, to only list its tokens, e.g. 'github', 'gitlab_hosted' or 'ado_services'"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return listOAuthTokensHandler(ctx, request, logger)
+		},
+	}
+}
+
+func listOAuthClientsHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	orgName, err := request.RequireString("terraform_org_name")
+	if err != nil {
+		return ToolError(logger, "missing required input: terraform_org_name", err)
+	}
+	orgName = strings.TrimSpace(orgName)
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+
+	oauthClients, err := listOAuthClients(ctx, tfeClient, orgName)
+	if err != nil {
+		return ToolErrorf(logger, "failed to list OAuth clients of org '%s': %v", orgName, err)
+	}
+
+	summaries := make([]OAuthClientSummary, 0, len(oauthClients))
+	for _, oauthClient := range oauthClients {
+		summaries = append(summaries, oauthClientSummary(oauthClient))
+	}
+	buf, err := json.Marshal(summaries)
+	if err != nil {
+		return ToolError(logger, "failed to marshal OAuth clients", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+func listOAuthTokensHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	orgName, err := request.RequireString("terraform_org_name")
+	if err != nil {
+		return ToolError(logger, "missing required input: terraform_org_name", err)
+	}
+	orgName = strings.TrimSpace(orgName)
+	oauthClient := strings.TrimSpace(request.GetString("oauth_client", ""))
+	serviceProvider := strings.TrimSpace(request.GetString("service_provider", ""))
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+
+	oauthClients, err := listOAuthClients(ctx, tfeClient, orgName)
+	if err != nil {
+		return ToolErrorf(logger, "failed to list OAuth clients of org '%s': %v", orgName, err)
+	}
+
+	tokens := oauthTokenSummaries(oauthClients, oauthClient, serviceProvider)
+	if oauthClient != "" && len(tokens) == 0 && !hasOAuthClient(oauthClients, oauthClient) {
+		return ToolErrorf(logger, "OAuth client '%s' not found in org '%s', use list_oauth_clients to find it", oauthClient, orgName)
+	}
+	buf, err := json.Marshal(tokens)
+	if err != nil {
+		return ToolError(logger, "failed to marshal OAuth tokens", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+// listOAuthClients lists all the OAuth clients of an organization with their OAuth tokens
+func listOAuthClients(ctx context.Context, tfeClient *tfe.Client, orgName string) ([]*tfe.OAuthClient, error) {
+	var oauthClients []*tfe.OAuthClient
+	options := &tfe.OAuthClientListOptions{
+		ListOptions: tfe.ListOptions{PageNumber: 1, PageSize: 100},
+		Include:     []tfe.OAuthClientIncludeOpt{tfe.OauthClientOauthTokens},
+	}
+	for {
+		page, err := tfeClient.OAuthClients.List(ctx, orgName, options)
+		if err != nil {
+			return nil, err
+		}
+		oauthClients = append(oauthClients, page.Items...)
+		if page.Pagination == nil || page.Pagination.NextPage == 0 {
+			return oauthClients, nil
+		}
+		options.PageNumber = page.Pagination.NextPage
+	}
+}
+
+func oauthClientSummary(oauthClient *tfe.OAuthClient) OAuthClientSummary {
+	summary := OAuthClientSummary{
+		ID:                  oauthClient.ID,
+		ServiceProvider:     string(oauthClient.ServiceProvider),
+		ServiceProviderName: oauthClient.ServiceProviderName,
+		HTTPURL:             oauthClient.HTTPURL,
+		OrganizationScoped:  oauthClient.OrganizationScoped,
+		OAuthTokenIDs:       []string{},
+	}
+	if oauthClient.Name != nil {
+		summary.Name = *oauthClient.Name
+	}
+	for _, project := range oauthClient.Projects {
+		if project != nil {
+			summary.ProjectIDs = append(summary.ProjectIDs, project.ID)
+		}
+	}
+	for _, token := range oauthClient.OAuthTokens {
+		if token != nil {
+			summary.OAuthTokenIDs = append(summary.OAuthTokenIDs, token.ID)
+		}
+	}
+	return summary
+}
+
+// oauthTokenSummaries flattens the OAuth tokens of OAuth clients, keeping the tokens of the
+// OAuth client given by ID or name and of the service provider, when given
+func oauthTokenSummaries(oauthClients []*tfe.OAuthClient, oauthClient string, serviceProvider string) []OAuthTokenSummary {
+	tokens := []OAuthTokenSummary{}
+	for _, c := range oauthClients {
+		if c == nil {
+			continue
+		}
+		summary := oauthClientSummary(c)
+		if oauthClient != "" && summary.ID != oauthClient && summary.Name != oauthClient {
+			continue
+		}
+		if serviceProvider != "" && !strings.EqualFold(summary.ServiceProvider, serviceProvider) {
+			continue
+		}
+		for _, token := range c.OAuthTokens {
+			if token == nil {
+				continue
+			}
+			tokenSummary := OAuthTokenSummary{
+				OAuthTokenID:        token.ID,
+				OAuthClientID:       summary.ID,
+				OAuthClientName:     summary.Name,
+				ServiceProvider:     summary.ServiceProvider,
+				ServiceProviderUser: token.ServiceProviderUser,
+				HasSSHKey:           token.HasSSHKey,
+			}
+			if !token.CreatedAt.IsZero() {
+				createdAt := token.CreatedAt
+				tokenSummary.CreatedAt = &createdAt
+			}
+			tokens = append(tokens, tokenSummary)
+		}
+	}
+	return tokens
+}
+
+func hasOAuthClient(oauthClients []*tfe.OAuthClient, oauthClient string) bool {
+	for _, c := range oauthClients {
+		if c != nil && (c.ID == oauthClient || (c.Name != nil && *c.Name == oauthClient)) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,87 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-tfe"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOAuthClientTools(t *testing.T) {
+	logger := log.New()
+	logger.SetLevel(log.ErrorLevel)
+
+	t.Run("tool creation", func(t *testing.T) {
+		clients := ListOAuthClients(logger)
+		assert.Equal(t, "list_oauth_clients", clients.Tool.Name)
+		assert.True(t, *clients.Tool.Annotations.ReadOnlyHint)
+		assert.ElementsMatch(t, []string{"terraform_org_name"}, clients.Tool.InputSchema.Required)
+
+		tokens := ListOAuthTokens(logger)
+		assert.Equal(t, "list_oauth_tokens", tokens.Tool.Name)
+		assert.True(t, *tokens.Tool.Annotations.ReadOnlyHint)
+		assert.Contains(t, tokens.Tool.InputSchema.Properties, "oauth_client")
+		assert.Contains(t, tokens.Tool.InputSchema.Properties, "service_provider")
+	})
+
+	createdAt := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+	github := "GitHub"
+	scoped := false
+	oauthClients := []*tfe.OAuthClient{
+		{
+			ID:                 "oc-github",
+			Name:               &github,
+			ServiceProvider:    tfe.ServiceProviderGithub,
+			HTTPURL:            "https://github.com",
+			OrganizationScoped: &scoped,
+			Projects:           []*tfe.Project{{ID: "prj-1"}},
+			OAuthTokens:        []*tfe.OAuthToken{{ID: "ot-github", ServiceProviderUser: "ci-bot", CreatedAt: createdAt}},
+		},
+		{
+			ID:              "oc-gitlab",
+			ServiceProvider: tfe.ServiceProviderGitlab,
+			OAuthTokens:     []*tfe.OAuthToken{{ID: "ot-gitlab", HasSSHKey: true}},
+		},
+		{ID: "oc-pending", ServiceProvider: tfe.ServiceProviderBitbucket},
+	}
+
+	t.Run("client summary", func(t *testing.T) {
+		summary := oauthClientSummary(oauthClients[0])
+		assert.Equal(t, "GitHub", summary.Name)
+		assert.Equal(t, "github", summary.ServiceProvider)
+		assert.Equal(t, []string{"prj-1"}, summary.ProjectIDs)
+		assert.Equal(t, []string{"ot-github"}, summary.OAuthTokenIDs)
+		assert.Equal(t, []string{}, oauthClientSummary(oauthClients[2]).OAuthTokenIDs)
+	})
+
+	t.Run("token summaries", func(t *testing.T) {
+		tokens := oauthTokenSummaries(oauthClients, "", "")
+		assert.Len(t, tokens, 2)
+		assert.Equal(t, OAuthTokenSummary{
+			OAuthTokenID:        "ot-github",
+			OAuthClientID:       "oc-github",
+			OAuthClientName:     "GitHub",
+			ServiceProvider:     "github",
+			ServiceProviderUser: "ci-bot",
+			CreatedAt:           &createdAt,
+		}, tokens[0])
+		assert.True(t, tokens[1].HasSSHKey)
+		assert.Nil(t, tokens[1].CreatedAt)
+
+		assert.Len(t, oauthTokenSummaries(oauthClients, "GitHub", ""), 1)
+		assert.Len(t, oauthTokenSummaries(oauthClients, "oc-gitlab", ""), 1)
+		assert.Equal(t, "ot-gitlab", oauthTokenSummaries(oauthClients, "", "GITLAB_HOSTED")[0].OAuthTokenID)
+		assert.Empty(t, oauthTokenSummaries(oauthClients, "oc-pending", ""))
+	})
+
+	t.Run("client lookup", func(t *testing.T) {
+		assert.True(t, hasOAuthClient(oauthClients, "GitHub"))
+		assert.True(t, hasOAuthClient(oauthClients, "oc-pending"))
+		assert.False(t, hasOAuthClient(oauthClients, "oc-missing"))
+	})
+}
@@ -0,0 +1,69 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	log "github.com/sirupsen/logrus"
+)
+
+// maxOnBehalfOfLength bounds the requester identity recorded in run messages and comments
+const maxOnBehalfOfLength = 256
+
+// withOnBehalfOf adds the "on_behalf_of" parameter to tools that create or act on runs.
+func withOnBehalfOf() mcp.ToolOption {
+	return mcp.WithString("on_behalf_of",
+		mcp.Description("Optional identity (e.g. email or username) of the person who requested this change. It is recorded in the run message or comment and in the server audit log so the requester is known even though the change is made by an agent"),
+	)
+}
+
+// onBehalfOf returns the requester identity of a request, with control characters removed
+func onBehalfOf(request mcp.CallToolRequest) string {
+	requester := strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return -1
+		}
+		return r
+	}, request.GetString("on_behalf_of", ""))
+	requester = strings.TrimSpace(requester)
+	if len(requester) > maxOnBehalfOfLength {
+		requester = strings.TrimSpace(requester[:maxOnBehalfOfLength])
+	}
+	return requester
+}
+
+// annotateRequester appends the requester identity to a run message or comment
+func annotateRequester(text string, requester string) string {
+	if requester == "" {
+		return text
+	}
+	if text == "" {
+		return fmt.Sprintf("Requested by %s", requester)
+	}
+	return fmt.Sprintf("%s (requested by %s)", text, requester)
+}
+
+// auditLog records a change made to a run in the server log
+func auditLog(logger *log.Logger, action string, requester string, fields log.Fields) {
+	logger.WithFields(fields).WithFields(log.Fields{
+		"audit":        true,
+		"action":       action,
+		"on_behalf_of": requester,
+	}).Info("Terraform run change")
+}
+
+// auditProtectedLog records a protected operation, such as scheduling the destruction of a
+// workspace, at warning level so that it stands out from other changes in the server log
+func auditProtectedLog(logger *log.Logger, action string, requester string, fields log.Fields) {
+	logger.WithFields(fields).WithFields(log.Fields{
+		"audit":        true,
+		"protected":    true,
+		"action":       action,
+		"on_behalf_of": requester,
+	}).Warn("Protected Terraform workspace change")
+}
@@ -0,0 +1,55 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	log "github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOnBehalfOf(t *testing.T) {
+	logger := log.New()
+
+	t.Run("run tools accept on_behalf_of", func(t *testing.T) {
+		for _, tool := range []mcp.Tool{CreateRunSafe(logger).Tool, CreateRun(logger).Tool, ActionRun(logger).Tool} {
+			assert.Contains(t, tool.InputSchema.Properties, "on_behalf_of", tool.Name)
+			assert.NotContains(t, tool.InputSchema.Required, "on_behalf_of", tool.Name)
+		}
+	})
+
+	t.Run("requester is sanitized", func(t *testing.T) {
+		request := mcp.CallToolRequest{}
+		request.Params.Arguments = map[string]any{"on_behalf_of": "  jane@example.com\n"}
+		assert.Equal(t, "jane@example.com", onBehalfOf(request))
+
+		request.Params.Arguments = map[string]any{"on_behalf_of": strings.Repeat("a", 300)}
+		assert.Len(t, onBehalfOf(request), maxOnBehalfOfLength)
+
+		request.Params.Arguments = map[string]any{}
+		assert.Empty(t, onBehalfOf(request))
+	})
+
+	t.Run("requester annotates messages", func(t *testing.T) {
+		assert.Equal(t, "Deploy", annotateRequester("Deploy", ""))
+		assert.Equal(t, "Deploy (requested by jane@example.com)", annotateRequester("Deploy", "jane@example.com"))
+		assert.Equal(t, "Requested by jane@example.com", annotateRequester("", "jane@example.com"))
+	})
+
+	t.Run("audit log entry", func(t *testing.T) {
+		auditLogger, hook := test.NewNullLogger()
+		auditLog(auditLogger, "apply_run", "jane@example.com", log.Fields{"run_id": "run-123"})
+
+		entry := hook.LastEntry()
+		assert.Equal(t, log.InfoLevel, entry.Level)
+		assert.Equal(t, true, entry.Data["audit"])
+		assert.Equal(t, "apply_run", entry.Data["action"])
+		assert.Equal(t, "jane@example.com", entry.Data["on_behalf_of"])
+		assert.Equal(t, "run-123", entry.Data["run_id"])
+	})
+}
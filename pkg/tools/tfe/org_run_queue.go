@@ -0,0 +1,261 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// Run queue stages, grouping the statuses of the runs that haven't finished
+const (
+	// RunQueueStagePending is a run waiting for the runs ahead of it in its workspace
+	RunQueueStagePending = "pending"
+	// RunQueueStageQueued is a run waiting for a free run slot or agent
+	RunQueueStageQueued   = "queued"
+	RunQueueStagePlanning = "planning"
+	// RunQueueStageAwaitingDecision is a run waiting for a user to confirm, discard or override it
+	RunQueueStageAwaitingDecision = "awaiting_decision"
+	RunQueueStageApplying         = "applying"
+)
+
+var runQueueStages = map[tfe.RunStatus]string{
+	tfe.RunPending:                  RunQueueStagePending,
+	tfe.RunQueuing:                  RunQueueStageQueued,
+	tfe.RunPlanQueued:               RunQueueStageQueued,
+	tfe.RunApplyQueued:              RunQueueStageQueued,
+	tfe.RunQueuingApply:             RunQueueStageQueued,
+	tfe.RunFetching:                 RunQueueStagePlanning,
+	tfe.RunFetchingCompleted:        RunQueueStagePlanning,
+	tfe.RunPrePlanRunning:           RunQueueStagePlanning,
+	tfe.RunPrePlanCompleted:         RunQueueStagePlanning,
+	tfe.RunPlanning:                 RunQueueStagePlanning,
+	tfe.RunCostEstimating:           RunQueueStagePlanning,
+	tfe.RunPolicyChecking:           RunQueueStagePlanning,
+	tfe.RunPostPlanRunning:          RunQueueStagePlanning,
+	tfe.RunPostPlanCompleted:        RunQueueStagePlanning,
+	tfe.RunPlanned:                  RunQueueStageAwaitingDecision,
+	tfe.RunCostEstimated:            RunQueueStageAwaitingDecision,
+	tfe.RunPolicyChecked:            RunQueueStageAwaitingDecision,
+	tfe.RunPolicyOverride:           RunQueueStageAwaitingDecision,
+	tfe.RunPostPlanAwaitingDecision: RunQueueStageAwaitingDecision,
+	tfe.RunConfirmed:                RunQueueStageApplying,
+	tfe.RunPreApplyRunning:          RunQueueStageApplying,
+	tfe.RunPreApplyCompleted:        RunQueueStageApplying,
+	tfe.RunApplying:                 RunQueueStageApplying,
+	tfe.RunPostApplyRunning:         RunQueueStageApplying,
+	tfe.RunPostApplyCompleted:       RunQueueStageApplying,
+}
+
+const (
+	// maxRunQueueRuns bounds the runs read to summarize the queue of an organization
+	maxRunQueueRuns = 1000
+	// maxReportedBlockedRuns bounds the blocked runs listed in the summary
+	maxReportedBlockedRuns = 20
+)
+
+// OrgRunQueue summarizes the runs of an organization that haven't finished
+type OrgRunQueue struct {
+	Organization string `json:"organization"`
+	// QueueDepth is the number of runs that haven't finished
+	QueueDepth       int            `json:"queue_depth"`
+	Stages           map[string]int `json:"stages"`
+	Statuses         map[string]int `json:"statuses"`
+	ActiveWorkspaces int            `json:"active_workspaces"`
+	OldestBlockedRun *QueuedRun     `json:"oldest_blocked_run,omitempty"`
+	// BlockedRuns are the runs waiting on something other than their own progress, oldest first
+	BlockedRuns []*QueuedRun `json:"blocked_runs"`
+	Truncated   bool         `json:"truncated,omitempty"`
+	Hints       []string     `json:"hints,omitempty"`
+}
+
+// QueuedRun is a run that hasn't finished, with what it is waiting for
+type QueuedRun struct {
+	ID            string    `json:"id"`
+	WorkspaceName string    `json:"workspace_name"`
+	Status        string    `json:"status"`
+	Stage         string    `json:"stage"`
+	CreatedAt     time.Time `json:"created_at"`
+	Waiting       string    `json:"waiting"`
+	BlockedBy     string    `json:"blocked_by,omitempty"`
+	Reason        string    `json:"reason,omitempty"`
+}
+
+// GetOrgRunQueue creates a tool to summarize the run queue of an organization.
+func GetOrgRunQueue(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("get_hcp_terraform_org_run_queue",
+			mcp.WithDescription(`Summarizes the runs of an HCP Terraform or Terraform Enterprise organization that haven't finished: the queue depth, the number of runs per stage (pending, queued, planning, awaiting_decision, applying) and status, and the blocked runs, oldest first, with what they are waiting for.
+Use it to answer "why are runs slow right now". Pending runs wait for the runs ahead of them in their workspace, queued runs wait for a free run slot of the organization or an agent, and runs awaiting a decision hold their workspace until a user confirms, discards or overrides them.`),
+			mcp.WithTitleAnnotation("Summarize the run queue of an organization"),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("terraform_org_name",
+				mcp.Required(),
+				mcp.Description("The name of the Terraform organization"),
+			),
+			mcp.WithArray("agent_pool_names",
+				mcp.Description("Optional agent pool names, to only summarize the runs of the workspaces using these agent pools"),
+				mcp.WithStringItems(),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return getOrgRunQueueHandler(ctx, request, logger)
+		},
+	}
+}
+
+func getOrgRunQueueHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	orgName, err := request.RequireString("terraform_org_name")
+	if err != nil {
+		return ToolError(logger, "missing required input: terraform_org_name", err)
+	}
+	orgName = strings.TrimSpace(orgName)
+	agentPoolNames := request.GetStringSlice("agent_pool_names", nil)
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+
+	options := &tfe.RunListForOrganizationOptions{
+		ListOptions:    tfe.ListOptions{PageNumber: 1, PageSize: 100},
+		StatusGroup:    "non_final",
+		AgentPoolNames: strings.Join(agentPoolNames, ","),
+		Include:        []tfe.RunIncludeOpt{tfe.RunWorkspace},
+	}
+	var runs []*tfe.Run
+	truncated := false
+	for {
+		page, err := tfeClient.Runs.ListForOrganization(ctx, orgName, options)
+		if err != nil {
+			return ToolErrorf(logger, "failed to list runs in org '%s': %v", orgName, err)
+		}
+		runs = append(runs, page.Items...)
+		if page.PaginationNextPrev == nil || page.NextPage == 0 {
+			break
+		}
+		if len(runs) >= maxRunQueueRuns {
+			truncated = true
+			break
+		}
+		options.PageNumber = page.NextPage
+	}
+
+	queue := summarizeRunQueue(orgName, runs, time.Now())
+	queue.Truncated = truncated
+	if truncated {
+		queue.Hints = append(queue.Hints, fmt.Sprintf("Only the first %d runs that haven't finished were read, the counts are lower bounds.", maxRunQueueRuns))
+	}
+
+	buf, err := json.Marshal(queue)
+	if err != nil {
+		return ToolError(logger, "failed to marshal run queue", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+// summarizeRunQueue summarizes the runs that haven't finished. Runs in a final status, which older
+// releases return as they ignore the status group filter, are skipped.
+func summarizeRunQueue(orgName string, runs []*tfe.Run, now time.Time) *OrgRunQueue {
+	queue := &OrgRunQueue{
+		Organization: orgName,
+		Stages:       map[string]int{},
+		Statuses:     map[string]int{},
+		BlockedRuns:  []*QueuedRun{},
+	}
+
+	byWorkspace := map[string][]*QueuedRun{}
+	var active []*QueuedRun
+	for _, r := range runs {
+		stage, ok := runQueueStages[r.Status]
+		if !ok {
+			continue
+		}
+		workspaceName := ""
+		if r.Workspace != nil {
+			workspaceName = r.Workspace.Name
+			if workspaceName == "" {
+				workspaceName = r.Workspace.ID
+			}
+		}
+		run := &QueuedRun{
+			ID:            r.ID,
+			WorkspaceName: workspaceName,
+			Status:        string(r.Status),
+			Stage:         stage,
+			CreatedAt:     r.CreatedAt,
+			Waiting:       now.Sub(r.CreatedAt).Round(time.Second).String(),
+		}
+		active = append(active, run)
+		byWorkspace[workspaceName] = append(byWorkspace[workspaceName], run)
+		queue.Stages[stage]++
+		queue.Statuses[run.Status]++
+	}
+	queue.QueueDepth = len(active)
+	queue.ActiveWorkspaces = len(byWorkspace)
+
+	for _, workspaceRuns := range byWorkspace {
+		sort.Slice(workspaceRuns, func(i, j int) bool { return workspaceRuns[i].CreatedAt.Before(workspaceRuns[j].CreatedAt) })
+		for i, run := range workspaceRuns {
+			switch run.Stage {
+			case RunQueueStagePending:
+				if i > 0 {
+					// Runs are processed in order, so a pending run waits for the oldest run of its workspace
+					run.BlockedBy = workspaceRuns[0].ID
+					run.Reason = fmt.Sprintf("waiting for run %s (%s) in the same workspace", workspaceRuns[0].ID, workspaceRuns[0].Status)
+				} else {
+					run.Reason = "waiting to be queued, the workspace may be locked"
+				}
+			case RunQueueStageQueued:
+				run.Reason = "waiting for a free run slot of the organization or an agent"
+			case RunQueueStageAwaitingDecision:
+				run.Reason = "waiting for a user to confirm, discard or override the run"
+			default:
+				continue
+			}
+			queue.BlockedRuns = append(queue.BlockedRuns, run)
+		}
+	}
+	sort.Slice(queue.BlockedRuns, func(i, j int) bool {
+		return queue.BlockedRuns[i].CreatedAt.Before(queue.BlockedRuns[j].CreatedAt)
+	})
+	if len(queue.BlockedRuns) > 0 {
+		queue.OldestBlockedRun = queue.BlockedRuns[0]
+	}
+	if len(queue.BlockedRuns) > maxReportedBlockedRuns {
+		queue.BlockedRuns = queue.BlockedRuns[:maxReportedBlockedRuns]
+	}
+
+	queue.Hints = runQueueHints(queue)
+	return queue
+}
+
+// runQueueHints explains what slows the runs of the queue down
+func runQueueHints(queue *OrgRunQueue) []string {
+	if queue.QueueDepth == 0 {
+		return []string{"No runs are in progress or waiting in the organization."}
+	}
+	var hints []string
+	if queued := queue.Stages[RunQueueStageQueued]; queued > 0 {
+		hints = append(hints, fmt.Sprintf("%d runs are waiting for a free run slot: the organization's run concurrency limit is reached or, for agent execution, all agents of the pool are busy. Check the agent pools with list_agent_pools.", queued))
+	}
+	if awaiting := queue.Stages[RunQueueStageAwaitingDecision]; awaiting > 0 {
+		hints = append(hints, fmt.Sprintf("%d runs are waiting for a user to confirm, discard or override them, and the runs queued behind them in the same workspaces can't start until they do.", awaiting))
+	}
+	if pending := queue.Stages[RunQueueStagePending]; pending > 0 {
+		hints = append(hints, fmt.Sprintf("%d runs are pending behind other runs of their workspace.", pending))
+	}
+	return hints
+}
@@ -0,0 +1,64 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-tfe"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetOrgRunQueue(t *testing.T) {
+	t.Run("tool creation", func(t *testing.T) {
+		tool := GetOrgRunQueue(log.New())
+		assert.Equal(t, "get_hcp_terraform_org_run_queue", tool.Tool.Name)
+		assert.True(t, *tool.Tool.Annotations.ReadOnlyHint)
+		assert.Equal(t, []string{"terraform_org_name"}, tool.Tool.InputSchema.Required)
+	})
+
+	t.Run("summary", func(t *testing.T) {
+		now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+		run := func(id, workspace string, status tfe.RunStatus, age time.Duration) *tfe.Run {
+			return &tfe.Run{ID: id, Status: status, CreatedAt: now.Add(-age), Workspace: &tfe.Workspace{Name: workspace}}
+		}
+		queue := summarizeRunQueue("my-org", []*tfe.Run{
+			run("run-planned", "network", tfe.RunPlanned, time.Hour),
+			run("run-pending", "network", tfe.RunPending, 30*time.Minute),
+			run("run-queued", "app", tfe.RunPlanQueued, 10*time.Minute),
+			run("run-applying", "db", tfe.RunApplying, 5*time.Minute),
+			run("run-applied", "db", tfe.RunApplied, 2*time.Hour),
+		}, now)
+
+		assert.Equal(t, 4, queue.QueueDepth)
+		assert.Equal(t, 3, queue.ActiveWorkspaces)
+		assert.Equal(t, map[string]int{
+			RunQueueStageAwaitingDecision: 1,
+			RunQueueStagePending:          1,
+			RunQueueStageQueued:           1,
+			RunQueueStageApplying:         1,
+		}, queue.Stages)
+		assert.Equal(t, 1, queue.Statuses["plan_queued"])
+
+		require.Len(t, queue.BlockedRuns, 3)
+		assert.Equal(t, "run-planned", queue.OldestBlockedRun.ID)
+		assert.Equal(t, "1h0m0s", queue.OldestBlockedRun.Waiting)
+		assert.Equal(t, "run-pending", queue.BlockedRuns[1].ID)
+		assert.Equal(t, "run-planned", queue.BlockedRuns[1].BlockedBy)
+		assert.Equal(t, "waiting for run run-planned (planned) in the same workspace", queue.BlockedRuns[1].Reason)
+		assert.Equal(t, "run-queued", queue.BlockedRuns[2].ID)
+		assert.Len(t, queue.Hints, 3)
+	})
+
+	t.Run("empty queue", func(t *testing.T) {
+		queue := summarizeRunQueue("my-org", nil, time.Now())
+		assert.Equal(t, 0, queue.QueueDepth)
+		assert.Nil(t, queue.OldestBlockedRun)
+		assert.Empty(t, queue.BlockedRuns)
+		assert.Equal(t, []string{"No runs are in progress or waiting in the organization."}, queue.Hints)
+	})
+}
@@ -0,0 +1,323 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// OrganizationTagSummary is an organization tag as returned by the list_organization_tags tool
+type OrganizationTagSummary struct {
+	ID             string `json:"id"`
+	Name           string `json:"name"`
+	WorkspaceCount int    `json:"workspace_count"`
+}
+
+// RetagResult reports the workspaces updated by the rename and merge tag tools
+type RetagResult struct {
+	TargetTag         string        `json:"target_tag"`
+	SourceTags        []string      `json:"source_tags"`
+	UpdatedWorkspaces []string      `json:"updated_workspaces"`
+	Failures          []RetagFailed `json:"failures,omitempty"`
+	DeletedTags       []string      `json:"deleted_tags,omitempty"`
+}
+
+// RetagFailed is a workspace that could not be retagged
+type RetagFailed struct {
+	Workspace string `json:"workspace"`
+	Error     string `json:"error"`
+}
+
+// ListOrganizationTags creates a tool to list the legacy workspace tags of an organization.
+func ListOrganizationTags(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("list_organization_tags",
+			mcp.WithDescription(`Lists the workspace tags (tag names) of an organization together with the number of workspaces using each tag. Use this to spot duplicate tags such as 'env' and 'environment' before renaming or merging them.`),
+			mcp.WithTitleAnnotation("List the workspace tags of an organization"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("terraform_org_name",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+			mcp.WithString("query",
+				mcp.Description("Optional search query to filter tags by name"),
+			),
+			utils.WithPagination(),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return listOrganizationTagsHandler(ctx, request, logger)
+		},
+	}
+}
+
+// RenameOrganizationTag creates a tool to rename a tag on every workspace of an organization.
+func RenameOrganizationTag(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("rename_organization_tag",
+			mcp.WithDescription(`Renames a workspace tag across every workspace of an organization by adding the new tag and removing the old one on each workspace. The old tag is deleted from the organization once no workspace uses it. Progress is reported per workspace.`),
+			mcp.WithTitleAnnotation("Rename a workspace tag across an organization"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(false),
+			mcp.WithDestructiveHintAnnotation(true),
+			mcp.WithString("terraform_org_name",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+			mcp.WithString("tag",
+				mcp.Required(),
+				mcp.Description("The tag to rename"),
+			),
+			mcp.WithString("new_name",
+				mcp.Required(),
+				mcp.Description("The new name of the tag"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			orgName, err := request.RequireString("terraform_org_name")
+			if err != nil {
+				return ToolError(logger, "missing required input: terraform_org_name", err)
+			}
+			tag, err := request.RequireString("tag")
+			if err != nil {
+				return ToolError(logger, "missing required input: tag", err)
+			}
+			newName, err := request.RequireString("new_name")
+			if err != nil {
+				return ToolError(logger, "missing required input: new_name", err)
+			}
+			return retagWorkspacesHandler(ctx, request, strings.TrimSpace(orgName), []string{tag}, newName, logger)
+		},
+	}
+}
+
+// MergeOrganizationTags creates a tool to merge duplicate tags into a single tag.
+func MergeOrganizationTags(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("merge_organization_tags",
+			mcp.WithDescription(`Merges duplicate workspace tags (e.g. 'env' and 'Environment') into a single target tag across every workspace of an organization. Each workspace with a source tag gets the target tag and loses the source tags, and source tags are deleted from the organization once unused. Progress is reported per workspace.`),
+			mcp.WithTitleAnnotation("Merge duplicate workspace tags across an organization"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(false),
+			mcp.WithDestructiveHintAnnotation(true),
+			mcp.WithString("terraform_org_name",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+			mcp.WithString("source_tags",
+				mcp.Required(),
+				mcp.Description("Comma-separated list of tags to merge into the target tag"),
+			),
+			mcp.WithString("target_tag",
+				mcp.Required(),
+				mcp.Description("The tag to keep. It may be one of the source tags or a new tag"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			orgName, err := request.RequireString("terraform_org_name")
+			if err != nil {
+				return ToolError(logger, "missing required input: terraform_org_name", err)
+			}
+			sourceTags, err := request.RequireString("source_tags")
+			if err != nil {
+				return ToolError(logger, "missing required input: source_tags", err)
+			}
+			targetTag, err := request.RequireString("target_tag")
+			if err != nil {
+				return ToolError(logger, "missing required input: target_tag", err)
+			}
+			return retagWorkspacesHandler(ctx, request, strings.TrimSpace(orgName), strings.Split(sourceTags, ","), targetTag, logger)
+		},
+	}
+}
+
+func listOrganizationTagsHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	orgName, err := request.RequireString("terraform_org_name")
+	if err != nil {
+		return ToolError(logger, "missing required input: terraform_org_name", err)
+	}
+	orgName = strings.TrimSpace(orgName)
+
+	pagination, err := utils.OptionalPaginationParams(request)
+	if err != nil {
+		return ToolError(logger, "invalid pagination parameters", err)
+	}
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+
+	tags, err := tfeClient.OrganizationTags.List(ctx, orgName, &tfe.OrganizationTagsListOptions{
+		ListOptions: tfe.ListOptions{
+			PageNumber: pagination.Page,
+			PageSize:   pagination.PageSize,
+		},
+		Query: strings.TrimSpace(request.GetString("query", "")),
+	})
+	if err != nil {
+		return ToolErrorf(logger, "failed to list tags in org '%s': %v", orgName, err)
+	}
+
+	summaries := make([]*OrganizationTagSummary, 0, len(tags.Items))
+	for _, tag := range tags.Items {
+		summaries = append(summaries, &OrganizationTagSummary{
+			ID:             tag.ID,
+			Name:           tag.Name,
+			WorkspaceCount: tag.InstanceCount,
+		})
+	}
+
+	buf, err := json.Marshal(map[string]any{
+		"tags":       summaries,
+		"pagination": tags.Pagination,
+	})
+	if err != nil {
+		return ToolError(logger, "failed to marshal tags", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+func retagWorkspacesHandler(ctx context.Context, request mcp.CallToolRequest, orgName string, sourceTags []string, targetTag string, logger *log.Logger) (*mcp.CallToolResult, error) {
+	targetTag = strings.TrimSpace(targetTag)
+	if targetTag == "" {
+		return ToolError(logger, "the target tag must not be empty", nil)
+	}
+
+	var sources []string
+	for _, tag := range sourceTags {
+		tag = strings.TrimSpace(tag)
+		if tag != "" && tag != targetTag {
+			sources = append(sources, tag)
+		}
+	}
+	if len(sources) == 0 {
+		return ToolError(logger, "at least one source tag different from the target tag is required", nil)
+	}
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+
+	// Collect the affected workspaces up front, retagging changes the search results
+	workspaces, err := listWorkspacesWithTags(ctx, tfeClient, orgName, sources)
+	if err != nil {
+		return ToolErrorf(logger, "failed to list workspaces in org '%s': %v", orgName, err)
+	}
+
+	result := &RetagResult{
+		TargetTag:         targetTag,
+		SourceTags:        sources,
+		UpdatedWorkspaces: make([]string, 0, len(workspaces)),
+	}
+	for i, workspace := range workspaces {
+		sendProgress(ctx, request, i, len(workspaces), fmt.Sprintf("Retagging workspace %s", workspace.Name), logger)
+		if err := retagWorkspace(ctx, tfeClient, workspace, sources, targetTag); err != nil {
+			result.Failures = append(result.Failures, RetagFailed{Workspace: workspace.Name, Error: err.Error()})
+			continue
+		}
+		result.UpdatedWorkspaces = append(result.UpdatedWorkspaces, workspace.Name)
+	}
+	sendProgress(ctx, request, len(workspaces), len(workspaces), "Retagging complete", logger)
+
+	if len(result.Failures) == 0 {
+		result.DeletedTags, err = deleteOrganizationTags(ctx, tfeClient, orgName, sources)
+		if err != nil {
+			logger.Warnf("failed to delete unused tags in org '%s': %v", orgName, err)
+		}
+	}
+
+	buf, err := json.Marshal(result)
+	if err != nil {
+		return ToolError(logger, "failed to marshal result", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+// listWorkspacesWithTags returns the workspaces carrying any of the given tags
+func listWorkspacesWithTags(ctx context.Context, tfeClient *tfe.Client, orgName string, tags []string) ([]*tfe.Workspace, error) {
+	seen := make(map[string]bool)
+	var workspaces []*tfe.Workspace
+	for _, tag := range tags {
+		options := &tfe.WorkspaceListOptions{
+			ListOptions: tfe.ListOptions{PageNumber: 1, PageSize: 100},
+			Tags:        tag,
+		}
+		for {
+			list, err := tfeClient.Workspaces.List(ctx, orgName, options)
+			if err != nil {
+				return nil, err
+			}
+			for _, ws := range list.Items {
+				if !seen[ws.ID] {
+					seen[ws.ID] = true
+					workspaces = append(workspaces, ws)
+				}
+			}
+			if list.Pagination == nil || list.Pagination.NextPage == 0 {
+				break
+			}
+			options.PageNumber = list.Pagination.NextPage
+		}
+	}
+	return workspaces, nil
+}
+
+func retagWorkspace(ctx context.Context, tfeClient *tfe.Client, workspace *tfe.Workspace, sources []string, target string) error {
+	if err := tfeClient.Workspaces.AddTags(ctx, workspace.ID, tfe.WorkspaceAddTagsOptions{
+		Tags: []*tfe.Tag{{Name: target}},
+	}); err != nil {
+		return fmt.Errorf("failed to add tag '%s': %w", target, err)
+	}
+
+	remove := make([]*tfe.Tag, 0, len(sources))
+	for _, source := range sources {
+		remove = append(remove, &tfe.Tag{Name: source})
+	}
+	if err := tfeClient.Workspaces.RemoveTags(ctx, workspace.ID, tfe.WorkspaceRemoveTagsOptions{Tags: remove}); err != nil {
+		return fmt.Errorf("failed to remove tags '%s': %w", strings.Join(sources, ", "), err)
+	}
+	return nil
+}
+
+// deleteOrganizationTags deletes the given tags from the organization if no workspace uses them anymore
+func deleteOrganizationTags(ctx context.Context, tfeClient *tfe.Client, orgName string, names []string) ([]string, error) {
+	var ids, deleted []string
+	for _, name := range names {
+		tags, err := tfeClient.OrganizationTags.List(ctx, orgName, &tfe.OrganizationTagsListOptions{
+			ListOptions: tfe.ListOptions{PageNumber: 1, PageSize: 100},
+			Query:       name,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, tag := range tags.Items {
+			if tag.Name == name && tag.InstanceCount == 0 {
+				ids = append(ids, tag.ID)
+				deleted = append(deleted, tag.Name)
+			}
+		}
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	if err := tfeClient.OrganizationTags.Delete(ctx, orgName, tfe.OrganizationTagsDeleteOptions{IDs: ids}); err != nil {
+		return nil, err
+	}
+	return deleted, nil
+}
@@ -0,0 +1,85 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListOrganizationTags(t *testing.T) {
+	logger := log.New()
+	logger.SetLevel(log.ErrorLevel)
+
+	t.Run("tool creation", func(t *testing.T) {
+		tool := ListOrganizationTags(logger)
+
+		assert.Equal(t, "list_organization_tags", tool.Tool.Name)
+		assert.Contains(t, tool.Tool.Description, "Lists the workspace tags")
+		assert.NotNil(t, tool.Handler)
+
+		assert.NotNil(t, tool.Tool.Annotations.ReadOnlyHint)
+		assert.True(t, *tool.Tool.Annotations.ReadOnlyHint)
+
+		assert.Contains(t, tool.Tool.InputSchema.Required, "terraform_org_name")
+		assert.NotContains(t, tool.Tool.InputSchema.Required, "query")
+	})
+}
+
+func TestRenameOrganizationTag(t *testing.T) {
+	logger := log.New()
+	logger.SetLevel(log.ErrorLevel)
+
+	t.Run("tool creation", func(t *testing.T) {
+		tool := RenameOrganizationTag(logger)
+
+		assert.Equal(t, "rename_organization_tag", tool.Tool.Name)
+		assert.Contains(t, tool.Tool.Description, "Renames a workspace tag")
+		assert.NotNil(t, tool.Handler)
+
+		assert.NotNil(t, tool.Tool.Annotations.DestructiveHint)
+		assert.True(t, *tool.Tool.Annotations.DestructiveHint)
+
+		assert.Contains(t, tool.Tool.InputSchema.Required, "terraform_org_name")
+		assert.Contains(t, tool.Tool.InputSchema.Required, "tag")
+		assert.Contains(t, tool.Tool.InputSchema.Required, "new_name")
+	})
+}
+
+func TestMergeOrganizationTags(t *testing.T) {
+	logger := log.New()
+	logger.SetLevel(log.ErrorLevel)
+
+	t.Run("tool creation", func(t *testing.T) {
+		tool := MergeOrganizationTags(logger)
+
+		assert.Equal(t, "merge_organization_tags", tool.Tool.Name)
+		assert.Contains(t, tool.Tool.Description, "Merges duplicate workspace tags")
+		assert.NotNil(t, tool.Handler)
+
+		assert.NotNil(t, tool.Tool.Annotations.DestructiveHint)
+		assert.True(t, *tool.Tool.Annotations.DestructiveHint)
+
+		assert.Contains(t, tool.Tool.InputSchema.Required, "terraform_org_name")
+		assert.Contains(t, tool.Tool.InputSchema.Required, "source_tags")
+		assert.Contains(t, tool.Tool.InputSchema.Required, "target_tag")
+	})
+
+	t.Run("rejects merging a tag into itself", func(t *testing.T) {
+		tool := MergeOrganizationTags(logger)
+		request := mcp.CallToolRequest{}
+		request.Params.Arguments = map[string]any{
+			"terraform_org_name": "my-org",
+			"source_tags":        "env, ",
+			"target_tag":         "env",
+		}
+
+		result, err := tool.Handler(t.Context(), request)
+		assert.NoError(t, err)
+		assert.True(t, result.IsError)
+	})
+}
@@ -0,0 +1,14 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import "github.com/hashicorp/go-tfe"
+
+// nextPage returns the number of the page after a list page, 0 on the last page
+func nextPage(pagination *tfe.Pagination) int {
+	if pagination == nil {
+		return 0
+	}
+	return pagination.NextPage
+}
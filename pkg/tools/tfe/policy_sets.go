@@ -182,3 +182,280 @@ func listWorkspacePolicySetsHandler(ctx context.Context, request mcp.CallToolReq
 		},
 	}, nil
 }
+
+// PolicySetSummary is a policy set of an organization.
+type PolicySetSummary struct {
+	ID             string `json:"id"`
+	Name           string `json:"name"`
+	Description    string `json:"description,omitempty"`
+	Kind           string `json:"kind"`
+	Global         bool   `json:"global"`
+	Overridable    *bool  `json:"overridable,omitempty"`
+	PolicyCount    int    `json:"policy_count"`
+	WorkspaceCount int    `json:"workspace_count"`
+	ProjectCount   int    `json:"project_count"`
+}
+
+// PolicySetDetails is a policy set with its policies and the workspaces and projects it is attached to.
+type PolicySetDetails struct {
+	PolicySetSummary
+	PoliciesPath string             `json:"policies_path,omitempty"`
+	VCSRepo      string             `json:"vcs_repo,omitempty"`
+	Policies     []*PolicySetPolicy `json:"policies"`
+	Workspaces   []*PolicySetTarget `json:"workspaces"`
+	Projects     []*PolicySetTarget `json:"projects"`
+}
+
+// PolicySetPolicy is a policy that belongs to a policy set.
+type PolicySetPolicy struct {
+	ID               string `json:"id"`
+	Name             string `json:"name"`
+	Description      string `json:"description,omitempty"`
+	EnforcementLevel string `json:"enforcement_level"`
+}
+
+// PolicySetTarget is a workspace or project a policy set is attached to.
+type PolicySetTarget struct {
+	ID   string `json:"id"`
+	Name string `json:"name,omitempty"`
+}
+
+func newPolicySetSummary(ps *tfe.PolicySet) PolicySetSummary {
+	return PolicySetSummary{
+		ID:             ps.ID,
+		Name:           ps.Name,
+		Description:    ps.Description,
+		Kind:           string(ps.Kind),
+		Global:         ps.Global,
+		Overridable:    ps.Overridable,
+		PolicyCount:    ps.PolicyCount,
+		WorkspaceCount: ps.WorkspaceCount,
+		ProjectCount:   ps.ProjectCount,
+	}
+}
+
+// ListPolicySets creates a tool to list the policy sets of an organization.
+func ListPolicySets(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("list_policy_sets",
+			mcp.WithDescription("List the Sentinel and OPA policy sets enforced in an organization, with the number of policies, workspaces and projects of each. Use get_policy_set_details to read the policies of a policy set."),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithString("terraform_org_name", mcp.Required(), mcp.Description("Organization name")),
+			mcp.WithString("search_query", mcp.Description("Optional search query to filter policy sets by name")),
+			mcp.WithString("kind", mcp.Description("Optional policy framework to filter by"), mcp.Enum("sentinel", "opa")),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return listPolicySetsHandler(ctx, request, logger)
+		},
+	}
+}
+
+func listPolicySetsHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	orgName, err := request.RequireString("terraform_org_name")
+	if err != nil {
+		return ToolError(logger, "missing required input: terraform_org_name", err)
+	}
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+
+	options := &tfe.PolicySetListOptions{
+		Search: strings.TrimSpace(request.GetString("search_query", "")),
+		Kind:   tfe.PolicyKind(request.GetString("kind", "")),
+		ListOptions: tfe.ListOptions{
+			PageNumber: 1,
+			PageSize:   100,
+		},
+	}
+
+	policySets := []*PolicySetSummary{}
+	for {
+		page, err := tfeClient.PolicySets.List(ctx, orgName, options)
+		if err != nil {
+			return ToolErrorf(logger, "failed to list policy sets for org '%s': %v", orgName, err)
+		}
+		for _, ps := range page.Items {
+			summary := newPolicySetSummary(ps)
+			policySets = append(policySets, &summary)
+		}
+		if page.NextPage == 0 {
+			break
+		}
+		options.PageNumber = page.NextPage
+	}
+
+	result, err := json.MarshalIndent(policySets, "", "  ")
+	if err != nil {
+		return ToolError(logger, "failed to marshal policy sets", err)
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.NewTextContent(string(result)),
+		},
+	}, nil
+}
+
+// GetPolicySetDetails creates a tool to read a policy set with its policies and attachments.
+func GetPolicySetDetails(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("get_policy_set_details",
+			mcp.WithDescription("Read a policy set with its policies and their enforcement levels, and the workspaces and projects it is attached to."),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithString("policy_set_id", mcp.Required(), mcp.Description("The ID of the policy set (e.g., polset-3yVQZvHzf5j3WRJ1)")),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return getPolicySetDetailsHandler(ctx, request, logger)
+		},
+	}
+}
+
+func getPolicySetDetailsHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	policySetID, err := request.RequireString("policy_set_id")
+	if err != nil {
+		return ToolError(logger, "missing required input: policy_set_id", err)
+	}
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+
+	ps, err := tfeClient.PolicySets.ReadWithOptions(ctx, strings.TrimSpace(policySetID), &tfe.PolicySetReadOptions{
+		Include: []tfe.PolicySetIncludeOpt{tfe.PolicySetPolicies, tfe.PolicySetWorkspaces, tfe.PolicySetProjects},
+	})
+	if err != nil {
+		return ToolErrorf(logger, "failed to read policy set '%s': %v", policySetID, err)
+	}
+
+	result, err := json.MarshalIndent(newPolicySetDetails(ps), "", "  ")
+	if err != nil {
+		return ToolError(logger, "failed to marshal policy set", err)
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.NewTextContent(string(result)),
+		},
+	}, nil
+}
+
+func newPolicySetDetails(ps *tfe.PolicySet) *PolicySetDetails {
+	details := &PolicySetDetails{
+		PolicySetSummary: newPolicySetSummary(ps),
+		PoliciesPath:     ps.PoliciesPath,
+		Policies:         []*PolicySetPolicy{},
+		Workspaces:       []*PolicySetTarget{},
+		Projects:         []*PolicySetTarget{},
+	}
+	if ps.VCSRepo != nil {
+		details.VCSRepo = ps.VCSRepo.Identifier
+	}
+	for _, policy := range ps.Policies {
+		details.Policies = append(details.Policies, &PolicySetPolicy{
+			ID:               policy.ID,
+			Name:             policy.Name,
+			Description:      policy.Description,
+			EnforcementLevel: string(policy.EnforcementLevel),
+		})
+	}
+	for _, ws := range ps.Workspaces {
+		details.Workspaces = append(details.Workspaces, &PolicySetTarget{ID: ws.ID, Name: ws.Name})
+	}
+	for _, project := range ps.Projects {
+		details.Projects = append(details.Projects, &PolicySetTarget{ID: project.ID, Name: project.Name})
+	}
+	return details
+}
+
+// DetachPolicySetFromWorkspaces creates a tool to detach a policy set from workspaces.
+func DetachPolicySetFromWorkspaces(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("detach_policy_set_from_workspaces",
+			mcp.WithDescription("Detach a policy set from one or more workspaces. The policies of the set are no longer enforced on runs of these workspaces."),
+			mcp.WithDestructiveHintAnnotation(true),
+			mcp.WithString("policy_set_id", mcp.Required(), mcp.Description("The ID of the policy set to detach (e.g., polset-3yVQZvHzf5j3WRJ1)")),
+			mcp.WithString("workspace_ids", mcp.Required(), mcp.Description("Comma-separated list of workspace IDs to detach the policy set from")),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			policySetID, err := request.RequireString("policy_set_id")
+			if err != nil {
+				return ToolError(logger, "missing required input: policy_set_id", err)
+			}
+			workspaceIDsStr, err := request.RequireString("workspace_ids")
+			if err != nil {
+				return ToolError(logger, "missing required input: workspace_ids", err)
+			}
+
+			var workspaces []*tfe.Workspace
+			for _, id := range strings.Split(workspaceIDsStr, ",") {
+				trimmedID := strings.TrimSpace(id)
+				if trimmedID != "" {
+					workspaces = append(workspaces, &tfe.Workspace{ID: trimmedID})
+				}
+			}
+
+			if len(workspaces) == 0 {
+				return ToolError(logger, "no valid workspace IDs provided", nil)
+			}
+
+			tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+			if err != nil {
+				return ToolError(logger, "failed to get Terraform client", err)
+			}
+
+			err = tfeClient.PolicySets.RemoveWorkspaces(ctx, policySetID, tfe.PolicySetRemoveWorkspacesOptions{
+				Workspaces: workspaces,
+			})
+			if err != nil {
+				return ToolErrorf(logger, "failed to detach policy set '%s' from workspaces: %v", policySetID, err)
+			}
+
+			return &mcp.CallToolResult{
+				Content: []mcp.Content{
+					mcp.NewTextContent(fmt.Sprintf("Successfully detached policy set %s from %d workspace(s)", policySetID, len(workspaces))),
+				},
+			}, nil
+		},
+	}
+}
+
+// ListRunPolicyResults creates a tool to list the policy checks and policy evaluations of a run.
+func ListRunPolicyResults(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("list_run_policy_results",
+			mcp.WithDescription("List the Sentinel policy checks and the Sentinel or OPA policy evaluations of a run, with the outcome and enforcement level of every evaluated policy. Use this to explain why a run is blocked by policy."),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithString("run_id", mcp.Required(), mcp.Description("The ID of the run (e.g., run-CZcmD7eagjhyX0vN)")),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			runID, err := request.RequireString("run_id")
+			if err != nil {
+				return ToolError(logger, "missing required input: run_id", err)
+			}
+
+			tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+			if err != nil {
+				return ToolError(logger, "failed to get Terraform client", err)
+			}
+
+			results, err := client.ListRunPolicyResults(ctx, tfeClient, strings.TrimSpace(runID))
+			if err != nil {
+				return ToolErrorf(logger, "failed to list policy results for run '%s': %v", runID, err)
+			}
+
+			result, err := json.MarshalIndent(results, "", "  ")
+			if err != nil {
+				return ToolError(logger, "failed to marshal policy results", err)
+			}
+
+			return &mcp.CallToolResult{
+				Content: []mcp.Content{
+					mcp.NewTextContent(string(result)),
+				},
+			}, nil
+		},
+	}
+}
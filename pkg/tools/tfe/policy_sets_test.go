@@ -6,6 +6,7 @@ package tools
 import (
 	"testing"
 
+	"github.com/hashicorp/go-tfe"
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 )
@@ -46,3 +47,82 @@ func TestListWorkspacePolicySets(t *testing.T) {
 		assert.Contains(t, tool.Tool.InputSchema.Required, "workspace_id")
 	})
 }
+
+func TestListPolicySets(t *testing.T) {
+	logger := log.New()
+	logger.SetLevel(log.ErrorLevel)
+
+	t.Run("tool creation", func(t *testing.T) {
+		tool := ListPolicySets(logger)
+
+		assert.Equal(t, "list_policy_sets", tool.Tool.Name)
+		assert.NotNil(t, tool.Handler)
+		assert.True(t, *tool.Tool.Annotations.ReadOnlyHint)
+
+		assert.Contains(t, tool.Tool.InputSchema.Required, "terraform_org_name")
+		assert.NotContains(t, tool.Tool.InputSchema.Required, "kind")
+	})
+}
+
+func TestGetPolicySetDetails(t *testing.T) {
+	logger := log.New()
+	logger.SetLevel(log.ErrorLevel)
+
+	t.Run("tool creation", func(t *testing.T) {
+		tool := GetPolicySetDetails(logger)
+
+		assert.Equal(t, "get_policy_set_details", tool.Tool.Name)
+		assert.NotNil(t, tool.Handler)
+		assert.True(t, *tool.Tool.Annotations.ReadOnlyHint)
+		assert.Contains(t, tool.Tool.InputSchema.Required, "policy_set_id")
+	})
+
+	t.Run("details", func(t *testing.T) {
+		details := newPolicySetDetails(&tfe.PolicySet{
+			ID:             "polset-1",
+			Name:           "security",
+			Kind:           tfe.OPA,
+			PolicyCount:    1,
+			WorkspaceCount: 1,
+			VCSRepo:        &tfe.VCSRepo{Identifier: "acme/policies"},
+			Policies:       []*tfe.Policy{{ID: "pol-1", Name: "no-public-buckets", EnforcementLevel: tfe.EnforcementMandatory}},
+			Workspaces:     []*tfe.Workspace{{ID: "ws-1", Name: "prod"}},
+		})
+
+		assert.Equal(t, "opa", details.Kind)
+		assert.Equal(t, "acme/policies", details.VCSRepo)
+		assert.Equal(t, []*PolicySetPolicy{{ID: "pol-1", Name: "no-public-buckets", EnforcementLevel: "mandatory"}}, details.Policies)
+		assert.Equal(t, []*PolicySetTarget{{ID: "ws-1", Name: "prod"}}, details.Workspaces)
+		assert.Empty(t, details.Projects)
+	})
+}
+
+func TestDetachPolicySetFromWorkspaces(t *testing.T) {
+	logger := log.New()
+	logger.SetLevel(log.ErrorLevel)
+
+	t.Run("tool creation", func(t *testing.T) {
+		tool := DetachPolicySetFromWorkspaces(logger)
+
+		assert.Equal(t, "detach_policy_set_from_workspaces", tool.Tool.Name)
+		assert.NotNil(t, tool.Handler)
+		assert.True(t, *tool.Tool.Annotations.DestructiveHint)
+
+		assert.Contains(t, tool.Tool.InputSchema.Required, "policy_set_id")
+		assert.Contains(t, tool.Tool.InputSchema.Required, "workspace_ids")
+	})
+}
+
+func TestListRunPolicyResults(t *testing.T) {
+	logger := log.New()
+	logger.SetLevel(log.ErrorLevel)
+
+	t.Run("tool creation", func(t *testing.T) {
+		tool := ListRunPolicyResults(logger)
+
+		assert.Equal(t, "list_run_policy_results", tool.Tool.Name)
+		assert.NotNil(t, tool.Handler)
+		assert.True(t, *tool.Tool.Annotations.ReadOnlyHint)
+		assert.Contains(t, tool.Tool.InputSchema.Required, "run_id")
+	})
+}
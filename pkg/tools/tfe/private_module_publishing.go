@@ -0,0 +1,393 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// PrivateModuleSummary is a module of the private registry
+type PrivateModuleSummary struct {
+	ID                  string `json:"id"`
+	PrivateModuleID     string `json:"private_module_id"`
+	Name                string `json:"name"`
+	Provider            string `json:"provider"`
+	PublishingMechanism string `json:"publishing_mechanism,omitempty"`
+	Status              string `json:"status,omitempty"`
+	VCSRepo             string `json:"vcs_repo,omitempty"`
+	Branch              string `json:"branch,omitempty"`
+}
+
+// PrivateModuleVersionUpload is a version of a private module, with the URL its configuration is
+// uploaded to until it is uploaded
+type PrivateModuleVersionUpload struct {
+	ID              string   `json:"id"`
+	PrivateModuleID string   `json:"private_module_id"`
+	Version         string   `json:"version"`
+	Status          string   `json:"status"`
+	Uploaded        bool     `json:"uploaded"`
+	Files           []string `json:"files,omitempty"`
+	UploadURL       string   `json:"upload_url,omitempty"`
+}
+
+// CreatePrivateModule creates a tool to add a module to the private registry of an organization.
+func CreatePrivateModule(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("create_private_module",
+			mcp.WithDescription(`Adds a module to the private registry of an organization. With vcs_repo_identifier, the module is published from a VCS repository: a version is created for each tag of the repository, or from a branch with vcs_repo_branch. Without it, the module is created empty and its versions are published with create_private_module_version.
+VCS repositories named 'terraform-<provider>-<name>' give the module name and provider, other repositories require module_name and module_provider.`),
+			mcp.WithTitleAnnotation("Add a module to the private registry"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(false),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("terraform_org_name",
+				mcp.Required(),
+				mcp.Description("The Terraform Cloud/Enterprise organization name, which is also the namespace of its private modules"),
+			),
+			mcp.WithString("module_name",
+				mcp.Description("The name of the module, e.g. 'vpc'. Required without vcs_repo_identifier"),
+			),
+			mcp.WithString("module_provider",
+				mcp.Description("The main provider of the module, e.g. 'aws'. Required without vcs_repo_identifier"),
+			),
+			mcp.WithString("vcs_repo_identifier",
+				mcp.Description("Optional VCS repository to publish the module from, e.g. 'my-org/terraform-aws-vpc'"),
+			),
+			mcp.WithString("vcs_repo_oauth_token_id",
+				mcp.Description("OAuth token ID of the VCS connection, e.g. 'ot-abc123'. Use list_oauth_tokens to find it"),
+			),
+			mcp.WithString("vcs_repo_github_app_installation_id",
+				mcp.Description("GitHub App installation ID instead of an OAuth token, e.g. 'ghain-abc123'. Use list_github_app_installations to find it"),
+			),
+			mcp.WithString("vcs_repo_branch",
+				mcp.Description("Optional branch to publish versions from instead of the tags of the repository"),
+			),
+			mcp.WithString("vcs_repo_source_directory",
+				mcp.Description("Optional directory of the module in a repository with several modules"),
+			),
+			mcp.WithString("vcs_repo_tag_prefix",
+				mcp.Description("Optional prefix of the tags of the module in a repository with several modules, e.g. 'vpc/'"),
+			),
+			mcp.WithString("initial_version",
+				mcp.Description("The version of the module published from vcs_repo_branch. Defaults to 0.0.0"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return createPrivateModuleHandler(ctx, request, logger)
+		},
+	}
+}
+
+// CreatePrivateModuleVersion creates a tool to publish a version of a private module.
+func CreatePrivateModuleVersion(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("create_private_module_version",
+			mcp.WithDescription(`Creates a version of a module of the private registry. For modules created without a VCS repository, pass the files of the module to upload them, or upload a tar.gz archive of the module to the returned upload_url. For modules published from a branch, pass the commit_sha to publish.
+Modules published from the tags of a VCS repository get a version for each new tag instead. Use list_private_module_versions to check that the version was ingested.`),
+			mcp.WithTitleAnnotation("Publish a version of a private module"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(false),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("terraform_org_name",
+				mcp.Required(),
+				mcp.Description("The Terraform Cloud/Enterprise organization name"),
+			),
+			mcp.WithString("private_module_id",
+				mcp.Required(),
+				mcp.Description("The private module ID in the format 'module-namespace/module-name/module-provider-name', e.g. 'my-tfc-org/vpc/aws'"),
+			),
+			mcp.WithString("version",
+				mcp.Required(),
+				mcp.Description("The semantic version to create, e.g. '1.2.0'"),
+			),
+			mcp.WithObject("files",
+				mcp.Description(`Optional map of the relative file paths of the module to their content, e.g. {"main.tf": "...", "variables.tf": "..."}, uploaded as the content of the version`),
+			),
+			mcp.WithString("commit_sha",
+				mcp.Description("The commit to publish, for modules published from a branch"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return createPrivateModuleVersionHandler(ctx, request, logger)
+		},
+	}
+}
+
+// DeletePrivateModuleVersion creates a tool to delete a version of a private module.
+func DeletePrivateModuleVersion(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("delete_private_module_version",
+			mcp.WithDescription(`Deletes a version of a module of the private registry. Configurations that pin the version can no longer install it, so confirm with the user first. The module and its other versions are kept.`),
+			mcp.WithTitleAnnotation("Delete a version of a private module"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(false),
+			mcp.WithDestructiveHintAnnotation(true),
+			mcp.WithString("terraform_org_name",
+				mcp.Required(),
+				mcp.Description("The Terraform Cloud/Enterprise organization name"),
+			),
+			mcp.WithString("private_module_id",
+				mcp.Required(),
+				mcp.Description("The private module ID in the format 'module-namespace/module-name/module-provider-name', e.g. 'my-tfc-org/vpc/aws'"),
+			),
+			mcp.WithString("version",
+				mcp.Required(),
+				mcp.Description("The version to delete"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return deletePrivateModuleVersionHandler(ctx, request, logger)
+		},
+	}
+}
+
+func createPrivateModuleHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	orgName, err := request.RequireString("terraform_org_name")
+	if err != nil {
+		return ToolError(logger, "missing required input: terraform_org_name", err)
+	}
+	orgName = strings.TrimSpace(orgName)
+
+	name := strings.TrimSpace(request.GetString("module_name", ""))
+	provider := strings.TrimSpace(request.GetString("module_provider", ""))
+	identifier := strings.TrimSpace(request.GetString("vcs_repo_identifier", ""))
+	oauthTokenID := strings.TrimSpace(request.GetString("vcs_repo_oauth_token_id", ""))
+	installationID := strings.TrimSpace(request.GetString("vcs_repo_github_app_installation_id", ""))
+	branch := strings.TrimSpace(request.GetString("vcs_repo_branch", ""))
+	initialVersion := strings.TrimSpace(request.GetString("initial_version", ""))
+
+	if identifier == "" && (name == "" || provider == "") {
+		return ToolError(logger, "module_name and module_provider are required without vcs_repo_identifier", nil)
+	}
+	if identifier != "" && (oauthTokenID == "") == (installationID == "") {
+		return ToolError(logger, "exactly one of vcs_repo_oauth_token_id or vcs_repo_github_app_installation_id is required with vcs_repo_identifier", nil)
+	}
+	if initialVersion != "" {
+		if branch == "" {
+			return ToolError(logger, "initial_version is only used for modules published from vcs_repo_branch", nil)
+		}
+		if _, err := version.NewSemver(initialVersion); err != nil {
+			return ToolErrorf(logger, "invalid initial_version '%s': must be a semantic version such as 1.0.0", initialVersion)
+		}
+	}
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+
+	var module *tfe.RegistryModule
+	if identifier == "" {
+		module, err = tfeClient.RegistryModules.Create(ctx, orgName, tfe.RegistryModuleCreateOptions{
+			Name:         tfe.String(name),
+			Provider:     tfe.String(provider),
+			RegistryName: tfe.PrivateRegistry,
+			Namespace:    orgName,
+		})
+	} else {
+		module, err = tfeClient.RegistryModules.CreateWithVCSConnection(ctx, privateModuleVCSOptions(request, orgName, name, provider, identifier, oauthTokenID, installationID, branch, initialVersion))
+	}
+	if err != nil {
+		return ToolErrorf(logger, "failed to add the module to the private registry of org '%s': %v", orgName, err)
+	}
+
+	buf, err := json.Marshal(privateModuleSummary(module))
+	if err != nil {
+		return ToolError(logger, "failed to marshal private module", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+func createPrivateModuleVersionHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	moduleID, err := privateModuleID(request)
+	if err != nil {
+		return ToolError(logger, err.Error(), nil)
+	}
+
+	moduleVersion, err := request.RequireString("version")
+	if err != nil {
+		return ToolError(logger, "missing required input: version", err)
+	}
+	moduleVersion = strings.TrimPrefix(strings.TrimSpace(moduleVersion), "v")
+	if _, err := version.NewSemver(moduleVersion); err != nil {
+		return ToolErrorf(logger, "invalid version '%s': must be a semantic version such as 1.2.0", moduleVersion)
+	}
+	commitSHA := strings.TrimSpace(request.GetString("commit_sha", ""))
+
+	var files map[string]string
+	if raw, ok := request.GetArguments()["files"]; ok && raw != nil {
+		if commitSHA != "" {
+			return ToolError(logger, "files and commit_sha cannot be used together", nil)
+		}
+		if files, err = configurationFileMap(raw); err != nil {
+			return ToolError(logger, "invalid files", err)
+		}
+	}
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+
+	options := tfe.RegistryModuleCreateVersionOptions{Version: tfe.String(moduleVersion)}
+	if commitSHA != "" {
+		options.CommitSHA = tfe.String(commitSHA)
+	}
+	moduleName := moduleID.Namespace + "/" + moduleID.Name + "/" + moduleID.Provider
+
+	// The files are packaged before the version is created, so that invalid files don't leave an empty version behind
+	var archive *bytes.Buffer
+	var names []string
+	if files != nil {
+		if archive, names, err = configurationArchive(files); err != nil {
+			return ToolError(logger, "failed to package module files", err)
+		}
+	}
+
+	created, err := tfeClient.RegistryModules.CreateVersion(ctx, moduleID, options)
+	if err != nil {
+		return ToolErrorf(logger, "failed to create version %s of private module %s: %v", moduleVersion, moduleName, err)
+	}
+	upload := privateModuleVersionUpload(moduleName, created)
+
+	if archive != nil {
+		if upload.UploadURL == "" {
+			return ToolErrorf(logger, "version %s of private module %s was created without an upload URL, the module is published from VCS", moduleVersion, moduleName)
+		}
+		if err := tfeClient.RegistryModules.UploadTarGzip(ctx, upload.UploadURL, archive); err != nil {
+			return ToolErrorf(logger, "failed to upload version %s of private module %s, upload a tar.gz archive of the module to %s to complete it: %v", moduleVersion, moduleName, upload.UploadURL, err)
+		}
+		upload.Uploaded = true
+		upload.UploadURL = ""
+		upload.Files = names
+	}
+
+	buf, err := json.Marshal(upload)
+	if err != nil {
+		return ToolError(logger, "failed to marshal private module version", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+func deletePrivateModuleVersionHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	moduleID, err := privateModuleID(request)
+	if err != nil {
+		return ToolError(logger, err.Error(), nil)
+	}
+
+	moduleVersion, err := request.RequireString("version")
+	if err != nil || strings.TrimSpace(moduleVersion) == "" {
+		return ToolError(logger, "missing required input: version", err)
+	}
+	moduleVersion = strings.TrimPrefix(strings.TrimSpace(moduleVersion), "v")
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+
+	moduleName := moduleID.Namespace + "/" + moduleID.Name + "/" + moduleID.Provider
+	if err := tfeClient.RegistryModules.DeleteVersion(ctx, moduleID, moduleVersion); err != nil {
+		return ToolErrorf(logger, "failed to delete version %s of private module %s: %v", moduleVersion, moduleName, err)
+	}
+	return mcp.NewToolResultText(fmt.Sprintf("Version %s of private module %s deleted", moduleVersion, moduleName)), nil
+}
+
+// privateModuleID returns the ID of the private module of the request, given as namespace/name/provider
+func privateModuleID(request mcp.CallToolRequest) (tfe.RegistryModuleID, error) {
+	orgName, err := request.RequireString("terraform_org_name")
+	if err != nil || strings.TrimSpace(orgName) == "" {
+		return tfe.RegistryModuleID{}, fmt.Errorf("missing required input: terraform_org_name")
+	}
+	moduleID, err := request.RequireString("private_module_id")
+	if err != nil || strings.TrimSpace(moduleID) == "" {
+		return tfe.RegistryModuleID{}, fmt.Errorf("missing required input: private_module_id")
+	}
+	parts := strings.Split(strings.TrimSpace(moduleID), "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return tfe.RegistryModuleID{}, fmt.Errorf("private_module_id must be in format 'module-namespace/module-name/module-provider-name'")
+	}
+	return tfe.RegistryModuleID{
+		Organization: strings.TrimSpace(orgName),
+		Namespace:    parts[0],
+		Name:         parts[1],
+		Provider:     parts[2],
+		RegistryName: tfe.PrivateRegistry,
+	}, nil
+}
+
+// privateModuleVCSOptions returns the options to publish a private module from a VCS repository
+func privateModuleVCSOptions(request mcp.CallToolRequest, orgName, name, provider, identifier, oauthTokenID, installationID, branch, initialVersion string) tfe.RegistryModuleCreateWithVCSConnectionOptions {
+	vcsRepo := &tfe.RegistryModuleVCSRepoOptions{
+		Identifier:        tfe.String(identifier),
+		DisplayIdentifier: tfe.String(identifier),
+		OrganizationName:  tfe.String(orgName),
+	}
+	if oauthTokenID != "" {
+		vcsRepo.OAuthTokenID = tfe.String(oauthTokenID)
+	} else {
+		vcsRepo.GHAInstallationID = tfe.String(installationID)
+	}
+	if branch != "" {
+		vcsRepo.Branch = tfe.String(branch)
+	} else {
+		vcsRepo.Tags = tfe.Bool(true)
+	}
+	if sourceDirectory := strings.TrimSpace(request.GetString("vcs_repo_source_directory", "")); sourceDirectory != "" {
+		vcsRepo.SourceDirectory = tfe.String(sourceDirectory)
+	}
+	if tagPrefix := strings.TrimSpace(request.GetString("vcs_repo_tag_prefix", "")); tagPrefix != "" {
+		vcsRepo.TagPrefix = tfe.String(tagPrefix)
+	}
+
+	options := tfe.RegistryModuleCreateWithVCSConnectionOptions{VCSRepo: vcsRepo}
+	if name != "" {
+		options.Name = tfe.String(name)
+	}
+	if provider != "" {
+		options.Provider = tfe.String(provider)
+	}
+	if initialVersion != "" {
+		options.InitialVersion = tfe.String(initialVersion)
+	}
+	return options
+}
+
+func privateModuleSummary(module *tfe.RegistryModule) *PrivateModuleSummary {
+	summary := &PrivateModuleSummary{
+		ID:                  module.ID,
+		PrivateModuleID:     module.Namespace + "/" + module.Name + "/" + module.Provider,
+		Name:                module.Name,
+		Provider:            module.Provider,
+		PublishingMechanism: string(module.PublishingMechanism),
+		Status:              string(module.Status),
+	}
+	if module.VCSRepo != nil {
+		summary.VCSRepo = module.VCSRepo.Identifier
+		summary.Branch = module.VCSRepo.Branch
+	}
+	return summary
+}
+
+func privateModuleVersionUpload(moduleName string, moduleVersion *tfe.RegistryModuleVersion) *PrivateModuleVersionUpload {
+	return &PrivateModuleVersionUpload{
+		ID:              moduleVersion.ID,
+		PrivateModuleID: moduleName,
+		Version:         moduleVersion.Version,
+		Status:          string(moduleVersion.Status),
+		UploadURL:       uploadLink(moduleVersion.Links, "upload"),
+	}
+}
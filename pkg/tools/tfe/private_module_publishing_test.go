@@ -0,0 +1,123 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/mark3labs/mcp-go/mcp"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrivateModulePublishingTools(t *testing.T) {
+	logger := log.New()
+	logger.SetLevel(log.PanicLevel)
+
+	newRequest := func(arguments map[string]any) mcp.CallToolRequest {
+		request := mcp.CallToolRequest{}
+		request.Params.Arguments = arguments
+		return request
+	}
+
+	t.Run("tool creation", func(t *testing.T) {
+		create := CreatePrivateModule(logger)
+		assert.Equal(t, "create_private_module", create.Tool.Name)
+		assert.False(t, *create.Tool.Annotations.ReadOnlyHint)
+		assert.Equal(t, []string{"terraform_org_name"}, create.Tool.InputSchema.Required)
+
+		createVersion := CreatePrivateModuleVersion(logger)
+		assert.ElementsMatch(t, []string{"terraform_org_name", "private_module_id", "version"}, createVersion.Tool.InputSchema.Required)
+
+		deleteVersion := DeletePrivateModuleVersion(logger)
+		assert.True(t, *deleteVersion.Tool.Annotations.DestructiveHint)
+	})
+
+	t.Run("create requires a name or a VCS connection", func(t *testing.T) {
+		for _, arguments := range []map[string]any{
+			{"terraform_org_name": "my-org", "module_name": "vpc"},
+			{"terraform_org_name": "my-org", "vcs_repo_identifier": "my-org/terraform-aws-vpc"},
+			{"terraform_org_name": "my-org", "vcs_repo_identifier": "my-org/terraform-aws-vpc", "vcs_repo_oauth_token_id": "ot-1", "vcs_repo_github_app_installation_id": "ghain-1"},
+			{"terraform_org_name": "my-org", "module_name": "vpc", "module_provider": "aws", "initial_version": "1.0.0"},
+		} {
+			result, err := createPrivateModuleHandler(context.Background(), newRequest(arguments), logger)
+			require.NoError(t, err)
+			assert.True(t, result.IsError, arguments)
+		}
+	})
+
+	t.Run("versions are validated before any call", func(t *testing.T) {
+		for _, arguments := range []map[string]any{
+			{"terraform_org_name": "my-org", "private_module_id": "my-org/vpc", "version": "1.0.0"},
+			{"terraform_org_name": "my-org", "private_module_id": "my-org/vpc/aws", "version": "latest"},
+			{"terraform_org_name": "my-org", "private_module_id": "my-org/vpc/aws", "version": "1.0.0", "files": map[string]any{"main.tf": ""}, "commit_sha": "abc123"},
+			{"terraform_org_name": "my-org", "private_module_id": "my-org/vpc/aws", "version": "1.0.0", "files": map[string]any{"main.tf": 1}},
+		} {
+			result, err := createPrivateModuleVersionHandler(context.Background(), newRequest(arguments), logger)
+			require.NoError(t, err)
+			assert.True(t, result.IsError, arguments)
+		}
+	})
+
+	t.Run("module IDs", func(t *testing.T) {
+		moduleID, err := privateModuleID(newRequest(map[string]any{"terraform_org_name": " my-org ", "private_module_id": "my-org/vpc/aws"}))
+		require.NoError(t, err)
+		assert.Equal(t, tfe.RegistryModuleID{Organization: "my-org", Namespace: "my-org", Name: "vpc", Provider: "aws", RegistryName: tfe.PrivateRegistry}, moduleID)
+
+		_, err = privateModuleID(newRequest(map[string]any{"terraform_org_name": "my-org", "private_module_id": "my-org//aws"}))
+		assert.Error(t, err)
+	})
+
+	t.Run("VCS options", func(t *testing.T) {
+		request := newRequest(map[string]any{"vcs_repo_source_directory": "modules/vpc", "vcs_repo_tag_prefix": "vpc/"})
+		options := privateModuleVCSOptions(request, "my-org", "vpc", "aws", "my-org/infra", "", "ghain-1", "", "")
+		assert.Equal(t, "vpc", *options.Name)
+		assert.Equal(t, "ghain-1", *options.VCSRepo.GHAInstallationID)
+		assert.Nil(t, options.VCSRepo.OAuthTokenID)
+		assert.True(t, *options.VCSRepo.Tags)
+		assert.Equal(t, "modules/vpc", *options.VCSRepo.SourceDirectory)
+		assert.Equal(t, "vpc/", *options.VCSRepo.TagPrefix)
+
+		options = privateModuleVCSOptions(newRequest(nil), "my-org", "", "", "my-org/terraform-aws-vpc", "ot-1", "", "main", "0.1.0")
+		assert.Nil(t, options.Name)
+		assert.Equal(t, "ot-1", *options.VCSRepo.OAuthTokenID)
+		assert.Equal(t, "main", *options.VCSRepo.Branch)
+		assert.Nil(t, options.VCSRepo.Tags)
+		assert.Equal(t, "0.1.0", *options.InitialVersion)
+	})
+
+	t.Run("summaries", func(t *testing.T) {
+		summary := privateModuleSummary(&tfe.RegistryModule{
+			ID:                  "mod-1",
+			Name:                "vpc",
+			Provider:            "aws",
+			Namespace:           "my-org",
+			PublishingMechanism: tfe.PublishingMechanismBranch,
+			Status:              tfe.RegistryModuleStatusSetupComplete,
+			VCSRepo:             &tfe.VCSRepo{Identifier: "my-org/terraform-aws-vpc", Branch: "main"},
+		})
+		assert.Equal(t, &PrivateModuleSummary{
+			ID:                  "mod-1",
+			PrivateModuleID:     "my-org/vpc/aws",
+			Name:                "vpc",
+			Provider:            "aws",
+			PublishingMechanism: "branch",
+			Status:              "setup_complete",
+			VCSRepo:             "my-org/terraform-aws-vpc",
+			Branch:              "main",
+		}, summary)
+
+		upload := privateModuleVersionUpload("my-org/vpc/aws", &tfe.RegistryModuleVersion{
+			ID:      "modver-1",
+			Version: "1.0.0",
+			Status:  tfe.RegistryModuleVersionStatusPending,
+			Links:   map[string]interface{}{"upload": "https://archivist.example.com/upload"},
+		})
+		assert.Equal(t, "https://archivist.example.com/upload", upload.UploadURL)
+		assert.False(t, upload.Uploaded)
+	})
+}
@@ -0,0 +1,577 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// GPGKeySummary is a GPG public key of the private registry, used to verify the signature of the
+// provider versions published with its key ID
+type GPGKeySummary struct {
+	ID        string `json:"id"`
+	KeyID     string `json:"key_id"`
+	Namespace string `json:"namespace"`
+	Source    string `json:"source,omitempty"`
+	CreatedAt string `json:"created_at,omitempty"`
+}
+
+// PrivateProviderVersionUploads is a version of a private provider with the URLs its SHA256SUMS
+// file, its signature and the binary of each platform are uploaded to. An upload URL is only
+// returned until the file is uploaded.
+type PrivateProviderVersionUploads struct {
+	ID                  string                           `json:"id"`
+	Provider            string                           `json:"provider"`
+	Version             string                           `json:"version"`
+	KeyID               string                           `json:"key_id"`
+	Protocols           []string                         `json:"protocols"`
+	ShasumsUploaded     bool                             `json:"shasums_uploaded"`
+	ShasumsSigUploaded  bool                             `json:"shasums_sig_uploaded"`
+	ShasumsUploadURL    string                           `json:"shasums_upload_url,omitempty"`
+	ShasumsSigUploadURL string                           `json:"shasums_sig_upload_url,omitempty"`
+	Platforms           []*PrivateProviderPlatformUpload `json:"platforms,omitempty"`
+}
+
+// PrivateProviderPlatformUpload is a platform of a private provider version with the URL its binary
+// is uploaded to
+type PrivateProviderPlatformUpload struct {
+	ID              string `json:"id"`
+	OS              string `json:"os"`
+	Arch            string `json:"arch"`
+	Filename        string `json:"filename"`
+	Shasum          string `json:"shasum"`
+	BinaryUploaded  bool   `json:"binary_uploaded"`
+	BinaryUploadURL string `json:"binary_upload_url,omitempty"`
+}
+
+var providerProtocols = []string{"5.0", "6.0"}
+
+// ListRegistryGPGKeys creates a tool to list the GPG keys of the private registry of an organization.
+func ListRegistryGPGKeys(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("list_registry_gpg_keys",
+			mcp.WithDescription(`Lists the GPG public keys of the private registry of an organization. Private provider versions are signed with one of these keys and reference it by its key ID.`),
+			mcp.WithTitleAnnotation("List the GPG keys of the private registry"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("terraform_org_name",
+				mcp.Required(),
+				mcp.Description("The Terraform Cloud/Enterprise organization name"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return listRegistryGPGKeysHandler(ctx, request, logger)
+		},
+	}
+}
+
+// CreateRegistryGPGKey creates a tool to add a GPG public key to the private registry of an organization.
+func CreateRegistryGPGKey(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("create_registry_gpg_key",
+			mcp.WithDescription(`Adds a GPG public key to the private registry of an organization, to verify the signature of the SHA256SUMS file of private provider versions. Returns the key ID to pass to create_private_provider_version. Only the ASCII-armored public key is accepted, never the private key.`),
+			mcp.WithTitleAnnotation("Add a GPG key to the private registry"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(false),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("terraform_org_name",
+				mcp.Required(),
+				mcp.Description("The Terraform Cloud/Enterprise organization name"),
+			),
+			mcp.WithString("ascii_armor",
+				mcp.Required(),
+				mcp.Description("The ASCII-armored GPG public key, as exported by 'gpg --armor --export', including its BEGIN and END lines"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return createRegistryGPGKeyHandler(ctx, request, logger)
+		},
+	}
+}
+
+// DeleteRegistryGPGKey creates a tool to delete a GPG key of the private registry of an organization.
+func DeleteRegistryGPGKey(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("delete_registry_gpg_key",
+			mcp.WithDescription(`Deletes a GPG key of the private registry of an organization. Provider versions signed with the key can no longer be installed, their signature cannot be verified anymore.`),
+			mcp.WithTitleAnnotation("Delete a GPG key of the private registry"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(false),
+			mcp.WithDestructiveHintAnnotation(true),
+			mcp.WithString("terraform_org_name",
+				mcp.Required(),
+				mcp.Description("The Terraform Cloud/Enterprise organization name"),
+			),
+			mcp.WithString("key_id",
+				mcp.Required(),
+				mcp.Description("The key ID of the GPG key, e.g. '32966F3FB5AC1129'"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return deleteRegistryGPGKeyHandler(ctx, request, logger)
+		},
+	}
+}
+
+// CreatePrivateProviderVersion creates a tool to create a version of a private provider.
+func CreatePrivateProviderVersion(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("create_private_provider_version",
+			mcp.WithDescription(`Creates a version of a private provider of the private registry and returns the URLs to upload its SHA256SUMS file and SHA256SUMS.sig signature to. The provider must exist in the registry and the signature must be made with the GPG key given by key_id.
+Add the binary of each platform with create_private_provider_platform afterwards. The version is installable once every file is uploaded.`),
+			mcp.WithTitleAnnotation("Create a version of a private provider"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(false),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("terraform_org_name",
+				mcp.Required(),
+				mcp.Description("The Terraform Cloud/Enterprise organization name, which is also the namespace of its private providers"),
+			),
+			mcp.WithString("private_provider_name",
+				mcp.Required(),
+				mcp.Description("The name of the private provider"),
+			),
+			mcp.WithString("version",
+				mcp.Required(),
+				mcp.Description("The semantic version to create, e.g. '1.2.0'"),
+			),
+			mcp.WithString("key_id",
+				mcp.Required(),
+				mcp.Description("The key ID of the GPG key of the registry that signs the SHA256SUMS file, from list_registry_gpg_keys"),
+			),
+			mcp.WithArray("protocols",
+				mcp.Description("The Terraform plugin protocol versions the provider supports. Defaults to 5.0"),
+				mcp.WithStringEnumItems(providerProtocols),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return createPrivateProviderVersionHandler(ctx, request, logger)
+		},
+	}
+}
+
+// CreatePrivateProviderPlatform creates a tool to add a platform to a version of a private provider.
+func CreatePrivateProviderPlatform(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("create_private_provider_platform",
+			mcp.WithDescription(`Adds a platform (operating system and architecture) to a version of a private provider and returns the URL to upload the zipped provider binary of the platform to. The shasum must match the line of the file in the SHA256SUMS file of the version.`),
+			mcp.WithTitleAnnotation("Add a platform to a private provider version"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(false),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("terraform_org_name",
+				mcp.Required(),
+				mcp.Description("The Terraform Cloud/Enterprise organization name, which is also the namespace of its private providers"),
+			),
+			mcp.WithString("private_provider_name",
+				mcp.Required(),
+				mcp.Description("The name of the private provider"),
+			),
+			mcp.WithString("version",
+				mcp.Required(),
+				mcp.Description("The version of the provider, created with create_private_provider_version"),
+			),
+			mcp.WithString("os",
+				mcp.Required(),
+				mcp.Description("The operating system of the binary, e.g. 'linux', 'darwin' or 'windows'"),
+			),
+			mcp.WithString("arch",
+				mcp.Required(),
+				mcp.Description("The architecture of the binary, e.g. 'amd64' or 'arm64'"),
+			),
+			mcp.WithString("filename",
+				mcp.Required(),
+				mcp.Description("The file name of the zipped binary, e.g. 'terraform-provider-example_1.2.0_linux_amd64.zip'"),
+			),
+			mcp.WithString("shasum",
+				mcp.Required(),
+				mcp.Description("The SHA256 checksum of the zipped binary, as a hex string"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return createPrivateProviderPlatformHandler(ctx, request, logger)
+		},
+	}
+}
+
+// GetPrivateProviderUploadURLs creates a tool to get the pending uploads of a version of a private provider.
+func GetPrivateProviderUploadURLs(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("get_private_provider_upload_urls",
+			mcp.WithDescription(`Returns which files of a version of a private provider are uploaded, and the URLs to upload the missing ones to: the SHA256SUMS file, its signature and the binary of each platform. Use this to resume a publication or check that a version is complete.`),
+			mcp.WithTitleAnnotation("Get the upload URLs of a private provider version"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("terraform_org_name",
+				mcp.Required(),
+				mcp.Description("The Terraform Cloud/Enterprise organization name, which is also the namespace of its private providers"),
+			),
+			mcp.WithString("private_provider_name",
+				mcp.Required(),
+				mcp.Description("The name of the private provider"),
+			),
+			mcp.WithString("version",
+				mcp.Required(),
+				mcp.Description("The version of the provider"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return getPrivateProviderUploadURLsHandler(ctx, request, logger)
+		},
+	}
+}
+
+func listRegistryGPGKeysHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	orgName, err := request.RequireString("terraform_org_name")
+	if err != nil {
+		return ToolError(logger, "missing required input: terraform_org_name", err)
+	}
+	orgName = strings.TrimSpace(orgName)
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+
+	var summaries []GPGKeySummary
+	options := tfe.GPGKeyListOptions{
+		ListOptions: tfe.ListOptions{PageNumber: 1, PageSize: 100},
+		Namespaces:  []string{orgName},
+	}
+	for {
+		page, err := tfeClient.GPGKeys.ListPrivate(ctx, options)
+		if err != nil {
+			return ToolErrorf(logger, "failed to list GPG keys of org '%s': %v", orgName, err)
+		}
+		for _, key := range page.Items {
+			summaries = append(summaries, gpgKeySummary(key))
+		}
+		if page.Pagination == nil || page.Pagination.NextPage == 0 {
+			break
+		}
+		options.PageNumber = page.Pagination.NextPage
+	}
+
+	if summaries == nil {
+		summaries = []GPGKeySummary{}
+	}
+	buf, err := json.Marshal(summaries)
+	if err != nil {
+		return ToolError(logger, "failed to marshal GPG keys", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+func createRegistryGPGKeyHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	orgName, err := request.RequireString("terraform_org_name")
+	if err != nil {
+		return ToolError(logger, "missing required input: terraform_org_name", err)
+	}
+	orgName = strings.TrimSpace(orgName)
+
+	asciiArmor, err := request.RequireString("ascii_armor")
+	if err != nil {
+		return ToolError(logger, "missing required input: ascii_armor", err)
+	}
+	if err := validateGPGPublicKey(asciiArmor); err != nil {
+		return ToolError(logger, err.Error(), nil)
+	}
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+
+	key, err := tfeClient.GPGKeys.Create(ctx, tfe.PrivateRegistry, tfe.GPGKeyCreateOptions{
+		Namespace:  orgName,
+		AsciiArmor: strings.TrimSpace(asciiArmor) + "\n",
+	})
+	if err != nil {
+		return ToolErrorf(logger, "failed to add GPG key to the private registry of org '%s': %v", orgName, err)
+	}
+
+	buf, err := json.Marshal(gpgKeySummary(key))
+	if err != nil {
+		return ToolError(logger, "failed to marshal GPG key", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+func deleteRegistryGPGKeyHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	orgName, err := request.RequireString("terraform_org_name")
+	if err != nil {
+		return ToolError(logger, "missing required input: terraform_org_name", err)
+	}
+	orgName = strings.TrimSpace(orgName)
+
+	keyID, err := request.RequireString("key_id")
+	if err != nil {
+		return ToolError(logger, "missing required input: key_id", err)
+	}
+	keyID = strings.TrimSpace(keyID)
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+
+	err = tfeClient.GPGKeys.Delete(ctx, tfe.GPGKeyID{
+		RegistryName: tfe.PrivateRegistry,
+		Namespace:    orgName,
+		KeyID:        keyID,
+	})
+	if err != nil {
+		return ToolErrorf(logger, "failed to delete GPG key '%s' of org '%s': %v", keyID, orgName, err)
+	}
+	return mcp.NewToolResultText(fmt.Sprintf("GPG key '%s' deleted from the private registry of org '%s'", keyID, orgName)), nil
+}
+
+func createPrivateProviderVersionHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	providerID, err := privateProviderID(request)
+	if err != nil {
+		return ToolError(logger, err.Error(), nil)
+	}
+
+	providerVersion, err := request.RequireString("version")
+	if err != nil {
+		return ToolError(logger, "missing required input: version", err)
+	}
+	providerVersion = strings.TrimPrefix(strings.TrimSpace(providerVersion), "v")
+	if _, err := version.NewSemver(providerVersion); err != nil {
+		return ToolErrorf(logger, "invalid version '%s': must be a semantic version such as 1.2.0", providerVersion)
+	}
+
+	keyID, err := request.RequireString("key_id")
+	if err != nil {
+		return ToolError(logger, "missing required input: key_id", err)
+	}
+	keyID = strings.TrimSpace(keyID)
+
+	protocols := request.GetStringSlice("protocols", []string{"5.0"})
+	for _, protocol := range protocols {
+		if !slices.Contains(providerProtocols, protocol) {
+			return ToolErrorf(logger, "invalid protocol '%s': must be one of %s", protocol, strings.Join(providerProtocols, ", "))
+		}
+	}
+	if len(protocols) == 0 {
+		protocols = []string{"5.0"}
+	}
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+
+	created, err := tfeClient.RegistryProviderVersions.Create(ctx, providerID, tfe.RegistryProviderVersionCreateOptions{
+		Version:   providerVersion,
+		KeyID:     keyID,
+		Protocols: protocols,
+	})
+	if err != nil {
+		return ToolErrorf(logger, "failed to create version %s of private provider %s/%s: %v", providerVersion, providerID.Namespace, providerID.Name, err)
+	}
+
+	buf, err := json.Marshal(providerVersionUploads(providerID, created, nil))
+	if err != nil {
+		return ToolError(logger, "failed to marshal provider version", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+func createPrivateProviderPlatformHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	versionID, err := privateProviderVersionID(request)
+	if err != nil {
+		return ToolError(logger, err.Error(), nil)
+	}
+
+	options := tfe.RegistryProviderPlatformCreateOptions{}
+	for _, input := range []struct {
+		name   string
+		target *string
+	}{{"os", &options.OS}, {"arch", &options.Arch}, {"filename", &options.Filename}, {"shasum", &options.Shasum}} {
+		value, err := request.RequireString(input.name)
+		if err != nil || strings.TrimSpace(value) == "" {
+			return ToolErrorf(logger, "missing required input: %s", input.name)
+		}
+		*input.target = strings.TrimSpace(value)
+	}
+	options.OS = strings.ToLower(options.OS)
+	options.Arch = strings.ToLower(options.Arch)
+	if !isSHA256(options.Shasum) {
+		return ToolErrorf(logger, "invalid shasum '%s': must be the 64 character hex SHA256 checksum of the zipped binary", options.Shasum)
+	}
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+
+	platform, err := tfeClient.RegistryProviderPlatforms.Create(ctx, versionID, options)
+	if err != nil {
+		return ToolErrorf(logger, "failed to add platform %s_%s to version %s of private provider %s/%s: %v", options.OS, options.Arch, versionID.Version, versionID.Namespace, versionID.Name, err)
+	}
+
+	buf, err := json.Marshal(providerPlatformUpload(platform))
+	if err != nil {
+		return ToolError(logger, "failed to marshal provider platform", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+func getPrivateProviderUploadURLsHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	versionID, err := privateProviderVersionID(request)
+	if err != nil {
+		return ToolError(logger, err.Error(), nil)
+	}
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+
+	providerVersion, err := tfeClient.RegistryProviderVersions.Read(ctx, versionID)
+	if err != nil {
+		return ToolErrorf(logger, "version %s of private provider %s/%s not found: %v", versionID.Version, versionID.Namespace, versionID.Name, err)
+	}
+
+	var platforms []*tfe.RegistryProviderPlatform
+	options := &tfe.RegistryProviderPlatformListOptions{ListOptions: tfe.ListOptions{PageNumber: 1, PageSize: 100}}
+	for {
+		page, err := tfeClient.RegistryProviderPlatforms.List(ctx, versionID, options)
+		if err != nil {
+			return ToolErrorf(logger, "failed to list the platforms of version %s of private provider %s/%s: %v", versionID.Version, versionID.Namespace, versionID.Name, err)
+		}
+		platforms = append(platforms, page.Items...)
+		if page.Pagination == nil || page.Pagination.NextPage == 0 {
+			break
+		}
+		options.PageNumber = page.Pagination.NextPage
+	}
+
+	buf, err := json.Marshal(providerVersionUploads(versionID.RegistryProviderID, providerVersion, platforms))
+	if err != nil {
+		return ToolError(logger, "failed to marshal provider version", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+// privateProviderID returns the ID of the private provider of the request. Private providers are
+// published in the namespace of their organization.
+func privateProviderID(request mcp.CallToolRequest) (tfe.RegistryProviderID, error) {
+	orgName, err := request.RequireString("terraform_org_name")
+	if err != nil || strings.TrimSpace(orgName) == "" {
+		return tfe.RegistryProviderID{}, fmt.Errorf("missing required input: terraform_org_name")
+	}
+	name, err := request.RequireString("private_provider_name")
+	if err != nil || strings.TrimSpace(name) == "" {
+		return tfe.RegistryProviderID{}, fmt.Errorf("missing required input: private_provider_name")
+	}
+	orgName = strings.TrimSpace(orgName)
+	return tfe.RegistryProviderID{
+		OrganizationName: orgName,
+		RegistryName:     tfe.PrivateRegistry,
+		Namespace:        orgName,
+		Name:             strings.TrimSpace(name),
+	}, nil
+}
+
+// privateProviderVersionID returns the ID of the private provider version of the request
+func privateProviderVersionID(request mcp.CallToolRequest) (tfe.RegistryProviderVersionID, error) {
+	providerID, err := privateProviderID(request)
+	if err != nil {
+		return tfe.RegistryProviderVersionID{}, err
+	}
+	providerVersion, err := request.RequireString("version")
+	if err != nil || strings.TrimSpace(providerVersion) == "" {
+		return tfe.RegistryProviderVersionID{}, fmt.Errorf("missing required input: version")
+	}
+	return tfe.RegistryProviderVersionID{
+		RegistryProviderID: providerID,
+		Version:            strings.TrimPrefix(strings.TrimSpace(providerVersion), "v"),
+	}, nil
+}
+
+func gpgKeySummary(key *tfe.GPGKey) GPGKeySummary {
+	summary := GPGKeySummary{
+		ID:        key.ID,
+		KeyID:     key.KeyID,
+		Namespace: key.Namespace,
+		Source:    key.Source,
+	}
+	if !key.CreatedAt.IsZero() {
+		summary.CreatedAt = key.CreatedAt.Format(time.RFC3339)
+	}
+	return summary
+}
+
+func providerVersionUploads(providerID tfe.RegistryProviderID, providerVersion *tfe.RegistryProviderVersion, platforms []*tfe.RegistryProviderPlatform) *PrivateProviderVersionUploads {
+	uploads := &PrivateProviderVersionUploads{
+		ID:                  providerVersion.ID,
+		Provider:            providerID.Namespace + "/" + providerID.Name,
+		Version:             providerVersion.Version,
+		KeyID:               providerVersion.KeyID,
+		Protocols:           providerVersion.Protocols,
+		ShasumsUploaded:     providerVersion.ShasumsUploaded,
+		ShasumsSigUploaded:  providerVersion.ShasumsSigUploaded,
+		ShasumsUploadURL:    uploadLink(providerVersion.Links, "shasums-upload"),
+		ShasumsSigUploadURL: uploadLink(providerVersion.Links, "shasums-sig-upload"),
+	}
+	for _, platform := range platforms {
+		uploads.Platforms = append(uploads.Platforms, providerPlatformUpload(platform))
+	}
+	return uploads
+}
+
+func providerPlatformUpload(platform *tfe.RegistryProviderPlatform) *PrivateProviderPlatformUpload {
+	return &PrivateProviderPlatformUpload{
+		ID:              platform.ID,
+		OS:              platform.OS,
+		Arch:            platform.Arch,
+		Filename:        platform.Filename,
+		Shasum:          platform.Shasum,
+		BinaryUploaded:  platform.ProviderBinaryUploaded,
+		BinaryUploadURL: uploadLink(platform.Links, "provider-binary-upload"),
+	}
+}
+
+// uploadLink returns an upload URL from the links of a registry object, empty once the file is uploaded
+func uploadLink(links map[string]interface{}, name string) string {
+	link, _ := links[name].(string)
+	return link
+}
+
+// validateGPGPublicKey checks that a value is an ASCII-armored GPG public key, so that a private key
+// is never sent to the registry by mistake
+func validateGPGPublicKey(value string) error {
+	value = strings.TrimSpace(value)
+	if strings.Contains(value, "PRIVATE KEY BLOCK") {
+		return fmt.Errorf("ascii_armor is a GPG private key - export the public key with 'gpg --armor --export' instead")
+	}
+	if !strings.HasPrefix(value, "-----BEGIN PGP PUBLIC KEY BLOCK-----") || !strings.HasSuffix(value, "-----END PGP PUBLIC KEY BLOCK-----") {
+		return fmt.Errorf("ascii_armor must be an ASCII-armored GPG public key, between '-----BEGIN PGP PUBLIC KEY BLOCK-----' and '-----END PGP PUBLIC KEY BLOCK-----' lines")
+	}
+	return nil
+}
+
+// isSHA256 reports whether a value is a hex-encoded SHA256 checksum
+func isSHA256(value string) bool {
+	decoded, err := hex.DecodeString(value)
+	return err == nil && len(decoded) == 32
+}
@@ -0,0 +1,34 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// sendProgress reports the progress of a long-running tool call to the client.
+// It is a no-op when the client didn't ask for progress notifications.
+func sendProgress(ctx context.Context, request mcp.CallToolRequest, progress, total int, message string, logger *log.Logger) {
+	if request.Params.Meta == nil || request.Params.Meta.ProgressToken == nil {
+		return
+	}
+	mcpServer := server.ServerFromContext(ctx)
+	if mcpServer == nil {
+		return
+	}
+
+	err := mcpServer.SendNotificationToClient(ctx, string(mcp.MethodNotificationProgress), map[string]any{
+		"progressToken": request.Params.Meta.ProgressToken,
+		"progress":      progress,
+		"total":         total,
+		"message":       message,
+	})
+	if err != nil {
+		logger.Debugf("failed to send progress notification: %v", err)
+	}
+}
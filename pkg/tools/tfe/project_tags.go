@@ -0,0 +1,258 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// ProjectTags are the key-value tags bound to a project. Workspaces of the project inherit them.
+type ProjectTags struct {
+	ProjectID string       `json:"project_id"`
+	Tags      []ProjectTag `json:"tags"`
+}
+
+// ProjectTag is a tag binding of a project
+type ProjectTag struct {
+	Key   string `json:"key"`
+	Value string `json:"value,omitempty"`
+}
+
+// CreateProjectTags creates a tool to add tags to a project.
+func CreateProjectTags(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("create_project_tags",
+			mcp.WithDescription("Add key-value tags to a Terraform project, or change the value of its existing tag keys. The workspaces of the project inherit its tags."),
+			mcp.WithTitleAnnotation("Add tags to a Terraform project"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(false),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("project_id",
+				mcp.Required(),
+				mcp.Description("Project ID, e.g. 'prj-abc123'"),
+			),
+			mcp.WithString("tags",
+				mcp.Required(),
+				mcp.Description("Comma-separated list of tag names to add, for key-value tags use key:value"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return createProjectTagsHandler(ctx, request, logger)
+		},
+	}
+}
+
+// ReadProjectTags creates a tool to read the tags of a project.
+func ReadProjectTags(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("read_project_tags",
+			mcp.WithDescription("Read the key-value tags of a Terraform project."),
+			mcp.WithTitleAnnotation("Read the tags of a Terraform project"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("project_id",
+				mcp.Required(),
+				mcp.Description("Project ID, e.g. 'prj-abc123'"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return readProjectTagsHandler(ctx, request, logger)
+		},
+	}
+}
+
+// DeleteProjectTags creates a tool to remove tags from a project.
+func DeleteProjectTags(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("delete_project_tags",
+			mcp.WithDescription("Remove tags from a Terraform project by key. The workspaces of the project no longer inherit them, which can change the variable sets, policies and run tasks selected by tag."),
+			mcp.WithTitleAnnotation("Remove tags from a Terraform project"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(false),
+			mcp.WithDestructiveHintAnnotation(true),
+			mcp.WithString("project_id",
+				mcp.Required(),
+				mcp.Description("Project ID, e.g. 'prj-abc123'"),
+			),
+			mcp.WithString("tag_keys",
+				mcp.Required(),
+				mcp.Description("Comma-separated list of the tag keys to remove"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return deleteProjectTagsHandler(ctx, request, logger)
+		},
+	}
+}
+
+func createProjectTagsHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	projectID, err := request.RequireString("project_id")
+	if err != nil {
+		return ToolError(logger, "missing required input: project_id", err)
+	}
+	projectID = strings.TrimSpace(projectID)
+	tagsStr, err := request.RequireString("tags")
+	if err != nil {
+		return ToolError(logger, "missing required input: tags", err)
+	}
+	tags := parseTagBindings(tagsStr)
+	if len(tags) == 0 {
+		return ToolError(logger, "tags must contain at least one tag", nil)
+	}
+
+	tfeClient, err := projectTagsClient(ctx, logger)
+	if err != nil {
+		return ToolError(logger, err.Error(), nil)
+	}
+
+	bindings, err := tfeClient.Projects.AddTagBindings(ctx, projectID, tfe.ProjectAddTagBindingsOptions{TagBindings: tags})
+	if err != nil {
+		return ToolErrorf(logger, "failed to add tags to project '%s': %v", projectID, err)
+	}
+	return projectTagsResult(projectID, bindings, logger)
+}
+
+func readProjectTagsHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	projectID, err := request.RequireString("project_id")
+	if err != nil {
+		return ToolError(logger, "missing required input: project_id", err)
+	}
+	projectID = strings.TrimSpace(projectID)
+
+	tfeClient, err := projectTagsClient(ctx, logger)
+	if err != nil {
+		return ToolError(logger, err.Error(), nil)
+	}
+
+	bindings, err := tfeClient.Projects.ListTagBindings(ctx, projectID)
+	if err != nil {
+		return ToolErrorf(logger, "failed to list the tags of project '%s': %v", projectID, err)
+	}
+	return projectTagsResult(projectID, bindings, logger)
+}
+
+func deleteProjectTagsHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	projectID, err := request.RequireString("project_id")
+	if err != nil {
+		return ToolError(logger, "missing required input: project_id", err)
+	}
+	projectID = strings.TrimSpace(projectID)
+	keysStr, err := request.RequireString("tag_keys")
+	if err != nil {
+		return ToolError(logger, "missing required input: tag_keys", err)
+	}
+	var keys []string
+	for _, key := range strings.Split(keysStr, ",") {
+		if key = strings.TrimSpace(key); key != "" {
+			keys = append(keys, key)
+		}
+	}
+	if len(keys) == 0 {
+		return ToolError(logger, "tag_keys must contain at least one key", nil)
+	}
+
+	tfeClient, err := projectTagsClient(ctx, logger)
+	if err != nil {
+		return ToolError(logger, err.Error(), nil)
+	}
+
+	bindings, err := tfeClient.Projects.ListTagBindings(ctx, projectID)
+	if err != nil {
+		return ToolErrorf(logger, "failed to list the tags of project '%s': %v", projectID, err)
+	}
+	remaining, missing := removeTagBindings(bindings, keys)
+	if len(missing) > 0 {
+		return ToolErrorf(logger, "project '%s' has no tags with the keys: %s", projectID, strings.Join(missing, ", "))
+	}
+
+	// The update replaces every tag binding of the project, but can't set none
+	if len(remaining) == 0 {
+		err = tfeClient.Projects.DeleteAllTagBindings(ctx, projectID)
+	} else {
+		_, err = tfeClient.Projects.Update(ctx, projectID, tfe.ProjectUpdateOptions{TagBindings: remaining})
+	}
+	if err != nil {
+		return ToolErrorf(logger, "failed to remove tags from project '%s': %v", projectID, err)
+	}
+	return projectTagsResult(projectID, remaining, logger)
+}
+
+// projectTagsClient returns the client of the request when its platform supports project tags
+func projectTagsClient(ctx context.Context, logger *log.Logger) (*tfe.Client, error) {
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return nil, err
+	}
+	if err := client.PlatformOf(tfeClient).Supports(client.FeatureTagBindings); err != nil {
+		return nil, err
+	}
+	return tfeClient, nil
+}
+
+func projectTagsResult(projectID string, bindings []*tfe.TagBinding, logger *log.Logger) (*mcp.CallToolResult, error) {
+	result := ProjectTags{ProjectID: projectID, Tags: make([]ProjectTag, 0, len(bindings))}
+	for _, binding := range bindings {
+		if binding != nil {
+			result.Tags = append(result.Tags, ProjectTag{Key: binding.Key, Value: binding.Value})
+		}
+	}
+	buf, err := json.Marshal(result)
+	if err != nil {
+		return ToolError(logger, "failed to marshal project tags", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+// parseTagBindings parses a comma-separated list of tag names and key:value tags
+func parseTagBindings(tagsStr string) []*tfe.TagBinding {
+	var tags []*tfe.TagBinding
+	for _, tagName := range strings.Split(strings.TrimSpace(tagsStr), ",") {
+		tagName = strings.TrimSpace(tagName)
+		if key, value, found := strings.Cut(tagName, ":"); found {
+			if key = strings.TrimSpace(key); key != "" {
+				tags = append(tags, &tfe.TagBinding{Key: key, Value: strings.TrimSpace(value)})
+			}
+			continue
+		}
+		if tagName != "" {
+			tags = append(tags, &tfe.TagBinding{Key: tagName})
+		}
+	}
+	return tags
+}
+
+// removeTagBindings returns the tag bindings without the given keys, and the keys that aren't bound
+func removeTagBindings(bindings []*tfe.TagBinding, keys []string) ([]*tfe.TagBinding, []string) {
+	remaining := make([]*tfe.TagBinding, 0, len(bindings))
+	found := make(map[string]bool)
+	for _, binding := range bindings {
+		if binding == nil {
+			continue
+		}
+		if slices.Contains(keys, binding.Key) {
+			found[binding.Key] = true
+			continue
+		}
+		// Only the key and value are sent back, the IDs of the bindings are assigned by the API
+		remaining = append(remaining, &tfe.TagBinding{Key: binding.Key, Value: binding.Value})
+	}
+	var missing []string
+	for _, key := range keys {
+		if !found[key] {
+			missing = append(missing, key)
+		}
+	}
+	return remaining, missing
+}
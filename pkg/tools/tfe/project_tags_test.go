@@ -0,0 +1,45 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTagBindings(t *testing.T) {
+	tags := parseTagBindings(" env:prod, team : platform ,critical,, :orphan")
+	assert.Equal(t, []*tfe.TagBinding{
+		{Key: "env", Value: "prod"},
+		{Key: "team", Value: "platform"},
+		{Key: "critical"},
+	}, tags)
+}
+
+func TestRemoveTagBindings(t *testing.T) {
+	bindings := []*tfe.TagBinding{
+		{ID: "tb-1", Key: "env", Value: "prod"},
+		{ID: "tb-2", Key: "team", Value: "platform"},
+		{ID: "tb-3", Key: "critical"},
+	}
+
+	t.Run("remaining bindings are sent without their IDs", func(t *testing.T) {
+		remaining, missing := removeTagBindings(bindings, []string{"team"})
+		assert.Empty(t, missing)
+		assert.Equal(t, []*tfe.TagBinding{{Key: "env", Value: "prod"}, {Key: "critical"}}, remaining)
+	})
+
+	t.Run("unknown keys are reported", func(t *testing.T) {
+		_, missing := removeTagBindings(bindings, []string{"env", "owner"})
+		assert.Equal(t, []string{"owner"}, missing)
+	})
+
+	t.Run("every binding removed", func(t *testing.T) {
+		remaining, missing := removeTagBindings(bindings, []string{"env", "team", "critical"})
+		assert.Empty(t, missing)
+		assert.Empty(t, remaining)
+	})
+}
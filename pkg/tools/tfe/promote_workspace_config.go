@@ -0,0 +1,276 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// WorkspacePromotion is the result of promoting the configuration of a workspace to another
+type WorkspacePromotion struct {
+	SourceWorkspace              string   `json:"source_workspace"`
+	SourceRunID                  string   `json:"source_run_id"`
+	SourceConfigurationVersionID string   `json:"source_configuration_version_id"`
+	TargetWorkspace              string   `json:"target_workspace"`
+	ConfigurationVersionID       string   `json:"configuration_version_id"`
+	RunID                        string   `json:"run_id"`
+	RunStatus                    string   `json:"run_status"`
+	OnlyInTarget                 []string `json:"variables_only_in_target,omitempty"`
+	ParityProblems               []string `json:"variable_parity_problems,omitempty"`
+}
+
+// PromoteWorkspaceConfig creates a tool to promote the applied configuration of a workspace to another workspace
+func PromoteWorkspaceConfig(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("promote_workspace_config",
+			mcp.WithDescription(`Promotes the configuration applied in a source workspace (e.g. staging) to a target workspace (e.g. prod): the configuration version of the source's latest applied run, or of source_run_id, is copied to the target as a new configuration version and a run is queued with it. The run waits to be confirmed, even when the target applies automatically.
+Variable parity is verified first: every variable key set on the source, directly or through variable sets, must also be set on the target, and every required variable of the configuration must be set on the target. Values are not compared, as they differ between environments.`),
+			mcp.WithTitleAnnotation("Promote a workspace's applied configuration to another workspace"),
+			mcp.WithReadOnlyHintAnnotation(false),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("terraform_org_name",
+				mcp.Required(),
+				mcp.Description("The Terraform Cloud/Enterprise organization name"),
+			),
+			mcp.WithString("source_workspace_name",
+				mcp.Required(),
+				mcp.Description("The workspace whose applied configuration is promoted, e.g. 'app-staging'"),
+			),
+			mcp.WithString("target_workspace_name",
+				mcp.Required(),
+				mcp.Description("The workspace to promote the configuration to, e.g. 'app-prod'"),
+			),
+			mcp.WithString("source_run_id",
+				mcp.Description("Optional applied run of the source workspace to promote the configuration of. Defaults to its latest applied run"),
+			),
+			mcp.WithBoolean("allow_variable_drift",
+				mcp.Description("Promote even if the variable parity check fails. Only set it after the user reviewed the reported differences"),
+				mcp.DefaultBool(false),
+			),
+			mcp.WithString("message",
+				mcp.Description("Optional message for the run, the source workspace and run are referenced in it"),
+			),
+			withOnBehalfOf(),
+		),
+		Handler: func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return promoteWorkspaceConfigHandler(ctx, req, logger)
+		},
+	}
+}
+
+func promoteWorkspaceConfigHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	terraformOrgName, err := request.RequireString("terraform_org_name")
+	if err != nil {
+		return ToolError(logger, "missing required input: terraform_org_name", err)
+	}
+	terraformOrgName = strings.TrimSpace(terraformOrgName)
+
+	sourceName, err := request.RequireString("source_workspace_name")
+	if err != nil {
+		return ToolError(logger, "missing required input: source_workspace_name", err)
+	}
+	sourceName = strings.TrimSpace(sourceName)
+
+	targetName, err := request.RequireString("target_workspace_name")
+	if err != nil {
+		return ToolError(logger, "missing required input: target_workspace_name", err)
+	}
+	targetName = strings.TrimSpace(targetName)
+	if sourceName == targetName {
+		return ToolError(logger, "source_workspace_name and target_workspace_name must be different workspaces", nil)
+	}
+
+	sourceRunID := strings.TrimSpace(request.GetString("source_run_id", ""))
+	allowDrift := request.GetBool("allow_variable_drift", false)
+	requester := onBehalfOf(request)
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+
+	source, err := tfeClient.Workspaces.Read(ctx, terraformOrgName, sourceName)
+	if err != nil {
+		return ToolErrorf(logger, "workspace '%s' not found in org '%s': %v", sourceName, terraformOrgName, err)
+	}
+	target, err := tfeClient.Workspaces.Read(ctx, terraformOrgName, targetName)
+	if err != nil {
+		return ToolErrorf(logger, "workspace '%s' not found in org '%s': %v", targetName, terraformOrgName, err)
+	}
+
+	sourceRun, err := appliedSourceRun(ctx, tfeClient, source, sourceRunID)
+	if err != nil {
+		return ToolError(logger, err.Error(), nil)
+	}
+	sourceCVID := sourceRun.ConfigurationVersion.ID
+
+	sendProgress(ctx, request, 1, 4, fmt.Sprintf("Downloading configuration version %s of run %s", sourceCVID, sourceRun.ID), logger)
+	archive, err := tfeClient.ConfigurationVersions.Download(ctx, sourceCVID)
+	if err != nil {
+		return ToolErrorf(logger, "failed to download configuration version %s: %v", sourceCVID, err)
+	}
+
+	sendProgress(ctx, request, 2, 4, fmt.Sprintf("Verifying variable parity between '%s' and '%s'", sourceName, targetName), logger)
+	sourceKeys, err := workspaceVariableKeys(ctx, tfeClient, source.ID)
+	if err != nil {
+		return ToolErrorf(logger, "failed to list the variables of workspace '%s': %v", sourceName, err)
+	}
+	targetKeys, err := workspaceVariableKeys(ctx, tfeClient, target.ID)
+	if err != nil {
+		return ToolErrorf(logger, "failed to list the variables of workspace '%s': %v", targetName, err)
+	}
+	problems, onlyInTarget := variableParity(sourceKeys, targetKeys)
+	if files, err := configurationFiles(archive, target.WorkingDirectory); err != nil {
+		logger.Warnf("failed to read configuration version %s, skipping required variable check: %v", sourceCVID, err)
+	} else if declared, err := declaredVariables(files); err != nil {
+		logger.Warnf("failed to parse configuration version %s, skipping required variable check: %v", sourceCVID, err)
+	} else {
+		problems = append(problems, unsetRequiredVariables(declared, targetKeys[tfe.CategoryTerraform])...)
+	}
+	if len(problems) > 0 && !allowDrift {
+		return ToolErrorf(logger, "variable parity check failed between '%s' and '%s': %s. Set the missing variables on '%s', or set allow_variable_drift after reviewing the differences with the user", sourceName, targetName, strings.Join(problems, "; "), targetName)
+	}
+
+	sendProgress(ctx, request, 3, 4, fmt.Sprintf("Uploading the configuration to '%s'", targetName), logger)
+	cv, err := tfeClient.ConfigurationVersions.Create(ctx, target.ID, tfe.ConfigurationVersionCreateOptions{
+		AutoQueueRuns: tfe.Bool(false),
+	})
+	if err != nil {
+		return ToolError(logger, "failed to create configuration version", err)
+	}
+	if err := tfeClient.ConfigurationVersions.UploadTarGzip(ctx, cv.UploadURL, bytes.NewReader(archive)); err != nil {
+		return ToolErrorf(logger, "failed to upload configuration version %s: %v", cv.ID, err)
+	}
+	readConfigurationVersion := func(ctx context.Context) (*tfe.ConfigurationVersion, error) {
+		return tfeClient.ConfigurationVersions.Read(ctx, cv.ID)
+	}
+	onStatus := func(time.Duration, tfe.ConfigurationStatus) {}
+	processed, err := waitForConfigurationVersion(ctx, cv.ID, readConfigurationVersion, defaultConfigurationWaitTimeout, configurationPollInterval, onStatus)
+	if err != nil {
+		return ToolErrorf(logger, "configuration version %s was not processed: %v", cv.ID, err)
+	}
+	if !processed.Usable {
+		return ToolErrorf(logger, "configuration version %s was not processed: %s", cv.ID, processed.Message)
+	}
+
+	message := fmt.Sprintf("Promoted from workspace %s run %s", sourceName, sourceRun.ID)
+	if custom := strings.TrimSpace(request.GetString("message", "")); custom != "" {
+		message = fmt.Sprintf("%s (promoted from workspace %s run %s)", custom, sourceName, sourceRun.ID)
+	}
+	message = annotateRequester(message, requester)
+
+	sendProgress(ctx, request, 4, 4, fmt.Sprintf("Queuing a run on '%s'", targetName), logger)
+	run, err := tfeClient.Runs.Create(ctx, tfe.RunCreateOptions{
+		Workspace:            target,
+		ConfigurationVersion: cv,
+		AutoApply:            tfe.Bool(false),
+		Message:              &message,
+	})
+	if err != nil {
+		return ToolErrorf(logger, "configuration version %s was uploaded but the run could not be created: %v", cv.ID, err)
+	}
+	auditLog(logger, "promote_workspace_config", requester, log.Fields{
+		"source_workspace_id":             source.ID,
+		"source_run_id":                   sourceRun.ID,
+		"source_configuration_version_id": sourceCVID,
+		"workspace_id":                    target.ID,
+		"run_id":                          run.ID,
+		"configuration_version_id":        cv.ID,
+		"variable_drift_allowed":          len(problems) > 0,
+	})
+
+	result := &WorkspacePromotion{
+		SourceWorkspace:              sourceName,
+		SourceRunID:                  sourceRun.ID,
+		SourceConfigurationVersionID: sourceCVID,
+		TargetWorkspace:              targetName,
+		ConfigurationVersionID:       cv.ID,
+		RunID:                        run.ID,
+		RunStatus:                    string(run.Status),
+		OnlyInTarget:                 onlyInTarget,
+		ParityProblems:               problems,
+	}
+	buf, err := json.Marshal(result)
+	if err != nil {
+		return ToolError(logger, "failed to marshal workspace promotion", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+// appliedSourceRun returns the given run of the source workspace, or its latest applied run, with
+// its configuration version
+func appliedSourceRun(ctx context.Context, tfeClient *tfe.Client, source *tfe.Workspace, runID string) (*tfe.Run, error) {
+	var run *tfe.Run
+	if runID != "" {
+		var err error
+		run, err = tfeClient.Runs.ReadWithOptions(ctx, runID, &tfe.RunReadOptions{
+			Include: []tfe.RunIncludeOpt{tfe.RunWorkspace},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("run not found: %s", runID)
+		}
+		if run.Workspace == nil || run.Workspace.ID != source.ID {
+			return nil, fmt.Errorf("run %s does not belong to workspace '%s'", runID, source.Name)
+		}
+		if run.Status != tfe.RunApplied {
+			return nil, fmt.Errorf("run %s is %s - only the configuration of applied runs can be promoted", runID, run.Status)
+		}
+	} else {
+		runs, err := tfeClient.Runs.List(ctx, source.ID, &tfe.RunListOptions{
+			Status:      string(tfe.RunApplied),
+			ListOptions: tfe.ListOptions{PageSize: 1},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list the applied runs of workspace '%s': %w", source.Name, err)
+		}
+		if len(runs.Items) == 0 {
+			return nil, fmt.Errorf("workspace '%s' has no applied run to promote", source.Name)
+		}
+		run = runs.Items[0]
+	}
+	if run.ConfigurationVersion == nil || run.ConfigurationVersion.ID == "" {
+		return nil, fmt.Errorf("run %s has no configuration version to promote", run.ID)
+	}
+	return run, nil
+}
+
+// variableParity reports the variables set on the source workspace but not on the target, and lists
+// the variables only set on the target. Keys are prefixed with their category, e.g. 'env:AWS_REGION'.
+func variableParity(source, target map[tfe.CategoryType]map[string]bool) (problems []string, onlyInTarget []string) {
+	for _, category := range []tfe.CategoryType{tfe.CategoryTerraform, tfe.CategoryEnv} {
+		for _, key := range sortedKeys(source[category]) {
+			if !target[category][key] {
+				problems = append(problems, fmt.Sprintf("%s variable %q is set on the source but not on the target", category, key))
+			}
+		}
+		for _, key := range sortedKeys(target[category]) {
+			if !source[category][key] {
+				onlyInTarget = append(onlyInTarget, fmt.Sprintf("%s:%s", category, key))
+			}
+		}
+	}
+	return problems, onlyInTarget
+}
+
+// unsetRequiredVariables reports the required variables of a configuration that are not set
+func unsetRequiredVariables(declared map[string]*declaredVariable, set map[string]bool) []string {
+	var problems []string
+	for _, name := range sortedKeys(declared) {
+		if declared[name].Required && !set[name] {
+			problems = append(problems, fmt.Sprintf("required variable %q is not set on the target", name))
+		}
+	}
+	return problems
+}
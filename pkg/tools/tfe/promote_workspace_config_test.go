@@ -0,0 +1,58 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-tfe"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPromoteWorkspaceConfig(t *testing.T) {
+	logger := log.New()
+	logger.SetLevel(log.ErrorLevel)
+
+	t.Run("tool creation", func(t *testing.T) {
+		tool := PromoteWorkspaceConfig(logger)
+
+		assert.Equal(t, "promote_workspace_config", tool.Tool.Name)
+		assert.False(t, *tool.Tool.Annotations.ReadOnlyHint)
+		assert.ElementsMatch(t, []string{"terraform_org_name", "source_workspace_name", "target_workspace_name"}, tool.Tool.InputSchema.Required)
+		assert.Contains(t, tool.Tool.InputSchema.Properties, "on_behalf_of")
+	})
+
+	t.Run("variable parity", func(t *testing.T) {
+		source := map[tfe.CategoryType]map[string]bool{
+			tfe.CategoryTerraform: {"region": true, "instance_count": true},
+			tfe.CategoryEnv:       {"AWS_ROLE_ARN": true},
+		}
+		target := map[tfe.CategoryType]map[string]bool{
+			tfe.CategoryTerraform: {"region": true, "alarm_email": true},
+		}
+
+		problems, onlyInTarget := variableParity(source, target)
+		assert.Equal(t, []string{
+			`terraform variable "instance_count" is set on the source but not on the target`,
+			`env variable "AWS_ROLE_ARN" is set on the source but not on the target`,
+		}, problems)
+		assert.Equal(t, []string{"terraform:alarm_email"}, onlyInTarget)
+
+		problems, onlyInTarget = variableParity(source, source)
+		assert.Empty(t, problems)
+		assert.Empty(t, onlyInTarget)
+	})
+
+	t.Run("required variables", func(t *testing.T) {
+		declared := map[string]*declaredVariable{
+			"region":         {Required: true},
+			"instance_count": {Required: false},
+			"db_password":    {Required: true},
+		}
+
+		assert.Equal(t, []string{`required variable "db_password" is not set on the target`}, unsetRequiredVariables(declared, map[string]bool{"region": true}))
+		assert.Len(t, unsetRequiredVariables(declared, nil), 2)
+	})
+}
@@ -0,0 +1,378 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclparse"
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	log "github.com/sirupsen/logrus"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// Module source types
+const (
+	ModuleSourceRoot     = "root"
+	ModuleSourceLocal    = "local"
+	ModuleSourceRegistry = "registry"
+	ModuleSourceRemote   = "remote"
+)
+
+// maxModuleDepth bounds how deep local module sources are followed, in case of cycles
+const maxModuleDepth = 10
+
+// ResourceOwnership attributes the managed resources of a workspace to the modules that declare them
+type ResourceOwnership struct {
+	WorkspaceName          string           `json:"workspace_name"`
+	StateVersionID         string           `json:"state_version_id"`
+	ConfigurationVersionID string           `json:"configuration_version_id,omitempty"`
+	Modules                []*ModuleOwner   `json:"modules"`
+	Resources              []*ResourceOwner `json:"resources"`
+	Warnings               []string         `json:"warnings,omitempty"`
+}
+
+// ModuleOwner is a module call of the configuration with the number of resources it owns
+type ModuleOwner struct {
+	Address           string `json:"address"`
+	Source            string `json:"source,omitempty"`
+	SourceType        string `json:"source_type"`
+	VersionConstraint string `json:"version_constraint,omitempty"`
+	ResourceCount     int    `json:"resource_count"`
+}
+
+// ResourceOwner is a managed resource of the state with the module call whose source declares it.
+// Owner differs from Module when the resource is declared by a child module of a registry or remote
+// module, whose configuration is not part of the workspace's configuration version.
+type ResourceOwner struct {
+	Address           string `json:"address"`
+	Type              string `json:"type"`
+	Instances         int    `json:"instances"`
+	Module            string `json:"module"`
+	Owner             string `json:"owner,omitempty"`
+	Source            string `json:"source,omitempty"`
+	VersionConstraint string `json:"version_constraint,omitempty"`
+}
+
+// moduleCall is a module block of the configuration
+type moduleCall struct {
+	Source  string
+	Version string
+}
+
+// GetWorkspaceResourceOwnership creates a tool to attribute the resources of a workspace to the modules that manage them.
+func GetWorkspaceResourceOwnership(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("get_workspace_resource_ownership",
+			mcp.WithDescription(`Attributes each managed resource in the current state of a workspace to the module that manages it, with the module's source (local path, registry module or remote address) and version constraint. Combines the module paths recorded in state with the module blocks of the workspace's current configuration version, following local modules.
+Use it to answer ownership questions such as "which module manages this security group". Resources declared inside a registry or remote module are attributed to that module call. The version is the constraint of the module block, not the version that was installed.`),
+			mcp.WithTitleAnnotation("Attribute the resources of a workspace to their modules"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("terraform_org_name",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+			mcp.WithString("workspace_name",
+				mcp.Required(),
+				mcp.Description("Workspace name"),
+			),
+			mcp.WithString("resource_type",
+				mcp.Description("Optional comma-separated list of resource types to return, e.g. 'aws_security_group'"),
+			),
+			mcp.WithString("address",
+				mcp.Description("Optional text that resource addresses must contain, e.g. 'aws_security_group.web'"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return getWorkspaceResourceOwnershipHandler(ctx, request, logger)
+		},
+	}
+}
+
+func getWorkspaceResourceOwnershipHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	orgName, err := request.RequireString("terraform_org_name")
+	if err != nil {
+		return ToolError(logger, "missing required input: terraform_org_name", err)
+	}
+	orgName = strings.TrimSpace(orgName)
+
+	workspaceName, err := request.RequireString("workspace_name")
+	if err != nil {
+		return ToolError(logger, "missing required input: workspace_name", err)
+	}
+	workspaceName = strings.TrimSpace(workspaceName)
+
+	filter := resourceOwnershipFilter{
+		types:   make(map[string]bool),
+		address: strings.TrimSpace(request.GetString("address", "")),
+	}
+	for _, resourceType := range strings.Split(request.GetString("resource_type", ""), ",") {
+		if resourceType = strings.TrimSpace(resourceType); resourceType != "" {
+			filter.types[resourceType] = true
+		}
+	}
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+
+	workspace, err := tfeClient.Workspaces.Read(ctx, orgName, workspaceName)
+	if err != nil {
+		return ToolErrorf(logger, "workspace '%s' not found in org '%s'", workspaceName, orgName)
+	}
+
+	sv, err := tfeClient.StateVersions.ReadCurrent(ctx, workspace.ID)
+	if err != nil {
+		return ToolErrorf(logger, "failed to read the current state version of workspace '%s': %v", workspaceName, err)
+	}
+	state, err := downloadState(ctx, tfeClient, sv)
+	if err != nil {
+		return ToolErrorf(logger, "failed to download state version %s: %v", sv.ID, err)
+	}
+
+	var warnings []string
+	var calls map[string]*moduleCall
+	cvID := ""
+	if workspace.CurrentConfigurationVersion == nil || workspace.CurrentConfigurationVersion.ID == "" {
+		warnings = append(warnings, "the workspace has no configuration version, module sources are unknown")
+	} else {
+		cvID = workspace.CurrentConfigurationVersion.ID
+		if archive, err := tfeClient.ConfigurationVersions.Download(ctx, cvID); err != nil {
+			logger.Warnf("failed to download configuration version %s: %v", cvID, err)
+			warnings = append(warnings, fmt.Sprintf("configuration version %s could not be downloaded, module sources are unknown", cvID))
+		} else if calls, err = configurationModules(archive, workspace.WorkingDirectory); err != nil {
+			logger.Warnf("failed to parse configuration version %s: %v", cvID, err)
+			warnings = append(warnings, fmt.Sprintf("configuration version %s could not be parsed, module sources are unknown", cvID))
+		}
+	}
+
+	result := resourceOwnership(state, calls, filter)
+	result.WorkspaceName = workspaceName
+	result.StateVersionID = sv.ID
+	result.ConfigurationVersionID = cvID
+	result.Warnings = append(warnings, result.Warnings...)
+
+	buf, err := json.Marshal(result)
+	if err != nil {
+		return ToolError(logger, "failed to marshal resource ownership", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+// resourceOwnershipFilter selects resources by type and address
+type resourceOwnershipFilter struct {
+	types   map[string]bool
+	address string
+}
+
+func (f resourceOwnershipFilter) matches(resource terraformStateResource, address string) bool {
+	if len(f.types) > 0 && !f.types[resource.Type] {
+		return false
+	}
+	return f.address == "" || strings.Contains(address, f.address)
+}
+
+// resourceOwnership attributes the managed resources of a state to the module calls of its configuration.
+// With no module calls, as when the configuration can't be read, resources only carry their module path.
+func resourceOwnership(state *terraformState, calls map[string]*moduleCall, filter resourceOwnershipFilter) *ResourceOwnership {
+	result := &ResourceOwnership{
+		Modules:   []*ModuleOwner{},
+		Resources: []*ResourceOwner{},
+	}
+	modules := make(map[string]*ModuleOwner)
+	undeclared := make(map[string]bool)
+
+	for _, resource := range state.Resources {
+		address := stateResourceAddress(resource)
+		if resource.Mode == "data" || !filter.matches(resource, address) {
+			continue
+		}
+
+		owner := &ResourceOwner{
+			Address:   address,
+			Type:      resource.Type,
+			Instances: len(resource.Instances),
+			Module:    resource.Module,
+		}
+		if owner.Module == "" {
+			owner.Module = ModuleSourceRoot
+		}
+		owner.Owner = moduleOwner(resource.Module, calls)
+		if owner.Owner == "" {
+			if calls != nil {
+				undeclared[resource.Module] = true
+			}
+			result.Resources = append(result.Resources, owner)
+			continue
+		}
+
+		module, ok := modules[owner.Owner]
+		if !ok {
+			module = &ModuleOwner{Address: owner.Owner, SourceType: ModuleSourceRoot}
+			if call, ok := calls[owner.Owner]; ok {
+				module.Source = call.Source
+				module.SourceType = moduleSourceType(call.Source)
+				module.VersionConstraint = call.Version
+			}
+			modules[owner.Owner] = module
+		}
+		module.ResourceCount++
+		owner.Source = module.Source
+		owner.VersionConstraint = module.VersionConstraint
+		result.Resources = append(result.Resources, owner)
+	}
+
+	for _, address := range sortedKeys(modules) {
+		result.Modules = append(result.Modules, modules[address])
+	}
+	for _, module := range sortedKeys(undeclared) {
+		result.Warnings = append(result.Warnings, fmt.Sprintf("%s is not declared by the current configuration version", module))
+	}
+	return result
+}
+
+// moduleInstanceKeyPattern matches the instance keys of a module path, e.g. [0] or ["a"]
+var moduleInstanceKeyPattern = regexp.MustCompile(`\[[^\]]*\]`)
+
+// moduleOwner returns the address of the innermost module call known for a state module path: the
+// module itself, or the registry or remote module that contains it. Resources of the root module are
+// owned by "root", and resources of undeclared modules by no one.
+func moduleOwner(modulePath string, calls map[string]*moduleCall) string {
+	if modulePath == "" {
+		return ModuleSourceRoot
+	}
+	if calls == nil {
+		return ""
+	}
+	address := moduleInstanceKeyPattern.ReplaceAllString(modulePath, "")
+	for {
+		if _, ok := calls[address]; ok {
+			return address
+		}
+		i := strings.LastIndex(address, ".module.")
+		if i < 0 {
+			return ""
+		}
+		address = address[:i]
+	}
+}
+
+// registryModuleSourcePattern matches registry module addresses such as 'terraform-aws-modules/vpc/aws',
+// 'app.terraform.io/example-corp/k8s-cluster/azurerm' or 'hashicorp/consul/aws//modules/consul-cluster'
+var registryModuleSourcePattern = regexp.MustCompile(`^([a-zA-Z0-9-]+(\.[a-zA-Z0-9-]+)+(:[0-9]+)?/)?[a-zA-Z0-9][a-zA-Z0-9_-]*/[a-zA-Z0-9][a-zA-Z0-9_-]*/[a-zA-Z0-9]+(//.*)?$`)
+
+// moduleSourceType classifies a module source as local, registry or remote
+func moduleSourceType(source string) string {
+	switch {
+	case strings.HasPrefix(source, "./") || strings.HasPrefix(source, "../"):
+		return ModuleSourceLocal
+	case strings.Contains(source, "::") || strings.Contains(source, "://") || strings.HasPrefix(source, "git@"),
+		strings.HasPrefix(source, "github.com/") || strings.HasPrefix(source, "bitbucket.org/"):
+		return ModuleSourceRemote
+	case registryModuleSourcePattern.MatchString(source):
+		return ModuleSourceRegistry
+	default:
+		return ModuleSourceRemote
+	}
+}
+
+// configurationModules returns the module calls of a configuration version archive keyed by their
+// address without instance keys, following local module sources within the archive
+func configurationModules(archive []byte, workingDirectory string) (map[string]*moduleCall, error) {
+	calls := make(map[string]*moduleCall)
+
+	var walk func(parent, dir string, depth int) error
+	walk = func(parent, dir string, depth int) error {
+		files, err := configurationFiles(archive, dir)
+		if err != nil {
+			return err
+		}
+		declared, err := moduleCalls(files)
+		if err != nil {
+			return err
+		}
+		for _, name := range sortedKeys(declared) {
+			address := "module." + name
+			if parent != "" {
+				address = parent + "." + address
+			}
+			call := declared[name]
+			calls[address] = call
+			if moduleSourceType(call.Source) == ModuleSourceLocal && depth < maxModuleDepth {
+				if err := walk(address, path.Join(dir, call.Source), depth+1); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+
+	if err := walk("", workingDirectory, 0); err != nil {
+		return nil, err
+	}
+	return calls, nil
+}
+
+var moduleBlockSchema = &hcl.BodySchema{
+	Blocks: []hcl.BlockHeaderSchema{{Type: "module", LabelNames: []string{"name"}}},
+}
+
+var moduleAttributesSchema = &hcl.BodySchema{
+	Attributes: []hcl.AttributeSchema{{Name: "source"}, {Name: "version"}},
+}
+
+// moduleCalls parses the module blocks of a set of Terraform files
+func moduleCalls(files map[string][]byte) (map[string]*moduleCall, error) {
+	parser := hclparse.NewParser()
+	calls := make(map[string]*moduleCall)
+
+	for _, name := range sortedKeys(files) {
+		file, diags := parseTerraformFile(parser, name, files[name])
+		if diags.HasErrors() {
+			return nil, diags
+		}
+
+		content, _, diags := file.Body.PartialContent(moduleBlockSchema)
+		if diags.HasErrors() {
+			return nil, diags
+		}
+		for _, block := range content.Blocks {
+			attrs, _, diags := block.Body.PartialContent(moduleAttributesSchema)
+			if diags.HasErrors() {
+				return nil, diags
+			}
+
+			call := &moduleCall{}
+			if attr, ok := attrs.Attributes["source"]; ok {
+				call.Source = literalString(attr.Expr)
+			}
+			if attr, ok := attrs.Attributes["version"]; ok {
+				call.Version = literalString(attr.Expr)
+			}
+			calls[block.Labels[0]] = call
+		}
+	}
+	return calls, nil
+}
+
+// literalString returns the value of a string literal expression, or an empty string
+func literalString(expr hcl.Expression) string {
+	value, diags := expr.Value(nil)
+	if diags.HasErrors() || value.IsNull() || !value.IsKnown() || value.Type() != cty.String {
+		return ""
+	}
+	return value.AsString()
+}
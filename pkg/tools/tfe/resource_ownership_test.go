@@ -0,0 +1,123 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const ownershipStateJSON = `{
+  "version": 4,
+  "resources": [
+    {"mode": "managed", "type": "aws_s3_bucket", "name": "logs", "instances": [{"attributes": {}}]},
+    {"mode": "data", "type": "aws_region", "name": "current", "instances": [{"attributes": {}}]},
+    {"module": "module.vpc", "mode": "managed", "type": "aws_vpc", "name": "this", "instances": [{"index_key": 0, "attributes": {}}]},
+    {"module": "module.vpc.module.endpoints", "mode": "managed", "type": "aws_vpc_endpoint", "name": "this", "instances": [{"attributes": {}}]},
+    {"module": "module.app[\"web\"]", "mode": "managed", "type": "aws_instance", "name": "this", "instances": [{"attributes": {}}]},
+    {"module": "module.app[\"web\"].module.sg", "mode": "managed", "type": "aws_security_group", "name": "this", "instances": [{"attributes": {}}]},
+    {"module": "module.legacy", "mode": "managed", "type": "aws_iam_role", "name": "this", "instances": [{"attributes": {}}]}
+  ]
+}`
+
+func TestConfigurationModules(t *testing.T) {
+	archive := newConfigurationArchive(t, map[string]string{
+		"./infra/main.tf": `
+module "vpc" {
+  source  = "terraform-aws-modules/vpc/aws"
+  version = "~> 5.0"
+}
+
+module "app" {
+  source   = "../modules/app"
+  for_each = toset(["web"])
+}
+`,
+		"./modules/app/main.tf": `
+module "sg" {
+  source  = "app.terraform.io/example-corp/security-group/aws"
+  version = "1.2.0"
+}
+`,
+	})
+
+	calls, err := configurationModules(archive, "infra")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]*moduleCall{
+		"module.vpc":           {Source: "terraform-aws-modules/vpc/aws", Version: "~> 5.0"},
+		"module.app":           {Source: "../modules/app"},
+		"module.app.module.sg": {Source: "app.terraform.io/example-corp/security-group/aws", Version: "1.2.0"},
+	}, calls)
+}
+
+func TestResourceOwnership(t *testing.T) {
+	var state terraformState
+	require.NoError(t, json.Unmarshal([]byte(ownershipStateJSON), &state))
+
+	calls := map[string]*moduleCall{
+		"module.vpc":           {Source: "terraform-aws-modules/vpc/aws", Version: "~> 5.0"},
+		"module.app":           {Source: "./modules/app"},
+		"module.app.module.sg": {Source: "app.terraform.io/example-corp/security-group/aws", Version: "1.2.0"},
+	}
+
+	t.Run("all resources", func(t *testing.T) {
+		result := resourceOwnership(&state, calls, resourceOwnershipFilter{})
+		require.Len(t, result.Resources, 6)
+
+		owners := make(map[string]*ResourceOwner)
+		for _, resource := range result.Resources {
+			owners[resource.Address] = resource
+		}
+		assert.Equal(t, "root", owners["aws_s3_bucket.logs"].Owner)
+		assert.Equal(t, "module.vpc", owners["module.vpc.module.endpoints.aws_vpc_endpoint.this"].Owner)
+		assert.Equal(t, "~> 5.0", owners["module.vpc.module.endpoints.aws_vpc_endpoint.this"].VersionConstraint)
+		assert.Equal(t, "module.app", owners[`module.app["web"].aws_instance.this`].Owner)
+		assert.Equal(t, "module.app.module.sg", owners[`module.app["web"].module.sg.aws_security_group.this`].Owner)
+		assert.Empty(t, owners["module.legacy.aws_iam_role.this"].Owner)
+
+		require.Len(t, result.Modules, 4)
+		assert.Equal(t, &ModuleOwner{Address: "module.app", Source: "./modules/app", SourceType: ModuleSourceLocal, ResourceCount: 1}, result.Modules[0])
+		assert.Equal(t, ModuleSourceRegistry, result.Modules[1].SourceType)
+		assert.Equal(t, &ModuleOwner{Address: "module.vpc", Source: "terraform-aws-modules/vpc/aws", SourceType: ModuleSourceRegistry, VersionConstraint: "~> 5.0", ResourceCount: 2}, result.Modules[2])
+		assert.Equal(t, &ModuleOwner{Address: "root", SourceType: ModuleSourceRoot, ResourceCount: 1}, result.Modules[3])
+		assert.Equal(t, []string{"module.legacy is not declared by the current configuration version"}, result.Warnings)
+	})
+
+	t.Run("filtered", func(t *testing.T) {
+		result := resourceOwnership(&state, calls, resourceOwnershipFilter{types: map[string]bool{"aws_security_group": true}})
+		require.Len(t, result.Resources, 1)
+		assert.Equal(t, "app.terraform.io/example-corp/security-group/aws", result.Resources[0].Source)
+		require.Len(t, result.Modules, 1)
+
+		result = resourceOwnership(&state, calls, resourceOwnershipFilter{address: "aws_vpc.this"})
+		require.Len(t, result.Resources, 1)
+		assert.Equal(t, "module.vpc", result.Resources[0].Module)
+	})
+
+	t.Run("unknown configuration", func(t *testing.T) {
+		result := resourceOwnership(&state, nil, resourceOwnershipFilter{})
+		require.Len(t, result.Resources, 6)
+		assert.Equal(t, "root", result.Resources[0].Owner)
+		assert.Empty(t, result.Resources[1].Owner)
+		assert.Empty(t, result.Warnings)
+	})
+}
+
+func TestModuleSourceType(t *testing.T) {
+	for source, expected := range map[string]string{
+		"./modules/network":                                     ModuleSourceLocal,
+		"../shared":                                             ModuleSourceLocal,
+		"terraform-aws-modules/vpc/aws":                         ModuleSourceRegistry,
+		"hashicorp/consul/aws//modules/consul-cluster":          ModuleSourceRegistry,
+		"app.terraform.io/example-corp/k8s-cluster/azurerm":     ModuleSourceRegistry,
+		"github.com/hashicorp/example":                          ModuleSourceRemote,
+		"git::https://example.com/network.git?ref=v1.2.0":       ModuleSourceRemote,
+		"s3::https://s3-eu-west-1.amazonaws.com/bucket/vpc.zip": ModuleSourceRemote,
+	} {
+		assert.Equal(t, expected, moduleSourceType(source), source)
+	}
+}
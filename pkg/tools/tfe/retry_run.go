@@ -0,0 +1,202 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// Error classes of an errored run
+const (
+	RunErrorTransient     = "transient"
+	RunErrorConfiguration = "configuration"
+	RunErrorUnknown       = "unknown"
+)
+
+// transientRunErrorPattern matches errors that are likely to succeed when retried, such as API throttling,
+// timeouts and temporary unavailability of a provider's API
+var transientRunErrorPattern = regexp.MustCompile(`(?i)(throttl|rate exceeded|rate limit|too many requests|\b429\b|\b50[234]\b|service unavailable|bad gateway|gateway time-?out|request ?limit ?exceeded|slow ?down|internal ?server ?error|connection reset|connection refused|i/o timeout|tls handshake timeout|context deadline exceeded|timeout while waiting|unexpected eof|temporarily unavailable|try again later|error acquiring the state lock)`)
+
+// configurationRunErrorPattern matches errors in the configuration or its inputs that fail again on a retry
+var configurationRunErrorPattern = regexp.MustCompile(`(?i)(unsupported (argument|attribute|block type)|missing required (argument|attribute)|invalid (reference|value|function argument|expression|resource type|attribute name|character|block definition|provider configuration)|reference to undeclared|undeclared (input )?variable|no value for required variable|module not installed|duplicate (resource|variable|output|module call)|incorrect attribute value type|unsupported terraform core version|inconsistent dependency lock file|argument or block definition required|cycle:)`)
+
+// RunRetry is the result of re-queuing an errored run
+type RunRetry struct {
+	OriginalRunID string `json:"original_run_id"`
+	ErroredPhase  string `json:"errored_phase"`
+	ErrorClass    string `json:"error_class"`
+	ErrorMessage  string `json:"error_message,omitempty"`
+	RunID         string `json:"run_id"`
+	RunStatus     string `json:"run_status"`
+}
+
+// RetryRun creates a tool to re-queue an errored run after a transient failure
+func RetryRun(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("retry_hcp_terraform_run",
+			mcp.WithDescription(`Re-queues an errored Terraform run with the same configuration version and run options. The errors in the plan or apply log are classified first: runs that failed on transient errors, such as provider API throttling or timeouts, are retried; runs that failed on configuration errors are not, because they fail again until the configuration is fixed.
+The new run waits to be confirmed, even if the original run applied automatically, and its message references the original run.`),
+			mcp.WithTitleAnnotation("Retry an errored Terraform run"),
+			mcp.WithReadOnlyHintAnnotation(false),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("run_id",
+				mcp.Required(),
+				mcp.Description("The ID of the errored run to retry"),
+			),
+			mcp.WithBoolean("force",
+				mcp.Description("Retry the run even if its errors are not classified as transient"),
+				mcp.DefaultBool(false),
+			),
+			mcp.WithString("message",
+				mcp.Description("Optional message for the new run, the original run is referenced in it"),
+			),
+			withOnBehalfOf(),
+		),
+		Handler: func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return retryRunHandler(ctx, req, logger)
+		},
+	}
+}
+
+func retryRunHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	runID, err := request.RequireString("run_id")
+	if err != nil {
+		return ToolError(logger, "missing required input: run_id", err)
+	}
+	runID = strings.TrimSpace(runID)
+	force := request.GetBool("force", false)
+	requester := onBehalfOf(request)
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+
+	run, err := tfeClient.Runs.ReadWithOptions(ctx, runID, &tfe.RunReadOptions{
+		Include: []tfe.RunIncludeOpt{tfe.RunPlan, tfe.RunApply, tfe.RunWorkspace, tfe.RunConfigVer},
+	})
+	if err != nil {
+		return ToolErrorf(logger, "run not found: %s", runID)
+	}
+	if run.Status != tfe.RunErrored {
+		return ToolErrorf(logger, "run %s is %s - only errored runs can be retried", runID, run.Status)
+	}
+	if run.Workspace == nil || run.ConfigurationVersion == nil {
+		return ToolErrorf(logger, "run %s has no workspace or configuration version to retry with", runID)
+	}
+
+	phase, logs, err := erroredRunLogs(ctx, tfeClient, run)
+	if err != nil {
+		return ToolError(logger, err.Error(), nil)
+	}
+
+	errorClass, errorMessage := classifyRunError(logs)
+	if errorClass != RunErrorTransient && !force {
+		return ToolErrorf(logger, "run %s failed during the %s with an error that is not transient (%s): %s. Fix the cause, or set force to retry anyway", runID, phase, errorClass, errorMessage)
+	}
+
+	message := request.GetString("message", "")
+	if message == "" {
+		message = run.Message
+	}
+	message = annotateRequester(retryRunMessage(runID, errorClass, message), requester)
+
+	retry, err := tfeClient.Runs.Create(ctx, retryRunOptions(run, message))
+	if err != nil {
+		return ToolError(logger, "failed to create run", err)
+	}
+	auditLog(logger, "retry_run", requester, log.Fields{
+		"workspace_id":    run.Workspace.ID,
+		"run_id":          retry.ID,
+		"original_run_id": runID,
+		"error_class":     errorClass,
+	})
+
+	buf, err := json.Marshal(&RunRetry{
+		OriginalRunID: runID,
+		ErroredPhase:  phase,
+		ErrorClass:    errorClass,
+		ErrorMessage:  errorMessage,
+		RunID:         retry.ID,
+		RunStatus:     string(retry.Status),
+	})
+	if err != nil {
+		return ToolError(logger, "failed to marshal run retry", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+// classifyRunError classifies the errors of a plan or apply log and returns the first error message.
+// Configuration errors take precedence, because a run with one fails again even if other errors were transient.
+func classifyRunError(logs []byte) (string, string) {
+	var messages []string
+	for _, entry := range parseRunLogs(logs) {
+		if isRunLogError(entry) {
+			messages = append(messages, strings.TrimSpace(entry.Message+" "+entry.Detail))
+		}
+	}
+	if len(messages) == 0 {
+		return RunErrorUnknown, ""
+	}
+
+	for _, message := range messages {
+		if configurationRunErrorPattern.MatchString(message) {
+			return RunErrorConfiguration, message
+		}
+	}
+	for _, message := range messages {
+		if transientRunErrorPattern.MatchString(message) {
+			return RunErrorTransient, message
+		}
+	}
+	return RunErrorUnknown, messages[0]
+}
+
+// isRunLogError reports whether a log entry is an error diagnostic
+func isRunLogError(entry *RunLogEntry) bool {
+	return entry.Level == "error" || strings.HasPrefix(strings.TrimSpace(entry.Message), "Error:")
+}
+
+// retryRunMessage references the original run in the message of its retry
+func retryRunMessage(runID string, errorClass string, message string) string {
+	retry := fmt.Sprintf("Retry of %s (%s error)", runID, errorClass)
+	if message == "" {
+		return retry
+	}
+	return fmt.Sprintf("%s: %s", retry, message)
+}
+
+// retryRunOptions creates a run equivalent to an errored run, with the same configuration version
+// and options, that waits to be confirmed
+func retryRunOptions(run *tfe.Run, message string) tfe.RunCreateOptions {
+	options := tfe.RunCreateOptions{
+		Workspace:            run.Workspace,
+		ConfigurationVersion: run.ConfigurationVersion,
+		Message:              &message,
+		AutoApply:            tfe.Bool(false),
+		IsDestroy:            tfe.Bool(run.IsDestroy),
+		Refresh:              tfe.Bool(run.Refresh),
+		RefreshOnly:          tfe.Bool(run.RefreshOnly),
+		AllowEmptyApply:      tfe.Bool(run.AllowEmptyApply),
+		TargetAddrs:          run.TargetAddrs,
+		ReplaceAddrs:         run.ReplaceAddrs,
+	}
+	if run.PlanOnly {
+		options.PlanOnly = tfe.Bool(true)
+	}
+	for _, variable := range run.Variables {
+		options.Variables = append(options.Variables, &tfe.RunVariable{Key: variable.Key, Value: variable.Value})
+	}
+	return options
+}
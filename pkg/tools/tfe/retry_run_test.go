@@ -0,0 +1,108 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-tfe"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryRun(t *testing.T) {
+	logger := log.New()
+	logger.SetLevel(log.ErrorLevel)
+
+	t.Run("tool creation", func(t *testing.T) {
+		tool := RetryRun(logger)
+
+		assert.Equal(t, "retry_hcp_terraform_run", tool.Tool.Name)
+		assert.False(t, *tool.Tool.Annotations.DestructiveHint)
+		assert.Equal(t, []string{"run_id"}, tool.Tool.InputSchema.Required)
+		assert.Contains(t, tool.Tool.InputSchema.Properties, "on_behalf_of")
+	})
+
+	t.Run("classify run errors", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			logs    string
+			class   string
+			message string
+		}{
+			{
+				name:    "throttled provider API",
+				logs:    `{"@level":"error","@message":"Error: creating EC2 Instance: operation error EC2: RunInstances, api error RequestLimitExceeded: Request limit exceeded.","type":"diagnostic"}`,
+				class:   RunErrorTransient,
+				message: "Error: creating EC2 Instance: operation error EC2: RunInstances, api error RequestLimitExceeded: Request limit exceeded.",
+			},
+			{
+				name:  "plain text timeout",
+				logs:  "Terraform v1.9.0\n\x1b[31mError: \x1b[0mreading S3 Bucket: dial tcp 52.0.0.1:443: i/o timeout\n",
+				class: RunErrorTransient,
+			},
+			{
+				name:  "configuration error",
+				logs:  `{"@level":"error","@message":"Error: Unsupported argument","diagnostic":{"detail":"An argument named \"nmae\" is not expected here."},"type":"diagnostic"}`,
+				class: RunErrorConfiguration,
+			},
+			{
+				name: "configuration error wins over a transient one",
+				logs: `{"@level":"error","@message":"Error: Too Many Requests","type":"diagnostic"}` + "\n" +
+					`{"@level":"error","@message":"Error: Reference to undeclared input variable","type":"diagnostic"}`,
+				class: RunErrorConfiguration,
+			},
+			{
+				name:    "unrecognized error",
+				logs:    "Error: the server exploded\n",
+				class:   RunErrorUnknown,
+				message: "Error: the server exploded",
+			},
+			{
+				name:  "no error in the logs",
+				logs:  `{"@level":"info","@message":"Plan: 1 to add, 0 to change, 0 to destroy.","type":"change_summary"}`,
+				class: RunErrorUnknown,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				class, message := classifyRunError([]byte(tt.logs))
+				assert.Equal(t, tt.class, class)
+				if tt.message != "" {
+					assert.Equal(t, tt.message, message)
+				}
+			})
+		}
+	})
+
+	t.Run("retry run options", func(t *testing.T) {
+		run := &tfe.Run{
+			ID:                   "run-123",
+			Workspace:            &tfe.Workspace{ID: "ws-123"},
+			ConfigurationVersion: &tfe.ConfigurationVersion{ID: "cv-123"},
+			AutoApply:            true,
+			PlanOnly:             true,
+			Refresh:              true,
+			TargetAddrs:          []string{"aws_instance.web"},
+			Variables:            []*tfe.RunVariableAttr{{Key: "region", Value: `"us-east-1"`}},
+		}
+
+		options := retryRunOptions(run, "Retry of run-123 (transient error): Deploy")
+		assert.Equal(t, "ws-123", options.Workspace.ID)
+		assert.Equal(t, "cv-123", options.ConfigurationVersion.ID)
+		assert.False(t, *options.AutoApply)
+		assert.True(t, *options.PlanOnly)
+		assert.True(t, *options.Refresh)
+		assert.False(t, *options.IsDestroy)
+		assert.Equal(t, []string{"aws_instance.web"}, options.TargetAddrs)
+		assert.Equal(t, []*tfe.RunVariable{{Key: "region", Value: `"us-east-1"`}}, options.Variables)
+		assert.Equal(t, "Retry of run-123 (transient error): Deploy", *options.Message)
+	})
+
+	t.Run("retry run message", func(t *testing.T) {
+		assert.Equal(t, "Retry of run-123 (transient error): Deploy", retryRunMessage("run-123", RunErrorTransient, "Deploy"))
+		assert.Equal(t, "Retry of run-123 (unknown error)", retryRunMessage("run-123", RunErrorUnknown, ""))
+	})
+}
@@ -0,0 +1,140 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// RunComment is a comment posted on a run, such as an approval note or the explanation of a change
+type RunComment struct {
+	ID   string `json:"comment_id"`
+	Body string `json:"body"`
+}
+
+// ListRunComments creates a tool to list the comments of a run.
+func ListRunComments(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("list_run_comments",
+			mcp.WithDescription(`Lists the comments posted on a Terraform run, in the order they were posted. They include the approval notes and explanations recorded with create_run_comment, and the comments of the users who applied or discarded the run.`),
+			mcp.WithTitleAnnotation("List the comments of a Terraform run"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("run_id",
+				mcp.Required(),
+				mcp.Description("The ID of the run"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return listRunCommentsHandler(ctx, request, logger)
+		},
+	}
+}
+
+// CreateRunComment creates a tool to post a comment on a run.
+func CreateRunComment(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("create_run_comment",
+			mcp.WithDescription(`Posts a comment on a Terraform run, to record a human approval note, the reason for a change or the explanation of an agent alongside the run, including after it was applied or discarded. The comment is visible to everyone who can read the run.`),
+			mcp.WithTitleAnnotation("Comment on a Terraform run"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(false),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("run_id",
+				mcp.Required(),
+				mcp.Description("The ID of the run to comment on"),
+			),
+			mcp.WithString("body",
+				mcp.Required(),
+				mcp.Description("The text of the comment"),
+			),
+			withOnBehalfOf(),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return createRunCommentHandler(ctx, request, logger)
+		},
+	}
+}
+
+func listRunCommentsHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	runID, err := request.RequireString("run_id")
+	if err != nil {
+		return ToolError(logger, "missing required input: run_id", err)
+	}
+	runID = strings.TrimSpace(runID)
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+
+	comments, err := tfeClient.Comments.List(ctx, runID)
+	if err != nil {
+		return ToolErrorf(logger, "failed to list the comments of run %s: %v", runID, err)
+	}
+
+	buf, err := json.Marshal(runComments(comments))
+	if err != nil {
+		return ToolError(logger, "failed to marshal run comments", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+func createRunCommentHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	runID, err := request.RequireString("run_id")
+	if err != nil {
+		return ToolError(logger, "missing required input: run_id", err)
+	}
+	runID = strings.TrimSpace(runID)
+	body, err := request.RequireString("body")
+	if err != nil || strings.TrimSpace(body) == "" {
+		return ToolError(logger, "missing required input: body", err)
+	}
+	requester := onBehalfOf(request)
+	body = annotateRequester(strings.TrimSpace(body), requester)
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+
+	comment, err := tfeClient.Comments.Create(ctx, runID, tfe.CommentCreateOptions{Body: body})
+	if err != nil {
+		return ToolErrorf(logger, "failed to comment on run %s: %v", runID, err)
+	}
+	auditLog(logger, "comment_run", requester, log.Fields{
+		"run_id":     runID,
+		"comment_id": comment.ID,
+	})
+
+	buf, err := json.Marshal(RunComment{ID: comment.ID, Body: comment.Body})
+	if err != nil {
+		return ToolError(logger, "failed to marshal run comment", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+// runComments returns the comments of a run
+func runComments(list *tfe.CommentList) []RunComment {
+	comments := make([]RunComment, 0)
+	if list == nil {
+		return comments
+	}
+	for _, comment := range list.Items {
+		if comment == nil {
+			continue
+		}
+		comments = append(comments, RunComment{ID: comment.ID, Body: comment.Body})
+	}
+	return comments
+}
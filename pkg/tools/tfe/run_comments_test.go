@@ -0,0 +1,45 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-tfe"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunComments(t *testing.T) {
+	t.Run("comments keep their order", func(t *testing.T) {
+		comments := runComments(&tfe.CommentList{Items: []*tfe.Comment{
+			{ID: "wsc-1", Body: "Approved by the platform team"},
+			nil,
+			{ID: "wsc-2", Body: "Applied after the maintenance window"},
+		}})
+		assert.Equal(t, []RunComment{
+			{ID: "wsc-1", Body: "Approved by the platform team"},
+			{ID: "wsc-2", Body: "Applied after the maintenance window"},
+		}, comments)
+	})
+
+	t.Run("runs without comments", func(t *testing.T) {
+		assert.Empty(t, runComments(nil))
+		assert.NotNil(t, runComments(nil))
+	})
+
+	t.Run("only posting a comment changes the run", func(t *testing.T) {
+		logger := log.New()
+		list := ListRunComments(logger).Tool
+		require.NotNil(t, list.Annotations.ReadOnlyHint)
+		assert.True(t, *list.Annotations.ReadOnlyHint)
+
+		create := CreateRunComment(logger).Tool
+		require.NotNil(t, create.Annotations.ReadOnlyHint)
+		assert.False(t, *create.Annotations.ReadOnlyHint)
+		assert.Contains(t, create.InputSchema.Required, "body")
+		assert.Contains(t, create.InputSchema.Properties, "on_behalf_of")
+	})
+}
@@ -0,0 +1,160 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// maxDiagnosisErrors bounds the error entries of a run diagnosis
+const maxDiagnosisErrors = 10
+
+// RunDiagnosis is the root-cause summary of an errored run
+type RunDiagnosis struct {
+	RunID         string         `json:"run_id"`
+	Organization  string         `json:"organization,omitempty"`
+	WorkspaceName string         `json:"workspace_name,omitempty"`
+	RunMessage    string         `json:"run_message,omitempty"`
+	RunURL        string         `json:"run_url,omitempty"`
+	ErroredPhase  string         `json:"errored_phase"`
+	ErrorClass    string         `json:"error_class"`
+	RootCause     string         `json:"root_cause,omitempty"`
+	Errors        []*RunLogEntry `json:"errors"`
+	Suggestion    string         `json:"suggestion"`
+}
+
+// DiagnoseRun creates a tool to summarize the root cause of an errored run
+func DiagnoseRun(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("diagnose_hcp_terraform_run",
+			mcp.WithDescription(`Diagnoses an errored Terraform run: reads the log of the phase that errored, extracts its error diagnostics with the affected resources, classifies them as transient (e.g. provider API throttling) or configuration errors, and returns the root cause with a suggested next step. Use it instead of reading the whole plan or apply log.`),
+			mcp.WithTitleAnnotation("Diagnose an errored Terraform run"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("run_id",
+				mcp.Required(),
+				mcp.Description("The ID of the errored run to diagnose"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return diagnoseRunHandler(ctx, request, logger)
+		},
+	}
+}
+
+func diagnoseRunHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	runID, err := request.RequireString("run_id")
+	if err != nil {
+		return ToolError(logger, "missing required input: run_id", err)
+	}
+	runID = strings.TrimSpace(runID)
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+
+	diagnosis, err := diagnoseErroredRun(ctx, tfeClient, runID)
+	if err != nil {
+		return ToolError(logger, err.Error(), nil)
+	}
+
+	buf, err := json.Marshal(diagnosis)
+	if err != nil {
+		return ToolError(logger, "failed to marshal run diagnosis", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+// diagnoseErroredRun reads an errored run and the log of the phase that errored, and summarizes its errors
+func diagnoseErroredRun(ctx context.Context, tfeClient *tfe.Client, runID string) (*RunDiagnosis, error) {
+	run, err := tfeClient.Runs.ReadWithOptions(ctx, runID, &tfe.RunReadOptions{
+		Include: []tfe.RunIncludeOpt{tfe.RunPlan, tfe.RunApply, tfe.RunWorkspace},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("run not found: %s", runID)
+	}
+	if run.Status != tfe.RunErrored {
+		return nil, fmt.Errorf("run %s is %s - only errored runs can be diagnosed", runID, run.Status)
+	}
+
+	phase, logs, err := erroredRunLogs(ctx, tfeClient, run)
+	if err != nil {
+		return nil, err
+	}
+
+	diagnosis := runDiagnosis(run, phase, logs)
+	if run.Workspace != nil && run.Workspace.Organization != nil {
+		base := tfeClient.BaseURL()
+		diagnosis.Organization = run.Workspace.Organization.Name
+		diagnosis.WorkspaceName = run.Workspace.Name
+		diagnosis.RunURL = fmt.Sprintf("%s://%s/app/%s/workspaces/%s/runs/%s", base.Scheme, base.Host, diagnosis.Organization, diagnosis.WorkspaceName, run.ID)
+	}
+	return diagnosis, nil
+}
+
+// erroredRunLogs returns the phase of a run that errored, "plan" or "apply", and its log
+func erroredRunLogs(ctx context.Context, tfeClient *tfe.Client, run *tfe.Run) (string, []byte, error) {
+	phase := "plan"
+	var logReader io.Reader
+	var err error
+	if run.Apply != nil && run.Apply.Status == tfe.ApplyErrored {
+		phase = "apply"
+		logReader, err = tfeClient.Applies.Logs(ctx, run.Apply.ID)
+	} else if run.Plan != nil {
+		logReader, err = tfeClient.Plans.Logs(ctx, run.Plan.ID)
+	} else {
+		err = fmt.Errorf("run has no plan")
+	}
+	if err != nil {
+		return phase, nil, fmt.Errorf("failed to retrieve the %s logs of run %s: %w", phase, run.ID, err)
+	}
+	logs, err := io.ReadAll(logReader)
+	if err != nil {
+		return phase, nil, fmt.Errorf("failed to read the %s logs of run %s: %w", phase, run.ID, err)
+	}
+	return phase, logs, nil
+}
+
+// runDiagnosis classifies the errors of the log of a run's errored phase
+func runDiagnosis(run *tfe.Run, phase string, logs []byte) *RunDiagnosis {
+	errorClass, rootCause := classifyRunError(logs)
+	diagnosis := &RunDiagnosis{
+		RunID:        run.ID,
+		RunMessage:   run.Message,
+		ErroredPhase: phase,
+		ErrorClass:   errorClass,
+		RootCause:    rootCause,
+		Errors:       []*RunLogEntry{},
+	}
+	for _, entry := range parseRunLogs(logs) {
+		if isRunLogError(entry) {
+			diagnosis.Errors = append(diagnosis.Errors, entry)
+			if len(diagnosis.Errors) == maxDiagnosisErrors {
+				break
+			}
+		}
+	}
+
+	switch errorClass {
+	case RunErrorTransient:
+		diagnosis.Suggestion = "The run failed on a transient error. Retry it with retry_hcp_terraform_run."
+	case RunErrorConfiguration:
+		diagnosis.Suggestion = fmt.Sprintf("The configuration or its inputs are invalid. Fix the error reported in the %s and queue a new run; retrying the run fails again.", phase)
+	default:
+		diagnosis.Suggestion = fmt.Sprintf("Review the errors of the %s log, for example with get_%s_logs, to find the cause.", phase, phase)
+	}
+	return diagnosis
+}
@@ -0,0 +1,157 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// Log formats supported by the plan and apply log tools
+const (
+	LogFormatText   = "text"
+	LogFormatParsed = "parsed"
+	LogFormatRaw    = "raw"
+)
+
+// ansiEscapePattern matches ANSI color and cursor control sequences
+var ansiEscapePattern = regexp.MustCompile(`\x1b\[[0-9;?]*[a-zA-Z]`)
+
+// withLogFormat adds the "log_format" parameter to a log tool.
+func withLogFormat() mcp.ToolOption {
+	return mcp.WithString("log_format",
+		mcp.Description("Log format: 'text' (default) strips color codes and renders structured log lines as timestamped messages, 'parsed' returns a JSON array of entries with level, message, type and resource address, 'raw' returns the logs unchanged"),
+		mcp.Enum(LogFormatText, LogFormatParsed, LogFormatRaw),
+		mcp.DefaultString(LogFormatText),
+	)
+}
+
+// RunLogEntry is a single entry of a plan or apply log
+type RunLogEntry struct {
+	Timestamp       string `json:"timestamp,omitempty"`
+	Level           string `json:"level,omitempty"`
+	Message         string `json:"message"`
+	Type            string `json:"type,omitempty"`
+	ResourceAddress string `json:"resource_address,omitempty"`
+	Detail          string `json:"detail,omitempty"`
+}
+
+// structuredLogLine is a line of Terraform's machine readable UI output (-json)
+type structuredLogLine struct {
+	Level     string `json:"@level"`
+	Message   string `json:"@message"`
+	Timestamp string `json:"@timestamp"`
+	Type      string `json:"type"`
+	Hook      *struct {
+		Resource struct {
+			Addr string `json:"addr"`
+		} `json:"resource"`
+	} `json:"hook"`
+	Change *struct {
+		Resource struct {
+			Addr string `json:"addr"`
+		} `json:"resource"`
+	} `json:"change"`
+	Diagnostic *struct {
+		Detail  string `json:"detail"`
+		Address string `json:"address"`
+	} `json:"diagnostic"`
+}
+
+// formatRunLogs renders plan or apply logs in the requested log format
+func formatRunLogs(logs []byte, format string) (string, error) {
+	switch format {
+	case "", LogFormatText:
+		entries := parseRunLogs(logs)
+		var b strings.Builder
+		for _, entry := range entries {
+			if entry.Timestamp != "" {
+				fmt.Fprintf(&b, "%s [%s] %s\n", entry.Timestamp, entry.Level, entry.Message)
+			} else {
+				fmt.Fprintln(&b, entry.Message)
+			}
+			if entry.Detail != "" {
+				fmt.Fprintln(&b, entry.Detail)
+			}
+		}
+		return b.String(), nil
+	case LogFormatParsed:
+		buf, err := json.Marshal(parseRunLogs(logs))
+		if err != nil {
+			return "", err
+		}
+		return string(buf), nil
+	case LogFormatRaw:
+		return string(logs), nil
+	default:
+		return "", fmt.Errorf("log_format must be one of '%s', '%s' or '%s', got %q", LogFormatText, LogFormatParsed, LogFormatRaw, format)
+	}
+}
+
+// parseRunLogs splits logs into entries. Structured JSON lines are decoded, plain lines are kept
+// as messages. Color codes and the STX/ETX markers HCP Terraform wraps logs in are removed.
+func parseRunLogs(logs []byte) []*RunLogEntry {
+	entries := make([]*RunLogEntry, 0)
+
+	scanner := bufio.NewScanner(bytes.NewReader(logs))
+	scanner.Buffer(make([]byte, 0, 64*1024), 10*1024*1024)
+	for scanner.Scan() {
+		line := strings.Trim(ansiEscapePattern.ReplaceAllString(scanner.Text(), ""), "\x02\x03\r")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		if entry, ok := parseStructuredLogLine(line); ok {
+			entries = append(entries, entry)
+			continue
+		}
+		entries = append(entries, &RunLogEntry{Message: line})
+	}
+	return entries
+}
+
+func parseStructuredLogLine(line string) (*RunLogEntry, bool) {
+	if !strings.HasPrefix(line, "{") {
+		return nil, false
+	}
+	var structured structuredLogLine
+	if err := json.Unmarshal([]byte(line), &structured); err != nil || structured.Message == "" {
+		return nil, false
+	}
+
+	entry := &RunLogEntry{
+		Timestamp: normalizeLogTimestamp(structured.Timestamp),
+		Level:     structured.Level,
+		Message:   structured.Message,
+		Type:      structured.Type,
+	}
+	switch {
+	case structured.Hook != nil && structured.Hook.Resource.Addr != "":
+		entry.ResourceAddress = structured.Hook.Resource.Addr
+	case structured.Change != nil && structured.Change.Resource.Addr != "":
+		entry.ResourceAddress = structured.Change.Resource.Addr
+	case structured.Diagnostic != nil:
+		entry.ResourceAddress = structured.Diagnostic.Address
+	}
+	if structured.Diagnostic != nil {
+		entry.Detail = structured.Diagnostic.Detail
+	}
+	return entry, true
+}
+
+// normalizeLogTimestamp converts log timestamps to RFC 3339 in UTC
+func normalizeLogTimestamp(timestamp string) string {
+	t, err := time.Parse(time.RFC3339Nano, timestamp)
+	if err != nil {
+		return timestamp
+	}
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,57 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const structuredRunLogs = "\x02" + `{"@level":"info","@message":"Terraform 1.9.0","@module":"terraform.ui","@timestamp":"2025-01-01T12:00:00.123456+01:00","terraform":"1.9.0","type":"version"}
+{"@level":"info","@message":"aws_instance.web: Plan to create","@module":"terraform.ui","@timestamp":"2025-01-01T12:00:01.000000+01:00","change":{"resource":{"addr":"aws_instance.web"},"action":"create"},"type":"planned_change"}
+{"@level":"info","@message":"aws_instance.web: Creating...","@module":"terraform.ui","@timestamp":"2025-01-01T12:00:02.000000+01:00","hook":{"resource":{"addr":"aws_instance.web"},"action":"create"},"type":"apply_start"}
+{"@level":"error","@message":"Error: creating EC2 Instance","@module":"terraform.ui","@timestamp":"2025-01-01T12:00:03.000000+01:00","diagnostic":{"severity":"error","summary":"creating EC2 Instance","detail":"UnauthorizedOperation","address":"aws_instance.web"},"type":"diagnostic"}
+` + "\x03"
+
+func TestFormatRunLogs(t *testing.T) {
+	t.Run("parsed structured logs", func(t *testing.T) {
+		out, err := formatRunLogs([]byte(structuredRunLogs), LogFormatParsed)
+		require.NoError(t, err)
+
+		var entries []*RunLogEntry
+		require.NoError(t, json.Unmarshal([]byte(out), &entries))
+		require.Len(t, entries, 4)
+
+		assert.Equal(t, "2025-01-01T11:00:00Z", entries[0].Timestamp)
+		assert.Equal(t, "version", entries[0].Type)
+		assert.Equal(t, "aws_instance.web", entries[1].ResourceAddress)
+		assert.Equal(t, "aws_instance.web", entries[2].ResourceAddress)
+		assert.Equal(t, "error", entries[3].Level)
+		assert.Equal(t, "aws_instance.web", entries[3].ResourceAddress)
+		assert.Equal(t, "UnauthorizedOperation", entries[3].Detail)
+	})
+
+	t.Run("text strips color codes", func(t *testing.T) {
+		logs := "\x1b[0m\x1b[1mTerraform v1.9.0\x1b[0m\n\n\x1b[31m│\x1b[0m \x1b[0m\x1b[1m\x1b[31mError: \x1b[0mboom\n"
+		out, err := formatRunLogs([]byte(logs), LogFormatText)
+		require.NoError(t, err)
+		assert.Equal(t, "Terraform v1.9.0\n│ Error: boom\n", out)
+
+		out, err = formatRunLogs([]byte(structuredRunLogs), LogFormatText)
+		require.NoError(t, err)
+		assert.Contains(t, out, "2025-01-01T11:00:03Z [error] Error: creating EC2 Instance\nUnauthorizedOperation\n")
+	})
+
+	t.Run("raw and invalid formats", func(t *testing.T) {
+		out, err := formatRunLogs([]byte(structuredRunLogs), LogFormatRaw)
+		require.NoError(t, err)
+		assert.Equal(t, structuredRunLogs, out)
+
+		_, err = formatRunLogs([]byte(structuredRunLogs), "xml")
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,299 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// RunTaskSummary is a run task of an organization, an integration such as a security scanner or
+// cost tool that HCP Terraform calls during runs
+type RunTaskSummary struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	URL         string `json:"url"`
+	Description string `json:"description,omitempty"`
+	Category    string `json:"category,omitempty"`
+	Enabled     bool   `json:"enabled"`
+	// GlobalStages are the stages of every workspace's runs the task runs in, when it is enabled globally
+	GlobalStages []string `json:"global_stages,omitempty"`
+}
+
+// WorkspaceRunTaskResult is returned when a run task is attached to a workspace
+type WorkspaceRunTaskResult struct {
+	ID               string   `json:"id"`
+	WorkspaceID      string   `json:"workspace_id"`
+	WorkspaceName    string   `json:"workspace_name"`
+	RunTaskID        string   `json:"run_task_id"`
+	RunTaskName      string   `json:"run_task_name"`
+	EnforcementLevel string   `json:"enforcement_level"`
+	Stages           []string `json:"stages"`
+}
+
+var runTaskStages = []string{string(tfe.PrePlan), string(tfe.PostPlan), string(tfe.PreApply), string(tfe.PostApply)}
+
+// ListRunTasks creates a tool to list the run tasks of an organization.
+func ListRunTasks(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("list_run_tasks",
+			mcp.WithDescription(`Lists the run tasks of an organization: the external integrations, such as security scanners or cost tools, that HCP Terraform calls during runs. Attach a run task to a workspace with attach_run_task_to_workspace and read what it reported for a run with list_run_task_results.`),
+			mcp.WithTitleAnnotation("List the run tasks of an organization"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("terraform_org_name",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return listRunTasksHandler(ctx, request, logger)
+		},
+	}
+}
+
+// AttachRunTaskToWorkspace creates a tool to attach a run task of the organization to a workspace.
+func AttachRunTaskToWorkspace(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("attach_run_task_to_workspace",
+			mcp.WithDescription(`Attaches a run task of the organization to a Terraform workspace, so that HCP Terraform calls it in the given stages of the workspace's runs. A failed mandatory run task stops the run, a failed advisory run task only shows a warning.`),
+			mcp.WithTitleAnnotation("Attach a run task to a workspace"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(false),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("terraform_org_name",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+			mcp.WithString("workspace_name",
+				mcp.Required(),
+				mcp.Description("Workspace name"),
+			),
+			mcp.WithString("run_task",
+				mcp.Required(),
+				mcp.Description("The ID (e.g. 'task-abc123') or name of a run task of the organization"),
+			),
+			mcp.WithString("enforcement_level",
+				mcp.Description("Whether a failure of the run task stops the run (mandatory) or only warns (advisory)"),
+				mcp.Enum(string(tfe.Advisory), string(tfe.Mandatory)),
+				mcp.DefaultString(string(tfe.Advisory)),
+			),
+			mcp.WithArray("stages",
+				mcp.Description("The stages of the runs the task runs in. Defaults to post_plan"),
+				mcp.WithStringEnumItems(runTaskStages),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return attachRunTaskToWorkspaceHandler(ctx, request, logger)
+		},
+	}
+}
+
+// ListRunTaskResults creates a tool to list the run task results of a run.
+func ListRunTaskResults(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("list_run_task_results",
+			mcp.WithDescription(`Lists the task stages of a run with the result each run task reported to HCP Terraform: its status, enforcement level, message and a link to the details in the external tool. Use this to explain why a run is blocked by a run task.`),
+			mcp.WithTitleAnnotation("List the run task results of a run"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("run_id", mcp.Required(), mcp.Description("The ID of the run (e.g., run-CZcmD7eagjhyX0vN)")),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return listRunTaskResultsHandler(ctx, request, logger)
+		},
+	}
+}
+
+func listRunTasksHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	orgName, err := request.RequireString("terraform_org_name")
+	if err != nil {
+		return ToolError(logger, "missing required input: terraform_org_name", err)
+	}
+	orgName = strings.TrimSpace(orgName)
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+
+	tasks, err := listRunTasks(ctx, tfeClient, orgName)
+	if err != nil {
+		return ToolErrorf(logger, "failed to list run tasks of org '%s': %v", orgName, err)
+	}
+
+	summaries := make([]RunTaskSummary, 0, len(tasks))
+	for _, task := range tasks {
+		summary := RunTaskSummary{
+			ID:          task.ID,
+			Name:        task.Name,
+			URL:         task.URL,
+			Description: task.Description,
+			Category:    task.Category,
+			Enabled:     task.Enabled,
+		}
+		if task.Global != nil && task.Global.Enabled {
+			summary.GlobalStages = stageNames(task.Global.Stages)
+		}
+		summaries = append(summaries, summary)
+	}
+	buf, err := json.Marshal(summaries)
+	if err != nil {
+		return ToolError(logger, "failed to marshal run tasks", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+func attachRunTaskToWorkspaceHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	orgName, err := request.RequireString("terraform_org_name")
+	if err != nil {
+		return ToolError(logger, "missing required input: terraform_org_name", err)
+	}
+	orgName = strings.TrimSpace(orgName)
+
+	workspaceName, err := request.RequireString("workspace_name")
+	if err != nil {
+		return ToolError(logger, "missing required input: workspace_name", err)
+	}
+	workspaceName = strings.TrimSpace(workspaceName)
+
+	runTaskRef, err := request.RequireString("run_task")
+	if err != nil {
+		return ToolError(logger, "missing required input: run_task", err)
+	}
+	runTaskRef = strings.TrimSpace(runTaskRef)
+
+	enforcementLevel := tfe.TaskEnforcementLevel(request.GetString("enforcement_level", string(tfe.Advisory)))
+	if enforcementLevel != tfe.Advisory && enforcementLevel != tfe.Mandatory {
+		return ToolErrorf(logger, "invalid enforcement_level '%s': must be 'advisory' or 'mandatory'", enforcementLevel)
+	}
+
+	var stages []tfe.Stage
+	for _, stage := range request.GetStringSlice("stages", []string{string(tfe.PostPlan)}) {
+		if !slices.Contains(runTaskStages, stage) {
+			return ToolErrorf(logger, "invalid stage '%s': must be one of %s", stage, strings.Join(runTaskStages, ", "))
+		}
+		stages = append(stages, tfe.Stage(stage))
+	}
+	if len(stages) == 0 {
+		stages = []tfe.Stage{tfe.PostPlan}
+	}
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+
+	workspace, err := tfeClient.Workspaces.Read(ctx, orgName, workspaceName)
+	if err != nil {
+		return ToolErrorf(logger, "workspace '%s' not found in org '%s'", workspaceName, orgName)
+	}
+
+	runTask, err := findRunTask(ctx, tfeClient, orgName, runTaskRef)
+	if err != nil {
+		return ToolError(logger, "failed to find run task", err)
+	}
+
+	workspaceTask, err := tfeClient.WorkspaceRunTasks.Create(ctx, workspace.ID, tfe.WorkspaceRunTaskCreateOptions{
+		EnforcementLevel: enforcementLevel,
+		RunTask:          runTask,
+		Stages:           &stages,
+	})
+	if err != nil {
+		return ToolErrorf(logger, "failed to attach run task '%s' to workspace '%s': %v", runTask.Name, workspaceName, err)
+	}
+
+	buf, err := json.Marshal(WorkspaceRunTaskResult{
+		ID:               workspaceTask.ID,
+		WorkspaceID:      workspace.ID,
+		WorkspaceName:    workspace.Name,
+		RunTaskID:        runTask.ID,
+		RunTaskName:      runTask.Name,
+		EnforcementLevel: string(workspaceTask.EnforcementLevel),
+		Stages:           stageNames(workspaceTask.Stages),
+	})
+	if err != nil {
+		return ToolError(logger, "failed to marshal result", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+func listRunTaskResultsHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	runID, err := request.RequireString("run_id")
+	if err != nil {
+		return ToolError(logger, "missing required input: run_id", err)
+	}
+	runID = strings.TrimSpace(runID)
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+
+	results, err := client.ListRunTaskResults(ctx, tfeClient, runID)
+	if err != nil {
+		return ToolErrorf(logger, "failed to list run task results for run '%s': %v", runID, err)
+	}
+
+	buf, err := json.Marshal(results)
+	if err != nil {
+		return ToolError(logger, "failed to marshal run task results", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+// findRunTask resolves a run task of the organization by ID or by name
+func findRunTask(ctx context.Context, tfeClient *tfe.Client, orgName string, ref string) (*tfe.RunTask, error) {
+	if strings.HasPrefix(ref, "task-") {
+		return tfeClient.RunTasks.Read(ctx, ref)
+	}
+
+	tasks, err := listRunTasks(ctx, tfeClient, orgName)
+	if err != nil {
+		return nil, err
+	}
+	for _, task := range tasks {
+		if task.Name == ref {
+			return task, nil
+		}
+	}
+	return nil, fmt.Errorf("no run task named '%s' in org '%s'", ref, orgName)
+}
+
+// listRunTasks lists all the run tasks of an organization
+func listRunTasks(ctx context.Context, tfeClient *tfe.Client, orgName string) ([]*tfe.RunTask, error) {
+	var tasks []*tfe.RunTask
+	options := &tfe.RunTaskListOptions{ListOptions: tfe.ListOptions{PageNumber: 1, PageSize: 100}}
+	for {
+		page, err := tfeClient.RunTasks.List(ctx, orgName, options)
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, page.Items...)
+		if page.Pagination == nil || page.Pagination.NextPage == 0 {
+			return tasks, nil
+		}
+		options.PageNumber = page.Pagination.NextPage
+	}
+}
+
+func stageNames(stages []tfe.Stage) []string {
+	names := make([]string, 0, len(stages))
+	for _, stage := range stages {
+		names = append(names, string(stage))
+	}
+	return names
+}
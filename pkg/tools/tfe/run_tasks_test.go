@@ -0,0 +1,67 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListRunTasks(t *testing.T) {
+	logger := log.New()
+	logger.SetLevel(log.ErrorLevel)
+
+	t.Run("tool creation", func(t *testing.T) {
+		tool := ListRunTasks(logger)
+
+		assert.Equal(t, "list_run_tasks", tool.Tool.Name)
+		assert.Contains(t, tool.Tool.Description, "Lists the run tasks of an organization")
+		assert.NotNil(t, tool.Handler)
+
+		assert.NotNil(t, tool.Tool.Annotations.ReadOnlyHint)
+		assert.True(t, *tool.Tool.Annotations.ReadOnlyHint)
+
+		assert.Contains(t, tool.Tool.InputSchema.Required, "terraform_org_name")
+	})
+}
+
+func TestAttachRunTaskToWorkspace(t *testing.T) {
+	logger := log.New()
+	logger.SetLevel(log.ErrorLevel)
+
+	t.Run("tool creation", func(t *testing.T) {
+		tool := AttachRunTaskToWorkspace(logger)
+
+		assert.Equal(t, "attach_run_task_to_workspace", tool.Tool.Name)
+		assert.Contains(t, tool.Tool.Description, "Attaches a run task")
+		assert.NotNil(t, tool.Handler)
+
+		assert.NotNil(t, tool.Tool.Annotations.ReadOnlyHint)
+		assert.False(t, *tool.Tool.Annotations.ReadOnlyHint)
+		assert.NotNil(t, tool.Tool.Annotations.DestructiveHint)
+		assert.False(t, *tool.Tool.Annotations.DestructiveHint)
+
+		assert.Contains(t, tool.Tool.InputSchema.Required, "terraform_org_name")
+		assert.Contains(t, tool.Tool.InputSchema.Required, "workspace_name")
+		assert.Contains(t, tool.Tool.InputSchema.Required, "run_task")
+		assert.NotContains(t, tool.Tool.InputSchema.Required, "enforcement_level")
+		assert.NotContains(t, tool.Tool.InputSchema.Required, "stages")
+	})
+}
+
+func TestListRunTaskResults(t *testing.T) {
+	logger := log.New()
+	logger.SetLevel(log.ErrorLevel)
+
+	t.Run("tool creation", func(t *testing.T) {
+		tool := ListRunTaskResults(logger)
+
+		assert.Equal(t, "list_run_task_results", tool.Tool.Name)
+		assert.Contains(t, tool.Tool.Description, "task stages of a run")
+		assert.NotNil(t, tool.Handler)
+		assert.Contains(t, tool.Tool.InputSchema.Required, "run_id")
+	})
+}
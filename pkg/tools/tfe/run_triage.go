@@ -0,0 +1,354 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// RunTriageWorkspacesEnv lists the workspaces whose errored runs are triaged, as comma-separated
+	// "organization/workspace" names. Triage is disabled when it is empty.
+	RunTriageWorkspacesEnv = "MCP_RUN_TRIAGE_WORKSPACES"
+	// RunTriageIntervalEnv sets how often the watched workspaces are checked for errored runs, e.g. "2m"
+	RunTriageIntervalEnv = "MCP_RUN_TRIAGE_INTERVAL"
+	// RunTriageGitHubRepoEnv is the "owner/repo" GitHub repository an issue is opened in for each errored run
+	RunTriageGitHubRepoEnv = "MCP_RUN_TRIAGE_GITHUB_REPO"
+	// RunTriageGitHubTokenEnv is the GitHub token used to open issues, with write access to issues
+	RunTriageGitHubTokenEnv = "MCP_RUN_TRIAGE_GITHUB_TOKEN"
+	// RunTriageGitHubAPIURLEnv is the GitHub API URL, for GitHub Enterprise Server
+	RunTriageGitHubAPIURLEnv = "MCP_RUN_TRIAGE_GITHUB_API_URL"
+	// RunTriageWebhookURLEnv is a URL the diagnosis of each errored run is posted to as JSON
+	RunTriageWebhookURLEnv = "MCP_RUN_TRIAGE_WEBHOOK_URL"
+
+	defaultRunTriageInterval = time.Minute
+	defaultGitHubAPIURL      = "https://api.github.com"
+	runTriagePublishTimeout  = 30 * time.Second
+)
+
+// RunTriageConfig holds the workspaces watched for errored runs and where their diagnoses are sent
+type RunTriageConfig struct {
+	Workspaces   []WatchedWorkspace
+	Interval     time.Duration
+	GitHubRepo   string
+	GitHubToken  string
+	GitHubAPIURL string
+	WebhookURL   string
+}
+
+// WatchedWorkspace is a workspace watched for errored runs
+type WatchedWorkspace struct {
+	Organization string
+	Name         string
+}
+
+// RunTriageEvent is the payload posted to the triage webhook for an errored run
+type RunTriageEvent struct {
+	*RunDiagnosis
+	IssueURL string `json:"issue_url,omitempty"`
+}
+
+// LoadRunTriageConfigFromEnv loads the run triage configuration from environment variables
+func LoadRunTriageConfigFromEnv() RunTriageConfig {
+	config := RunTriageConfig{
+		Interval:     defaultRunTriageInterval,
+		GitHubRepo:   strings.TrimSpace(os.Getenv(RunTriageGitHubRepoEnv)),
+		GitHubToken:  strings.TrimSpace(os.Getenv(RunTriageGitHubTokenEnv)),
+		GitHubAPIURL: defaultGitHubAPIURL,
+		WebhookURL:   strings.TrimSpace(os.Getenv(RunTriageWebhookURLEnv)),
+	}
+
+	if apiURL := strings.TrimSpace(os.Getenv(RunTriageGitHubAPIURLEnv)); apiURL != "" {
+		config.GitHubAPIURL = strings.TrimSuffix(apiURL, "/")
+	}
+
+	for _, name := range strings.Split(os.Getenv(RunTriageWorkspacesEnv), ",") {
+		if name = strings.TrimSpace(name); name == "" {
+			continue
+		}
+		organization, workspace, ok := strings.Cut(name, "/")
+		if !ok || organization == "" || workspace == "" || strings.Contains(workspace, "/") {
+			log.Warnf("Invalid workspace %q in %s, expected 'organization/workspace'", name, RunTriageWorkspacesEnv)
+			continue
+		}
+		config.Workspaces = append(config.Workspaces, WatchedWorkspace{Organization: organization, Name: workspace})
+	}
+
+	if interval := strings.TrimSpace(os.Getenv(RunTriageIntervalEnv)); interval != "" {
+		if duration, err := time.ParseDuration(interval); err == nil && duration >= 10*time.Second {
+			config.Interval = duration
+		} else {
+			log.Warnf("Invalid %s value %q, it must be at least 10s, using default %s", RunTriageIntervalEnv, interval, config.Interval)
+		}
+	}
+
+	if config.GitHubRepo != "" {
+		if owner, repo, ok := strings.Cut(config.GitHubRepo, "/"); !ok || owner == "" || repo == "" || strings.Contains(repo, "/") {
+			log.Warnf("Invalid %s value %q, expected 'owner/repo', GitHub issues disabled", RunTriageGitHubRepoEnv, config.GitHubRepo)
+			config.GitHubRepo = ""
+		} else if config.GitHubToken == "" {
+			log.Warnf("%s is set without %s, GitHub issues disabled", RunTriageGitHubRepoEnv, RunTriageGitHubTokenEnv)
+			config.GitHubRepo = ""
+		}
+	}
+
+	return config
+}
+
+// StartRunTriage watches the configured workspaces for errored runs until the context is done. Each
+// errored run is diagnosed, and its diagnosis is logged, opened as an issue in the configured GitHub
+// repository and posted to the configured webhook. It uses the server's TFE_TOKEN and TFE_ADDRESS.
+func StartRunTriage(ctx context.Context, logger *log.Logger) {
+	config := LoadRunTriageConfigFromEnv()
+	if len(config.Workspaces) == 0 {
+		return
+	}
+
+	token := utils.GetEnv(client.TerraformToken, "")
+	if token == "" {
+		logger.Warnf("%s is set but TFE_TOKEN is not, run triage disabled", RunTriageWorkspacesEnv)
+		return
+	}
+	skipTLSVerify, _ := strconv.ParseBool(utils.GetEnv(client.TerraformSkipTLSVerify, "false"))
+	tfeClient, err := client.NewTfeClientForToken(utils.GetEnv(client.TerraformAddress, client.DefaultTerraformAddress), skipTLSVerify, token, "", logger)
+	if err != nil {
+		logger.Errorf("Failed to create the Terraform client of run triage: %v", err)
+		return
+	}
+
+	triage := newRunTriage(config, tfeClient, logger)
+	logger.Infof("Run triage watching %d workspaces every %s (GitHub issues: %t, webhook: %t)", len(config.Workspaces), config.Interval, config.GitHubRepo != "", config.WebhookURL != "")
+	go triage.watch(ctx)
+}
+
+// runTriage diagnoses the errored runs of the watched workspaces. Runs created before the triage
+// started are not triaged, so that past failures don't open issues when the server restarts.
+type runTriage struct {
+	config     RunTriageConfig
+	tfeClient  *tfe.Client
+	httpClient *http.Client
+	logger     *log.Logger
+	since      time.Time
+	triaged    map[string]bool
+}
+
+func newRunTriage(config RunTriageConfig, tfeClient *tfe.Client, logger *log.Logger) *runTriage {
+	return &runTriage{
+		config:     config,
+		tfeClient:  tfeClient,
+		httpClient: client.NewOutboundHTTPClient(runTriagePublishTimeout, logger),
+		logger:     logger,
+		since:      time.Now(),
+		triaged:    make(map[string]bool),
+	}
+}
+
+func (t *runTriage) watch(ctx context.Context) {
+	ticker := time.NewTicker(t.config.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			t.poll(ctx)
+		}
+	}
+}
+
+// poll triages the new errored runs of every watched workspace
+func (t *runTriage) poll(ctx context.Context) {
+	for _, watched := range t.config.Workspaces {
+		workspace, err := t.tfeClient.Workspaces.Read(ctx, watched.Organization, watched.Name)
+		if err != nil {
+			t.logger.Warnf("Run triage failed to read workspace %s/%s: %v", watched.Organization, watched.Name, err)
+			continue
+		}
+		runs, err := t.tfeClient.Runs.List(ctx, workspace.ID, &tfe.RunListOptions{
+			ListOptions: tfe.ListOptions{PageSize: 20},
+			Status:      string(tfe.RunErrored),
+		})
+		if err != nil {
+			t.logger.Warnf("Run triage failed to list the runs of workspace %s/%s: %v", watched.Organization, watched.Name, err)
+			continue
+		}
+		for _, run := range newErroredRuns(runs.Items, t.since, t.triaged) {
+			t.triage(ctx, run.ID)
+		}
+	}
+}
+
+// newErroredRuns returns the errored runs created since a time that were not triaged yet, oldest first
+func newErroredRuns(runs []*tfe.Run, since time.Time, triaged map[string]bool) []*tfe.Run {
+	var errored []*tfe.Run
+	for _, run := range runs {
+		if run.Status == tfe.RunErrored && run.CreatedAt.After(since) && !triaged[run.ID] {
+			errored = append(errored, run)
+		}
+	}
+	sort.Slice(errored, func(i, j int) bool { return errored[i].CreatedAt.Before(errored[j].CreatedAt) })
+	return errored
+}
+
+// triage diagnoses an errored run and sends its diagnosis. Runs that can't be diagnosed, for example
+// because their logs are not available yet, are tried again on the next poll.
+func (t *runTriage) triage(ctx context.Context, runID string) {
+	diagnosis, err := diagnoseErroredRun(ctx, t.tfeClient, runID)
+	if err != nil {
+		t.logger.Warnf("Run triage failed to diagnose run %s: %v", runID, err)
+		return
+	}
+	t.triaged[runID] = true
+	t.logger.WithFields(log.Fields{
+		"run_id":        diagnosis.RunID,
+		"organization":  diagnosis.Organization,
+		"workspace":     diagnosis.WorkspaceName,
+		"errored_phase": diagnosis.ErroredPhase,
+		"error_class":   diagnosis.ErrorClass,
+		"root_cause":    diagnosis.RootCause,
+	}).Warn("Terraform run errored")
+
+	event := &RunTriageEvent{RunDiagnosis: diagnosis}
+	if t.config.GitHubRepo != "" {
+		issueURL, err := t.openGitHubIssue(ctx, diagnosis)
+		if err != nil {
+			t.logger.Errorf("Run triage failed to open a GitHub issue for run %s: %v", runID, err)
+		} else {
+			t.logger.Infof("Run triage opened %s for run %s", issueURL, runID)
+			event.IssueURL = issueURL
+		}
+	}
+	if t.config.WebhookURL != "" {
+		if err := t.postWebhook(ctx, event); err != nil {
+			t.logger.Errorf("Run triage failed to post the diagnosis of run %s to the webhook: %v", runID, err)
+		}
+	}
+}
+
+// openGitHubIssue opens an issue with the diagnosis of a run and returns its URL
+func (t *runTriage) openGitHubIssue(ctx context.Context, diagnosis *RunDiagnosis) (string, error) {
+	payload, err := json.Marshal(map[string]string{
+		"title": runTriageIssueTitle(diagnosis),
+		"body":  runTriageIssueBody(diagnosis),
+	})
+	if err != nil {
+		return "", err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/repos/%s/issues", t.config.GitHubAPIURL, t.config.GitHubRepo), bytes.NewReader(payload))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("Authorization", "Bearer "+t.config.GitHubToken)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-GitHub-Api-Version", "2022-11-28")
+
+	resp, err := t.httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != http.StatusCreated {
+		return "", fmt.Errorf("GitHub returned %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	var issue struct {
+		HTMLURL string `json:"html_url"`
+	}
+	if err := json.Unmarshal(body, &issue); err != nil {
+		return "", fmt.Errorf("failed to parse the GitHub issue: %w", err)
+	}
+	return issue.HTMLURL, nil
+}
+
+// postWebhook posts the triage event of a run to the webhook
+func (t *runTriage) postWebhook(ctx context.Context, event *RunTriageEvent) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, t.config.WebhookURL, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := t.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<20))
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook returned %s", resp.Status)
+	}
+	return nil
+}
+
+// runTriageIssueTitle summarizes a run diagnosis in an issue title
+func runTriageIssueTitle(diagnosis *RunDiagnosis) string {
+	workspace := diagnosis.RunID
+	if diagnosis.WorkspaceName != "" {
+		workspace = diagnosis.Organization + "/" + diagnosis.WorkspaceName
+	}
+	return fmt.Sprintf("Terraform %s failed in %s (%s error)", diagnosis.ErroredPhase, workspace, diagnosis.ErrorClass)
+}
+
+// runTriageIssueBody renders a run diagnosis as the Markdown body of an issue
+func runTriageIssueBody(diagnosis *RunDiagnosis) string {
+	var b strings.Builder
+	run := "`" + diagnosis.RunID + "`"
+	if diagnosis.RunURL != "" {
+		run = fmt.Sprintf("[%s](%s)", diagnosis.RunID, diagnosis.RunURL)
+	}
+	if diagnosis.WorkspaceName != "" {
+		fmt.Fprintf(&b, "Run %s of workspace `%s/%s` errored during the %s.\n\n", run, diagnosis.Organization, diagnosis.WorkspaceName, diagnosis.ErroredPhase)
+	} else {
+		fmt.Fprintf(&b, "Run %s errored during the %s.\n\n", run, diagnosis.ErroredPhase)
+	}
+	if diagnosis.RunMessage != "" {
+		fmt.Fprintf(&b, "Run message: %s\n\n", diagnosis.RunMessage)
+	}
+
+	fmt.Fprintf(&b, "## Root cause\n\nError class: **%s**\n\n", diagnosis.ErrorClass)
+	if diagnosis.RootCause != "" {
+		fmt.Fprintf(&b, "```\n%s\n```\n\n", diagnosis.RootCause)
+	}
+	fmt.Fprintf(&b, "## Suggested next step\n\n%s\n", diagnosis.Suggestion)
+
+	if len(diagnosis.Errors) > 0 {
+		b.WriteString("\n## Errors\n\n")
+		for _, entry := range diagnosis.Errors {
+			b.WriteString("- ")
+			if entry.ResourceAddress != "" {
+				fmt.Fprintf(&b, "`%s`: ", entry.ResourceAddress)
+			}
+			b.WriteString(entry.Message)
+			if entry.Detail != "" {
+				fmt.Fprintf(&b, " - %s", strings.Join(strings.Fields(entry.Detail), " "))
+			}
+			b.WriteString("\n")
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,161 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-tfe"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const erroredApplyLogs = `{"@level":"info","@message":"aws_instance.web: Creating...","type":"apply_start"}
+{"@level":"error","@message":"Error: creating EC2 Instance: InvalidAMIID.NotFound: The image id '[ami-123]' does not exist","diagnostic":{"address":"aws_instance.web","detail":"Check the\n  AMI of the region."},"type":"diagnostic"}`
+
+func TestRunDiagnosis(t *testing.T) {
+	run := &tfe.Run{ID: "run-abc123", Message: "Deploy web"}
+
+	diagnosis := runDiagnosis(run, "apply", []byte(erroredApplyLogs))
+	assert.Equal(t, "run-abc123", diagnosis.RunID)
+	assert.Equal(t, "Deploy web", diagnosis.RunMessage)
+	assert.Equal(t, "apply", diagnosis.ErroredPhase)
+	assert.Equal(t, RunErrorUnknown, diagnosis.ErrorClass)
+	require.Len(t, diagnosis.Errors, 1)
+	assert.Equal(t, "aws_instance.web", diagnosis.Errors[0].ResourceAddress)
+	assert.Contains(t, diagnosis.Suggestion, "get_apply_logs")
+
+	diagnosis = runDiagnosis(run, "plan", []byte(`{"@level":"error","@message":"Error: Rate exceeded","type":"diagnostic"}`))
+	assert.Equal(t, RunErrorTransient, diagnosis.ErrorClass)
+	assert.Equal(t, "Error: Rate exceeded", diagnosis.RootCause)
+	assert.Contains(t, diagnosis.Suggestion, "retry_hcp_terraform_run")
+}
+
+func TestLoadRunTriageConfigFromEnv(t *testing.T) {
+	t.Run("disabled by default", func(t *testing.T) {
+		t.Setenv(RunTriageWorkspacesEnv, "")
+		t.Setenv(RunTriageIntervalEnv, "")
+		t.Setenv(RunTriageGitHubRepoEnv, "")
+		t.Setenv(RunTriageGitHubAPIURLEnv, "")
+		t.Setenv(RunTriageWebhookURLEnv, "")
+		config := LoadRunTriageConfigFromEnv()
+		assert.Empty(t, config.Workspaces)
+		assert.Equal(t, time.Minute, config.Interval)
+		assert.Equal(t, "https://api.github.com", config.GitHubAPIURL)
+	})
+
+	t.Run("custom values", func(t *testing.T) {
+		t.Setenv(RunTriageWorkspacesEnv, "example-corp/prod-network, example-corp/prod-app,invalid,a/b/c")
+		t.Setenv(RunTriageIntervalEnv, "5m")
+		t.Setenv(RunTriageGitHubRepoEnv, "example-corp/infrastructure")
+		t.Setenv(RunTriageGitHubTokenEnv, "ghp_example")
+		t.Setenv(RunTriageGitHubAPIURLEnv, "https://github.example.com/api/v3/")
+		t.Setenv(RunTriageWebhookURLEnv, "https://hooks.example.com/terraform")
+		config := LoadRunTriageConfigFromEnv()
+		assert.Equal(t, []WatchedWorkspace{
+			{Organization: "example-corp", Name: "prod-network"},
+			{Organization: "example-corp", Name: "prod-app"},
+		}, config.Workspaces)
+		assert.Equal(t, 5*time.Minute, config.Interval)
+		assert.Equal(t, "example-corp/infrastructure", config.GitHubRepo)
+		assert.Equal(t, "https://github.example.com/api/v3", config.GitHubAPIURL)
+		assert.Equal(t, "https://hooks.example.com/terraform", config.WebhookURL)
+	})
+
+	t.Run("invalid values", func(t *testing.T) {
+		t.Setenv(RunTriageIntervalEnv, "1s")
+		t.Setenv(RunTriageGitHubRepoEnv, "example-corp/infrastructure")
+		t.Setenv(RunTriageGitHubTokenEnv, "")
+		config := LoadRunTriageConfigFromEnv()
+		assert.Equal(t, time.Minute, config.Interval)
+		assert.Empty(t, config.GitHubRepo, "GitHub issues need a token")
+	})
+}
+
+func TestNewErroredRuns(t *testing.T) {
+	since := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	runs := []*tfe.Run{
+		{ID: "run-3", Status: tfe.RunErrored, CreatedAt: since.Add(3 * time.Minute)},
+		{ID: "run-2", Status: tfe.RunErrored, CreatedAt: since.Add(2 * time.Minute)},
+		{ID: "run-1", Status: tfe.RunErrored, CreatedAt: since.Add(time.Minute)},
+		{ID: "run-applied", Status: tfe.RunApplied, CreatedAt: since.Add(time.Minute)},
+		{ID: "run-old", Status: tfe.RunErrored, CreatedAt: since.Add(-time.Minute)},
+	}
+
+	var ids []string
+	for _, run := range newErroredRuns(runs, since, map[string]bool{"run-2": true}) {
+		ids = append(ids, run.ID)
+	}
+	assert.Equal(t, []string{"run-1", "run-3"}, ids)
+}
+
+func TestRunTriagePublishing(t *testing.T) {
+	logger := log.New()
+	logger.SetLevel(log.ErrorLevel)
+
+	diagnosis := runDiagnosis(&tfe.Run{ID: "run-abc123"}, "apply", []byte(erroredApplyLogs))
+	diagnosis.Organization = "example-corp"
+	diagnosis.WorkspaceName = "prod-app"
+	diagnosis.RunURL = "https://app.terraform.io/app/example-corp/workspaces/prod-app/runs/run-abc123"
+
+	t.Run("issue content", func(t *testing.T) {
+		assert.Equal(t, "Terraform apply failed in example-corp/prod-app (unknown error)", runTriageIssueTitle(diagnosis))
+
+		body := runTriageIssueBody(diagnosis)
+		assert.Contains(t, body, "Run [run-abc123](https://app.terraform.io/app/example-corp/workspaces/prod-app/runs/run-abc123) of workspace `example-corp/prod-app` errored during the apply.")
+		assert.Contains(t, body, "- `aws_instance.web`: Error: creating EC2 Instance: InvalidAMIID.NotFound: The image id '[ami-123]' does not exist - Check the AMI of the region.\n")
+	})
+
+	t.Run("GitHub issue", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/repos/example-corp/infrastructure/issues", r.URL.Path)
+			assert.Equal(t, "Bearer ghp_example", r.Header.Get("Authorization"))
+			var issue map[string]string
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&issue))
+			assert.Equal(t, runTriageIssueTitle(diagnosis), issue["title"])
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"number":42,"html_url":"https://github.com/example-corp/infrastructure/issues/42"}`))
+		}))
+		defer server.Close()
+
+		triage := newRunTriage(RunTriageConfig{GitHubRepo: "example-corp/infrastructure", GitHubToken: "ghp_example", GitHubAPIURL: server.URL}, nil, logger)
+		issueURL, err := triage.openGitHubIssue(context.Background(), diagnosis)
+		require.NoError(t, err)
+		assert.Equal(t, "https://github.com/example-corp/infrastructure/issues/42", issueURL)
+	})
+
+	t.Run("GitHub error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, `{"message":"Bad credentials"}`, http.StatusUnauthorized)
+		}))
+		defer server.Close()
+
+		triage := newRunTriage(RunTriageConfig{GitHubRepo: "example-corp/infrastructure", GitHubToken: "invalid", GitHubAPIURL: server.URL}, nil, logger)
+		_, err := triage.openGitHubIssue(context.Background(), diagnosis)
+		assert.ErrorContains(t, err, "Bad credentials")
+	})
+
+	t.Run("webhook", func(t *testing.T) {
+		var received map[string]any
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			assert.NoError(t, json.Unmarshal(body, &received))
+		}))
+		defer server.Close()
+
+		triage := newRunTriage(RunTriageConfig{WebhookURL: server.URL}, nil, logger)
+		require.NoError(t, triage.postWebhook(context.Background(), &RunTriageEvent{RunDiagnosis: diagnosis, IssueURL: "https://github.com/example-corp/infrastructure/issues/42"}))
+		assert.Equal(t, "run-abc123", received["run_id"])
+		assert.Equal(t, "prod-app", received["workspace_name"])
+		assert.Equal(t, "https://github.com/example-corp/infrastructure/issues/42", received["issue_url"])
+	})
+}
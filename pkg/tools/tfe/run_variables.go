@@ -0,0 +1,346 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/ext/typeexpr"
+	"github.com/hashicorp/hcl/v2/hclparse"
+	"github.com/mark3labs/mcp-go/mcp"
+	log "github.com/sirupsen/logrus"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/convert"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+)
+
+// withRunVariables adds the "variables" and "validate_variables" parameters to a run creation tool.
+func withRunVariables() mcp.ToolOption {
+	return func(tool *mcp.Tool) {
+		mcp.WithObject("variables",
+			mcp.Description("Optional run-specific Terraform variable values keyed by variable name. Values may be strings, numbers, booleans, lists or objects"),
+		)(tool)
+
+		mcp.WithBoolean("validate_variables",
+			mcp.Description("Validate the provided variables against the variables declared in the workspace's current configuration version before the run is created"),
+			mcp.DefaultBool(true),
+		)(tool)
+	}
+}
+
+// declaredVariable is a variable block found in a Terraform configuration
+type declaredVariable struct {
+	Name     string
+	Type     cty.Type
+	Required bool
+}
+
+// applyRunVariables reads the "variables" parameter, validates it against the workspace's configuration
+// unless disabled and sets the resulting run variables on the run options.
+func applyRunVariables(ctx context.Context, tfeClient *tfe.Client, workspace *tfe.Workspace, request mcp.CallToolRequest, options *tfe.RunCreateOptions, logger *log.Logger) error {
+	provided, err := runVariablesParam(request)
+	if err != nil {
+		return err
+	}
+
+	if request.GetBool("validate_variables", true) {
+		if err := validateRunVariablesForWorkspace(ctx, tfeClient, workspace, provided, logger); err != nil {
+			return err
+		}
+	}
+
+	for _, name := range sortedKeys(provided) {
+		value, err := runVariableValueHCL(provided[name])
+		if err != nil {
+			return fmt.Errorf("variable %q: %w", name, err)
+		}
+		options.Variables = append(options.Variables, &tfe.RunVariable{Key: name, Value: value})
+	}
+	return nil
+}
+
+func runVariablesParam(request mcp.CallToolRequest) (map[string]any, error) {
+	raw, ok := request.GetArguments()["variables"]
+	if !ok || raw == nil {
+		return map[string]any{}, nil
+	}
+	variables, ok := raw.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("variables must be an object keyed by variable name")
+	}
+	return variables, nil
+}
+
+// runVariableValueHCL renders a JSON value as the HCL expression expected by the runs API.
+// JSON literals are valid HCL expressions once template sequences in strings are escaped.
+func runVariableValueHCL(value any) (string, error) {
+	buf, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	escaped := strings.NewReplacer("${", "$${", "%{", "%%{").Replace(string(buf))
+	return escaped, nil
+}
+
+// validateRunVariablesForWorkspace checks the provided variables against the variables declared in the
+// workspace's current configuration version. Variables already set on the workspace or through its
+// variable sets satisfy required variables. Validation is skipped when the configuration can't be read.
+func validateRunVariablesForWorkspace(ctx context.Context, tfeClient *tfe.Client, workspace *tfe.Workspace, provided map[string]any, logger *log.Logger) error {
+	if workspace.CurrentConfigurationVersion == nil || workspace.CurrentConfigurationVersion.ID == "" {
+		logger.Debugf("workspace %s has no configuration version, skipping variable validation", workspace.ID)
+		return nil
+	}
+
+	archive, err := tfeClient.ConfigurationVersions.Download(ctx, workspace.CurrentConfigurationVersion.ID)
+	if err != nil {
+		logger.Warnf("failed to download configuration version %s, skipping variable validation: %v", workspace.CurrentConfigurationVersion.ID, err)
+		return nil
+	}
+
+	files, err := configurationFiles(archive, workspace.WorkingDirectory)
+	if err != nil {
+		logger.Warnf("failed to read configuration version %s, skipping variable validation: %v", workspace.CurrentConfigurationVersion.ID, err)
+		return nil
+	}
+
+	declared, err := declaredVariables(files)
+	if err != nil {
+		logger.Warnf("failed to parse configuration version %s, skipping variable validation: %v", workspace.CurrentConfigurationVersion.ID, err)
+		return nil
+	}
+
+	preset, err := presetVariableKeys(ctx, tfeClient, workspace.ID)
+	if err != nil {
+		logger.Warnf("failed to list variables for workspace %s, required variables will not be checked: %v", workspace.ID, err)
+		preset = nil
+	}
+
+	return validateRunVariables(declared, provided, preset)
+}
+
+// validateRunVariables reports unknown variables, values that don't match the declared type and, when
+// preset is not nil, required variables that are neither provided nor already set.
+func validateRunVariables(declared map[string]*declaredVariable, provided map[string]any, preset map[string]bool) error {
+	var problems []string
+
+	for _, name := range sortedKeys(provided) {
+		variable, ok := declared[name]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("unknown variable %q is not declared in the configuration", name))
+			continue
+		}
+		if err := checkVariableType(variable.Type, provided[name]); err != nil {
+			problems = append(problems, fmt.Sprintf("variable %q: %v", name, err))
+		}
+	}
+
+	if preset != nil {
+		for _, name := range sortedKeys(declared) {
+			if !declared[name].Required {
+				continue
+			}
+			if _, ok := provided[name]; ok || preset[name] {
+				continue
+			}
+			problems = append(problems, fmt.Sprintf("required variable %q is not set", name))
+		}
+	}
+
+	if len(problems) > 0 {
+		return errors.New("invalid run variables: " + strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+func checkVariableType(ty cty.Type, value any) error {
+	buf, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	implied, err := ctyjson.ImpliedType(buf)
+	if err != nil {
+		return err
+	}
+	val, err := ctyjson.Unmarshal(buf, implied)
+	if err != nil {
+		return err
+	}
+	if _, err := convert.Convert(val, ty); err != nil {
+		return fmt.Errorf("expected %s: %v", ty.FriendlyName(), err)
+	}
+	return nil
+}
+
+// configurationFiles extracts the Terraform files of the root module from a configuration version archive
+func configurationFiles(archive []byte, workingDirectory string) (map[string][]byte, error) {
+	gz, err := gzip.NewReader(bytes.NewReader(archive))
+	if err != nil {
+		return nil, err
+	}
+	defer gz.Close()
+
+	root := path.Clean("/" + workingDirectory)
+	files := make(map[string][]byte)
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		name := path.Clean("/" + header.Name)
+		if path.Dir(name) != root || !(strings.HasSuffix(name, ".tf") || strings.HasSuffix(name, ".tf.json")) {
+			continue
+		}
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, err
+		}
+		files[name] = data
+	}
+	return files, nil
+}
+
+var variableBlockSchema = &hcl.BodySchema{
+	Blocks: []hcl.BlockHeaderSchema{{Type: "variable", LabelNames: []string{"name"}}},
+}
+
+var variableAttributesSchema = &hcl.BodySchema{
+	Attributes: []hcl.AttributeSchema{{Name: "type"}, {Name: "default"}},
+}
+
+// declaredVariables parses the variable blocks of a set of Terraform files
+func declaredVariables(files map[string][]byte) (map[string]*declaredVariable, error) {
+	parser := hclparse.NewParser()
+	declared := make(map[string]*declaredVariable)
+
+	for _, name := range sortedKeys(files) {
+		file, diags := parseTerraformFile(parser, name, files[name])
+		if diags.HasErrors() {
+			return nil, diags
+		}
+
+		content, _, diags := file.Body.PartialContent(variableBlockSchema)
+		if diags.HasErrors() {
+			return nil, diags
+		}
+		for _, block := range content.Blocks {
+			attrs, _, diags := block.Body.PartialContent(variableAttributesSchema)
+			if diags.HasErrors() {
+				return nil, diags
+			}
+
+			variable := &declaredVariable{Name: block.Labels[0], Type: cty.DynamicPseudoType}
+			if attr, ok := attrs.Attributes["type"]; ok {
+				ty, diags := typeexpr.TypeConstraint(attr.Expr)
+				if diags.HasErrors() {
+					return nil, diags
+				}
+				variable.Type = ty
+			}
+			if _, ok := attrs.Attributes["default"]; !ok {
+				variable.Required = true
+			}
+			declared[variable.Name] = variable
+		}
+	}
+	return declared, nil
+}
+
+// parseTerraformFile parses a Terraform file in the native or JSON syntax, depending on its name
+func parseTerraformFile(parser *hclparse.Parser, name string, data []byte) (*hcl.File, hcl.Diagnostics) {
+	if strings.HasSuffix(name, ".json") {
+		return parser.ParseJSON(data, name)
+	}
+	return parser.ParseHCL(data, name)
+}
+
+// presetVariableKeys returns the Terraform variables set on a workspace directly or through variable sets
+func presetVariableKeys(ctx context.Context, tfeClient *tfe.Client, workspaceID string) (map[string]bool, error) {
+	keys, err := workspaceVariableKeys(ctx, tfeClient, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	preset := make(map[string]bool)
+	for key := range keys[tfe.CategoryTerraform] {
+		preset[key] = true
+	}
+	return preset, nil
+}
+
+// workspaceVariableKeys returns the keys of the variables set on a workspace directly or through
+// variable sets, by category
+func workspaceVariableKeys(ctx context.Context, tfeClient *tfe.Client, workspaceID string) (map[tfe.CategoryType]map[string]bool, error) {
+	keys := make(map[tfe.CategoryType]map[string]bool)
+	add := func(category tfe.CategoryType, key string) {
+		if keys[category] == nil {
+			keys[category] = make(map[string]bool)
+		}
+		keys[category][key] = true
+	}
+
+	listOptions := tfe.ListOptions{PageNumber: 1, PageSize: 100}
+	for {
+		vars, err := tfeClient.Variables.List(ctx, workspaceID, &tfe.VariableListOptions{ListOptions: listOptions})
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range vars.Items {
+			add(v.Category, v.Key)
+		}
+		if vars.Pagination == nil || vars.Pagination.NextPage == 0 {
+			break
+		}
+		listOptions.PageNumber = vars.Pagination.NextPage
+	}
+
+	listOptions = tfe.ListOptions{PageNumber: 1, PageSize: 100}
+	for {
+		sets, err := tfeClient.VariableSets.ListForWorkspace(ctx, workspaceID, &tfe.VariableSetListOptions{
+			ListOptions: listOptions,
+			Include:     string(tfe.VariableSetVars),
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, set := range sets.Items {
+			for _, v := range set.Variables {
+				add(v.Category, v.Key)
+			}
+		}
+		if sets.Pagination == nil || sets.Pagination.NextPage == 0 {
+			break
+		}
+		listOptions.PageNumber = sets.Pagination.NextPage
+	}
+
+	return keys, nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,110 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func newConfigurationArchive(t *testing.T, files map[string]string) []byte {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for name, content := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(content)), Typeflag: tar.TypeReg}))
+		_, err := tw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+	return buf.Bytes()
+}
+
+func TestRunVariables(t *testing.T) {
+	archive := newConfigurationArchive(t, map[string]string{
+		"./infra/variables.tf": `
+variable "region" {
+  type = string
+}
+
+variable "instance_count" {
+  type    = number
+  default = 1
+}
+
+variable "tags" {
+  type    = map(string)
+  default = {}
+}
+
+variable "untyped" {}
+`,
+		"./infra/main.tf.json": `{"variable": {"environment": {"type": "string"}}}`,
+		"./infra/README.md":    `variable "ignored" {}`,
+		"./other/variables.tf": `variable "ignored" {}`,
+	})
+
+	t.Run("declared variables of the working directory", func(t *testing.T) {
+		files, err := configurationFiles(archive, "infra")
+		require.NoError(t, err)
+		assert.Len(t, files, 2)
+
+		declared, err := declaredVariables(files)
+		require.NoError(t, err)
+		assert.Len(t, declared, 5)
+		assert.True(t, declared["region"].Required)
+		assert.Equal(t, cty.String, declared["region"].Type)
+		assert.False(t, declared["instance_count"].Required)
+		assert.Equal(t, cty.Map(cty.String), declared["tags"].Type)
+		assert.Equal(t, cty.DynamicPseudoType, declared["untyped"].Type)
+		assert.True(t, declared["environment"].Required)
+		assert.NotContains(t, declared, "ignored")
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		files, err := configurationFiles(archive, "infra")
+		require.NoError(t, err)
+		declared, err := declaredVariables(files)
+		require.NoError(t, err)
+
+		err = validateRunVariables(declared, map[string]any{
+			"region":         "eu-west-1",
+			"instance_count": float64(3),
+			"tags":           map[string]any{"team": "platform"},
+			"untyped":        []any{"a", true},
+		}, map[string]bool{"environment": true})
+		assert.NoError(t, err)
+
+		err = validateRunVariables(declared, map[string]any{
+			"regoin":         "eu-west-1",
+			"instance_count": "three",
+		}, map[string]bool{})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `unknown variable "regoin"`)
+		assert.Contains(t, err.Error(), `variable "instance_count": expected number`)
+		assert.Contains(t, err.Error(), `required variable "region" is not set`)
+		assert.Contains(t, err.Error(), `required variable "environment" is not set`)
+
+		// Without the preset variables required variables are not checked
+		err = validateRunVariables(declared, map[string]any{}, nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("values are rendered as HCL", func(t *testing.T) {
+		value, err := runVariableValueHCL("${var.x}")
+		require.NoError(t, err)
+		assert.Equal(t, `"$${var.x}"`, value)
+
+		value, err = runVariableValueHCL(map[string]any{"a": []any{float64(1), false}})
+		require.NoError(t, err)
+		assert.Equal(t, `{"a":[1,false]}`, value)
+	})
+}
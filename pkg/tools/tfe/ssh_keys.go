@@ -0,0 +1,227 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// SSHKeySummary is an SSH key of an organization. The private key itself cannot be read back.
+type SSHKeySummary struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// ListSSHKeys creates a tool to list the SSH keys of an organization.
+func ListSSHKeys(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("list_ssh_keys",
+			mcp.WithDescription(`Lists the SSH keys of an organization with their ID and name. SSH keys are assigned to workspaces with assign_workspace_ssh_key so that runs can clone modules from private Git repositories over SSH.`),
+			mcp.WithTitleAnnotation("List the SSH keys of an organization"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("terraform_org_name",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return listSSHKeysHandler(ctx, request, logger)
+		},
+	}
+}
+
+// CreateSSHKey creates a tool to add an SSH key to an organization.
+func CreateSSHKey(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("create_ssh_key",
+			mcp.WithDescription(`Adds an SSH private key to an organization, to be assigned to workspaces that source modules from private Git repositories over SSH. The key is write-only: it cannot be read back once added. Requires an organization or owners team token.`),
+			mcp.WithTitleAnnotation("Add an SSH key to an organization"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(false),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("terraform_org_name",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+			mcp.WithString("name",
+				mcp.Required(),
+				mcp.Description("A name to identify the SSH key"),
+			),
+			mcp.WithString("value",
+				mcp.Required(),
+				mcp.Description("The PEM-encoded SSH private key, including its BEGIN and END lines. It must not be protected by a passphrase"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return createSSHKeyHandler(ctx, request, logger)
+		},
+	}
+}
+
+// DeleteSSHKey creates a tool to delete an SSH key of an organization.
+func DeleteSSHKey(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("delete_ssh_key",
+			mcp.WithDescription(`Deletes an SSH key of an organization, by ID or name. Workspaces that use the key can no longer clone modules from private Git repositories over SSH.`),
+			mcp.WithTitleAnnotation("Delete an SSH key of an organization"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(false),
+			mcp.WithDestructiveHintAnnotation(true),
+			mcp.WithString("terraform_org_name",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+			mcp.WithString("ssh_key",
+				mcp.Required(),
+				mcp.Description("The ID (e.g. 'sshkey-abc123') or name of the SSH key to delete"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return deleteSSHKeyHandler(ctx, request, logger)
+		},
+	}
+}
+
+func listSSHKeysHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	orgName, err := request.RequireString("terraform_org_name")
+	if err != nil {
+		return ToolError(logger, "missing required input: terraform_org_name", err)
+	}
+	orgName = strings.TrimSpace(orgName)
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+
+	keys, err := listSSHKeys(ctx, tfeClient, orgName)
+	if err != nil {
+		return ToolErrorf(logger, "failed to list SSH keys of org '%s': %v", orgName, err)
+	}
+
+	summaries := make([]SSHKeySummary, 0, len(keys))
+	for _, key := range keys {
+		summaries = append(summaries, SSHKeySummary{ID: key.ID, Name: key.Name})
+	}
+	buf, err := json.Marshal(summaries)
+	if err != nil {
+		return ToolError(logger, "failed to marshal SSH keys", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+func createSSHKeyHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	orgName, err := request.RequireString("terraform_org_name")
+	if err != nil {
+		return ToolError(logger, "missing required input: terraform_org_name", err)
+	}
+	orgName = strings.TrimSpace(orgName)
+
+	name, err := request.RequireString("name")
+	if err != nil {
+		return ToolError(logger, "missing required input: name", err)
+	}
+	name = strings.TrimSpace(name)
+
+	value, err := request.RequireString("value")
+	if err != nil {
+		return ToolError(logger, "missing required input: value", err)
+	}
+	if err := validateSSHPrivateKey(value); err != nil {
+		return ToolError(logger, err.Error(), nil)
+	}
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+
+	key, err := tfeClient.SSHKeys.Create(ctx, orgName, tfe.SSHKeyCreateOptions{
+		Name:  tfe.String(name),
+		Value: tfe.String(strings.TrimSpace(value) + "\n"),
+	})
+	if err != nil {
+		return ToolErrorf(logger, "failed to create SSH key '%s' in org '%s': %v", name, orgName, err)
+	}
+
+	buf, err := json.Marshal(SSHKeySummary{ID: key.ID, Name: key.Name})
+	if err != nil {
+		return ToolError(logger, "failed to marshal SSH key", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+func deleteSSHKeyHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	orgName, err := request.RequireString("terraform_org_name")
+	if err != nil {
+		return ToolError(logger, "missing required input: terraform_org_name", err)
+	}
+	orgName = strings.TrimSpace(orgName)
+
+	sshKeyRef, err := request.RequireString("ssh_key")
+	if err != nil {
+		return ToolError(logger, "missing required input: ssh_key", err)
+	}
+	sshKeyRef = strings.TrimSpace(sshKeyRef)
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+
+	sshKey, err := findSSHKey(ctx, tfeClient, orgName, sshKeyRef)
+	if err != nil {
+		return ToolError(logger, "failed to find SSH key", err)
+	}
+
+	if err := tfeClient.SSHKeys.Delete(ctx, sshKey.ID); err != nil {
+		return ToolErrorf(logger, "failed to delete SSH key '%s': %v", sshKey.Name, err)
+	}
+	return mcp.NewToolResultText(fmt.Sprintf("SSH key '%s' (%s) deleted from org '%s'", sshKey.Name, sshKey.ID, orgName)), nil
+}
+
+// listSSHKeys lists all the SSH keys of an organization
+func listSSHKeys(ctx context.Context, tfeClient *tfe.Client, orgName string) ([]*tfe.SSHKey, error) {
+	var keys []*tfe.SSHKey
+	options := &tfe.SSHKeyListOptions{ListOptions: tfe.ListOptions{PageNumber: 1, PageSize: 100}}
+	for {
+		page, err := tfeClient.SSHKeys.List(ctx, orgName, options)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, page.Items...)
+		if page.Pagination == nil || page.Pagination.NextPage == 0 {
+			return keys, nil
+		}
+		options.PageNumber = page.Pagination.NextPage
+	}
+}
+
+// validateSSHPrivateKey checks that a value looks like a PEM-encoded private key, so that public keys
+// and keys marked as encrypted by a passphrase are refused before they are uploaded
+func validateSSHPrivateKey(value string) error {
+	value = strings.TrimSpace(value)
+	if strings.HasPrefix(value, "ssh-") || strings.HasPrefix(value, "ecdsa-") {
+		return fmt.Errorf("value is an SSH public key - provide the private key instead")
+	}
+	if !strings.HasPrefix(value, "-----BEGIN ") || !strings.Contains(value, "PRIVATE KEY-----") {
+		return fmt.Errorf("value must be a PEM-encoded SSH private key, starting with a '-----BEGIN ... PRIVATE KEY-----' line")
+	}
+	if strings.Contains(value, "ENCRYPTED") {
+		return fmt.Errorf("the SSH private key is protected by a passphrase, which Terraform cannot use - provide an unencrypted key")
+	}
+	return nil
+}
@@ -0,0 +1,233 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// UnsafeFullStateEnv returns the sensitive attribute values of state resources when set to true
+const UnsafeFullStateEnv = "MCP_UNSAFE_FULL_STATE"
+
+var (
+	sharedUnsafeFullState   bool
+	sharedUnsafeFullStateMu sync.Mutex
+)
+
+// StateResources are the resource instances of a state version with their non-sensitive attributes
+type StateResources struct {
+	WorkspaceName string                   `json:"workspace_name"`
+	StateVersion  *StateVersionRef         `json:"state_version"`
+	FullState     bool                     `json:"full_state"`
+	Resources     []*StateResourceInstance `json:"resources"`
+	Total         int                      `json:"total"`
+}
+
+// StateResourceInstance is a resource instance of a state. RedactedAttributes lists the attributes
+// removed because they are, or contain, sensitive values.
+type StateResourceInstance struct {
+	Address            string         `json:"address"`
+	Type               string         `json:"type"`
+	Provider           string         `json:"provider,omitempty"`
+	Attributes         map[string]any `json:"attributes"`
+	RedactedAttributes []string       `json:"redacted_attributes,omitempty"`
+}
+
+// SetUnsafeFullState returns the full attributes of state resources, sensitive values included, such as
+// with the --unsafe-full-state flag. MCP_UNSAFE_FULL_STATE enables it as well.
+func SetUnsafeFullState(unsafe bool) {
+	sharedUnsafeFullStateMu.Lock()
+	defer sharedUnsafeFullStateMu.Unlock()
+	sharedUnsafeFullState = unsafe
+}
+
+func unsafeFullState() bool {
+	sharedUnsafeFullStateMu.Lock()
+	defer sharedUnsafeFullStateMu.Unlock()
+	if sharedUnsafeFullState {
+		return true
+	}
+	unsafe, _ := strconv.ParseBool(strings.TrimSpace(utils.GetEnv(UnsafeFullStateEnv, "false")))
+	return unsafe
+}
+
+// GetStateResources creates a tool to read the resources of a state version without their sensitive values.
+func GetStateResources(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("get_state_resources",
+			mcp.WithDescription(`Downloads a state version of a workspace and returns its resource instances with their addresses and attributes. Attributes that Terraform recorded as sensitive, and attributes whose names denote secrets such as passwords, tokens and private keys, are removed and listed in redacted_attributes; the raw state is never returned.
+Defaults to the current state version. Use list_state_versions to find state version IDs and resource_type to narrow large states.`),
+			mcp.WithTitleAnnotation("Get the resources of a state version without sensitive values"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("terraform_org_name",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+			mcp.WithString("workspace_name",
+				mcp.Required(),
+				mcp.Description("Workspace name"),
+			),
+			mcp.WithString("state_version_id",
+				mcp.Description("Optional state version to read. Defaults to the current state version"),
+			),
+			mcp.WithString("resource_type",
+				mcp.Description("Optional resource type to return, e.g. 'aws_instance'"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return getStateResourcesHandler(ctx, request, logger)
+		},
+	}
+}
+
+func getStateResourcesHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	orgName, err := request.RequireString("terraform_org_name")
+	if err != nil {
+		return ToolError(logger, "missing required input: terraform_org_name", err)
+	}
+	orgName = strings.TrimSpace(orgName)
+
+	workspaceName, err := request.RequireString("workspace_name")
+	if err != nil {
+		return ToolError(logger, "missing required input: workspace_name", err)
+	}
+	workspaceName = strings.TrimSpace(workspaceName)
+
+	stateVersionID := strings.TrimSpace(request.GetString("state_version_id", ""))
+	resourceType := strings.TrimSpace(request.GetString("resource_type", ""))
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+
+	var sv *tfe.StateVersion
+	if stateVersionID != "" {
+		sv, err = tfeClient.StateVersions.Read(ctx, stateVersionID)
+	} else {
+		var workspace *tfe.Workspace
+		workspace, err = tfeClient.Workspaces.Read(ctx, orgName, workspaceName)
+		if err != nil {
+			return ToolErrorf(logger, "workspace '%s' not found in org '%s': %v", workspaceName, orgName, err)
+		}
+		sv, err = tfeClient.StateVersions.ReadCurrent(ctx, workspace.ID)
+	}
+	if err != nil {
+		return ToolErrorf(logger, "failed to read the state version: %v", err)
+	}
+
+	state, err := downloadState(ctx, tfeClient, sv)
+	if err != nil {
+		return ToolErrorf(logger, "failed to download state version %s: %v", sv.ID, err)
+	}
+
+	fullState := unsafeFullState()
+	if fullState {
+		logger.WithField("state_version", sv.ID).Warn("Returning the full state with sensitive values, as the unsafe full state mode is enabled")
+	}
+	result := &StateResources{
+		WorkspaceName: workspaceName,
+		StateVersion:  newStateVersionRef(sv),
+		FullState:     fullState,
+		Resources:     stateResourceInstances(state, resourceType, fullState),
+	}
+	result.Total = len(result.Resources)
+
+	buf, err := json.Marshal(result)
+	if err != nil {
+		return ToolError(logger, "failed to marshal state resources", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+// stateResourceInstances lists the resource instances of a state, optionally of one type, removing the
+// sensitive attributes unless the full state is requested
+func stateResourceInstances(state *terraformState, resourceType string, fullState bool) []*StateResourceInstance {
+	instances := []*StateResourceInstance{}
+	for _, resource := range state.Resources {
+		if resourceType != "" && resource.Type != resourceType {
+			continue
+		}
+		address := stateResourceAddress(resource)
+		for _, instance := range resource.Instances {
+			item := &StateResourceInstance{
+				Address:    stateInstanceAddress(address, instance.IndexKey),
+				Type:       resource.Type,
+				Provider:   resource.Provider,
+				Attributes: instance.Attributes,
+			}
+			if item.Attributes == nil {
+				item.Attributes = map[string]any{}
+			}
+			if !fullState {
+				item.Attributes, item.RedactedAttributes = redactStateAttributes(instance.Attributes, instance.SensitiveAttributes)
+			}
+			instances = append(instances, item)
+		}
+	}
+	sort.SliceStable(instances, func(i, j int) bool { return instances[i].Address < instances[j].Address })
+	return instances
+}
+
+// redactStateAttributes returns the attributes of a resource instance without the top-level attributes
+// that are, or contain, a sensitive value, and the sorted names of the removed attributes. When the
+// sensitive paths can't be read, every attribute is removed rather than risk returning a secret.
+func redactStateAttributes(attributes map[string]any, sensitivePaths json.RawMessage) (map[string]any, []string) {
+	sensitive, ok := sensitiveAttributeNames(sensitivePaths)
+	kept := make(map[string]any, len(attributes))
+	var redacted []string
+	for name, value := range attributes {
+		// Older Terraform versions don't record the attributes that providers mark sensitive in
+		// their schemas, so attributes named like secrets are removed as well
+		if !ok || sensitive[name] || utils.IsSecretName(name) {
+			redacted = append(redacted, name)
+			continue
+		}
+		kept[name] = value
+	}
+	sort.Strings(redacted)
+	return kept, redacted
+}
+
+// sensitiveAttributeNames returns the top-level attributes of the sensitive_attributes paths of a state
+// instance, e.g. [[{"type":"get_attr","value":"password"}]], and false when they can't be read
+func sensitiveAttributeNames(sensitivePaths json.RawMessage) (map[string]bool, bool) {
+	names := make(map[string]bool)
+	if len(sensitivePaths) == 0 || string(sensitivePaths) == "null" {
+		return names, true
+	}
+	var paths [][]struct {
+		Type  string `json:"type"`
+		Value any    `json:"value"`
+	}
+	if err := json.Unmarshal(sensitivePaths, &paths); err != nil {
+		return nil, false
+	}
+	for _, path := range paths {
+		if len(path) == 0 {
+			continue
+		}
+		name, isString := path[0].Value.(string)
+		if path[0].Type != "get_attr" || !isString {
+			return nil, false
+		}
+		names[name] = true
+	}
+	return names, true
+}
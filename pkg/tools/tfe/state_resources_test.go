@@ -0,0 +1,94 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"encoding/json"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const sensitiveStateJSON = `{
+  "version": 4,
+  "serial": 3,
+  "resources": [
+    {"mode": "managed", "type": "aws_db_instance", "name": "main", "provider": "provider[\"registry.terraform.io/hashicorp/aws\"]", "instances": [{
+      "attributes": {"id": "db-1", "engine": "postgres", "password": "hunter2", "tags": {"owner": "team-a", "api": "s3cr3t"}},
+      "sensitive_attributes": [[{"type": "get_attr", "value": "tags"}, {"type": "index", "value": {"value": "api", "type": "string"}}]]
+    }]},
+    {"mode": "managed", "type": "random_password", "name": "admin", "instances": [{
+      "attributes": {"id": "none", "result": "p4ss", "length": 16},
+      "sensitive_attributes": [[{"type": "get_attr", "value": "result"}]]
+    }]},
+    {"mode": "managed", "type": "aws_subnet", "name": "private", "instances": [
+      {"index_key": 1, "attributes": {"id": "subnet-1"}},
+      {"index_key": 0, "attributes": {"id": "subnet-0"}, "sensitive_attributes": "unexpected"}
+    ]}
+  ]
+}`
+
+func TestGetStateResources(t *testing.T) {
+	logger := log.New()
+	logger.SetLevel(log.ErrorLevel)
+
+	t.Run("tool creation", func(t *testing.T) {
+		tool := GetStateResources(logger)
+		assert.Equal(t, "get_state_resources", tool.Tool.Name)
+		assert.True(t, *tool.Tool.Annotations.ReadOnlyHint)
+		assert.ElementsMatch(t, []string{"terraform_org_name", "workspace_name"}, tool.Tool.InputSchema.Required)
+	})
+
+	var state terraformState
+	require.NoError(t, json.Unmarshal([]byte(sensitiveStateJSON), &state))
+
+	t.Run("sensitive attributes are removed", func(t *testing.T) {
+		resources := stateResourceInstances(&state, "", false)
+		require.Len(t, resources, 4)
+
+		assert.Equal(t, "aws_db_instance.main", resources[0].Address)
+		assert.Equal(t, `provider["registry.terraform.io/hashicorp/aws"]`, resources[0].Provider)
+		assert.Equal(t, map[string]any{"id": "db-1", "engine": "postgres"}, resources[0].Attributes)
+		assert.Equal(t, []string{"password", "tags"}, resources[0].RedactedAttributes)
+
+		assert.Equal(t, "aws_subnet.private[0]", resources[1].Address)
+		assert.Empty(t, resources[1].Attributes)
+		assert.Equal(t, []string{"id"}, resources[1].RedactedAttributes)
+
+		assert.Equal(t, "aws_subnet.private[1]", resources[2].Address)
+		assert.Equal(t, map[string]any{"id": "subnet-1"}, resources[2].Attributes)
+		assert.Empty(t, resources[2].RedactedAttributes)
+
+		assert.Equal(t, "random_password.admin", resources[3].Address)
+		assert.Equal(t, map[string]any{"id": "none", "length": float64(16)}, resources[3].Attributes)
+		assert.Equal(t, []string{"result"}, resources[3].RedactedAttributes)
+	})
+
+	t.Run("resource type", func(t *testing.T) {
+		resources := stateResourceInstances(&state, "random_password", false)
+		require.Len(t, resources, 1)
+		assert.Equal(t, "random_password.admin", resources[0].Address)
+		assert.Empty(t, stateResourceInstances(&state, "aws_instance", false))
+	})
+
+	t.Run("full state", func(t *testing.T) {
+		resources := stateResourceInstances(&state, "aws_db_instance", true)
+		require.Len(t, resources, 1)
+		assert.Equal(t, "hunter2", resources[0].Attributes["password"])
+		assert.Empty(t, resources[0].RedactedAttributes)
+	})
+
+	t.Run("unsafe full state setting", func(t *testing.T) {
+		t.Cleanup(func() { SetUnsafeFullState(false) })
+		t.Setenv(UnsafeFullStateEnv, "")
+		assert.False(t, unsafeFullState())
+		t.Setenv(UnsafeFullStateEnv, "true")
+		assert.True(t, unsafeFullState())
+		t.Setenv(UnsafeFullStateEnv, "")
+		SetUnsafeFullState(true)
+		assert.True(t, unsafeFullState())
+	})
+}
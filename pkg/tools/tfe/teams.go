@@ -0,0 +1,501 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// ownersTeamName is the name of the team whose members administer the whole organization
+const ownersTeamName = "owners"
+
+// OrganizationMember is a user invited to or member of an organization
+type OrganizationMember struct {
+	MembershipID string   `json:"membership_id"`
+	Status       string   `json:"status"`
+	Email        string   `json:"email,omitempty"`
+	UserID       string   `json:"user_id,omitempty"`
+	Username     string   `json:"username,omitempty"`
+	Teams        []string `json:"teams"`
+}
+
+// TeamSummary is a team of an organization
+type TeamSummary struct {
+	ID                 string   `json:"id"`
+	Name               string   `json:"name"`
+	Visibility         string   `json:"visibility,omitempty"`
+	UserCount          int      `json:"user_count"`
+	SSOTeamID          string   `json:"sso_team_id,omitempty"`
+	OrganizationAccess []string `json:"organization_access"`
+}
+
+// TeamDetails describes a team with its members
+type TeamDetails struct {
+	TeamSummary
+	Members []*TeamMember `json:"members"`
+}
+
+// TeamMember is a user of a team
+type TeamMember struct {
+	UserID           string `json:"user_id"`
+	Username         string `json:"username"`
+	Email            string `json:"email,omitempty"`
+	IsServiceAccount bool   `json:"is_service_account,omitempty"`
+}
+
+// WorkspaceTeamAccess lists the teams with access to a workspace
+type WorkspaceTeamAccess struct {
+	WorkspaceID            string                `json:"workspace_id"`
+	WorkspaceName          string                `json:"workspace_name"`
+	Teams                  []*TeamWorkspaceGrant `json:"teams"`
+	OrganizationWideAdmins []*TeamWorkspaceGrant `json:"organization_wide_admins"`
+}
+
+// TeamWorkspaceGrant is the access of a team to a workspace
+type TeamWorkspaceGrant struct {
+	TeamID      string            `json:"team_id"`
+	TeamName    string            `json:"team_name"`
+	Access      string            `json:"access"`
+	Permissions map[string]string `json:"permissions,omitempty"`
+	Reason      string            `json:"reason,omitempty"`
+	Members     []*TeamMember     `json:"members,omitempty"`
+}
+
+// ListOrganizationMemberships creates a tool to list the members of an organization.
+func ListOrganizationMemberships(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("list_organization_memberships",
+			mcp.WithDescription(`Lists the members of an organization, and the users invited to it, with their email, username and the teams they belong to.`),
+			mcp.WithTitleAnnotation("List the members of an organization"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("terraform_org_name",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+			mcp.WithString("query",
+				mcp.Description("Optional search on the user name or email"),
+			),
+			mcp.WithString("status",
+				mcp.Description("Optional membership status filter"),
+				mcp.Enum("active", "invited"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return listOrganizationMembershipsHandler(ctx, request, logger)
+		},
+	}
+}
+
+// ListTeams creates a tool to list the teams of an organization.
+func ListTeams(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("list_teams",
+			mcp.WithDescription(`Lists the teams of an organization with their visibility, number of users and organization-level permissions such as manage_workspaces or manage_policies.`),
+			mcp.WithTitleAnnotation("List the teams of an organization"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("terraform_org_name",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+			mcp.WithString("query",
+				mcp.Description("Optional search on the team name"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return listTeamsHandler(ctx, request, logger)
+		},
+	}
+}
+
+// GetTeamDetails creates a tool to describe a team and its members.
+func GetTeamDetails(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("get_team_details",
+			mcp.WithDescription(`Returns a team of an organization with its organization-level permissions and its members.`),
+			mcp.WithTitleAnnotation("Get the details of a team"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("terraform_org_name",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+			mcp.WithString("team_name",
+				mcp.Required(),
+				mcp.Description("Team name"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return getTeamDetailsHandler(ctx, request, logger)
+		},
+	}
+}
+
+// ListWorkspaceTeamAccess creates a tool to list the teams with access to a workspace.
+func ListWorkspaceTeamAccess(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("list_workspace_team_access",
+			mcp.WithDescription(`Lists the teams with access to a workspace and their access level (read, plan, write, admin or custom with the individual permissions), and the teams that administer every workspace of the organization. Use this to answer questions such as "who has admin on workspace X".`),
+			mcp.WithTitleAnnotation("List the teams with access to a workspace"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("terraform_org_name",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+			mcp.WithString("workspace_name",
+				mcp.Required(),
+				mcp.Description("Workspace name"),
+			),
+			mcp.WithBoolean("include_members",
+				mcp.Description("Also list the members of every team"),
+				mcp.DefaultBool(false),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return listWorkspaceTeamAccessHandler(ctx, request, logger)
+		},
+	}
+}
+
+func listOrganizationMembershipsHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	orgName, err := request.RequireString("terraform_org_name")
+	if err != nil {
+		return ToolError(logger, "missing required input: terraform_org_name", err)
+	}
+	orgName = strings.TrimSpace(orgName)
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+
+	options := &tfe.OrganizationMembershipListOptions{
+		ListOptions: tfe.ListOptions{PageNumber: 1, PageSize: 100},
+		Include:     []tfe.OrgMembershipIncludeOpt{tfe.OrgMembershipUser, tfe.OrgMembershipTeam},
+		Query:       strings.TrimSpace(request.GetString("query", "")),
+		Status:      tfe.OrganizationMembershipStatus(request.GetString("status", "")),
+	}
+	members := []*OrganizationMember{}
+	for {
+		page, err := tfeClient.OrganizationMemberships.List(ctx, orgName, options)
+		if err != nil {
+			return ToolErrorf(logger, "failed to list the members of org '%s': %v", orgName, err)
+		}
+		for _, membership := range page.Items {
+			members = append(members, organizationMember(membership))
+		}
+		if page.Pagination == nil || page.NextPage == 0 {
+			break
+		}
+		options.PageNumber = page.NextPage
+	}
+
+	buf, err := json.Marshal(members)
+	if err != nil {
+		return ToolError(logger, "failed to marshal organization members", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+func listTeamsHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	orgName, err := request.RequireString("terraform_org_name")
+	if err != nil {
+		return ToolError(logger, "missing required input: terraform_org_name", err)
+	}
+	orgName = strings.TrimSpace(orgName)
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+
+	teams, err := listTeams(ctx, tfeClient, orgName, &tfe.TeamListOptions{
+		Query: strings.TrimSpace(request.GetString("query", "")),
+	})
+	if err != nil {
+		return ToolErrorf(logger, "failed to list the teams of org '%s': %v", orgName, err)
+	}
+
+	summaries := make([]*TeamSummary, 0, len(teams))
+	for _, team := range teams {
+		summaries = append(summaries, teamSummary(team))
+	}
+
+	buf, err := json.Marshal(summaries)
+	if err != nil {
+		return ToolError(logger, "failed to marshal teams", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+func getTeamDetailsHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	orgName, err := request.RequireString("terraform_org_name")
+	if err != nil {
+		return ToolError(logger, "missing required input: terraform_org_name", err)
+	}
+	orgName = strings.TrimSpace(orgName)
+
+	teamName, err := request.RequireString("team_name")
+	if err != nil {
+		return ToolError(logger, "missing required input: team_name", err)
+	}
+	teamName = strings.TrimSpace(teamName)
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+
+	teams, err := listTeams(ctx, tfeClient, orgName, &tfe.TeamListOptions{
+		Names:   []string{teamName},
+		Include: []tfe.TeamIncludeOpt{tfe.TeamUsers},
+	})
+	if err != nil {
+		return ToolErrorf(logger, "failed to read team '%s' in org '%s': %v", teamName, orgName, err)
+	}
+	var team *tfe.Team
+	for _, t := range teams {
+		if t.Name == teamName {
+			team = t
+		}
+	}
+	if team == nil {
+		return ToolErrorf(logger, "team '%s' not found in org '%s'", teamName, orgName)
+	}
+
+	buf, err := json.Marshal(TeamDetails{
+		TeamSummary: *teamSummary(team),
+		Members:     teamMembers(team.Users),
+	})
+	if err != nil {
+		return ToolError(logger, "failed to marshal team details", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+func listWorkspaceTeamAccessHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	orgName, err := request.RequireString("terraform_org_name")
+	if err != nil {
+		return ToolError(logger, "missing required input: terraform_org_name", err)
+	}
+	orgName = strings.TrimSpace(orgName)
+
+	workspaceName, err := request.RequireString("workspace_name")
+	if err != nil {
+		return ToolError(logger, "missing required input: workspace_name", err)
+	}
+	workspaceName = strings.TrimSpace(workspaceName)
+	includeMembers := request.GetBool("include_members", false)
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+
+	workspace, err := tfeClient.Workspaces.Read(ctx, orgName, workspaceName)
+	if err != nil {
+		return ToolErrorf(logger, "workspace '%s' not found in org '%s'", workspaceName, orgName)
+	}
+
+	var accesses []*tfe.TeamAccess
+	options := &tfe.TeamAccessListOptions{
+		ListOptions: tfe.ListOptions{PageNumber: 1, PageSize: 100},
+		WorkspaceID: workspace.ID,
+	}
+	for {
+		page, err := tfeClient.TeamAccess.List(ctx, options)
+		if err != nil {
+			return ToolErrorf(logger, "failed to list the team access of workspace '%s': %v", workspaceName, err)
+		}
+		accesses = append(accesses, page.Items...)
+		if page.Pagination == nil || page.NextPage == 0 {
+			break
+		}
+		options.PageNumber = page.NextPage
+	}
+
+	// The team access only references the teams, their names and members come from the team list
+	teamOptions := &tfe.TeamListOptions{}
+	if includeMembers {
+		teamOptions.Include = []tfe.TeamIncludeOpt{tfe.TeamUsers}
+	}
+	teams, err := listTeams(ctx, tfeClient, orgName, teamOptions)
+	if err != nil {
+		return ToolErrorf(logger, "failed to list the teams of org '%s': %v", orgName, err)
+	}
+
+	buf, err := json.Marshal(workspaceTeamAccess(workspace, accesses, teams, includeMembers))
+	if err != nil {
+		return ToolError(logger, "failed to marshal workspace team access", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+// listTeams lists every team of an organization matching the options
+func listTeams(ctx context.Context, tfeClient *tfe.Client, orgName string, options *tfe.TeamListOptions) ([]*tfe.Team, error) {
+	options.ListOptions = tfe.ListOptions{PageNumber: 1, PageSize: 100}
+	var teams []*tfe.Team
+	for {
+		page, err := tfeClient.Teams.List(ctx, orgName, options)
+		if err != nil {
+			return nil, err
+		}
+		teams = append(teams, page.Items...)
+		if page.Pagination == nil || page.NextPage == 0 {
+			return teams, nil
+		}
+		options.PageNumber = page.NextPage
+	}
+}
+
+func organizationMember(membership *tfe.OrganizationMembership) *OrganizationMember {
+	member := &OrganizationMember{
+		MembershipID: membership.ID,
+		Status:       string(membership.Status),
+		Email:        membership.Email,
+		Teams:        []string{},
+	}
+	if membership.User != nil {
+		member.UserID = membership.User.ID
+		member.Username = membership.User.Username
+		if member.Email == "" {
+			member.Email = membership.User.Email
+		}
+	}
+	for _, team := range membership.Teams {
+		member.Teams = append(member.Teams, team.Name)
+	}
+	sort.Strings(member.Teams)
+	return member
+}
+
+func teamSummary(team *tfe.Team) *TeamSummary {
+	return &TeamSummary{
+		ID:                 team.ID,
+		Name:               team.Name,
+		Visibility:         team.Visibility,
+		UserCount:          team.UserCount,
+		SSOTeamID:          team.SSOTeamID,
+		OrganizationAccess: organizationAccess(team),
+	}
+}
+
+// organizationAccess lists the organization-level permissions granted to a team
+func organizationAccess(team *tfe.Team) []string {
+	granted := []string{}
+	access := team.OrganizationAccess
+	if access == nil {
+		return granted
+	}
+	for name, ok := range map[string]bool{
+		"manage_policies":         access.ManagePolicies,
+		"manage_policy_overrides": access.ManagePolicyOverrides,
+		"manage_workspaces":       access.ManageWorkspaces,
+		"manage_vcs_settings":     access.ManageVCSSettings,
+		"manage_providers":        access.ManageProviders,
+		"manage_modules":          access.ManageModules,
+		"manage_run_tasks":        access.ManageRunTasks,
+		"manage_projects":         access.ManageProjects,
+		"manage_membership":       access.ManageMembership,
+		"read_workspaces":         access.ReadWorkspaces,
+		"read_projects":           access.ReadProjects,
+	} {
+		if ok {
+			granted = append(granted, name)
+		}
+	}
+	sort.Strings(granted)
+	return granted
+}
+
+func teamMembers(users []*tfe.User) []*TeamMember {
+	members := make([]*TeamMember, 0, len(users))
+	for _, user := range users {
+		members = append(members, &TeamMember{
+			UserID:           user.ID,
+			Username:         user.Username,
+			Email:            user.Email,
+			IsServiceAccount: user.IsServiceAccount,
+		})
+	}
+	sort.Slice(members, func(i, j int) bool { return members[i].Username < members[j].Username })
+	return members
+}
+
+// workspaceTeamAccess combines the team access of a workspace with the teams of the organization.
+// Besides the teams granted access to the workspace, the owners team and teams allowed to manage
+// every workspace are administrators of the workspace.
+func workspaceTeamAccess(workspace *tfe.Workspace, accesses []*tfe.TeamAccess, teams []*tfe.Team, includeMembers bool) *WorkspaceTeamAccess {
+	teamsByID := make(map[string]*tfe.Team, len(teams))
+	for _, team := range teams {
+		teamsByID[team.ID] = team
+	}
+
+	result := &WorkspaceTeamAccess{
+		WorkspaceID:            workspace.ID,
+		WorkspaceName:          workspace.Name,
+		Teams:                  []*TeamWorkspaceGrant{},
+		OrganizationWideAdmins: []*TeamWorkspaceGrant{},
+	}
+	for _, access := range accesses {
+		if access.Team == nil {
+			continue
+		}
+		grant := &TeamWorkspaceGrant{TeamID: access.Team.ID, Access: string(access.Access)}
+		if team, ok := teamsByID[access.Team.ID]; ok {
+			grant.TeamName = team.Name
+			if includeMembers {
+				grant.Members = teamMembers(team.Users)
+			}
+		}
+		if access.Access == tfe.AccessCustom {
+			grant.Permissions = map[string]string{
+				"runs":           string(access.Runs),
+				"variables":      string(access.Variables),
+				"state_versions": string(access.StateVersions),
+				"sentinel_mocks": string(access.SentinelMocks),
+			}
+		}
+		result.Teams = append(result.Teams, grant)
+	}
+
+	for _, team := range teams {
+		var reason string
+		switch {
+		case team.Name == ownersTeamName:
+			reason = "members of the owners team administer the whole organization"
+		case team.OrganizationAccess != nil && team.OrganizationAccess.ManageWorkspaces:
+			reason = "the team can manage all workspaces of the organization"
+		default:
+			continue
+		}
+		grant := &TeamWorkspaceGrant{TeamID: team.ID, TeamName: team.Name, Access: string(tfe.AccessAdmin), Reason: reason}
+		if includeMembers {
+			grant.Members = teamMembers(team.Users)
+		}
+		result.OrganizationWideAdmins = append(result.OrganizationWideAdmins, grant)
+	}
+
+	sort.Slice(result.Teams, func(i, j int) bool { return result.Teams[i].TeamName < result.Teams[j].TeamName })
+	sort.Slice(result.OrganizationWideAdmins, func(i, j int) bool {
+		return result.OrganizationWideAdmins[i].TeamName < result.OrganizationWideAdmins[j].TeamName
+	})
+	return result
+}
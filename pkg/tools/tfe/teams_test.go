@@ -0,0 +1,93 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTeamTools(t *testing.T) {
+	logger := log.New()
+	logger.SetLevel(log.ErrorLevel)
+
+	t.Run("tool creation", func(t *testing.T) {
+		for name, tool := range map[string]func(*log.Logger) server.ServerTool{
+			"list_organization_memberships": ListOrganizationMemberships,
+			"list_teams":                    ListTeams,
+			"get_team_details":              GetTeamDetails,
+			"list_workspace_team_access":    ListWorkspaceTeamAccess,
+		} {
+			created := tool(logger)
+			assert.Equal(t, name, created.Tool.Name)
+			assert.NotNil(t, created.Handler)
+			assert.NotNil(t, created.Tool.Annotations.ReadOnlyHint)
+			assert.True(t, *created.Tool.Annotations.ReadOnlyHint)
+			assert.Contains(t, created.Tool.InputSchema.Required, "terraform_org_name")
+		}
+
+		assert.Contains(t, GetTeamDetails(logger).Tool.InputSchema.Required, "team_name")
+		assert.Contains(t, ListWorkspaceTeamAccess(logger).Tool.InputSchema.Required, "workspace_name")
+	})
+}
+
+func TestOrganizationMember(t *testing.T) {
+	member := organizationMember(&tfe.OrganizationMembership{
+		ID:     "ou-1",
+		Status: tfe.OrganizationMembershipActive,
+		User:   &tfe.User{ID: "user-1", Username: "jane", Email: "jane@example.com"},
+		Teams:  []*tfe.Team{{Name: "platform"}, {Name: "owners"}},
+	})
+
+	assert.Equal(t, "active", member.Status)
+	assert.Equal(t, "jane", member.Username)
+	assert.Equal(t, "jane@example.com", member.Email)
+	assert.Equal(t, []string{"owners", "platform"}, member.Teams)
+}
+
+func TestWorkspaceTeamAccess(t *testing.T) {
+	workspace := &tfe.Workspace{ID: "ws-1", Name: "prod"}
+	teams := []*tfe.Team{
+		{ID: "team-owners", Name: "owners", Users: []*tfe.User{{ID: "user-1", Username: "root"}}},
+		{ID: "team-ops", Name: "ops", OrganizationAccess: &tfe.OrganizationAccess{ManageWorkspaces: true}},
+		{ID: "team-dev", Name: "dev", OrganizationAccess: &tfe.OrganizationAccess{ReadWorkspaces: true}, Users: []*tfe.User{{ID: "user-3", Username: "zoe"}, {ID: "user-2", Username: "al"}}},
+		{ID: "team-audit", Name: "audit"},
+	}
+	accesses := []*tfe.TeamAccess{
+		{ID: "tws-1", Access: tfe.AccessWrite, Team: &tfe.Team{ID: "team-dev"}},
+		{ID: "tws-2", Access: tfe.AccessCustom, Runs: tfe.RunsPermissionRead, Variables: tfe.VariablesPermissionNone, Team: &tfe.Team{ID: "team-audit"}},
+	}
+
+	result := workspaceTeamAccess(workspace, accesses, teams, true)
+
+	require.Len(t, result.Teams, 2)
+	assert.Equal(t, "audit", result.Teams[0].TeamName)
+	assert.Equal(t, "custom", result.Teams[0].Access)
+	assert.Equal(t, "read", result.Teams[0].Permissions["runs"])
+	assert.Equal(t, "dev", result.Teams[1].TeamName)
+	assert.Equal(t, "write", result.Teams[1].Access)
+	assert.Nil(t, result.Teams[1].Permissions)
+	require.Len(t, result.Teams[1].Members, 2)
+	assert.Equal(t, "al", result.Teams[1].Members[0].Username)
+
+	require.Len(t, result.OrganizationWideAdmins, 2)
+	assert.Equal(t, "ops", result.OrganizationWideAdmins[0].TeamName)
+	assert.Equal(t, "owners", result.OrganizationWideAdmins[1].TeamName)
+	assert.Equal(t, "admin", result.OrganizationWideAdmins[1].Access)
+
+	result = workspaceTeamAccess(workspace, accesses, teams, false)
+	assert.Empty(t, result.Teams[1].Members)
+}
+
+func TestOrganizationAccess(t *testing.T) {
+	assert.Equal(t, []string{}, organizationAccess(&tfe.Team{}))
+	assert.Equal(t, []string{"manage_policies", "manage_workspaces"}, organizationAccess(&tfe.Team{
+		OrganizationAccess: &tfe.OrganizationAccess{ManageWorkspaces: true, ManagePolicies: true},
+	}))
+}
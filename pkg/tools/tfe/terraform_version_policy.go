@@ -0,0 +1,259 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// ApprovedTerraformVersionsEnv sets the comma-separated Terraform versions or version constraints
+// workspaces are allowed to use, e.g. "1.9.8,~> 1.10.0"
+const ApprovedTerraformVersionsEnv = "MCP_APPROVED_TERRAFORM_VERSIONS"
+
+// Compliance statuses of a workspace in a Terraform version policy report
+const (
+	VersionPolicyNonCompliant = "non_compliant"
+	VersionPolicyUpdated      = "updated"
+	VersionPolicyFailed       = "failed"
+)
+
+// terraformVersionPolicy is the list of approved Terraform versions
+type terraformVersionPolicy struct {
+	entries     []string
+	constraints []version.Constraints
+}
+
+// TerraformVersionPolicyReport is the result of checking and enforcing the Terraform version policy
+type TerraformVersionPolicyReport struct {
+	Organization     string                             `json:"organization"`
+	ApprovedVersions []string                           `json:"approved_versions"`
+	TargetVersion    string                             `json:"target_version,omitempty"`
+	Remediate        bool                               `json:"remediate"`
+	WorkspaceCount   int                                `json:"workspace_count"`
+	CompliantCount   int                                `json:"compliant_count"`
+	UpdatedCount     int                                `json:"updated_count"`
+	FailedCount      int                                `json:"failed_count"`
+	NonCompliant     []*TerraformVersionPolicyWorkspace `json:"non_compliant_workspaces"`
+}
+
+// TerraformVersionPolicyWorkspace is a workspace that does not use an approved Terraform version
+type TerraformVersionPolicyWorkspace struct {
+	ID               string `json:"id"`
+	Name             string `json:"workspace_name"`
+	ProjectID        string `json:"project_id,omitempty"`
+	TerraformVersion string `json:"terraform_version"`
+	Status           string `json:"status"`
+	NewVersion       string `json:"new_terraform_version,omitempty"`
+	Error            string `json:"error,omitempty"`
+}
+
+// EnforceTerraformVersionPolicy creates a tool to find and update workspaces that do not use an approved Terraform version
+func EnforceTerraformVersionPolicy(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("enforce_terraform_version_policy",
+			mcp.WithDescription(fmt.Sprintf(`Checks the Terraform version of every workspace in an organization against the approved versions configured by the server operator in %s, and reports the workspaces that do not comply.
+With remediate, the non-compliant workspaces are updated to target_version in bulk and the report lists the outcome for each of them. Changing the Terraform version affects the next runs of a workspace, so only remediate after the user confirmed the report.`, ApprovedTerraformVersionsEnv)),
+			mcp.WithTitleAnnotation("Enforce the approved Terraform versions of an organization"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(false),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("terraform_org_name",
+				mcp.Required(),
+				mcp.Description("The Terraform organization name"),
+			),
+			mcp.WithString("project_id",
+				mcp.Description("Optional project ID to restrict the check to"),
+			),
+			mcp.WithBoolean("remediate",
+				mcp.Description("Update the non-compliant workspaces to target_version. Without it, the tool only reports them"),
+				mcp.DefaultBool(false),
+			),
+			mcp.WithString("target_version",
+				mcp.Description("The approved Terraform version to update non-compliant workspaces to. Defaults to the highest exact version in the approved list"),
+			),
+			withOnBehalfOf(),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return enforceTerraformVersionPolicyHandler(ctx, request, logger)
+		},
+	}
+}
+
+func enforceTerraformVersionPolicyHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	terraformOrgName, err := request.RequireString("terraform_org_name")
+	if err != nil {
+		return ToolError(logger, "missing required input: terraform_org_name", err)
+	}
+	terraformOrgName = strings.TrimSpace(terraformOrgName)
+	projectID := strings.TrimSpace(request.GetString("project_id", ""))
+	remediate := request.GetBool("remediate", false)
+	requester := onBehalfOf(request)
+
+	policy, err := parseTerraformVersionPolicy(utils.GetEnv(ApprovedTerraformVersionsEnv, ""))
+	if err != nil {
+		return ToolError(logger, err.Error(), nil)
+	}
+
+	var targetVersion string
+	if remediate {
+		targetVersion, err = policy.target(strings.TrimSpace(request.GetString("target_version", "")))
+		if err != nil {
+			return ToolError(logger, err.Error(), nil)
+		}
+	}
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client - ensure TFE_TOKEN and TFE_ADDRESS are configured", err)
+	}
+
+	var sessionID string
+	if session := server.ClientSessionFromContext(ctx); session != nil {
+		sessionID = session.SessionID()
+	}
+
+	// Cached inventories can miss version changes made since, so remediation always walks every workspace
+	inventory, err := client.GetWorkspaceInventory(ctx, tfeClient, sessionID, terraformOrgName, remediate, logger)
+	if err != nil {
+		return ToolErrorf(logger, "failed to build workspace inventory for org '%s': %v", terraformOrgName, err)
+	}
+
+	report := policy.check(inventory, projectID)
+	report.Remediate = remediate
+	report.TargetVersion = targetVersion
+
+	if remediate {
+		for _, ws := range report.NonCompliant {
+			_, err := tfeClient.Workspaces.UpdateByID(ctx, ws.ID, tfe.WorkspaceUpdateOptions{
+				TerraformVersion: tfe.String(targetVersion),
+			})
+			if err != nil {
+				ws.Status = VersionPolicyFailed
+				ws.Error = err.Error()
+				report.FailedCount++
+				logger.Warnf("Failed to update the Terraform version of workspace %s: %v", ws.ID, err)
+				continue
+			}
+			ws.Status = VersionPolicyUpdated
+			ws.NewVersion = targetVersion
+			report.UpdatedCount++
+			auditLog(logger, "enforce_terraform_version", requester, log.Fields{
+				"workspace_id":          ws.ID,
+				"workspace":             ws.Name,
+				"terraform_version":     ws.TerraformVersion,
+				"new_terraform_version": targetVersion,
+			})
+		}
+	}
+
+	buf, err := json.Marshal(report)
+	if err != nil {
+		return ToolError(logger, "failed to marshal Terraform version policy report", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+// parseTerraformVersionPolicy parses the comma-separated approved versions or version constraints
+func parseTerraformVersionPolicy(value string) (*terraformVersionPolicy, error) {
+	policy := &terraformVersionPolicy{}
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		constraints, err := version.NewConstraint(entry)
+		if err != nil {
+			return nil, fmt.Errorf("invalid approved Terraform version '%s' in %s: %v", entry, ApprovedTerraformVersionsEnv, err)
+		}
+		policy.entries = append(policy.entries, entry)
+		policy.constraints = append(policy.constraints, constraints)
+	}
+	if len(policy.entries) == 0 {
+		return nil, fmt.Errorf("no approved Terraform versions are configured - the server operator must set %s", ApprovedTerraformVersionsEnv)
+	}
+	return policy, nil
+}
+
+// approves reports whether a workspace Terraform version is approved. Workspaces set to "latest" or to
+// a version constraint instead of an exact version do not comply, because their version can change.
+func (p *terraformVersionPolicy) approves(terraformVersion string) bool {
+	v, err := version.NewVersion(strings.TrimSpace(terraformVersion))
+	if err != nil {
+		return false
+	}
+	for _, constraints := range p.constraints {
+		if constraints.Check(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// target returns the version to update non-compliant workspaces to: the requested version, which
+// must be approved, or the highest exact version of the approved list
+func (p *terraformVersionPolicy) target(requested string) (string, error) {
+	if requested != "" {
+		v, err := version.NewVersion(requested)
+		if err != nil {
+			return "", fmt.Errorf("invalid target_version '%s': %v", requested, err)
+		}
+		if !p.approves(requested) {
+			return "", fmt.Errorf("target_version %s is not approved - approved versions: %s", requested, strings.Join(p.entries, ", "))
+		}
+		return v.Original(), nil
+	}
+
+	var highest *version.Version
+	for _, entry := range p.entries {
+		v, err := version.NewVersion(entry)
+		if err != nil {
+			continue
+		}
+		if highest == nil || v.GreaterThan(highest) {
+			highest = v
+		}
+	}
+	if highest == nil {
+		return "", fmt.Errorf("the approved versions (%s) contain no exact version - set target_version", strings.Join(p.entries, ", "))
+	}
+	return highest.Original(), nil
+}
+
+// check reports the workspaces of an inventory that do not use an approved Terraform version
+func (p *terraformVersionPolicy) check(inventory *client.WorkspaceInventory, projectID string) *TerraformVersionPolicyReport {
+	report := &TerraformVersionPolicyReport{
+		Organization:     inventory.Organization,
+		ApprovedVersions: p.entries,
+		NonCompliant:     []*TerraformVersionPolicyWorkspace{},
+	}
+	for _, ws := range inventory.Workspaces {
+		if projectID != "" && ws.ProjectID != projectID {
+			continue
+		}
+		report.WorkspaceCount++
+		if p.approves(ws.TerraformVersion) {
+			report.CompliantCount++
+			continue
+		}
+		report.NonCompliant = append(report.NonCompliant, &TerraformVersionPolicyWorkspace{
+			ID:               ws.ID,
+			Name:             ws.Name,
+			ProjectID:        ws.ProjectID,
+			TerraformVersion: ws.TerraformVersion,
+			Status:           VersionPolicyNonCompliant,
+		})
+	}
+	return report
+}
@@ -0,0 +1,93 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnforceTerraformVersionPolicy(t *testing.T) {
+	logger := log.New()
+	logger.SetLevel(log.ErrorLevel)
+
+	t.Run("tool creation", func(t *testing.T) {
+		tool := EnforceTerraformVersionPolicy(logger)
+
+		assert.Equal(t, "enforce_terraform_version_policy", tool.Tool.Name)
+		assert.False(t, *tool.Tool.Annotations.ReadOnlyHint)
+		assert.Equal(t, []string{"terraform_org_name"}, tool.Tool.InputSchema.Required)
+		assert.Contains(t, tool.Tool.InputSchema.Properties, "on_behalf_of")
+	})
+
+	t.Run("parse policy", func(t *testing.T) {
+		policy, err := parseTerraformVersionPolicy(" 1.9.8, ~> 1.10.0 ,")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"1.9.8", "~> 1.10.0"}, policy.entries)
+
+		_, err = parseTerraformVersionPolicy("")
+		assert.ErrorContains(t, err, ApprovedTerraformVersionsEnv)
+
+		_, err = parseTerraformVersionPolicy("1.9.8,not-a-version")
+		assert.ErrorContains(t, err, "not-a-version")
+	})
+
+	t.Run("check workspaces", func(t *testing.T) {
+		policy, err := parseTerraformVersionPolicy("1.9.8,~> 1.10.0")
+		require.NoError(t, err)
+
+		report := policy.check(&client.WorkspaceInventory{
+			Organization: "example",
+			Workspaces: []*client.InventoryWorkspace{
+				{ID: "ws-1", Name: "exact", ProjectID: "prj-1", TerraformVersion: "1.9.8"},
+				{ID: "ws-2", Name: "constraint", ProjectID: "prj-1", TerraformVersion: "1.10.3"},
+				{ID: "ws-3", Name: "old", ProjectID: "prj-1", TerraformVersion: "1.5.7"},
+				{ID: "ws-4", Name: "latest", ProjectID: "prj-1", TerraformVersion: "latest"},
+				{ID: "ws-5", Name: "floating", ProjectID: "prj-2", TerraformVersion: "~> 1.9.0"},
+			},
+		}, "")
+		assert.Equal(t, 5, report.WorkspaceCount)
+		assert.Equal(t, 2, report.CompliantCount)
+		require.Len(t, report.NonCompliant, 3)
+		assert.Equal(t, "ws-3", report.NonCompliant[0].ID)
+		assert.Equal(t, VersionPolicyNonCompliant, report.NonCompliant[0].Status)
+		assert.Equal(t, "ws-4", report.NonCompliant[1].ID)
+		assert.Equal(t, "ws-5", report.NonCompliant[2].ID)
+
+		report = policy.check(&client.WorkspaceInventory{
+			Workspaces: []*client.InventoryWorkspace{
+				{ID: "ws-3", TerraformVersion: "1.5.7", ProjectID: "prj-1"},
+				{ID: "ws-5", TerraformVersion: "1.5.7", ProjectID: "prj-2"},
+			},
+		}, "prj-2")
+		assert.Equal(t, 1, report.WorkspaceCount)
+		require.Len(t, report.NonCompliant, 1)
+		assert.Equal(t, "ws-5", report.NonCompliant[0].ID)
+	})
+
+	t.Run("target version", func(t *testing.T) {
+		policy, err := parseTerraformVersionPolicy("1.9.8,1.10.5,~> 1.11.0")
+		require.NoError(t, err)
+
+		target, err := policy.target("")
+		require.NoError(t, err)
+		assert.Equal(t, "1.10.5", target)
+
+		target, err = policy.target("1.11.2")
+		require.NoError(t, err)
+		assert.Equal(t, "1.11.2", target)
+
+		_, err = policy.target("1.8.0")
+		assert.ErrorContains(t, err, "not approved")
+
+		policy, err = parseTerraformVersionPolicy("~> 1.11.0")
+		require.NoError(t, err)
+		_, err = policy.target("")
+		assert.ErrorContains(t, err, "set target_version")
+	})
+}
@@ -0,0 +1,448 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// Statuses of the Terraform version of a workspace
+const (
+	TerraformVersionCurrent    = "current"
+	TerraformVersionOutdated   = "outdated"
+	TerraformVersionDeprecated = "deprecated"
+	TerraformVersionWithdrawn  = "withdrawn"
+	TerraformVersionPrerelease = "prerelease"
+	TerraformVersionUnknown    = "unknown"
+)
+
+const (
+	defaultTerraformVersionsLimit = 50
+	maxTerraformVersionsLimit     = 200
+	// terraformReleasesLookback is the number of releases read to resolve workspace versions
+	terraformReleasesLookback = 400
+)
+
+// TerraformVersionList are the Terraform versions, newest first
+type TerraformVersionList struct {
+	Versions []*TerraformVersionInfo `json:"versions"`
+	Latest   string                  `json:"latest,omitempty"`
+	// AdminFlags is set when the enabled, beta and deprecated flags of the Terraform Enterprise
+	// admin API are included, which requires a site admin token
+	AdminFlags bool   `json:"admin_flags"`
+	Note       string `json:"note,omitempty"`
+}
+
+// TerraformVersionInfo is a Terraform version with its release and availability flags
+type TerraformVersionInfo struct {
+	Version          string     `json:"version"`
+	Prerelease       bool       `json:"prerelease"`
+	Beta             bool       `json:"beta,omitempty"`
+	Deprecated       bool       `json:"deprecated,omitempty"`
+	DeprecatedReason string     `json:"deprecated_reason,omitempty"`
+	Withdrawn        bool       `json:"withdrawn,omitempty"`
+	Enabled          *bool      `json:"enabled,omitempty"`
+	Official         *bool      `json:"official,omitempty"`
+	ReleasedAt       *time.Time `json:"released_at,omitempty"`
+	ChangelogURL     string     `json:"changelog_url,omitempty"`
+}
+
+// WorkspaceTerraformVersionReport lists the workspaces of an organization that run an outdated,
+// deprecated or withdrawn Terraform version
+type WorkspaceTerraformVersionReport struct {
+	Organization           string                           `json:"organization"`
+	Latest                 string                           `json:"latest"`
+	MaxMinorVersionsBehind int                              `json:"max_minor_versions_behind"`
+	AdminFlags             bool                             `json:"admin_flags"`
+	WorkspaceCount         int                              `json:"workspace_count"`
+	StatusCounts           map[string]int                   `json:"status_counts"`
+	VersionUsage           map[string]int                   `json:"version_usage"`
+	Workspaces             []*WorkspaceTerraformVersionInfo `json:"workspaces"`
+}
+
+// WorkspaceTerraformVersionInfo is a workspace whose Terraform version needs attention
+type WorkspaceTerraformVersionInfo struct {
+	ID               string `json:"id"`
+	Name             string `json:"workspace_name"`
+	ProjectID        string `json:"project_id,omitempty"`
+	TerraformVersion string `json:"terraform_version"`
+	// ResolvedVersion is the release a version constraint of the workspace resolves to
+	ResolvedVersion string `json:"resolved_version,omitempty"`
+	Status          string `json:"status"`
+	Reason          string `json:"reason"`
+	// LatestPatch is the newest release of the minor version of the workspace, a safe first upgrade
+	LatestPatch string `json:"latest_patch,omitempty"`
+}
+
+// ListTerraformVersions creates a tool to list the official Terraform versions.
+func ListTerraformVersions(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("list_terraform_versions",
+			mcp.WithDescription(`Lists the official Terraform versions, newest first, from the HashiCorp releases API with their release date, prerelease and withdrawn flags and changelog. With a Terraform Enterprise site admin token, the versions installed on the instance are included with their enabled, beta and deprecated flags.
+Use it to pick the target version of an upgrade; check_workspace_terraform_versions finds the workspaces to upgrade.`),
+			mcp.WithTitleAnnotation("List the Terraform versions"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithBoolean("include_prereleases",
+				mcp.Description("Include alpha, beta and release candidate versions"),
+				mcp.DefaultBool(false),
+			),
+			mcp.WithNumber("limit",
+				mcp.Description(fmt.Sprintf("The number of versions to return (1-%d)", maxTerraformVersionsLimit)),
+				mcp.DefaultNumber(defaultTerraformVersionsLimit),
+				mcp.Min(1),
+				mcp.Max(maxTerraformVersionsLimit),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return listTerraformVersionsHandler(ctx, request, logger)
+		},
+	}
+}
+
+// CheckWorkspaceTerraformVersions creates a tool to find the workspaces of an organization that run an
+// outdated or deprecated Terraform version.
+func CheckWorkspaceTerraformVersions(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("check_workspace_terraform_versions",
+			mcp.WithDescription(`Checks the Terraform version of every workspace in an organization against the official releases and reports the workspaces that need an upgrade: outdated versions more than max_minor_versions_behind minor versions behind the latest release, withdrawn and prerelease versions, and versions deprecated on Terraform Enterprise when the token is a site admin token.
+Version constraints of workspaces are resolved to the newest matching release. Each reported workspace has the newest patch of its minor version as a safe first upgrade step. Use enforce_terraform_version_policy or bulk_update_workspaces to upgrade them.`),
+			mcp.WithTitleAnnotation("Find the workspaces running outdated Terraform versions"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("terraform_org_name",
+				mcp.Required(),
+				mcp.Description("The Terraform organization name"),
+			),
+			mcp.WithString("project_id",
+				mcp.Description("Optional project ID to restrict the check to"),
+			),
+			mcp.WithNumber("max_minor_versions_behind",
+				mcp.Description("How many minor versions a workspace can be behind the latest release before it is outdated, e.g. 2 accepts 1.8.x when the latest release is 1.10.x"),
+				mcp.DefaultNumber(2),
+				mcp.Min(0),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return checkWorkspaceTerraformVersionsHandler(ctx, request, logger)
+		},
+	}
+}
+
+func listTerraformVersionsHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	includePrereleases := request.GetBool("include_prereleases", false)
+	limit := request.GetInt("limit", defaultTerraformVersionsLimit)
+	if limit < 1 || limit > maxTerraformVersionsLimit {
+		return ToolErrorf(logger, "limit must be between 1 and %d", maxTerraformVersionsLimit)
+	}
+
+	httpClient, err := client.GetHttpClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get http client", err)
+	}
+	// Prereleases are filtered out after reading, so read enough releases to fill the limit
+	releases, err := client.ListTerraformReleases(ctx, httpClient, min(limit*3, terraformReleasesLookback), logger)
+	if err != nil {
+		return ToolErrorf(logger, "failed to list Terraform releases: %v", err)
+	}
+	adminVersions, adminErr := listAdminTerraformVersions(ctx, logger)
+
+	list := terraformVersionList(releases, adminVersions, includePrereleases, limit)
+	if adminErr != nil {
+		list.Note = "The enabled, beta and deprecated flags of the versions installed on Terraform Enterprise require a site admin token"
+	}
+
+	buf, err := json.Marshal(list)
+	if err != nil {
+		return ToolError(logger, "failed to marshal Terraform versions", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+func checkWorkspaceTerraformVersionsHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	terraformOrgName, err := request.RequireString("terraform_org_name")
+	if err != nil {
+		return ToolError(logger, "missing required input: terraform_org_name", err)
+	}
+	terraformOrgName = strings.TrimSpace(terraformOrgName)
+	projectID := strings.TrimSpace(request.GetString("project_id", ""))
+	maxBehind := request.GetInt("max_minor_versions_behind", 2)
+	if maxBehind < 0 {
+		return ToolError(logger, "max_minor_versions_behind cannot be negative", nil)
+	}
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client - ensure TFE_TOKEN and TFE_ADDRESS are configured", err)
+	}
+	httpClient, err := client.GetHttpClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get http client", err)
+	}
+	releases, err := client.ListTerraformReleases(ctx, httpClient, terraformReleasesLookback, logger)
+	if err != nil {
+		return ToolErrorf(logger, "failed to list Terraform releases: %v", err)
+	}
+	adminVersions, _ := listAdminTerraformVersions(ctx, logger)
+
+	var sessionID string
+	if session := server.ClientSessionFromContext(ctx); session != nil {
+		sessionID = session.SessionID()
+	}
+	inventory, err := client.GetWorkspaceInventory(ctx, tfeClient, sessionID, terraformOrgName, false, logger)
+	if err != nil {
+		return ToolErrorf(logger, "failed to build workspace inventory for org '%s': %v", terraformOrgName, err)
+	}
+
+	report := checkWorkspaceTerraformVersions(inventory, projectID, releases, adminVersions, maxBehind)
+
+	buf, err := json.Marshal(report)
+	if err != nil {
+		return ToolError(logger, "failed to marshal Terraform version report", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+// listAdminTerraformVersions returns the Terraform versions installed on Terraform Enterprise, which
+// only site admins can list
+func listAdminTerraformVersions(ctx context.Context, logger *log.Logger) ([]*tfe.AdminTerraformVersion, error) {
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	versions := []*tfe.AdminTerraformVersion{}
+	options := &tfe.AdminTerraformVersionsListOptions{ListOptions: tfe.ListOptions{PageNumber: 1, PageSize: 100}}
+	for {
+		page, err := tfeClient.Admin.TerraformVersions.List(ctx, options)
+		if err != nil {
+			logger.Debugf("Terraform Enterprise admin Terraform versions unavailable: %v", err)
+			return nil, err
+		}
+		versions = append(versions, page.Items...)
+		if page.Pagination == nil || page.Pagination.NextPage == 0 {
+			return versions, nil
+		}
+		options.PageNumber = page.Pagination.NextPage
+	}
+}
+
+// terraformVersionList merges the official releases with the versions installed on Terraform Enterprise,
+// newest first
+func terraformVersionList(releases []client.TerraformRelease, adminVersions []*tfe.AdminTerraformVersion, includePrereleases bool, limit int) *TerraformVersionList {
+	list := &TerraformVersionList{Versions: []*TerraformVersionInfo{}, AdminFlags: adminVersions != nil}
+	byVersion := make(map[string]*TerraformVersionInfo)
+	var all []*TerraformVersionInfo
+	for _, release := range releases {
+		releasedAt := release.CreatedAt
+		info := &TerraformVersionInfo{
+			Version:      release.Version,
+			Prerelease:   release.Prerelease,
+			Withdrawn:    release.Withdrawn,
+			ReleasedAt:   &releasedAt,
+			ChangelogURL: release.Changelog,
+		}
+		byVersion[info.Version] = info
+		all = append(all, info)
+	}
+	for _, admin := range adminVersions {
+		if admin == nil {
+			continue
+		}
+		info, ok := byVersion[admin.Version]
+		if !ok {
+			v, err := version.NewVersion(admin.Version)
+			if err != nil {
+				continue
+			}
+			info = &TerraformVersionInfo{Version: admin.Version, Prerelease: v.Prerelease() != ""}
+			byVersion[info.Version] = info
+			all = append(all, info)
+		}
+		info.Beta = admin.Beta
+		info.Deprecated = admin.Deprecated
+		if admin.DeprecatedReason != nil {
+			info.DeprecatedReason = *admin.DeprecatedReason
+		}
+		info.Enabled = tfe.Bool(admin.Enabled)
+		info.Official = tfe.Bool(admin.Official)
+	}
+
+	sort.SliceStable(all, func(i, j int) bool {
+		return compareVersionStrings(all[i].Version, all[j].Version) > 0
+	})
+	for _, info := range all {
+		stable := !info.Prerelease && !info.Beta && !info.Withdrawn
+		if stable && list.Latest == "" {
+			list.Latest = info.Version
+		}
+		if (info.Prerelease || info.Beta) && !includePrereleases {
+			continue
+		}
+		if len(list.Versions) < limit {
+			list.Versions = append(list.Versions, info)
+		}
+	}
+	return list
+}
+
+// checkWorkspaceTerraformVersions reports the workspaces of an inventory whose Terraform version is
+// outdated, deprecated, withdrawn, a prerelease or can't be resolved
+func checkWorkspaceTerraformVersions(inventory *client.WorkspaceInventory, projectID string, releases []client.TerraformRelease, adminVersions []*tfe.AdminTerraformVersion, maxBehind int) *WorkspaceTerraformVersionReport {
+	list := terraformVersionList(releases, adminVersions, true, len(releases)+len(adminVersions))
+	report := &WorkspaceTerraformVersionReport{
+		Organization:           inventory.Organization,
+		Latest:                 list.Latest,
+		MaxMinorVersionsBehind: maxBehind,
+		AdminFlags:             list.AdminFlags,
+		StatusCounts:           map[string]int{},
+		VersionUsage:           map[string]int{},
+		Workspaces:             []*WorkspaceTerraformVersionInfo{},
+	}
+
+	byVersion := make(map[string]*TerraformVersionInfo, len(list.Versions))
+	var stable []*version.Version
+	for _, info := range list.Versions {
+		byVersion[info.Version] = info
+		if v, err := version.NewVersion(info.Version); err == nil && !info.Prerelease && !info.Beta && !info.Withdrawn {
+			stable = append(stable, v)
+		}
+	}
+	latest, _ := version.NewVersion(list.Latest)
+
+	for _, ws := range inventory.Workspaces {
+		if projectID != "" && ws.ProjectID != projectID {
+			continue
+		}
+		report.WorkspaceCount++
+		report.VersionUsage[ws.TerraformVersion]++
+
+		status, reason, resolved := workspaceTerraformVersionStatus(ws.TerraformVersion, byVersion, stable, latest, maxBehind)
+		report.StatusCounts[status]++
+		if status == TerraformVersionCurrent {
+			continue
+		}
+		item := &WorkspaceTerraformVersionInfo{
+			ID:               ws.ID,
+			Name:             ws.Name,
+			ProjectID:        ws.ProjectID,
+			TerraformVersion: ws.TerraformVersion,
+			Status:           status,
+			Reason:           reason,
+		}
+		if resolved != nil {
+			if resolved.Original() != ws.TerraformVersion {
+				item.ResolvedVersion = resolved.Original()
+			}
+			item.LatestPatch = latestPatch(resolved, stable)
+		}
+		report.Workspaces = append(report.Workspaces, item)
+	}
+	sort.SliceStable(report.Workspaces, func(i, j int) bool {
+		return compareVersionStrings(report.Workspaces[i].TerraformVersion, report.Workspaces[j].TerraformVersion) < 0
+	})
+	return report
+}
+
+// workspaceTerraformVersionStatus returns the status of the Terraform version of a workspace, why, and
+// the release it resolves to
+func workspaceTerraformVersionStatus(terraformVersion string, byVersion map[string]*TerraformVersionInfo, stable []*version.Version, latest *version.Version, maxBehind int) (string, string, *version.Version) {
+	terraformVersion = strings.TrimSpace(terraformVersion)
+	if terraformVersion == "latest" {
+		return TerraformVersionCurrent, "", latest
+	}
+
+	resolved, err := version.NewVersion(terraformVersion)
+	if err != nil {
+		constraints, err := version.NewConstraint(terraformVersion)
+		if err != nil {
+			return TerraformVersionUnknown, fmt.Sprintf("'%s' is not a Terraform version or version constraint", terraformVersion), nil
+		}
+		for _, v := range stable {
+			if constraints.Check(v) {
+				resolved = v
+				break
+			}
+		}
+		if resolved == nil {
+			return TerraformVersionUnknown, fmt.Sprintf("no official release matches the constraint '%s'", terraformVersion), nil
+		}
+	}
+
+	info := byVersion[resolved.Original()]
+	switch {
+	case info != nil && info.Deprecated:
+		reason := fmt.Sprintf("%s is deprecated on Terraform Enterprise", resolved.Original())
+		if info.DeprecatedReason != "" {
+			reason += ": " + info.DeprecatedReason
+		}
+		return TerraformVersionDeprecated, reason, resolved
+	case info != nil && info.Withdrawn:
+		return TerraformVersionWithdrawn, fmt.Sprintf("%s was withdrawn by HashiCorp", resolved.Original()), resolved
+	case resolved.Prerelease() != "" || (info != nil && info.Beta):
+		return TerraformVersionPrerelease, fmt.Sprintf("%s is a prerelease", resolved.Original()), resolved
+	}
+	if reason := outdatedReason(resolved, latest, maxBehind); reason != "" {
+		return TerraformVersionOutdated, reason, resolved
+	}
+	return TerraformVersionCurrent, "", resolved
+}
+
+// outdatedReason explains why v is outdated: it is an earlier major version than the latest release,
+// or more than maxBehind minor versions behind it
+func outdatedReason(v, latest *version.Version, maxBehind int) string {
+	if latest == nil {
+		return ""
+	}
+	vs, ls := v.Segments(), latest.Segments()
+	switch {
+	case vs[0] < ls[0]:
+		return fmt.Sprintf("%s is a major version behind the latest release %s", v.Original(), latest.Original())
+	case vs[0] == ls[0] && ls[1]-vs[1] > maxBehind:
+		return fmt.Sprintf("%s is %d minor versions behind the latest release %s", v.Original(), ls[1]-vs[1], latest.Original())
+	}
+	return ""
+}
+
+// latestPatch returns the newest stable release of the minor version of v, when it is newer than v
+func latestPatch(v *version.Version, stable []*version.Version) string {
+	segments := v.Segments()
+	for _, candidate := range stable {
+		cs := candidate.Segments()
+		if cs[0] == segments[0] && cs[1] == segments[1] && candidate.GreaterThan(v) {
+			return candidate.Original()
+		}
+	}
+	return ""
+}
+
+// compareVersionStrings compares two versions, sorting versions that can't be parsed first
+func compareVersionStrings(a, b string) int {
+	va, errA := version.NewVersion(a)
+	vb, errB := version.NewVersion(b)
+	switch {
+	case errA != nil && errB != nil:
+		return strings.Compare(a, b)
+	case errA != nil:
+		return -1
+	case errB != nil:
+		return 1
+	}
+	return va.Compare(vb)
+}
@@ -0,0 +1,140 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTerraformVersions(t *testing.T) {
+	logger := log.New()
+	logger.SetLevel(log.ErrorLevel)
+
+	released := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	releases := []client.TerraformRelease{
+		{Version: "1.11.0-beta1", Prerelease: true, CreatedAt: released},
+		{Version: "1.10.5", CreatedAt: released},
+		{Version: "1.10.4", Withdrawn: true, CreatedAt: released},
+		{Version: "1.10.3", CreatedAt: released},
+		{Version: "1.9.8", CreatedAt: released},
+		{Version: "1.8.5", CreatedAt: released},
+		{Version: "1.7.5", CreatedAt: released},
+		{Version: "1.7.4", CreatedAt: released},
+		{Version: "0.15.5", CreatedAt: released},
+	}
+	adminVersions := []*tfe.AdminTerraformVersion{
+		{Version: "1.10.5", Official: true, Enabled: true},
+		{Version: "1.8.5", Official: true, Enabled: true, Deprecated: true, DeprecatedReason: tfe.String("End of support")},
+		{Version: "1.10.5-custom", Official: false, Enabled: true},
+	}
+
+	t.Run("tool creation", func(t *testing.T) {
+		tool := ListTerraformVersions(logger)
+		assert.Equal(t, "list_terraform_versions", tool.Tool.Name)
+		assert.True(t, *tool.Tool.Annotations.ReadOnlyHint)
+		assert.Empty(t, tool.Tool.InputSchema.Required)
+
+		tool = CheckWorkspaceTerraformVersions(logger)
+		assert.Equal(t, "check_workspace_terraform_versions", tool.Tool.Name)
+		assert.True(t, *tool.Tool.Annotations.ReadOnlyHint)
+		assert.Equal(t, []string{"terraform_org_name"}, tool.Tool.InputSchema.Required)
+	})
+
+	t.Run("versions", func(t *testing.T) {
+		list := terraformVersionList(releases, nil, false, 3)
+		assert.False(t, list.AdminFlags)
+		assert.Equal(t, "1.10.5", list.Latest)
+		require.Len(t, list.Versions, 3)
+		assert.Equal(t, []string{"1.10.5", "1.10.4", "1.10.3"}, []string{list.Versions[0].Version, list.Versions[1].Version, list.Versions[2].Version})
+		assert.True(t, list.Versions[1].Withdrawn)
+		assert.Nil(t, list.Versions[0].Enabled)
+
+		list = terraformVersionList(releases, adminVersions, true, 100)
+		assert.True(t, list.AdminFlags)
+		require.Len(t, list.Versions, 10)
+		assert.Equal(t, "1.11.0-beta1", list.Versions[0].Version)
+		assert.Equal(t, "1.10.5", list.Versions[1].Version)
+		assert.True(t, *list.Versions[1].Official)
+		assert.Equal(t, "1.10.5-custom", list.Versions[2].Version)
+		assert.True(t, list.Versions[2].Prerelease)
+		assert.False(t, *list.Versions[2].Official)
+		assert.Nil(t, list.Versions[2].ReleasedAt)
+		assert.Equal(t, &TerraformVersionInfo{
+			Version:          "1.8.5",
+			Deprecated:       true,
+			DeprecatedReason: "End of support",
+			Enabled:          tfe.Bool(true),
+			Official:         tfe.Bool(true),
+			ReleasedAt:       &released,
+		}, list.Versions[6])
+	})
+
+	t.Run("workspaces", func(t *testing.T) {
+		inventory := &client.WorkspaceInventory{
+			Organization: "example",
+			Workspaces: []*client.InventoryWorkspace{
+				{ID: "ws-latest", Name: "latest", TerraformVersion: "latest"},
+				{ID: "ws-current", Name: "current", TerraformVersion: "1.10.5"},
+				{ID: "ws-old-patch", Name: "old-patch", TerraformVersion: "1.9.8"},
+				{ID: "ws-deprecated", Name: "deprecated", TerraformVersion: "1.8.5"},
+				{ID: "ws-withdrawn", Name: "withdrawn", TerraformVersion: "1.10.4"},
+				{ID: "ws-beta", Name: "beta", TerraformVersion: "1.11.0-beta1"},
+				{ID: "ws-constraint", Name: "constraint", TerraformVersion: "~> 1.7.0"},
+				{ID: "ws-major", Name: "major", TerraformVersion: "0.15.5", ProjectID: "prj-legacy"},
+				{ID: "ws-invalid", Name: "invalid", TerraformVersion: "one"},
+			},
+		}
+
+		report := checkWorkspaceTerraformVersions(inventory, "", releases, adminVersions, 2)
+		assert.Equal(t, "1.10.5", report.Latest)
+		assert.True(t, report.AdminFlags)
+		assert.Equal(t, 9, report.WorkspaceCount)
+		assert.Equal(t, map[string]int{
+			TerraformVersionCurrent:    3,
+			TerraformVersionDeprecated: 1,
+			TerraformVersionWithdrawn:  1,
+			TerraformVersionPrerelease: 1,
+			TerraformVersionOutdated:   2,
+			TerraformVersionUnknown:    1,
+		}, report.StatusCounts)
+		assert.Equal(t, 1, report.VersionUsage["1.10.5"])
+
+		byID := map[string]*WorkspaceTerraformVersionInfo{}
+		for _, ws := range report.Workspaces {
+			byID[ws.ID] = ws
+		}
+		require.Len(t, byID, 6)
+		assert.Equal(t, "invalid", report.Workspaces[0].Name)
+		assert.Contains(t, byID["ws-deprecated"].Reason, "End of support")
+		assert.Equal(t, TerraformVersionWithdrawn, byID["ws-withdrawn"].Status)
+		assert.Equal(t, "1.10.5", byID["ws-withdrawn"].LatestPatch)
+		assert.Equal(t, TerraformVersionPrerelease, byID["ws-beta"].Status)
+		assert.Equal(t, &WorkspaceTerraformVersionInfo{
+			ID:               "ws-constraint",
+			Name:             "constraint",
+			TerraformVersion: "~> 1.7.0",
+			ResolvedVersion:  "1.7.5",
+			Status:           TerraformVersionOutdated,
+			Reason:           "1.7.5 is 3 minor versions behind the latest release 1.10.5",
+		}, byID["ws-constraint"])
+		assert.Contains(t, byID["ws-major"].Reason, "a major version behind")
+		assert.Equal(t, TerraformVersionUnknown, byID["ws-invalid"].Status)
+
+		report = checkWorkspaceTerraformVersions(inventory, "", releases, nil, 0)
+		assert.False(t, report.AdminFlags)
+		assert.Equal(t, 2, report.StatusCounts[TerraformVersionCurrent])
+		assert.Equal(t, 4, report.StatusCounts[TerraformVersionOutdated])
+
+		report = checkWorkspaceTerraformVersions(inventory, "prj-legacy", releases, nil, 2)
+		assert.Equal(t, 1, report.WorkspaceCount)
+		assert.Equal(t, "major", report.Workspaces[0].Name)
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"context"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/go-tfe"
 	"github.com/hashicorp/jsonapi"
@@ -21,7 +22,7 @@ import (
 func UpdateWorkspace(logger *log.Logger) server.ServerTool {
 	return server.ServerTool{
 		Tool: mcp.NewTool("update_workspace",
-			mcp.WithDescription(`Updates an existing Terraform workspace configuration. This is a potentially destructive operation that may affect infrastructure resources.`),
+			mcp.WithDescription(`Updates an existing Terraform workspace configuration. This is a potentially destructive operation that may affect infrastructure resources. Scheduling the destruction of the workspace's resources with auto_destroy_at or auto_destroy_activity_duration requires confirm_auto_destroy.`),
 			mcp.WithTitleAnnotation("Update an existing Terraform workspace"),
 			mcp.WithOpenWorldHintAnnotation(true),
 			mcp.WithReadOnlyHintAnnotation(false),
@@ -67,6 +68,8 @@ func UpdateWorkspace(logger *log.Logger) server.ServerTool {
 			mcp.WithString("tags",
 				mcp.Description("Optional comma-separated list of tags to replace existing tags"),
 			),
+			withAutoDestroy(),
+			withOnBehalfOf(),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			return updateWorkspaceHandler(ctx, request, logger)
@@ -98,13 +101,25 @@ func updateWorkspaceHandler(ctx context.Context, request mcp.CallToolRequest, lo
 	triggerPrefixesStr := request.GetString("trigger_prefixes", "")
 	fileTriggersEnabledStr := request.GetString("file_triggers_enabled", "")
 	tagsStr := request.GetString("tags", "")
+	requester := onBehalfOf(request)
+
+	autoDestroy, err := parseAutoDestroy(request, time.Now())
+	if err != nil {
+		return ToolError(logger, "invalid auto-destroy settings", err)
+	}
+	if err := checkAutoDestroy(logger, autoDestroy, request.GetBool("confirm_auto_destroy", false), requester, workspaceName); err != nil {
+		return ToolError(logger, err.Error(), nil)
+	}
 
 	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
 	if err != nil {
 		return ToolError(logger, "failed to get Terraform client - ensure TFE_TOKEN and TFE_ADDRESS are configured", err)
 	}
 
-	options := &tfe.WorkspaceUpdateOptions{}
+	options := &tfe.WorkspaceUpdateOptions{
+		AutoDestroyAt:               autoDestroy.At,
+		AutoDestroyActivityDuration: autoDestroy.Duration,
+	}
 
 	if newName != "" {
 		options.Name = &newName
@@ -168,6 +183,8 @@ func updateWorkspaceHandler(ctx context.Context, request mcp.CallToolRequest, lo
 	if err != nil {
 		return ToolErrorf(logger, "failed to update workspace '%s' in org '%s': %v", workspaceName, terraformOrgName, err)
 	}
+	auditAutoDestroy(logger, autoDestroy, requester, workspace.ID, workspaceName)
+
 	buf := bytes.NewBuffer(nil)
 	err = jsonapi.MarshalPayload(buf, workspace)
 	if err != nil {
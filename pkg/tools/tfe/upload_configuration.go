@@ -0,0 +1,268 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// maxConfigurationSize bounds the total size of the uploaded configuration files
+const maxConfigurationSize = 10 << 20
+
+// ConfigurationUpload is the result of uploading a configuration to a workspace
+type ConfigurationUpload struct {
+	ConfigurationVersionID string   `json:"configuration_version_id"`
+	Status                 string   `json:"status"`
+	Speculative            bool     `json:"speculative"`
+	Files                  []string `json:"files"`
+	RunID                  string   `json:"run_id,omitempty"`
+	RunStatus              string   `json:"run_status,omitempty"`
+}
+
+// UploadConfiguration creates a tool to upload configuration files to a workspace and optionally queue a run.
+func UploadConfiguration(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("upload_hcp_terraform_configuration",
+			mcp.WithDescription(`Uploads Terraform configuration files to a workspace as a new configuration version, from a map of file paths to their content or a directory within the roots of the client, and optionally queues a run with it. Use it to plan generated HCL in an HCP Terraform or Terraform Enterprise workspace that is not connected to a VCS repository.
+Runs are queued as plans that wait to be confirmed, even when the workspace applies automatically. A speculative configuration version can only be used for plan-only runs.`),
+			mcp.WithTitleAnnotation("Upload Terraform configuration files to a workspace"),
+			mcp.WithReadOnlyHintAnnotation(false),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("terraform_org_name",
+				mcp.Required(),
+				mcp.Description("The Terraform Cloud/Enterprise organization name"),
+			),
+			mcp.WithString("workspace_name",
+				mcp.Required(),
+				mcp.Description("The name of the workspace to upload the configuration to"),
+			),
+			mcp.WithObject("files",
+				mcp.Description(`Map of relative file paths to their content, e.g. {"main.tf": "...", "modules/app/main.tf": "..."}. Paths are relative to the root of the configuration, the workspace's working directory is applied to them. Required unless directory is set`),
+			),
+			mcp.WithString("directory",
+				mcp.Description("Absolute path of a configuration directory to read the Terraform files from instead of passing files. It must be within the roots of the client and the directories of MCP_LOCAL_ROOTS"),
+			),
+			mcp.WithBoolean("speculative",
+				mcp.Description("Create a speculative configuration version, which can only be used for plan-only runs"),
+				mcp.DefaultBool(false),
+			),
+			mcp.WithBoolean("queue_run",
+				mcp.Description("Queue a run with the uploaded configuration. It is a plan-only run when speculative is set, otherwise a plan that waits to be confirmed"),
+				mcp.DefaultBool(false),
+			),
+			mcp.WithString("message",
+				mcp.Description("Optional message for the queued run"),
+				mcp.DefaultString("Triggered via Terraform MCP Server"),
+			),
+			withOnBehalfOf(),
+		),
+		Handler: func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return uploadConfigurationHandler(ctx, req, logger)
+		},
+	}
+}
+
+func uploadConfigurationHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	terraformOrgName, err := request.RequireString("terraform_org_name")
+	if err != nil {
+		return ToolError(logger, "missing required input: terraform_org_name", err)
+	}
+	terraformOrgName = strings.TrimSpace(terraformOrgName)
+
+	workspaceName, err := request.RequireString("workspace_name")
+	if err != nil {
+		return ToolError(logger, "missing required input: workspace_name", err)
+	}
+	workspaceName = strings.TrimSpace(workspaceName)
+
+	var files map[string]string
+	if directory := strings.TrimSpace(request.GetString("directory", "")); directory != "" {
+		if request.GetArguments()["files"] != nil {
+			return ToolError(logger, "set either files or directory, not both", nil)
+		}
+		files, err = utils.ReadConfigurationDirectory(ctx, directory, maxConfigurationSize)
+		if err != nil {
+			return ToolError(logger, "failed to read the configuration directory", err)
+		}
+	} else {
+		files, err = configurationFileMap(request.GetArguments()["files"])
+		if err != nil {
+			return ToolError(logger, "invalid files", err)
+		}
+	}
+	archive, names, err := configurationArchive(files)
+	if err != nil {
+		return ToolError(logger, "failed to package configuration files", err)
+	}
+
+	speculative := request.GetBool("speculative", false)
+	queueRun := request.GetBool("queue_run", false)
+	requester := onBehalfOf(request)
+	message := annotateRequester(request.GetString("message", "Triggered via Terraform MCP Server"), requester)
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+
+	workspace, err := tfeClient.Workspaces.Read(ctx, terraformOrgName, workspaceName)
+	if err != nil {
+		return ToolErrorf(logger, "workspace '%s' not found in org '%s': %v", workspaceName, terraformOrgName, err)
+	}
+
+	// Runs are queued explicitly below, so that the run ID can be returned
+	cv, err := tfeClient.ConfigurationVersions.Create(ctx, workspace.ID, tfe.ConfigurationVersionCreateOptions{
+		AutoQueueRuns: tfe.Bool(false),
+		Speculative:   tfe.Bool(speculative),
+	})
+	if err != nil {
+		return ToolError(logger, "failed to create configuration version", err)
+	}
+	if err := tfeClient.ConfigurationVersions.UploadTarGzip(ctx, cv.UploadURL, archive); err != nil {
+		return ToolErrorf(logger, "failed to upload configuration version %s: %v", cv.ID, err)
+	}
+
+	readConfigurationVersion := func(ctx context.Context) (*tfe.ConfigurationVersion, error) {
+		return tfeClient.ConfigurationVersions.Read(ctx, cv.ID)
+	}
+	onStatus := func(elapsed time.Duration, status tfe.ConfigurationStatus) {
+		sendProgress(ctx, request, int(elapsed.Seconds()), int(defaultConfigurationWaitTimeout.Seconds()), fmt.Sprintf("Configuration version %s is %s", cv.ID, status), logger)
+	}
+	processed, err := waitForConfigurationVersion(ctx, cv.ID, readConfigurationVersion, defaultConfigurationWaitTimeout, configurationPollInterval, onStatus)
+	if err != nil {
+		return ToolErrorf(logger, "configuration version %s was not processed: %v", cv.ID, err)
+	}
+	if !processed.Usable {
+		return ToolErrorf(logger, "configuration version %s was not processed: %s", cv.ID, processed.Message)
+	}
+
+	result := &ConfigurationUpload{
+		ConfigurationVersionID: cv.ID,
+		Status:                 processed.Status,
+		Speculative:            speculative,
+		Files:                  names,
+	}
+
+	if queueRun {
+		options := tfe.RunCreateOptions{
+			Workspace:            workspace,
+			ConfigurationVersion: cv,
+			Message:              &message,
+		}
+		if speculative {
+			options.PlanOnly = tfe.Bool(true)
+		} else {
+			options.AutoApply = tfe.Bool(false)
+		}
+
+		run, err := tfeClient.Runs.Create(ctx, options)
+		if err != nil {
+			return ToolErrorf(logger, "configuration version %s was uploaded but the run could not be created: %v", cv.ID, err)
+		}
+		auditLog(logger, "create_run", requester, log.Fields{
+			"workspace_id":             workspace.ID,
+			"run_id":                   run.ID,
+			"configuration_version_id": cv.ID,
+		})
+		result.RunID = run.ID
+		result.RunStatus = string(run.Status)
+	}
+
+	buf, err := json.Marshal(result)
+	if err != nil {
+		return ToolError(logger, "failed to marshal configuration upload", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+// configurationFileMap reads the files parameter, a map of file paths to their content
+func configurationFileMap(raw any) (map[string]string, error) {
+	object, ok := raw.(map[string]any)
+	if !ok || len(object) == 0 {
+		return nil, fmt.Errorf("files must be a non-empty map of file paths to their content")
+	}
+	files := make(map[string]string, len(object))
+	for name, content := range object {
+		text, ok := content.(string)
+		if !ok {
+			return nil, fmt.Errorf("the content of %s must be a string", name)
+		}
+		files[name] = text
+	}
+	return files, nil
+}
+
+// configurationArchive packages configuration files into a tar.gz archive, in path order. Paths
+// must be relative and stay within the configuration, and at least one Terraform file is required.
+func configurationArchive(files map[string]string) (*bytes.Buffer, []string, error) {
+	names := make([]string, 0, len(files))
+	cleaned := make(map[string]string, len(files))
+	size := 0
+	hasTerraformFile := false
+	for name, content := range files {
+		clean := path.Clean(strings.ReplaceAll(strings.TrimSpace(name), "\\", "/"))
+		if clean == "." || path.IsAbs(clean) || clean == ".." || strings.HasPrefix(clean, "../") {
+			return nil, nil, fmt.Errorf("invalid file path %q - paths must be relative to the configuration root", name)
+		}
+		if _, ok := cleaned[clean]; ok {
+			return nil, nil, fmt.Errorf("file path %q is set more than once", clean)
+		}
+		size += len(content)
+		if size > maxConfigurationSize {
+			return nil, nil, fmt.Errorf("configuration files exceed %d MB", maxConfigurationSize>>20)
+		}
+		if strings.HasSuffix(clean, ".tf") || strings.HasSuffix(clean, ".tf.json") {
+			hasTerraformFile = true
+		}
+		cleaned[clean] = content
+		names = append(names, clean)
+	}
+	if !hasTerraformFile {
+		return nil, nil, fmt.Errorf("no Terraform file (.tf or .tf.json) in the configuration files")
+	}
+	sort.Strings(names)
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for _, name := range names {
+		content := cleaned[name]
+		header := &tar.Header{
+			Name:     name,
+			Mode:     0o644,
+			Size:     int64(len(content)),
+			Typeflag: tar.TypeReg,
+			ModTime:  time.Unix(0, 0),
+		}
+		if err := tw.WriteHeader(header); err != nil {
+			return nil, nil, err
+		}
+		if _, err := tw.Write([]byte(content)); err != nil {
+			return nil, nil, err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return nil, nil, err
+	}
+	if err := gz.Close(); err != nil {
+		return nil, nil, err
+	}
+	return &buf, names, nil
+}
@@ -0,0 +1,101 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUploadConfiguration(t *testing.T) {
+	logger := log.New()
+	logger.SetLevel(log.ErrorLevel)
+
+	t.Run("tool creation", func(t *testing.T) {
+		tool := UploadConfiguration(logger)
+
+		assert.Equal(t, "upload_hcp_terraform_configuration", tool.Tool.Name)
+		assert.False(t, *tool.Tool.Annotations.DestructiveHint)
+		assert.Equal(t, []string{"terraform_org_name", "workspace_name"}, tool.Tool.InputSchema.Required)
+		assert.Contains(t, tool.Tool.InputSchema.Properties, "directory")
+	})
+
+	t.Run("files or directory", func(t *testing.T) {
+		for _, args := range []map[string]any{
+			{"terraform_org_name": "example-corp", "workspace_name": "app"},
+			{"terraform_org_name": "example-corp", "workspace_name": "app", "files": map[string]any{"main.tf": ""}, "directory": "/configuration"},
+			{"terraform_org_name": "example-corp", "workspace_name": "app", "directory": "/configuration"},
+		} {
+			request := mcp.CallToolRequest{}
+			request.Params.Arguments = args
+			result, err := uploadConfigurationHandler(context.Background(), request, logger)
+			require.NoError(t, err)
+			assert.True(t, result.IsError, args)
+		}
+	})
+
+	t.Run("file map", func(t *testing.T) {
+		files, err := configurationFileMap(map[string]any{"main.tf": `resource "null_resource" "this" {}`})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"main.tf": `resource "null_resource" "this" {}`}, files)
+
+		_, err = configurationFileMap(map[string]any{})
+		assert.Error(t, err)
+		_, err = configurationFileMap(map[string]any{"main.tf": 3})
+		assert.Error(t, err)
+		_, err = configurationFileMap("main.tf")
+		assert.Error(t, err)
+	})
+
+	t.Run("archive", func(t *testing.T) {
+		archive, names, err := configurationArchive(map[string]string{
+			"main.tf":               `module "app" { source = "./modules/app" }`,
+			"./modules/app/main.tf": `output "name" { value = "app" }`,
+			"terraform.tfvars":      `region = "us-east-1"`,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"main.tf", "modules/app/main.tf", "terraform.tfvars"}, names)
+
+		gz, err := gzip.NewReader(archive)
+		require.NoError(t, err)
+		tr := tar.NewReader(gz)
+		contents := map[string]string{}
+		for {
+			header, err := tr.Next()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			content, err := io.ReadAll(tr)
+			require.NoError(t, err)
+			contents[header.Name] = string(content)
+		}
+		assert.Equal(t, `output "name" { value = "app" }`, contents["modules/app/main.tf"])
+		assert.Len(t, contents, 3)
+	})
+
+	t.Run("invalid archive", func(t *testing.T) {
+		for name, files := range map[string]map[string]string{
+			"absolute path":     {"/etc/main.tf": ""},
+			"parent directory":  {"../main.tf": ""},
+			"duplicate path":    {"main.tf": "", "./main.tf": ""},
+			"no terraform file": {"README.md": "# app"},
+			"too large":         {"main.tf": strings.Repeat("#", maxConfigurationSize+1)},
+		} {
+			t.Run(name, func(t *testing.T) {
+				_, _, err := configurationArchive(files)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
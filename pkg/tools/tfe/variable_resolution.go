@@ -0,0 +1,261 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// Sources and variable set scopes of a variable value
+const (
+	VariableSourceWorkspace   = "workspace"
+	VariableSourceVariableSet = "variable_set"
+
+	VariableSetScopeWorkspace = "workspace"
+	VariableSetScopeProject   = "project"
+	VariableSetScopeGlobal    = "global"
+)
+
+// VariableResolution lists the effective value of each variable of a workspace with where it comes from
+type VariableResolution struct {
+	WorkspaceID   string              `json:"workspace_id"`
+	WorkspaceName string              `json:"workspace_name"`
+	Variables     []*ResolvedVariable `json:"variables"`
+	Notes         []string            `json:"notes"`
+}
+
+// ResolvedVariable is the value of a variable that wins, with the values it overrides
+type ResolvedVariable struct {
+	Key        string           `json:"key"`
+	Category   string           `json:"category"`
+	Effective  *VariableValue   `json:"effective"`
+	Overridden []*VariableValue `json:"overridden,omitempty"`
+}
+
+// VariableValue is a value of a variable set on the workspace or in one of its variable sets
+type VariableValue struct {
+	Value           string `json:"value,omitempty"`
+	HCL             bool   `json:"hcl,omitempty"`
+	Sensitive       bool   `json:"sensitive,omitempty"`
+	Source          string `json:"source"`
+	VariableSetID   string `json:"variable_set_id,omitempty"`
+	VariableSetName string `json:"variable_set_name,omitempty"`
+	Scope           string `json:"scope,omitempty"`
+	Priority        bool   `json:"priority,omitempty"`
+
+	precedence int
+}
+
+// variableResolutionNotes explain the precedence rules the resolution follows
+var variableResolutionNotes = []string{
+	"Precedence, highest first: priority variable sets, run-specific variables (CLI -var or the run's variables), workspace variables, then other variable sets.",
+	"Between variable sets of the same priority, sets applied to the workspace win over sets applied to its project, which win over global sets. Sets of the same scope are ordered by name, the lexically first one wins.",
+	"Workspace and variable set values override *.auto.tfvars files and variable defaults in the configuration, unless the variable is absent from both.",
+	"Sensitive values are write-only and never returned.",
+}
+
+// ExplainVariableResolution creates a tool to explain which value of each variable a workspace uses.
+func ExplainVariableResolution(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("explain_hcp_terraform_variable_resolution",
+			mcp.WithDescription(`Explains which value of each Terraform and environment variable a workspace actually uses. Merges the workspace variables with the global, project and workspace variable sets applied to it, following the precedence rules of HCP Terraform, and lists for each key the effective value and its source along with the values it overrides.
+Use this to answer "which value actually wins" for a variable set in several places.`),
+			mcp.WithTitleAnnotation("Explain the effective variables of a workspace"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("terraform_org_name",
+				mcp.Required(),
+				mcp.Description("The Terraform organization name"),
+			),
+			mcp.WithString("workspace_name",
+				mcp.Required(),
+				mcp.Description("The workspace name"),
+			),
+			mcp.WithString("variable_key",
+				mcp.Description("Optional variable key to only explain that variable"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return explainVariableResolutionHandler(ctx, request, logger)
+		},
+	}
+}
+
+func explainVariableResolutionHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	orgName, err := request.RequireString("terraform_org_name")
+	if err != nil {
+		return ToolError(logger, "missing required input: terraform_org_name", err)
+	}
+	orgName = strings.TrimSpace(orgName)
+
+	workspaceName, err := request.RequireString("workspace_name")
+	if err != nil {
+		return ToolError(logger, "missing required input: workspace_name", err)
+	}
+	workspaceName = strings.TrimSpace(workspaceName)
+	variableKey := strings.TrimSpace(request.GetString("variable_key", ""))
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+
+	workspace, err := tfeClient.Workspaces.Read(ctx, orgName, workspaceName)
+	if err != nil {
+		return ToolErrorf(logger, "workspace '%s' not found in org '%s'", workspaceName, orgName)
+	}
+
+	var variables []*tfe.Variable
+	listOptions := tfe.ListOptions{PageNumber: 1, PageSize: 100}
+	for {
+		page, err := tfeClient.Variables.List(ctx, workspace.ID, &tfe.VariableListOptions{ListOptions: listOptions})
+		if err != nil {
+			return ToolErrorf(logger, "failed to list the variables of workspace '%s': %v", workspaceName, err)
+		}
+		variables = append(variables, page.Items...)
+		if page.Pagination == nil || page.Pagination.NextPage == 0 {
+			break
+		}
+		listOptions.PageNumber = page.Pagination.NextPage
+	}
+
+	var sets []*tfe.VariableSet
+	listOptions = tfe.ListOptions{PageNumber: 1, PageSize: 100}
+	for {
+		page, err := tfeClient.VariableSets.ListForWorkspace(ctx, workspace.ID, &tfe.VariableSetListOptions{
+			ListOptions: listOptions,
+			Include:     string(tfe.VariableSetVars) + "," + string(tfe.VariableSetWorkspaces),
+		})
+		if err != nil {
+			return ToolErrorf(logger, "failed to list the variable sets of workspace '%s': %v", workspaceName, err)
+		}
+		sets = append(sets, page.Items...)
+		if page.Pagination == nil || page.Pagination.NextPage == 0 {
+			break
+		}
+		listOptions.PageNumber = page.Pagination.NextPage
+	}
+
+	resolution := &VariableResolution{
+		WorkspaceID:   workspace.ID,
+		WorkspaceName: workspace.Name,
+		Variables:     resolveVariables(workspace.ID, variables, sets, variableKey),
+		Notes:         variableResolutionNotes,
+	}
+	if variableKey != "" && len(resolution.Variables) == 0 {
+		return ToolErrorf(logger, "variable '%s' is not set on workspace '%s' nor in its variable sets", variableKey, workspaceName)
+	}
+
+	buf, err := json.Marshal(resolution)
+	if err != nil {
+		return ToolError(logger, "failed to marshal variable resolution", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+// resolveVariables merges the variables of a workspace with the variables of the variable sets
+// applied to it, and orders the values of each key by precedence
+func resolveVariables(workspaceID string, variables []*tfe.Variable, sets []*tfe.VariableSet, onlyKey string) []*ResolvedVariable {
+	type variableID struct {
+		category tfe.CategoryType
+		key      string
+	}
+	values := make(map[variableID][]*VariableValue)
+	add := func(category tfe.CategoryType, key string, value *VariableValue) {
+		if onlyKey != "" && key != onlyKey {
+			return
+		}
+		id := variableID{category: category, key: key}
+		values[id] = append(values[id], value)
+	}
+
+	for _, v := range variables {
+		add(v.Category, v.Key, &VariableValue{
+			Value:      v.Value,
+			HCL:        v.HCL,
+			Sensitive:  v.Sensitive,
+			Source:     VariableSourceWorkspace,
+			precedence: variableSetPrecedence(false, "") - 1,
+		})
+	}
+	for _, set := range sets {
+		scope := variableSetScope(set, workspaceID)
+		for _, v := range set.Variables {
+			add(v.Category, v.Key, &VariableValue{
+				Value:           v.Value,
+				HCL:             v.HCL,
+				Sensitive:       v.Sensitive,
+				Source:          VariableSourceVariableSet,
+				VariableSetID:   set.ID,
+				VariableSetName: set.Name,
+				Scope:           scope,
+				Priority:        set.Priority,
+				precedence:      variableSetPrecedence(set.Priority, scope),
+			})
+		}
+	}
+
+	resolved := make([]*ResolvedVariable, 0, len(values))
+	for id, candidates := range values {
+		sort.SliceStable(candidates, func(i, j int) bool {
+			if candidates[i].precedence != candidates[j].precedence {
+				return candidates[i].precedence < candidates[j].precedence
+			}
+			return candidates[i].VariableSetName < candidates[j].VariableSetName
+		})
+		resolved = append(resolved, &ResolvedVariable{
+			Key:        id.key,
+			Category:   string(id.category),
+			Effective:  candidates[0],
+			Overridden: candidates[1:],
+		})
+	}
+	sort.Slice(resolved, func(i, j int) bool {
+		if resolved[i].Category != resolved[j].Category {
+			return resolved[i].Category > resolved[j].Category
+		}
+		return resolved[i].Key < resolved[j].Key
+	})
+	return resolved
+}
+
+// variableSetScope returns whether a variable set applies to the workspace through the organization,
+// its project or the workspace itself
+func variableSetScope(set *tfe.VariableSet, workspaceID string) string {
+	if set.Global {
+		return VariableSetScopeGlobal
+	}
+	for _, ws := range set.Workspaces {
+		if ws != nil && ws.ID == workspaceID {
+			return VariableSetScopeWorkspace
+		}
+	}
+	return VariableSetScopeProject
+}
+
+// variableSetPrecedence ranks the variables of a variable set, lower wins. Workspace variables rank
+// between the priority variable sets and the other ones.
+func variableSetPrecedence(priority bool, scope string) int {
+	rank := 1
+	switch scope {
+	case VariableSetScopeWorkspace:
+		rank = 0
+	case VariableSetScopeGlobal:
+		rank = 2
+	}
+	if priority {
+		return rank
+	}
+	return 4 + rank
+}
@@ -0,0 +1,99 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveVariables(t *testing.T) {
+	const workspaceID = "ws-123"
+	variables := []*tfe.Variable{
+		{Key: "region", Value: "us-east-1", Category: tfe.CategoryTerraform},
+		{Key: "instance_type", Value: "t3.small", Category: tfe.CategoryTerraform},
+		{Key: "AWS_REGION", Value: "us-east-1", Category: tfe.CategoryEnv},
+	}
+	sets := []*tfe.VariableSet{
+		{
+			ID: "varset-global", Name: "org-defaults", Global: true,
+			Variables: []*tfe.VariableSetVariable{
+				{Key: "region", Value: "eu-west-1", Category: tfe.CategoryTerraform},
+				{Key: "owner", Value: "platform", Category: tfe.CategoryTerraform},
+			},
+		},
+		{
+			ID: "varset-b", Name: "b-project", Projects: []*tfe.Project{{ID: "prj-1"}},
+			Variables: []*tfe.VariableSetVariable{
+				{Key: "owner", Value: "team-b", Category: tfe.CategoryTerraform},
+			},
+		},
+		{
+			ID: "varset-a", Name: "a-project", Projects: []*tfe.Project{{ID: "prj-1"}},
+			Variables: []*tfe.VariableSetVariable{
+				{Key: "owner", Value: "team-a", Category: tfe.CategoryTerraform},
+			},
+		},
+		{
+			ID: "varset-ws", Name: "z-workspace", Workspaces: []*tfe.Workspace{{ID: workspaceID}},
+			Variables: []*tfe.VariableSetVariable{
+				{Key: "owner", Value: "team-ws", Category: tfe.CategoryTerraform},
+			},
+		},
+		{
+			ID: "varset-priority", Name: "guardrails", Global: true, Priority: true,
+			Variables: []*tfe.VariableSetVariable{
+				{Key: "instance_type", Value: "t3.micro", Category: tfe.CategoryTerraform},
+			},
+		},
+		{
+			ID: "varset-secret", Name: "secrets", Global: true,
+			Variables: []*tfe.VariableSetVariable{
+				{Key: "AWS_SECRET_ACCESS_KEY", Sensitive: true, Category: tfe.CategoryEnv},
+			},
+		},
+	}
+
+	resolved := resolveVariables(workspaceID, variables, sets, "")
+	byKey := make(map[string]*ResolvedVariable)
+	for _, v := range resolved {
+		byKey[v.Category+"/"+v.Key] = v
+	}
+	require.Len(t, resolved, 5)
+	assert.Equal(t, "terraform", resolved[0].Category, "terraform variables are listed first")
+
+	region := byKey["terraform/region"]
+	assert.Equal(t, VariableSourceWorkspace, region.Effective.Source)
+	assert.Equal(t, "us-east-1", region.Effective.Value)
+	require.Len(t, region.Overridden, 1)
+	assert.Equal(t, "varset-global", region.Overridden[0].VariableSetID)
+	assert.Equal(t, VariableSetScopeGlobal, region.Overridden[0].Scope)
+
+	instanceType := byKey["terraform/instance_type"]
+	assert.Equal(t, "varset-priority", instanceType.Effective.VariableSetID)
+	assert.True(t, instanceType.Effective.Priority)
+	assert.Equal(t, VariableSourceWorkspace, instanceType.Overridden[0].Source)
+
+	owner := byKey["terraform/owner"]
+	assert.Equal(t, "varset-ws", owner.Effective.VariableSetID)
+	assert.Equal(t, VariableSetScopeWorkspace, owner.Effective.Scope)
+	require.Len(t, owner.Overridden, 3)
+	assert.Equal(t, "varset-a", owner.Overridden[0].VariableSetID, "lexically first set wins within a scope")
+	assert.Equal(t, "varset-b", owner.Overridden[1].VariableSetID)
+	assert.Equal(t, "varset-global", owner.Overridden[2].VariableSetID)
+
+	envRegion := byKey["env/AWS_REGION"]
+	assert.Empty(t, envRegion.Overridden, "env and terraform variables do not override each other")
+
+	secret := byKey["env/AWS_SECRET_ACCESS_KEY"]
+	assert.True(t, secret.Effective.Sensitive)
+	assert.Empty(t, secret.Effective.Value)
+
+	only := resolveVariables(workspaceID, variables, sets, "owner")
+	require.Len(t, only, 1)
+	assert.Equal(t, "owner", only[0].Key)
+}
@@ -24,6 +24,8 @@ func ListVariableSets(logger *log.Logger) server.ServerTool {
 	return server.ServerTool{
 		Tool: mcp.NewTool("list_variable_sets",
 			mcp.WithDescription("List all variable sets in an organization. Returns all if query is empty."),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
 			mcp.WithString("terraform_org_name", mcp.Required(), mcp.Description("Organization name")),
 			mcp.WithString("query", mcp.Description("Optional filter query for variable set names")),
 			utils.WithPagination(),
@@ -123,7 +125,8 @@ func CreateVariableInVariableSet(logger *log.Logger) server.ServerTool {
 			mcp.WithDescription("Create a new variable in a variable set."),
 			mcp.WithString("variable_set_id", mcp.Required(), mcp.Description("Variable set ID")),
 			mcp.WithString("key", mcp.Required(), mcp.Description("Variable key/name")),
-			mcp.WithString("value", mcp.Required(), mcp.Description("Variable value")),
+			mcp.WithString("value", mcp.Description("Variable value as a string. Required unless typed_value is set")),
+			withTypedValue(),
 			mcp.WithString("description", mcp.Description("Variable description")),
 			mcp.WithString("category", mcp.Description("Variable category: terraform or env"), mcp.Enum("terraform", "env"), mcp.DefaultString("terraform")),
 			mcp.WithBoolean("hcl", mcp.Description("Whether variable is HCL: true or false"), mcp.DefaultBool(false)),
@@ -138,17 +141,15 @@ func CreateVariableInVariableSet(logger *log.Logger) server.ServerTool {
 			if err != nil {
 				return ToolError(logger, "missing required input: key", err)
 			}
-			value, err := request.RequireString("value")
-			if err != nil {
-				return ToolError(logger, "missing required input: value", err)
-			}
-
 			category := tfe.CategoryTerraform
 			if request.GetString("category", "") == "env" {
 				category = tfe.CategoryEnv
 			}
 
-			hcl := request.GetBool("hcl", false)
+			value, hcl, err := variableValue(request, key, category, request.GetBool("hcl", false))
+			if err != nil {
+				return ToolError(logger, err.Error(), nil)
+			}
 			sensitive := request.GetBool("sensitive", false)
 			description := request.GetString("description", "")
 
@@ -304,3 +305,234 @@ func DetachVariableSetFromWorkspaces(logger *log.Logger) server.ServerTool {
 		},
 	}
 }
+
+// UpdateVariableSet creates a tool to update a variable set.
+func UpdateVariableSet(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("update_variable_set",
+			mcp.WithDescription("Update the name, description, scope or priority of a variable set. Only provided fields are changed."),
+			mcp.WithString("variable_set_id", mcp.Required(), mcp.Description("Variable set ID")),
+			mcp.WithString("name", mcp.Description("New variable set name")),
+			mcp.WithString("description", mcp.Description("New variable set description")),
+			mcp.WithBoolean("global", mcp.Description("Whether variable set is global: true or false")),
+			mcp.WithBoolean("priority", mcp.Description("Whether the variables in the set override values set in more specific scopes: true or false")),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			varSetID, err := request.RequireString("variable_set_id")
+			if err != nil {
+				return ToolError(logger, "missing required input: variable_set_id", err)
+			}
+
+			options := &tfe.VariableSetUpdateOptions{}
+			args := request.GetArguments()
+			if _, ok := args["name"]; ok {
+				options.Name = tfe.String(request.GetString("name", ""))
+			}
+			if _, ok := args["description"]; ok {
+				options.Description = tfe.String(request.GetString("description", ""))
+			}
+			if _, ok := args["global"]; ok {
+				options.Global = tfe.Bool(request.GetBool("global", false))
+			}
+			if _, ok := args["priority"]; ok {
+				options.Priority = tfe.Bool(request.GetBool("priority", false))
+			}
+			if options.Name == nil && options.Description == nil && options.Global == nil && options.Priority == nil {
+				return ToolError(logger, "at least one of name, description, global or priority must be provided", nil)
+			}
+
+			tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+			if err != nil {
+				return ToolError(logger, "failed to get Terraform client", err)
+			}
+
+			varSet, err := tfeClient.VariableSets.Update(ctx, varSetID, options)
+			if err != nil {
+				return ToolErrorf(logger, "failed to update variable set '%s': %v", varSetID, err)
+			}
+
+			return &mcp.CallToolResult{
+				Content: []mcp.Content{
+					mcp.NewTextContent(fmt.Sprintf("Successfully updated variable set %s with ID %s", varSet.Name, varSet.ID)),
+				},
+			}, nil
+		},
+	}
+}
+
+// DeleteVariableSet creates a tool to delete a variable set.
+func DeleteVariableSet(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("delete_variable_set",
+			mcp.WithDescription("Delete a variable set and all of its variables. The variables are removed from every workspace and project the set is attached to."),
+			mcp.WithDestructiveHintAnnotation(true),
+			mcp.WithString("variable_set_id", mcp.Required(), mcp.Description("Variable set ID to delete")),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			varSetID, err := request.RequireString("variable_set_id")
+			if err != nil {
+				return ToolError(logger, "missing required input: variable_set_id", err)
+			}
+
+			tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+			if err != nil {
+				return ToolError(logger, "failed to get Terraform client", err)
+			}
+
+			err = tfeClient.VariableSets.Delete(ctx, varSetID)
+			if err != nil {
+				return ToolErrorf(logger, "failed to delete variable set '%s': %v", varSetID, err)
+			}
+
+			return &mcp.CallToolResult{
+				Content: []mcp.Content{
+					mcp.NewTextContent(fmt.Sprintf("Successfully deleted variable set %s", varSetID)),
+				},
+			}, nil
+		},
+	}
+}
+
+// AttachVariableSetToProjects creates a tool to attach a variable set to projects.
+func AttachVariableSetToProjects(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("attach_variable_set_to_projects",
+			mcp.WithDescription("Attach a variable set to one or more projects. The variables apply to every workspace in the projects."),
+			mcp.WithString("variable_set_id", mcp.Required(), mcp.Description("Variable set ID")),
+			mcp.WithString("project_ids", mcp.Required(), mcp.Description("Comma-separated list of project IDs")),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			varSetID, err := request.RequireString("variable_set_id")
+			if err != nil {
+				return ToolError(logger, "missing required input: variable_set_id", err)
+			}
+			projectIDsStr, err := request.RequireString("project_ids")
+			if err != nil {
+				return ToolError(logger, "missing required input: project_ids", err)
+			}
+			projectIDsList := strings.Split(projectIDsStr, ",")
+
+			var projects []*tfe.Project
+			for _, id := range projectIDsList {
+				projects = append(projects, &tfe.Project{ID: strings.TrimSpace(id)})
+			}
+
+			tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+			if err != nil {
+				return ToolError(logger, "failed to get Terraform client", err)
+			}
+
+			err = tfeClient.VariableSets.ApplyToProjects(ctx, varSetID, tfe.VariableSetApplyToProjectsOptions{
+				Projects: projects,
+			})
+			if err != nil {
+				return ToolErrorf(logger, "failed to attach variable set '%s' to projects: %v", varSetID, err)
+			}
+
+			return &mcp.CallToolResult{
+				Content: []mcp.Content{
+					mcp.NewTextContent(fmt.Sprintf("Successfully attached variable set %s to %d projects", varSetID, len(projects))),
+				},
+			}, nil
+		},
+	}
+}
+
+// DetachVariableSetFromProjects creates a tool to detach a variable set from projects.
+func DetachVariableSetFromProjects(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("detach_variable_set_from_projects",
+			mcp.WithDescription("Detach a variable set from one or more projects."),
+			mcp.WithString("variable_set_id", mcp.Required(), mcp.Description("Variable set ID")),
+			mcp.WithString("project_ids", mcp.Required(), mcp.Description("Comma-separated list of project IDs")),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			varSetID, err := request.RequireString("variable_set_id")
+			if err != nil {
+				return ToolError(logger, "missing required input: variable_set_id", err)
+			}
+			projectIDsStr, err := request.RequireString("project_ids")
+			if err != nil {
+				return ToolError(logger, "missing required input: project_ids", err)
+			}
+			projectIDsList := strings.Split(projectIDsStr, ",")
+
+			var projects []*tfe.Project
+			for _, id := range projectIDsList {
+				projects = append(projects, &tfe.Project{ID: strings.TrimSpace(id)})
+			}
+
+			tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+			if err != nil {
+				return ToolError(logger, "failed to get Terraform client", err)
+			}
+
+			err = tfeClient.VariableSets.RemoveFromProjects(ctx, varSetID, tfe.VariableSetRemoveFromProjectsOptions{
+				Projects: projects,
+			})
+			if err != nil {
+				return ToolErrorf(logger, "failed to detach variable set '%s' from projects: %v", varSetID, err)
+			}
+
+			return &mcp.CallToolResult{
+				Content: []mcp.Content{
+					mcp.NewTextContent(fmt.Sprintf("Successfully detached variable set %s from %d projects", varSetID, len(projects))),
+				},
+			}, nil
+		},
+	}
+}
+
+// ListProjectVariableSets creates a tool to list the variable sets that apply to a project.
+func ListProjectVariableSets(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("list_project_variable_sets",
+			mcp.WithDescription("List the variable sets attached to a project, whose variables apply to every workspace in the project. Global variable sets also apply but are not listed."),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("project_id", mcp.Required(), mcp.Description("Project ID")),
+			mcp.WithString("query", mcp.Description("Optional filter query for variable set names")),
+			utils.WithPagination(),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			projectID, err := request.RequireString("project_id")
+			if err != nil {
+				return ToolError(logger, "missing required input: project_id", err)
+			}
+			query := request.GetString("query", "")
+
+			tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+			if err != nil {
+				return ToolError(logger, "failed to get Terraform client", err)
+			}
+
+			pagination, err := utils.OptionalPaginationParams(request)
+			if err != nil {
+				return ToolError(logger, "invalid pagination parameters", err)
+			}
+
+			varSets, err := tfeClient.VariableSets.ListForProject(ctx, strings.TrimSpace(projectID), &tfe.VariableSetListOptions{
+				Query: query,
+				ListOptions: tfe.ListOptions{
+					PageNumber: pagination.Page,
+					PageSize:   pagination.PageSize,
+				},
+			})
+			if err != nil {
+				return ToolErrorf(logger, "failed to list variable sets of project '%s': %v", projectID, err)
+			}
+
+			buf := bytes.NewBuffer(nil)
+			err = jsonapi.MarshalPayloadWithoutIncluded(buf, varSets.Items)
+			if err != nil {
+				return ToolError(logger, "failed to marshal variable sets", err)
+			}
+
+			return &mcp.CallToolResult{
+				Content: []mcp.Content{
+					mcp.NewTextContent(buf.String()),
+				},
+			}, nil
+		},
+	}
+}
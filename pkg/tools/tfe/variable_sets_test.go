@@ -54,7 +54,8 @@ func TestCreateVariableInVariableSet(t *testing.T) {
 
 		assert.Contains(t, tool.Tool.InputSchema.Required, "variable_set_id")
 		assert.Contains(t, tool.Tool.InputSchema.Required, "key")
-		assert.Contains(t, tool.Tool.InputSchema.Required, "value")
+		assert.NotContains(t, tool.Tool.InputSchema.Required, "value")
+		assert.Contains(t, tool.Tool.InputSchema.Properties, "typed_value")
 	})
 }
 
@@ -105,3 +106,68 @@ func TestDetachVariableSetFromWorkspaces(t *testing.T) {
 		assert.Contains(t, tool.Tool.InputSchema.Required, "workspace_ids")
 	})
 }
+
+func TestUpdateVariableSet(t *testing.T) {
+	logger := log.New()
+	logger.SetLevel(log.ErrorLevel)
+
+	t.Run("tool creation", func(t *testing.T) {
+		tool := UpdateVariableSet(logger)
+
+		assert.Equal(t, "update_variable_set", tool.Tool.Name)
+		assert.Contains(t, tool.Tool.Description, "Update the name, description, scope or priority of a variable set")
+		assert.NotNil(t, tool.Handler)
+
+		assert.Contains(t, tool.Tool.InputSchema.Required, "variable_set_id")
+		assert.NotContains(t, tool.Tool.InputSchema.Required, "name")
+	})
+}
+
+func TestDeleteVariableSet(t *testing.T) {
+	logger := log.New()
+	logger.SetLevel(log.ErrorLevel)
+
+	t.Run("tool creation", func(t *testing.T) {
+		tool := DeleteVariableSet(logger)
+
+		assert.Equal(t, "delete_variable_set", tool.Tool.Name)
+		assert.Contains(t, tool.Tool.Description, "Delete a variable set")
+		assert.NotNil(t, tool.Handler)
+
+		assert.NotNil(t, tool.Tool.Annotations.DestructiveHint)
+		assert.True(t, *tool.Tool.Annotations.DestructiveHint)
+		assert.Contains(t, tool.Tool.InputSchema.Required, "variable_set_id")
+	})
+}
+
+func TestAttachVariableSetToProjects(t *testing.T) {
+	logger := log.New()
+	logger.SetLevel(log.ErrorLevel)
+
+	t.Run("tool creation", func(t *testing.T) {
+		tool := AttachVariableSetToProjects(logger)
+
+		assert.Equal(t, "attach_variable_set_to_projects", tool.Tool.Name)
+		assert.Contains(t, tool.Tool.Description, "Attach a variable set to one or more projects")
+		assert.NotNil(t, tool.Handler)
+
+		assert.Contains(t, tool.Tool.InputSchema.Required, "variable_set_id")
+		assert.Contains(t, tool.Tool.InputSchema.Required, "project_ids")
+	})
+}
+
+func TestDetachVariableSetFromProjects(t *testing.T) {
+	logger := log.New()
+	logger.SetLevel(log.ErrorLevel)
+
+	t.Run("tool creation", func(t *testing.T) {
+		tool := DetachVariableSetFromProjects(logger)
+
+		assert.Equal(t, "detach_variable_set_from_projects", tool.Tool.Name)
+		assert.Contains(t, tool.Tool.Description, "Detach a variable set from one or more projects")
+		assert.NotNil(t, tool.Handler)
+
+		assert.Contains(t, tool.Tool.InputSchema.Required, "variable_set_id")
+		assert.Contains(t, tool.Tool.InputSchema.Required, "project_ids")
+	})
+}
@@ -0,0 +1,84 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/mark3labs/mcp-go/mcp"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+)
+
+// withTypedValue adds the typed_value parameter, an alternative to the string value parameter
+// of the variable tools. It has no type so that any JSON value is accepted.
+func withTypedValue() mcp.ToolOption {
+	return func(tool *mcp.Tool) {
+		if tool.InputSchema.Properties == nil {
+			tool.InputSchema.Properties = make(map[string]any)
+		}
+		tool.InputSchema.Properties["typed_value"] = map[string]any{
+			"description": "Variable value as a JSON value instead of a string, e.g. [\"a\", \"b\"], {\"team\" = \"platform\"} or true. " +
+				"Lists, maps, numbers and bools of terraform variables are encoded as HCL and hcl is set to true; use it instead of passing JSON in value. " +
+				"Environment variables only accept strings. Set either value or typed_value",
+		}
+	}
+}
+
+// variableValue returns the value of a variable from the value or typed_value parameter, and
+// whether it must be stored as HCL
+func variableValue(request mcp.CallToolRequest, key string, category tfe.CategoryType, hcl bool) (string, bool, error) {
+	typedValue, typed := request.GetArguments()["typed_value"]
+	value, ok := request.GetArguments()["value"].(string)
+	switch {
+	case typed && ok:
+		return "", false, fmt.Errorf("set either value or typed_value for variable '%s', not both", key)
+	case typed:
+		encoded, encodedHCL, err := encodeVariableValue(typedValue, key, category)
+		return encoded, hcl || encodedHCL, err
+	case ok:
+		return value, hcl, nil
+	default:
+		return "", false, fmt.Errorf("missing required input: value or typed_value")
+	}
+}
+
+// encodeVariableValue encodes a JSON value as the value of a variable. Strings are kept as they
+// are, other values of terraform variables are encoded as HCL.
+func encodeVariableValue(value any, key string, category tfe.CategoryType) (string, bool, error) {
+	switch v := value.(type) {
+	case string:
+		return v, false, nil
+	case nil:
+		return "", false, fmt.Errorf("variable '%s' has no value", key)
+	}
+	if category == tfe.CategoryEnv {
+		return "", false, fmt.Errorf("environment variable '%s' must have a string value", key)
+	}
+	encoded, err := hclValue(value)
+	if err != nil {
+		return "", false, fmt.Errorf("invalid value for variable '%s': %w", key, err)
+	}
+	return encoded, true, nil
+}
+
+// hclValue renders a JSON value as an HCL expression, e.g. ["a", "b"] or { team = "platform" }.
+// Template sequences in strings are escaped so they are stored literally.
+func hclValue(value any) (string, error) {
+	buf, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	ty, err := ctyjson.ImpliedType(buf)
+	if err != nil {
+		return "", err
+	}
+	val, err := ctyjson.Unmarshal(buf, ty)
+	if err != nil {
+		return "", err
+	}
+	return string(hclwrite.Format(hclwrite.TokensForValue(val).Bytes())), nil
+}
@@ -0,0 +1,87 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestHCLValue(t *testing.T) {
+	value, err := hclValue([]any{"a", "b"})
+	require.NoError(t, err)
+	assert.Equal(t, `["a", "b"]`, value)
+
+	value, err = hclValue(float64(3))
+	require.NoError(t, err)
+	assert.Equal(t, "3", value)
+
+	value, err = hclValue(true)
+	require.NoError(t, err)
+	assert.Equal(t, "true", value)
+
+	// Maps are valid HCL that evaluates to the original value, with templates kept literally
+	value, err = hclValue(map[string]any{
+		"team":        "platform",
+		"cost-center": "${var.x}",
+		"zones":       []any{"a", "b"},
+		"replicas":    float64(2),
+	})
+	require.NoError(t, err)
+	expr, diags := hclsyntax.ParseExpression([]byte(value), "value.hcl", hcl.InitialPos)
+	require.False(t, diags.HasErrors(), diags.Error())
+	evaluated, diags := expr.Value(nil)
+	require.False(t, diags.HasErrors(), diags.Error())
+	assert.Equal(t, cty.StringVal("platform"), evaluated.GetAttr("team"))
+	assert.Equal(t, cty.StringVal("${var.x}"), evaluated.GetAttr("cost-center"))
+	assert.Equal(t, 2, evaluated.GetAttr("zones").LengthInt())
+	assert.True(t, evaluated.GetAttr("replicas").Equals(cty.NumberIntVal(2)).True())
+}
+
+func TestVariableValue(t *testing.T) {
+	newRequest := func(args map[string]any) mcp.CallToolRequest {
+		request := mcp.CallToolRequest{}
+		request.Params.Arguments = args
+		return request
+	}
+
+	value, hcl, err := variableValue(newRequest(map[string]any{"value": "us-east-1"}), "region", tfe.CategoryTerraform, false)
+	require.NoError(t, err)
+	assert.Equal(t, "us-east-1", value)
+	assert.False(t, hcl)
+
+	// The hcl flag of string values is kept
+	_, hcl, err = variableValue(newRequest(map[string]any{"value": `["a"]`}), "zones", tfe.CategoryTerraform, true)
+	require.NoError(t, err)
+	assert.True(t, hcl)
+
+	value, hcl, err = variableValue(newRequest(map[string]any{"typed_value": []any{"a", "b"}}), "zones", tfe.CategoryTerraform, false)
+	require.NoError(t, err)
+	assert.Equal(t, `["a", "b"]`, value)
+	assert.True(t, hcl)
+
+	value, hcl, err = variableValue(newRequest(map[string]any{"typed_value": "plain"}), "name", tfe.CategoryTerraform, false)
+	require.NoError(t, err)
+	assert.Equal(t, "plain", value)
+	assert.False(t, hcl)
+
+	for name, args := range map[string]map[string]any{
+		"no value":           {},
+		"both values":        {"value": "a", "typed_value": "b"},
+		"null typed value":   {"typed_value": nil},
+		"non-string env var": {"typed_value": float64(3)},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, _, err := variableValue(newRequest(args), "KEY", tfe.CategoryEnv, false)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,193 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	defaultRunWaitTimeout  = 10 * time.Minute
+	maxRunWaitTimeout      = time.Hour
+	defaultRunPollInterval = 5 * time.Second
+	minRunPollInterval     = 2 * time.Second
+)
+
+// Outcomes of waiting for a run
+const (
+	runWaitOutcomeFinished          = "finished"
+	runWaitOutcomeNeedsAction       = "awaiting_action"
+	runWaitOutcomeNeedsConfirmation = "awaiting_confirmation"
+	runWaitOutcomeTimedOut          = "timed_out"
+)
+
+// finalRunStatuses are the statuses a run never leaves
+var finalRunStatuses = map[tfe.RunStatus]bool{
+	tfe.RunApplied:            true,
+	tfe.RunPlannedAndFinished: true,
+	tfe.RunPlannedAndSaved:    true,
+	tfe.RunErrored:            true,
+	tfe.RunDiscarded:          true,
+	tfe.RunCanceled:           true,
+	"force_canceled":          true,
+}
+
+// RunWaitResult is returned once a run stops making progress on its own
+type RunWaitResult struct {
+	RunID          string            `json:"run_id"`
+	Status         string            `json:"status"`
+	Outcome        string            `json:"outcome"`
+	ElapsedSeconds int               `json:"elapsed_seconds"`
+	Actions        *tfe.RunActions   `json:"actions,omitempty"`
+	Message        string            `json:"message"`
+	StatusHistory  []RunStatusChange `json:"status_history"`
+}
+
+// RunStatusChange records when a status was first observed while waiting
+type RunStatusChange struct {
+	Status     string `json:"status"`
+	ObservedAt string `json:"observed_at"`
+}
+
+// WaitForRun creates a tool that waits until a Terraform run finishes or needs a decision.
+func WaitForRun(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("wait_for_run",
+			mcp.WithDescription(`Waits for a Terraform run to finish or to need a decision, such as a confirmation to apply or a policy override, then returns its status. Use this instead of calling get_run_details repeatedly. Progress notifications are sent while waiting when the client provides a progress token.`),
+			mcp.WithTitleAnnotation("Wait for a Terraform run to complete"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("run_id",
+				mcp.Required(),
+				mcp.Description("The ID of the run to wait for"),
+			),
+			mcp.WithNumber("timeout_seconds",
+				mcp.Description(fmt.Sprintf("Maximum time to wait in seconds, at most %d", int(maxRunWaitTimeout.Seconds()))),
+				mcp.DefaultNumber(defaultRunWaitTimeout.Seconds()),
+				mcp.Min(1),
+				mcp.Max(maxRunWaitTimeout.Seconds()),
+			),
+			mcp.WithNumber("poll_interval_seconds",
+				mcp.Description("Time between status checks in seconds"),
+				mcp.DefaultNumber(defaultRunPollInterval.Seconds()),
+				mcp.Min(minRunPollInterval.Seconds()),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return waitForRunHandler(ctx, request, logger)
+		},
+	}
+}
+
+func waitForRunHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	runID, err := request.RequireString("run_id")
+	if err != nil {
+		return ToolError(logger, "missing required input: run_id", err)
+	}
+	runID = strings.TrimSpace(runID)
+
+	timeout := time.Duration(request.GetFloat("timeout_seconds", defaultRunWaitTimeout.Seconds()) * float64(time.Second))
+	if timeout <= 0 || timeout > maxRunWaitTimeout {
+		return ToolErrorf(logger, "timeout_seconds must be between 1 and %d", int(maxRunWaitTimeout.Seconds()))
+	}
+	pollInterval := time.Duration(request.GetFloat("poll_interval_seconds", defaultRunPollInterval.Seconds()) * float64(time.Second))
+	if pollInterval < minRunPollInterval {
+		pollInterval = minRunPollInterval
+	}
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+
+	readRun := func(ctx context.Context) (*tfe.Run, error) {
+		return tfeClient.Runs.Read(ctx, runID)
+	}
+	onStatus := func(elapsed time.Duration, status tfe.RunStatus) {
+		sendProgress(ctx, request, int(elapsed.Seconds()), int(timeout.Seconds()), fmt.Sprintf("Run %s is %s", runID, status), logger)
+	}
+
+	result, err := waitForRun(ctx, runID, readRun, timeout, pollInterval, onStatus)
+	if err != nil {
+		return ToolErrorf(logger, "failed to wait for run %s: %v", runID, err)
+	}
+
+	buf, err := json.Marshal(result)
+	if err != nil {
+		return ToolError(logger, "failed to marshal run status", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+// waitForRun polls a run until it reaches a final status, needs a decision or the timeout expires
+func waitForRun(ctx context.Context, runID string, readRun func(context.Context) (*tfe.Run, error), timeout, pollInterval time.Duration, onStatus func(time.Duration, tfe.RunStatus)) (*RunWaitResult, error) {
+	start := time.Now()
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	result := &RunWaitResult{RunID: runID, StatusHistory: []RunStatusChange{}}
+	for {
+		run, err := readRun(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		elapsed := time.Since(start)
+		result.Status = string(run.Status)
+		result.Actions = run.Actions
+		result.ElapsedSeconds = int(elapsed.Seconds())
+		if len(result.StatusHistory) == 0 || result.StatusHistory[len(result.StatusHistory)-1].Status != result.Status {
+			result.StatusHistory = append(result.StatusHistory, RunStatusChange{
+				Status:     result.Status,
+				ObservedAt: time.Now().UTC().Format(time.RFC3339),
+			})
+			onStatus(elapsed, run.Status)
+		}
+
+		if outcome, message, done := runWaitOutcome(run); done {
+			result.Outcome = outcome
+			result.Message = message
+			return result, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-deadline.C:
+			result.Outcome = runWaitOutcomeTimedOut
+			result.Message = fmt.Sprintf("Run is still %s after %s, call wait_for_run again to keep waiting", run.Status, timeout)
+			return result, nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// runWaitOutcome reports whether a run stopped making progress on its own, and why
+func runWaitOutcome(run *tfe.Run) (string, string, bool) {
+	switch {
+	case finalRunStatuses[run.Status]:
+		return runWaitOutcomeFinished, fmt.Sprintf("Run finished with status %s", run.Status), true
+	case run.Status == tfe.RunPolicySoftFailed || run.Status == tfe.RunPolicyOverride:
+		return runWaitOutcomeNeedsAction, "A soft-mandatory policy failed, the run waits for a policy override or to be discarded", true
+	case run.Status == tfe.RunPostPlanAwaitingDecision:
+		return runWaitOutcomeNeedsAction, "A run task waits for a decision before the run can continue", true
+	case run.Actions != nil && run.Actions.IsConfirmable:
+		return runWaitOutcomeNeedsConfirmation, "The plan finished and the run waits to be confirmed, ask the user before applying it with action_run", true
+	}
+	return "", "", false
+}
@@ -0,0 +1,100 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-tfe"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWaitForRun(t *testing.T) {
+	logger := log.New()
+	logger.SetLevel(log.ErrorLevel)
+
+	t.Run("tool creation", func(t *testing.T) {
+		tool := WaitForRun(logger)
+
+		assert.Equal(t, "wait_for_run", tool.Tool.Name)
+		assert.True(t, *tool.Tool.Annotations.ReadOnlyHint)
+		assert.Equal(t, []string{"run_id"}, tool.Tool.InputSchema.Required)
+	})
+
+	// readRuns returns the given runs in order, repeating the last one
+	readRuns := func(runs ...*tfe.Run) func(context.Context) (*tfe.Run, error) {
+		calls := 0
+		return func(context.Context) (*tfe.Run, error) {
+			run := runs[min(calls, len(runs)-1)]
+			calls++
+			return run, nil
+		}
+	}
+
+	t.Run("finished", func(t *testing.T) {
+		var statuses []tfe.RunStatus
+		result, err := waitForRun(context.Background(), "run-1", readRuns(
+			&tfe.Run{Status: tfe.RunPlanning},
+			&tfe.Run{Status: tfe.RunPlanning},
+			&tfe.Run{Status: tfe.RunApplying},
+			&tfe.Run{Status: tfe.RunApplied},
+		), time.Second, time.Millisecond, func(_ time.Duration, status tfe.RunStatus) {
+			statuses = append(statuses, status)
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, runWaitOutcomeFinished, result.Outcome)
+		assert.Equal(t, "applied", result.Status)
+		assert.Equal(t, []tfe.RunStatus{tfe.RunPlanning, tfe.RunApplying, tfe.RunApplied}, statuses)
+		assert.Len(t, result.StatusHistory, 3)
+	})
+
+	t.Run("awaiting confirmation", func(t *testing.T) {
+		result, err := waitForRun(context.Background(), "run-1", readRuns(
+			&tfe.Run{Status: tfe.RunPlanning},
+			&tfe.Run{Status: tfe.RunPlanned, Actions: &tfe.RunActions{IsConfirmable: true}},
+		), time.Second, time.Millisecond, func(time.Duration, tfe.RunStatus) {})
+		require.NoError(t, err)
+
+		assert.Equal(t, runWaitOutcomeNeedsConfirmation, result.Outcome)
+		assert.Equal(t, "planned", result.Status)
+	})
+
+	t.Run("timed out", func(t *testing.T) {
+		result, err := waitForRun(context.Background(), "run-1", readRuns(
+			&tfe.Run{Status: tfe.RunPlanQueued},
+		), 20*time.Millisecond, time.Millisecond, func(time.Duration, tfe.RunStatus) {})
+		require.NoError(t, err)
+
+		assert.Equal(t, runWaitOutcomeTimedOut, result.Outcome)
+		assert.Equal(t, "plan_queued", result.Status)
+		assert.Len(t, result.StatusHistory, 1)
+	})
+
+	t.Run("canceled context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := waitForRun(ctx, "run-1", readRuns(&tfe.Run{Status: tfe.RunPlanQueued}), time.Second, time.Millisecond, func(time.Duration, tfe.RunStatus) {})
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("outcomes", func(t *testing.T) {
+		for status, outcome := range map[tfe.RunStatus]string{
+			tfe.RunErrored:                  runWaitOutcomeFinished,
+			tfe.RunPlannedAndFinished:       runWaitOutcomeFinished,
+			tfe.RunPolicySoftFailed:         runWaitOutcomeNeedsAction,
+			tfe.RunPostPlanAwaitingDecision: runWaitOutcomeNeedsAction,
+			tfe.RunApplying:                 "",
+		} {
+			got, _, done := runWaitOutcome(&tfe.Run{Status: status})
+			assert.Equal(t, outcome, got, status)
+			assert.Equal(t, outcome != "", done, status)
+		}
+	})
+}
@@ -0,0 +1,488 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v3"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// WorkspaceBlueprintsFileEnv points to the JSON or YAML file of the workspace blueprints
+const WorkspaceBlueprintsFileEnv = "MCP_WORKSPACE_BLUEPRINTS_FILE"
+
+var blueprintNamePattern = regexp.MustCompile(`^[a-zA-Z0-9_.-]{1,64}$`)
+
+var (
+	sharedWorkspaceBlueprintsFile   string
+	sharedWorkspaceBlueprintsFileMu sync.Mutex
+)
+
+// WorkspaceBlueprints is the content of the workspace blueprints file
+type WorkspaceBlueprints struct {
+	Blueprints []*WorkspaceBlueprint `json:"blueprints" yaml:"blueprints"`
+}
+
+// WorkspaceBlueprint is the standard shape of a workspace: its settings and what is attached to it.
+// Variable sets and teams are given by ID or by name.
+type WorkspaceBlueprint struct {
+	Name                       string                    `json:"name" yaml:"name"`
+	Description                string                    `json:"description,omitempty" yaml:"description,omitempty"`
+	Organization               string                    `json:"organization,omitempty" yaml:"organization,omitempty"`
+	ProjectID                  string                    `json:"project_id,omitempty" yaml:"project_id,omitempty"`
+	ExecutionMode              string                    `json:"execution_mode,omitempty" yaml:"execution_mode,omitempty"`
+	AgentPoolID                string                    `json:"agent_pool_id,omitempty" yaml:"agent_pool_id,omitempty"`
+	TerraformVersion           string                    `json:"terraform_version,omitempty" yaml:"terraform_version,omitempty"`
+	AutoApply                  bool                      `json:"auto_apply,omitempty" yaml:"auto_apply,omitempty"`
+	WorkingDirectory           string                    `json:"working_directory,omitempty" yaml:"working_directory,omitempty"`
+	Tags                       []string                  `json:"tags,omitempty" yaml:"tags,omitempty"`
+	VariableSets               []string                  `json:"variable_sets,omitempty" yaml:"variable_sets,omitempty"`
+	TeamAccess                 []*WorkspaceBlueprintTeam `json:"team_access,omitempty" yaml:"team_access,omitempty"`
+	VCSOAuthTokenID            string                    `json:"vcs_oauth_token_id,omitempty" yaml:"vcs_oauth_token_id,omitempty"`
+	VCSGitHubAppInstallationID string                    `json:"vcs_github_app_installation_id,omitempty" yaml:"vcs_github_app_installation_id,omitempty"`
+}
+
+// WorkspaceBlueprintTeam is the access of a team to the workspaces of a blueprint
+type WorkspaceBlueprintTeam struct {
+	Team   string `json:"team" yaml:"team"`
+	Access string `json:"access" yaml:"access"`
+}
+
+// BlueprintWorkspace is a workspace created from a blueprint, with its attachments
+type BlueprintWorkspace struct {
+	Blueprint     string                `json:"blueprint"`
+	WorkspaceID   string                `json:"workspace_id"`
+	WorkspaceName string                `json:"workspace_name"`
+	Organization  string                `json:"organization"`
+	ProjectID     string                `json:"project_id,omitempty"`
+	ExecutionMode string                `json:"execution_mode"`
+	Tags          []string              `json:"tags,omitempty"`
+	VariableSets  []string              `json:"variable_set_ids,omitempty"`
+	TeamAccess    []*BlueprintTeamGrant `json:"team_access,omitempty"`
+}
+
+// BlueprintTeamGrant is the access a team got to a workspace created from a blueprint
+type BlueprintTeamGrant struct {
+	TeamID   string `json:"team_id"`
+	TeamName string `json:"team_name"`
+	Access   string `json:"access"`
+}
+
+// SetWorkspaceBlueprintsFile sets the file the workspace blueprints are loaded from, such as with the
+// --workspace-blueprints-file flag. MCP_WORKSPACE_BLUEPRINTS_FILE is used when it isn't set.
+func SetWorkspaceBlueprintsFile(path string) {
+	sharedWorkspaceBlueprintsFileMu.Lock()
+	defer sharedWorkspaceBlueprintsFileMu.Unlock()
+	sharedWorkspaceBlueprintsFile = path
+}
+
+func workspaceBlueprintsFile() string {
+	sharedWorkspaceBlueprintsFileMu.Lock()
+	defer sharedWorkspaceBlueprintsFileMu.Unlock()
+	if sharedWorkspaceBlueprintsFile != "" {
+		return sharedWorkspaceBlueprintsFile
+	}
+	return strings.TrimSpace(utils.GetEnv(WorkspaceBlueprintsFileEnv, ""))
+}
+
+// LoadWorkspaceBlueprints reads and validates the workspace blueprints from a JSON or YAML file
+func LoadWorkspaceBlueprints(path string) (*WorkspaceBlueprints, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read workspace blueprints file: %w", err)
+	}
+	ext := strings.ToLower(filepath.Ext(path))
+	return ParseWorkspaceBlueprints(data, ext == ".yaml" || ext == ".yml")
+}
+
+// ParseWorkspaceBlueprints parses and validates workspace blueprints
+func ParseWorkspaceBlueprints(data []byte, isYAML bool) (*WorkspaceBlueprints, error) {
+	var blueprints WorkspaceBlueprints
+	if isYAML {
+		decoder := yaml.NewDecoder(bytes.NewReader(data))
+		decoder.KnownFields(true)
+		if err := decoder.Decode(&blueprints); err != nil {
+			return nil, fmt.Errorf("failed to parse workspace blueprints file: %w", err)
+		}
+	} else {
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&blueprints); err != nil {
+			return nil, fmt.Errorf("failed to parse workspace blueprints file: %w", err)
+		}
+	}
+
+	seen := make(map[string]bool)
+	for i, blueprint := range blueprints.Blueprints {
+		if blueprint == nil {
+			return nil, fmt.Errorf("workspace blueprint #%d is empty", i+1)
+		}
+		if err := blueprint.validate(); err != nil {
+			return nil, fmt.Errorf("workspace blueprint %q: %w", blueprint.Name, err)
+		}
+		if seen[blueprint.Name] {
+			return nil, fmt.Errorf("workspace blueprint %q is declared more than once", blueprint.Name)
+		}
+		seen[blueprint.Name] = true
+	}
+	return &blueprints, nil
+}
+
+func (b *WorkspaceBlueprint) validate() error {
+	if !blueprintNamePattern.MatchString(b.Name) {
+		return fmt.Errorf("name must match %s", blueprintNamePattern)
+	}
+	b.ExecutionMode = strings.ToLower(strings.TrimSpace(b.ExecutionMode))
+	switch b.ExecutionMode {
+	case "":
+		b.ExecutionMode = "remote"
+	case "remote", "local":
+	case "agent":
+		if b.AgentPoolID == "" {
+			return fmt.Errorf("agent_pool_id is required with the agent execution mode")
+		}
+	default:
+		return fmt.Errorf("invalid execution_mode '%s' - must be 'remote', 'local', or 'agent'", b.ExecutionMode)
+	}
+	if b.AgentPoolID != "" && b.ExecutionMode != "agent" {
+		return fmt.Errorf("agent_pool_id requires the agent execution mode")
+	}
+	if b.VCSOAuthTokenID != "" && b.VCSGitHubAppInstallationID != "" {
+		return fmt.Errorf("only one of vcs_oauth_token_id and vcs_github_app_installation_id may be set")
+	}
+	for _, grant := range b.TeamAccess {
+		if grant == nil || strings.TrimSpace(grant.Team) == "" {
+			return fmt.Errorf("every team_access entry needs a team")
+		}
+		switch tfe.AccessType(grant.Access) {
+		case tfe.AccessRead, tfe.AccessPlan, tfe.AccessWrite, tfe.AccessAdmin:
+		default:
+			return fmt.Errorf("invalid access '%s' for team '%s' - must be 'read', 'plan', 'write', or 'admin'", grant.Access, grant.Team)
+		}
+	}
+	return nil
+}
+
+// find returns the blueprint with the given name
+func (b *WorkspaceBlueprints) find(name string) *WorkspaceBlueprint {
+	for _, blueprint := range b.Blueprints {
+		if blueprint.Name == name {
+			return blueprint
+		}
+	}
+	return nil
+}
+
+// names returns the sorted names of the blueprints
+func (b *WorkspaceBlueprints) names() []string {
+	names := make([]string, 0, len(b.Blueprints))
+	for _, blueprint := range b.Blueprints {
+		names = append(names, blueprint.Name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// CreateWorkspaceFromBlueprint creates a tool to create a workspace with the settings and attachments
+// of a blueprint. The blueprints are loaded when the tool is created.
+func CreateWorkspaceFromBlueprint(logger *log.Logger) server.ServerTool {
+	blueprints := &WorkspaceBlueprints{}
+	var loadErr error
+	if path := workspaceBlueprintsFile(); path != "" {
+		blueprints, loadErr = LoadWorkspaceBlueprints(path)
+		if loadErr != nil {
+			logger.Errorf("Workspace blueprints are disabled: %v", loadErr)
+		} else {
+			logger.Infof("Loaded %d workspace blueprints from %s", len(blueprints.Blueprints), path)
+		}
+	}
+
+	available := "No blueprints are configured; the server administrator sets them up with " + WorkspaceBlueprintsFileEnv + "."
+	if loadErr == nil && len(blueprints.Blueprints) > 0 {
+		available = "Available blueprints: " + strings.Join(blueprints.names(), ", ") + "."
+	}
+
+	return server.ServerTool{
+		Tool: mcp.NewTool("create_workspace_from_blueprint",
+			mcp.WithDescription(`Creates a workspace from a blueprint defined by the server administrator, with the blueprint's execution mode, Terraform version, tags, variable sets and team access, in one call. Either everything is set up or the workspace is deleted again. Prefer it over create_workspace when the organization standardizes its workspaces. `+available),
+			mcp.WithTitleAnnotation("Create a Terraform workspace from a blueprint"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(false),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("terraform_org_name",
+				mcp.Required(),
+				mcp.Description("The Terraform Cloud/Enterprise organization name"),
+			),
+			mcp.WithString("blueprint",
+				mcp.Required(),
+				mcp.Description("Name of the blueprint"),
+			),
+			mcp.WithString("workspace_name",
+				mcp.Required(),
+				mcp.Description("The name of the workspace to create"),
+			),
+			mcp.WithString("description",
+				mcp.Description("Optional description of the workspace"),
+			),
+			mcp.WithString("project_id",
+				mcp.Description("Optional project of the workspace, instead of the project of the blueprint"),
+			),
+			mcp.WithString("tags",
+				mcp.Description("Optional comma-separated list of tags added to the tags of the blueprint"),
+			),
+			mcp.WithString("vcs_repo_identifier",
+				mcp.Description("Optional VCS repository of the workspace (e.g., 'org/repo'), connected through the VCS connection of the blueprint"),
+			),
+			mcp.WithString("vcs_repo_branch",
+				mcp.Description("Optional VCS repository branch (default: the default branch of the repository)"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			if loadErr != nil {
+				return ToolError(logger, "workspace blueprints could not be loaded", loadErr)
+			}
+			return createWorkspaceFromBlueprintHandler(ctx, request, blueprints, logger)
+		},
+	}
+}
+
+func createWorkspaceFromBlueprintHandler(ctx context.Context, request mcp.CallToolRequest, blueprints *WorkspaceBlueprints, logger *log.Logger) (*mcp.CallToolResult, error) {
+	orgName, err := request.RequireString("terraform_org_name")
+	if err != nil {
+		return ToolError(logger, "missing required input: terraform_org_name", err)
+	}
+	orgName = strings.TrimSpace(orgName)
+
+	blueprintName, err := request.RequireString("blueprint")
+	if err != nil {
+		return ToolError(logger, "missing required input: blueprint", err)
+	}
+	blueprint := blueprints.find(strings.TrimSpace(blueprintName))
+	if blueprint == nil {
+		if len(blueprints.Blueprints) == 0 {
+			return ToolErrorf(logger, "no workspace blueprints are configured, set %s", WorkspaceBlueprintsFileEnv)
+		}
+		return ToolErrorf(logger, "workspace blueprint '%s' not found, available blueprints: %s", blueprintName, strings.Join(blueprints.names(), ", "))
+	}
+	if blueprint.Organization != "" && !strings.EqualFold(blueprint.Organization, orgName) {
+		return ToolErrorf(logger, "workspace blueprint '%s' is for org '%s', not '%s'", blueprint.Name, blueprint.Organization, orgName)
+	}
+
+	workspaceName, err := request.RequireString("workspace_name")
+	if err != nil {
+		return ToolError(logger, "missing required input: workspace_name", err)
+	}
+	workspaceName = strings.TrimSpace(workspaceName)
+
+	vcsRepoIdentifier := strings.TrimSpace(request.GetString("vcs_repo_identifier", ""))
+	if vcsRepoIdentifier != "" && blueprint.VCSOAuthTokenID == "" && blueprint.VCSGitHubAppInstallationID == "" {
+		return ToolErrorf(logger, "workspace blueprint '%s' has no VCS connection, use create_workspace to connect a repository", blueprint.Name)
+	}
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client - ensure TFE_TOKEN and TFE_ADDRESS are configured", err)
+	}
+
+	// The variable sets and teams are resolved before the workspace is created, so that a mistake in
+	// the blueprint leaves nothing behind
+	varSetIDs, err := resolveBlueprintVariableSets(ctx, tfeClient, orgName, blueprint.VariableSets)
+	if err != nil {
+		return ToolErrorf(logger, "workspace blueprint '%s': %v", blueprint.Name, err)
+	}
+	grants, err := resolveBlueprintTeams(ctx, tfeClient, orgName, blueprint.TeamAccess)
+	if err != nil {
+		return ToolErrorf(logger, "workspace blueprint '%s': %v", blueprint.Name, err)
+	}
+
+	options := blueprintWorkspaceOptions(blueprint, workspaceName, request)
+	workspace, err := tfeClient.Workspaces.Create(ctx, orgName, options)
+	if err != nil {
+		return ToolErrorf(logger, "failed to create workspace '%s' in org '%s': %v", workspaceName, orgName, err)
+	}
+
+	if err := attachBlueprint(ctx, tfeClient, workspace, varSetIDs, grants); err != nil {
+		if deleteErr := tfeClient.Workspaces.DeleteByID(ctx, workspace.ID); deleteErr != nil {
+			return ToolErrorf(logger, "failed to set up workspace '%s': %v; deleting the workspace also failed, delete it manually: %v", workspaceName, err, deleteErr)
+		}
+		return ToolErrorf(logger, "failed to set up workspace '%s', the workspace was deleted: %v", workspaceName, err)
+	}
+	logger.WithFields(log.Fields{
+		"blueprint": blueprint.Name,
+		"workspace": workspace.ID,
+	}).Info("Created workspace from blueprint")
+
+	result := &BlueprintWorkspace{
+		Blueprint:     blueprint.Name,
+		WorkspaceID:   workspace.ID,
+		WorkspaceName: workspace.Name,
+		Organization:  orgName,
+		ExecutionMode: workspace.ExecutionMode,
+		VariableSets:  varSetIDs,
+		TeamAccess:    grants,
+	}
+	if workspace.Project != nil {
+		result.ProjectID = workspace.Project.ID
+	}
+	for _, tag := range options.Tags {
+		result.Tags = append(result.Tags, tag.Name)
+	}
+
+	buf, err := json.Marshal(result)
+	if err != nil {
+		return ToolError(logger, "failed to marshal workspace", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+// blueprintWorkspaceOptions returns the options of a workspace created from a blueprint
+func blueprintWorkspaceOptions(blueprint *WorkspaceBlueprint, workspaceName string, request mcp.CallToolRequest) tfe.WorkspaceCreateOptions {
+	options := tfe.WorkspaceCreateOptions{
+		Name:          tfe.String(workspaceName),
+		AutoApply:     tfe.Bool(blueprint.AutoApply),
+		ExecutionMode: tfe.String(blueprint.ExecutionMode),
+		SourceName:    tfe.String(SourceName),
+	}
+	if description := strings.TrimSpace(request.GetString("description", "")); description != "" {
+		options.Description = tfe.String(description)
+	}
+	if blueprint.AgentPoolID != "" {
+		options.AgentPoolID = tfe.String(blueprint.AgentPoolID)
+	}
+	if blueprint.TerraformVersion != "" {
+		options.TerraformVersion = tfe.String(blueprint.TerraformVersion)
+	}
+	if blueprint.WorkingDirectory != "" {
+		options.WorkingDirectory = tfe.String(blueprint.WorkingDirectory)
+	}
+
+	projectID := strings.TrimSpace(request.GetString("project_id", ""))
+	if projectID == "" {
+		projectID = blueprint.ProjectID
+	}
+	if projectID != "" {
+		options.Project = &tfe.Project{ID: projectID}
+	}
+
+	seen := make(map[string]bool)
+	extraTags := strings.Split(request.GetString("tags", ""), ",")
+	for _, name := range append(append([]string{}, blueprint.Tags...), extraTags...) {
+		name = strings.TrimSpace(name)
+		if name != "" && !seen[name] {
+			seen[name] = true
+			options.Tags = append(options.Tags, &tfe.Tag{Name: name})
+		}
+	}
+
+	if identifier := strings.TrimSpace(request.GetString("vcs_repo_identifier", "")); identifier != "" {
+		vcsRepo := &tfe.VCSRepoOptions{Identifier: tfe.String(identifier)}
+		if blueprint.VCSOAuthTokenID != "" {
+			vcsRepo.OAuthTokenID = tfe.String(blueprint.VCSOAuthTokenID)
+		} else {
+			vcsRepo.GHAInstallationID = tfe.String(blueprint.VCSGitHubAppInstallationID)
+		}
+		if branch := strings.TrimSpace(request.GetString("vcs_repo_branch", "")); branch != "" {
+			vcsRepo.Branch = tfe.String(branch)
+		}
+		options.VCSRepo = vcsRepo
+	}
+	return options
+}
+
+// resolveBlueprintVariableSets returns the IDs of the variable sets of a blueprint given by ID or by name
+func resolveBlueprintVariableSets(ctx context.Context, tfeClient *tfe.Client, orgName string, varSets []string) ([]string, error) {
+	var ids []string
+	for _, varSet := range varSets {
+		varSet = strings.TrimSpace(varSet)
+		if strings.HasPrefix(varSet, "varset-") {
+			ids = append(ids, varSet)
+			continue
+		}
+		options := &tfe.VariableSetListOptions{ListOptions: tfe.ListOptions{PageNumber: 1, PageSize: 100}, Query: varSet}
+		var id string
+		for id == "" {
+			page, err := tfeClient.VariableSets.List(ctx, orgName, options)
+			if err != nil {
+				return nil, fmt.Errorf("failed to look up variable set '%s': %w", varSet, err)
+			}
+			for _, candidate := range page.Items {
+				if candidate.Name == varSet {
+					id = candidate.ID
+				}
+			}
+			if page.Pagination == nil || page.NextPage == 0 {
+				break
+			}
+			options.PageNumber = page.NextPage
+		}
+		if id == "" {
+			return nil, fmt.Errorf("variable set '%s' not found in org '%s'", varSet, orgName)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// resolveBlueprintTeams returns the teams of the team access of a blueprint given by ID or by name
+func resolveBlueprintTeams(ctx context.Context, tfeClient *tfe.Client, orgName string, teamAccess []*WorkspaceBlueprintTeam) ([]*BlueprintTeamGrant, error) {
+	if len(teamAccess) == 0 {
+		return nil, nil
+	}
+	teams, err := listTeams(ctx, tfeClient, orgName, &tfe.TeamListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the teams of org '%s': %w", orgName, err)
+	}
+
+	grants := make([]*BlueprintTeamGrant, 0, len(teamAccess))
+	for _, access := range teamAccess {
+		team := strings.TrimSpace(access.Team)
+		var grant *BlueprintTeamGrant
+		for _, candidate := range teams {
+			if candidate.ID == team || candidate.Name == team {
+				grant = &BlueprintTeamGrant{TeamID: candidate.ID, TeamName: candidate.Name, Access: access.Access}
+				break
+			}
+		}
+		if grant == nil {
+			return nil, fmt.Errorf("team '%s' not found in org '%s'", team, orgName)
+		}
+		grants = append(grants, grant)
+	}
+	return grants, nil
+}
+
+// attachBlueprint attaches the variable sets and grants the team access of a blueprint to a new workspace
+func attachBlueprint(ctx context.Context, tfeClient *tfe.Client, workspace *tfe.Workspace, varSetIDs []string, grants []*BlueprintTeamGrant) error {
+	if err := setUpWorkspace(ctx, tfeClient, workspace, nil, varSetIDs); err != nil {
+		return err
+	}
+	for _, grant := range grants {
+		access := tfe.AccessType(grant.Access)
+		_, err := tfeClient.TeamAccess.Add(ctx, tfe.TeamAccessAddOptions{
+			Access:    &access,
+			Team:      &tfe.Team{ID: grant.TeamID},
+			Workspace: workspace,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to grant team '%s' %s access: %w", grant.TeamName, grant.Access, err)
+		}
+	}
+	return nil
+}
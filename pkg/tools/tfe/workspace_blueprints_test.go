@@ -0,0 +1,150 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/mark3labs/mcp-go/mcp"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testBlueprintsYAML = `
+blueprints:
+  - name: aws-service
+    organization: acme
+    project_id: prj-services
+    execution_mode: agent
+    agent_pool_id: apool-123
+    terraform_version: 1.9.5
+    tags: [aws, managed]
+    variable_sets: [varset-abc, AWS credentials]
+    team_access:
+      - team: platform
+        access: admin
+      - team: team-dev
+        access: write
+    vcs_oauth_token_id: ot-123
+  - name: sandbox
+    execution_mode: local
+`
+
+func TestParseWorkspaceBlueprints(t *testing.T) {
+	t.Run("yaml", func(t *testing.T) {
+		blueprints, err := ParseWorkspaceBlueprints([]byte(testBlueprintsYAML), true)
+		require.NoError(t, err)
+		require.Len(t, blueprints.Blueprints, 2)
+
+		aws := blueprints.find("aws-service")
+		require.NotNil(t, aws)
+		assert.Equal(t, "agent", aws.ExecutionMode)
+		assert.Equal(t, []string{"varset-abc", "AWS credentials"}, aws.VariableSets)
+		assert.Equal(t, &WorkspaceBlueprintTeam{Team: "team-dev", Access: "write"}, aws.TeamAccess[1])
+		assert.Equal(t, []string{"aws-service", "sandbox"}, blueprints.names())
+		assert.Nil(t, blueprints.find("unknown"))
+	})
+
+	t.Run("json", func(t *testing.T) {
+		blueprints, err := ParseWorkspaceBlueprints([]byte(`{"blueprints":[{"name":"default","tags":["team-a"]}]}`), false)
+		require.NoError(t, err)
+		assert.Equal(t, "remote", blueprints.Blueprints[0].ExecutionMode)
+	})
+
+	invalid := map[string]string{
+		"unknown field":            `{"blueprints":[{"name":"a","color":"blue"}]}`,
+		"invalid name":             `{"blueprints":[{"name":"a b"}]}`,
+		"duplicate":                `{"blueprints":[{"name":"a"},{"name":"a"}]}`,
+		"invalid execution mode":   `{"blueprints":[{"name":"a","execution_mode":"cloud"}]}`,
+		"agent without pool":       `{"blueprints":[{"name":"a","execution_mode":"agent"}]}`,
+		"pool without agent mode":  `{"blueprints":[{"name":"a","agent_pool_id":"apool-1"}]}`,
+		"two vcs connections":      `{"blueprints":[{"name":"a","vcs_oauth_token_id":"ot-1","vcs_github_app_installation_id":"ghain-1"}]}`,
+		"invalid access":           `{"blueprints":[{"name":"a","team_access":[{"team":"dev","access":"owner"}]}]}`,
+		"team access without team": `{"blueprints":[{"name":"a","team_access":[{"access":"read"}]}]}`,
+	}
+	for name, data := range invalid {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParseWorkspaceBlueprints([]byte(data), false)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestBlueprintWorkspaceOptions(t *testing.T) {
+	blueprints, err := ParseWorkspaceBlueprints([]byte(testBlueprintsYAML), true)
+	require.NoError(t, err)
+
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]any{
+		"tags":                "managed, payments",
+		"vcs_repo_identifier": "acme/payments",
+		"vcs_repo_branch":     "release",
+	}
+	options := blueprintWorkspaceOptions(blueprints.find("aws-service"), "payments-prod", request)
+
+	assert.Equal(t, "payments-prod", *options.Name)
+	assert.Equal(t, "agent", *options.ExecutionMode)
+	assert.Equal(t, "apool-123", *options.AgentPoolID)
+	assert.Equal(t, "1.9.5", *options.TerraformVersion)
+	assert.Equal(t, "prj-services", options.Project.ID)
+	assert.Equal(t, []*tfe.Tag{{Name: "aws"}, {Name: "managed"}, {Name: "payments"}}, options.Tags)
+	assert.Equal(t, "acme/payments", *options.VCSRepo.Identifier)
+	assert.Equal(t, "ot-123", *options.VCSRepo.OAuthTokenID)
+	assert.Equal(t, "release", *options.VCSRepo.Branch)
+
+	request.Params.Arguments = map[string]any{"project_id": "prj-other"}
+	options = blueprintWorkspaceOptions(blueprints.find("sandbox"), "scratch", request)
+	assert.Equal(t, "prj-other", options.Project.ID)
+	assert.Equal(t, "local", *options.ExecutionMode)
+	assert.Nil(t, options.VCSRepo)
+	assert.Empty(t, options.Tags)
+}
+
+func TestCreateWorkspaceFromBlueprint(t *testing.T) {
+	logger := log.New()
+	logger.SetLevel(log.ErrorLevel)
+	t.Setenv(WorkspaceBlueprintsFileEnv, "")
+
+	path := filepath.Join(t.TempDir(), "blueprints.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(testBlueprintsYAML), 0o600))
+	SetWorkspaceBlueprintsFile(path)
+	t.Cleanup(func() { SetWorkspaceBlueprintsFile("") })
+
+	tool := CreateWorkspaceFromBlueprint(logger)
+	assert.Equal(t, "create_workspace_from_blueprint", tool.Tool.Name)
+	assert.Contains(t, tool.Tool.Description, "Available blueprints: aws-service, sandbox.")
+	assert.Equal(t, []string{"terraform_org_name", "blueprint", "workspace_name"}, tool.Tool.InputSchema.Required)
+	assert.False(t, *tool.Tool.Annotations.ReadOnlyHint)
+
+	call := func(args map[string]any) *mcp.CallToolResult {
+		request := mcp.CallToolRequest{}
+		request.Params.Arguments = args
+		result, err := tool.Handler(context.Background(), request)
+		require.NoError(t, err)
+		return result
+	}
+
+	result := call(map[string]any{"terraform_org_name": "acme", "blueprint": "unknown", "workspace_name": "ws"})
+	assert.True(t, result.IsError)
+	assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "available blueprints: aws-service, sandbox")
+
+	result = call(map[string]any{"terraform_org_name": "other", "blueprint": "aws-service", "workspace_name": "ws"})
+	assert.True(t, result.IsError)
+	assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "is for org 'acme'")
+
+	result = call(map[string]any{"terraform_org_name": "acme", "blueprint": "sandbox", "workspace_name": "ws", "vcs_repo_identifier": "acme/app"})
+	assert.True(t, result.IsError)
+	assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "has no VCS connection")
+
+	t.Run("no blueprints", func(t *testing.T) {
+		SetWorkspaceBlueprintsFile("")
+		tool := CreateWorkspaceFromBlueprint(logger)
+		assert.Contains(t, tool.Tool.Description, "No blueprints are configured")
+	})
+}
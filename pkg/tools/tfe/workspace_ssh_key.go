@@ -0,0 +1,191 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// WorkspaceSSHKeyResult is returned by the SSH key assignment tools
+type WorkspaceSSHKeyResult struct {
+	WorkspaceID   string `json:"workspace_id"`
+	WorkspaceName string `json:"workspace_name"`
+	SSHKeyID      string `json:"ssh_key_id,omitempty"`
+	SSHKeyName    string `json:"ssh_key_name,omitempty"`
+}
+
+// AssignWorkspaceSSHKey creates a tool to assign an SSH key to a workspace.
+func AssignWorkspaceSSHKey(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("assign_workspace_ssh_key",
+			mcp.WithDescription(`Assigns an organization SSH key to a Terraform workspace. Terraform uses the key to clone modules sourced from private Git repositories over SSH during runs.`),
+			mcp.WithTitleAnnotation("Assign an SSH key to a workspace"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(false),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("terraform_org_name",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+			mcp.WithString("workspace_name",
+				mcp.Required(),
+				mcp.Description("Workspace name"),
+			),
+			mcp.WithString("ssh_key",
+				mcp.Required(),
+				mcp.Description("The ID (e.g. 'sshkey-abc123') or name of an SSH key of the organization"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return assignWorkspaceSSHKeyHandler(ctx, request, logger)
+		},
+	}
+}
+
+// UnassignWorkspaceSSHKey creates a tool to unassign the SSH key of a workspace.
+func UnassignWorkspaceSSHKey(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("unassign_workspace_ssh_key",
+			mcp.WithDescription(`Removes the SSH key assigned to a Terraform workspace. Runs that source modules from private Git repositories over SSH will fail afterwards.`),
+			mcp.WithTitleAnnotation("Unassign the SSH key of a workspace"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(false),
+			mcp.WithDestructiveHintAnnotation(true),
+			mcp.WithString("terraform_org_name",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+			mcp.WithString("workspace_name",
+				mcp.Required(),
+				mcp.Description("Workspace name"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return unassignWorkspaceSSHKeyHandler(ctx, request, logger)
+		},
+	}
+}
+
+func assignWorkspaceSSHKeyHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	orgName, err := request.RequireString("terraform_org_name")
+	if err != nil {
+		return ToolError(logger, "missing required input: terraform_org_name", err)
+	}
+	orgName = strings.TrimSpace(orgName)
+
+	workspaceName, err := request.RequireString("workspace_name")
+	if err != nil {
+		return ToolError(logger, "missing required input: workspace_name", err)
+	}
+	workspaceName = strings.TrimSpace(workspaceName)
+
+	sshKeyRef, err := request.RequireString("ssh_key")
+	if err != nil {
+		return ToolError(logger, "missing required input: ssh_key", err)
+	}
+	sshKeyRef = strings.TrimSpace(sshKeyRef)
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+
+	workspace, err := tfeClient.Workspaces.Read(ctx, orgName, workspaceName)
+	if err != nil {
+		return ToolErrorf(logger, "workspace '%s' not found in org '%s'", workspaceName, orgName)
+	}
+
+	sshKey, err := findSSHKey(ctx, tfeClient, orgName, sshKeyRef)
+	if err != nil {
+		return ToolError(logger, "failed to find SSH key", err)
+	}
+
+	workspace, err = tfeClient.Workspaces.AssignSSHKey(ctx, workspace.ID, tfe.WorkspaceAssignSSHKeyOptions{
+		SSHKeyID: tfe.String(sshKey.ID),
+	})
+	if err != nil {
+		return ToolErrorf(logger, "failed to assign SSH key '%s' to workspace '%s': %v", sshKey.ID, workspaceName, err)
+	}
+
+	return workspaceSSHKeyResult(workspace, sshKey, logger)
+}
+
+func unassignWorkspaceSSHKeyHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	orgName, err := request.RequireString("terraform_org_name")
+	if err != nil {
+		return ToolError(logger, "missing required input: terraform_org_name", err)
+	}
+	orgName = strings.TrimSpace(orgName)
+
+	workspaceName, err := request.RequireString("workspace_name")
+	if err != nil {
+		return ToolError(logger, "missing required input: workspace_name", err)
+	}
+	workspaceName = strings.TrimSpace(workspaceName)
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+
+	workspace, err := tfeClient.Workspaces.Read(ctx, orgName, workspaceName)
+	if err != nil {
+		return ToolErrorf(logger, "workspace '%s' not found in org '%s'", workspaceName, orgName)
+	}
+	if workspace.SSHKey == nil {
+		return ToolErrorf(logger, "workspace '%s' has no SSH key assigned", workspaceName)
+	}
+
+	workspace, err = tfeClient.Workspaces.UnassignSSHKey(ctx, workspace.ID)
+	if err != nil {
+		return ToolErrorf(logger, "failed to unassign SSH key from workspace '%s': %v", workspaceName, err)
+	}
+
+	return workspaceSSHKeyResult(workspace, nil, logger)
+}
+
+// findSSHKey resolves an SSH key of the organization by ID or by name
+func findSSHKey(ctx context.Context, tfeClient *tfe.Client, orgName string, ref string) (*tfe.SSHKey, error) {
+	if strings.HasPrefix(ref, "sshkey-") {
+		return tfeClient.SSHKeys.Read(ctx, ref)
+	}
+
+	keys, err := listSSHKeys(ctx, tfeClient, orgName)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range keys {
+		if key.Name == ref {
+			return key, nil
+		}
+	}
+	return nil, fmt.Errorf("no SSH key named '%s' in org '%s'", ref, orgName)
+}
+
+func workspaceSSHKeyResult(workspace *tfe.Workspace, sshKey *tfe.SSHKey, logger *log.Logger) (*mcp.CallToolResult, error) {
+	result := WorkspaceSSHKeyResult{
+		WorkspaceID:   workspace.ID,
+		WorkspaceName: workspace.Name,
+	}
+	if sshKey != nil {
+		result.SSHKeyID = sshKey.ID
+		result.SSHKeyName = sshKey.Name
+	}
+
+	buf, err := json.Marshal(result)
+	if err != nil {
+		return ToolError(logger, "failed to marshal result", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
@@ -48,23 +48,7 @@ func CreateWorkspaceTags(logger *log.Logger) server.ServerTool {
 				return ToolError(logger, "missing required input: tags", err)
 			}
 
-			tagNames := strings.Split(strings.TrimSpace(tagsStr), ",")
-			var tags []*tfe.TagBinding
-			for _, tagName := range tagNames {
-				tagName = strings.TrimSpace(tagName)
-				if strings.Contains(tagName, ":") {
-					parts := strings.SplitN(tagName, ":", 2)
-					key := strings.TrimSpace(parts[0])
-					value := strings.TrimSpace(parts[1])
-					if key != "" {
-						tags = append(tags, &tfe.TagBinding{Key: key, Value: value})
-					}
-					continue
-				}
-				if tagName != "" {
-					tags = append(tags, &tfe.TagBinding{Key: tagName})
-				}
-			}
+			tags := parseTagBindings(tagsStr)
 
 			tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
 			if err != nil {
@@ -76,9 +60,21 @@ func CreateWorkspaceTags(logger *log.Logger) server.ServerTool {
 				return ToolErrorf(logger, "workspace '%s' not found in org '%s'", workspaceName, orgName)
 			}
 
-			_, err = tfeClient.Workspaces.AddTagBindings(ctx, workspace.ID, tfe.WorkspaceAddTagBindingsOptions{
-				TagBindings: tags,
-			})
+			// Terraform Enterprise releases without key-value tags only support tag names
+			if err := client.PlatformOf(tfeClient).Supports(client.FeatureTagBindings); err != nil {
+				tagNames := make([]*tfe.Tag, 0, len(tags))
+				for _, tag := range tags {
+					if tag.Value != "" {
+						return ToolErrorf(logger, "cannot add tag '%s:%s': %v", tag.Key, tag.Value, err)
+					}
+					tagNames = append(tagNames, &tfe.Tag{Name: tag.Key})
+				}
+				err = tfeClient.Workspaces.AddTags(ctx, workspace.ID, tfe.WorkspaceAddTagsOptions{Tags: tagNames})
+			} else {
+				_, err = tfeClient.Workspaces.AddTagBindings(ctx, workspace.ID, tfe.WorkspaceAddTagBindingsOptions{
+					TagBindings: tags,
+				})
+			}
 			if err != nil {
 				return ToolErrorf(logger, "failed to add tags to workspace '%s': %v", workspaceName, err)
 			}
@@ -137,16 +133,19 @@ func ReadWorkspaceTags(logger *log.Logger) server.ServerTool {
 				tagNames = append(tagNames, tag.Name)
 			}
 
+			// Terraform Enterprise releases without key-value tags have no tag bindings to list
 			var tagBindings []string
-			bindings, err := tfeClient.Workspaces.ListTagBindings(ctx, workspace.ID)
-			if err != nil {
-				return ToolError(logger, "failed to list tag bindings", err)
-			}
-			for _, binding := range bindings {
-				if binding.Value != "" {
-					tagBindings = append(tagBindings, fmt.Sprintf("%s:%s", binding.Key, binding.Value))
-				} else {
-					tagBindings = append(tagBindings, binding.Key)
+			if client.PlatformOf(tfeClient).Supports(client.FeatureTagBindings) == nil {
+				bindings, err := tfeClient.Workspaces.ListTagBindings(ctx, workspace.ID)
+				if err != nil {
+					return ToolError(logger, "failed to list tag bindings", err)
+				}
+				for _, binding := range bindings {
+					if binding.Value != "" {
+						tagBindings = append(tagBindings, fmt.Sprintf("%s:%s", binding.Key, binding.Value))
+					} else {
+						tagBindings = append(tagBindings, binding.Key)
+					}
 				}
 			}
 
@@ -0,0 +1,224 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	defaultVariableHistoryDays = 90
+	maxAuditTrailPages         = 50
+	auditTrailPageSize         = 100
+)
+
+// VariableHistory is the reconstructed change history of a workspace variable
+type VariableHistory struct {
+	WorkspaceID   string                  `json:"workspace_id"`
+	WorkspaceName string                  `json:"workspace_name"`
+	Key           string                  `json:"key"`
+	VariableIDs   []string                `json:"variable_ids"`
+	Since         time.Time               `json:"since"`
+	Changes       []*VariableHistoryEvent `json:"changes"`
+	Truncated     bool                    `json:"truncated,omitempty"`
+}
+
+// VariableHistoryEvent is a single audit trail event of a variable
+type VariableHistoryEvent struct {
+	Timestamp      time.Time `json:"timestamp"`
+	Action         string    `json:"action"`
+	VariableID     string    `json:"variable_id"`
+	Actor          string    `json:"actor"`
+	ActorType      string    `json:"actor_type"`
+	AccessorID     string    `json:"accessor_id"`
+	ImpersonatorID string    `json:"impersonator_id,omitempty"`
+	RequestID      string    `json:"request_id,omitempty"`
+}
+
+// GetWorkspaceVariableHistory creates a tool to reconstruct who changed a workspace variable and when.
+func GetWorkspaceVariableHistory(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("get_workspace_variable_history",
+			mcp.WithDescription(`Reconstructs the change history of a workspace variable, who created, updated or deleted it and when, from the organization audit trail. Values are never included. The audit trail is only available on HCP Terraform plans that include it and requires an organization token.`),
+			mcp.WithTitleAnnotation("Get the change history of a workspace variable"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("terraform_org_name",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+			mcp.WithString("workspace_name",
+				mcp.Required(),
+				mcp.Description("Workspace name"),
+			),
+			mcp.WithString("key",
+				mcp.Required(),
+				mcp.Description("Variable key, e.g. 'instance_type' or 'TF_VAR_instance_type'"),
+			),
+			mcp.WithNumber("days",
+				mcp.Description("Number of days of audit trail to search"),
+				mcp.DefaultNumber(defaultVariableHistoryDays),
+				mcp.Min(1),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return getWorkspaceVariableHistoryHandler(ctx, request, logger)
+		},
+	}
+}
+
+func getWorkspaceVariableHistoryHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	orgName, err := request.RequireString("terraform_org_name")
+	if err != nil {
+		return ToolError(logger, "missing required input: terraform_org_name", err)
+	}
+	orgName = strings.TrimSpace(orgName)
+
+	workspaceName, err := request.RequireString("workspace_name")
+	if err != nil {
+		return ToolError(logger, "missing required input: workspace_name", err)
+	}
+	workspaceName = strings.TrimSpace(workspaceName)
+
+	key, err := request.RequireString("key")
+	if err != nil {
+		return ToolError(logger, "missing required input: key", err)
+	}
+	key = strings.TrimSpace(key)
+
+	days := request.GetInt("days", defaultVariableHistoryDays)
+	if days < 1 {
+		return ToolErrorf(logger, "days must be at least 1, got %d", days)
+	}
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+
+	workspace, err := tfeClient.Workspaces.Read(ctx, orgName, workspaceName)
+	if err != nil {
+		return ToolErrorf(logger, "workspace '%s' not found in org '%s'", workspaceName, orgName)
+	}
+
+	variableIDs, err := workspaceVariableIDs(ctx, tfeClient, workspace.ID, key)
+	if err != nil {
+		return ToolErrorf(logger, "failed to list the variables of workspace '%s': %v", workspaceName, err)
+	}
+
+	history := &VariableHistory{
+		WorkspaceID:   workspace.ID,
+		WorkspaceName: workspace.Name,
+		Key:           key,
+		VariableIDs:   variableIDs,
+		Since:         time.Now().UTC().AddDate(0, 0, -days).Truncate(time.Second),
+		Changes:       []*VariableHistoryEvent{},
+	}
+
+	options := &tfe.AuditTrailListOptions{
+		Since:       history.Since,
+		ListOptions: &tfe.ListOptions{PageNumber: 1, PageSize: auditTrailPageSize},
+	}
+	for {
+		events, err := tfeClient.AuditTrails.List(ctx, options)
+		if err != nil {
+			return ToolErrorf(logger, "failed to read the audit trail, it requires an organization token and a plan that includes audit logging: %v", err)
+		}
+		history.Changes = append(history.Changes, variableHistoryEvents(events.Items, workspace.ID, key, variableIDs)...)
+
+		if events.AuditTrailPagination == nil || events.NextPage == 0 {
+			break
+		}
+		sendProgress(ctx, request, events.CurrentPage, events.TotalPages, fmt.Sprintf("Searched %d of %d audit trail pages", events.CurrentPage, events.TotalPages), logger)
+		if options.PageNumber >= maxAuditTrailPages {
+			history.Truncated = true
+			break
+		}
+		options.PageNumber = events.NextPage
+	}
+
+	sort.Slice(history.Changes, func(i, j int) bool {
+		return history.Changes[i].Timestamp.Before(history.Changes[j].Timestamp)
+	})
+
+	buf, err := json.Marshal(history)
+	if err != nil {
+		return ToolError(logger, "failed to marshal variable history", err)
+	}
+	return mcp.NewToolResultText(string(buf)), nil
+}
+
+// workspaceVariableIDs returns the IDs of the workspace variables with the given key
+func workspaceVariableIDs(ctx context.Context, tfeClient *tfe.Client, workspaceID string, key string) ([]string, error) {
+	ids := make([]string, 0)
+	options := &tfe.VariableListOptions{ListOptions: tfe.ListOptions{PageNumber: 1, PageSize: 100}}
+	for {
+		variables, err := tfeClient.Variables.List(ctx, workspaceID, options)
+		if err != nil {
+			return nil, err
+		}
+		for _, variable := range variables.Items {
+			if variable.Key == key {
+				ids = append(ids, variable.ID)
+			}
+		}
+		if variables.Pagination == nil || variables.NextPage == 0 {
+			return ids, nil
+		}
+		options.PageNumber = variables.NextPage
+	}
+}
+
+// variableHistoryEvents selects the audit trail events of a variable. Events match by variable ID,
+// or by key and workspace for variables that were deleted or recreated under a new ID.
+func variableHistoryEvents(events []*tfe.AuditTrail, workspaceID string, key string, variableIDs []string) []*VariableHistoryEvent {
+	ids := make(map[string]bool, len(variableIDs))
+	for _, id := range variableIDs {
+		ids[id] = true
+	}
+
+	matches := make([]*VariableHistoryEvent, 0)
+	for _, event := range events {
+		if event.Resource.Type != "var" && event.Resource.Type != "variable" {
+			continue
+		}
+		if !ids[event.Resource.ID] && !(auditMeta(event, "key") == key && auditMeta(event, "workspace_id") == workspaceID) {
+			continue
+		}
+
+		match := &VariableHistoryEvent{
+			Timestamp:  event.Timestamp,
+			Action:     event.Resource.Action,
+			VariableID: event.Resource.ID,
+			Actor:      event.Auth.Description,
+			ActorType:  event.Auth.Type,
+			AccessorID: event.Auth.AccessorID,
+			RequestID:  event.Request.ID,
+		}
+		if event.Auth.ImpersonatorID != nil {
+			match.ImpersonatorID = *event.Auth.ImpersonatorID
+		}
+		matches = append(matches, match)
+	}
+	return matches
+}
+
+// auditMeta returns a string value of the resource metadata of an audit trail event
+func auditMeta(event *tfe.AuditTrail, name string) string {
+	value, _ := event.Resource.Meta[name].(string)
+	return value
+}
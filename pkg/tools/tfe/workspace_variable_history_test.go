@@ -0,0 +1,71 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-tfe"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetWorkspaceVariableHistory(t *testing.T) {
+	logger := log.New()
+	logger.SetLevel(log.ErrorLevel)
+
+	t.Run("tool creation", func(t *testing.T) {
+		tool := GetWorkspaceVariableHistory(logger)
+
+		assert.Equal(t, "get_workspace_variable_history", tool.Tool.Name)
+		assert.Contains(t, tool.Tool.Description, "audit trail")
+		assert.True(t, *tool.Tool.Annotations.ReadOnlyHint)
+
+		assert.Contains(t, tool.Tool.InputSchema.Required, "terraform_org_name")
+		assert.Contains(t, tool.Tool.InputSchema.Required, "workspace_name")
+		assert.Contains(t, tool.Tool.InputSchema.Required, "key")
+	})
+
+	t.Run("event correlation", func(t *testing.T) {
+		timestamp := time.Date(2025, 3, 1, 9, 30, 0, 0, time.UTC)
+		impersonator := "user-admin"
+		events := []*tfe.AuditTrail{
+			{
+				Timestamp: timestamp,
+				Auth:      tfe.AuditTrailAuth{Description: "jane", Type: "Client", AccessorID: "user-jane", ImpersonatorID: &impersonator},
+				Request:   tfe.AuditTrailRequest{ID: "req-1"},
+				Resource:  tfe.AuditTrailResource{ID: "var-current", Type: "var", Action: "update"},
+			},
+			{
+				// A deleted variable with the same key in the same workspace
+				Resource: tfe.AuditTrailResource{ID: "var-old", Type: "var", Action: "destroy", Meta: map[string]any{"key": "instance_type", "workspace_id": "ws-1"}},
+			},
+			{
+				// Same key in another workspace
+				Resource: tfe.AuditTrailResource{ID: "var-other", Type: "var", Action: "update", Meta: map[string]any{"key": "instance_type", "workspace_id": "ws-2"}},
+			},
+			{
+				Resource: tfe.AuditTrailResource{ID: "var-current", Type: "workspace", Action: "update"},
+			},
+		}
+
+		matches := variableHistoryEvents(events, "ws-1", "instance_type", []string{"var-current"})
+		require.Len(t, matches, 2)
+
+		assert.Equal(t, &VariableHistoryEvent{
+			Timestamp:      timestamp,
+			Action:         "update",
+			VariableID:     "var-current",
+			Actor:          "jane",
+			ActorType:      "Client",
+			AccessorID:     "user-jane",
+			ImpersonatorID: "user-admin",
+			RequestID:      "req-1",
+		}, matches[0])
+		assert.Equal(t, "var-old", matches[1].VariableID)
+		assert.Equal(t, "destroy", matches[1].Action)
+	})
+}
@@ -22,10 +22,13 @@ import (
 func ListWorkspaceVariables(logger *log.Logger) server.ServerTool {
 	return server.ServerTool{
 		Tool: mcp.NewTool("list_workspace_variables",
-			mcp.WithDescription("List all variables in a Terraform workspace. Returns all variables if query is empty."),
+			mcp.WithDescription("List all variables in a Terraform workspace. Returns all variables if query is empty. Use fetch_all to return the variables of several pages at once."),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
 			mcp.WithString("terraform_org_name", mcp.Required(), mcp.Description("Organization name")),
 			mcp.WithString("workspace_name", mcp.Required(), mcp.Description("Workspace name")),
 			utils.WithPagination(),
+			utils.WithFetchAll(),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			orgName, err := request.RequireString("terraform_org_name")
@@ -52,18 +55,24 @@ func ListWorkspaceVariables(logger *log.Logger) server.ServerTool {
 				return ToolErrorf(logger, "workspace '%s' not found in org '%s'", workspaceName, orgName)
 			}
 
-			vars, err := tfeClient.Variables.List(ctx, workspace.ID, &tfe.VariableListOptions{
-				ListOptions: tfe.ListOptions{
-					PageNumber: pagination.Page,
-					PageSize:   pagination.PageSize,
-				},
+			vars, err := utils.FetchPages(pagination, func(pageNumber int) ([]*tfe.Variable, int, error) {
+				page, err := tfeClient.Variables.List(ctx, workspace.ID, &tfe.VariableListOptions{
+					ListOptions: tfe.ListOptions{
+						PageNumber: pageNumber,
+						PageSize:   pagination.PageSize,
+					},
+				})
+				if err != nil {
+					return nil, 0, err
+				}
+				return page.Items, nextPage(page.Pagination), nil
 			})
 			if err != nil {
 				return ToolError(logger, "failed to list variables", err)
 			}
 
 			buf := bytes.NewBuffer(nil)
-			err = jsonapi.MarshalPayload(buf, vars.Items)
+			err = jsonapi.MarshalPayload(buf, vars)
 			if err != nil {
 				return ToolError(logger, "failed to marshal variables", err)
 			}
@@ -85,7 +94,8 @@ func CreateWorkspaceVariable(logger *log.Logger) server.ServerTool {
 			mcp.WithString("terraform_org_name", mcp.Required(), mcp.Description("Organization name")),
 			mcp.WithString("workspace_name", mcp.Required(), mcp.Description("Workspace name")),
 			mcp.WithString("key", mcp.Required(), mcp.Description("Variable key/name")),
-			mcp.WithString("value", mcp.Required(), mcp.Description("Variable value")),
+			mcp.WithString("value", mcp.Description("Variable value as a string. Required unless typed_value is set")),
+			withTypedValue(),
 			mcp.WithString("description", mcp.Description("Variable description"), mcp.DefaultString("")),
 			mcp.WithString("category",
 				mcp.Description("Variable category: terraform or env"),
@@ -108,18 +118,17 @@ func CreateWorkspaceVariable(logger *log.Logger) server.ServerTool {
 			if err != nil {
 				return ToolError(logger, "missing required input: key", err)
 			}
-			value, err := request.RequireString("value")
-			if err != nil {
-				return ToolError(logger, "missing required input: value", err)
-			}
-
 			category := tfe.CategoryEnv
 			if request.GetString("category", "") == "terraform" {
 				category = tfe.CategoryTerraform
 			}
 
+			value, hcl, err := variableValue(request, key, category, request.GetBool("hcl", false))
+			if err != nil {
+				return ToolError(logger, err.Error(), nil)
+			}
+
 			sensitive := request.GetBool("sensitive", false)
-			hcl := request.GetBool("hcl", false)
 			description := request.GetString("description", "")
 
 			tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
@@ -162,7 +171,8 @@ func UpdateWorkspaceVariable(logger *log.Logger) server.ServerTool {
 			mcp.WithString("workspace_name", mcp.Required(), mcp.Description("Workspace name")),
 			mcp.WithString("variable_id", mcp.Required(), mcp.Description("Variable ID to update")),
 			mcp.WithString("key", mcp.Required(), mcp.Description("Variable key/name")),
-			mcp.WithString("value", mcp.Required(), mcp.Description("Variable value")),
+			mcp.WithString("value", mcp.Description("Variable value as a string. Required unless typed_value is set")),
+			withTypedValue(),
 			mcp.WithBoolean("sensitive", mcp.Description("Whether variable is sensitive: true or false"), mcp.DefaultBool(false)),
 			mcp.WithBoolean("hcl", mcp.Description("Whether variable is HCL: true or false"), mcp.DefaultBool(false)),
 			mcp.WithString("description", mcp.Description("Variable description")),
@@ -184,14 +194,9 @@ func UpdateWorkspaceVariable(logger *log.Logger) server.ServerTool {
 			if err != nil {
 				return ToolError(logger, "missing required input: key", err)
 			}
-			value, err := request.RequireString("value")
-			if err != nil {
-				return ToolError(logger, "missing required input: value", err)
-			}
 
 			options := tfe.VariableUpdateOptions{
-				Key:   &key,
-				Value: &value,
+				Key: &key,
 			}
 			if sensitiveStr := request.GetString("sensitive", ""); sensitiveStr != "" {
 				sensitive := sensitiveStr == "true"
@@ -215,6 +220,24 @@ func UpdateWorkspaceVariable(logger *log.Logger) server.ServerTool {
 				return ToolErrorf(logger, "workspace '%s' not found in org '%s'", workspaceName, orgName)
 			}
 
+			// A typed value is encoded according to the category of the variable
+			category := tfe.CategoryTerraform
+			if _, typed := request.GetArguments()["typed_value"]; typed {
+				current, err := tfeClient.Variables.Read(ctx, workspace.ID, variableID)
+				if err != nil {
+					return ToolErrorf(logger, "variable '%s' not found in workspace '%s'", variableID, workspaceName)
+				}
+				category = current.Category
+			}
+			value, hcl, err := variableValue(request, key, category, false)
+			if err != nil {
+				return ToolError(logger, err.Error(), nil)
+			}
+			options.Value = &value
+			if hcl {
+				options.HCL = &hcl
+			}
+
 			variable, err := tfeClient.Variables.Update(ctx, workspace.ID, variableID, options)
 			if err != nil {
 				return ToolErrorf(logger, "failed to update variable '%s': %v", variableID, err)
@@ -40,7 +40,8 @@ func TestCreateWorkspaceVariable(t *testing.T) {
 		assert.Contains(t, tool.Tool.InputSchema.Required, "terraform_org_name")
 		assert.Contains(t, tool.Tool.InputSchema.Required, "workspace_name")
 		assert.Contains(t, tool.Tool.InputSchema.Required, "key")
-		assert.Contains(t, tool.Tool.InputSchema.Required, "value")
+		assert.NotContains(t, tool.Tool.InputSchema.Required, "value")
+		assert.Contains(t, tool.Tool.InputSchema.Properties, "typed_value")
 	})
 }
 
@@ -0,0 +1,57 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"path"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// ToolsAllowlistEnv is a comma-separated list of tool names or glob patterns, e.g.
+	// "list_*,get_workspace_details". When set, only the matching tools are registered.
+	ToolsAllowlistEnv = "TOOLS_ALLOWLIST"
+	// ToolsDenylistEnv is a comma-separated list of tool names or glob patterns of tools that are
+	// never registered, even when they match the allowlist
+	ToolsDenylistEnv = "TOOLS_DENYLIST"
+)
+
+// invalidToolPatterns are the invalid patterns that were logged, so each is logged once
+var invalidToolPatterns sync.Map
+
+// isToolAllowed reports whether the allowlist and denylist let a tool be registered
+func isToolAllowed(name string, logger *log.Logger) bool {
+	if matchesToolPatterns(name, ToolsDenylistEnv, logger) {
+		return false
+	}
+	if strings.TrimSpace(utils.GetEnv(ToolsAllowlistEnv, "")) == "" {
+		return true
+	}
+	return matchesToolPatterns(name, ToolsAllowlistEnv, logger)
+}
+
+// matchesToolPatterns reports whether a tool name matches one of the patterns of an environment variable
+func matchesToolPatterns(name string, env string, logger *log.Logger) bool {
+	for _, pattern := range strings.Split(utils.GetEnv(env, ""), ",") {
+		pattern = strings.TrimSpace(pattern)
+		if pattern == "" {
+			continue
+		}
+		matched, err := path.Match(pattern, name)
+		if err != nil {
+			if _, logged := invalidToolPatterns.LoadOrStore(env+"="+pattern, true); !logged {
+				logger.Warnf("Invalid pattern %q in %s ignored: %v", pattern, env, err)
+			}
+			continue
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
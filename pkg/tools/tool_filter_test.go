@@ -0,0 +1,41 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsToolAllowed(t *testing.T) {
+	logger := log.New()
+
+	tests := []struct {
+		name      string
+		allowlist string
+		denylist  string
+		tool      string
+		expected  bool
+	}{
+		{"no lists", "", "", "create_run", true},
+		{"allowed by name", "create_run, list_runs", "", "list_runs", true},
+		{"not in allowlist", "list_runs", "", "create_run", false},
+		{"allowed by pattern", "list_*,get_*", "", "list_workspaces", true},
+		{"denied by name", "", "delete_workspace_safely", "delete_workspace_safely", false},
+		{"denied by pattern", "", "delete_*", "delete_hcp_terraform_workspace", false},
+		{"denylist wins over allowlist", "*", "*_variable_set", "delete_variable_set", false},
+		{"not denied", "", "delete_*", "list_runs", true},
+		{"invalid pattern ignored", "[list_runs,list_runs", "", "list_runs", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(ToolsAllowlistEnv, tt.allowlist)
+			t.Setenv(ToolsDenylistEnv, tt.denylist)
+			assert.Equal(t, tt.expected, isToolAllowed(tt.tool, logger))
+		})
+	}
+}
@@ -6,59 +6,173 @@ package tools
 import (
 	registryTools "github.com/hashicorp/terraform-mcp-server/pkg/tools/registry"
 	"github.com/hashicorp/terraform-mcp-server/pkg/toolsets"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
 	"github.com/mark3labs/mcp-go/server"
 	log "github.com/sirupsen/logrus"
 )
 
-func RegisterTools(hcServer *server.MCPServer, logger *log.Logger, enabledToolsets []string) {
+// Transport is the MCP transport the tools of a server are served over
+type Transport string
+
+const (
+	TransportStdio          Transport = "stdio"
+	TransportStreamableHTTP Transport = "streamable-http"
+	TransportSSE            Transport = "sse"
+)
+
+func RegisterTools(hcServer *server.MCPServer, logger *log.Logger, enabledToolsets []string, transport Transport) {
+	if toolsMode(logger) == ToolsModeReadOnly {
+		logger.Infof("%s is read-only, tools that modify workspaces, runs or variables are not registered", ToolsModeEnv)
+	}
+	if allowlist := utils.GetEnv(ToolsAllowlistEnv, ""); allowlist != "" {
+		logger.Infof("Only tools matching %s=%s are registered", ToolsAllowlistEnv, allowlist)
+	}
+	if denylist := utils.GetEnv(ToolsDenylistEnv, ""); denylist != "" {
+		logger.Infof("Tools matching %s=%s are not registered", ToolsDenylistEnv, denylist)
+	}
+	configureAuditLog(transport, logger)
+	// The roots of HTTP clients are directories of their own machine, not of the server's
+	utils.SetLocalRootsEnabled(transport == TransportStdio)
+	if transport != TransportStdio && utils.GetEnv(utils.LocalRootsEnv, "") != "" {
+		logger.Warnf("%s is only used with the stdio transport, configuration is not read from local directories with %s", utils.LocalRootsEnv, transport)
+	}
+
 	// Register the dynamic tools (TFE tools that require authentication)
 	registerDynamicTools(hcServer, logger, enabledToolsets)
 
+	// Terraform toolset - Session credentials, available before any TFE client exists. Only for the
+	// local client of the stdio transport: the HTTP transports take the address of the instance from
+	// TFE_ADDRESS, never from the client
+	if transport == TransportStdio {
+		if toolsets.IsToolEnabled("set_hcp_terraform_credentials", enabledToolsets) {
+			addCredentialRedactionHook(logger)
+			addTool(hcServer, SetCredentials(logger), logger)
+		}
+		if toolsets.IsToolEnabled("clear_credentials", enabledToolsets) {
+			addTool(hcServer, ClearCredentials(logger), logger)
+		}
+	}
+
 	// Registry toolset - Provider tools
 	if toolsets.IsToolEnabled("search_providers", enabledToolsets) {
 		tool := registryTools.ResolveProviderDocID(logger)
-		hcServer.AddTool(tool.Tool, tool.Handler)
+		addTool(hcServer, tool, logger)
 	}
 
 	if toolsets.IsToolEnabled("get_provider_details", enabledToolsets) {
 		tool := registryTools.GetProviderDocs(logger)
-		hcServer.AddTool(tool.Tool, tool.Handler)
+		addTool(hcServer, tool, logger)
 	}
 
 	if toolsets.IsToolEnabled("get_latest_provider_version", enabledToolsets) {
 		tool := registryTools.GetLatestProviderVersion(logger)
-		hcServer.AddTool(tool.Tool, tool.Handler)
+		addTool(hcServer, tool, logger)
+	}
+
+	if toolsets.IsToolEnabled("list_provider_versions", enabledToolsets) {
+		tool := registryTools.ListProviderVersions(logger)
+		addTool(hcServer, tool, logger)
+	}
+
+	if toolsets.IsToolEnabled("get_provider_stats", enabledToolsets) {
+		tool := registryTools.GetProviderStats(logger)
+		addTool(hcServer, tool, logger)
+	}
+
+	if toolsets.IsToolEnabled("resolve_provider_version", enabledToolsets) {
+		tool := registryTools.ResolveProviderVersion(logger)
+		addTool(hcServer, tool, logger)
 	}
 
 	if toolsets.IsToolEnabled("get_provider_capabilities", enabledToolsets) {
 		tool := registryTools.GetProviderCapabilities(logger)
-		hcServer.AddTool(tool.Tool, tool.Handler)
+		addTool(hcServer, tool, logger)
+	}
+
+	if toolsets.IsToolEnabled("compare_provider_versions", enabledToolsets) {
+		tool := registryTools.CompareProviderVersions(logger)
+		addTool(hcServer, tool, logger)
+	}
+
+	if toolsets.IsToolEnabled("get_provider_schema", enabledToolsets) {
+		tool := registryTools.GetProviderSchema(logger)
+		addTool(hcServer, tool, logger)
+	}
+
+	if toolsets.IsToolEnabled("generate_terraform_scaffold", enabledToolsets) {
+		tool := registryTools.GenerateTerraformScaffold(logger)
+		addTool(hcServer, tool, logger)
+	}
+
+	// Runs the Terraform CLI on configuration passed in by the client, so it is opt-in and only served
+	// to the local client of the stdio transport
+	if toolsets.IsToolEnabled("validate_terraform_configuration", enabledToolsets) && registryTools.IsLocalExecutionEnabled() {
+		if transport != TransportStdio {
+			logger.Warnf("validate_terraform_configuration is only registered with the stdio transport, local execution is ignored with %s", transport)
+		} else if err := registryTools.CheckLocalExecutionConfig(); err != nil {
+			logger.Warnf("validate_terraform_configuration is not registered: %v", err)
+		} else {
+			tool := registryTools.ValidateTerraformConfiguration(logger)
+			addTool(hcServer, tool, logger)
+		}
+	}
+
+	if toolsets.IsToolEnabled("autocomplete_service_slug", enabledToolsets) {
+		tool := registryTools.AutocompleteServiceSlug(logger)
+		addTool(hcServer, tool, logger)
 	}
 
 	// Registry toolset - Module tools
 	if toolsets.IsToolEnabled("search_modules", enabledToolsets) {
 		tool := registryTools.SearchModules(logger)
-		hcServer.AddTool(tool.Tool, tool.Handler)
+		addTool(hcServer, tool, logger)
 	}
 
 	if toolsets.IsToolEnabled("get_module_details", enabledToolsets) {
 		tool := registryTools.ModuleDetails(logger)
-		hcServer.AddTool(tool.Tool, tool.Handler)
+		addTool(hcServer, tool, logger)
 	}
 
 	if toolsets.IsToolEnabled("get_latest_module_version", enabledToolsets) {
 		tool := registryTools.GetLatestModuleVersion(logger)
-		hcServer.AddTool(tool.Tool, tool.Handler)
+		addTool(hcServer, tool, logger)
+	}
+
+	if toolsets.IsToolEnabled("list_module_versions", enabledToolsets) {
+		tool := registryTools.ListModuleVersions(logger)
+		addTool(hcServer, tool, logger)
+	}
+
+	if toolsets.IsToolEnabled("get_module_version_diff", enabledToolsets) {
+		tool := registryTools.GetModuleVersionDiff(logger)
+		addTool(hcServer, tool, logger)
+	}
+
+	if toolsets.IsToolEnabled("check_module_terraform_compatibility", enabledToolsets) {
+		tool := registryTools.CheckModuleCompatibility(logger)
+		addTool(hcServer, tool, logger)
+	}
+
+	if toolsets.IsToolEnabled("diagnose_connectivity", enabledToolsets) {
+		tool := registryTools.DiagnoseConnectivity(logger)
+		addTool(hcServer, tool, logger)
+	}
+
+	if toolsets.IsToolEnabled("batch_call", enabledToolsets) {
+		addTool(hcServer, BatchCall(hcServer, logger), logger)
 	}
 
 	// Registry toolset - Policy tools
 	if toolsets.IsToolEnabled("search_policies", enabledToolsets) {
 		tool := registryTools.SearchPolicies(logger)
-		hcServer.AddTool(tool.Tool, tool.Handler)
+		addTool(hcServer, tool, logger)
 	}
 
 	if toolsets.IsToolEnabled("get_policy_details", enabledToolsets) {
 		tool := registryTools.PolicyDetails(logger)
-		hcServer.AddTool(tool.Tool, tool.Handler)
+		addTool(hcServer, tool, logger)
 	}
+
+	// Custom REST tools declared by the operator
+	registerCustomTools(hcServer, logger)
 }
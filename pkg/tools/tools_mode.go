@@ -0,0 +1,68 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// ToolsModeEnv selects which tools are registered
+const ToolsModeEnv = "MCP_TOOLS_MODE"
+
+// Supported tools modes
+const (
+	ToolsModeAll      = "all"
+	ToolsModeReadOnly = "read-only"
+)
+
+// toolsMode returns the configured tools mode. Unknown values fall back to read-only so a
+// typo never grants write access.
+func toolsMode(logger *log.Logger) string {
+	mode := strings.ToLower(strings.TrimSpace(utils.GetEnv(ToolsModeEnv, ToolsModeAll)))
+	switch mode {
+	case "", ToolsModeAll:
+		return ToolsModeAll
+	case ToolsModeReadOnly:
+		return ToolsModeReadOnly
+	default:
+		logger.Warnf("Unknown %s value %q, only read-only tools are registered", ToolsModeEnv, mode)
+		return ToolsModeReadOnly
+	}
+}
+
+// isReadOnlyTool reports whether a tool is annotated as not modifying its environment
+func isReadOnlyTool(tool mcp.Tool) bool {
+	return tool.Annotations.ReadOnlyHint != nil && *tool.Annotations.ReadOnlyHint
+}
+
+// addTool registers a tool with the server, skipping tools that modify their environment
+// when the server runs in read-only mode and tools excluded by TOOLS_ALLOWLIST or
+// TOOLS_DENYLIST. Registered tools accept a result_filter, and
+// heavyweight tools a dry_fetch to estimate the response size first. Secrets are redacted
+// from results, results larger than the response budget are truncated, and calls are audited
+// when audit logging is enabled.
+func addTool(hcServer *server.MCPServer, tool server.ServerTool, logger *log.Logger) {
+	if !isReadOnlyTool(tool.Tool) && toolsMode(logger) == ToolsModeReadOnly {
+		logger.WithField("tool", tool.Tool.Name).Debug("Skipping tool that is not read-only")
+		return
+	}
+	if !isToolAllowed(tool.Tool.Name, logger) {
+		logger.WithField("tool", tool.Tool.Name).Debug("Skipping tool excluded by the tools allowlist or denylist")
+		return
+	}
+	tool = withOutputRedaction(tool, logger)
+	tool = withStructuredContent(tool)
+	tool = withResultFilter(tool)
+	tool = withDryFetch(tool)
+	tool = withResponseBudget(tool, logger)
+	tool = withRequestTimeout(tool, logger)
+	tool = withAuditLog(tool, logger)
+	tool = withRequestLog(tool, logger)
+	hcServer.AddTool(tool.Tool, tool.Handler)
+}
@@ -0,0 +1,65 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToolsMode(t *testing.T) {
+	logger := log.New()
+
+	tests := []struct {
+		name     string
+		envValue string
+		expected string
+	}{
+		{"unset", "", ToolsModeAll},
+		{"all", "all", ToolsModeAll},
+		{"read-only", "read-only", ToolsModeReadOnly},
+		{"READ-ONLY", "READ-ONLY", ToolsModeReadOnly},
+		{"unknown falls back to read-only", "readonly", ToolsModeReadOnly},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(ToolsModeEnv, tt.envValue)
+			assert.Equal(t, tt.expected, toolsMode(logger))
+		})
+	}
+}
+
+func TestAddTool(t *testing.T) {
+	logger := log.New()
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return mcp.NewToolResultText("ok"), nil
+	}
+	readTool := server.ServerTool{Tool: mcp.NewTool("read_tool", mcp.WithReadOnlyHintAnnotation(true)), Handler: handler}
+	writeTool := server.ServerTool{Tool: mcp.NewTool("write_tool"), Handler: handler}
+
+	registered := func(mode string) []string {
+		t.Setenv(ToolsModeEnv, mode)
+		hcServer := server.NewMCPServer("test", "1.0.0", server.WithToolCapabilities(true))
+		addTool(hcServer, readTool, logger)
+		addTool(hcServer, writeTool, logger)
+
+		names := make([]string, 0)
+		for name := range hcServer.ListTools() {
+			names = append(names, name)
+		}
+		return names
+	}
+
+	assert.ElementsMatch(t, []string{"read_tool", "write_tool"}, registered(ToolsModeAll))
+	assert.ElementsMatch(t, []string{"read_tool"}, registered(ToolsModeReadOnly))
+
+	t.Setenv(ToolsDenylistEnv, "write_*")
+	assert.ElementsMatch(t, []string{"read_tool"}, registered(ToolsModeAll))
+}
@@ -0,0 +1,58 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"testing"
+
+	registryTools "github.com/hashicorp/terraform-mcp-server/pkg/tools/registry"
+	"github.com/hashicorp/terraform-mcp-server/pkg/toolsets"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegisterToolsSessionCredentials(t *testing.T) {
+	logger := log.New()
+	logger.SetLevel(log.ErrorLevel)
+
+	registered := func(transport Transport) []string {
+		hcServer := server.NewMCPServer("test", "1.0.0", server.WithToolCapabilities(true))
+		RegisterTools(hcServer, logger, []string{toolsets.Terraform}, transport)
+		names := make([]string, 0)
+		for _, name := range []string{"set_hcp_terraform_credentials", "clear_credentials"} {
+			if hcServer.GetTool(name) != nil {
+				names = append(names, name)
+			}
+		}
+		return names
+	}
+
+	assert.Equal(t, []string{"set_hcp_terraform_credentials", "clear_credentials"}, registered(TransportStdio))
+	assert.Empty(t, registered(TransportStreamableHTTP))
+	assert.Empty(t, registered(TransportSSE))
+
+	t.Setenv(ToolsModeEnv, ToolsModeReadOnly)
+	assert.Empty(t, registered(TransportStdio), "the tools change the state of the session")
+}
+
+func TestRegisterToolsLocalExecution(t *testing.T) {
+	logger := log.New()
+	logger.SetLevel(log.ErrorLevel)
+	t.Setenv(registryTools.LocalExecutionEnv, "true")
+
+	registered := func(transport Transport) bool {
+		hcServer := server.NewMCPServer("test", "1.0.0", server.WithToolCapabilities(true))
+		RegisterTools(hcServer, logger, []string{toolsets.Registry}, transport)
+		return hcServer.GetTool("validate_terraform_configuration") != nil
+	}
+
+	t.Setenv(registryTools.ProviderMirrorEnv, "")
+	assert.False(t, registered(TransportStdio), "a provider mirror is required")
+
+	t.Setenv(registryTools.ProviderMirrorEnv, t.TempDir())
+	assert.True(t, registered(TransportStdio))
+	assert.False(t, registered(TransportStreamableHTTP))
+	assert.False(t, registered(TransportSSE))
+}
@@ -7,60 +7,157 @@ import "strings"
 
 var ToolToToolset = map[string]string{
 	// Public Registry tools (providers, modules, policies)
-	"search_providers":            Registry,
-	"get_provider_details":        Registry,
-	"get_latest_provider_version": Registry,
-	"get_provider_capabilities":   Registry,
-	"search_modules":              Registry,
-	"get_module_details":          Registry,
-	"get_latest_module_version":   Registry,
-	"search_policies":             Registry,
-	"get_policy_details":          Registry,
+	"search_providers":                     Registry,
+	"get_provider_details":                 Registry,
+	"get_latest_provider_version":          Registry,
+	"list_provider_versions":               Registry,
+	"get_provider_stats":                   Registry,
+	"resolve_provider_version":             Registry,
+	"get_provider_capabilities":            Registry,
+	"compare_provider_versions":            Registry,
+	"get_provider_schema":                  Registry,
+	"generate_terraform_scaffold":          Registry,
+	"validate_terraform_configuration":     Registry,
+	"autocomplete_service_slug":            Registry,
+	"search_modules":                       Registry,
+	"get_module_details":                   Registry,
+	"get_latest_module_version":            Registry,
+	"check_module_terraform_compatibility": Registry,
+	"list_module_versions":                 Registry,
+	"get_module_version_diff":              Registry,
+	"batch_call":                           Registry,
+	"diagnose_connectivity":                Registry,
+	"search_policies":                      Registry,
+	"get_policy_details":                   Registry,
 
 	// Private Registry tools (TFE/TFC private registry)
-	"search_private_modules":       RegistryPrivate,
-	"get_private_module_details":   RegistryPrivate,
-	"search_private_providers":     RegistryPrivate,
-	"get_private_provider_details": RegistryPrivate,
+	"search_private_modules":           RegistryPrivate,
+	"get_private_module_details":       RegistryPrivate,
+	"search_private_providers":         RegistryPrivate,
+	"get_private_provider_details":     RegistryPrivate,
+	"list_registry_gpg_keys":           RegistryPrivate,
+	"create_registry_gpg_key":          RegistryPrivate,
+	"delete_registry_gpg_key":          RegistryPrivate,
+	"create_private_provider_version":  RegistryPrivate,
+	"create_private_provider_platform": RegistryPrivate,
+	"get_private_provider_upload_urls": RegistryPrivate,
+	"list_private_module_versions":     RegistryPrivate,
+	"create_private_module":            RegistryPrivate,
+	"create_private_module_version":    RegistryPrivate,
+	"delete_private_module_version":    RegistryPrivate,
+	"list_no_code_modules":             RegistryPrivate,
+	"get_no_code_module":               RegistryPrivate,
 
 	// Terraform tools (TFE/TFC workspaces, runs, variables, etc.)
-	"list_terraform_orgs":                 Terraform,
-	"list_terraform_projects":             Terraform,
-	"list_workspaces":                     Terraform,
-	"get_workspace_details":               Terraform,
-	"create_workspace":                    Terraform,
-	"create_no_code_workspace":            Terraform,
-	"update_workspace":                    Terraform,
-	"delete_workspace_safely":             Terraform,
-	"list_runs":                           Terraform,
-	"get_run_details":                     Terraform,
-	"get_plan_details":                    Terraform,
-	"get_plan_logs":                       Terraform,
-	"get_plan_json_output":                Terraform,
-	"get_apply_details":                   Terraform,
-	"get_apply_logs":                      Terraform,
-	"get_sentinel_mock":                   Terraform,
-	"create_run":                          Terraform,
-	"action_run":                          Terraform,
-	"list_workspace_variables":            Terraform,
-	"create_workspace_variable":           Terraform,
-	"update_workspace_variable":           Terraform,
-	"list_variable_sets":                  Terraform,
-	"create_variable_set":                 Terraform,
-	"create_variable_in_variable_set":     Terraform,
-	"delete_variable_in_variable_set":     Terraform,
-	"attach_variable_set_to_workspaces":   Terraform,
-	"detach_variable_set_from_workspaces": Terraform,
-	"create_workspace_tags":               Terraform,
-	"read_workspace_tags":                 Terraform,
-	"attach_policy_set_to_workspaces":     Terraform,
-	"get_token_permissions":               Terraform,
-	"list_stacks":                         Terraform,
-	"get_stack_details":                   Terraform,
-	"list_workspace_policy_sets":          Terraform,
-	"force_unlock_workspace":              Terraform,
-	"list_state_versions":                 Terraform,
-	"get_state_version":                   Terraform,
+	"set_hcp_terraform_credentials":               Terraform,
+	"clear_credentials":                           Terraform,
+	"list_terraform_orgs":                         Terraform,
+	"list_terraform_projects":                     Terraform,
+	"list_workspaces":                             Terraform,
+	"get_workspace_details":                       Terraform,
+	"get_workspace_outputs":                       Terraform,
+	"get_workspace_health_assessment":             Terraform,
+	"list_workspace_drifted_resources":            Terraform,
+	"start_workspace_drift_detection":             Terraform,
+	"list_hcp_terraform_workspace_resources":      Terraform,
+	"get_workspace_resource_ownership":            Terraform,
+	"get_workspace_inventory":                     Terraform,
+	"query_hcp_terraform_explorer":                Terraform,
+	"bulk_update_workspaces":                      Terraform,
+	"enforce_terraform_version_policy":            Terraform,
+	"list_terraform_versions":                     Terraform,
+	"check_workspace_terraform_versions":          Terraform,
+	"create_workspace":                            Terraform,
+	"create_no_code_workspace":                    Terraform,
+	"create_workspace_from_blueprint":             Terraform,
+	"update_workspace":                            Terraform,
+	"delete_workspace_safely":                     Terraform,
+	"delete_hcp_terraform_workspace":              Terraform,
+	"list_runs":                                   Terraform,
+	"get_hcp_terraform_org_run_queue":             Terraform,
+	"get_run_details":                             Terraform,
+	"get_hcp_terraform_cost_estimate":             Terraform,
+	"list_run_comments":                           Terraform,
+	"create_run_comment":                          Terraform,
+	"wait_for_run":                                Terraform,
+	"wait_for_configuration_version":              Terraform,
+	"get_plan_details":                            Terraform,
+	"get_plan_logs":                               Terraform,
+	"get_plan_json_output":                        Terraform,
+	"get_apply_details":                           Terraform,
+	"get_apply_logs":                              Terraform,
+	"get_sentinel_mock":                           Terraform,
+	"create_run":                                  Terraform,
+	"upload_hcp_terraform_configuration":          Terraform,
+	"retry_hcp_terraform_run":                     Terraform,
+	"diagnose_hcp_terraform_run":                  Terraform,
+	"promote_workspace_config":                    Terraform,
+	"action_run":                                  Terraform,
+	"run_guarded_deployment":                      Terraform,
+	"list_workspace_variables":                    Terraform,
+	"get_workspace_variable_history":              Terraform,
+	"explain_hcp_terraform_variable_resolution":   Terraform,
+	"create_workspace_variable":                   Terraform,
+	"update_workspace_variable":                   Terraform,
+	"list_workspace_notification_configurations":  Terraform,
+	"create_workspace_notification_configuration": Terraform,
+	"update_workspace_notification_configuration": Terraform,
+	"delete_workspace_notification_configuration": Terraform,
+	"list_variable_sets":                          Terraform,
+	"create_variable_set":                         Terraform,
+	"update_variable_set":                         Terraform,
+	"delete_variable_set":                         Terraform,
+	"create_variable_in_variable_set":             Terraform,
+	"delete_variable_in_variable_set":             Terraform,
+	"attach_variable_set_to_workspaces":           Terraform,
+	"detach_variable_set_from_workspaces":         Terraform,
+	"attach_variable_set_to_projects":             Terraform,
+	"detach_variable_set_from_projects":           Terraform,
+	"list_project_variable_sets":                  Terraform,
+	"create_workspace_tags":                       Terraform,
+	"read_workspace_tags":                         Terraform,
+	"create_project_tags":                         Terraform,
+	"read_project_tags":                           Terraform,
+	"delete_project_tags":                         Terraform,
+	"list_organization_tags":                      Terraform,
+	"rename_organization_tag":                     Terraform,
+	"merge_organization_tags":                     Terraform,
+	"list_oauth_clients":                          Terraform,
+	"list_oauth_tokens":                           Terraform,
+	"list_github_app_installations":               Terraform,
+	"list_ssh_keys":                               Terraform,
+	"create_ssh_key":                              Terraform,
+	"delete_ssh_key":                              Terraform,
+	"assign_workspace_ssh_key":                    Terraform,
+	"unassign_workspace_ssh_key":                  Terraform,
+	"list_agent_pools":                            Terraform,
+	"get_agent_pool_details":                      Terraform,
+	"list_agent_pool_agents":                      Terraform,
+	"assign_workspace_agent_pool":                 Terraform,
+	"list_organization_memberships":               Terraform,
+	"list_teams":                                  Terraform,
+	"get_team_details":                            Terraform,
+	"list_workspace_team_access":                  Terraform,
+	"attach_policy_set_to_workspaces":             Terraform,
+	"get_token_permissions":                       Terraform,
+	"list_stacks":                                 Terraform,
+	"get_stack_details":                           Terraform,
+	"list_workspace_policy_sets":                  Terraform,
+	"detach_policy_set_from_workspaces":           Terraform,
+	"list_policy_sets":                            Terraform,
+	"get_policy_set_details":                      Terraform,
+	"list_run_policy_results":                     Terraform,
+	"list_run_tasks":                              Terraform,
+	"attach_run_task_to_workspace":                Terraform,
+	"list_run_task_results":                       Terraform,
+	"list_policy_overrides":                       Terraform,
+	"get_hcp_terraform_activity_report":           Terraform,
+	"list_locked_workspaces":                      Terraform,
+	"force_unlock_workspace":                      Terraform,
+	"list_state_versions":                         Terraform,
+	"get_state_version":                           Terraform,
+	"compare_hcp_terraform_state_versions":        Terraform,
+	"get_state_resources":                         Terraform,
 }
 
 // GetToolsetForTool returns the toolset name for a given tool name
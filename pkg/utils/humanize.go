@@ -0,0 +1,98 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+// HumanizeDuration renders a duration the way a person would write it, e.g. "850ms", "42s" or "1h 3m 20s".
+func HumanizeDuration(d time.Duration) string {
+	if d < 0 {
+		return "-" + HumanizeDuration(-d)
+	}
+	if d < time.Second {
+		return fmt.Sprintf("%dms", d.Milliseconds())
+	}
+
+	d = d.Round(time.Second)
+	hours := int64(d / time.Hour)
+	minutes := int64((d % time.Hour) / time.Minute)
+	seconds := int64((d % time.Minute) / time.Second)
+
+	var parts []string
+	if hours > 0 {
+		parts = append(parts, fmt.Sprintf("%dh", hours))
+	}
+	if minutes > 0 {
+		parts = append(parts, fmt.Sprintf("%dm", minutes))
+	}
+	if seconds > 0 || len(parts) == 0 {
+		parts = append(parts, fmt.Sprintf("%ds", seconds))
+	}
+	return strings.Join(parts, " ")
+}
+
+// HumanizeMilliseconds renders a millisecond count as returned by the HCP Terraform API as a humanized duration.
+func HumanizeMilliseconds(ms int64) string {
+	return HumanizeDuration(time.Duration(ms) * time.Millisecond)
+}
+
+// HumanizeBytes renders a byte count using binary units, e.g. "512 B", "1.5 KiB" or "23.4 MiB".
+func HumanizeBytes(n int64) string {
+	const unit = 1024
+	if n < unit && n > -unit {
+		return fmt.Sprintf("%d B", n)
+	}
+
+	units := []string{"KiB", "MiB", "GiB", "TiB", "PiB", "EiB"}
+	value := float64(n)
+	exp := -1
+	for math.Abs(value) >= unit && exp < len(units)-1 {
+		value /= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %s", value, units[exp])
+}
+
+// HumanizeTimestamp renders a timestamp in UTC together with its distance from now,
+// e.g. "2025-01-01 12:00 UTC (3 hours ago)". Zero timestamps are rendered as "never".
+func HumanizeTimestamp(t time.Time, now time.Time) string {
+	if t.IsZero() {
+		return "never"
+	}
+	return fmt.Sprintf("%s (%s)", t.UTC().Format("2006-01-02 15:04 MST"), humanizeRelative(now.Sub(t)))
+}
+
+func humanizeRelative(d time.Duration) string {
+	suffix := "ago"
+	if d < 0 {
+		d = -d
+		suffix = "from now"
+	}
+
+	var value int64
+	var unit string
+	switch {
+	case d < time.Minute:
+		return "just now"
+	case d < time.Hour:
+		value, unit = int64(d/time.Minute), "minute"
+	case d < 24*time.Hour:
+		value, unit = int64(d/time.Hour), "hour"
+	case d < 30*24*time.Hour:
+		value, unit = int64(d/(24*time.Hour)), "day"
+	case d < 365*24*time.Hour:
+		value, unit = int64(d/(30*24*time.Hour)), "month"
+	default:
+		value, unit = int64(d/(365*24*time.Hour)), "year"
+	}
+	if value != 1 {
+		unit += "s"
+	}
+	return fmt.Sprintf("%d %s %s", value, unit, suffix)
+}
@@ -0,0 +1,51 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHumanizeDuration(t *testing.T) {
+	tests := []struct {
+		duration time.Duration
+		expected string
+	}{
+		{0, "0ms"},
+		{850 * time.Millisecond, "850ms"},
+		{42 * time.Second, "42s"},
+		{2 * time.Minute, "2m"},
+		{time.Hour + 3*time.Minute + 20*time.Second, "1h 3m 20s"},
+		{-90 * time.Second, "-1m 30s"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			assert.Equal(t, tt.expected, HumanizeDuration(tt.duration))
+		})
+	}
+
+	assert.Equal(t, "1m 5s", HumanizeMilliseconds(65000))
+}
+
+func TestHumanizeBytes(t *testing.T) {
+	assert.Equal(t, "0 B", HumanizeBytes(0))
+	assert.Equal(t, "512 B", HumanizeBytes(512))
+	assert.Equal(t, "1.5 KiB", HumanizeBytes(1536))
+	assert.Equal(t, "23.4 MiB", HumanizeBytes(23*1024*1024+400*1024))
+	assert.Equal(t, "2.0 GiB", HumanizeBytes(2*1024*1024*1024))
+}
+
+func TestHumanizeTimestamp(t *testing.T) {
+	now := time.Date(2025, 1, 1, 15, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, "never", HumanizeTimestamp(time.Time{}, now))
+	assert.Equal(t, "2025-01-01 15:00 UTC (just now)", HumanizeTimestamp(now, now))
+	assert.Equal(t, "2025-01-01 12:00 UTC (3 hours ago)", HumanizeTimestamp(now.Add(-3*time.Hour), now))
+	assert.Equal(t, "2024-12-31 15:00 UTC (1 day ago)", HumanizeTimestamp(now.Add(-24*time.Hour), now))
+	assert.Equal(t, "2025-01-01 15:05 UTC (5 minutes from now)", HumanizeTimestamp(now.Add(5*time.Minute), now))
+}
@@ -0,0 +1,40 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// Output formats supported by tools that offer a human readable summary
+const (
+	OutputFormatJSON     = "json"
+	OutputFormatMarkdown = "markdown"
+)
+
+// WithOutputFormat adds the "output_format" parameter to a tool.
+// JSON output keeps the raw API values, Markdown output humanizes durations, sizes and timestamps.
+func WithOutputFormat() mcp.ToolOption {
+	return mcp.WithString("output_format",
+		mcp.Description("Output format: 'json' (default) returns raw values, 'markdown' returns a readable summary with humanized durations, sizes and timestamps"),
+		mcp.Enum(OutputFormatJSON, OutputFormatMarkdown),
+		mcp.DefaultString(OutputFormatJSON),
+	)
+}
+
+// OutputFormatParam returns the validated "output_format" parameter from the request, defaulting to JSON.
+func OutputFormatParam(r mcp.CallToolRequest) (string, error) {
+	format := strings.ToLower(strings.TrimSpace(r.GetString("output_format", OutputFormatJSON)))
+	switch format {
+	case "", OutputFormatJSON:
+		return OutputFormatJSON, nil
+	case OutputFormatMarkdown:
+		return OutputFormatMarkdown, nil
+	default:
+		return "", fmt.Errorf("output_format must be '%s' or '%s', got %q", OutputFormatJSON, OutputFormatMarkdown, format)
+	}
+}
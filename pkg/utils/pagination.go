@@ -5,14 +5,30 @@ package utils
 
 import (
 	"fmt"
+	"os"
+	"strconv"
 
 	"github.com/mark3labs/mcp-go/mcp"
 )
 
+const (
+	// FetchAllMaxPagesEnv sets the most pages a fetch_all request follows
+	FetchAllMaxPagesEnv = "MCP_FETCH_ALL_MAX_PAGES"
+	// DefaultFetchAllMaxPages is the most pages a fetch_all request follows by default
+	DefaultFetchAllMaxPages = 10
+	// fetchAllPageSize is the page size of fetch_all requests when none is given
+	fetchAllPageSize = 100
+)
+
 type PaginationParams struct {
 	Page     int
 	PageSize int
 	After    string
+
+	// FetchAll follows the pages after Page, up to MaxPages pages in total.
+	// MaxPages is only set with FetchAll.
+	FetchAll bool
+	MaxPages int
 }
 
 // OptionalParam is a helper function to retrieve an optional parameter from the request.
@@ -71,13 +87,62 @@ func OptionalPaginationParams(r mcp.CallToolRequest) (PaginationParams, error) {
 	if err != nil {
 		return PaginationParams{}, err
 	}
+	fetchAll, err := OptionalParam[bool](r, "fetch_all")
+	if err != nil {
+		return PaginationParams{}, err
+	}
+	var maxPages int
+	if fetchAll {
+		if _, ok := r.GetArguments()["pageSize"]; !ok {
+			pageSize = fetchAllPageSize
+		}
+		limit := FetchAllMaxPages()
+		maxPages, err = OptionalIntParamWithDefault(r, "max_pages", limit)
+		if err != nil {
+			return PaginationParams{}, err
+		}
+		if maxPages < 1 || maxPages > limit {
+			maxPages = limit
+		}
+	}
 	return PaginationParams{
 		Page:     page,
 		PageSize: pageSize,
 		After:    after,
+		FetchAll: fetchAll,
+		MaxPages: maxPages,
 	}, nil
 }
 
+// FetchAllMaxPages returns the most pages a fetch_all request follows, from MCP_FETCH_ALL_MAX_PAGES or the default
+func FetchAllMaxPages() int {
+	if value := os.Getenv(FetchAllMaxPagesEnv); value != "" {
+		if maxPages, err := strconv.Atoi(value); err == nil && maxPages > 0 {
+			return maxPages
+		}
+	}
+	return DefaultFetchAllMaxPages
+}
+
+// FetchPages fetches the requested page and, when fetch_all is set, follows the next pages until
+// the last page or MaxPages pages. fetch returns the items of a page and the number of the next
+// page, 0 on the last page. FetchPages returns the items of all fetched pages in order.
+func FetchPages[T any](pagination PaginationParams, fetch func(pageNumber int) ([]T, int, error)) ([]T, error) {
+	var all []T
+	pageNumber := pagination.Page
+	for fetched := 0; ; fetched++ {
+		items, nextPage, err := fetch(pageNumber)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, items...)
+		if !pagination.FetchAll || nextPage == 0 || fetched+1 >= pagination.MaxPages {
+			return all, nil
+		}
+		pageNumber = nextPage
+	}
+}
+
 // WithPagination adds pagination parameters to a tool.
 // It adds "page", "pageSize", and "after" parameters with appropriate descriptions and defaults.
 func WithPagination() mcp.ToolOption {
@@ -94,3 +159,19 @@ func WithPagination() mcp.ToolOption {
 		)(tool)
 	}
 }
+
+// WithFetchAll adds the "fetch_all" and "max_pages" parameters to a tool, for list tools that
+// follow the pagination and return the aggregated results of several pages.
+func WithFetchAll() mcp.ToolOption {
+	return func(tool *mcp.Tool) {
+		mcp.WithBoolean("fetch_all",
+			mcp.Description("Follow the pagination from the requested page and return the results of all pages, up to max_pages pages. The page size defaults to 100. The pagination details are those of the last page fetched, a next page means there are more results"),
+			mcp.DefaultBool(false),
+		)(tool)
+
+		mcp.WithNumber("max_pages",
+			mcp.Description(fmt.Sprintf("Most pages to fetch with fetch_all (min 1, defaults to and is capped at the server limit, %d unless %s is set)", DefaultFetchAllMaxPages, FetchAllMaxPagesEnv)),
+			mcp.Min(1),
+		)(tool)
+	}
+}
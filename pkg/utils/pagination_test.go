@@ -521,6 +521,64 @@ func TestPaginationParams_Struct(t *testing.T) {
 	assert.Equal(t, "", zeroParams.After)
 }
 
+func TestOptionalPaginationParams_FetchAll(t *testing.T) {
+	t.Setenv(FetchAllMaxPagesEnv, "")
+	params, err := OptionalPaginationParams(mockCallToolRequest(map[string]interface{}{"fetch_all": true}))
+	require.NoError(t, err)
+	assert.Equal(t, PaginationParams{Page: 1, PageSize: 100, FetchAll: true, MaxPages: DefaultFetchAllMaxPages}, params)
+
+	params, err = OptionalPaginationParams(mockCallToolRequest(map[string]interface{}{"fetch_all": true, "pageSize": 20.0, "max_pages": 3.0}))
+	require.NoError(t, err)
+	assert.Equal(t, 20, params.PageSize)
+	assert.Equal(t, 3, params.MaxPages)
+
+	// max_pages is capped at the server limit
+	t.Setenv(FetchAllMaxPagesEnv, "5")
+	params, err = OptionalPaginationParams(mockCallToolRequest(map[string]interface{}{"fetch_all": true, "max_pages": 50.0}))
+	require.NoError(t, err)
+	assert.Equal(t, 5, params.MaxPages)
+
+	_, err = OptionalPaginationParams(mockCallToolRequest(map[string]interface{}{"fetch_all": "yes"}))
+	assert.Error(t, err)
+}
+
+func TestFetchPages(t *testing.T) {
+	// pages 1 to 4 of a list holding 2 items per page
+	fetch := func(calls *[]int) func(int) ([]int, int, error) {
+		return func(pageNumber int) ([]int, int, error) {
+			*calls = append(*calls, pageNumber)
+			nextPage := pageNumber + 1
+			if pageNumber == 4 {
+				nextPage = 0
+			}
+			return []int{pageNumber*10 + 1, pageNumber*10 + 2}, nextPage, nil
+		}
+	}
+
+	var calls []int
+	items, err := FetchPages(PaginationParams{Page: 2}, fetch(&calls))
+	require.NoError(t, err)
+	assert.Equal(t, []int{21, 22}, items)
+	assert.Equal(t, []int{2}, calls)
+
+	calls = nil
+	items, err = FetchPages(PaginationParams{Page: 1, FetchAll: true, MaxPages: 10}, fetch(&calls))
+	require.NoError(t, err)
+	assert.Equal(t, []int{11, 12, 21, 22, 31, 32, 41, 42}, items)
+	assert.Equal(t, []int{1, 2, 3, 4}, calls)
+
+	calls = nil
+	items, err = FetchPages(PaginationParams{Page: 1, FetchAll: true, MaxPages: 2}, fetch(&calls))
+	require.NoError(t, err)
+	assert.Equal(t, []int{11, 12, 21, 22}, items)
+	assert.Equal(t, []int{1, 2}, calls)
+
+	_, err = FetchPages(PaginationParams{Page: 1, FetchAll: true, MaxPages: 2}, func(int) ([]int, int, error) {
+		return nil, 0, assert.AnError
+	})
+	assert.ErrorIs(t, err, assert.AnError)
+}
+
 // Benchmark tests for performance
 func BenchmarkOptionalParam(b *testing.B) {
 	req := mockCallToolRequest(map[string]interface{}{
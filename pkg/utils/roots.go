@@ -0,0 +1,175 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// LocalRootsEnv lists the directories, separated by commas, that the tools working on Terraform
+// configuration may read it from, within the roots exposed by the client. Reading configuration
+// from local directories is disabled when it is empty.
+const LocalRootsEnv = "MCP_LOCAL_ROOTS"
+
+// configurationFileSuffixes are the files read from a configuration directory
+var configurationFileSuffixes = []string{".tf", ".tf.json", ".tfvars", ".tfvars.json", ".terraform.lock.hcl"}
+
+// localRootsEnabled is set for the stdio transport only, as the roots of HTTP clients are directories
+// of their own machine, not of the server's
+var localRootsEnabled atomic.Bool
+
+// SetLocalRootsEnabled enables reading configuration from the local directories of MCP_LOCAL_ROOTS
+func SetLocalRootsEnabled(enabled bool) {
+	localRootsEnabled.Store(enabled)
+}
+
+// ReadConfigurationDirectory reads the Terraform files of a configuration directory of the machine the
+// server runs on, by path relative to the directory. The directory must be within one of the roots the
+// client of the session exposes and within one of the directories of MCP_LOCAL_ROOTS. Hidden
+// directories, such as .terraform and .git, and symbolic links are skipped.
+func ReadConfigurationDirectory(ctx context.Context, directory string, maxSize int) (map[string]string, error) {
+	if !localRootsEnabled.Load() {
+		return nil, fmt.Errorf("reading configuration from local directories is only supported with the stdio transport")
+	}
+	allowed := localRoots()
+	if len(allowed) == 0 {
+		return nil, fmt.Errorf("reading configuration from local directories is disabled, set %s to the directories it may be read from", LocalRootsEnv)
+	}
+	if !filepath.IsAbs(directory) {
+		return nil, fmt.Errorf("directory %q must be an absolute path", directory)
+	}
+	roots, err := clientRoots(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resolved, err := filepath.EvalSymlinks(filepath.Clean(directory))
+	if err != nil {
+		return nil, fmt.Errorf("cannot read directory %q: %w", directory, err)
+	}
+	if !isWithinAny(resolved, roots) {
+		return nil, fmt.Errorf("directory %q is not within the roots of the client", directory)
+	}
+	if !isWithinAny(resolved, allowed) {
+		return nil, fmt.Errorf("directory %q is not within the directories of %s", directory, LocalRootsEnv)
+	}
+
+	files := make(map[string]string)
+	size := 0
+	err = filepath.WalkDir(resolved, func(name string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			if name != resolved && strings.HasPrefix(entry.Name(), ".") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !entry.Type().IsRegular() || !isConfigurationFile(entry.Name()) {
+			return nil
+		}
+		content, err := os.ReadFile(name)
+		if err != nil {
+			return err
+		}
+		size += len(content)
+		if size > maxSize {
+			return fmt.Errorf("configuration files exceed %d MB", maxSize>>20)
+		}
+		relative, err := filepath.Rel(resolved, name)
+		if err != nil {
+			return err
+		}
+		files[filepath.ToSlash(relative)] = string(content)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no Terraform files in directory %q", directory)
+	}
+	return files, nil
+}
+
+func isConfigurationFile(name string) bool {
+	for _, suffix := range configurationFileSuffixes {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// localRoots returns the directories of MCP_LOCAL_ROOTS, with their symbolic links resolved
+func localRoots() []string {
+	var roots []string
+	for _, directory := range strings.Split(GetEnv(LocalRootsEnv, ""), ",") {
+		directory = strings.TrimSpace(directory)
+		if directory == "" || !filepath.IsAbs(directory) {
+			continue
+		}
+		if resolved, err := filepath.EvalSymlinks(directory); err == nil {
+			roots = append(roots, resolved)
+		}
+	}
+	return roots
+}
+
+// clientRoots asks the client of the session for its roots and returns their directories, with their
+// symbolic links resolved
+func clientRoots(ctx context.Context) ([]string, error) {
+	session := server.ClientSessionFromContext(ctx)
+	if session == nil {
+		return nil, fmt.Errorf("no client session to read the roots of")
+	}
+	if clientInfo, ok := session.(server.SessionWithClientInfo); ok && clientInfo.GetClientCapabilities().Roots == nil {
+		return nil, fmt.Errorf("the client does not expose roots, pass the files instead")
+	}
+	rootsSession, ok := session.(server.SessionWithRoots)
+	if !ok {
+		return nil, fmt.Errorf("the client does not expose roots, pass the files instead")
+	}
+	result, err := rootsSession.ListRoots(ctx, mcp.ListRootsRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the roots of the client: %w", err)
+	}
+
+	var roots []string
+	for _, root := range result.Roots {
+		u, err := url.Parse(root.URI)
+		if err != nil || u.Scheme != "file" || u.Path == "" {
+			continue
+		}
+		if resolved, err := filepath.EvalSymlinks(filepath.FromSlash(u.Path)); err == nil {
+			roots = append(roots, resolved)
+		}
+	}
+	if len(roots) == 0 {
+		return nil, fmt.Errorf("the client exposes no local directory as a root")
+	}
+	return roots, nil
+}
+
+// isWithinAny reports whether a path is one of the directories or is within one of them
+func isWithinAny(name string, directories []string) bool {
+	for _, directory := range directories {
+		relative, err := filepath.Rel(directory, name)
+		if err == nil && relative != ".." && !strings.HasPrefix(relative, ".."+string(filepath.Separator)) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,110 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build !integration
+
+package utils
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// rootsSession is a client session exposing roots
+type rootsSession struct {
+	roots []mcp.Root
+}
+
+func (s rootsSession) Initialize()                                         {}
+func (s rootsSession) Initialized() bool                                   { return true }
+func (s rootsSession) NotificationChannel() chan<- mcp.JSONRPCNotification { return nil }
+func (s rootsSession) SessionID() string                                   { return "roots-session" }
+func (s rootsSession) ListRoots(ctx context.Context, request mcp.ListRootsRequest) (*mcp.ListRootsResult, error) {
+	return &mcp.ListRootsResult{Roots: s.roots}, nil
+}
+
+func writeTestFile(t *testing.T, name string, content string) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Dir(name), 0o700))
+	require.NoError(t, os.WriteFile(name, []byte(content), 0o600))
+}
+
+func TestReadConfigurationDirectory(t *testing.T) {
+	workspace, err := filepath.EvalSymlinks(t.TempDir())
+	require.NoError(t, err)
+	project := filepath.Join(workspace, "project")
+	writeTestFile(t, filepath.Join(project, "main.tf"), `resource "null_resource" "this" {}`)
+	writeTestFile(t, filepath.Join(project, "modules", "app", "variables.tf"), `variable "name" {}`)
+	writeTestFile(t, filepath.Join(project, "README.md"), "# Project")
+	writeTestFile(t, filepath.Join(project, ".terraform", "modules", "modules.json"), "{}")
+	writeTestFile(t, filepath.Join(project, ".terraform", "providers", "main.tf"), "")
+	outside := filepath.Join(t.TempDir(), "secrets.tf")
+	writeTestFile(t, outside, `variable "password" {}`)
+	require.NoError(t, os.Symlink(outside, filepath.Join(project, "linked.tf")))
+
+	hcServer := server.NewMCPServer("test", "test")
+	ctx := hcServer.WithContext(context.Background(), rootsSession{roots: []mcp.Root{{URI: "file://" + filepath.ToSlash(workspace)}}})
+
+	SetLocalRootsEnabled(true)
+	t.Cleanup(func() { SetLocalRootsEnabled(false) })
+
+	t.Run("disabled without local roots", func(t *testing.T) {
+		t.Setenv(LocalRootsEnv, "")
+		_, err := ReadConfigurationDirectory(ctx, project, 1<<20)
+		assert.ErrorContains(t, err, "disabled")
+	})
+
+	t.Setenv(LocalRootsEnv, workspace)
+
+	t.Run("reads the Terraform files", func(t *testing.T) {
+		files, err := ReadConfigurationDirectory(ctx, project, 1<<20)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{
+			"main.tf":                  `resource "null_resource" "this" {}`,
+			"modules/app/variables.tf": `variable "name" {}`,
+		}, files)
+	})
+
+	t.Run("directory outside the roots of the client", func(t *testing.T) {
+		t.Setenv(LocalRootsEnv, workspace+","+filepath.Dir(outside))
+		_, err := ReadConfigurationDirectory(ctx, filepath.Dir(outside), 1<<20)
+		assert.ErrorContains(t, err, "not within the roots of the client")
+	})
+
+	t.Run("directory outside the local roots", func(t *testing.T) {
+		t.Setenv(LocalRootsEnv, project)
+		_, err := ReadConfigurationDirectory(ctx, workspace, 1<<20)
+		assert.ErrorContains(t, err, "not within the directories of "+LocalRootsEnv)
+	})
+
+	t.Run("escaping the roots", func(t *testing.T) {
+		_, err := ReadConfigurationDirectory(ctx, filepath.Join(project, "..", ".."), 1<<20)
+		assert.ErrorContains(t, err, "not within the roots of the client")
+		_, err = ReadConfigurationDirectory(ctx, "project", 1<<20)
+		assert.ErrorContains(t, err, "must be an absolute path")
+	})
+
+	t.Run("size limit", func(t *testing.T) {
+		_, err := ReadConfigurationDirectory(ctx, project, 10)
+		assert.ErrorContains(t, err, "exceed")
+	})
+
+	t.Run("client without roots", func(t *testing.T) {
+		_, err := ReadConfigurationDirectory(context.Background(), project, 1<<20)
+		assert.Error(t, err)
+	})
+
+	t.Run("only with the stdio transport", func(t *testing.T) {
+		SetLocalRootsEnabled(false)
+		defer SetLocalRootsEnabled(true)
+		_, err := ReadConfigurationDirectory(ctx, project, 1<<20)
+		assert.ErrorContains(t, err, "stdio")
+	})
+}
@@ -0,0 +1,50 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import "regexp"
+
+// RedactedValue replaces the secrets masked in tool arguments, tool results and logs
+const RedactedValue = "[REDACTED]"
+
+var (
+	// secretNamePattern matches the names of arguments, fields and attributes that hold secrets
+	secretNamePattern = regexp.MustCompile(`(?i)(password|passphrase|secret|token|private[_-]?key|credential|api[_-]?key|access[_-]?key|certificate[_-]?key|connection[_-]?string|kubeconfig|authorization)`)
+
+	// secretReferencePattern matches the names of the IDs of secrets, such as oauth_token_id, which
+	// tools need to reference them and which are not secrets themselves
+	secretReferencePattern = regexp.MustCompile(`(?i)[_-]ids?$`)
+
+	// terraformTokenPattern matches HCP Terraform and TFE API tokens
+	terraformTokenPattern = regexp.MustCompile(`[A-Za-z0-9]{14}\.atlasv1\.[A-Za-z0-9_=-]{20,}`)
+
+	// awsAccessKeyIDPattern matches AWS access key IDs of long-term and temporary credentials
+	awsAccessKeyIDPattern = regexp.MustCompile(`\b(?:AKIA|ASIA)[A-Z0-9]{16}\b`)
+
+	// awsSecretAccessKeyPattern matches AWS secret access keys assigned to a variable, key or setting
+	awsSecretAccessKeyPattern = regexp.MustCompile(`(?i)(aws_?secret_?access_?key["']?\s*[:=]\s*["']?)[A-Za-z0-9/+]{40}`)
+
+	// authorizationHeaderPattern matches the value of Authorization headers in logs and error messages
+	authorizationHeaderPattern = regexp.MustCompile(`(?i)((?:proxy-)?authorization["']?\s*[:=]\s*["']?)(?:(?:bearer|basic|token)\s+)?[^\s"',]+`)
+
+	// privateKeyPattern matches PEM-encoded private keys, such as the SSH keys added to an
+	// organization, up to their end line or the end of a truncated text
+	privateKeyPattern = regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----[\s\S]*?(?:-----END [A-Z ]*PRIVATE KEY-----\s*|$)`)
+)
+
+// IsSecretName reports whether the name of an argument, field or attribute denotes a secret, such
+// as a password, token, private key or Authorization header
+func IsSecretName(name string) bool {
+	return secretNamePattern.MatchString(name) && !secretReferencePattern.MatchString(name)
+}
+
+// RedactSecrets masks the secrets embedded in a string: HCP Terraform and TFE API tokens, AWS
+// keys, PEM-encoded private keys and the values of Authorization headers
+func RedactSecrets(value string) string {
+	value = privateKeyPattern.ReplaceAllString(value, RedactedValue)
+	value = terraformTokenPattern.ReplaceAllString(value, RedactedValue)
+	value = awsAccessKeyIDPattern.ReplaceAllString(value, RedactedValue)
+	value = awsSecretAccessKeyPattern.ReplaceAllString(value, "${1}"+RedactedValue)
+	return authorizationHeaderPattern.ReplaceAllString(value, "${1}"+RedactedValue)
+}
@@ -0,0 +1,32 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// TextRange returns the part of a text starting at offset and at most maxBytes long. The part
+// ends at a line break when there is one in its second half and never splits a character. The offset
+// the next part starts at is returned, or 0 when the text is complete.
+func TextRange(text string, offset, maxBytes int) (string, int) {
+	if offset >= len(text) {
+		return "", 0
+	}
+	for offset > 0 && !utf8.RuneStart(text[offset]) {
+		offset--
+	}
+	end := offset + maxBytes
+	if end >= len(text) {
+		return text[offset:], 0
+	}
+	if newline := strings.LastIndexByte(text[offset:end], '\n'); newline >= maxBytes/2 {
+		end = offset + newline + 1
+	}
+	for end > offset && !utf8.RuneStart(text[end]) {
+		end--
+	}
+	return text[offset:end], end
+}
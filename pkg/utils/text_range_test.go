@@ -0,0 +1,47 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTextRange(t *testing.T) {
+	t.Run("whole text within the budget", func(t *testing.T) {
+		part, next := TextRange("short text", 0, 100)
+		assert.Equal(t, "short text", part)
+		assert.Zero(t, next)
+	})
+
+	t.Run("parts end at line breaks", func(t *testing.T) {
+		text := "line one\nline two\nline three\n"
+		part, next := TextRange(text, 0, 20)
+		assert.Equal(t, "line one\nline two\n", part)
+		assert.Equal(t, len(part), next)
+
+		rest, next := TextRange(text, next, 20)
+		assert.Equal(t, "line three\n", rest)
+		assert.Zero(t, next)
+	})
+
+	t.Run("characters are never split", func(t *testing.T) {
+		text := strings.Repeat("é", 10)
+		part, next := TextRange(text, 0, 5)
+		assert.True(t, utf8.ValidString(part))
+		assert.Equal(t, 4, next)
+
+		part, _ = TextRange(text, 5, 4)
+		assert.True(t, utf8.ValidString(part))
+	})
+
+	t.Run("offset past the end", func(t *testing.T) {
+		part, next := TextRange("text", 10, 5)
+		assert.Empty(t, part)
+		assert.Zero(t, next)
+	})
+}
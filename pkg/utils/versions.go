@@ -0,0 +1,99 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-version"
+)
+
+// Release channels accepted by the latest version tools
+const (
+	ReleaseChannelStable = "stable"
+	ReleaseChannelAny    = "any"
+	ReleaseChannelAlpha  = "alpha"
+	ReleaseChannelBeta   = "beta"
+	ReleaseChannelRC     = "rc"
+)
+
+// ReleaseChannels returns the list of supported release channels
+func ReleaseChannels() []string {
+	return []string{ReleaseChannelStable, ReleaseChannelAny, ReleaseChannelAlpha, ReleaseChannelBeta, ReleaseChannelRC}
+}
+
+// LatestVersionForChannel returns the highest version in versions that belongs to the given release channel.
+// The "stable" channel only considers versions without a pre-release suffix, "any" considers every version,
+// and "alpha", "beta" and "rc" only consider pre-releases whose suffix starts with the channel name.
+// Entries that cannot be parsed as versions are ignored.
+func LatestVersionForChannel(versions []string, channel string) (string, error) {
+	channel = strings.ToLower(strings.TrimSpace(channel))
+	if channel == "" {
+		channel = ReleaseChannelStable
+	}
+
+	var latest *version.Version
+	var latestRaw string
+	for _, raw := range versions {
+		v, err := version.NewVersion(raw)
+		if err != nil {
+			continue
+		}
+		if !versionMatchesChannel(v, channel) {
+			continue
+		}
+		if latest == nil || v.GreaterThan(latest) {
+			latest = v
+			latestRaw = raw
+		}
+	}
+
+	if latest == nil {
+		return "", fmt.Errorf("no versions found in the %q release channel", channel)
+	}
+	return latestRaw, nil
+}
+
+// IsVersionInChannel reports whether the given version string belongs to the release channel,
+// with the same rules as LatestVersionForChannel. Invalid versions belong to no channel.
+func IsVersionInChannel(raw string, channel string) bool {
+	v, err := version.NewVersion(raw)
+	if err != nil {
+		return false
+	}
+	channel = strings.ToLower(strings.TrimSpace(channel))
+	if channel == "" {
+		channel = ReleaseChannelStable
+	}
+	return versionMatchesChannel(v, channel)
+}
+
+// ReleaseChannelOf returns the release channel label of the given version string,
+// e.g. "stable" for 1.2.0 and "beta" for 1.2.0-beta1. Unknown pre-release suffixes
+// are reported as "pre-release".
+func ReleaseChannelOf(raw string) string {
+	v, err := version.NewVersion(raw)
+	if err != nil || v.Prerelease() == "" {
+		return ReleaseChannelStable
+	}
+	for _, channel := range []string{ReleaseChannelAlpha, ReleaseChannelBeta, ReleaseChannelRC} {
+		if versionMatchesChannel(v, channel) {
+			return channel
+		}
+	}
+	return "pre-release"
+}
+
+func versionMatchesChannel(v *version.Version, channel string) bool {
+	prerelease := strings.ToLower(v.Prerelease())
+	switch channel {
+	case ReleaseChannelAny:
+		return true
+	case ReleaseChannelStable:
+		return prerelease == ""
+	default:
+		return prerelease != "" && strings.HasPrefix(prerelease, channel)
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLatestVersionForChannel(t *testing.T) {
+	versions := []string{"1.0.0", "1.1.0", "1.2.0-alpha1", "1.2.0-beta.2", "1.2.0-beta.10", "1.2.0-rc1", "not-a-version", "v0.9.0"}
+
+	tests := []struct {
+		channel  string
+		expected string
+	}{
+		{"", "1.1.0"},
+		{ReleaseChannelStable, "1.1.0"},
+		{ReleaseChannelAny, "1.2.0-rc1"},
+		{ReleaseChannelAlpha, "1.2.0-alpha1"},
+		{ReleaseChannelBeta, "1.2.0-beta.10"},
+		{"RC", "1.2.0-rc1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.channel, func(t *testing.T) {
+			latest, err := LatestVersionForChannel(versions, tt.channel)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, latest)
+		})
+	}
+
+	t.Run("no matching versions", func(t *testing.T) {
+		_, err := LatestVersionForChannel([]string{"1.0.0", "1.1.0"}, ReleaseChannelBeta)
+		assert.Error(t, err)
+	})
+}
+
+func TestReleaseChannelOf(t *testing.T) {
+	assert.Equal(t, ReleaseChannelStable, ReleaseChannelOf("1.2.3"))
+	assert.Equal(t, ReleaseChannelAlpha, ReleaseChannelOf("1.2.3-alpha20240101"))
+	assert.Equal(t, ReleaseChannelBeta, ReleaseChannelOf("1.2.3-beta1"))
+	assert.Equal(t, ReleaseChannelRC, ReleaseChannelOf("1.2.3-rc.2"))
+	assert.Equal(t, "pre-release", ReleaseChannelOf("1.2.3-dev"))
+	assert.Equal(t, ReleaseChannelStable, ReleaseChannelOf("garbage"))
+}
+
+func TestIsVersionInChannel(t *testing.T) {
+	assert.True(t, IsVersionInChannel("1.1.0", ""))
+	assert.False(t, IsVersionInChannel("1.2.0-beta1", ReleaseChannelStable))
+	assert.True(t, IsVersionInChannel("1.2.0-beta1", "Beta"))
+	assert.True(t, IsVersionInChannel("1.2.0-beta1", ReleaseChannelAny))
+	assert.False(t, IsVersionInChannel("not-a-version", ReleaseChannelAny))
+}